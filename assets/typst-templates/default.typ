@@ -67,6 +67,7 @@
   keywords: (),
   styling: (:),                 // font, font-size, margin (sets defaults below)
   items: (),                    // Line items
+  sections: (),                 // Line items grouped per customer on consolidated invoices
   applied-taxes: (),            // Applied taxes breakdown
   applied-discounts: (),        // Applied discounts breakdown
   subtotal: 0,                  // Subtotal before discounts and tax
@@ -181,47 +182,66 @@
   [== Order Details]
   v(1em)
 
-  table(
-    columns: (1fr, 2fr, 1fr, 1fr, 1fr),
-    inset: 8pt,
-    align: (left, left, left, center, right),
-    fill: white,
-    stroke: (x, y) => (
-      bottom: if y == 0 { 1pt + styling.line-color } else { 1pt + styling.line-color },
-    ),
-    table.header(
-      [*Item*],
-      [*Description*],
-      [*Interval*],
-      [*Quantity*],
-      [*Amount*],
-    ),
-    ..items.map((item) => {
-      let line-total = item.quantity * item.amount
-      let amount-display = if line-total < 0 {
-        [−#currency #format-number(calc.abs(line-total))]
-      } else {
-        [#currency #format-number(line-total)]
-      }
-      
-      (
-        item.at("plan_display_name", default: "Plan"),
-        if item.at("description", default: "Recurring") != "" {
-          item.at("description", default: "Recurring")
-        } else {
-          "-"
-        },
-        if item.at("period_start", default: "") != "" and 
-         item.at("period_end", default: "") != "" {
-          [#format-date(parse-date(item.at("period_start"))) - #format-date(parse-date(item.at("period_end")))]
+  let items-table = (section-items) => {
+    table(
+      columns: (1fr, 2fr, 1fr, 1fr, 1fr),
+      inset: 8pt,
+      align: (left, left, left, center, right),
+      fill: white,
+      stroke: (x, y) => (
+        bottom: if y == 0 { 1pt + styling.line-color } else { 1pt + styling.line-color },
+      ),
+      table.header(
+        [*Item*],
+        [*Description*],
+        [*Interval*],
+        [*Quantity*],
+        [*Amount*],
+      ),
+      ..section-items.map((item) => {
+        let line-total = item.quantity * item.amount
+        let amount-display = if line-total < 0 {
+          [−#currency #format-number(calc.abs(line-total))]
         } else {
-          "-"
-        },
-        format-number(item.quantity),
-        amount-display,
+          [#currency #format-number(line-total)]
+        }
+      
+        (
+          item.at("plan_display_name", default: "Plan"),
+          if item.at("description", default: "Recurring") != "" {
+            item.at("description", default: "Recurring")
+          } else {
+            "-"
+          },
+          if item.at("period_start", default: "") != "" and 
+           item.at("period_end", default: "") != "" {
+            [#format-date(parse-date(item.at("period_start"))) - #format-date(parse-date(item.at("period_end")))]
+          } else {
+            "-"
+          },
+          format-number(item.quantity),
+          amount-display,
+        )
+      }).flatten(),
+    )
+  }
+
+  if sections.len() > 0 {
+    // Consolidated invoices list the line items of each customer in a separate section
+    for section in sections {
+      grid(
+        columns: (1fr, 1fr),
+        align: (left, right),
+        text(weight: "semibold")[#section.at("customer_name", default: "")],
+        text(weight: "semibold")[#currency#format-number(section.at("subtotal", default: 0))],
       )
-    }).flatten(),
-  )
+      v(0.5em)
+      items-table(section.at("line_items", default: ()))
+      v(1em)
+    }
+  } else {
+    items-table(items)
+  }
 
   v(1em)
 
@@ -41,6 +41,7 @@
     )
   ),
   items: invoice-data.at("line_items", default: ()),
+  sections: invoice-data.at("sections", default: ()),
   applied-taxes: invoice-data.at("applied_taxes", default: ()),
   applied-discounts: invoice-data.at("applied_discounts", default: ()),
   styling: (
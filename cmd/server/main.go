@@ -136,6 +136,7 @@ func main() {
 			repository.NewInvoiceRepository,
 			repository.NewFeatureRepository,
 			repository.NewEntitlementRepository,
+			repository.NewEntitlementUsageCounterRepository,
 			repository.NewPaymentRepository,
 			repository.NewTaskRepository,
 			repository.NewTaxAppliedRepository,
@@ -152,6 +153,7 @@ func main() {
 			repository.NewCouponRepository,
 			repository.NewCouponAssociationRepository,
 			repository.NewCouponApplicationRepository,
+			repository.NewPromotionCodeRepository,
 			repository.NewPriceUnitRepository,
 			repository.NewAddonRepository,
 			repository.NewAddonAssociationRepository,
@@ -160,6 +162,12 @@ func main() {
 			repository.NewAlertLogsRepository,
 			repository.NewGroupRepository,
 			repository.NewScheduledTaskRepository,
+			repository.NewEventSchemaRepository,
+			repository.NewRejectedEventRepository,
+			repository.NewDeadLetterEventRepository,
+			repository.NewEventCorrectionRepository,
+			repository.NewEventCorrectionClaimRepository,
+			repository.NewMetricSeriesStateRepository,
 
 			// PubSub
 			pubsubRouter.NewRouter,
@@ -190,6 +198,7 @@ func main() {
 			service.NewEventPostProcessingService,
 			service.NewEventConsumptionService,
 			service.NewFeatureUsageTrackingService,
+			service.NewEventQueueConsumptionService,
 			service.NewPriceService,
 			service.NewCustomerService,
 			service.NewPlanService,
@@ -212,6 +221,7 @@ func main() {
 			service.NewEntityIntegrationMappingService,
 			service.NewTaxService,
 			service.NewCouponService,
+			service.NewPromotionCodeService,
 			service.NewPriceUnitService,
 			service.NewAddonService,
 			service.NewSettingsService,
@@ -219,6 +229,10 @@ func main() {
 			service.NewAlertLogsService,
 			service.NewGroupService,
 			service.NewScheduledTaskService,
+			service.NewEventSchemaService,
+			service.NewEventCorrectionService,
+			service.NewMetricsIngestionService,
+			service.NewUsageStreamService,
 		),
 	)
 
@@ -281,6 +295,7 @@ func provideHandlers(
 	svixClient *svix.Client,
 	taxService service.TaxService,
 	couponService service.CouponService,
+	promotionCodeService service.PromotionCodeService,
 	addonService service.AddonService,
 	settingsService service.SettingsService,
 	subscriptionChangeService service.SubscriptionChangeService,
@@ -290,9 +305,14 @@ func provideHandlers(
 	integrationFactory *integration.Factory,
 	db postgres.IClient,
 	scheduledTaskService service.ScheduledTaskService,
+	eventSchemaService service.EventSchemaService,
+	eventCorrectionService service.EventCorrectionService,
+	metricsIngestionService service.MetricsIngestionService,
+	usageStreamService service.UsageStreamService,
+	eventQueueConsumptionService service.EventQueueConsumptionService,
 ) api.Handlers {
 	return api.Handlers{
-		Events:                   v1.NewEventsHandler(eventService, eventPostProcessingService, featureUsageTrackingService, cfg, logger),
+		Events:                   v1.NewEventsHandler(eventService, eventPostProcessingService, featureUsageTrackingService, eventCorrectionService, cfg, logger),
 		Meter:                    v1.NewMeterHandler(meterService, logger),
 		Auth:                     v1.NewAuthHandler(cfg, authService, logger),
 		User:                     v1.NewUserHandler(userService, logger),
@@ -327,11 +347,16 @@ func provideHandlers(
 		PriceUnit:                v1.NewPriceUnitHandler(priceUnitService, logger),
 		Webhook:                  v1.NewWebhookHandler(cfg, svixClient, logger, integrationFactory, customerService, paymentService, invoiceService, planService, subscriptionService, entityIntegrationMappingService, db),
 		Coupon:                   v1.NewCouponHandler(couponService, logger),
+		PromotionCode:            v1.NewPromotionCodeHandler(promotionCodeService, logger),
 		Addon:                    v1.NewAddonHandler(addonService, logger),
 		Settings:                 v1.NewSettingsHandler(settingsService, logger),
 		SetupIntent:              v1.NewSetupIntentHandler(integrationFactory, customerService, logger),
 		Group:                    v1.NewGroupHandler(groupService, logger),
 		ScheduledTask:            v1.NewScheduledTaskHandler(scheduledTaskService, logger),
+		EventSchema:              v1.NewEventSchemaHandler(eventSchemaService, logger),
+		MetricsIngestion:         v1.NewMetricsIngestionHandler(metricsIngestionService, logger),
+		ForwardedEvents:          v1.NewForwardedEventsHandler(eventQueueConsumptionService, cfg, logger),
+		UsageStream:              v1.NewUsageStreamHandler(usageStreamService, featureUsageTrackingService, logger),
 	}
 }
 
@@ -402,6 +427,7 @@ func startServer(
 	eventPostProcessingSvc service.EventPostProcessingService,
 	eventConsumptionSvc service.EventConsumptionService,
 	featureUsageSvc service.FeatureUsageTrackingService,
+	eventQueueConsumptionSvc service.EventQueueConsumptionService,
 	params service.ServiceParams,
 ) {
 	mode := cfg.Deployment.Mode
@@ -417,14 +443,14 @@ func startServer(
 		startAPIServer(lc, r, cfg, log)
 
 		// Register all handlers and start router once
-		registerRouterHandlers(router, webhookService, onboardingService, eventPostProcessingSvc, eventConsumptionSvc, featureUsageSvc, cfg, true)
+		registerRouterHandlers(router, webhookService, onboardingService, eventPostProcessingSvc, eventConsumptionSvc, featureUsageSvc, eventQueueConsumptionSvc, cfg, true)
 		startRouter(lc, router, log)
 		startTemporalWorker(lc, temporalService, params)
 	case types.ModeAPI:
 		startAPIServer(lc, r, cfg, log)
 
 		// Register all handlers and start router once (no event consumption)
-		registerRouterHandlers(router, webhookService, onboardingService, eventPostProcessingSvc, eventConsumptionSvc, featureUsageSvc, cfg, false)
+		registerRouterHandlers(router, webhookService, onboardingService, eventPostProcessingSvc, eventConsumptionSvc, featureUsageSvc, eventQueueConsumptionSvc, cfg, false)
 		startRouter(lc, router, log)
 
 	case types.ModeTemporalWorker:
@@ -435,7 +461,7 @@ func startServer(
 		}
 
 		// Register all handlers and start router once
-		registerRouterHandlers(router, webhookService, onboardingService, eventPostProcessingSvc, eventConsumptionSvc, featureUsageSvc, cfg, true)
+		registerRouterHandlers(router, webhookService, onboardingService, eventPostProcessingSvc, eventConsumptionSvc, featureUsageSvc, eventQueueConsumptionSvc, cfg, true)
 		startRouter(lc, router, log)
 	default:
 		log.Fatalf("Unknown deployment mode: %s", mode)
@@ -500,6 +526,7 @@ func registerRouterHandlers(
 	eventPostProcessingSvc service.EventPostProcessingService,
 	eventConsumptionSvc service.EventConsumptionService,
 	featureUsageSvc service.FeatureUsageTrackingService,
+	eventQueueConsumptionSvc service.EventQueueConsumptionService,
 	cfg *config.Configuration,
 	includeProcessingHandlers bool,
 ) {
@@ -515,6 +542,7 @@ func registerRouterHandlers(
 		eventPostProcessingSvc.RegisterHandler(router, cfg)
 		featureUsageSvc.RegisterHandler(router, cfg)
 		featureUsageSvc.RegisterHandlerLazy(router, cfg)
+		eventQueueConsumptionSvc.RegisterHandler(router, cfg)
 	}
 }
 
@@ -31,19 +31,25 @@ import (
 	"github.com/flexprice/flexprice/ent/creditnotelineitem"
 	"github.com/flexprice/flexprice/ent/customer"
 	"github.com/flexprice/flexprice/ent/entitlement"
+	"github.com/flexprice/flexprice/ent/entitlementusagecounter"
 	"github.com/flexprice/flexprice/ent/entityintegrationmapping"
 	"github.com/flexprice/flexprice/ent/environment"
+	"github.com/flexprice/flexprice/ent/eventcorrectionclaim"
+	"github.com/flexprice/flexprice/ent/eventschema"
 	"github.com/flexprice/flexprice/ent/feature"
 	"github.com/flexprice/flexprice/ent/group"
 	"github.com/flexprice/flexprice/ent/invoice"
 	"github.com/flexprice/flexprice/ent/invoicelineitem"
 	"github.com/flexprice/flexprice/ent/invoicesequence"
 	"github.com/flexprice/flexprice/ent/meter"
+	"github.com/flexprice/flexprice/ent/metricseriesstate"
 	"github.com/flexprice/flexprice/ent/payment"
 	"github.com/flexprice/flexprice/ent/paymentattempt"
+	"github.com/flexprice/flexprice/ent/paymentrefund"
 	"github.com/flexprice/flexprice/ent/plan"
 	"github.com/flexprice/flexprice/ent/price"
 	"github.com/flexprice/flexprice/ent/priceunit"
+	"github.com/flexprice/flexprice/ent/promotioncode"
 	"github.com/flexprice/flexprice/ent/scheduledtask"
 	"github.com/flexprice/flexprice/ent/secret"
 	"github.com/flexprice/flexprice/ent/settings"
@@ -101,10 +107,16 @@ type Client struct {
 	Customer *CustomerClient
 	// Entitlement is the client for interacting with the Entitlement builders.
 	Entitlement *EntitlementClient
+	// EntitlementUsageCounter is the client for interacting with the EntitlementUsageCounter builders.
+	EntitlementUsageCounter *EntitlementUsageCounterClient
 	// EntityIntegrationMapping is the client for interacting with the EntityIntegrationMapping builders.
 	EntityIntegrationMapping *EntityIntegrationMappingClient
 	// Environment is the client for interacting with the Environment builders.
 	Environment *EnvironmentClient
+	// EventCorrectionClaim is the client for interacting with the EventCorrectionClaim builders.
+	EventCorrectionClaim *EventCorrectionClaimClient
+	// EventSchema is the client for interacting with the EventSchema builders.
+	EventSchema *EventSchemaClient
 	// Feature is the client for interacting with the Feature builders.
 	Feature *FeatureClient
 	// Group is the client for interacting with the Group builders.
@@ -117,16 +129,22 @@ type Client struct {
 	InvoiceSequence *InvoiceSequenceClient
 	// Meter is the client for interacting with the Meter builders.
 	Meter *MeterClient
+	// MetricSeriesState is the client for interacting with the MetricSeriesState builders.
+	MetricSeriesState *MetricSeriesStateClient
 	// Payment is the client for interacting with the Payment builders.
 	Payment *PaymentClient
 	// PaymentAttempt is the client for interacting with the PaymentAttempt builders.
 	PaymentAttempt *PaymentAttemptClient
+	// PaymentRefund is the client for interacting with the PaymentRefund builders.
+	PaymentRefund *PaymentRefundClient
 	// Plan is the client for interacting with the Plan builders.
 	Plan *PlanClient
 	// Price is the client for interacting with the Price builders.
 	Price *PriceClient
 	// PriceUnit is the client for interacting with the PriceUnit builders.
 	PriceUnit *PriceUnitClient
+	// PromotionCode is the client for interacting with the PromotionCode builders.
+	PromotionCode *PromotionCodeClient
 	// ScheduledTask is the client for interacting with the ScheduledTask builders.
 	ScheduledTask *ScheduledTaskClient
 	// Secret is the client for interacting with the Secret builders.
@@ -186,19 +204,25 @@ func (c *Client) init() {
 	c.CreditNoteLineItem = NewCreditNoteLineItemClient(c.config)
 	c.Customer = NewCustomerClient(c.config)
 	c.Entitlement = NewEntitlementClient(c.config)
+	c.EntitlementUsageCounter = NewEntitlementUsageCounterClient(c.config)
 	c.EntityIntegrationMapping = NewEntityIntegrationMappingClient(c.config)
 	c.Environment = NewEnvironmentClient(c.config)
+	c.EventCorrectionClaim = NewEventCorrectionClaimClient(c.config)
+	c.EventSchema = NewEventSchemaClient(c.config)
 	c.Feature = NewFeatureClient(c.config)
 	c.Group = NewGroupClient(c.config)
 	c.Invoice = NewInvoiceClient(c.config)
 	c.InvoiceLineItem = NewInvoiceLineItemClient(c.config)
 	c.InvoiceSequence = NewInvoiceSequenceClient(c.config)
 	c.Meter = NewMeterClient(c.config)
+	c.MetricSeriesState = NewMetricSeriesStateClient(c.config)
 	c.Payment = NewPaymentClient(c.config)
 	c.PaymentAttempt = NewPaymentAttemptClient(c.config)
+	c.PaymentRefund = NewPaymentRefundClient(c.config)
 	c.Plan = NewPlanClient(c.config)
 	c.Price = NewPriceClient(c.config)
 	c.PriceUnit = NewPriceUnitClient(c.config)
+	c.PromotionCode = NewPromotionCodeClient(c.config)
 	c.ScheduledTask = NewScheduledTaskClient(c.config)
 	c.Secret = NewSecretClient(c.config)
 	c.Settings = NewSettingsClient(c.config)
@@ -323,19 +347,25 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		CreditNoteLineItem:        NewCreditNoteLineItemClient(cfg),
 		Customer:                  NewCustomerClient(cfg),
 		Entitlement:               NewEntitlementClient(cfg),
+		EntitlementUsageCounter:   NewEntitlementUsageCounterClient(cfg),
 		EntityIntegrationMapping:  NewEntityIntegrationMappingClient(cfg),
 		Environment:               NewEnvironmentClient(cfg),
+		EventCorrectionClaim:      NewEventCorrectionClaimClient(cfg),
+		EventSchema:               NewEventSchemaClient(cfg),
 		Feature:                   NewFeatureClient(cfg),
 		Group:                     NewGroupClient(cfg),
 		Invoice:                   NewInvoiceClient(cfg),
 		InvoiceLineItem:           NewInvoiceLineItemClient(cfg),
 		InvoiceSequence:           NewInvoiceSequenceClient(cfg),
 		Meter:                     NewMeterClient(cfg),
+		MetricSeriesState:         NewMetricSeriesStateClient(cfg),
 		Payment:                   NewPaymentClient(cfg),
 		PaymentAttempt:            NewPaymentAttemptClient(cfg),
+		PaymentRefund:             NewPaymentRefundClient(cfg),
 		Plan:                      NewPlanClient(cfg),
 		Price:                     NewPriceClient(cfg),
 		PriceUnit:                 NewPriceUnitClient(cfg),
+		PromotionCode:             NewPromotionCodeClient(cfg),
 		ScheduledTask:             NewScheduledTaskClient(cfg),
 		Secret:                    NewSecretClient(cfg),
 		Settings:                  NewSettingsClient(cfg),
@@ -387,19 +417,25 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		CreditNoteLineItem:        NewCreditNoteLineItemClient(cfg),
 		Customer:                  NewCustomerClient(cfg),
 		Entitlement:               NewEntitlementClient(cfg),
+		EntitlementUsageCounter:   NewEntitlementUsageCounterClient(cfg),
 		EntityIntegrationMapping:  NewEntityIntegrationMappingClient(cfg),
 		Environment:               NewEnvironmentClient(cfg),
+		EventCorrectionClaim:      NewEventCorrectionClaimClient(cfg),
+		EventSchema:               NewEventSchemaClient(cfg),
 		Feature:                   NewFeatureClient(cfg),
 		Group:                     NewGroupClient(cfg),
 		Invoice:                   NewInvoiceClient(cfg),
 		InvoiceLineItem:           NewInvoiceLineItemClient(cfg),
 		InvoiceSequence:           NewInvoiceSequenceClient(cfg),
 		Meter:                     NewMeterClient(cfg),
+		MetricSeriesState:         NewMetricSeriesStateClient(cfg),
 		Payment:                   NewPaymentClient(cfg),
 		PaymentAttempt:            NewPaymentAttemptClient(cfg),
+		PaymentRefund:             NewPaymentRefundClient(cfg),
 		Plan:                      NewPlanClient(cfg),
 		Price:                     NewPriceClient(cfg),
 		PriceUnit:                 NewPriceUnitClient(cfg),
+		PromotionCode:             NewPromotionCodeClient(cfg),
 		ScheduledTask:             NewScheduledTaskClient(cfg),
 		Secret:                    NewSecretClient(cfg),
 		Settings:                  NewSettingsClient(cfg),
@@ -448,10 +484,12 @@ func (c *Client) Use(hooks ...Hook) {
 		c.Addon, c.AddonAssociation, c.AlertLogs, c.Auth, c.BillingSequence,
 		c.Connection, c.Costsheet, c.Coupon, c.CouponApplication, c.CouponAssociation,
 		c.CreditGrant, c.CreditGrantApplication, c.CreditNote, c.CreditNoteLineItem,
-		c.Customer, c.Entitlement, c.EntityIntegrationMapping, c.Environment,
-		c.Feature, c.Group, c.Invoice, c.InvoiceLineItem, c.InvoiceSequence, c.Meter,
-		c.Payment, c.PaymentAttempt, c.Plan, c.Price, c.PriceUnit, c.ScheduledTask,
-		c.Secret, c.Settings, c.Subscription, c.SubscriptionLineItem,
+		c.Customer, c.Entitlement, c.EntitlementUsageCounter,
+		c.EntityIntegrationMapping, c.Environment, c.EventCorrectionClaim,
+		c.EventSchema, c.Feature, c.Group, c.Invoice, c.InvoiceLineItem,
+		c.InvoiceSequence, c.Meter, c.MetricSeriesState, c.Payment, c.PaymentAttempt,
+		c.PaymentRefund, c.Plan, c.Price, c.PriceUnit, c.PromotionCode,
+		c.ScheduledTask, c.Secret, c.Settings, c.Subscription, c.SubscriptionLineItem,
 		c.SubscriptionPause, c.SubscriptionSchedule, c.SubscriptionSchedulePhase,
 		c.Task, c.TaxApplied, c.TaxAssociation, c.TaxRate, c.Tenant, c.User, c.Wallet,
 		c.WalletTransaction,
@@ -467,10 +505,12 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 		c.Addon, c.AddonAssociation, c.AlertLogs, c.Auth, c.BillingSequence,
 		c.Connection, c.Costsheet, c.Coupon, c.CouponApplication, c.CouponAssociation,
 		c.CreditGrant, c.CreditGrantApplication, c.CreditNote, c.CreditNoteLineItem,
-		c.Customer, c.Entitlement, c.EntityIntegrationMapping, c.Environment,
-		c.Feature, c.Group, c.Invoice, c.InvoiceLineItem, c.InvoiceSequence, c.Meter,
-		c.Payment, c.PaymentAttempt, c.Plan, c.Price, c.PriceUnit, c.ScheduledTask,
-		c.Secret, c.Settings, c.Subscription, c.SubscriptionLineItem,
+		c.Customer, c.Entitlement, c.EntitlementUsageCounter,
+		c.EntityIntegrationMapping, c.Environment, c.EventCorrectionClaim,
+		c.EventSchema, c.Feature, c.Group, c.Invoice, c.InvoiceLineItem,
+		c.InvoiceSequence, c.Meter, c.MetricSeriesState, c.Payment, c.PaymentAttempt,
+		c.PaymentRefund, c.Plan, c.Price, c.PriceUnit, c.PromotionCode,
+		c.ScheduledTask, c.Secret, c.Settings, c.Subscription, c.SubscriptionLineItem,
 		c.SubscriptionPause, c.SubscriptionSchedule, c.SubscriptionSchedulePhase,
 		c.Task, c.TaxApplied, c.TaxAssociation, c.TaxRate, c.Tenant, c.User, c.Wallet,
 		c.WalletTransaction,
@@ -514,10 +554,16 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Customer.mutate(ctx, m)
 	case *EntitlementMutation:
 		return c.Entitlement.mutate(ctx, m)
+	case *EntitlementUsageCounterMutation:
+		return c.EntitlementUsageCounter.mutate(ctx, m)
 	case *EntityIntegrationMappingMutation:
 		return c.EntityIntegrationMapping.mutate(ctx, m)
 	case *EnvironmentMutation:
 		return c.Environment.mutate(ctx, m)
+	case *EventCorrectionClaimMutation:
+		return c.EventCorrectionClaim.mutate(ctx, m)
+	case *EventSchemaMutation:
+		return c.EventSchema.mutate(ctx, m)
 	case *FeatureMutation:
 		return c.Feature.mutate(ctx, m)
 	case *GroupMutation:
@@ -530,16 +576,22 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.InvoiceSequence.mutate(ctx, m)
 	case *MeterMutation:
 		return c.Meter.mutate(ctx, m)
+	case *MetricSeriesStateMutation:
+		return c.MetricSeriesState.mutate(ctx, m)
 	case *PaymentMutation:
 		return c.Payment.mutate(ctx, m)
 	case *PaymentAttemptMutation:
 		return c.PaymentAttempt.mutate(ctx, m)
+	case *PaymentRefundMutation:
+		return c.PaymentRefund.mutate(ctx, m)
 	case *PlanMutation:
 		return c.Plan.mutate(ctx, m)
 	case *PriceMutation:
 		return c.Price.mutate(ctx, m)
 	case *PriceUnitMutation:
 		return c.PriceUnit.mutate(ctx, m)
+	case *PromotionCodeMutation:
+		return c.PromotionCode.mutate(ctx, m)
 	case *ScheduledTaskMutation:
 		return c.ScheduledTask.mutate(ctx, m)
 	case *SecretMutation:
@@ -1680,6 +1732,22 @@ func (c *CouponClient) QueryCouponApplications(co *Coupon) *CouponApplicationQue
 	return query
 }
 
+// QueryPromotionCodes queries the promotion_codes edge of a Coupon.
+func (c *CouponClient) QueryPromotionCodes(co *Coupon) *PromotionCodeQuery {
+	query := (&PromotionCodeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := co.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(coupon.Table, coupon.FieldID, id),
+			sqlgraph.To(promotioncode.Table, promotioncode.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, coupon.PromotionCodesTable, coupon.PromotionCodesColumn),
+		)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *CouponClient) Hooks() []Hook {
 	return c.hooks.Coupon
@@ -2977,6 +3045,139 @@ func (c *EntitlementClient) mutate(ctx context.Context, m *EntitlementMutation)
 	}
 }
 
+// EntitlementUsageCounterClient is a client for the EntitlementUsageCounter schema.
+type EntitlementUsageCounterClient struct {
+	config
+}
+
+// NewEntitlementUsageCounterClient returns a client for the EntitlementUsageCounter from the given config.
+func NewEntitlementUsageCounterClient(c config) *EntitlementUsageCounterClient {
+	return &EntitlementUsageCounterClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `entitlementusagecounter.Hooks(f(g(h())))`.
+func (c *EntitlementUsageCounterClient) Use(hooks ...Hook) {
+	c.hooks.EntitlementUsageCounter = append(c.hooks.EntitlementUsageCounter, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `entitlementusagecounter.Intercept(f(g(h())))`.
+func (c *EntitlementUsageCounterClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EntitlementUsageCounter = append(c.inters.EntitlementUsageCounter, interceptors...)
+}
+
+// Create returns a builder for creating a EntitlementUsageCounter entity.
+func (c *EntitlementUsageCounterClient) Create() *EntitlementUsageCounterCreate {
+	mutation := newEntitlementUsageCounterMutation(c.config, OpCreate)
+	return &EntitlementUsageCounterCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EntitlementUsageCounter entities.
+func (c *EntitlementUsageCounterClient) CreateBulk(builders ...*EntitlementUsageCounterCreate) *EntitlementUsageCounterCreateBulk {
+	return &EntitlementUsageCounterCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EntitlementUsageCounterClient) MapCreateBulk(slice any, setFunc func(*EntitlementUsageCounterCreate, int)) *EntitlementUsageCounterCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EntitlementUsageCounterCreateBulk{err: fmt.Errorf("calling to EntitlementUsageCounterClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EntitlementUsageCounterCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EntitlementUsageCounterCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EntitlementUsageCounter.
+func (c *EntitlementUsageCounterClient) Update() *EntitlementUsageCounterUpdate {
+	mutation := newEntitlementUsageCounterMutation(c.config, OpUpdate)
+	return &EntitlementUsageCounterUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EntitlementUsageCounterClient) UpdateOne(euc *EntitlementUsageCounter) *EntitlementUsageCounterUpdateOne {
+	mutation := newEntitlementUsageCounterMutation(c.config, OpUpdateOne, withEntitlementUsageCounter(euc))
+	return &EntitlementUsageCounterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EntitlementUsageCounterClient) UpdateOneID(id int) *EntitlementUsageCounterUpdateOne {
+	mutation := newEntitlementUsageCounterMutation(c.config, OpUpdateOne, withEntitlementUsageCounterID(id))
+	return &EntitlementUsageCounterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EntitlementUsageCounter.
+func (c *EntitlementUsageCounterClient) Delete() *EntitlementUsageCounterDelete {
+	mutation := newEntitlementUsageCounterMutation(c.config, OpDelete)
+	return &EntitlementUsageCounterDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EntitlementUsageCounterClient) DeleteOne(euc *EntitlementUsageCounter) *EntitlementUsageCounterDeleteOne {
+	return c.DeleteOneID(euc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EntitlementUsageCounterClient) DeleteOneID(id int) *EntitlementUsageCounterDeleteOne {
+	builder := c.Delete().Where(entitlementusagecounter.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EntitlementUsageCounterDeleteOne{builder}
+}
+
+// Query returns a query builder for EntitlementUsageCounter.
+func (c *EntitlementUsageCounterClient) Query() *EntitlementUsageCounterQuery {
+	return &EntitlementUsageCounterQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEntitlementUsageCounter},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EntitlementUsageCounter entity by its id.
+func (c *EntitlementUsageCounterClient) Get(ctx context.Context, id int) (*EntitlementUsageCounter, error) {
+	return c.Query().Where(entitlementusagecounter.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EntitlementUsageCounterClient) GetX(ctx context.Context, id int) *EntitlementUsageCounter {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *EntitlementUsageCounterClient) Hooks() []Hook {
+	return c.hooks.EntitlementUsageCounter
+}
+
+// Interceptors returns the client interceptors.
+func (c *EntitlementUsageCounterClient) Interceptors() []Interceptor {
+	return c.inters.EntitlementUsageCounter
+}
+
+func (c *EntitlementUsageCounterClient) mutate(ctx context.Context, m *EntitlementUsageCounterMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EntitlementUsageCounterCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EntitlementUsageCounterUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EntitlementUsageCounterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EntitlementUsageCounterDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EntitlementUsageCounter mutation op: %q", m.Op())
+	}
+}
+
 // EntityIntegrationMappingClient is a client for the EntityIntegrationMapping schema.
 type EntityIntegrationMappingClient struct {
 	config
@@ -3243,6 +3444,272 @@ func (c *EnvironmentClient) mutate(ctx context.Context, m *EnvironmentMutation)
 	}
 }
 
+// EventCorrectionClaimClient is a client for the EventCorrectionClaim schema.
+type EventCorrectionClaimClient struct {
+	config
+}
+
+// NewEventCorrectionClaimClient returns a client for the EventCorrectionClaim from the given config.
+func NewEventCorrectionClaimClient(c config) *EventCorrectionClaimClient {
+	return &EventCorrectionClaimClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `eventcorrectionclaim.Hooks(f(g(h())))`.
+func (c *EventCorrectionClaimClient) Use(hooks ...Hook) {
+	c.hooks.EventCorrectionClaim = append(c.hooks.EventCorrectionClaim, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `eventcorrectionclaim.Intercept(f(g(h())))`.
+func (c *EventCorrectionClaimClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EventCorrectionClaim = append(c.inters.EventCorrectionClaim, interceptors...)
+}
+
+// Create returns a builder for creating a EventCorrectionClaim entity.
+func (c *EventCorrectionClaimClient) Create() *EventCorrectionClaimCreate {
+	mutation := newEventCorrectionClaimMutation(c.config, OpCreate)
+	return &EventCorrectionClaimCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EventCorrectionClaim entities.
+func (c *EventCorrectionClaimClient) CreateBulk(builders ...*EventCorrectionClaimCreate) *EventCorrectionClaimCreateBulk {
+	return &EventCorrectionClaimCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EventCorrectionClaimClient) MapCreateBulk(slice any, setFunc func(*EventCorrectionClaimCreate, int)) *EventCorrectionClaimCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EventCorrectionClaimCreateBulk{err: fmt.Errorf("calling to EventCorrectionClaimClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EventCorrectionClaimCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EventCorrectionClaimCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EventCorrectionClaim.
+func (c *EventCorrectionClaimClient) Update() *EventCorrectionClaimUpdate {
+	mutation := newEventCorrectionClaimMutation(c.config, OpUpdate)
+	return &EventCorrectionClaimUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EventCorrectionClaimClient) UpdateOne(ecc *EventCorrectionClaim) *EventCorrectionClaimUpdateOne {
+	mutation := newEventCorrectionClaimMutation(c.config, OpUpdateOne, withEventCorrectionClaim(ecc))
+	return &EventCorrectionClaimUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EventCorrectionClaimClient) UpdateOneID(id string) *EventCorrectionClaimUpdateOne {
+	mutation := newEventCorrectionClaimMutation(c.config, OpUpdateOne, withEventCorrectionClaimID(id))
+	return &EventCorrectionClaimUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EventCorrectionClaim.
+func (c *EventCorrectionClaimClient) Delete() *EventCorrectionClaimDelete {
+	mutation := newEventCorrectionClaimMutation(c.config, OpDelete)
+	return &EventCorrectionClaimDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EventCorrectionClaimClient) DeleteOne(ecc *EventCorrectionClaim) *EventCorrectionClaimDeleteOne {
+	return c.DeleteOneID(ecc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EventCorrectionClaimClient) DeleteOneID(id string) *EventCorrectionClaimDeleteOne {
+	builder := c.Delete().Where(eventcorrectionclaim.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EventCorrectionClaimDeleteOne{builder}
+}
+
+// Query returns a query builder for EventCorrectionClaim.
+func (c *EventCorrectionClaimClient) Query() *EventCorrectionClaimQuery {
+	return &EventCorrectionClaimQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEventCorrectionClaim},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EventCorrectionClaim entity by its id.
+func (c *EventCorrectionClaimClient) Get(ctx context.Context, id string) (*EventCorrectionClaim, error) {
+	return c.Query().Where(eventcorrectionclaim.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EventCorrectionClaimClient) GetX(ctx context.Context, id string) *EventCorrectionClaim {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *EventCorrectionClaimClient) Hooks() []Hook {
+	return c.hooks.EventCorrectionClaim
+}
+
+// Interceptors returns the client interceptors.
+func (c *EventCorrectionClaimClient) Interceptors() []Interceptor {
+	return c.inters.EventCorrectionClaim
+}
+
+func (c *EventCorrectionClaimClient) mutate(ctx context.Context, m *EventCorrectionClaimMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EventCorrectionClaimCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EventCorrectionClaimUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EventCorrectionClaimUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EventCorrectionClaimDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EventCorrectionClaim mutation op: %q", m.Op())
+	}
+}
+
+// EventSchemaClient is a client for the EventSchema schema.
+type EventSchemaClient struct {
+	config
+}
+
+// NewEventSchemaClient returns a client for the EventSchema from the given config.
+func NewEventSchemaClient(c config) *EventSchemaClient {
+	return &EventSchemaClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `eventschema.Hooks(f(g(h())))`.
+func (c *EventSchemaClient) Use(hooks ...Hook) {
+	c.hooks.EventSchema = append(c.hooks.EventSchema, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `eventschema.Intercept(f(g(h())))`.
+func (c *EventSchemaClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EventSchema = append(c.inters.EventSchema, interceptors...)
+}
+
+// Create returns a builder for creating a EventSchema entity.
+func (c *EventSchemaClient) Create() *EventSchemaCreate {
+	mutation := newEventSchemaMutation(c.config, OpCreate)
+	return &EventSchemaCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EventSchema entities.
+func (c *EventSchemaClient) CreateBulk(builders ...*EventSchemaCreate) *EventSchemaCreateBulk {
+	return &EventSchemaCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EventSchemaClient) MapCreateBulk(slice any, setFunc func(*EventSchemaCreate, int)) *EventSchemaCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EventSchemaCreateBulk{err: fmt.Errorf("calling to EventSchemaClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EventSchemaCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EventSchemaCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EventSchema.
+func (c *EventSchemaClient) Update() *EventSchemaUpdate {
+	mutation := newEventSchemaMutation(c.config, OpUpdate)
+	return &EventSchemaUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EventSchemaClient) UpdateOne(es *EventSchema) *EventSchemaUpdateOne {
+	mutation := newEventSchemaMutation(c.config, OpUpdateOne, withEventSchema(es))
+	return &EventSchemaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EventSchemaClient) UpdateOneID(id string) *EventSchemaUpdateOne {
+	mutation := newEventSchemaMutation(c.config, OpUpdateOne, withEventSchemaID(id))
+	return &EventSchemaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EventSchema.
+func (c *EventSchemaClient) Delete() *EventSchemaDelete {
+	mutation := newEventSchemaMutation(c.config, OpDelete)
+	return &EventSchemaDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EventSchemaClient) DeleteOne(es *EventSchema) *EventSchemaDeleteOne {
+	return c.DeleteOneID(es.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EventSchemaClient) DeleteOneID(id string) *EventSchemaDeleteOne {
+	builder := c.Delete().Where(eventschema.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EventSchemaDeleteOne{builder}
+}
+
+// Query returns a query builder for EventSchema.
+func (c *EventSchemaClient) Query() *EventSchemaQuery {
+	return &EventSchemaQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEventSchema},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EventSchema entity by its id.
+func (c *EventSchemaClient) Get(ctx context.Context, id string) (*EventSchema, error) {
+	return c.Query().Where(eventschema.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EventSchemaClient) GetX(ctx context.Context, id string) *EventSchema {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *EventSchemaClient) Hooks() []Hook {
+	return c.hooks.EventSchema
+}
+
+// Interceptors returns the client interceptors.
+func (c *EventSchemaClient) Interceptors() []Interceptor {
+	return c.inters.EventSchema
+}
+
+func (c *EventSchemaClient) mutate(ctx context.Context, m *EventSchemaMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EventSchemaCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EventSchemaUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EventSchemaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EventSchemaDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EventSchema mutation op: %q", m.Op())
+	}
+}
+
 // FeatureClient is a client for the Feature schema.
 type FeatureClient struct {
 	config
@@ -4121,6 +4588,139 @@ func (c *MeterClient) mutate(ctx context.Context, m *MeterMutation) (Value, erro
 	}
 }
 
+// MetricSeriesStateClient is a client for the MetricSeriesState schema.
+type MetricSeriesStateClient struct {
+	config
+}
+
+// NewMetricSeriesStateClient returns a client for the MetricSeriesState from the given config.
+func NewMetricSeriesStateClient(c config) *MetricSeriesStateClient {
+	return &MetricSeriesStateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `metricseriesstate.Hooks(f(g(h())))`.
+func (c *MetricSeriesStateClient) Use(hooks ...Hook) {
+	c.hooks.MetricSeriesState = append(c.hooks.MetricSeriesState, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `metricseriesstate.Intercept(f(g(h())))`.
+func (c *MetricSeriesStateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MetricSeriesState = append(c.inters.MetricSeriesState, interceptors...)
+}
+
+// Create returns a builder for creating a MetricSeriesState entity.
+func (c *MetricSeriesStateClient) Create() *MetricSeriesStateCreate {
+	mutation := newMetricSeriesStateMutation(c.config, OpCreate)
+	return &MetricSeriesStateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MetricSeriesState entities.
+func (c *MetricSeriesStateClient) CreateBulk(builders ...*MetricSeriesStateCreate) *MetricSeriesStateCreateBulk {
+	return &MetricSeriesStateCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MetricSeriesStateClient) MapCreateBulk(slice any, setFunc func(*MetricSeriesStateCreate, int)) *MetricSeriesStateCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MetricSeriesStateCreateBulk{err: fmt.Errorf("calling to MetricSeriesStateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MetricSeriesStateCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MetricSeriesStateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MetricSeriesState.
+func (c *MetricSeriesStateClient) Update() *MetricSeriesStateUpdate {
+	mutation := newMetricSeriesStateMutation(c.config, OpUpdate)
+	return &MetricSeriesStateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MetricSeriesStateClient) UpdateOne(mss *MetricSeriesState) *MetricSeriesStateUpdateOne {
+	mutation := newMetricSeriesStateMutation(c.config, OpUpdateOne, withMetricSeriesState(mss))
+	return &MetricSeriesStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MetricSeriesStateClient) UpdateOneID(id int) *MetricSeriesStateUpdateOne {
+	mutation := newMetricSeriesStateMutation(c.config, OpUpdateOne, withMetricSeriesStateID(id))
+	return &MetricSeriesStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MetricSeriesState.
+func (c *MetricSeriesStateClient) Delete() *MetricSeriesStateDelete {
+	mutation := newMetricSeriesStateMutation(c.config, OpDelete)
+	return &MetricSeriesStateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MetricSeriesStateClient) DeleteOne(mss *MetricSeriesState) *MetricSeriesStateDeleteOne {
+	return c.DeleteOneID(mss.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MetricSeriesStateClient) DeleteOneID(id int) *MetricSeriesStateDeleteOne {
+	builder := c.Delete().Where(metricseriesstate.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MetricSeriesStateDeleteOne{builder}
+}
+
+// Query returns a query builder for MetricSeriesState.
+func (c *MetricSeriesStateClient) Query() *MetricSeriesStateQuery {
+	return &MetricSeriesStateQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMetricSeriesState},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MetricSeriesState entity by its id.
+func (c *MetricSeriesStateClient) Get(ctx context.Context, id int) (*MetricSeriesState, error) {
+	return c.Query().Where(metricseriesstate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MetricSeriesStateClient) GetX(ctx context.Context, id int) *MetricSeriesState {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *MetricSeriesStateClient) Hooks() []Hook {
+	return c.hooks.MetricSeriesState
+}
+
+// Interceptors returns the client interceptors.
+func (c *MetricSeriesStateClient) Interceptors() []Interceptor {
+	return c.inters.MetricSeriesState
+}
+
+func (c *MetricSeriesStateClient) mutate(ctx context.Context, m *MetricSeriesStateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MetricSeriesStateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MetricSeriesStateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MetricSeriesStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MetricSeriesStateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MetricSeriesState mutation op: %q", m.Op())
+	}
+}
+
 // PaymentClient is a client for the Payment schema.
 type PaymentClient struct {
 	config
@@ -4245,6 +4845,22 @@ func (c *PaymentClient) QueryAttempts(pa *Payment) *PaymentAttemptQuery {
 	return query
 }
 
+// QueryRefunds queries the refunds edge of a Payment.
+func (c *PaymentClient) QueryRefunds(pa *Payment) *PaymentRefundQuery {
+	query := (&PaymentRefundClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pa.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(payment.Table, payment.FieldID, id),
+			sqlgraph.To(paymentrefund.Table, paymentrefund.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, payment.RefundsTable, payment.RefundsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pa.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *PaymentClient) Hooks() []Hook {
 	return c.hooks.Payment
@@ -4419,6 +5035,155 @@ func (c *PaymentAttemptClient) mutate(ctx context.Context, m *PaymentAttemptMuta
 	}
 }
 
+// PaymentRefundClient is a client for the PaymentRefund schema.
+type PaymentRefundClient struct {
+	config
+}
+
+// NewPaymentRefundClient returns a client for the PaymentRefund from the given config.
+func NewPaymentRefundClient(c config) *PaymentRefundClient {
+	return &PaymentRefundClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `paymentrefund.Hooks(f(g(h())))`.
+func (c *PaymentRefundClient) Use(hooks ...Hook) {
+	c.hooks.PaymentRefund = append(c.hooks.PaymentRefund, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `paymentrefund.Intercept(f(g(h())))`.
+func (c *PaymentRefundClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PaymentRefund = append(c.inters.PaymentRefund, interceptors...)
+}
+
+// Create returns a builder for creating a PaymentRefund entity.
+func (c *PaymentRefundClient) Create() *PaymentRefundCreate {
+	mutation := newPaymentRefundMutation(c.config, OpCreate)
+	return &PaymentRefundCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PaymentRefund entities.
+func (c *PaymentRefundClient) CreateBulk(builders ...*PaymentRefundCreate) *PaymentRefundCreateBulk {
+	return &PaymentRefundCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PaymentRefundClient) MapCreateBulk(slice any, setFunc func(*PaymentRefundCreate, int)) *PaymentRefundCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PaymentRefundCreateBulk{err: fmt.Errorf("calling to PaymentRefundClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PaymentRefundCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PaymentRefundCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PaymentRefund.
+func (c *PaymentRefundClient) Update() *PaymentRefundUpdate {
+	mutation := newPaymentRefundMutation(c.config, OpUpdate)
+	return &PaymentRefundUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PaymentRefundClient) UpdateOne(pr *PaymentRefund) *PaymentRefundUpdateOne {
+	mutation := newPaymentRefundMutation(c.config, OpUpdateOne, withPaymentRefund(pr))
+	return &PaymentRefundUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PaymentRefundClient) UpdateOneID(id string) *PaymentRefundUpdateOne {
+	mutation := newPaymentRefundMutation(c.config, OpUpdateOne, withPaymentRefundID(id))
+	return &PaymentRefundUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PaymentRefund.
+func (c *PaymentRefundClient) Delete() *PaymentRefundDelete {
+	mutation := newPaymentRefundMutation(c.config, OpDelete)
+	return &PaymentRefundDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PaymentRefundClient) DeleteOne(pr *PaymentRefund) *PaymentRefundDeleteOne {
+	return c.DeleteOneID(pr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PaymentRefundClient) DeleteOneID(id string) *PaymentRefundDeleteOne {
+	builder := c.Delete().Where(paymentrefund.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PaymentRefundDeleteOne{builder}
+}
+
+// Query returns a query builder for PaymentRefund.
+func (c *PaymentRefundClient) Query() *PaymentRefundQuery {
+	return &PaymentRefundQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePaymentRefund},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PaymentRefund entity by its id.
+func (c *PaymentRefundClient) Get(ctx context.Context, id string) (*PaymentRefund, error) {
+	return c.Query().Where(paymentrefund.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PaymentRefundClient) GetX(ctx context.Context, id string) *PaymentRefund {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPayment queries the payment edge of a PaymentRefund.
+func (c *PaymentRefundClient) QueryPayment(pr *PaymentRefund) *PaymentQuery {
+	query := (&PaymentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentrefund.Table, paymentrefund.FieldID, id),
+			sqlgraph.To(payment.Table, payment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, paymentrefund.PaymentTable, paymentrefund.PaymentColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PaymentRefundClient) Hooks() []Hook {
+	return c.hooks.PaymentRefund
+}
+
+// Interceptors returns the client interceptors.
+func (c *PaymentRefundClient) Interceptors() []Interceptor {
+	return c.inters.PaymentRefund
+}
+
+func (c *PaymentRefundClient) mutate(ctx context.Context, m *PaymentRefundMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PaymentRefundCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PaymentRefundUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PaymentRefundUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PaymentRefundDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PaymentRefund mutation op: %q", m.Op())
+	}
+}
+
 // PlanClient is a client for the Plan schema.
 type PlanClient struct {
 	config
@@ -4882,6 +5647,155 @@ func (c *PriceUnitClient) mutate(ctx context.Context, m *PriceUnitMutation) (Val
 	}
 }
 
+// PromotionCodeClient is a client for the PromotionCode schema.
+type PromotionCodeClient struct {
+	config
+}
+
+// NewPromotionCodeClient returns a client for the PromotionCode from the given config.
+func NewPromotionCodeClient(c config) *PromotionCodeClient {
+	return &PromotionCodeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `promotioncode.Hooks(f(g(h())))`.
+func (c *PromotionCodeClient) Use(hooks ...Hook) {
+	c.hooks.PromotionCode = append(c.hooks.PromotionCode, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `promotioncode.Intercept(f(g(h())))`.
+func (c *PromotionCodeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PromotionCode = append(c.inters.PromotionCode, interceptors...)
+}
+
+// Create returns a builder for creating a PromotionCode entity.
+func (c *PromotionCodeClient) Create() *PromotionCodeCreate {
+	mutation := newPromotionCodeMutation(c.config, OpCreate)
+	return &PromotionCodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PromotionCode entities.
+func (c *PromotionCodeClient) CreateBulk(builders ...*PromotionCodeCreate) *PromotionCodeCreateBulk {
+	return &PromotionCodeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PromotionCodeClient) MapCreateBulk(slice any, setFunc func(*PromotionCodeCreate, int)) *PromotionCodeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PromotionCodeCreateBulk{err: fmt.Errorf("calling to PromotionCodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PromotionCodeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PromotionCodeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PromotionCode.
+func (c *PromotionCodeClient) Update() *PromotionCodeUpdate {
+	mutation := newPromotionCodeMutation(c.config, OpUpdate)
+	return &PromotionCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PromotionCodeClient) UpdateOne(pc *PromotionCode) *PromotionCodeUpdateOne {
+	mutation := newPromotionCodeMutation(c.config, OpUpdateOne, withPromotionCode(pc))
+	return &PromotionCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PromotionCodeClient) UpdateOneID(id string) *PromotionCodeUpdateOne {
+	mutation := newPromotionCodeMutation(c.config, OpUpdateOne, withPromotionCodeID(id))
+	return &PromotionCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PromotionCode.
+func (c *PromotionCodeClient) Delete() *PromotionCodeDelete {
+	mutation := newPromotionCodeMutation(c.config, OpDelete)
+	return &PromotionCodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PromotionCodeClient) DeleteOne(pc *PromotionCode) *PromotionCodeDeleteOne {
+	return c.DeleteOneID(pc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PromotionCodeClient) DeleteOneID(id string) *PromotionCodeDeleteOne {
+	builder := c.Delete().Where(promotioncode.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PromotionCodeDeleteOne{builder}
+}
+
+// Query returns a query builder for PromotionCode.
+func (c *PromotionCodeClient) Query() *PromotionCodeQuery {
+	return &PromotionCodeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePromotionCode},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PromotionCode entity by its id.
+func (c *PromotionCodeClient) Get(ctx context.Context, id string) (*PromotionCode, error) {
+	return c.Query().Where(promotioncode.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PromotionCodeClient) GetX(ctx context.Context, id string) *PromotionCode {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCoupon queries the coupon edge of a PromotionCode.
+func (c *PromotionCodeClient) QueryCoupon(pc *PromotionCode) *CouponQuery {
+	query := (&CouponClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(promotioncode.Table, promotioncode.FieldID, id),
+			sqlgraph.To(coupon.Table, coupon.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, promotioncode.CouponTable, promotioncode.CouponColumn),
+		)
+		fromV = sqlgraph.Neighbors(pc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PromotionCodeClient) Hooks() []Hook {
+	return c.hooks.PromotionCode
+}
+
+// Interceptors returns the client interceptors.
+func (c *PromotionCodeClient) Interceptors() []Interceptor {
+	return c.inters.PromotionCode
+}
+
+func (c *PromotionCodeClient) mutate(ctx context.Context, m *PromotionCodeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PromotionCodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PromotionCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PromotionCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PromotionCodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PromotionCode mutation op: %q", m.Op())
+	}
+}
+
 // ScheduledTaskClient is a client for the ScheduledTask schema.
 type ScheduledTaskClient struct {
 	config
@@ -7208,22 +8122,25 @@ type (
 		Addon, AddonAssociation, AlertLogs, Auth, BillingSequence, Connection,
 		Costsheet, Coupon, CouponApplication, CouponAssociation, CreditGrant,
 		CreditGrantApplication, CreditNote, CreditNoteLineItem, Customer, Entitlement,
-		EntityIntegrationMapping, Environment, Feature, Group, Invoice,
-		InvoiceLineItem, InvoiceSequence, Meter, Payment, PaymentAttempt, Plan, Price,
-		PriceUnit, ScheduledTask, Secret, Settings, Subscription, SubscriptionLineItem,
-		SubscriptionPause, SubscriptionSchedule, SubscriptionSchedulePhase, Task,
-		TaxApplied, TaxAssociation, TaxRate, Tenant, User, Wallet,
-		WalletTransaction []ent.Hook
+		EntitlementUsageCounter, EntityIntegrationMapping, Environment,
+		EventCorrectionClaim, EventSchema, Feature, Group, Invoice, InvoiceLineItem,
+		InvoiceSequence, Meter, MetricSeriesState, Payment, PaymentAttempt,
+		PaymentRefund, Plan, Price, PriceUnit, PromotionCode, ScheduledTask, Secret,
+		Settings, Subscription, SubscriptionLineItem, SubscriptionPause,
+		SubscriptionSchedule, SubscriptionSchedulePhase, Task, TaxApplied,
+		TaxAssociation, TaxRate, Tenant, User, Wallet, WalletTransaction []ent.Hook
 	}
 	inters struct {
 		Addon, AddonAssociation, AlertLogs, Auth, BillingSequence, Connection,
 		Costsheet, Coupon, CouponApplication, CouponAssociation, CreditGrant,
 		CreditGrantApplication, CreditNote, CreditNoteLineItem, Customer, Entitlement,
-		EntityIntegrationMapping, Environment, Feature, Group, Invoice,
-		InvoiceLineItem, InvoiceSequence, Meter, Payment, PaymentAttempt, Plan, Price,
-		PriceUnit, ScheduledTask, Secret, Settings, Subscription, SubscriptionLineItem,
-		SubscriptionPause, SubscriptionSchedule, SubscriptionSchedulePhase, Task,
-		TaxApplied, TaxAssociation, TaxRate, Tenant, User, Wallet,
+		EntitlementUsageCounter, EntityIntegrationMapping, Environment,
+		EventCorrectionClaim, EventSchema, Feature, Group, Invoice, InvoiceLineItem,
+		InvoiceSequence, Meter, MetricSeriesState, Payment, PaymentAttempt,
+		PaymentRefund, Plan, Price, PriceUnit, PromotionCode, ScheduledTask, Secret,
+		Settings, Subscription, SubscriptionLineItem, SubscriptionPause,
+		SubscriptionSchedule, SubscriptionSchedulePhase, Task, TaxApplied,
+		TaxAssociation, TaxRate, Tenant, User, Wallet,
 		WalletTransaction []ent.Interceptor
 	}
 )
@@ -71,9 +71,11 @@ type CouponEdges struct {
 	CouponAssociations []*CouponAssociation `json:"coupon_associations,omitempty"`
 	// Coupon can have multiple coupon applications
 	CouponApplications []*CouponApplication `json:"coupon_applications,omitempty"`
+	// Coupon can be redeemed with multiple promotion codes
+	PromotionCodes []*PromotionCode `json:"promotion_codes,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // CouponAssociationsOrErr returns the CouponAssociations value or an error if the edge
@@ -94,6 +96,15 @@ func (e CouponEdges) CouponApplicationsOrErr() ([]*CouponApplication, error) {
 	return nil, &NotLoadedError{edge: "coupon_applications"}
 }
 
+// PromotionCodesOrErr returns the PromotionCodes value or an error if the edge
+// was not loaded in eager-loading.
+func (e CouponEdges) PromotionCodesOrErr() ([]*PromotionCode, error) {
+	if e.loadedTypes[2] {
+		return e.PromotionCodes, nil
+	}
+	return nil, &NotLoadedError{edge: "promotion_codes"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Coupon) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -282,6 +293,11 @@ func (c *Coupon) QueryCouponApplications() *CouponApplicationQuery {
 	return NewCouponClient(c.config).QueryCouponApplications(c)
 }
 
+// QueryPromotionCodes queries the "promotion_codes" edge of the Coupon entity.
+func (c *Coupon) QueryPromotionCodes() *PromotionCodeQuery {
+	return NewCouponClient(c.config).QueryPromotionCodes(c)
+}
+
 // Update returns a builder for updating this Coupon.
 // Note that you need to call Coupon.Unwrap() before calling this method if this Coupon
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -59,6 +59,8 @@ const (
 	EdgeCouponAssociations = "coupon_associations"
 	// EdgeCouponApplications holds the string denoting the coupon_applications edge name in mutations.
 	EdgeCouponApplications = "coupon_applications"
+	// EdgePromotionCodes holds the string denoting the promotion_codes edge name in mutations.
+	EdgePromotionCodes = "promotion_codes"
 	// Table holds the table name of the coupon in the database.
 	Table = "coupons"
 	// CouponAssociationsTable is the table that holds the coupon_associations relation/edge.
@@ -75,6 +77,13 @@ const (
 	CouponApplicationsInverseTable = "coupon_applications"
 	// CouponApplicationsColumn is the table column denoting the coupon_applications relation/edge.
 	CouponApplicationsColumn = "coupon_id"
+	// PromotionCodesTable is the table that holds the promotion_codes relation/edge.
+	PromotionCodesTable = "promotion_codes"
+	// PromotionCodesInverseTable is the table name for the PromotionCode entity.
+	// It exists in this package in order to avoid circular dependency with the "promotioncode" package.
+	PromotionCodesInverseTable = "promotion_codes"
+	// PromotionCodesColumn is the table column denoting the promotion_codes relation/edge.
+	PromotionCodesColumn = "coupon_id"
 )
 
 // Columns holds all SQL columns for coupon fields.
@@ -268,6 +277,20 @@ func ByCouponApplications(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOptio
 		sqlgraph.OrderByNeighborTerms(s, newCouponApplicationsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByPromotionCodesCount orders the results by promotion_codes count.
+func ByPromotionCodesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPromotionCodesStep(), opts...)
+	}
+}
+
+// ByPromotionCodes orders the results by promotion_codes terms.
+func ByPromotionCodes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPromotionCodesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newCouponAssociationsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -282,3 +305,10 @@ func newCouponApplicationsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, CouponApplicationsTable, CouponApplicationsColumn),
 	)
 }
+func newPromotionCodesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PromotionCodesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PromotionCodesTable, PromotionCodesColumn),
+	)
+}
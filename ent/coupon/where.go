@@ -1267,6 +1267,29 @@ func HasCouponApplicationsWith(preds ...predicate.CouponApplication) predicate.C
 	})
 }
 
+// HasPromotionCodes applies the HasEdge predicate on the "promotion_codes" edge.
+func HasPromotionCodes() predicate.Coupon {
+	return predicate.Coupon(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PromotionCodesTable, PromotionCodesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPromotionCodesWith applies the HasEdge predicate on the "promotion_codes" edge with a given conditions (other predicates).
+func HasPromotionCodesWith(preds ...predicate.PromotionCode) predicate.Coupon {
+	return predicate.Coupon(func(s *sql.Selector) {
+		step := newPromotionCodesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Coupon) predicate.Coupon {
 	return predicate.Coupon(sql.AndPredicates(predicates...))
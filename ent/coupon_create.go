@@ -13,6 +13,7 @@ import (
 	"github.com/flexprice/flexprice/ent/coupon"
 	"github.com/flexprice/flexprice/ent/couponapplication"
 	"github.com/flexprice/flexprice/ent/couponassociation"
+	"github.com/flexprice/flexprice/ent/promotioncode"
 	"github.com/shopspring/decimal"
 )
 
@@ -307,6 +308,21 @@ func (cc *CouponCreate) AddCouponApplications(c ...*CouponApplication) *CouponCr
 	return cc.AddCouponApplicationIDs(ids...)
 }
 
+// AddPromotionCodeIDs adds the "promotion_codes" edge to the PromotionCode entity by IDs.
+func (cc *CouponCreate) AddPromotionCodeIDs(ids ...string) *CouponCreate {
+	cc.mutation.AddPromotionCodeIDs(ids...)
+	return cc
+}
+
+// AddPromotionCodes adds the "promotion_codes" edges to the PromotionCode entity.
+func (cc *CouponCreate) AddPromotionCodes(p ...*PromotionCode) *CouponCreate {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return cc.AddPromotionCodeIDs(ids...)
+}
+
 // Mutation returns the CouponMutation object of the builder.
 func (cc *CouponCreate) Mutation() *CouponMutation {
 	return cc.mutation
@@ -573,6 +589,22 @@ func (cc *CouponCreate) createSpec() (*Coupon, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := cc.mutation.PromotionCodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coupon.PromotionCodesTable,
+			Columns: []string{coupon.PromotionCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(promotioncode.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -16,6 +16,7 @@ import (
 	"github.com/flexprice/flexprice/ent/couponapplication"
 	"github.com/flexprice/flexprice/ent/couponassociation"
 	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/ent/promotioncode"
 )
 
 // CouponQuery is the builder for querying Coupon entities.
@@ -27,6 +28,7 @@ type CouponQuery struct {
 	predicates             []predicate.Coupon
 	withCouponAssociations *CouponAssociationQuery
 	withCouponApplications *CouponApplicationQuery
+	withPromotionCodes     *PromotionCodeQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -107,6 +109,28 @@ func (cq *CouponQuery) QueryCouponApplications() *CouponApplicationQuery {
 	return query
 }
 
+// QueryPromotionCodes chains the current query on the "promotion_codes" edge.
+func (cq *CouponQuery) QueryPromotionCodes() *PromotionCodeQuery {
+	query := (&PromotionCodeClient{config: cq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(coupon.Table, coupon.FieldID, selector),
+			sqlgraph.To(promotioncode.Table, promotioncode.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, coupon.PromotionCodesTable, coupon.PromotionCodesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Coupon entity from the query.
 // Returns a *NotFoundError when no Coupon was found.
 func (cq *CouponQuery) First(ctx context.Context) (*Coupon, error) {
@@ -301,6 +325,7 @@ func (cq *CouponQuery) Clone() *CouponQuery {
 		predicates:             append([]predicate.Coupon{}, cq.predicates...),
 		withCouponAssociations: cq.withCouponAssociations.Clone(),
 		withCouponApplications: cq.withCouponApplications.Clone(),
+		withPromotionCodes:     cq.withPromotionCodes.Clone(),
 		// clone intermediate query.
 		sql:  cq.sql.Clone(),
 		path: cq.path,
@@ -329,6 +354,17 @@ func (cq *CouponQuery) WithCouponApplications(opts ...func(*CouponApplicationQue
 	return cq
 }
 
+// WithPromotionCodes tells the query-builder to eager-load the nodes that are connected to
+// the "promotion_codes" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *CouponQuery) WithPromotionCodes(opts ...func(*PromotionCodeQuery)) *CouponQuery {
+	query := (&PromotionCodeClient{config: cq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withPromotionCodes = query
+	return cq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -407,9 +443,10 @@ func (cq *CouponQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Coupo
 	var (
 		nodes       = []*Coupon{}
 		_spec       = cq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			cq.withCouponAssociations != nil,
 			cq.withCouponApplications != nil,
+			cq.withPromotionCodes != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -448,6 +485,13 @@ func (cq *CouponQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Coupo
 			return nil, err
 		}
 	}
+	if query := cq.withPromotionCodes; query != nil {
+		if err := cq.loadPromotionCodes(ctx, query, nodes,
+			func(n *Coupon) { n.Edges.PromotionCodes = []*PromotionCode{} },
+			func(n *Coupon, e *PromotionCode) { n.Edges.PromotionCodes = append(n.Edges.PromotionCodes, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -511,6 +555,36 @@ func (cq *CouponQuery) loadCouponApplications(ctx context.Context, query *Coupon
 	}
 	return nil
 }
+func (cq *CouponQuery) loadPromotionCodes(ctx context.Context, query *PromotionCodeQuery, nodes []*Coupon, init func(*Coupon), assign func(*Coupon, *PromotionCode)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Coupon)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(promotioncode.FieldCouponID)
+	}
+	query.Where(predicate.PromotionCode(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(coupon.PromotionCodesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.CouponID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "coupon_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (cq *CouponQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := cq.querySpec()
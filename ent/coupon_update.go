@@ -15,6 +15,7 @@ import (
 	"github.com/flexprice/flexprice/ent/couponapplication"
 	"github.com/flexprice/flexprice/ent/couponassociation"
 	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/ent/promotioncode"
 	"github.com/shopspring/decimal"
 )
 
@@ -342,6 +343,21 @@ func (cu *CouponUpdate) AddCouponApplications(c ...*CouponApplication) *CouponUp
 	return cu.AddCouponApplicationIDs(ids...)
 }
 
+// AddPromotionCodeIDs adds the "promotion_codes" edge to the PromotionCode entity by IDs.
+func (cu *CouponUpdate) AddPromotionCodeIDs(ids ...string) *CouponUpdate {
+	cu.mutation.AddPromotionCodeIDs(ids...)
+	return cu
+}
+
+// AddPromotionCodes adds the "promotion_codes" edges to the PromotionCode entity.
+func (cu *CouponUpdate) AddPromotionCodes(p ...*PromotionCode) *CouponUpdate {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return cu.AddPromotionCodeIDs(ids...)
+}
+
 // Mutation returns the CouponMutation object of the builder.
 func (cu *CouponUpdate) Mutation() *CouponMutation {
 	return cu.mutation
@@ -389,6 +405,27 @@ func (cu *CouponUpdate) RemoveCouponApplications(c ...*CouponApplication) *Coupo
 	return cu.RemoveCouponApplicationIDs(ids...)
 }
 
+// ClearPromotionCodes clears all "promotion_codes" edges to the PromotionCode entity.
+func (cu *CouponUpdate) ClearPromotionCodes() *CouponUpdate {
+	cu.mutation.ClearPromotionCodes()
+	return cu
+}
+
+// RemovePromotionCodeIDs removes the "promotion_codes" edge to PromotionCode entities by IDs.
+func (cu *CouponUpdate) RemovePromotionCodeIDs(ids ...string) *CouponUpdate {
+	cu.mutation.RemovePromotionCodeIDs(ids...)
+	return cu
+}
+
+// RemovePromotionCodes removes "promotion_codes" edges to PromotionCode entities.
+func (cu *CouponUpdate) RemovePromotionCodes(p ...*PromotionCode) *CouponUpdate {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return cu.RemovePromotionCodeIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (cu *CouponUpdate) Save(ctx context.Context) (int, error) {
 	cu.defaults()
@@ -640,6 +677,51 @@ func (cu *CouponUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if cu.mutation.PromotionCodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coupon.PromotionCodesTable,
+			Columns: []string{coupon.PromotionCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(promotioncode.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedPromotionCodesIDs(); len(nodes) > 0 && !cu.mutation.PromotionCodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coupon.PromotionCodesTable,
+			Columns: []string{coupon.PromotionCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(promotioncode.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.PromotionCodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coupon.PromotionCodesTable,
+			Columns: []string{coupon.PromotionCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(promotioncode.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{coupon.Label}
@@ -971,6 +1053,21 @@ func (cuo *CouponUpdateOne) AddCouponApplications(c ...*CouponApplication) *Coup
 	return cuo.AddCouponApplicationIDs(ids...)
 }
 
+// AddPromotionCodeIDs adds the "promotion_codes" edge to the PromotionCode entity by IDs.
+func (cuo *CouponUpdateOne) AddPromotionCodeIDs(ids ...string) *CouponUpdateOne {
+	cuo.mutation.AddPromotionCodeIDs(ids...)
+	return cuo
+}
+
+// AddPromotionCodes adds the "promotion_codes" edges to the PromotionCode entity.
+func (cuo *CouponUpdateOne) AddPromotionCodes(p ...*PromotionCode) *CouponUpdateOne {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return cuo.AddPromotionCodeIDs(ids...)
+}
+
 // Mutation returns the CouponMutation object of the builder.
 func (cuo *CouponUpdateOne) Mutation() *CouponMutation {
 	return cuo.mutation
@@ -1018,6 +1115,27 @@ func (cuo *CouponUpdateOne) RemoveCouponApplications(c ...*CouponApplication) *C
 	return cuo.RemoveCouponApplicationIDs(ids...)
 }
 
+// ClearPromotionCodes clears all "promotion_codes" edges to the PromotionCode entity.
+func (cuo *CouponUpdateOne) ClearPromotionCodes() *CouponUpdateOne {
+	cuo.mutation.ClearPromotionCodes()
+	return cuo
+}
+
+// RemovePromotionCodeIDs removes the "promotion_codes" edge to PromotionCode entities by IDs.
+func (cuo *CouponUpdateOne) RemovePromotionCodeIDs(ids ...string) *CouponUpdateOne {
+	cuo.mutation.RemovePromotionCodeIDs(ids...)
+	return cuo
+}
+
+// RemovePromotionCodes removes "promotion_codes" edges to PromotionCode entities.
+func (cuo *CouponUpdateOne) RemovePromotionCodes(p ...*PromotionCode) *CouponUpdateOne {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return cuo.RemovePromotionCodeIDs(ids...)
+}
+
 // Where appends a list predicates to the CouponUpdate builder.
 func (cuo *CouponUpdateOne) Where(ps ...predicate.Coupon) *CouponUpdateOne {
 	cuo.mutation.Where(ps...)
@@ -1299,6 +1417,51 @@ func (cuo *CouponUpdateOne) sqlSave(ctx context.Context) (_node *Coupon, err err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if cuo.mutation.PromotionCodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coupon.PromotionCodesTable,
+			Columns: []string{coupon.PromotionCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(promotioncode.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedPromotionCodesIDs(); len(nodes) > 0 && !cuo.mutation.PromotionCodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coupon.PromotionCodesTable,
+			Columns: []string{coupon.PromotionCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(promotioncode.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.PromotionCodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coupon.PromotionCodesTable,
+			Columns: []string{coupon.PromotionCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(promotioncode.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Coupon{config: cuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -48,6 +48,8 @@ type CreditNote struct {
 	CreditNoteType types.CreditNoteType `json:"credit_note_type,omitempty"`
 	// RefundStatus holds the value of the "refund_status" field.
 	RefundStatus *types.PaymentStatus `json:"refund_status,omitempty"`
+	// RefundMethod holds the value of the "refund_method" field.
+	RefundMethod types.CreditNoteRefundMethod `json:"refund_method,omitempty"`
 	// Reason holds the value of the "reason" field.
 	Reason types.CreditNoteReason `json:"reason,omitempty"`
 	// Memo holds the value of the "memo" field.
@@ -97,7 +99,7 @@ func (*CreditNote) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case creditnote.FieldTotalAmount:
 			values[i] = new(decimal.Decimal)
-		case creditnote.FieldID, creditnote.FieldTenantID, creditnote.FieldStatus, creditnote.FieldCreatedBy, creditnote.FieldUpdatedBy, creditnote.FieldEnvironmentID, creditnote.FieldInvoiceID, creditnote.FieldCustomerID, creditnote.FieldSubscriptionID, creditnote.FieldCreditNoteNumber, creditnote.FieldCreditNoteStatus, creditnote.FieldCreditNoteType, creditnote.FieldRefundStatus, creditnote.FieldReason, creditnote.FieldMemo, creditnote.FieldCurrency, creditnote.FieldIdempotencyKey:
+		case creditnote.FieldID, creditnote.FieldTenantID, creditnote.FieldStatus, creditnote.FieldCreatedBy, creditnote.FieldUpdatedBy, creditnote.FieldEnvironmentID, creditnote.FieldInvoiceID, creditnote.FieldCustomerID, creditnote.FieldSubscriptionID, creditnote.FieldCreditNoteNumber, creditnote.FieldCreditNoteStatus, creditnote.FieldCreditNoteType, creditnote.FieldRefundStatus, creditnote.FieldRefundMethod, creditnote.FieldReason, creditnote.FieldMemo, creditnote.FieldCurrency, creditnote.FieldIdempotencyKey:
 			values[i] = new(sql.NullString)
 		case creditnote.FieldCreatedAt, creditnote.FieldUpdatedAt, creditnote.FieldVoidedAt, creditnote.FieldFinalizedAt:
 			values[i] = new(sql.NullTime)
@@ -208,6 +210,12 @@ func (cn *CreditNote) assignValues(columns []string, values []any) error {
 				cn.RefundStatus = new(types.PaymentStatus)
 				*cn.RefundStatus = types.PaymentStatus(value.String)
 			}
+		case creditnote.FieldRefundMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field refund_method", values[i])
+			} else if value.Valid {
+				cn.RefundMethod = types.CreditNoteRefundMethod(value.String)
+			}
 		case creditnote.FieldReason:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field reason", values[i])
@@ -348,6 +356,9 @@ func (cn *CreditNote) String() string {
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
+	builder.WriteString("refund_method=")
+	builder.WriteString(fmt.Sprintf("%v", cn.RefundMethod))
+	builder.WriteString(", ")
 	builder.WriteString("reason=")
 	builder.WriteString(fmt.Sprintf("%v", cn.Reason))
 	builder.WriteString(", ")
@@ -44,6 +44,8 @@ const (
 	FieldCreditNoteType = "credit_note_type"
 	// FieldRefundStatus holds the string denoting the refund_status field in the database.
 	FieldRefundStatus = "refund_status"
+	// FieldRefundMethod holds the string denoting the refund_method field in the database.
+	FieldRefundMethod = "refund_method"
 	// FieldReason holds the string denoting the reason field in the database.
 	FieldReason = "reason"
 	// FieldMemo holds the string denoting the memo field in the database.
@@ -90,6 +92,7 @@ var Columns = []string{
 	FieldCreditNoteStatus,
 	FieldCreditNoteType,
 	FieldRefundStatus,
+	FieldRefundMethod,
 	FieldReason,
 	FieldMemo,
 	FieldCurrency,
@@ -131,6 +134,8 @@ var (
 	DefaultCreditNoteStatus types.CreditNoteStatus
 	// CreditNoteTypeValidator is a validator for the "credit_note_type" field. It is called by the builders before save.
 	CreditNoteTypeValidator func(string) error
+	// DefaultRefundMethod holds the default value on creation for the "refund_method" field.
+	DefaultRefundMethod types.CreditNoteRefundMethod
 	// ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
 	ReasonValidator func(string) error
 	// DefaultTotalAmount holds the default value on creation for the "total_amount" field.
@@ -215,6 +220,11 @@ func ByRefundStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRefundStatus, opts...).ToFunc()
 }
 
+// ByRefundMethod orders the results by the refund_method field.
+func ByRefundMethod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRefundMethod, opts...).ToFunc()
+}
+
 // ByReason orders the results by the reason field.
 func ByReason(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldReason, opts...).ToFunc()
@@ -140,6 +140,12 @@ func RefundStatus(v types.PaymentStatus) predicate.CreditNote {
 	return predicate.CreditNote(sql.FieldEQ(FieldRefundStatus, vc))
 }
 
+// RefundMethod applies equality check predicate on the "refund_method" field. It's identical to RefundMethodEQ.
+func RefundMethod(v types.CreditNoteRefundMethod) predicate.CreditNote {
+	vc := string(v)
+	return predicate.CreditNote(sql.FieldEQ(FieldRefundMethod, vc))
+}
+
 // Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
 func Reason(v types.CreditNoteReason) predicate.CreditNote {
 	vc := string(v)
@@ -1143,6 +1149,90 @@ func RefundStatusContainsFold(v types.PaymentStatus) predicate.CreditNote {
 	return predicate.CreditNote(sql.FieldContainsFold(FieldRefundStatus, vc))
 }
 
+// RefundMethodEQ applies the EQ predicate on the "refund_method" field.
+func RefundMethodEQ(v types.CreditNoteRefundMethod) predicate.CreditNote {
+	vc := string(v)
+	return predicate.CreditNote(sql.FieldEQ(FieldRefundMethod, vc))
+}
+
+// RefundMethodNEQ applies the NEQ predicate on the "refund_method" field.
+func RefundMethodNEQ(v types.CreditNoteRefundMethod) predicate.CreditNote {
+	vc := string(v)
+	return predicate.CreditNote(sql.FieldNEQ(FieldRefundMethod, vc))
+}
+
+// RefundMethodIn applies the In predicate on the "refund_method" field.
+func RefundMethodIn(vs ...types.CreditNoteRefundMethod) predicate.CreditNote {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.CreditNote(sql.FieldIn(FieldRefundMethod, v...))
+}
+
+// RefundMethodNotIn applies the NotIn predicate on the "refund_method" field.
+func RefundMethodNotIn(vs ...types.CreditNoteRefundMethod) predicate.CreditNote {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.CreditNote(sql.FieldNotIn(FieldRefundMethod, v...))
+}
+
+// RefundMethodGT applies the GT predicate on the "refund_method" field.
+func RefundMethodGT(v types.CreditNoteRefundMethod) predicate.CreditNote {
+	vc := string(v)
+	return predicate.CreditNote(sql.FieldGT(FieldRefundMethod, vc))
+}
+
+// RefundMethodGTE applies the GTE predicate on the "refund_method" field.
+func RefundMethodGTE(v types.CreditNoteRefundMethod) predicate.CreditNote {
+	vc := string(v)
+	return predicate.CreditNote(sql.FieldGTE(FieldRefundMethod, vc))
+}
+
+// RefundMethodLT applies the LT predicate on the "refund_method" field.
+func RefundMethodLT(v types.CreditNoteRefundMethod) predicate.CreditNote {
+	vc := string(v)
+	return predicate.CreditNote(sql.FieldLT(FieldRefundMethod, vc))
+}
+
+// RefundMethodLTE applies the LTE predicate on the "refund_method" field.
+func RefundMethodLTE(v types.CreditNoteRefundMethod) predicate.CreditNote {
+	vc := string(v)
+	return predicate.CreditNote(sql.FieldLTE(FieldRefundMethod, vc))
+}
+
+// RefundMethodContains applies the Contains predicate on the "refund_method" field.
+func RefundMethodContains(v types.CreditNoteRefundMethod) predicate.CreditNote {
+	vc := string(v)
+	return predicate.CreditNote(sql.FieldContains(FieldRefundMethod, vc))
+}
+
+// RefundMethodHasPrefix applies the HasPrefix predicate on the "refund_method" field.
+func RefundMethodHasPrefix(v types.CreditNoteRefundMethod) predicate.CreditNote {
+	vc := string(v)
+	return predicate.CreditNote(sql.FieldHasPrefix(FieldRefundMethod, vc))
+}
+
+// RefundMethodHasSuffix applies the HasSuffix predicate on the "refund_method" field.
+func RefundMethodHasSuffix(v types.CreditNoteRefundMethod) predicate.CreditNote {
+	vc := string(v)
+	return predicate.CreditNote(sql.FieldHasSuffix(FieldRefundMethod, vc))
+}
+
+// RefundMethodEqualFold applies the EqualFold predicate on the "refund_method" field.
+func RefundMethodEqualFold(v types.CreditNoteRefundMethod) predicate.CreditNote {
+	vc := string(v)
+	return predicate.CreditNote(sql.FieldEqualFold(FieldRefundMethod, vc))
+}
+
+// RefundMethodContainsFold applies the ContainsFold predicate on the "refund_method" field.
+func RefundMethodContainsFold(v types.CreditNoteRefundMethod) predicate.CreditNote {
+	vc := string(v)
+	return predicate.CreditNote(sql.FieldContainsFold(FieldRefundMethod, vc))
+}
+
 // ReasonEQ applies the EQ predicate on the "reason" field.
 func ReasonEQ(v types.CreditNoteReason) predicate.CreditNote {
 	vc := string(v)
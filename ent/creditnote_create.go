@@ -179,6 +179,20 @@ func (cnc *CreditNoteCreate) SetNillableRefundStatus(ts *types.PaymentStatus) *C
 	return cnc
 }
 
+// SetRefundMethod sets the "refund_method" field.
+func (cnc *CreditNoteCreate) SetRefundMethod(tnrm types.CreditNoteRefundMethod) *CreditNoteCreate {
+	cnc.mutation.SetRefundMethod(tnrm)
+	return cnc
+}
+
+// SetNillableRefundMethod sets the "refund_method" field if the given value is not nil.
+func (cnc *CreditNoteCreate) SetNillableRefundMethod(tnrm *types.CreditNoteRefundMethod) *CreditNoteCreate {
+	if tnrm != nil {
+		cnc.SetRefundMethod(*tnrm)
+	}
+	return cnc
+}
+
 // SetReason sets the "reason" field.
 func (cnc *CreditNoteCreate) SetReason(tnr types.CreditNoteReason) *CreditNoteCreate {
 	cnc.mutation.SetReason(tnr)
@@ -335,6 +349,10 @@ func (cnc *CreditNoteCreate) defaults() {
 		v := creditnote.DefaultCreditNoteStatus
 		cnc.mutation.SetCreditNoteStatus(v)
 	}
+	if _, ok := cnc.mutation.RefundMethod(); !ok {
+		v := creditnote.DefaultRefundMethod
+		cnc.mutation.SetRefundMethod(v)
+	}
 	if _, ok := cnc.mutation.TotalAmount(); !ok {
 		v := creditnote.DefaultTotalAmount
 		cnc.mutation.SetTotalAmount(v)
@@ -400,6 +418,14 @@ func (cnc *CreditNoteCreate) check() error {
 			return &ValidationError{Name: "refund_status", err: fmt.Errorf(`ent: validator failed for field "CreditNote.refund_status": %w`, err)}
 		}
 	}
+	if _, ok := cnc.mutation.RefundMethod(); !ok {
+		return &ValidationError{Name: "refund_method", err: errors.New(`ent: missing required field "CreditNote.refund_method"`)}
+	}
+	if v, ok := cnc.mutation.RefundMethod(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "refund_method", err: fmt.Errorf(`ent: validator failed for field "CreditNote.refund_method": %w`, err)}
+		}
+	}
 	if _, ok := cnc.mutation.Reason(); !ok {
 		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "CreditNote.reason"`)}
 	}
@@ -508,6 +534,10 @@ func (cnc *CreditNoteCreate) createSpec() (*CreditNote, *sqlgraph.CreateSpec) {
 		_spec.SetField(creditnote.FieldRefundStatus, field.TypeString, value)
 		_node.RefundStatus = &value
 	}
+	if value, ok := cnc.mutation.RefundMethod(); ok {
+		_spec.SetField(creditnote.FieldRefundMethod, field.TypeString, value)
+		_node.RefundMethod = value
+	}
 	if value, ok := cnc.mutation.Reason(); ok {
 		_spec.SetField(creditnote.FieldReason, field.TypeString, value)
 		_node.Reason = value
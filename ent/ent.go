@@ -28,19 +28,25 @@ import (
 	"github.com/flexprice/flexprice/ent/creditnotelineitem"
 	"github.com/flexprice/flexprice/ent/customer"
 	"github.com/flexprice/flexprice/ent/entitlement"
+	"github.com/flexprice/flexprice/ent/entitlementusagecounter"
 	"github.com/flexprice/flexprice/ent/entityintegrationmapping"
 	"github.com/flexprice/flexprice/ent/environment"
+	"github.com/flexprice/flexprice/ent/eventcorrectionclaim"
+	"github.com/flexprice/flexprice/ent/eventschema"
 	"github.com/flexprice/flexprice/ent/feature"
 	"github.com/flexprice/flexprice/ent/group"
 	"github.com/flexprice/flexprice/ent/invoice"
 	"github.com/flexprice/flexprice/ent/invoicelineitem"
 	"github.com/flexprice/flexprice/ent/invoicesequence"
 	"github.com/flexprice/flexprice/ent/meter"
+	"github.com/flexprice/flexprice/ent/metricseriesstate"
 	"github.com/flexprice/flexprice/ent/payment"
 	"github.com/flexprice/flexprice/ent/paymentattempt"
+	"github.com/flexprice/flexprice/ent/paymentrefund"
 	"github.com/flexprice/flexprice/ent/plan"
 	"github.com/flexprice/flexprice/ent/price"
 	"github.com/flexprice/flexprice/ent/priceunit"
+	"github.com/flexprice/flexprice/ent/promotioncode"
 	"github.com/flexprice/flexprice/ent/scheduledtask"
 	"github.com/flexprice/flexprice/ent/secret"
 	"github.com/flexprice/flexprice/ent/settings"
@@ -133,19 +139,25 @@ func checkColumn(table, column string) error {
 			creditnotelineitem.Table:        creditnotelineitem.ValidColumn,
 			customer.Table:                  customer.ValidColumn,
 			entitlement.Table:               entitlement.ValidColumn,
+			entitlementusagecounter.Table:   entitlementusagecounter.ValidColumn,
 			entityintegrationmapping.Table:  entityintegrationmapping.ValidColumn,
 			environment.Table:               environment.ValidColumn,
+			eventcorrectionclaim.Table:      eventcorrectionclaim.ValidColumn,
+			eventschema.Table:               eventschema.ValidColumn,
 			feature.Table:                   feature.ValidColumn,
 			group.Table:                     group.ValidColumn,
 			invoice.Table:                   invoice.ValidColumn,
 			invoicelineitem.Table:           invoicelineitem.ValidColumn,
 			invoicesequence.Table:           invoicesequence.ValidColumn,
 			meter.Table:                     meter.ValidColumn,
+			metricseriesstate.Table:         metricseriesstate.ValidColumn,
 			payment.Table:                   payment.ValidColumn,
 			paymentattempt.Table:            paymentattempt.ValidColumn,
+			paymentrefund.Table:             paymentrefund.ValidColumn,
 			plan.Table:                      plan.ValidColumn,
 			price.Table:                     price.ValidColumn,
 			priceunit.Table:                 priceunit.ValidColumn,
+			promotioncode.Table:             promotioncode.ValidColumn,
 			scheduledtask.Table:             scheduledtask.ValidColumn,
 			secret.Table:                    secret.ValidColumn,
 			settings.Table:                  settings.ValidColumn,
@@ -0,0 +1,211 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/flexprice/flexprice/ent/entitlementusagecounter"
+	"github.com/shopspring/decimal"
+)
+
+// EntitlementUsageCounter is the model entity for the EntitlementUsageCounter schema.
+type EntitlementUsageCounter struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID string `json:"tenant_id,omitempty"`
+	// EnvironmentID holds the value of the "environment_id" field.
+	EnvironmentID string `json:"environment_id,omitempty"`
+	// CustomerID holds the value of the "customer_id" field.
+	CustomerID string `json:"customer_id,omitempty"`
+	// FeatureID holds the value of the "feature_id" field.
+	FeatureID string `json:"feature_id,omitempty"`
+	// PeriodStart holds the value of the "period_start" field.
+	PeriodStart time.Time `json:"period_start,omitempty"`
+	// Aggregated usage at the time of the last reservation
+	Baseline decimal.Decimal `json:"baseline,omitempty"`
+	// Usage reserved since the baseline was aggregated
+	Reserved decimal.Decimal `json:"reserved,omitempty"`
+	// LimitReachedAt holds the value of the "limit_reached_at" field.
+	LimitReachedAt *time.Time `json:"limit_reached_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EntitlementUsageCounter) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case entitlementusagecounter.FieldBaseline, entitlementusagecounter.FieldReserved:
+			values[i] = new(decimal.Decimal)
+		case entitlementusagecounter.FieldID:
+			values[i] = new(sql.NullInt64)
+		case entitlementusagecounter.FieldTenantID, entitlementusagecounter.FieldEnvironmentID, entitlementusagecounter.FieldCustomerID, entitlementusagecounter.FieldFeatureID:
+			values[i] = new(sql.NullString)
+		case entitlementusagecounter.FieldPeriodStart, entitlementusagecounter.FieldLimitReachedAt, entitlementusagecounter.FieldCreatedAt, entitlementusagecounter.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EntitlementUsageCounter fields.
+func (euc *EntitlementUsageCounter) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case entitlementusagecounter.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			euc.ID = int(value.Int64)
+		case entitlementusagecounter.FieldTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				euc.TenantID = value.String
+			}
+		case entitlementusagecounter.FieldEnvironmentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field environment_id", values[i])
+			} else if value.Valid {
+				euc.EnvironmentID = value.String
+			}
+		case entitlementusagecounter.FieldCustomerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field customer_id", values[i])
+			} else if value.Valid {
+				euc.CustomerID = value.String
+			}
+		case entitlementusagecounter.FieldFeatureID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field feature_id", values[i])
+			} else if value.Valid {
+				euc.FeatureID = value.String
+			}
+		case entitlementusagecounter.FieldPeriodStart:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field period_start", values[i])
+			} else if value.Valid {
+				euc.PeriodStart = value.Time
+			}
+		case entitlementusagecounter.FieldBaseline:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field baseline", values[i])
+			} else if value != nil {
+				euc.Baseline = *value
+			}
+		case entitlementusagecounter.FieldReserved:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field reserved", values[i])
+			} else if value != nil {
+				euc.Reserved = *value
+			}
+		case entitlementusagecounter.FieldLimitReachedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field limit_reached_at", values[i])
+			} else if value.Valid {
+				euc.LimitReachedAt = new(time.Time)
+				*euc.LimitReachedAt = value.Time
+			}
+		case entitlementusagecounter.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				euc.CreatedAt = value.Time
+			}
+		case entitlementusagecounter.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				euc.UpdatedAt = value.Time
+			}
+		default:
+			euc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EntitlementUsageCounter.
+// This includes values selected through modifiers, order, etc.
+func (euc *EntitlementUsageCounter) Value(name string) (ent.Value, error) {
+	return euc.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this EntitlementUsageCounter.
+// Note that you need to call EntitlementUsageCounter.Unwrap() before calling this method if this EntitlementUsageCounter
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (euc *EntitlementUsageCounter) Update() *EntitlementUsageCounterUpdateOne {
+	return NewEntitlementUsageCounterClient(euc.config).UpdateOne(euc)
+}
+
+// Unwrap unwraps the EntitlementUsageCounter entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (euc *EntitlementUsageCounter) Unwrap() *EntitlementUsageCounter {
+	_tx, ok := euc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EntitlementUsageCounter is not a transactional entity")
+	}
+	euc.config.driver = _tx.drv
+	return euc
+}
+
+// String implements the fmt.Stringer.
+func (euc *EntitlementUsageCounter) String() string {
+	var builder strings.Builder
+	builder.WriteString("EntitlementUsageCounter(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", euc.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(euc.TenantID)
+	builder.WriteString(", ")
+	builder.WriteString("environment_id=")
+	builder.WriteString(euc.EnvironmentID)
+	builder.WriteString(", ")
+	builder.WriteString("customer_id=")
+	builder.WriteString(euc.CustomerID)
+	builder.WriteString(", ")
+	builder.WriteString("feature_id=")
+	builder.WriteString(euc.FeatureID)
+	builder.WriteString(", ")
+	builder.WriteString("period_start=")
+	builder.WriteString(euc.PeriodStart.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("baseline=")
+	builder.WriteString(fmt.Sprintf("%v", euc.Baseline))
+	builder.WriteString(", ")
+	builder.WriteString("reserved=")
+	builder.WriteString(fmt.Sprintf("%v", euc.Reserved))
+	builder.WriteString(", ")
+	if v := euc.LimitReachedAt; v != nil {
+		builder.WriteString("limit_reached_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(euc.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(euc.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EntitlementUsageCounters is a parsable slice of EntitlementUsageCounter.
+type EntitlementUsageCounters []*EntitlementUsageCounter
@@ -0,0 +1,641 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entitlementusagecounter
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldEQ(FieldTenantID, v))
+}
+
+// EnvironmentID applies equality check predicate on the "environment_id" field. It's identical to EnvironmentIDEQ.
+func EnvironmentID(v string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldEQ(FieldEnvironmentID, v))
+}
+
+// CustomerID applies equality check predicate on the "customer_id" field. It's identical to CustomerIDEQ.
+func CustomerID(v string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldEQ(FieldCustomerID, v))
+}
+
+// FeatureID applies equality check predicate on the "feature_id" field. It's identical to FeatureIDEQ.
+func FeatureID(v string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldEQ(FieldFeatureID, v))
+}
+
+// PeriodStart applies equality check predicate on the "period_start" field. It's identical to PeriodStartEQ.
+func PeriodStart(v time.Time) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldEQ(FieldPeriodStart, v))
+}
+
+// Baseline applies equality check predicate on the "baseline" field. It's identical to BaselineEQ.
+func Baseline(v decimal.Decimal) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldEQ(FieldBaseline, v))
+}
+
+// Reserved applies equality check predicate on the "reserved" field. It's identical to ReservedEQ.
+func Reserved(v decimal.Decimal) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldEQ(FieldReserved, v))
+}
+
+// LimitReachedAt applies equality check predicate on the "limit_reached_at" field. It's identical to LimitReachedAtEQ.
+func LimitReachedAt(v time.Time) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldEQ(FieldLimitReachedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDContains applies the Contains predicate on the "tenant_id" field.
+func TenantIDContains(v string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldContains(FieldTenantID, v))
+}
+
+// TenantIDHasPrefix applies the HasPrefix predicate on the "tenant_id" field.
+func TenantIDHasPrefix(v string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldHasPrefix(FieldTenantID, v))
+}
+
+// TenantIDHasSuffix applies the HasSuffix predicate on the "tenant_id" field.
+func TenantIDHasSuffix(v string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldHasSuffix(FieldTenantID, v))
+}
+
+// TenantIDEqualFold applies the EqualFold predicate on the "tenant_id" field.
+func TenantIDEqualFold(v string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldEqualFold(FieldTenantID, v))
+}
+
+// TenantIDContainsFold applies the ContainsFold predicate on the "tenant_id" field.
+func TenantIDContainsFold(v string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldContainsFold(FieldTenantID, v))
+}
+
+// EnvironmentIDEQ applies the EQ predicate on the "environment_id" field.
+func EnvironmentIDEQ(v string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldEQ(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDNEQ applies the NEQ predicate on the "environment_id" field.
+func EnvironmentIDNEQ(v string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldNEQ(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDIn applies the In predicate on the "environment_id" field.
+func EnvironmentIDIn(vs ...string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldIn(FieldEnvironmentID, vs...))
+}
+
+// EnvironmentIDNotIn applies the NotIn predicate on the "environment_id" field.
+func EnvironmentIDNotIn(vs ...string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldNotIn(FieldEnvironmentID, vs...))
+}
+
+// EnvironmentIDGT applies the GT predicate on the "environment_id" field.
+func EnvironmentIDGT(v string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldGT(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDGTE applies the GTE predicate on the "environment_id" field.
+func EnvironmentIDGTE(v string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldGTE(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDLT applies the LT predicate on the "environment_id" field.
+func EnvironmentIDLT(v string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldLT(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDLTE applies the LTE predicate on the "environment_id" field.
+func EnvironmentIDLTE(v string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldLTE(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDContains applies the Contains predicate on the "environment_id" field.
+func EnvironmentIDContains(v string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldContains(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDHasPrefix applies the HasPrefix predicate on the "environment_id" field.
+func EnvironmentIDHasPrefix(v string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldHasPrefix(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDHasSuffix applies the HasSuffix predicate on the "environment_id" field.
+func EnvironmentIDHasSuffix(v string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldHasSuffix(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDIsNil applies the IsNil predicate on the "environment_id" field.
+func EnvironmentIDIsNil() predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldIsNull(FieldEnvironmentID))
+}
+
+// EnvironmentIDNotNil applies the NotNil predicate on the "environment_id" field.
+func EnvironmentIDNotNil() predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldNotNull(FieldEnvironmentID))
+}
+
+// EnvironmentIDEqualFold applies the EqualFold predicate on the "environment_id" field.
+func EnvironmentIDEqualFold(v string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldEqualFold(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDContainsFold applies the ContainsFold predicate on the "environment_id" field.
+func EnvironmentIDContainsFold(v string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldContainsFold(FieldEnvironmentID, v))
+}
+
+// CustomerIDEQ applies the EQ predicate on the "customer_id" field.
+func CustomerIDEQ(v string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldEQ(FieldCustomerID, v))
+}
+
+// CustomerIDNEQ applies the NEQ predicate on the "customer_id" field.
+func CustomerIDNEQ(v string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldNEQ(FieldCustomerID, v))
+}
+
+// CustomerIDIn applies the In predicate on the "customer_id" field.
+func CustomerIDIn(vs ...string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldIn(FieldCustomerID, vs...))
+}
+
+// CustomerIDNotIn applies the NotIn predicate on the "customer_id" field.
+func CustomerIDNotIn(vs ...string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldNotIn(FieldCustomerID, vs...))
+}
+
+// CustomerIDGT applies the GT predicate on the "customer_id" field.
+func CustomerIDGT(v string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldGT(FieldCustomerID, v))
+}
+
+// CustomerIDGTE applies the GTE predicate on the "customer_id" field.
+func CustomerIDGTE(v string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldGTE(FieldCustomerID, v))
+}
+
+// CustomerIDLT applies the LT predicate on the "customer_id" field.
+func CustomerIDLT(v string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldLT(FieldCustomerID, v))
+}
+
+// CustomerIDLTE applies the LTE predicate on the "customer_id" field.
+func CustomerIDLTE(v string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldLTE(FieldCustomerID, v))
+}
+
+// CustomerIDContains applies the Contains predicate on the "customer_id" field.
+func CustomerIDContains(v string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldContains(FieldCustomerID, v))
+}
+
+// CustomerIDHasPrefix applies the HasPrefix predicate on the "customer_id" field.
+func CustomerIDHasPrefix(v string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldHasPrefix(FieldCustomerID, v))
+}
+
+// CustomerIDHasSuffix applies the HasSuffix predicate on the "customer_id" field.
+func CustomerIDHasSuffix(v string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldHasSuffix(FieldCustomerID, v))
+}
+
+// CustomerIDEqualFold applies the EqualFold predicate on the "customer_id" field.
+func CustomerIDEqualFold(v string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldEqualFold(FieldCustomerID, v))
+}
+
+// CustomerIDContainsFold applies the ContainsFold predicate on the "customer_id" field.
+func CustomerIDContainsFold(v string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldContainsFold(FieldCustomerID, v))
+}
+
+// FeatureIDEQ applies the EQ predicate on the "feature_id" field.
+func FeatureIDEQ(v string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldEQ(FieldFeatureID, v))
+}
+
+// FeatureIDNEQ applies the NEQ predicate on the "feature_id" field.
+func FeatureIDNEQ(v string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldNEQ(FieldFeatureID, v))
+}
+
+// FeatureIDIn applies the In predicate on the "feature_id" field.
+func FeatureIDIn(vs ...string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldIn(FieldFeatureID, vs...))
+}
+
+// FeatureIDNotIn applies the NotIn predicate on the "feature_id" field.
+func FeatureIDNotIn(vs ...string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldNotIn(FieldFeatureID, vs...))
+}
+
+// FeatureIDGT applies the GT predicate on the "feature_id" field.
+func FeatureIDGT(v string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldGT(FieldFeatureID, v))
+}
+
+// FeatureIDGTE applies the GTE predicate on the "feature_id" field.
+func FeatureIDGTE(v string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldGTE(FieldFeatureID, v))
+}
+
+// FeatureIDLT applies the LT predicate on the "feature_id" field.
+func FeatureIDLT(v string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldLT(FieldFeatureID, v))
+}
+
+// FeatureIDLTE applies the LTE predicate on the "feature_id" field.
+func FeatureIDLTE(v string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldLTE(FieldFeatureID, v))
+}
+
+// FeatureIDContains applies the Contains predicate on the "feature_id" field.
+func FeatureIDContains(v string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldContains(FieldFeatureID, v))
+}
+
+// FeatureIDHasPrefix applies the HasPrefix predicate on the "feature_id" field.
+func FeatureIDHasPrefix(v string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldHasPrefix(FieldFeatureID, v))
+}
+
+// FeatureIDHasSuffix applies the HasSuffix predicate on the "feature_id" field.
+func FeatureIDHasSuffix(v string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldHasSuffix(FieldFeatureID, v))
+}
+
+// FeatureIDEqualFold applies the EqualFold predicate on the "feature_id" field.
+func FeatureIDEqualFold(v string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldEqualFold(FieldFeatureID, v))
+}
+
+// FeatureIDContainsFold applies the ContainsFold predicate on the "feature_id" field.
+func FeatureIDContainsFold(v string) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldContainsFold(FieldFeatureID, v))
+}
+
+// PeriodStartEQ applies the EQ predicate on the "period_start" field.
+func PeriodStartEQ(v time.Time) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldEQ(FieldPeriodStart, v))
+}
+
+// PeriodStartNEQ applies the NEQ predicate on the "period_start" field.
+func PeriodStartNEQ(v time.Time) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldNEQ(FieldPeriodStart, v))
+}
+
+// PeriodStartIn applies the In predicate on the "period_start" field.
+func PeriodStartIn(vs ...time.Time) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldIn(FieldPeriodStart, vs...))
+}
+
+// PeriodStartNotIn applies the NotIn predicate on the "period_start" field.
+func PeriodStartNotIn(vs ...time.Time) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldNotIn(FieldPeriodStart, vs...))
+}
+
+// PeriodStartGT applies the GT predicate on the "period_start" field.
+func PeriodStartGT(v time.Time) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldGT(FieldPeriodStart, v))
+}
+
+// PeriodStartGTE applies the GTE predicate on the "period_start" field.
+func PeriodStartGTE(v time.Time) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldGTE(FieldPeriodStart, v))
+}
+
+// PeriodStartLT applies the LT predicate on the "period_start" field.
+func PeriodStartLT(v time.Time) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldLT(FieldPeriodStart, v))
+}
+
+// PeriodStartLTE applies the LTE predicate on the "period_start" field.
+func PeriodStartLTE(v time.Time) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldLTE(FieldPeriodStart, v))
+}
+
+// BaselineEQ applies the EQ predicate on the "baseline" field.
+func BaselineEQ(v decimal.Decimal) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldEQ(FieldBaseline, v))
+}
+
+// BaselineNEQ applies the NEQ predicate on the "baseline" field.
+func BaselineNEQ(v decimal.Decimal) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldNEQ(FieldBaseline, v))
+}
+
+// BaselineIn applies the In predicate on the "baseline" field.
+func BaselineIn(vs ...decimal.Decimal) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldIn(FieldBaseline, vs...))
+}
+
+// BaselineNotIn applies the NotIn predicate on the "baseline" field.
+func BaselineNotIn(vs ...decimal.Decimal) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldNotIn(FieldBaseline, vs...))
+}
+
+// BaselineGT applies the GT predicate on the "baseline" field.
+func BaselineGT(v decimal.Decimal) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldGT(FieldBaseline, v))
+}
+
+// BaselineGTE applies the GTE predicate on the "baseline" field.
+func BaselineGTE(v decimal.Decimal) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldGTE(FieldBaseline, v))
+}
+
+// BaselineLT applies the LT predicate on the "baseline" field.
+func BaselineLT(v decimal.Decimal) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldLT(FieldBaseline, v))
+}
+
+// BaselineLTE applies the LTE predicate on the "baseline" field.
+func BaselineLTE(v decimal.Decimal) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldLTE(FieldBaseline, v))
+}
+
+// ReservedEQ applies the EQ predicate on the "reserved" field.
+func ReservedEQ(v decimal.Decimal) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldEQ(FieldReserved, v))
+}
+
+// ReservedNEQ applies the NEQ predicate on the "reserved" field.
+func ReservedNEQ(v decimal.Decimal) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldNEQ(FieldReserved, v))
+}
+
+// ReservedIn applies the In predicate on the "reserved" field.
+func ReservedIn(vs ...decimal.Decimal) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldIn(FieldReserved, vs...))
+}
+
+// ReservedNotIn applies the NotIn predicate on the "reserved" field.
+func ReservedNotIn(vs ...decimal.Decimal) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldNotIn(FieldReserved, vs...))
+}
+
+// ReservedGT applies the GT predicate on the "reserved" field.
+func ReservedGT(v decimal.Decimal) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldGT(FieldReserved, v))
+}
+
+// ReservedGTE applies the GTE predicate on the "reserved" field.
+func ReservedGTE(v decimal.Decimal) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldGTE(FieldReserved, v))
+}
+
+// ReservedLT applies the LT predicate on the "reserved" field.
+func ReservedLT(v decimal.Decimal) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldLT(FieldReserved, v))
+}
+
+// ReservedLTE applies the LTE predicate on the "reserved" field.
+func ReservedLTE(v decimal.Decimal) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldLTE(FieldReserved, v))
+}
+
+// LimitReachedAtEQ applies the EQ predicate on the "limit_reached_at" field.
+func LimitReachedAtEQ(v time.Time) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldEQ(FieldLimitReachedAt, v))
+}
+
+// LimitReachedAtNEQ applies the NEQ predicate on the "limit_reached_at" field.
+func LimitReachedAtNEQ(v time.Time) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldNEQ(FieldLimitReachedAt, v))
+}
+
+// LimitReachedAtIn applies the In predicate on the "limit_reached_at" field.
+func LimitReachedAtIn(vs ...time.Time) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldIn(FieldLimitReachedAt, vs...))
+}
+
+// LimitReachedAtNotIn applies the NotIn predicate on the "limit_reached_at" field.
+func LimitReachedAtNotIn(vs ...time.Time) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldNotIn(FieldLimitReachedAt, vs...))
+}
+
+// LimitReachedAtGT applies the GT predicate on the "limit_reached_at" field.
+func LimitReachedAtGT(v time.Time) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldGT(FieldLimitReachedAt, v))
+}
+
+// LimitReachedAtGTE applies the GTE predicate on the "limit_reached_at" field.
+func LimitReachedAtGTE(v time.Time) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldGTE(FieldLimitReachedAt, v))
+}
+
+// LimitReachedAtLT applies the LT predicate on the "limit_reached_at" field.
+func LimitReachedAtLT(v time.Time) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldLT(FieldLimitReachedAt, v))
+}
+
+// LimitReachedAtLTE applies the LTE predicate on the "limit_reached_at" field.
+func LimitReachedAtLTE(v time.Time) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldLTE(FieldLimitReachedAt, v))
+}
+
+// LimitReachedAtIsNil applies the IsNil predicate on the "limit_reached_at" field.
+func LimitReachedAtIsNil() predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldIsNull(FieldLimitReachedAt))
+}
+
+// LimitReachedAtNotNil applies the NotNil predicate on the "limit_reached_at" field.
+func LimitReachedAtNotNil() predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldNotNull(FieldLimitReachedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EntitlementUsageCounter) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EntitlementUsageCounter) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EntitlementUsageCounter) predicate.EntitlementUsageCounter {
+	return predicate.EntitlementUsageCounter(sql.NotPredicates(p))
+}
@@ -0,0 +1,381 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/entitlementusagecounter"
+	"github.com/shopspring/decimal"
+)
+
+// EntitlementUsageCounterCreate is the builder for creating a EntitlementUsageCounter entity.
+type EntitlementUsageCounterCreate struct {
+	config
+	mutation *EntitlementUsageCounterMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (eucc *EntitlementUsageCounterCreate) SetTenantID(s string) *EntitlementUsageCounterCreate {
+	eucc.mutation.SetTenantID(s)
+	return eucc
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (eucc *EntitlementUsageCounterCreate) SetEnvironmentID(s string) *EntitlementUsageCounterCreate {
+	eucc.mutation.SetEnvironmentID(s)
+	return eucc
+}
+
+// SetNillableEnvironmentID sets the "environment_id" field if the given value is not nil.
+func (eucc *EntitlementUsageCounterCreate) SetNillableEnvironmentID(s *string) *EntitlementUsageCounterCreate {
+	if s != nil {
+		eucc.SetEnvironmentID(*s)
+	}
+	return eucc
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (eucc *EntitlementUsageCounterCreate) SetCustomerID(s string) *EntitlementUsageCounterCreate {
+	eucc.mutation.SetCustomerID(s)
+	return eucc
+}
+
+// SetFeatureID sets the "feature_id" field.
+func (eucc *EntitlementUsageCounterCreate) SetFeatureID(s string) *EntitlementUsageCounterCreate {
+	eucc.mutation.SetFeatureID(s)
+	return eucc
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (eucc *EntitlementUsageCounterCreate) SetPeriodStart(t time.Time) *EntitlementUsageCounterCreate {
+	eucc.mutation.SetPeriodStart(t)
+	return eucc
+}
+
+// SetBaseline sets the "baseline" field.
+func (eucc *EntitlementUsageCounterCreate) SetBaseline(d decimal.Decimal) *EntitlementUsageCounterCreate {
+	eucc.mutation.SetBaseline(d)
+	return eucc
+}
+
+// SetNillableBaseline sets the "baseline" field if the given value is not nil.
+func (eucc *EntitlementUsageCounterCreate) SetNillableBaseline(d *decimal.Decimal) *EntitlementUsageCounterCreate {
+	if d != nil {
+		eucc.SetBaseline(*d)
+	}
+	return eucc
+}
+
+// SetReserved sets the "reserved" field.
+func (eucc *EntitlementUsageCounterCreate) SetReserved(d decimal.Decimal) *EntitlementUsageCounterCreate {
+	eucc.mutation.SetReserved(d)
+	return eucc
+}
+
+// SetNillableReserved sets the "reserved" field if the given value is not nil.
+func (eucc *EntitlementUsageCounterCreate) SetNillableReserved(d *decimal.Decimal) *EntitlementUsageCounterCreate {
+	if d != nil {
+		eucc.SetReserved(*d)
+	}
+	return eucc
+}
+
+// SetLimitReachedAt sets the "limit_reached_at" field.
+func (eucc *EntitlementUsageCounterCreate) SetLimitReachedAt(t time.Time) *EntitlementUsageCounterCreate {
+	eucc.mutation.SetLimitReachedAt(t)
+	return eucc
+}
+
+// SetNillableLimitReachedAt sets the "limit_reached_at" field if the given value is not nil.
+func (eucc *EntitlementUsageCounterCreate) SetNillableLimitReachedAt(t *time.Time) *EntitlementUsageCounterCreate {
+	if t != nil {
+		eucc.SetLimitReachedAt(*t)
+	}
+	return eucc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (eucc *EntitlementUsageCounterCreate) SetCreatedAt(t time.Time) *EntitlementUsageCounterCreate {
+	eucc.mutation.SetCreatedAt(t)
+	return eucc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (eucc *EntitlementUsageCounterCreate) SetNillableCreatedAt(t *time.Time) *EntitlementUsageCounterCreate {
+	if t != nil {
+		eucc.SetCreatedAt(*t)
+	}
+	return eucc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (eucc *EntitlementUsageCounterCreate) SetUpdatedAt(t time.Time) *EntitlementUsageCounterCreate {
+	eucc.mutation.SetUpdatedAt(t)
+	return eucc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (eucc *EntitlementUsageCounterCreate) SetNillableUpdatedAt(t *time.Time) *EntitlementUsageCounterCreate {
+	if t != nil {
+		eucc.SetUpdatedAt(*t)
+	}
+	return eucc
+}
+
+// Mutation returns the EntitlementUsageCounterMutation object of the builder.
+func (eucc *EntitlementUsageCounterCreate) Mutation() *EntitlementUsageCounterMutation {
+	return eucc.mutation
+}
+
+// Save creates the EntitlementUsageCounter in the database.
+func (eucc *EntitlementUsageCounterCreate) Save(ctx context.Context) (*EntitlementUsageCounter, error) {
+	eucc.defaults()
+	return withHooks(ctx, eucc.sqlSave, eucc.mutation, eucc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (eucc *EntitlementUsageCounterCreate) SaveX(ctx context.Context) *EntitlementUsageCounter {
+	v, err := eucc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (eucc *EntitlementUsageCounterCreate) Exec(ctx context.Context) error {
+	_, err := eucc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eucc *EntitlementUsageCounterCreate) ExecX(ctx context.Context) {
+	if err := eucc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (eucc *EntitlementUsageCounterCreate) defaults() {
+	if _, ok := eucc.mutation.Baseline(); !ok {
+		v := entitlementusagecounter.DefaultBaseline
+		eucc.mutation.SetBaseline(v)
+	}
+	if _, ok := eucc.mutation.Reserved(); !ok {
+		v := entitlementusagecounter.DefaultReserved
+		eucc.mutation.SetReserved(v)
+	}
+	if _, ok := eucc.mutation.CreatedAt(); !ok {
+		v := entitlementusagecounter.DefaultCreatedAt()
+		eucc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := eucc.mutation.UpdatedAt(); !ok {
+		v := entitlementusagecounter.DefaultUpdatedAt()
+		eucc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (eucc *EntitlementUsageCounterCreate) check() error {
+	if _, ok := eucc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "EntitlementUsageCounter.tenant_id"`)}
+	}
+	if v, ok := eucc.mutation.TenantID(); ok {
+		if err := entitlementusagecounter.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "EntitlementUsageCounter.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := eucc.mutation.CustomerID(); !ok {
+		return &ValidationError{Name: "customer_id", err: errors.New(`ent: missing required field "EntitlementUsageCounter.customer_id"`)}
+	}
+	if v, ok := eucc.mutation.CustomerID(); ok {
+		if err := entitlementusagecounter.CustomerIDValidator(v); err != nil {
+			return &ValidationError{Name: "customer_id", err: fmt.Errorf(`ent: validator failed for field "EntitlementUsageCounter.customer_id": %w`, err)}
+		}
+	}
+	if _, ok := eucc.mutation.FeatureID(); !ok {
+		return &ValidationError{Name: "feature_id", err: errors.New(`ent: missing required field "EntitlementUsageCounter.feature_id"`)}
+	}
+	if v, ok := eucc.mutation.FeatureID(); ok {
+		if err := entitlementusagecounter.FeatureIDValidator(v); err != nil {
+			return &ValidationError{Name: "feature_id", err: fmt.Errorf(`ent: validator failed for field "EntitlementUsageCounter.feature_id": %w`, err)}
+		}
+	}
+	if _, ok := eucc.mutation.PeriodStart(); !ok {
+		return &ValidationError{Name: "period_start", err: errors.New(`ent: missing required field "EntitlementUsageCounter.period_start"`)}
+	}
+	if _, ok := eucc.mutation.Baseline(); !ok {
+		return &ValidationError{Name: "baseline", err: errors.New(`ent: missing required field "EntitlementUsageCounter.baseline"`)}
+	}
+	if _, ok := eucc.mutation.Reserved(); !ok {
+		return &ValidationError{Name: "reserved", err: errors.New(`ent: missing required field "EntitlementUsageCounter.reserved"`)}
+	}
+	if _, ok := eucc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "EntitlementUsageCounter.created_at"`)}
+	}
+	if _, ok := eucc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "EntitlementUsageCounter.updated_at"`)}
+	}
+	return nil
+}
+
+func (eucc *EntitlementUsageCounterCreate) sqlSave(ctx context.Context) (*EntitlementUsageCounter, error) {
+	if err := eucc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := eucc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, eucc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	eucc.mutation.id = &_node.ID
+	eucc.mutation.done = true
+	return _node, nil
+}
+
+func (eucc *EntitlementUsageCounterCreate) createSpec() (*EntitlementUsageCounter, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EntitlementUsageCounter{config: eucc.config}
+		_spec = sqlgraph.NewCreateSpec(entitlementusagecounter.Table, sqlgraph.NewFieldSpec(entitlementusagecounter.FieldID, field.TypeInt))
+	)
+	if value, ok := eucc.mutation.TenantID(); ok {
+		_spec.SetField(entitlementusagecounter.FieldTenantID, field.TypeString, value)
+		_node.TenantID = value
+	}
+	if value, ok := eucc.mutation.EnvironmentID(); ok {
+		_spec.SetField(entitlementusagecounter.FieldEnvironmentID, field.TypeString, value)
+		_node.EnvironmentID = value
+	}
+	if value, ok := eucc.mutation.CustomerID(); ok {
+		_spec.SetField(entitlementusagecounter.FieldCustomerID, field.TypeString, value)
+		_node.CustomerID = value
+	}
+	if value, ok := eucc.mutation.FeatureID(); ok {
+		_spec.SetField(entitlementusagecounter.FieldFeatureID, field.TypeString, value)
+		_node.FeatureID = value
+	}
+	if value, ok := eucc.mutation.PeriodStart(); ok {
+		_spec.SetField(entitlementusagecounter.FieldPeriodStart, field.TypeTime, value)
+		_node.PeriodStart = value
+	}
+	if value, ok := eucc.mutation.Baseline(); ok {
+		_spec.SetField(entitlementusagecounter.FieldBaseline, field.TypeOther, value)
+		_node.Baseline = value
+	}
+	if value, ok := eucc.mutation.Reserved(); ok {
+		_spec.SetField(entitlementusagecounter.FieldReserved, field.TypeOther, value)
+		_node.Reserved = value
+	}
+	if value, ok := eucc.mutation.LimitReachedAt(); ok {
+		_spec.SetField(entitlementusagecounter.FieldLimitReachedAt, field.TypeTime, value)
+		_node.LimitReachedAt = &value
+	}
+	if value, ok := eucc.mutation.CreatedAt(); ok {
+		_spec.SetField(entitlementusagecounter.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := eucc.mutation.UpdatedAt(); ok {
+		_spec.SetField(entitlementusagecounter.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// EntitlementUsageCounterCreateBulk is the builder for creating many EntitlementUsageCounter entities in bulk.
+type EntitlementUsageCounterCreateBulk struct {
+	config
+	err      error
+	builders []*EntitlementUsageCounterCreate
+}
+
+// Save creates the EntitlementUsageCounter entities in the database.
+func (euccb *EntitlementUsageCounterCreateBulk) Save(ctx context.Context) ([]*EntitlementUsageCounter, error) {
+	if euccb.err != nil {
+		return nil, euccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(euccb.builders))
+	nodes := make([]*EntitlementUsageCounter, len(euccb.builders))
+	mutators := make([]Mutator, len(euccb.builders))
+	for i := range euccb.builders {
+		func(i int, root context.Context) {
+			builder := euccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EntitlementUsageCounterMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, euccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, euccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, euccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (euccb *EntitlementUsageCounterCreateBulk) SaveX(ctx context.Context) []*EntitlementUsageCounter {
+	v, err := euccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (euccb *EntitlementUsageCounterCreateBulk) Exec(ctx context.Context) error {
+	_, err := euccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (euccb *EntitlementUsageCounterCreateBulk) ExecX(ctx context.Context) {
+	if err := euccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
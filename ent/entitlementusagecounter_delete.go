@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/entitlementusagecounter"
+	"github.com/flexprice/flexprice/ent/predicate"
+)
+
+// EntitlementUsageCounterDelete is the builder for deleting a EntitlementUsageCounter entity.
+type EntitlementUsageCounterDelete struct {
+	config
+	hooks    []Hook
+	mutation *EntitlementUsageCounterMutation
+}
+
+// Where appends a list predicates to the EntitlementUsageCounterDelete builder.
+func (eucd *EntitlementUsageCounterDelete) Where(ps ...predicate.EntitlementUsageCounter) *EntitlementUsageCounterDelete {
+	eucd.mutation.Where(ps...)
+	return eucd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (eucd *EntitlementUsageCounterDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, eucd.sqlExec, eucd.mutation, eucd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eucd *EntitlementUsageCounterDelete) ExecX(ctx context.Context) int {
+	n, err := eucd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (eucd *EntitlementUsageCounterDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(entitlementusagecounter.Table, sqlgraph.NewFieldSpec(entitlementusagecounter.FieldID, field.TypeInt))
+	if ps := eucd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, eucd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	eucd.mutation.done = true
+	return affected, err
+}
+
+// EntitlementUsageCounterDeleteOne is the builder for deleting a single EntitlementUsageCounter entity.
+type EntitlementUsageCounterDeleteOne struct {
+	eucd *EntitlementUsageCounterDelete
+}
+
+// Where appends a list predicates to the EntitlementUsageCounterDelete builder.
+func (eucdo *EntitlementUsageCounterDeleteOne) Where(ps ...predicate.EntitlementUsageCounter) *EntitlementUsageCounterDeleteOne {
+	eucdo.eucd.mutation.Where(ps...)
+	return eucdo
+}
+
+// Exec executes the deletion query.
+func (eucdo *EntitlementUsageCounterDeleteOne) Exec(ctx context.Context) error {
+	n, err := eucdo.eucd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{entitlementusagecounter.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eucdo *EntitlementUsageCounterDeleteOne) ExecX(ctx context.Context) {
+	if err := eucdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/entitlementusagecounter"
+	"github.com/flexprice/flexprice/ent/predicate"
+)
+
+// EntitlementUsageCounterQuery is the builder for querying EntitlementUsageCounter entities.
+type EntitlementUsageCounterQuery struct {
+	config
+	ctx        *QueryContext
+	order      []entitlementusagecounter.OrderOption
+	inters     []Interceptor
+	predicates []predicate.EntitlementUsageCounter
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the EntitlementUsageCounterQuery builder.
+func (eucq *EntitlementUsageCounterQuery) Where(ps ...predicate.EntitlementUsageCounter) *EntitlementUsageCounterQuery {
+	eucq.predicates = append(eucq.predicates, ps...)
+	return eucq
+}
+
+// Limit the number of records to be returned by this query.
+func (eucq *EntitlementUsageCounterQuery) Limit(limit int) *EntitlementUsageCounterQuery {
+	eucq.ctx.Limit = &limit
+	return eucq
+}
+
+// Offset to start from.
+func (eucq *EntitlementUsageCounterQuery) Offset(offset int) *EntitlementUsageCounterQuery {
+	eucq.ctx.Offset = &offset
+	return eucq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (eucq *EntitlementUsageCounterQuery) Unique(unique bool) *EntitlementUsageCounterQuery {
+	eucq.ctx.Unique = &unique
+	return eucq
+}
+
+// Order specifies how the records should be ordered.
+func (eucq *EntitlementUsageCounterQuery) Order(o ...entitlementusagecounter.OrderOption) *EntitlementUsageCounterQuery {
+	eucq.order = append(eucq.order, o...)
+	return eucq
+}
+
+// First returns the first EntitlementUsageCounter entity from the query.
+// Returns a *NotFoundError when no EntitlementUsageCounter was found.
+func (eucq *EntitlementUsageCounterQuery) First(ctx context.Context) (*EntitlementUsageCounter, error) {
+	nodes, err := eucq.Limit(1).All(setContextOp(ctx, eucq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{entitlementusagecounter.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (eucq *EntitlementUsageCounterQuery) FirstX(ctx context.Context) *EntitlementUsageCounter {
+	node, err := eucq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first EntitlementUsageCounter ID from the query.
+// Returns a *NotFoundError when no EntitlementUsageCounter ID was found.
+func (eucq *EntitlementUsageCounterQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = eucq.Limit(1).IDs(setContextOp(ctx, eucq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{entitlementusagecounter.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (eucq *EntitlementUsageCounterQuery) FirstIDX(ctx context.Context) int {
+	id, err := eucq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single EntitlementUsageCounter entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one EntitlementUsageCounter entity is found.
+// Returns a *NotFoundError when no EntitlementUsageCounter entities are found.
+func (eucq *EntitlementUsageCounterQuery) Only(ctx context.Context) (*EntitlementUsageCounter, error) {
+	nodes, err := eucq.Limit(2).All(setContextOp(ctx, eucq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{entitlementusagecounter.Label}
+	default:
+		return nil, &NotSingularError{entitlementusagecounter.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (eucq *EntitlementUsageCounterQuery) OnlyX(ctx context.Context) *EntitlementUsageCounter {
+	node, err := eucq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only EntitlementUsageCounter ID in the query.
+// Returns a *NotSingularError when more than one EntitlementUsageCounter ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (eucq *EntitlementUsageCounterQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = eucq.Limit(2).IDs(setContextOp(ctx, eucq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{entitlementusagecounter.Label}
+	default:
+		err = &NotSingularError{entitlementusagecounter.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (eucq *EntitlementUsageCounterQuery) OnlyIDX(ctx context.Context) int {
+	id, err := eucq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of EntitlementUsageCounters.
+func (eucq *EntitlementUsageCounterQuery) All(ctx context.Context) ([]*EntitlementUsageCounter, error) {
+	ctx = setContextOp(ctx, eucq.ctx, ent.OpQueryAll)
+	if err := eucq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*EntitlementUsageCounter, *EntitlementUsageCounterQuery]()
+	return withInterceptors[[]*EntitlementUsageCounter](ctx, eucq, qr, eucq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (eucq *EntitlementUsageCounterQuery) AllX(ctx context.Context) []*EntitlementUsageCounter {
+	nodes, err := eucq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of EntitlementUsageCounter IDs.
+func (eucq *EntitlementUsageCounterQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if eucq.ctx.Unique == nil && eucq.path != nil {
+		eucq.Unique(true)
+	}
+	ctx = setContextOp(ctx, eucq.ctx, ent.OpQueryIDs)
+	if err = eucq.Select(entitlementusagecounter.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (eucq *EntitlementUsageCounterQuery) IDsX(ctx context.Context) []int {
+	ids, err := eucq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (eucq *EntitlementUsageCounterQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, eucq.ctx, ent.OpQueryCount)
+	if err := eucq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, eucq, querierCount[*EntitlementUsageCounterQuery](), eucq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (eucq *EntitlementUsageCounterQuery) CountX(ctx context.Context) int {
+	count, err := eucq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (eucq *EntitlementUsageCounterQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, eucq.ctx, ent.OpQueryExist)
+	switch _, err := eucq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (eucq *EntitlementUsageCounterQuery) ExistX(ctx context.Context) bool {
+	exist, err := eucq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the EntitlementUsageCounterQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (eucq *EntitlementUsageCounterQuery) Clone() *EntitlementUsageCounterQuery {
+	if eucq == nil {
+		return nil
+	}
+	return &EntitlementUsageCounterQuery{
+		config:     eucq.config,
+		ctx:        eucq.ctx.Clone(),
+		order:      append([]entitlementusagecounter.OrderOption{}, eucq.order...),
+		inters:     append([]Interceptor{}, eucq.inters...),
+		predicates: append([]predicate.EntitlementUsageCounter{}, eucq.predicates...),
+		// clone intermediate query.
+		sql:  eucq.sql.Clone(),
+		path: eucq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID string `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.EntitlementUsageCounter.Query().
+//		GroupBy(entitlementusagecounter.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (eucq *EntitlementUsageCounterQuery) GroupBy(field string, fields ...string) *EntitlementUsageCounterGroupBy {
+	eucq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &EntitlementUsageCounterGroupBy{build: eucq}
+	grbuild.flds = &eucq.ctx.Fields
+	grbuild.label = entitlementusagecounter.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID string `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.EntitlementUsageCounter.Query().
+//		Select(entitlementusagecounter.FieldTenantID).
+//		Scan(ctx, &v)
+func (eucq *EntitlementUsageCounterQuery) Select(fields ...string) *EntitlementUsageCounterSelect {
+	eucq.ctx.Fields = append(eucq.ctx.Fields, fields...)
+	sbuild := &EntitlementUsageCounterSelect{EntitlementUsageCounterQuery: eucq}
+	sbuild.label = entitlementusagecounter.Label
+	sbuild.flds, sbuild.scan = &eucq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a EntitlementUsageCounterSelect configured with the given aggregations.
+func (eucq *EntitlementUsageCounterQuery) Aggregate(fns ...AggregateFunc) *EntitlementUsageCounterSelect {
+	return eucq.Select().Aggregate(fns...)
+}
+
+func (eucq *EntitlementUsageCounterQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range eucq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, eucq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range eucq.ctx.Fields {
+		if !entitlementusagecounter.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if eucq.path != nil {
+		prev, err := eucq.path(ctx)
+		if err != nil {
+			return err
+		}
+		eucq.sql = prev
+	}
+	return nil
+}
+
+func (eucq *EntitlementUsageCounterQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*EntitlementUsageCounter, error) {
+	var (
+		nodes = []*EntitlementUsageCounter{}
+		_spec = eucq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*EntitlementUsageCounter).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &EntitlementUsageCounter{config: eucq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, eucq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (eucq *EntitlementUsageCounterQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := eucq.querySpec()
+	_spec.Node.Columns = eucq.ctx.Fields
+	if len(eucq.ctx.Fields) > 0 {
+		_spec.Unique = eucq.ctx.Unique != nil && *eucq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, eucq.driver, _spec)
+}
+
+func (eucq *EntitlementUsageCounterQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(entitlementusagecounter.Table, entitlementusagecounter.Columns, sqlgraph.NewFieldSpec(entitlementusagecounter.FieldID, field.TypeInt))
+	_spec.From = eucq.sql
+	if unique := eucq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if eucq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := eucq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, entitlementusagecounter.FieldID)
+		for i := range fields {
+			if fields[i] != entitlementusagecounter.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := eucq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := eucq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := eucq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := eucq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (eucq *EntitlementUsageCounterQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(eucq.driver.Dialect())
+	t1 := builder.Table(entitlementusagecounter.Table)
+	columns := eucq.ctx.Fields
+	if len(columns) == 0 {
+		columns = entitlementusagecounter.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if eucq.sql != nil {
+		selector = eucq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if eucq.ctx.Unique != nil && *eucq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range eucq.predicates {
+		p(selector)
+	}
+	for _, p := range eucq.order {
+		p(selector)
+	}
+	if offset := eucq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := eucq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// EntitlementUsageCounterGroupBy is the group-by builder for EntitlementUsageCounter entities.
+type EntitlementUsageCounterGroupBy struct {
+	selector
+	build *EntitlementUsageCounterQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (eucgb *EntitlementUsageCounterGroupBy) Aggregate(fns ...AggregateFunc) *EntitlementUsageCounterGroupBy {
+	eucgb.fns = append(eucgb.fns, fns...)
+	return eucgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (eucgb *EntitlementUsageCounterGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, eucgb.build.ctx, ent.OpQueryGroupBy)
+	if err := eucgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*EntitlementUsageCounterQuery, *EntitlementUsageCounterGroupBy](ctx, eucgb.build, eucgb, eucgb.build.inters, v)
+}
+
+func (eucgb *EntitlementUsageCounterGroupBy) sqlScan(ctx context.Context, root *EntitlementUsageCounterQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(eucgb.fns))
+	for _, fn := range eucgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*eucgb.flds)+len(eucgb.fns))
+		for _, f := range *eucgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*eucgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := eucgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// EntitlementUsageCounterSelect is the builder for selecting fields of EntitlementUsageCounter entities.
+type EntitlementUsageCounterSelect struct {
+	*EntitlementUsageCounterQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (eucs *EntitlementUsageCounterSelect) Aggregate(fns ...AggregateFunc) *EntitlementUsageCounterSelect {
+	eucs.fns = append(eucs.fns, fns...)
+	return eucs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (eucs *EntitlementUsageCounterSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, eucs.ctx, ent.OpQuerySelect)
+	if err := eucs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*EntitlementUsageCounterQuery, *EntitlementUsageCounterSelect](ctx, eucs.EntitlementUsageCounterQuery, eucs, eucs.inters, v)
+}
+
+func (eucs *EntitlementUsageCounterSelect) sqlScan(ctx context.Context, root *EntitlementUsageCounterQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(eucs.fns))
+	for _, fn := range eucs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*eucs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := eucs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
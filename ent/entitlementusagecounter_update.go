@@ -0,0 +1,567 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/entitlementusagecounter"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/shopspring/decimal"
+)
+
+// EntitlementUsageCounterUpdate is the builder for updating EntitlementUsageCounter entities.
+type EntitlementUsageCounterUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EntitlementUsageCounterMutation
+}
+
+// Where appends a list predicates to the EntitlementUsageCounterUpdate builder.
+func (eucu *EntitlementUsageCounterUpdate) Where(ps ...predicate.EntitlementUsageCounter) *EntitlementUsageCounterUpdate {
+	eucu.mutation.Where(ps...)
+	return eucu
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (eucu *EntitlementUsageCounterUpdate) SetTenantID(s string) *EntitlementUsageCounterUpdate {
+	eucu.mutation.SetTenantID(s)
+	return eucu
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (eucu *EntitlementUsageCounterUpdate) SetNillableTenantID(s *string) *EntitlementUsageCounterUpdate {
+	if s != nil {
+		eucu.SetTenantID(*s)
+	}
+	return eucu
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (eucu *EntitlementUsageCounterUpdate) SetEnvironmentID(s string) *EntitlementUsageCounterUpdate {
+	eucu.mutation.SetEnvironmentID(s)
+	return eucu
+}
+
+// SetNillableEnvironmentID sets the "environment_id" field if the given value is not nil.
+func (eucu *EntitlementUsageCounterUpdate) SetNillableEnvironmentID(s *string) *EntitlementUsageCounterUpdate {
+	if s != nil {
+		eucu.SetEnvironmentID(*s)
+	}
+	return eucu
+}
+
+// ClearEnvironmentID clears the value of the "environment_id" field.
+func (eucu *EntitlementUsageCounterUpdate) ClearEnvironmentID() *EntitlementUsageCounterUpdate {
+	eucu.mutation.ClearEnvironmentID()
+	return eucu
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (eucu *EntitlementUsageCounterUpdate) SetCustomerID(s string) *EntitlementUsageCounterUpdate {
+	eucu.mutation.SetCustomerID(s)
+	return eucu
+}
+
+// SetNillableCustomerID sets the "customer_id" field if the given value is not nil.
+func (eucu *EntitlementUsageCounterUpdate) SetNillableCustomerID(s *string) *EntitlementUsageCounterUpdate {
+	if s != nil {
+		eucu.SetCustomerID(*s)
+	}
+	return eucu
+}
+
+// SetFeatureID sets the "feature_id" field.
+func (eucu *EntitlementUsageCounterUpdate) SetFeatureID(s string) *EntitlementUsageCounterUpdate {
+	eucu.mutation.SetFeatureID(s)
+	return eucu
+}
+
+// SetNillableFeatureID sets the "feature_id" field if the given value is not nil.
+func (eucu *EntitlementUsageCounterUpdate) SetNillableFeatureID(s *string) *EntitlementUsageCounterUpdate {
+	if s != nil {
+		eucu.SetFeatureID(*s)
+	}
+	return eucu
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (eucu *EntitlementUsageCounterUpdate) SetPeriodStart(t time.Time) *EntitlementUsageCounterUpdate {
+	eucu.mutation.SetPeriodStart(t)
+	return eucu
+}
+
+// SetNillablePeriodStart sets the "period_start" field if the given value is not nil.
+func (eucu *EntitlementUsageCounterUpdate) SetNillablePeriodStart(t *time.Time) *EntitlementUsageCounterUpdate {
+	if t != nil {
+		eucu.SetPeriodStart(*t)
+	}
+	return eucu
+}
+
+// SetBaseline sets the "baseline" field.
+func (eucu *EntitlementUsageCounterUpdate) SetBaseline(d decimal.Decimal) *EntitlementUsageCounterUpdate {
+	eucu.mutation.SetBaseline(d)
+	return eucu
+}
+
+// SetNillableBaseline sets the "baseline" field if the given value is not nil.
+func (eucu *EntitlementUsageCounterUpdate) SetNillableBaseline(d *decimal.Decimal) *EntitlementUsageCounterUpdate {
+	if d != nil {
+		eucu.SetBaseline(*d)
+	}
+	return eucu
+}
+
+// SetReserved sets the "reserved" field.
+func (eucu *EntitlementUsageCounterUpdate) SetReserved(d decimal.Decimal) *EntitlementUsageCounterUpdate {
+	eucu.mutation.SetReserved(d)
+	return eucu
+}
+
+// SetNillableReserved sets the "reserved" field if the given value is not nil.
+func (eucu *EntitlementUsageCounterUpdate) SetNillableReserved(d *decimal.Decimal) *EntitlementUsageCounterUpdate {
+	if d != nil {
+		eucu.SetReserved(*d)
+	}
+	return eucu
+}
+
+// SetLimitReachedAt sets the "limit_reached_at" field.
+func (eucu *EntitlementUsageCounterUpdate) SetLimitReachedAt(t time.Time) *EntitlementUsageCounterUpdate {
+	eucu.mutation.SetLimitReachedAt(t)
+	return eucu
+}
+
+// SetNillableLimitReachedAt sets the "limit_reached_at" field if the given value is not nil.
+func (eucu *EntitlementUsageCounterUpdate) SetNillableLimitReachedAt(t *time.Time) *EntitlementUsageCounterUpdate {
+	if t != nil {
+		eucu.SetLimitReachedAt(*t)
+	}
+	return eucu
+}
+
+// ClearLimitReachedAt clears the value of the "limit_reached_at" field.
+func (eucu *EntitlementUsageCounterUpdate) ClearLimitReachedAt() *EntitlementUsageCounterUpdate {
+	eucu.mutation.ClearLimitReachedAt()
+	return eucu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (eucu *EntitlementUsageCounterUpdate) SetUpdatedAt(t time.Time) *EntitlementUsageCounterUpdate {
+	eucu.mutation.SetUpdatedAt(t)
+	return eucu
+}
+
+// Mutation returns the EntitlementUsageCounterMutation object of the builder.
+func (eucu *EntitlementUsageCounterUpdate) Mutation() *EntitlementUsageCounterMutation {
+	return eucu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (eucu *EntitlementUsageCounterUpdate) Save(ctx context.Context) (int, error) {
+	eucu.defaults()
+	return withHooks(ctx, eucu.sqlSave, eucu.mutation, eucu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eucu *EntitlementUsageCounterUpdate) SaveX(ctx context.Context) int {
+	affected, err := eucu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (eucu *EntitlementUsageCounterUpdate) Exec(ctx context.Context) error {
+	_, err := eucu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eucu *EntitlementUsageCounterUpdate) ExecX(ctx context.Context) {
+	if err := eucu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (eucu *EntitlementUsageCounterUpdate) defaults() {
+	if _, ok := eucu.mutation.UpdatedAt(); !ok {
+		v := entitlementusagecounter.UpdateDefaultUpdatedAt()
+		eucu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (eucu *EntitlementUsageCounterUpdate) check() error {
+	if v, ok := eucu.mutation.TenantID(); ok {
+		if err := entitlementusagecounter.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "EntitlementUsageCounter.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := eucu.mutation.CustomerID(); ok {
+		if err := entitlementusagecounter.CustomerIDValidator(v); err != nil {
+			return &ValidationError{Name: "customer_id", err: fmt.Errorf(`ent: validator failed for field "EntitlementUsageCounter.customer_id": %w`, err)}
+		}
+	}
+	if v, ok := eucu.mutation.FeatureID(); ok {
+		if err := entitlementusagecounter.FeatureIDValidator(v); err != nil {
+			return &ValidationError{Name: "feature_id", err: fmt.Errorf(`ent: validator failed for field "EntitlementUsageCounter.feature_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (eucu *EntitlementUsageCounterUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := eucu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(entitlementusagecounter.Table, entitlementusagecounter.Columns, sqlgraph.NewFieldSpec(entitlementusagecounter.FieldID, field.TypeInt))
+	if ps := eucu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := eucu.mutation.TenantID(); ok {
+		_spec.SetField(entitlementusagecounter.FieldTenantID, field.TypeString, value)
+	}
+	if value, ok := eucu.mutation.EnvironmentID(); ok {
+		_spec.SetField(entitlementusagecounter.FieldEnvironmentID, field.TypeString, value)
+	}
+	if eucu.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(entitlementusagecounter.FieldEnvironmentID, field.TypeString)
+	}
+	if value, ok := eucu.mutation.CustomerID(); ok {
+		_spec.SetField(entitlementusagecounter.FieldCustomerID, field.TypeString, value)
+	}
+	if value, ok := eucu.mutation.FeatureID(); ok {
+		_spec.SetField(entitlementusagecounter.FieldFeatureID, field.TypeString, value)
+	}
+	if value, ok := eucu.mutation.PeriodStart(); ok {
+		_spec.SetField(entitlementusagecounter.FieldPeriodStart, field.TypeTime, value)
+	}
+	if value, ok := eucu.mutation.Baseline(); ok {
+		_spec.SetField(entitlementusagecounter.FieldBaseline, field.TypeOther, value)
+	}
+	if value, ok := eucu.mutation.Reserved(); ok {
+		_spec.SetField(entitlementusagecounter.FieldReserved, field.TypeOther, value)
+	}
+	if value, ok := eucu.mutation.LimitReachedAt(); ok {
+		_spec.SetField(entitlementusagecounter.FieldLimitReachedAt, field.TypeTime, value)
+	}
+	if eucu.mutation.LimitReachedAtCleared() {
+		_spec.ClearField(entitlementusagecounter.FieldLimitReachedAt, field.TypeTime)
+	}
+	if value, ok := eucu.mutation.UpdatedAt(); ok {
+		_spec.SetField(entitlementusagecounter.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, eucu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{entitlementusagecounter.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	eucu.mutation.done = true
+	return n, nil
+}
+
+// EntitlementUsageCounterUpdateOne is the builder for updating a single EntitlementUsageCounter entity.
+type EntitlementUsageCounterUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EntitlementUsageCounterMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (eucuo *EntitlementUsageCounterUpdateOne) SetTenantID(s string) *EntitlementUsageCounterUpdateOne {
+	eucuo.mutation.SetTenantID(s)
+	return eucuo
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (eucuo *EntitlementUsageCounterUpdateOne) SetNillableTenantID(s *string) *EntitlementUsageCounterUpdateOne {
+	if s != nil {
+		eucuo.SetTenantID(*s)
+	}
+	return eucuo
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (eucuo *EntitlementUsageCounterUpdateOne) SetEnvironmentID(s string) *EntitlementUsageCounterUpdateOne {
+	eucuo.mutation.SetEnvironmentID(s)
+	return eucuo
+}
+
+// SetNillableEnvironmentID sets the "environment_id" field if the given value is not nil.
+func (eucuo *EntitlementUsageCounterUpdateOne) SetNillableEnvironmentID(s *string) *EntitlementUsageCounterUpdateOne {
+	if s != nil {
+		eucuo.SetEnvironmentID(*s)
+	}
+	return eucuo
+}
+
+// ClearEnvironmentID clears the value of the "environment_id" field.
+func (eucuo *EntitlementUsageCounterUpdateOne) ClearEnvironmentID() *EntitlementUsageCounterUpdateOne {
+	eucuo.mutation.ClearEnvironmentID()
+	return eucuo
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (eucuo *EntitlementUsageCounterUpdateOne) SetCustomerID(s string) *EntitlementUsageCounterUpdateOne {
+	eucuo.mutation.SetCustomerID(s)
+	return eucuo
+}
+
+// SetNillableCustomerID sets the "customer_id" field if the given value is not nil.
+func (eucuo *EntitlementUsageCounterUpdateOne) SetNillableCustomerID(s *string) *EntitlementUsageCounterUpdateOne {
+	if s != nil {
+		eucuo.SetCustomerID(*s)
+	}
+	return eucuo
+}
+
+// SetFeatureID sets the "feature_id" field.
+func (eucuo *EntitlementUsageCounterUpdateOne) SetFeatureID(s string) *EntitlementUsageCounterUpdateOne {
+	eucuo.mutation.SetFeatureID(s)
+	return eucuo
+}
+
+// SetNillableFeatureID sets the "feature_id" field if the given value is not nil.
+func (eucuo *EntitlementUsageCounterUpdateOne) SetNillableFeatureID(s *string) *EntitlementUsageCounterUpdateOne {
+	if s != nil {
+		eucuo.SetFeatureID(*s)
+	}
+	return eucuo
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (eucuo *EntitlementUsageCounterUpdateOne) SetPeriodStart(t time.Time) *EntitlementUsageCounterUpdateOne {
+	eucuo.mutation.SetPeriodStart(t)
+	return eucuo
+}
+
+// SetNillablePeriodStart sets the "period_start" field if the given value is not nil.
+func (eucuo *EntitlementUsageCounterUpdateOne) SetNillablePeriodStart(t *time.Time) *EntitlementUsageCounterUpdateOne {
+	if t != nil {
+		eucuo.SetPeriodStart(*t)
+	}
+	return eucuo
+}
+
+// SetBaseline sets the "baseline" field.
+func (eucuo *EntitlementUsageCounterUpdateOne) SetBaseline(d decimal.Decimal) *EntitlementUsageCounterUpdateOne {
+	eucuo.mutation.SetBaseline(d)
+	return eucuo
+}
+
+// SetNillableBaseline sets the "baseline" field if the given value is not nil.
+func (eucuo *EntitlementUsageCounterUpdateOne) SetNillableBaseline(d *decimal.Decimal) *EntitlementUsageCounterUpdateOne {
+	if d != nil {
+		eucuo.SetBaseline(*d)
+	}
+	return eucuo
+}
+
+// SetReserved sets the "reserved" field.
+func (eucuo *EntitlementUsageCounterUpdateOne) SetReserved(d decimal.Decimal) *EntitlementUsageCounterUpdateOne {
+	eucuo.mutation.SetReserved(d)
+	return eucuo
+}
+
+// SetNillableReserved sets the "reserved" field if the given value is not nil.
+func (eucuo *EntitlementUsageCounterUpdateOne) SetNillableReserved(d *decimal.Decimal) *EntitlementUsageCounterUpdateOne {
+	if d != nil {
+		eucuo.SetReserved(*d)
+	}
+	return eucuo
+}
+
+// SetLimitReachedAt sets the "limit_reached_at" field.
+func (eucuo *EntitlementUsageCounterUpdateOne) SetLimitReachedAt(t time.Time) *EntitlementUsageCounterUpdateOne {
+	eucuo.mutation.SetLimitReachedAt(t)
+	return eucuo
+}
+
+// SetNillableLimitReachedAt sets the "limit_reached_at" field if the given value is not nil.
+func (eucuo *EntitlementUsageCounterUpdateOne) SetNillableLimitReachedAt(t *time.Time) *EntitlementUsageCounterUpdateOne {
+	if t != nil {
+		eucuo.SetLimitReachedAt(*t)
+	}
+	return eucuo
+}
+
+// ClearLimitReachedAt clears the value of the "limit_reached_at" field.
+func (eucuo *EntitlementUsageCounterUpdateOne) ClearLimitReachedAt() *EntitlementUsageCounterUpdateOne {
+	eucuo.mutation.ClearLimitReachedAt()
+	return eucuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (eucuo *EntitlementUsageCounterUpdateOne) SetUpdatedAt(t time.Time) *EntitlementUsageCounterUpdateOne {
+	eucuo.mutation.SetUpdatedAt(t)
+	return eucuo
+}
+
+// Mutation returns the EntitlementUsageCounterMutation object of the builder.
+func (eucuo *EntitlementUsageCounterUpdateOne) Mutation() *EntitlementUsageCounterMutation {
+	return eucuo.mutation
+}
+
+// Where appends a list predicates to the EntitlementUsageCounterUpdate builder.
+func (eucuo *EntitlementUsageCounterUpdateOne) Where(ps ...predicate.EntitlementUsageCounter) *EntitlementUsageCounterUpdateOne {
+	eucuo.mutation.Where(ps...)
+	return eucuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (eucuo *EntitlementUsageCounterUpdateOne) Select(field string, fields ...string) *EntitlementUsageCounterUpdateOne {
+	eucuo.fields = append([]string{field}, fields...)
+	return eucuo
+}
+
+// Save executes the query and returns the updated EntitlementUsageCounter entity.
+func (eucuo *EntitlementUsageCounterUpdateOne) Save(ctx context.Context) (*EntitlementUsageCounter, error) {
+	eucuo.defaults()
+	return withHooks(ctx, eucuo.sqlSave, eucuo.mutation, eucuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eucuo *EntitlementUsageCounterUpdateOne) SaveX(ctx context.Context) *EntitlementUsageCounter {
+	node, err := eucuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (eucuo *EntitlementUsageCounterUpdateOne) Exec(ctx context.Context) error {
+	_, err := eucuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eucuo *EntitlementUsageCounterUpdateOne) ExecX(ctx context.Context) {
+	if err := eucuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (eucuo *EntitlementUsageCounterUpdateOne) defaults() {
+	if _, ok := eucuo.mutation.UpdatedAt(); !ok {
+		v := entitlementusagecounter.UpdateDefaultUpdatedAt()
+		eucuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (eucuo *EntitlementUsageCounterUpdateOne) check() error {
+	if v, ok := eucuo.mutation.TenantID(); ok {
+		if err := entitlementusagecounter.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "EntitlementUsageCounter.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := eucuo.mutation.CustomerID(); ok {
+		if err := entitlementusagecounter.CustomerIDValidator(v); err != nil {
+			return &ValidationError{Name: "customer_id", err: fmt.Errorf(`ent: validator failed for field "EntitlementUsageCounter.customer_id": %w`, err)}
+		}
+	}
+	if v, ok := eucuo.mutation.FeatureID(); ok {
+		if err := entitlementusagecounter.FeatureIDValidator(v); err != nil {
+			return &ValidationError{Name: "feature_id", err: fmt.Errorf(`ent: validator failed for field "EntitlementUsageCounter.feature_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (eucuo *EntitlementUsageCounterUpdateOne) sqlSave(ctx context.Context) (_node *EntitlementUsageCounter, err error) {
+	if err := eucuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(entitlementusagecounter.Table, entitlementusagecounter.Columns, sqlgraph.NewFieldSpec(entitlementusagecounter.FieldID, field.TypeInt))
+	id, ok := eucuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EntitlementUsageCounter.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := eucuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, entitlementusagecounter.FieldID)
+		for _, f := range fields {
+			if !entitlementusagecounter.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != entitlementusagecounter.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := eucuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := eucuo.mutation.TenantID(); ok {
+		_spec.SetField(entitlementusagecounter.FieldTenantID, field.TypeString, value)
+	}
+	if value, ok := eucuo.mutation.EnvironmentID(); ok {
+		_spec.SetField(entitlementusagecounter.FieldEnvironmentID, field.TypeString, value)
+	}
+	if eucuo.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(entitlementusagecounter.FieldEnvironmentID, field.TypeString)
+	}
+	if value, ok := eucuo.mutation.CustomerID(); ok {
+		_spec.SetField(entitlementusagecounter.FieldCustomerID, field.TypeString, value)
+	}
+	if value, ok := eucuo.mutation.FeatureID(); ok {
+		_spec.SetField(entitlementusagecounter.FieldFeatureID, field.TypeString, value)
+	}
+	if value, ok := eucuo.mutation.PeriodStart(); ok {
+		_spec.SetField(entitlementusagecounter.FieldPeriodStart, field.TypeTime, value)
+	}
+	if value, ok := eucuo.mutation.Baseline(); ok {
+		_spec.SetField(entitlementusagecounter.FieldBaseline, field.TypeOther, value)
+	}
+	if value, ok := eucuo.mutation.Reserved(); ok {
+		_spec.SetField(entitlementusagecounter.FieldReserved, field.TypeOther, value)
+	}
+	if value, ok := eucuo.mutation.LimitReachedAt(); ok {
+		_spec.SetField(entitlementusagecounter.FieldLimitReachedAt, field.TypeTime, value)
+	}
+	if eucuo.mutation.LimitReachedAtCleared() {
+		_spec.ClearField(entitlementusagecounter.FieldLimitReachedAt, field.TypeTime)
+	}
+	if value, ok := eucuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(entitlementusagecounter.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &EntitlementUsageCounter{config: eucuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, eucuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{entitlementusagecounter.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	eucuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,160 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/flexprice/flexprice/ent/eventcorrectionclaim"
+)
+
+// EventCorrectionClaim is the model entity for the EventCorrectionClaim schema.
+type EventCorrectionClaim struct {
+	config `json:"-"`
+	// ID of the ent.
+	// ID of the event correction
+	ID string `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID string `json:"tenant_id,omitempty"`
+	// EnvironmentID holds the value of the "environment_id" field.
+	EnvironmentID string `json:"environment_id,omitempty"`
+	// EventID holds the value of the "event_id" field.
+	EventID string `json:"event_id,omitempty"`
+	// Action holds the value of the "action" field.
+	Action string `json:"action,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy    string `json:"created_by,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EventCorrectionClaim) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case eventcorrectionclaim.FieldID, eventcorrectionclaim.FieldTenantID, eventcorrectionclaim.FieldEnvironmentID, eventcorrectionclaim.FieldEventID, eventcorrectionclaim.FieldAction, eventcorrectionclaim.FieldCreatedBy:
+			values[i] = new(sql.NullString)
+		case eventcorrectionclaim.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EventCorrectionClaim fields.
+func (ecc *EventCorrectionClaim) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case eventcorrectionclaim.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				ecc.ID = value.String
+			}
+		case eventcorrectionclaim.FieldTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				ecc.TenantID = value.String
+			}
+		case eventcorrectionclaim.FieldEnvironmentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field environment_id", values[i])
+			} else if value.Valid {
+				ecc.EnvironmentID = value.String
+			}
+		case eventcorrectionclaim.FieldEventID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event_id", values[i])
+			} else if value.Valid {
+				ecc.EventID = value.String
+			}
+		case eventcorrectionclaim.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				ecc.Action = value.String
+			}
+		case eventcorrectionclaim.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ecc.CreatedAt = value.Time
+			}
+		case eventcorrectionclaim.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				ecc.CreatedBy = value.String
+			}
+		default:
+			ecc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EventCorrectionClaim.
+// This includes values selected through modifiers, order, etc.
+func (ecc *EventCorrectionClaim) Value(name string) (ent.Value, error) {
+	return ecc.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this EventCorrectionClaim.
+// Note that you need to call EventCorrectionClaim.Unwrap() before calling this method if this EventCorrectionClaim
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ecc *EventCorrectionClaim) Update() *EventCorrectionClaimUpdateOne {
+	return NewEventCorrectionClaimClient(ecc.config).UpdateOne(ecc)
+}
+
+// Unwrap unwraps the EventCorrectionClaim entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ecc *EventCorrectionClaim) Unwrap() *EventCorrectionClaim {
+	_tx, ok := ecc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EventCorrectionClaim is not a transactional entity")
+	}
+	ecc.config.driver = _tx.drv
+	return ecc
+}
+
+// String implements the fmt.Stringer.
+func (ecc *EventCorrectionClaim) String() string {
+	var builder strings.Builder
+	builder.WriteString("EventCorrectionClaim(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ecc.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(ecc.TenantID)
+	builder.WriteString(", ")
+	builder.WriteString("environment_id=")
+	builder.WriteString(ecc.EnvironmentID)
+	builder.WriteString(", ")
+	builder.WriteString("event_id=")
+	builder.WriteString(ecc.EventID)
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(ecc.Action)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ecc.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(ecc.CreatedBy)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EventCorrectionClaims is a parsable slice of EventCorrectionClaim.
+type EventCorrectionClaims []*EventCorrectionClaim
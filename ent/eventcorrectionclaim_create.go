@@ -0,0 +1,303 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/eventcorrectionclaim"
+)
+
+// EventCorrectionClaimCreate is the builder for creating a EventCorrectionClaim entity.
+type EventCorrectionClaimCreate struct {
+	config
+	mutation *EventCorrectionClaimMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (eccc *EventCorrectionClaimCreate) SetTenantID(s string) *EventCorrectionClaimCreate {
+	eccc.mutation.SetTenantID(s)
+	return eccc
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (eccc *EventCorrectionClaimCreate) SetEnvironmentID(s string) *EventCorrectionClaimCreate {
+	eccc.mutation.SetEnvironmentID(s)
+	return eccc
+}
+
+// SetNillableEnvironmentID sets the "environment_id" field if the given value is not nil.
+func (eccc *EventCorrectionClaimCreate) SetNillableEnvironmentID(s *string) *EventCorrectionClaimCreate {
+	if s != nil {
+		eccc.SetEnvironmentID(*s)
+	}
+	return eccc
+}
+
+// SetEventID sets the "event_id" field.
+func (eccc *EventCorrectionClaimCreate) SetEventID(s string) *EventCorrectionClaimCreate {
+	eccc.mutation.SetEventID(s)
+	return eccc
+}
+
+// SetAction sets the "action" field.
+func (eccc *EventCorrectionClaimCreate) SetAction(s string) *EventCorrectionClaimCreate {
+	eccc.mutation.SetAction(s)
+	return eccc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (eccc *EventCorrectionClaimCreate) SetCreatedAt(t time.Time) *EventCorrectionClaimCreate {
+	eccc.mutation.SetCreatedAt(t)
+	return eccc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (eccc *EventCorrectionClaimCreate) SetNillableCreatedAt(t *time.Time) *EventCorrectionClaimCreate {
+	if t != nil {
+		eccc.SetCreatedAt(*t)
+	}
+	return eccc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (eccc *EventCorrectionClaimCreate) SetCreatedBy(s string) *EventCorrectionClaimCreate {
+	eccc.mutation.SetCreatedBy(s)
+	return eccc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (eccc *EventCorrectionClaimCreate) SetNillableCreatedBy(s *string) *EventCorrectionClaimCreate {
+	if s != nil {
+		eccc.SetCreatedBy(*s)
+	}
+	return eccc
+}
+
+// SetID sets the "id" field.
+func (eccc *EventCorrectionClaimCreate) SetID(s string) *EventCorrectionClaimCreate {
+	eccc.mutation.SetID(s)
+	return eccc
+}
+
+// Mutation returns the EventCorrectionClaimMutation object of the builder.
+func (eccc *EventCorrectionClaimCreate) Mutation() *EventCorrectionClaimMutation {
+	return eccc.mutation
+}
+
+// Save creates the EventCorrectionClaim in the database.
+func (eccc *EventCorrectionClaimCreate) Save(ctx context.Context) (*EventCorrectionClaim, error) {
+	eccc.defaults()
+	return withHooks(ctx, eccc.sqlSave, eccc.mutation, eccc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (eccc *EventCorrectionClaimCreate) SaveX(ctx context.Context) *EventCorrectionClaim {
+	v, err := eccc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (eccc *EventCorrectionClaimCreate) Exec(ctx context.Context) error {
+	_, err := eccc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eccc *EventCorrectionClaimCreate) ExecX(ctx context.Context) {
+	if err := eccc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (eccc *EventCorrectionClaimCreate) defaults() {
+	if _, ok := eccc.mutation.CreatedAt(); !ok {
+		v := eventcorrectionclaim.DefaultCreatedAt()
+		eccc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (eccc *EventCorrectionClaimCreate) check() error {
+	if _, ok := eccc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "EventCorrectionClaim.tenant_id"`)}
+	}
+	if v, ok := eccc.mutation.TenantID(); ok {
+		if err := eventcorrectionclaim.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "EventCorrectionClaim.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := eccc.mutation.EventID(); !ok {
+		return &ValidationError{Name: "event_id", err: errors.New(`ent: missing required field "EventCorrectionClaim.event_id"`)}
+	}
+	if v, ok := eccc.mutation.EventID(); ok {
+		if err := eventcorrectionclaim.EventIDValidator(v); err != nil {
+			return &ValidationError{Name: "event_id", err: fmt.Errorf(`ent: validator failed for field "EventCorrectionClaim.event_id": %w`, err)}
+		}
+	}
+	if _, ok := eccc.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "EventCorrectionClaim.action"`)}
+	}
+	if v, ok := eccc.mutation.Action(); ok {
+		if err := eventcorrectionclaim.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "EventCorrectionClaim.action": %w`, err)}
+		}
+	}
+	if _, ok := eccc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "EventCorrectionClaim.created_at"`)}
+	}
+	return nil
+}
+
+func (eccc *EventCorrectionClaimCreate) sqlSave(ctx context.Context) (*EventCorrectionClaim, error) {
+	if err := eccc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := eccc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, eccc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected EventCorrectionClaim.ID type: %T", _spec.ID.Value)
+		}
+	}
+	eccc.mutation.id = &_node.ID
+	eccc.mutation.done = true
+	return _node, nil
+}
+
+func (eccc *EventCorrectionClaimCreate) createSpec() (*EventCorrectionClaim, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EventCorrectionClaim{config: eccc.config}
+		_spec = sqlgraph.NewCreateSpec(eventcorrectionclaim.Table, sqlgraph.NewFieldSpec(eventcorrectionclaim.FieldID, field.TypeString))
+	)
+	if id, ok := eccc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := eccc.mutation.TenantID(); ok {
+		_spec.SetField(eventcorrectionclaim.FieldTenantID, field.TypeString, value)
+		_node.TenantID = value
+	}
+	if value, ok := eccc.mutation.EnvironmentID(); ok {
+		_spec.SetField(eventcorrectionclaim.FieldEnvironmentID, field.TypeString, value)
+		_node.EnvironmentID = value
+	}
+	if value, ok := eccc.mutation.EventID(); ok {
+		_spec.SetField(eventcorrectionclaim.FieldEventID, field.TypeString, value)
+		_node.EventID = value
+	}
+	if value, ok := eccc.mutation.Action(); ok {
+		_spec.SetField(eventcorrectionclaim.FieldAction, field.TypeString, value)
+		_node.Action = value
+	}
+	if value, ok := eccc.mutation.CreatedAt(); ok {
+		_spec.SetField(eventcorrectionclaim.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := eccc.mutation.CreatedBy(); ok {
+		_spec.SetField(eventcorrectionclaim.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	return _node, _spec
+}
+
+// EventCorrectionClaimCreateBulk is the builder for creating many EventCorrectionClaim entities in bulk.
+type EventCorrectionClaimCreateBulk struct {
+	config
+	err      error
+	builders []*EventCorrectionClaimCreate
+}
+
+// Save creates the EventCorrectionClaim entities in the database.
+func (ecccb *EventCorrectionClaimCreateBulk) Save(ctx context.Context) ([]*EventCorrectionClaim, error) {
+	if ecccb.err != nil {
+		return nil, ecccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ecccb.builders))
+	nodes := make([]*EventCorrectionClaim, len(ecccb.builders))
+	mutators := make([]Mutator, len(ecccb.builders))
+	for i := range ecccb.builders {
+		func(i int, root context.Context) {
+			builder := ecccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EventCorrectionClaimMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ecccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ecccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ecccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ecccb *EventCorrectionClaimCreateBulk) SaveX(ctx context.Context) []*EventCorrectionClaim {
+	v, err := ecccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ecccb *EventCorrectionClaimCreateBulk) Exec(ctx context.Context) error {
+	_, err := ecccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ecccb *EventCorrectionClaimCreateBulk) ExecX(ctx context.Context) {
+	if err := ecccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/eventcorrectionclaim"
+	"github.com/flexprice/flexprice/ent/predicate"
+)
+
+// EventCorrectionClaimDelete is the builder for deleting a EventCorrectionClaim entity.
+type EventCorrectionClaimDelete struct {
+	config
+	hooks    []Hook
+	mutation *EventCorrectionClaimMutation
+}
+
+// Where appends a list predicates to the EventCorrectionClaimDelete builder.
+func (eccd *EventCorrectionClaimDelete) Where(ps ...predicate.EventCorrectionClaim) *EventCorrectionClaimDelete {
+	eccd.mutation.Where(ps...)
+	return eccd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (eccd *EventCorrectionClaimDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, eccd.sqlExec, eccd.mutation, eccd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eccd *EventCorrectionClaimDelete) ExecX(ctx context.Context) int {
+	n, err := eccd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (eccd *EventCorrectionClaimDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(eventcorrectionclaim.Table, sqlgraph.NewFieldSpec(eventcorrectionclaim.FieldID, field.TypeString))
+	if ps := eccd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, eccd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	eccd.mutation.done = true
+	return affected, err
+}
+
+// EventCorrectionClaimDeleteOne is the builder for deleting a single EventCorrectionClaim entity.
+type EventCorrectionClaimDeleteOne struct {
+	eccd *EventCorrectionClaimDelete
+}
+
+// Where appends a list predicates to the EventCorrectionClaimDelete builder.
+func (eccdo *EventCorrectionClaimDeleteOne) Where(ps ...predicate.EventCorrectionClaim) *EventCorrectionClaimDeleteOne {
+	eccdo.eccd.mutation.Where(ps...)
+	return eccdo
+}
+
+// Exec executes the deletion query.
+func (eccdo *EventCorrectionClaimDeleteOne) Exec(ctx context.Context) error {
+	n, err := eccdo.eccd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{eventcorrectionclaim.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eccdo *EventCorrectionClaimDeleteOne) ExecX(ctx context.Context) {
+	if err := eccdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/eventcorrectionclaim"
+	"github.com/flexprice/flexprice/ent/predicate"
+)
+
+// EventCorrectionClaimQuery is the builder for querying EventCorrectionClaim entities.
+type EventCorrectionClaimQuery struct {
+	config
+	ctx        *QueryContext
+	order      []eventcorrectionclaim.OrderOption
+	inters     []Interceptor
+	predicates []predicate.EventCorrectionClaim
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the EventCorrectionClaimQuery builder.
+func (eccq *EventCorrectionClaimQuery) Where(ps ...predicate.EventCorrectionClaim) *EventCorrectionClaimQuery {
+	eccq.predicates = append(eccq.predicates, ps...)
+	return eccq
+}
+
+// Limit the number of records to be returned by this query.
+func (eccq *EventCorrectionClaimQuery) Limit(limit int) *EventCorrectionClaimQuery {
+	eccq.ctx.Limit = &limit
+	return eccq
+}
+
+// Offset to start from.
+func (eccq *EventCorrectionClaimQuery) Offset(offset int) *EventCorrectionClaimQuery {
+	eccq.ctx.Offset = &offset
+	return eccq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (eccq *EventCorrectionClaimQuery) Unique(unique bool) *EventCorrectionClaimQuery {
+	eccq.ctx.Unique = &unique
+	return eccq
+}
+
+// Order specifies how the records should be ordered.
+func (eccq *EventCorrectionClaimQuery) Order(o ...eventcorrectionclaim.OrderOption) *EventCorrectionClaimQuery {
+	eccq.order = append(eccq.order, o...)
+	return eccq
+}
+
+// First returns the first EventCorrectionClaim entity from the query.
+// Returns a *NotFoundError when no EventCorrectionClaim was found.
+func (eccq *EventCorrectionClaimQuery) First(ctx context.Context) (*EventCorrectionClaim, error) {
+	nodes, err := eccq.Limit(1).All(setContextOp(ctx, eccq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{eventcorrectionclaim.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (eccq *EventCorrectionClaimQuery) FirstX(ctx context.Context) *EventCorrectionClaim {
+	node, err := eccq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first EventCorrectionClaim ID from the query.
+// Returns a *NotFoundError when no EventCorrectionClaim ID was found.
+func (eccq *EventCorrectionClaimQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = eccq.Limit(1).IDs(setContextOp(ctx, eccq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{eventcorrectionclaim.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (eccq *EventCorrectionClaimQuery) FirstIDX(ctx context.Context) string {
+	id, err := eccq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single EventCorrectionClaim entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one EventCorrectionClaim entity is found.
+// Returns a *NotFoundError when no EventCorrectionClaim entities are found.
+func (eccq *EventCorrectionClaimQuery) Only(ctx context.Context) (*EventCorrectionClaim, error) {
+	nodes, err := eccq.Limit(2).All(setContextOp(ctx, eccq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{eventcorrectionclaim.Label}
+	default:
+		return nil, &NotSingularError{eventcorrectionclaim.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (eccq *EventCorrectionClaimQuery) OnlyX(ctx context.Context) *EventCorrectionClaim {
+	node, err := eccq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only EventCorrectionClaim ID in the query.
+// Returns a *NotSingularError when more than one EventCorrectionClaim ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (eccq *EventCorrectionClaimQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = eccq.Limit(2).IDs(setContextOp(ctx, eccq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{eventcorrectionclaim.Label}
+	default:
+		err = &NotSingularError{eventcorrectionclaim.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (eccq *EventCorrectionClaimQuery) OnlyIDX(ctx context.Context) string {
+	id, err := eccq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of EventCorrectionClaims.
+func (eccq *EventCorrectionClaimQuery) All(ctx context.Context) ([]*EventCorrectionClaim, error) {
+	ctx = setContextOp(ctx, eccq.ctx, ent.OpQueryAll)
+	if err := eccq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*EventCorrectionClaim, *EventCorrectionClaimQuery]()
+	return withInterceptors[[]*EventCorrectionClaim](ctx, eccq, qr, eccq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (eccq *EventCorrectionClaimQuery) AllX(ctx context.Context) []*EventCorrectionClaim {
+	nodes, err := eccq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of EventCorrectionClaim IDs.
+func (eccq *EventCorrectionClaimQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if eccq.ctx.Unique == nil && eccq.path != nil {
+		eccq.Unique(true)
+	}
+	ctx = setContextOp(ctx, eccq.ctx, ent.OpQueryIDs)
+	if err = eccq.Select(eventcorrectionclaim.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (eccq *EventCorrectionClaimQuery) IDsX(ctx context.Context) []string {
+	ids, err := eccq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (eccq *EventCorrectionClaimQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, eccq.ctx, ent.OpQueryCount)
+	if err := eccq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, eccq, querierCount[*EventCorrectionClaimQuery](), eccq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (eccq *EventCorrectionClaimQuery) CountX(ctx context.Context) int {
+	count, err := eccq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (eccq *EventCorrectionClaimQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, eccq.ctx, ent.OpQueryExist)
+	switch _, err := eccq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (eccq *EventCorrectionClaimQuery) ExistX(ctx context.Context) bool {
+	exist, err := eccq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the EventCorrectionClaimQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (eccq *EventCorrectionClaimQuery) Clone() *EventCorrectionClaimQuery {
+	if eccq == nil {
+		return nil
+	}
+	return &EventCorrectionClaimQuery{
+		config:     eccq.config,
+		ctx:        eccq.ctx.Clone(),
+		order:      append([]eventcorrectionclaim.OrderOption{}, eccq.order...),
+		inters:     append([]Interceptor{}, eccq.inters...),
+		predicates: append([]predicate.EventCorrectionClaim{}, eccq.predicates...),
+		// clone intermediate query.
+		sql:  eccq.sql.Clone(),
+		path: eccq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID string `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.EventCorrectionClaim.Query().
+//		GroupBy(eventcorrectionclaim.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (eccq *EventCorrectionClaimQuery) GroupBy(field string, fields ...string) *EventCorrectionClaimGroupBy {
+	eccq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &EventCorrectionClaimGroupBy{build: eccq}
+	grbuild.flds = &eccq.ctx.Fields
+	grbuild.label = eventcorrectionclaim.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID string `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.EventCorrectionClaim.Query().
+//		Select(eventcorrectionclaim.FieldTenantID).
+//		Scan(ctx, &v)
+func (eccq *EventCorrectionClaimQuery) Select(fields ...string) *EventCorrectionClaimSelect {
+	eccq.ctx.Fields = append(eccq.ctx.Fields, fields...)
+	sbuild := &EventCorrectionClaimSelect{EventCorrectionClaimQuery: eccq}
+	sbuild.label = eventcorrectionclaim.Label
+	sbuild.flds, sbuild.scan = &eccq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a EventCorrectionClaimSelect configured with the given aggregations.
+func (eccq *EventCorrectionClaimQuery) Aggregate(fns ...AggregateFunc) *EventCorrectionClaimSelect {
+	return eccq.Select().Aggregate(fns...)
+}
+
+func (eccq *EventCorrectionClaimQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range eccq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, eccq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range eccq.ctx.Fields {
+		if !eventcorrectionclaim.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if eccq.path != nil {
+		prev, err := eccq.path(ctx)
+		if err != nil {
+			return err
+		}
+		eccq.sql = prev
+	}
+	return nil
+}
+
+func (eccq *EventCorrectionClaimQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*EventCorrectionClaim, error) {
+	var (
+		nodes = []*EventCorrectionClaim{}
+		_spec = eccq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*EventCorrectionClaim).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &EventCorrectionClaim{config: eccq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, eccq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (eccq *EventCorrectionClaimQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := eccq.querySpec()
+	_spec.Node.Columns = eccq.ctx.Fields
+	if len(eccq.ctx.Fields) > 0 {
+		_spec.Unique = eccq.ctx.Unique != nil && *eccq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, eccq.driver, _spec)
+}
+
+func (eccq *EventCorrectionClaimQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(eventcorrectionclaim.Table, eventcorrectionclaim.Columns, sqlgraph.NewFieldSpec(eventcorrectionclaim.FieldID, field.TypeString))
+	_spec.From = eccq.sql
+	if unique := eccq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if eccq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := eccq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, eventcorrectionclaim.FieldID)
+		for i := range fields {
+			if fields[i] != eventcorrectionclaim.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := eccq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := eccq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := eccq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := eccq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (eccq *EventCorrectionClaimQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(eccq.driver.Dialect())
+	t1 := builder.Table(eventcorrectionclaim.Table)
+	columns := eccq.ctx.Fields
+	if len(columns) == 0 {
+		columns = eventcorrectionclaim.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if eccq.sql != nil {
+		selector = eccq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if eccq.ctx.Unique != nil && *eccq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range eccq.predicates {
+		p(selector)
+	}
+	for _, p := range eccq.order {
+		p(selector)
+	}
+	if offset := eccq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := eccq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// EventCorrectionClaimGroupBy is the group-by builder for EventCorrectionClaim entities.
+type EventCorrectionClaimGroupBy struct {
+	selector
+	build *EventCorrectionClaimQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (eccgb *EventCorrectionClaimGroupBy) Aggregate(fns ...AggregateFunc) *EventCorrectionClaimGroupBy {
+	eccgb.fns = append(eccgb.fns, fns...)
+	return eccgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (eccgb *EventCorrectionClaimGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, eccgb.build.ctx, ent.OpQueryGroupBy)
+	if err := eccgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*EventCorrectionClaimQuery, *EventCorrectionClaimGroupBy](ctx, eccgb.build, eccgb, eccgb.build.inters, v)
+}
+
+func (eccgb *EventCorrectionClaimGroupBy) sqlScan(ctx context.Context, root *EventCorrectionClaimQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(eccgb.fns))
+	for _, fn := range eccgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*eccgb.flds)+len(eccgb.fns))
+		for _, f := range *eccgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*eccgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := eccgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// EventCorrectionClaimSelect is the builder for selecting fields of EventCorrectionClaim entities.
+type EventCorrectionClaimSelect struct {
+	*EventCorrectionClaimQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (eccs *EventCorrectionClaimSelect) Aggregate(fns ...AggregateFunc) *EventCorrectionClaimSelect {
+	eccs.fns = append(eccs.fns, fns...)
+	return eccs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (eccs *EventCorrectionClaimSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, eccs.ctx, ent.OpQuerySelect)
+	if err := eccs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*EventCorrectionClaimQuery, *EventCorrectionClaimSelect](ctx, eccs.EventCorrectionClaimQuery, eccs, eccs.inters, v)
+}
+
+func (eccs *EventCorrectionClaimSelect) sqlScan(ctx context.Context, root *EventCorrectionClaimQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(eccs.fns))
+	for _, fn := range eccs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*eccs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := eccs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,187 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/eventcorrectionclaim"
+	"github.com/flexprice/flexprice/ent/predicate"
+)
+
+// EventCorrectionClaimUpdate is the builder for updating EventCorrectionClaim entities.
+type EventCorrectionClaimUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EventCorrectionClaimMutation
+}
+
+// Where appends a list predicates to the EventCorrectionClaimUpdate builder.
+func (eccu *EventCorrectionClaimUpdate) Where(ps ...predicate.EventCorrectionClaim) *EventCorrectionClaimUpdate {
+	eccu.mutation.Where(ps...)
+	return eccu
+}
+
+// Mutation returns the EventCorrectionClaimMutation object of the builder.
+func (eccu *EventCorrectionClaimUpdate) Mutation() *EventCorrectionClaimMutation {
+	return eccu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (eccu *EventCorrectionClaimUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, eccu.sqlSave, eccu.mutation, eccu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eccu *EventCorrectionClaimUpdate) SaveX(ctx context.Context) int {
+	affected, err := eccu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (eccu *EventCorrectionClaimUpdate) Exec(ctx context.Context) error {
+	_, err := eccu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eccu *EventCorrectionClaimUpdate) ExecX(ctx context.Context) {
+	if err := eccu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (eccu *EventCorrectionClaimUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(eventcorrectionclaim.Table, eventcorrectionclaim.Columns, sqlgraph.NewFieldSpec(eventcorrectionclaim.FieldID, field.TypeString))
+	if ps := eccu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if eccu.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(eventcorrectionclaim.FieldEnvironmentID, field.TypeString)
+	}
+	if eccu.mutation.CreatedByCleared() {
+		_spec.ClearField(eventcorrectionclaim.FieldCreatedBy, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, eccu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{eventcorrectionclaim.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	eccu.mutation.done = true
+	return n, nil
+}
+
+// EventCorrectionClaimUpdateOne is the builder for updating a single EventCorrectionClaim entity.
+type EventCorrectionClaimUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EventCorrectionClaimMutation
+}
+
+// Mutation returns the EventCorrectionClaimMutation object of the builder.
+func (eccuo *EventCorrectionClaimUpdateOne) Mutation() *EventCorrectionClaimMutation {
+	return eccuo.mutation
+}
+
+// Where appends a list predicates to the EventCorrectionClaimUpdate builder.
+func (eccuo *EventCorrectionClaimUpdateOne) Where(ps ...predicate.EventCorrectionClaim) *EventCorrectionClaimUpdateOne {
+	eccuo.mutation.Where(ps...)
+	return eccuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (eccuo *EventCorrectionClaimUpdateOne) Select(field string, fields ...string) *EventCorrectionClaimUpdateOne {
+	eccuo.fields = append([]string{field}, fields...)
+	return eccuo
+}
+
+// Save executes the query and returns the updated EventCorrectionClaim entity.
+func (eccuo *EventCorrectionClaimUpdateOne) Save(ctx context.Context) (*EventCorrectionClaim, error) {
+	return withHooks(ctx, eccuo.sqlSave, eccuo.mutation, eccuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eccuo *EventCorrectionClaimUpdateOne) SaveX(ctx context.Context) *EventCorrectionClaim {
+	node, err := eccuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (eccuo *EventCorrectionClaimUpdateOne) Exec(ctx context.Context) error {
+	_, err := eccuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eccuo *EventCorrectionClaimUpdateOne) ExecX(ctx context.Context) {
+	if err := eccuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (eccuo *EventCorrectionClaimUpdateOne) sqlSave(ctx context.Context) (_node *EventCorrectionClaim, err error) {
+	_spec := sqlgraph.NewUpdateSpec(eventcorrectionclaim.Table, eventcorrectionclaim.Columns, sqlgraph.NewFieldSpec(eventcorrectionclaim.FieldID, field.TypeString))
+	id, ok := eccuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EventCorrectionClaim.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := eccuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, eventcorrectionclaim.FieldID)
+		for _, f := range fields {
+			if !eventcorrectionclaim.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != eventcorrectionclaim.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := eccuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if eccuo.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(eventcorrectionclaim.FieldEnvironmentID, field.TypeString)
+	}
+	if eccuo.mutation.CreatedByCleared() {
+		_spec.ClearField(eventcorrectionclaim.FieldCreatedBy, field.TypeString)
+	}
+	_node = &EventCorrectionClaim{config: eccuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, eccuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{eventcorrectionclaim.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	eccuo.mutation.done = true
+	return _node, nil
+}
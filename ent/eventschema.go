@@ -0,0 +1,220 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/flexprice/flexprice/ent/eventschema"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// EventSchema is the model entity for the EventSchema schema.
+type EventSchema struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID string `json:"tenant_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// EnvironmentID holds the value of the "environment_id" field.
+	EnvironmentID string `json:"environment_id,omitempty"`
+	// EventName holds the value of the "event_name" field.
+	EventName string `json:"event_name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// ValidationMode holds the value of the "validation_mode" field.
+	ValidationMode string `json:"validation_mode,omitempty"`
+	// Properties of the event with their types, requiredness and allowed values
+	Properties   []types.EventPropertySchema `json:"properties,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EventSchema) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case eventschema.FieldProperties:
+			values[i] = new([]byte)
+		case eventschema.FieldID, eventschema.FieldTenantID, eventschema.FieldStatus, eventschema.FieldCreatedBy, eventschema.FieldUpdatedBy, eventschema.FieldEnvironmentID, eventschema.FieldEventName, eventschema.FieldDescription, eventschema.FieldValidationMode:
+			values[i] = new(sql.NullString)
+		case eventschema.FieldCreatedAt, eventschema.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EventSchema fields.
+func (es *EventSchema) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case eventschema.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				es.ID = value.String
+			}
+		case eventschema.FieldTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				es.TenantID = value.String
+			}
+		case eventschema.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				es.Status = value.String
+			}
+		case eventschema.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				es.CreatedAt = value.Time
+			}
+		case eventschema.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				es.UpdatedAt = value.Time
+			}
+		case eventschema.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				es.CreatedBy = value.String
+			}
+		case eventschema.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				es.UpdatedBy = value.String
+			}
+		case eventschema.FieldEnvironmentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field environment_id", values[i])
+			} else if value.Valid {
+				es.EnvironmentID = value.String
+			}
+		case eventschema.FieldEventName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event_name", values[i])
+			} else if value.Valid {
+				es.EventName = value.String
+			}
+		case eventschema.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				es.Description = value.String
+			}
+		case eventschema.FieldValidationMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field validation_mode", values[i])
+			} else if value.Valid {
+				es.ValidationMode = value.String
+			}
+		case eventschema.FieldProperties:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field properties", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &es.Properties); err != nil {
+					return fmt.Errorf("unmarshal field properties: %w", err)
+				}
+			}
+		default:
+			es.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EventSchema.
+// This includes values selected through modifiers, order, etc.
+func (es *EventSchema) Value(name string) (ent.Value, error) {
+	return es.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this EventSchema.
+// Note that you need to call EventSchema.Unwrap() before calling this method if this EventSchema
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (es *EventSchema) Update() *EventSchemaUpdateOne {
+	return NewEventSchemaClient(es.config).UpdateOne(es)
+}
+
+// Unwrap unwraps the EventSchema entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (es *EventSchema) Unwrap() *EventSchema {
+	_tx, ok := es.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EventSchema is not a transactional entity")
+	}
+	es.config.driver = _tx.drv
+	return es
+}
+
+// String implements the fmt.Stringer.
+func (es *EventSchema) String() string {
+	var builder strings.Builder
+	builder.WriteString("EventSchema(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", es.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(es.TenantID)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(es.Status)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(es.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(es.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(es.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(es.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("environment_id=")
+	builder.WriteString(es.EnvironmentID)
+	builder.WriteString(", ")
+	builder.WriteString("event_name=")
+	builder.WriteString(es.EventName)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(es.Description)
+	builder.WriteString(", ")
+	builder.WriteString("validation_mode=")
+	builder.WriteString(es.ValidationMode)
+	builder.WriteString(", ")
+	builder.WriteString("properties=")
+	builder.WriteString(fmt.Sprintf("%v", es.Properties))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EventSchemas is a parsable slice of EventSchema.
+type EventSchemas []*EventSchema
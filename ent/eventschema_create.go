@@ -0,0 +1,411 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/eventschema"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// EventSchemaCreate is the builder for creating a EventSchema entity.
+type EventSchemaCreate struct {
+	config
+	mutation *EventSchemaMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (esc *EventSchemaCreate) SetTenantID(s string) *EventSchemaCreate {
+	esc.mutation.SetTenantID(s)
+	return esc
+}
+
+// SetStatus sets the "status" field.
+func (esc *EventSchemaCreate) SetStatus(s string) *EventSchemaCreate {
+	esc.mutation.SetStatus(s)
+	return esc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (esc *EventSchemaCreate) SetNillableStatus(s *string) *EventSchemaCreate {
+	if s != nil {
+		esc.SetStatus(*s)
+	}
+	return esc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (esc *EventSchemaCreate) SetCreatedAt(t time.Time) *EventSchemaCreate {
+	esc.mutation.SetCreatedAt(t)
+	return esc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (esc *EventSchemaCreate) SetNillableCreatedAt(t *time.Time) *EventSchemaCreate {
+	if t != nil {
+		esc.SetCreatedAt(*t)
+	}
+	return esc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (esc *EventSchemaCreate) SetUpdatedAt(t time.Time) *EventSchemaCreate {
+	esc.mutation.SetUpdatedAt(t)
+	return esc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (esc *EventSchemaCreate) SetNillableUpdatedAt(t *time.Time) *EventSchemaCreate {
+	if t != nil {
+		esc.SetUpdatedAt(*t)
+	}
+	return esc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (esc *EventSchemaCreate) SetCreatedBy(s string) *EventSchemaCreate {
+	esc.mutation.SetCreatedBy(s)
+	return esc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (esc *EventSchemaCreate) SetNillableCreatedBy(s *string) *EventSchemaCreate {
+	if s != nil {
+		esc.SetCreatedBy(*s)
+	}
+	return esc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (esc *EventSchemaCreate) SetUpdatedBy(s string) *EventSchemaCreate {
+	esc.mutation.SetUpdatedBy(s)
+	return esc
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (esc *EventSchemaCreate) SetNillableUpdatedBy(s *string) *EventSchemaCreate {
+	if s != nil {
+		esc.SetUpdatedBy(*s)
+	}
+	return esc
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (esc *EventSchemaCreate) SetEnvironmentID(s string) *EventSchemaCreate {
+	esc.mutation.SetEnvironmentID(s)
+	return esc
+}
+
+// SetNillableEnvironmentID sets the "environment_id" field if the given value is not nil.
+func (esc *EventSchemaCreate) SetNillableEnvironmentID(s *string) *EventSchemaCreate {
+	if s != nil {
+		esc.SetEnvironmentID(*s)
+	}
+	return esc
+}
+
+// SetEventName sets the "event_name" field.
+func (esc *EventSchemaCreate) SetEventName(s string) *EventSchemaCreate {
+	esc.mutation.SetEventName(s)
+	return esc
+}
+
+// SetDescription sets the "description" field.
+func (esc *EventSchemaCreate) SetDescription(s string) *EventSchemaCreate {
+	esc.mutation.SetDescription(s)
+	return esc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (esc *EventSchemaCreate) SetNillableDescription(s *string) *EventSchemaCreate {
+	if s != nil {
+		esc.SetDescription(*s)
+	}
+	return esc
+}
+
+// SetValidationMode sets the "validation_mode" field.
+func (esc *EventSchemaCreate) SetValidationMode(s string) *EventSchemaCreate {
+	esc.mutation.SetValidationMode(s)
+	return esc
+}
+
+// SetNillableValidationMode sets the "validation_mode" field if the given value is not nil.
+func (esc *EventSchemaCreate) SetNillableValidationMode(s *string) *EventSchemaCreate {
+	if s != nil {
+		esc.SetValidationMode(*s)
+	}
+	return esc
+}
+
+// SetProperties sets the "properties" field.
+func (esc *EventSchemaCreate) SetProperties(tps []types.EventPropertySchema) *EventSchemaCreate {
+	esc.mutation.SetProperties(tps)
+	return esc
+}
+
+// SetID sets the "id" field.
+func (esc *EventSchemaCreate) SetID(s string) *EventSchemaCreate {
+	esc.mutation.SetID(s)
+	return esc
+}
+
+// Mutation returns the EventSchemaMutation object of the builder.
+func (esc *EventSchemaCreate) Mutation() *EventSchemaMutation {
+	return esc.mutation
+}
+
+// Save creates the EventSchema in the database.
+func (esc *EventSchemaCreate) Save(ctx context.Context) (*EventSchema, error) {
+	esc.defaults()
+	return withHooks(ctx, esc.sqlSave, esc.mutation, esc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (esc *EventSchemaCreate) SaveX(ctx context.Context) *EventSchema {
+	v, err := esc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (esc *EventSchemaCreate) Exec(ctx context.Context) error {
+	_, err := esc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (esc *EventSchemaCreate) ExecX(ctx context.Context) {
+	if err := esc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (esc *EventSchemaCreate) defaults() {
+	if _, ok := esc.mutation.Status(); !ok {
+		v := eventschema.DefaultStatus
+		esc.mutation.SetStatus(v)
+	}
+	if _, ok := esc.mutation.CreatedAt(); !ok {
+		v := eventschema.DefaultCreatedAt()
+		esc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := esc.mutation.UpdatedAt(); !ok {
+		v := eventschema.DefaultUpdatedAt()
+		esc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := esc.mutation.EnvironmentID(); !ok {
+		v := eventschema.DefaultEnvironmentID
+		esc.mutation.SetEnvironmentID(v)
+	}
+	if _, ok := esc.mutation.ValidationMode(); !ok {
+		v := eventschema.DefaultValidationMode
+		esc.mutation.SetValidationMode(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (esc *EventSchemaCreate) check() error {
+	if _, ok := esc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "EventSchema.tenant_id"`)}
+	}
+	if v, ok := esc.mutation.TenantID(); ok {
+		if err := eventschema.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "EventSchema.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := esc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "EventSchema.status"`)}
+	}
+	if _, ok := esc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "EventSchema.created_at"`)}
+	}
+	if _, ok := esc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "EventSchema.updated_at"`)}
+	}
+	if _, ok := esc.mutation.EventName(); !ok {
+		return &ValidationError{Name: "event_name", err: errors.New(`ent: missing required field "EventSchema.event_name"`)}
+	}
+	if v, ok := esc.mutation.EventName(); ok {
+		if err := eventschema.EventNameValidator(v); err != nil {
+			return &ValidationError{Name: "event_name", err: fmt.Errorf(`ent: validator failed for field "EventSchema.event_name": %w`, err)}
+		}
+	}
+	if _, ok := esc.mutation.ValidationMode(); !ok {
+		return &ValidationError{Name: "validation_mode", err: errors.New(`ent: missing required field "EventSchema.validation_mode"`)}
+	}
+	return nil
+}
+
+func (esc *EventSchemaCreate) sqlSave(ctx context.Context) (*EventSchema, error) {
+	if err := esc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := esc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, esc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected EventSchema.ID type: %T", _spec.ID.Value)
+		}
+	}
+	esc.mutation.id = &_node.ID
+	esc.mutation.done = true
+	return _node, nil
+}
+
+func (esc *EventSchemaCreate) createSpec() (*EventSchema, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EventSchema{config: esc.config}
+		_spec = sqlgraph.NewCreateSpec(eventschema.Table, sqlgraph.NewFieldSpec(eventschema.FieldID, field.TypeString))
+	)
+	if id, ok := esc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := esc.mutation.TenantID(); ok {
+		_spec.SetField(eventschema.FieldTenantID, field.TypeString, value)
+		_node.TenantID = value
+	}
+	if value, ok := esc.mutation.Status(); ok {
+		_spec.SetField(eventschema.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := esc.mutation.CreatedAt(); ok {
+		_spec.SetField(eventschema.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := esc.mutation.UpdatedAt(); ok {
+		_spec.SetField(eventschema.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := esc.mutation.CreatedBy(); ok {
+		_spec.SetField(eventschema.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := esc.mutation.UpdatedBy(); ok {
+		_spec.SetField(eventschema.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := esc.mutation.EnvironmentID(); ok {
+		_spec.SetField(eventschema.FieldEnvironmentID, field.TypeString, value)
+		_node.EnvironmentID = value
+	}
+	if value, ok := esc.mutation.EventName(); ok {
+		_spec.SetField(eventschema.FieldEventName, field.TypeString, value)
+		_node.EventName = value
+	}
+	if value, ok := esc.mutation.Description(); ok {
+		_spec.SetField(eventschema.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := esc.mutation.ValidationMode(); ok {
+		_spec.SetField(eventschema.FieldValidationMode, field.TypeString, value)
+		_node.ValidationMode = value
+	}
+	if value, ok := esc.mutation.Properties(); ok {
+		_spec.SetField(eventschema.FieldProperties, field.TypeJSON, value)
+		_node.Properties = value
+	}
+	return _node, _spec
+}
+
+// EventSchemaCreateBulk is the builder for creating many EventSchema entities in bulk.
+type EventSchemaCreateBulk struct {
+	config
+	err      error
+	builders []*EventSchemaCreate
+}
+
+// Save creates the EventSchema entities in the database.
+func (escb *EventSchemaCreateBulk) Save(ctx context.Context) ([]*EventSchema, error) {
+	if escb.err != nil {
+		return nil, escb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(escb.builders))
+	nodes := make([]*EventSchema, len(escb.builders))
+	mutators := make([]Mutator, len(escb.builders))
+	for i := range escb.builders {
+		func(i int, root context.Context) {
+			builder := escb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EventSchemaMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, escb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, escb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, escb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (escb *EventSchemaCreateBulk) SaveX(ctx context.Context) []*EventSchema {
+	v, err := escb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (escb *EventSchemaCreateBulk) Exec(ctx context.Context) error {
+	_, err := escb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (escb *EventSchemaCreateBulk) ExecX(ctx context.Context) {
+	if err := escb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
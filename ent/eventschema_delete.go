@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/eventschema"
+	"github.com/flexprice/flexprice/ent/predicate"
+)
+
+// EventSchemaDelete is the builder for deleting a EventSchema entity.
+type EventSchemaDelete struct {
+	config
+	hooks    []Hook
+	mutation *EventSchemaMutation
+}
+
+// Where appends a list predicates to the EventSchemaDelete builder.
+func (esd *EventSchemaDelete) Where(ps ...predicate.EventSchema) *EventSchemaDelete {
+	esd.mutation.Where(ps...)
+	return esd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (esd *EventSchemaDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, esd.sqlExec, esd.mutation, esd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (esd *EventSchemaDelete) ExecX(ctx context.Context) int {
+	n, err := esd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (esd *EventSchemaDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(eventschema.Table, sqlgraph.NewFieldSpec(eventschema.FieldID, field.TypeString))
+	if ps := esd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, esd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	esd.mutation.done = true
+	return affected, err
+}
+
+// EventSchemaDeleteOne is the builder for deleting a single EventSchema entity.
+type EventSchemaDeleteOne struct {
+	esd *EventSchemaDelete
+}
+
+// Where appends a list predicates to the EventSchemaDelete builder.
+func (esdo *EventSchemaDeleteOne) Where(ps ...predicate.EventSchema) *EventSchemaDeleteOne {
+	esdo.esd.mutation.Where(ps...)
+	return esdo
+}
+
+// Exec executes the deletion query.
+func (esdo *EventSchemaDeleteOne) Exec(ctx context.Context) error {
+	n, err := esdo.esd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{eventschema.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (esdo *EventSchemaDeleteOne) ExecX(ctx context.Context) {
+	if err := esdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
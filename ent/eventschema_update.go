@@ -0,0 +1,456 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/eventschema"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// EventSchemaUpdate is the builder for updating EventSchema entities.
+type EventSchemaUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EventSchemaMutation
+}
+
+// Where appends a list predicates to the EventSchemaUpdate builder.
+func (esu *EventSchemaUpdate) Where(ps ...predicate.EventSchema) *EventSchemaUpdate {
+	esu.mutation.Where(ps...)
+	return esu
+}
+
+// SetStatus sets the "status" field.
+func (esu *EventSchemaUpdate) SetStatus(s string) *EventSchemaUpdate {
+	esu.mutation.SetStatus(s)
+	return esu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (esu *EventSchemaUpdate) SetNillableStatus(s *string) *EventSchemaUpdate {
+	if s != nil {
+		esu.SetStatus(*s)
+	}
+	return esu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (esu *EventSchemaUpdate) SetUpdatedAt(t time.Time) *EventSchemaUpdate {
+	esu.mutation.SetUpdatedAt(t)
+	return esu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (esu *EventSchemaUpdate) SetUpdatedBy(s string) *EventSchemaUpdate {
+	esu.mutation.SetUpdatedBy(s)
+	return esu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (esu *EventSchemaUpdate) SetNillableUpdatedBy(s *string) *EventSchemaUpdate {
+	if s != nil {
+		esu.SetUpdatedBy(*s)
+	}
+	return esu
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (esu *EventSchemaUpdate) ClearUpdatedBy() *EventSchemaUpdate {
+	esu.mutation.ClearUpdatedBy()
+	return esu
+}
+
+// SetDescription sets the "description" field.
+func (esu *EventSchemaUpdate) SetDescription(s string) *EventSchemaUpdate {
+	esu.mutation.SetDescription(s)
+	return esu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (esu *EventSchemaUpdate) SetNillableDescription(s *string) *EventSchemaUpdate {
+	if s != nil {
+		esu.SetDescription(*s)
+	}
+	return esu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (esu *EventSchemaUpdate) ClearDescription() *EventSchemaUpdate {
+	esu.mutation.ClearDescription()
+	return esu
+}
+
+// SetValidationMode sets the "validation_mode" field.
+func (esu *EventSchemaUpdate) SetValidationMode(s string) *EventSchemaUpdate {
+	esu.mutation.SetValidationMode(s)
+	return esu
+}
+
+// SetNillableValidationMode sets the "validation_mode" field if the given value is not nil.
+func (esu *EventSchemaUpdate) SetNillableValidationMode(s *string) *EventSchemaUpdate {
+	if s != nil {
+		esu.SetValidationMode(*s)
+	}
+	return esu
+}
+
+// SetProperties sets the "properties" field.
+func (esu *EventSchemaUpdate) SetProperties(tps []types.EventPropertySchema) *EventSchemaUpdate {
+	esu.mutation.SetProperties(tps)
+	return esu
+}
+
+// AppendProperties appends tps to the "properties" field.
+func (esu *EventSchemaUpdate) AppendProperties(tps []types.EventPropertySchema) *EventSchemaUpdate {
+	esu.mutation.AppendProperties(tps)
+	return esu
+}
+
+// ClearProperties clears the value of the "properties" field.
+func (esu *EventSchemaUpdate) ClearProperties() *EventSchemaUpdate {
+	esu.mutation.ClearProperties()
+	return esu
+}
+
+// Mutation returns the EventSchemaMutation object of the builder.
+func (esu *EventSchemaUpdate) Mutation() *EventSchemaMutation {
+	return esu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (esu *EventSchemaUpdate) Save(ctx context.Context) (int, error) {
+	esu.defaults()
+	return withHooks(ctx, esu.sqlSave, esu.mutation, esu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (esu *EventSchemaUpdate) SaveX(ctx context.Context) int {
+	affected, err := esu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (esu *EventSchemaUpdate) Exec(ctx context.Context) error {
+	_, err := esu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (esu *EventSchemaUpdate) ExecX(ctx context.Context) {
+	if err := esu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (esu *EventSchemaUpdate) defaults() {
+	if _, ok := esu.mutation.UpdatedAt(); !ok {
+		v := eventschema.UpdateDefaultUpdatedAt()
+		esu.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (esu *EventSchemaUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(eventschema.Table, eventschema.Columns, sqlgraph.NewFieldSpec(eventschema.FieldID, field.TypeString))
+	if ps := esu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := esu.mutation.Status(); ok {
+		_spec.SetField(eventschema.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := esu.mutation.UpdatedAt(); ok {
+		_spec.SetField(eventschema.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if esu.mutation.CreatedByCleared() {
+		_spec.ClearField(eventschema.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := esu.mutation.UpdatedBy(); ok {
+		_spec.SetField(eventschema.FieldUpdatedBy, field.TypeString, value)
+	}
+	if esu.mutation.UpdatedByCleared() {
+		_spec.ClearField(eventschema.FieldUpdatedBy, field.TypeString)
+	}
+	if esu.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(eventschema.FieldEnvironmentID, field.TypeString)
+	}
+	if value, ok := esu.mutation.Description(); ok {
+		_spec.SetField(eventschema.FieldDescription, field.TypeString, value)
+	}
+	if esu.mutation.DescriptionCleared() {
+		_spec.ClearField(eventschema.FieldDescription, field.TypeString)
+	}
+	if value, ok := esu.mutation.ValidationMode(); ok {
+		_spec.SetField(eventschema.FieldValidationMode, field.TypeString, value)
+	}
+	if value, ok := esu.mutation.Properties(); ok {
+		_spec.SetField(eventschema.FieldProperties, field.TypeJSON, value)
+	}
+	if value, ok := esu.mutation.AppendedProperties(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, eventschema.FieldProperties, value)
+		})
+	}
+	if esu.mutation.PropertiesCleared() {
+		_spec.ClearField(eventschema.FieldProperties, field.TypeJSON)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, esu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{eventschema.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	esu.mutation.done = true
+	return n, nil
+}
+
+// EventSchemaUpdateOne is the builder for updating a single EventSchema entity.
+type EventSchemaUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EventSchemaMutation
+}
+
+// SetStatus sets the "status" field.
+func (esuo *EventSchemaUpdateOne) SetStatus(s string) *EventSchemaUpdateOne {
+	esuo.mutation.SetStatus(s)
+	return esuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (esuo *EventSchemaUpdateOne) SetNillableStatus(s *string) *EventSchemaUpdateOne {
+	if s != nil {
+		esuo.SetStatus(*s)
+	}
+	return esuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (esuo *EventSchemaUpdateOne) SetUpdatedAt(t time.Time) *EventSchemaUpdateOne {
+	esuo.mutation.SetUpdatedAt(t)
+	return esuo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (esuo *EventSchemaUpdateOne) SetUpdatedBy(s string) *EventSchemaUpdateOne {
+	esuo.mutation.SetUpdatedBy(s)
+	return esuo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (esuo *EventSchemaUpdateOne) SetNillableUpdatedBy(s *string) *EventSchemaUpdateOne {
+	if s != nil {
+		esuo.SetUpdatedBy(*s)
+	}
+	return esuo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (esuo *EventSchemaUpdateOne) ClearUpdatedBy() *EventSchemaUpdateOne {
+	esuo.mutation.ClearUpdatedBy()
+	return esuo
+}
+
+// SetDescription sets the "description" field.
+func (esuo *EventSchemaUpdateOne) SetDescription(s string) *EventSchemaUpdateOne {
+	esuo.mutation.SetDescription(s)
+	return esuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (esuo *EventSchemaUpdateOne) SetNillableDescription(s *string) *EventSchemaUpdateOne {
+	if s != nil {
+		esuo.SetDescription(*s)
+	}
+	return esuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (esuo *EventSchemaUpdateOne) ClearDescription() *EventSchemaUpdateOne {
+	esuo.mutation.ClearDescription()
+	return esuo
+}
+
+// SetValidationMode sets the "validation_mode" field.
+func (esuo *EventSchemaUpdateOne) SetValidationMode(s string) *EventSchemaUpdateOne {
+	esuo.mutation.SetValidationMode(s)
+	return esuo
+}
+
+// SetNillableValidationMode sets the "validation_mode" field if the given value is not nil.
+func (esuo *EventSchemaUpdateOne) SetNillableValidationMode(s *string) *EventSchemaUpdateOne {
+	if s != nil {
+		esuo.SetValidationMode(*s)
+	}
+	return esuo
+}
+
+// SetProperties sets the "properties" field.
+func (esuo *EventSchemaUpdateOne) SetProperties(tps []types.EventPropertySchema) *EventSchemaUpdateOne {
+	esuo.mutation.SetProperties(tps)
+	return esuo
+}
+
+// AppendProperties appends tps to the "properties" field.
+func (esuo *EventSchemaUpdateOne) AppendProperties(tps []types.EventPropertySchema) *EventSchemaUpdateOne {
+	esuo.mutation.AppendProperties(tps)
+	return esuo
+}
+
+// ClearProperties clears the value of the "properties" field.
+func (esuo *EventSchemaUpdateOne) ClearProperties() *EventSchemaUpdateOne {
+	esuo.mutation.ClearProperties()
+	return esuo
+}
+
+// Mutation returns the EventSchemaMutation object of the builder.
+func (esuo *EventSchemaUpdateOne) Mutation() *EventSchemaMutation {
+	return esuo.mutation
+}
+
+// Where appends a list predicates to the EventSchemaUpdate builder.
+func (esuo *EventSchemaUpdateOne) Where(ps ...predicate.EventSchema) *EventSchemaUpdateOne {
+	esuo.mutation.Where(ps...)
+	return esuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (esuo *EventSchemaUpdateOne) Select(field string, fields ...string) *EventSchemaUpdateOne {
+	esuo.fields = append([]string{field}, fields...)
+	return esuo
+}
+
+// Save executes the query and returns the updated EventSchema entity.
+func (esuo *EventSchemaUpdateOne) Save(ctx context.Context) (*EventSchema, error) {
+	esuo.defaults()
+	return withHooks(ctx, esuo.sqlSave, esuo.mutation, esuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (esuo *EventSchemaUpdateOne) SaveX(ctx context.Context) *EventSchema {
+	node, err := esuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (esuo *EventSchemaUpdateOne) Exec(ctx context.Context) error {
+	_, err := esuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (esuo *EventSchemaUpdateOne) ExecX(ctx context.Context) {
+	if err := esuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (esuo *EventSchemaUpdateOne) defaults() {
+	if _, ok := esuo.mutation.UpdatedAt(); !ok {
+		v := eventschema.UpdateDefaultUpdatedAt()
+		esuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (esuo *EventSchemaUpdateOne) sqlSave(ctx context.Context) (_node *EventSchema, err error) {
+	_spec := sqlgraph.NewUpdateSpec(eventschema.Table, eventschema.Columns, sqlgraph.NewFieldSpec(eventschema.FieldID, field.TypeString))
+	id, ok := esuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EventSchema.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := esuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, eventschema.FieldID)
+		for _, f := range fields {
+			if !eventschema.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != eventschema.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := esuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := esuo.mutation.Status(); ok {
+		_spec.SetField(eventschema.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := esuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(eventschema.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if esuo.mutation.CreatedByCleared() {
+		_spec.ClearField(eventschema.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := esuo.mutation.UpdatedBy(); ok {
+		_spec.SetField(eventschema.FieldUpdatedBy, field.TypeString, value)
+	}
+	if esuo.mutation.UpdatedByCleared() {
+		_spec.ClearField(eventschema.FieldUpdatedBy, field.TypeString)
+	}
+	if esuo.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(eventschema.FieldEnvironmentID, field.TypeString)
+	}
+	if value, ok := esuo.mutation.Description(); ok {
+		_spec.SetField(eventschema.FieldDescription, field.TypeString, value)
+	}
+	if esuo.mutation.DescriptionCleared() {
+		_spec.ClearField(eventschema.FieldDescription, field.TypeString)
+	}
+	if value, ok := esuo.mutation.ValidationMode(); ok {
+		_spec.SetField(eventschema.FieldValidationMode, field.TypeString, value)
+	}
+	if value, ok := esuo.mutation.Properties(); ok {
+		_spec.SetField(eventschema.FieldProperties, field.TypeJSON, value)
+	}
+	if value, ok := esuo.mutation.AppendedProperties(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, eventschema.FieldProperties, value)
+		})
+	}
+	if esuo.mutation.PropertiesCleared() {
+		_spec.ClearField(eventschema.FieldProperties, field.TypeJSON)
+	}
+	_node = &EventSchema{config: esuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, esuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{eventschema.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	esuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,255 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/flexprice/flexprice/ent/metricseriesstate"
+)
+
+// MetricSeriesState is the model entity for the MetricSeriesState schema.
+type MetricSeriesState struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID string `json:"tenant_id,omitempty"`
+	// EnvironmentID holds the value of the "environment_id" field.
+	EnvironmentID string `json:"environment_id,omitempty"`
+	// Hash of the metric name and the attributes of the series
+	SeriesID string `json:"series_id,omitempty"`
+	// Value holds the value of the "value" field.
+	Value float64 `json:"value,omitempty"`
+	// Count holds the value of the "count" field.
+	Count int64 `json:"count,omitempty"`
+	// StartTime holds the value of the "start_time" field.
+	StartTime *time.Time `json:"start_time,omitempty"`
+	// ValueAt holds the value of the "value_at" field.
+	ValueAt time.Time `json:"value_at,omitempty"`
+	// PreviousValue holds the value of the "previous_value" field.
+	PreviousValue *float64 `json:"previous_value,omitempty"`
+	// PreviousCount holds the value of the "previous_count" field.
+	PreviousCount *int64 `json:"previous_count,omitempty"`
+	// PreviousStartTime holds the value of the "previous_start_time" field.
+	PreviousStartTime *time.Time `json:"previous_start_time,omitempty"`
+	// PreviousValueAt holds the value of the "previous_value_at" field.
+	PreviousValueAt *time.Time `json:"previous_value_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MetricSeriesState) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case metricseriesstate.FieldValue, metricseriesstate.FieldPreviousValue:
+			values[i] = new(sql.NullFloat64)
+		case metricseriesstate.FieldID, metricseriesstate.FieldCount, metricseriesstate.FieldPreviousCount:
+			values[i] = new(sql.NullInt64)
+		case metricseriesstate.FieldTenantID, metricseriesstate.FieldEnvironmentID, metricseriesstate.FieldSeriesID:
+			values[i] = new(sql.NullString)
+		case metricseriesstate.FieldStartTime, metricseriesstate.FieldValueAt, metricseriesstate.FieldPreviousStartTime, metricseriesstate.FieldPreviousValueAt, metricseriesstate.FieldCreatedAt, metricseriesstate.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MetricSeriesState fields.
+func (mss *MetricSeriesState) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case metricseriesstate.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			mss.ID = int(value.Int64)
+		case metricseriesstate.FieldTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				mss.TenantID = value.String
+			}
+		case metricseriesstate.FieldEnvironmentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field environment_id", values[i])
+			} else if value.Valid {
+				mss.EnvironmentID = value.String
+			}
+		case metricseriesstate.FieldSeriesID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field series_id", values[i])
+			} else if value.Valid {
+				mss.SeriesID = value.String
+			}
+		case metricseriesstate.FieldValue:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field value", values[i])
+			} else if value.Valid {
+				mss.Value = value.Float64
+			}
+		case metricseriesstate.FieldCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field count", values[i])
+			} else if value.Valid {
+				mss.Count = value.Int64
+			}
+		case metricseriesstate.FieldStartTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field start_time", values[i])
+			} else if value.Valid {
+				mss.StartTime = new(time.Time)
+				*mss.StartTime = value.Time
+			}
+		case metricseriesstate.FieldValueAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field value_at", values[i])
+			} else if value.Valid {
+				mss.ValueAt = value.Time
+			}
+		case metricseriesstate.FieldPreviousValue:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_value", values[i])
+			} else if value.Valid {
+				mss.PreviousValue = new(float64)
+				*mss.PreviousValue = value.Float64
+			}
+		case metricseriesstate.FieldPreviousCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_count", values[i])
+			} else if value.Valid {
+				mss.PreviousCount = new(int64)
+				*mss.PreviousCount = value.Int64
+			}
+		case metricseriesstate.FieldPreviousStartTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_start_time", values[i])
+			} else if value.Valid {
+				mss.PreviousStartTime = new(time.Time)
+				*mss.PreviousStartTime = value.Time
+			}
+		case metricseriesstate.FieldPreviousValueAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_value_at", values[i])
+			} else if value.Valid {
+				mss.PreviousValueAt = new(time.Time)
+				*mss.PreviousValueAt = value.Time
+			}
+		case metricseriesstate.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				mss.CreatedAt = value.Time
+			}
+		case metricseriesstate.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				mss.UpdatedAt = value.Time
+			}
+		default:
+			mss.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// GetValue returns the ent.Value that was dynamically selected and assigned to the MetricSeriesState.
+// This includes values selected through modifiers, order, etc.
+func (mss *MetricSeriesState) GetValue(name string) (ent.Value, error) {
+	return mss.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this MetricSeriesState.
+// Note that you need to call MetricSeriesState.Unwrap() before calling this method if this MetricSeriesState
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mss *MetricSeriesState) Update() *MetricSeriesStateUpdateOne {
+	return NewMetricSeriesStateClient(mss.config).UpdateOne(mss)
+}
+
+// Unwrap unwraps the MetricSeriesState entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mss *MetricSeriesState) Unwrap() *MetricSeriesState {
+	_tx, ok := mss.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MetricSeriesState is not a transactional entity")
+	}
+	mss.config.driver = _tx.drv
+	return mss
+}
+
+// String implements the fmt.Stringer.
+func (mss *MetricSeriesState) String() string {
+	var builder strings.Builder
+	builder.WriteString("MetricSeriesState(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mss.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(mss.TenantID)
+	builder.WriteString(", ")
+	builder.WriteString("environment_id=")
+	builder.WriteString(mss.EnvironmentID)
+	builder.WriteString(", ")
+	builder.WriteString("series_id=")
+	builder.WriteString(mss.SeriesID)
+	builder.WriteString(", ")
+	builder.WriteString("value=")
+	builder.WriteString(fmt.Sprintf("%v", mss.Value))
+	builder.WriteString(", ")
+	builder.WriteString("count=")
+	builder.WriteString(fmt.Sprintf("%v", mss.Count))
+	builder.WriteString(", ")
+	if v := mss.StartTime; v != nil {
+		builder.WriteString("start_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("value_at=")
+	builder.WriteString(mss.ValueAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := mss.PreviousValue; v != nil {
+		builder.WriteString("previous_value=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := mss.PreviousCount; v != nil {
+		builder.WriteString("previous_count=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := mss.PreviousStartTime; v != nil {
+		builder.WriteString("previous_start_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := mss.PreviousValueAt; v != nil {
+		builder.WriteString("previous_value_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(mss.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(mss.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MetricSeriesStates is a parsable slice of MetricSeriesState.
+type MetricSeriesStates []*MetricSeriesState
@@ -0,0 +1,160 @@
+// Code generated by ent, DO NOT EDIT.
+
+package metricseriesstate
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the metricseriesstate type in the database.
+	Label = "metric_series_state"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldEnvironmentID holds the string denoting the environment_id field in the database.
+	FieldEnvironmentID = "environment_id"
+	// FieldSeriesID holds the string denoting the series_id field in the database.
+	FieldSeriesID = "series_id"
+	// FieldValue holds the string denoting the value field in the database.
+	FieldValue = "value"
+	// FieldCount holds the string denoting the count field in the database.
+	FieldCount = "count"
+	// FieldStartTime holds the string denoting the start_time field in the database.
+	FieldStartTime = "start_time"
+	// FieldValueAt holds the string denoting the value_at field in the database.
+	FieldValueAt = "value_at"
+	// FieldPreviousValue holds the string denoting the previous_value field in the database.
+	FieldPreviousValue = "previous_value"
+	// FieldPreviousCount holds the string denoting the previous_count field in the database.
+	FieldPreviousCount = "previous_count"
+	// FieldPreviousStartTime holds the string denoting the previous_start_time field in the database.
+	FieldPreviousStartTime = "previous_start_time"
+	// FieldPreviousValueAt holds the string denoting the previous_value_at field in the database.
+	FieldPreviousValueAt = "previous_value_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the metricseriesstate in the database.
+	Table = "metric_series_states"
+)
+
+// Columns holds all SQL columns for metricseriesstate fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldEnvironmentID,
+	FieldSeriesID,
+	FieldValue,
+	FieldCount,
+	FieldStartTime,
+	FieldValueAt,
+	FieldPreviousValue,
+	FieldPreviousCount,
+	FieldPreviousStartTime,
+	FieldPreviousValueAt,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	TenantIDValidator func(string) error
+	// SeriesIDValidator is a validator for the "series_id" field. It is called by the builders before save.
+	SeriesIDValidator func(string) error
+	// DefaultCount holds the default value on creation for the "count" field.
+	DefaultCount int64
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the MetricSeriesState queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByEnvironmentID orders the results by the environment_id field.
+func ByEnvironmentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnvironmentID, opts...).ToFunc()
+}
+
+// BySeriesID orders the results by the series_id field.
+func BySeriesID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeriesID, opts...).ToFunc()
+}
+
+// ByValue orders the results by the value field.
+func ByValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValue, opts...).ToFunc()
+}
+
+// ByCount orders the results by the count field.
+func ByCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCount, opts...).ToFunc()
+}
+
+// ByStartTime orders the results by the start_time field.
+func ByStartTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartTime, opts...).ToFunc()
+}
+
+// ByValueAt orders the results by the value_at field.
+func ByValueAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValueAt, opts...).ToFunc()
+}
+
+// ByPreviousValue orders the results by the previous_value field.
+func ByPreviousValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviousValue, opts...).ToFunc()
+}
+
+// ByPreviousCount orders the results by the previous_count field.
+func ByPreviousCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviousCount, opts...).ToFunc()
+}
+
+// ByPreviousStartTime orders the results by the previous_start_time field.
+func ByPreviousStartTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviousStartTime, opts...).ToFunc()
+}
+
+// ByPreviousValueAt orders the results by the previous_value_at field.
+func ByPreviousValueAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviousValueAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,790 @@
+// Code generated by ent, DO NOT EDIT.
+
+package metricseriesstate
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/flexprice/flexprice/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v string) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldEQ(FieldTenantID, v))
+}
+
+// EnvironmentID applies equality check predicate on the "environment_id" field. It's identical to EnvironmentIDEQ.
+func EnvironmentID(v string) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldEQ(FieldEnvironmentID, v))
+}
+
+// SeriesID applies equality check predicate on the "series_id" field. It's identical to SeriesIDEQ.
+func SeriesID(v string) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldEQ(FieldSeriesID, v))
+}
+
+// Value applies equality check predicate on the "value" field. It's identical to ValueEQ.
+func Value(v float64) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldEQ(FieldValue, v))
+}
+
+// Count applies equality check predicate on the "count" field. It's identical to CountEQ.
+func Count(v int64) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldEQ(FieldCount, v))
+}
+
+// StartTime applies equality check predicate on the "start_time" field. It's identical to StartTimeEQ.
+func StartTime(v time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldEQ(FieldStartTime, v))
+}
+
+// ValueAt applies equality check predicate on the "value_at" field. It's identical to ValueAtEQ.
+func ValueAt(v time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldEQ(FieldValueAt, v))
+}
+
+// PreviousValue applies equality check predicate on the "previous_value" field. It's identical to PreviousValueEQ.
+func PreviousValue(v float64) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldEQ(FieldPreviousValue, v))
+}
+
+// PreviousCount applies equality check predicate on the "previous_count" field. It's identical to PreviousCountEQ.
+func PreviousCount(v int64) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldEQ(FieldPreviousCount, v))
+}
+
+// PreviousStartTime applies equality check predicate on the "previous_start_time" field. It's identical to PreviousStartTimeEQ.
+func PreviousStartTime(v time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldEQ(FieldPreviousStartTime, v))
+}
+
+// PreviousValueAt applies equality check predicate on the "previous_value_at" field. It's identical to PreviousValueAtEQ.
+func PreviousValueAt(v time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldEQ(FieldPreviousValueAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v string) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v string) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...string) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...string) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v string) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v string) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v string) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v string) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDContains applies the Contains predicate on the "tenant_id" field.
+func TenantIDContains(v string) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldContains(FieldTenantID, v))
+}
+
+// TenantIDHasPrefix applies the HasPrefix predicate on the "tenant_id" field.
+func TenantIDHasPrefix(v string) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldHasPrefix(FieldTenantID, v))
+}
+
+// TenantIDHasSuffix applies the HasSuffix predicate on the "tenant_id" field.
+func TenantIDHasSuffix(v string) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldHasSuffix(FieldTenantID, v))
+}
+
+// TenantIDEqualFold applies the EqualFold predicate on the "tenant_id" field.
+func TenantIDEqualFold(v string) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldEqualFold(FieldTenantID, v))
+}
+
+// TenantIDContainsFold applies the ContainsFold predicate on the "tenant_id" field.
+func TenantIDContainsFold(v string) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldContainsFold(FieldTenantID, v))
+}
+
+// EnvironmentIDEQ applies the EQ predicate on the "environment_id" field.
+func EnvironmentIDEQ(v string) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldEQ(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDNEQ applies the NEQ predicate on the "environment_id" field.
+func EnvironmentIDNEQ(v string) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldNEQ(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDIn applies the In predicate on the "environment_id" field.
+func EnvironmentIDIn(vs ...string) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldIn(FieldEnvironmentID, vs...))
+}
+
+// EnvironmentIDNotIn applies the NotIn predicate on the "environment_id" field.
+func EnvironmentIDNotIn(vs ...string) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldNotIn(FieldEnvironmentID, vs...))
+}
+
+// EnvironmentIDGT applies the GT predicate on the "environment_id" field.
+func EnvironmentIDGT(v string) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldGT(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDGTE applies the GTE predicate on the "environment_id" field.
+func EnvironmentIDGTE(v string) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldGTE(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDLT applies the LT predicate on the "environment_id" field.
+func EnvironmentIDLT(v string) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldLT(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDLTE applies the LTE predicate on the "environment_id" field.
+func EnvironmentIDLTE(v string) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldLTE(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDContains applies the Contains predicate on the "environment_id" field.
+func EnvironmentIDContains(v string) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldContains(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDHasPrefix applies the HasPrefix predicate on the "environment_id" field.
+func EnvironmentIDHasPrefix(v string) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldHasPrefix(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDHasSuffix applies the HasSuffix predicate on the "environment_id" field.
+func EnvironmentIDHasSuffix(v string) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldHasSuffix(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDIsNil applies the IsNil predicate on the "environment_id" field.
+func EnvironmentIDIsNil() predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldIsNull(FieldEnvironmentID))
+}
+
+// EnvironmentIDNotNil applies the NotNil predicate on the "environment_id" field.
+func EnvironmentIDNotNil() predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldNotNull(FieldEnvironmentID))
+}
+
+// EnvironmentIDEqualFold applies the EqualFold predicate on the "environment_id" field.
+func EnvironmentIDEqualFold(v string) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldEqualFold(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDContainsFold applies the ContainsFold predicate on the "environment_id" field.
+func EnvironmentIDContainsFold(v string) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldContainsFold(FieldEnvironmentID, v))
+}
+
+// SeriesIDEQ applies the EQ predicate on the "series_id" field.
+func SeriesIDEQ(v string) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldEQ(FieldSeriesID, v))
+}
+
+// SeriesIDNEQ applies the NEQ predicate on the "series_id" field.
+func SeriesIDNEQ(v string) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldNEQ(FieldSeriesID, v))
+}
+
+// SeriesIDIn applies the In predicate on the "series_id" field.
+func SeriesIDIn(vs ...string) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldIn(FieldSeriesID, vs...))
+}
+
+// SeriesIDNotIn applies the NotIn predicate on the "series_id" field.
+func SeriesIDNotIn(vs ...string) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldNotIn(FieldSeriesID, vs...))
+}
+
+// SeriesIDGT applies the GT predicate on the "series_id" field.
+func SeriesIDGT(v string) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldGT(FieldSeriesID, v))
+}
+
+// SeriesIDGTE applies the GTE predicate on the "series_id" field.
+func SeriesIDGTE(v string) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldGTE(FieldSeriesID, v))
+}
+
+// SeriesIDLT applies the LT predicate on the "series_id" field.
+func SeriesIDLT(v string) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldLT(FieldSeriesID, v))
+}
+
+// SeriesIDLTE applies the LTE predicate on the "series_id" field.
+func SeriesIDLTE(v string) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldLTE(FieldSeriesID, v))
+}
+
+// SeriesIDContains applies the Contains predicate on the "series_id" field.
+func SeriesIDContains(v string) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldContains(FieldSeriesID, v))
+}
+
+// SeriesIDHasPrefix applies the HasPrefix predicate on the "series_id" field.
+func SeriesIDHasPrefix(v string) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldHasPrefix(FieldSeriesID, v))
+}
+
+// SeriesIDHasSuffix applies the HasSuffix predicate on the "series_id" field.
+func SeriesIDHasSuffix(v string) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldHasSuffix(FieldSeriesID, v))
+}
+
+// SeriesIDEqualFold applies the EqualFold predicate on the "series_id" field.
+func SeriesIDEqualFold(v string) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldEqualFold(FieldSeriesID, v))
+}
+
+// SeriesIDContainsFold applies the ContainsFold predicate on the "series_id" field.
+func SeriesIDContainsFold(v string) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldContainsFold(FieldSeriesID, v))
+}
+
+// ValueEQ applies the EQ predicate on the "value" field.
+func ValueEQ(v float64) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldEQ(FieldValue, v))
+}
+
+// ValueNEQ applies the NEQ predicate on the "value" field.
+func ValueNEQ(v float64) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldNEQ(FieldValue, v))
+}
+
+// ValueIn applies the In predicate on the "value" field.
+func ValueIn(vs ...float64) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldIn(FieldValue, vs...))
+}
+
+// ValueNotIn applies the NotIn predicate on the "value" field.
+func ValueNotIn(vs ...float64) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldNotIn(FieldValue, vs...))
+}
+
+// ValueGT applies the GT predicate on the "value" field.
+func ValueGT(v float64) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldGT(FieldValue, v))
+}
+
+// ValueGTE applies the GTE predicate on the "value" field.
+func ValueGTE(v float64) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldGTE(FieldValue, v))
+}
+
+// ValueLT applies the LT predicate on the "value" field.
+func ValueLT(v float64) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldLT(FieldValue, v))
+}
+
+// ValueLTE applies the LTE predicate on the "value" field.
+func ValueLTE(v float64) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldLTE(FieldValue, v))
+}
+
+// CountEQ applies the EQ predicate on the "count" field.
+func CountEQ(v int64) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldEQ(FieldCount, v))
+}
+
+// CountNEQ applies the NEQ predicate on the "count" field.
+func CountNEQ(v int64) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldNEQ(FieldCount, v))
+}
+
+// CountIn applies the In predicate on the "count" field.
+func CountIn(vs ...int64) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldIn(FieldCount, vs...))
+}
+
+// CountNotIn applies the NotIn predicate on the "count" field.
+func CountNotIn(vs ...int64) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldNotIn(FieldCount, vs...))
+}
+
+// CountGT applies the GT predicate on the "count" field.
+func CountGT(v int64) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldGT(FieldCount, v))
+}
+
+// CountGTE applies the GTE predicate on the "count" field.
+func CountGTE(v int64) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldGTE(FieldCount, v))
+}
+
+// CountLT applies the LT predicate on the "count" field.
+func CountLT(v int64) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldLT(FieldCount, v))
+}
+
+// CountLTE applies the LTE predicate on the "count" field.
+func CountLTE(v int64) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldLTE(FieldCount, v))
+}
+
+// StartTimeEQ applies the EQ predicate on the "start_time" field.
+func StartTimeEQ(v time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldEQ(FieldStartTime, v))
+}
+
+// StartTimeNEQ applies the NEQ predicate on the "start_time" field.
+func StartTimeNEQ(v time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldNEQ(FieldStartTime, v))
+}
+
+// StartTimeIn applies the In predicate on the "start_time" field.
+func StartTimeIn(vs ...time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldIn(FieldStartTime, vs...))
+}
+
+// StartTimeNotIn applies the NotIn predicate on the "start_time" field.
+func StartTimeNotIn(vs ...time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldNotIn(FieldStartTime, vs...))
+}
+
+// StartTimeGT applies the GT predicate on the "start_time" field.
+func StartTimeGT(v time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldGT(FieldStartTime, v))
+}
+
+// StartTimeGTE applies the GTE predicate on the "start_time" field.
+func StartTimeGTE(v time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldGTE(FieldStartTime, v))
+}
+
+// StartTimeLT applies the LT predicate on the "start_time" field.
+func StartTimeLT(v time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldLT(FieldStartTime, v))
+}
+
+// StartTimeLTE applies the LTE predicate on the "start_time" field.
+func StartTimeLTE(v time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldLTE(FieldStartTime, v))
+}
+
+// StartTimeIsNil applies the IsNil predicate on the "start_time" field.
+func StartTimeIsNil() predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldIsNull(FieldStartTime))
+}
+
+// StartTimeNotNil applies the NotNil predicate on the "start_time" field.
+func StartTimeNotNil() predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldNotNull(FieldStartTime))
+}
+
+// ValueAtEQ applies the EQ predicate on the "value_at" field.
+func ValueAtEQ(v time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldEQ(FieldValueAt, v))
+}
+
+// ValueAtNEQ applies the NEQ predicate on the "value_at" field.
+func ValueAtNEQ(v time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldNEQ(FieldValueAt, v))
+}
+
+// ValueAtIn applies the In predicate on the "value_at" field.
+func ValueAtIn(vs ...time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldIn(FieldValueAt, vs...))
+}
+
+// ValueAtNotIn applies the NotIn predicate on the "value_at" field.
+func ValueAtNotIn(vs ...time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldNotIn(FieldValueAt, vs...))
+}
+
+// ValueAtGT applies the GT predicate on the "value_at" field.
+func ValueAtGT(v time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldGT(FieldValueAt, v))
+}
+
+// ValueAtGTE applies the GTE predicate on the "value_at" field.
+func ValueAtGTE(v time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldGTE(FieldValueAt, v))
+}
+
+// ValueAtLT applies the LT predicate on the "value_at" field.
+func ValueAtLT(v time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldLT(FieldValueAt, v))
+}
+
+// ValueAtLTE applies the LTE predicate on the "value_at" field.
+func ValueAtLTE(v time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldLTE(FieldValueAt, v))
+}
+
+// PreviousValueEQ applies the EQ predicate on the "previous_value" field.
+func PreviousValueEQ(v float64) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldEQ(FieldPreviousValue, v))
+}
+
+// PreviousValueNEQ applies the NEQ predicate on the "previous_value" field.
+func PreviousValueNEQ(v float64) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldNEQ(FieldPreviousValue, v))
+}
+
+// PreviousValueIn applies the In predicate on the "previous_value" field.
+func PreviousValueIn(vs ...float64) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldIn(FieldPreviousValue, vs...))
+}
+
+// PreviousValueNotIn applies the NotIn predicate on the "previous_value" field.
+func PreviousValueNotIn(vs ...float64) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldNotIn(FieldPreviousValue, vs...))
+}
+
+// PreviousValueGT applies the GT predicate on the "previous_value" field.
+func PreviousValueGT(v float64) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldGT(FieldPreviousValue, v))
+}
+
+// PreviousValueGTE applies the GTE predicate on the "previous_value" field.
+func PreviousValueGTE(v float64) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldGTE(FieldPreviousValue, v))
+}
+
+// PreviousValueLT applies the LT predicate on the "previous_value" field.
+func PreviousValueLT(v float64) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldLT(FieldPreviousValue, v))
+}
+
+// PreviousValueLTE applies the LTE predicate on the "previous_value" field.
+func PreviousValueLTE(v float64) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldLTE(FieldPreviousValue, v))
+}
+
+// PreviousValueIsNil applies the IsNil predicate on the "previous_value" field.
+func PreviousValueIsNil() predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldIsNull(FieldPreviousValue))
+}
+
+// PreviousValueNotNil applies the NotNil predicate on the "previous_value" field.
+func PreviousValueNotNil() predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldNotNull(FieldPreviousValue))
+}
+
+// PreviousCountEQ applies the EQ predicate on the "previous_count" field.
+func PreviousCountEQ(v int64) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldEQ(FieldPreviousCount, v))
+}
+
+// PreviousCountNEQ applies the NEQ predicate on the "previous_count" field.
+func PreviousCountNEQ(v int64) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldNEQ(FieldPreviousCount, v))
+}
+
+// PreviousCountIn applies the In predicate on the "previous_count" field.
+func PreviousCountIn(vs ...int64) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldIn(FieldPreviousCount, vs...))
+}
+
+// PreviousCountNotIn applies the NotIn predicate on the "previous_count" field.
+func PreviousCountNotIn(vs ...int64) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldNotIn(FieldPreviousCount, vs...))
+}
+
+// PreviousCountGT applies the GT predicate on the "previous_count" field.
+func PreviousCountGT(v int64) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldGT(FieldPreviousCount, v))
+}
+
+// PreviousCountGTE applies the GTE predicate on the "previous_count" field.
+func PreviousCountGTE(v int64) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldGTE(FieldPreviousCount, v))
+}
+
+// PreviousCountLT applies the LT predicate on the "previous_count" field.
+func PreviousCountLT(v int64) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldLT(FieldPreviousCount, v))
+}
+
+// PreviousCountLTE applies the LTE predicate on the "previous_count" field.
+func PreviousCountLTE(v int64) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldLTE(FieldPreviousCount, v))
+}
+
+// PreviousCountIsNil applies the IsNil predicate on the "previous_count" field.
+func PreviousCountIsNil() predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldIsNull(FieldPreviousCount))
+}
+
+// PreviousCountNotNil applies the NotNil predicate on the "previous_count" field.
+func PreviousCountNotNil() predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldNotNull(FieldPreviousCount))
+}
+
+// PreviousStartTimeEQ applies the EQ predicate on the "previous_start_time" field.
+func PreviousStartTimeEQ(v time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldEQ(FieldPreviousStartTime, v))
+}
+
+// PreviousStartTimeNEQ applies the NEQ predicate on the "previous_start_time" field.
+func PreviousStartTimeNEQ(v time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldNEQ(FieldPreviousStartTime, v))
+}
+
+// PreviousStartTimeIn applies the In predicate on the "previous_start_time" field.
+func PreviousStartTimeIn(vs ...time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldIn(FieldPreviousStartTime, vs...))
+}
+
+// PreviousStartTimeNotIn applies the NotIn predicate on the "previous_start_time" field.
+func PreviousStartTimeNotIn(vs ...time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldNotIn(FieldPreviousStartTime, vs...))
+}
+
+// PreviousStartTimeGT applies the GT predicate on the "previous_start_time" field.
+func PreviousStartTimeGT(v time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldGT(FieldPreviousStartTime, v))
+}
+
+// PreviousStartTimeGTE applies the GTE predicate on the "previous_start_time" field.
+func PreviousStartTimeGTE(v time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldGTE(FieldPreviousStartTime, v))
+}
+
+// PreviousStartTimeLT applies the LT predicate on the "previous_start_time" field.
+func PreviousStartTimeLT(v time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldLT(FieldPreviousStartTime, v))
+}
+
+// PreviousStartTimeLTE applies the LTE predicate on the "previous_start_time" field.
+func PreviousStartTimeLTE(v time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldLTE(FieldPreviousStartTime, v))
+}
+
+// PreviousStartTimeIsNil applies the IsNil predicate on the "previous_start_time" field.
+func PreviousStartTimeIsNil() predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldIsNull(FieldPreviousStartTime))
+}
+
+// PreviousStartTimeNotNil applies the NotNil predicate on the "previous_start_time" field.
+func PreviousStartTimeNotNil() predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldNotNull(FieldPreviousStartTime))
+}
+
+// PreviousValueAtEQ applies the EQ predicate on the "previous_value_at" field.
+func PreviousValueAtEQ(v time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldEQ(FieldPreviousValueAt, v))
+}
+
+// PreviousValueAtNEQ applies the NEQ predicate on the "previous_value_at" field.
+func PreviousValueAtNEQ(v time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldNEQ(FieldPreviousValueAt, v))
+}
+
+// PreviousValueAtIn applies the In predicate on the "previous_value_at" field.
+func PreviousValueAtIn(vs ...time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldIn(FieldPreviousValueAt, vs...))
+}
+
+// PreviousValueAtNotIn applies the NotIn predicate on the "previous_value_at" field.
+func PreviousValueAtNotIn(vs ...time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldNotIn(FieldPreviousValueAt, vs...))
+}
+
+// PreviousValueAtGT applies the GT predicate on the "previous_value_at" field.
+func PreviousValueAtGT(v time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldGT(FieldPreviousValueAt, v))
+}
+
+// PreviousValueAtGTE applies the GTE predicate on the "previous_value_at" field.
+func PreviousValueAtGTE(v time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldGTE(FieldPreviousValueAt, v))
+}
+
+// PreviousValueAtLT applies the LT predicate on the "previous_value_at" field.
+func PreviousValueAtLT(v time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldLT(FieldPreviousValueAt, v))
+}
+
+// PreviousValueAtLTE applies the LTE predicate on the "previous_value_at" field.
+func PreviousValueAtLTE(v time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldLTE(FieldPreviousValueAt, v))
+}
+
+// PreviousValueAtIsNil applies the IsNil predicate on the "previous_value_at" field.
+func PreviousValueAtIsNil() predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldIsNull(FieldPreviousValueAt))
+}
+
+// PreviousValueAtNotNil applies the NotNil predicate on the "previous_value_at" field.
+func PreviousValueAtNotNil() predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldNotNull(FieldPreviousValueAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MetricSeriesState) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MetricSeriesState) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MetricSeriesState) predicate.MetricSeriesState {
+	return predicate.MetricSeriesState(sql.NotPredicates(p))
+}
@@ -0,0 +1,422 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/metricseriesstate"
+)
+
+// MetricSeriesStateCreate is the builder for creating a MetricSeriesState entity.
+type MetricSeriesStateCreate struct {
+	config
+	mutation *MetricSeriesStateMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (mssc *MetricSeriesStateCreate) SetTenantID(s string) *MetricSeriesStateCreate {
+	mssc.mutation.SetTenantID(s)
+	return mssc
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (mssc *MetricSeriesStateCreate) SetEnvironmentID(s string) *MetricSeriesStateCreate {
+	mssc.mutation.SetEnvironmentID(s)
+	return mssc
+}
+
+// SetNillableEnvironmentID sets the "environment_id" field if the given value is not nil.
+func (mssc *MetricSeriesStateCreate) SetNillableEnvironmentID(s *string) *MetricSeriesStateCreate {
+	if s != nil {
+		mssc.SetEnvironmentID(*s)
+	}
+	return mssc
+}
+
+// SetSeriesID sets the "series_id" field.
+func (mssc *MetricSeriesStateCreate) SetSeriesID(s string) *MetricSeriesStateCreate {
+	mssc.mutation.SetSeriesID(s)
+	return mssc
+}
+
+// SetValue sets the "value" field.
+func (mssc *MetricSeriesStateCreate) SetValue(f float64) *MetricSeriesStateCreate {
+	mssc.mutation.SetValue(f)
+	return mssc
+}
+
+// SetCount sets the "count" field.
+func (mssc *MetricSeriesStateCreate) SetCount(i int64) *MetricSeriesStateCreate {
+	mssc.mutation.SetCount(i)
+	return mssc
+}
+
+// SetNillableCount sets the "count" field if the given value is not nil.
+func (mssc *MetricSeriesStateCreate) SetNillableCount(i *int64) *MetricSeriesStateCreate {
+	if i != nil {
+		mssc.SetCount(*i)
+	}
+	return mssc
+}
+
+// SetStartTime sets the "start_time" field.
+func (mssc *MetricSeriesStateCreate) SetStartTime(t time.Time) *MetricSeriesStateCreate {
+	mssc.mutation.SetStartTime(t)
+	return mssc
+}
+
+// SetNillableStartTime sets the "start_time" field if the given value is not nil.
+func (mssc *MetricSeriesStateCreate) SetNillableStartTime(t *time.Time) *MetricSeriesStateCreate {
+	if t != nil {
+		mssc.SetStartTime(*t)
+	}
+	return mssc
+}
+
+// SetValueAt sets the "value_at" field.
+func (mssc *MetricSeriesStateCreate) SetValueAt(t time.Time) *MetricSeriesStateCreate {
+	mssc.mutation.SetValueAt(t)
+	return mssc
+}
+
+// SetPreviousValue sets the "previous_value" field.
+func (mssc *MetricSeriesStateCreate) SetPreviousValue(f float64) *MetricSeriesStateCreate {
+	mssc.mutation.SetPreviousValue(f)
+	return mssc
+}
+
+// SetNillablePreviousValue sets the "previous_value" field if the given value is not nil.
+func (mssc *MetricSeriesStateCreate) SetNillablePreviousValue(f *float64) *MetricSeriesStateCreate {
+	if f != nil {
+		mssc.SetPreviousValue(*f)
+	}
+	return mssc
+}
+
+// SetPreviousCount sets the "previous_count" field.
+func (mssc *MetricSeriesStateCreate) SetPreviousCount(i int64) *MetricSeriesStateCreate {
+	mssc.mutation.SetPreviousCount(i)
+	return mssc
+}
+
+// SetNillablePreviousCount sets the "previous_count" field if the given value is not nil.
+func (mssc *MetricSeriesStateCreate) SetNillablePreviousCount(i *int64) *MetricSeriesStateCreate {
+	if i != nil {
+		mssc.SetPreviousCount(*i)
+	}
+	return mssc
+}
+
+// SetPreviousStartTime sets the "previous_start_time" field.
+func (mssc *MetricSeriesStateCreate) SetPreviousStartTime(t time.Time) *MetricSeriesStateCreate {
+	mssc.mutation.SetPreviousStartTime(t)
+	return mssc
+}
+
+// SetNillablePreviousStartTime sets the "previous_start_time" field if the given value is not nil.
+func (mssc *MetricSeriesStateCreate) SetNillablePreviousStartTime(t *time.Time) *MetricSeriesStateCreate {
+	if t != nil {
+		mssc.SetPreviousStartTime(*t)
+	}
+	return mssc
+}
+
+// SetPreviousValueAt sets the "previous_value_at" field.
+func (mssc *MetricSeriesStateCreate) SetPreviousValueAt(t time.Time) *MetricSeriesStateCreate {
+	mssc.mutation.SetPreviousValueAt(t)
+	return mssc
+}
+
+// SetNillablePreviousValueAt sets the "previous_value_at" field if the given value is not nil.
+func (mssc *MetricSeriesStateCreate) SetNillablePreviousValueAt(t *time.Time) *MetricSeriesStateCreate {
+	if t != nil {
+		mssc.SetPreviousValueAt(*t)
+	}
+	return mssc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mssc *MetricSeriesStateCreate) SetCreatedAt(t time.Time) *MetricSeriesStateCreate {
+	mssc.mutation.SetCreatedAt(t)
+	return mssc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mssc *MetricSeriesStateCreate) SetNillableCreatedAt(t *time.Time) *MetricSeriesStateCreate {
+	if t != nil {
+		mssc.SetCreatedAt(*t)
+	}
+	return mssc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mssc *MetricSeriesStateCreate) SetUpdatedAt(t time.Time) *MetricSeriesStateCreate {
+	mssc.mutation.SetUpdatedAt(t)
+	return mssc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (mssc *MetricSeriesStateCreate) SetNillableUpdatedAt(t *time.Time) *MetricSeriesStateCreate {
+	if t != nil {
+		mssc.SetUpdatedAt(*t)
+	}
+	return mssc
+}
+
+// Mutation returns the MetricSeriesStateMutation object of the builder.
+func (mssc *MetricSeriesStateCreate) Mutation() *MetricSeriesStateMutation {
+	return mssc.mutation
+}
+
+// Save creates the MetricSeriesState in the database.
+func (mssc *MetricSeriesStateCreate) Save(ctx context.Context) (*MetricSeriesState, error) {
+	mssc.defaults()
+	return withHooks(ctx, mssc.sqlSave, mssc.mutation, mssc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mssc *MetricSeriesStateCreate) SaveX(ctx context.Context) *MetricSeriesState {
+	v, err := mssc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mssc *MetricSeriesStateCreate) Exec(ctx context.Context) error {
+	_, err := mssc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mssc *MetricSeriesStateCreate) ExecX(ctx context.Context) {
+	if err := mssc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mssc *MetricSeriesStateCreate) defaults() {
+	if _, ok := mssc.mutation.Count(); !ok {
+		v := metricseriesstate.DefaultCount
+		mssc.mutation.SetCount(v)
+	}
+	if _, ok := mssc.mutation.CreatedAt(); !ok {
+		v := metricseriesstate.DefaultCreatedAt()
+		mssc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := mssc.mutation.UpdatedAt(); !ok {
+		v := metricseriesstate.DefaultUpdatedAt()
+		mssc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mssc *MetricSeriesStateCreate) check() error {
+	if _, ok := mssc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "MetricSeriesState.tenant_id"`)}
+	}
+	if v, ok := mssc.mutation.TenantID(); ok {
+		if err := metricseriesstate.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "MetricSeriesState.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := mssc.mutation.SeriesID(); !ok {
+		return &ValidationError{Name: "series_id", err: errors.New(`ent: missing required field "MetricSeriesState.series_id"`)}
+	}
+	if v, ok := mssc.mutation.SeriesID(); ok {
+		if err := metricseriesstate.SeriesIDValidator(v); err != nil {
+			return &ValidationError{Name: "series_id", err: fmt.Errorf(`ent: validator failed for field "MetricSeriesState.series_id": %w`, err)}
+		}
+	}
+	if _, ok := mssc.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "MetricSeriesState.value"`)}
+	}
+	if _, ok := mssc.mutation.Count(); !ok {
+		return &ValidationError{Name: "count", err: errors.New(`ent: missing required field "MetricSeriesState.count"`)}
+	}
+	if _, ok := mssc.mutation.ValueAt(); !ok {
+		return &ValidationError{Name: "value_at", err: errors.New(`ent: missing required field "MetricSeriesState.value_at"`)}
+	}
+	if _, ok := mssc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "MetricSeriesState.created_at"`)}
+	}
+	if _, ok := mssc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "MetricSeriesState.updated_at"`)}
+	}
+	return nil
+}
+
+func (mssc *MetricSeriesStateCreate) sqlSave(ctx context.Context) (*MetricSeriesState, error) {
+	if err := mssc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mssc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mssc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	mssc.mutation.id = &_node.ID
+	mssc.mutation.done = true
+	return _node, nil
+}
+
+func (mssc *MetricSeriesStateCreate) createSpec() (*MetricSeriesState, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MetricSeriesState{config: mssc.config}
+		_spec = sqlgraph.NewCreateSpec(metricseriesstate.Table, sqlgraph.NewFieldSpec(metricseriesstate.FieldID, field.TypeInt))
+	)
+	if value, ok := mssc.mutation.TenantID(); ok {
+		_spec.SetField(metricseriesstate.FieldTenantID, field.TypeString, value)
+		_node.TenantID = value
+	}
+	if value, ok := mssc.mutation.EnvironmentID(); ok {
+		_spec.SetField(metricseriesstate.FieldEnvironmentID, field.TypeString, value)
+		_node.EnvironmentID = value
+	}
+	if value, ok := mssc.mutation.SeriesID(); ok {
+		_spec.SetField(metricseriesstate.FieldSeriesID, field.TypeString, value)
+		_node.SeriesID = value
+	}
+	if value, ok := mssc.mutation.Value(); ok {
+		_spec.SetField(metricseriesstate.FieldValue, field.TypeFloat64, value)
+		_node.Value = value
+	}
+	if value, ok := mssc.mutation.Count(); ok {
+		_spec.SetField(metricseriesstate.FieldCount, field.TypeInt64, value)
+		_node.Count = value
+	}
+	if value, ok := mssc.mutation.StartTime(); ok {
+		_spec.SetField(metricseriesstate.FieldStartTime, field.TypeTime, value)
+		_node.StartTime = &value
+	}
+	if value, ok := mssc.mutation.ValueAt(); ok {
+		_spec.SetField(metricseriesstate.FieldValueAt, field.TypeTime, value)
+		_node.ValueAt = value
+	}
+	if value, ok := mssc.mutation.PreviousValue(); ok {
+		_spec.SetField(metricseriesstate.FieldPreviousValue, field.TypeFloat64, value)
+		_node.PreviousValue = &value
+	}
+	if value, ok := mssc.mutation.PreviousCount(); ok {
+		_spec.SetField(metricseriesstate.FieldPreviousCount, field.TypeInt64, value)
+		_node.PreviousCount = &value
+	}
+	if value, ok := mssc.mutation.PreviousStartTime(); ok {
+		_spec.SetField(metricseriesstate.FieldPreviousStartTime, field.TypeTime, value)
+		_node.PreviousStartTime = &value
+	}
+	if value, ok := mssc.mutation.PreviousValueAt(); ok {
+		_spec.SetField(metricseriesstate.FieldPreviousValueAt, field.TypeTime, value)
+		_node.PreviousValueAt = &value
+	}
+	if value, ok := mssc.mutation.CreatedAt(); ok {
+		_spec.SetField(metricseriesstate.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := mssc.mutation.UpdatedAt(); ok {
+		_spec.SetField(metricseriesstate.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// MetricSeriesStateCreateBulk is the builder for creating many MetricSeriesState entities in bulk.
+type MetricSeriesStateCreateBulk struct {
+	config
+	err      error
+	builders []*MetricSeriesStateCreate
+}
+
+// Save creates the MetricSeriesState entities in the database.
+func (msscb *MetricSeriesStateCreateBulk) Save(ctx context.Context) ([]*MetricSeriesState, error) {
+	if msscb.err != nil {
+		return nil, msscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(msscb.builders))
+	nodes := make([]*MetricSeriesState, len(msscb.builders))
+	mutators := make([]Mutator, len(msscb.builders))
+	for i := range msscb.builders {
+		func(i int, root context.Context) {
+			builder := msscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MetricSeriesStateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, msscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, msscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, msscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (msscb *MetricSeriesStateCreateBulk) SaveX(ctx context.Context) []*MetricSeriesState {
+	v, err := msscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (msscb *MetricSeriesStateCreateBulk) Exec(ctx context.Context) error {
+	_, err := msscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (msscb *MetricSeriesStateCreateBulk) ExecX(ctx context.Context) {
+	if err := msscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
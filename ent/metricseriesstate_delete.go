@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/metricseriesstate"
+	"github.com/flexprice/flexprice/ent/predicate"
+)
+
+// MetricSeriesStateDelete is the builder for deleting a MetricSeriesState entity.
+type MetricSeriesStateDelete struct {
+	config
+	hooks    []Hook
+	mutation *MetricSeriesStateMutation
+}
+
+// Where appends a list predicates to the MetricSeriesStateDelete builder.
+func (mssd *MetricSeriesStateDelete) Where(ps ...predicate.MetricSeriesState) *MetricSeriesStateDelete {
+	mssd.mutation.Where(ps...)
+	return mssd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (mssd *MetricSeriesStateDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, mssd.sqlExec, mssd.mutation, mssd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mssd *MetricSeriesStateDelete) ExecX(ctx context.Context) int {
+	n, err := mssd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (mssd *MetricSeriesStateDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(metricseriesstate.Table, sqlgraph.NewFieldSpec(metricseriesstate.FieldID, field.TypeInt))
+	if ps := mssd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, mssd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	mssd.mutation.done = true
+	return affected, err
+}
+
+// MetricSeriesStateDeleteOne is the builder for deleting a single MetricSeriesState entity.
+type MetricSeriesStateDeleteOne struct {
+	mssd *MetricSeriesStateDelete
+}
+
+// Where appends a list predicates to the MetricSeriesStateDelete builder.
+func (mssdo *MetricSeriesStateDeleteOne) Where(ps ...predicate.MetricSeriesState) *MetricSeriesStateDeleteOne {
+	mssdo.mssd.mutation.Where(ps...)
+	return mssdo
+}
+
+// Exec executes the deletion query.
+func (mssdo *MetricSeriesStateDeleteOne) Exec(ctx context.Context) error {
+	n, err := mssdo.mssd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{metricseriesstate.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mssdo *MetricSeriesStateDeleteOne) ExecX(ctx context.Context) {
+	if err := mssdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
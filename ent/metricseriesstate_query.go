@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/metricseriesstate"
+	"github.com/flexprice/flexprice/ent/predicate"
+)
+
+// MetricSeriesStateQuery is the builder for querying MetricSeriesState entities.
+type MetricSeriesStateQuery struct {
+	config
+	ctx        *QueryContext
+	order      []metricseriesstate.OrderOption
+	inters     []Interceptor
+	predicates []predicate.MetricSeriesState
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MetricSeriesStateQuery builder.
+func (mssq *MetricSeriesStateQuery) Where(ps ...predicate.MetricSeriesState) *MetricSeriesStateQuery {
+	mssq.predicates = append(mssq.predicates, ps...)
+	return mssq
+}
+
+// Limit the number of records to be returned by this query.
+func (mssq *MetricSeriesStateQuery) Limit(limit int) *MetricSeriesStateQuery {
+	mssq.ctx.Limit = &limit
+	return mssq
+}
+
+// Offset to start from.
+func (mssq *MetricSeriesStateQuery) Offset(offset int) *MetricSeriesStateQuery {
+	mssq.ctx.Offset = &offset
+	return mssq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (mssq *MetricSeriesStateQuery) Unique(unique bool) *MetricSeriesStateQuery {
+	mssq.ctx.Unique = &unique
+	return mssq
+}
+
+// Order specifies how the records should be ordered.
+func (mssq *MetricSeriesStateQuery) Order(o ...metricseriesstate.OrderOption) *MetricSeriesStateQuery {
+	mssq.order = append(mssq.order, o...)
+	return mssq
+}
+
+// First returns the first MetricSeriesState entity from the query.
+// Returns a *NotFoundError when no MetricSeriesState was found.
+func (mssq *MetricSeriesStateQuery) First(ctx context.Context) (*MetricSeriesState, error) {
+	nodes, err := mssq.Limit(1).All(setContextOp(ctx, mssq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{metricseriesstate.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (mssq *MetricSeriesStateQuery) FirstX(ctx context.Context) *MetricSeriesState {
+	node, err := mssq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first MetricSeriesState ID from the query.
+// Returns a *NotFoundError when no MetricSeriesState ID was found.
+func (mssq *MetricSeriesStateQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = mssq.Limit(1).IDs(setContextOp(ctx, mssq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{metricseriesstate.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (mssq *MetricSeriesStateQuery) FirstIDX(ctx context.Context) int {
+	id, err := mssq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single MetricSeriesState entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one MetricSeriesState entity is found.
+// Returns a *NotFoundError when no MetricSeriesState entities are found.
+func (mssq *MetricSeriesStateQuery) Only(ctx context.Context) (*MetricSeriesState, error) {
+	nodes, err := mssq.Limit(2).All(setContextOp(ctx, mssq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{metricseriesstate.Label}
+	default:
+		return nil, &NotSingularError{metricseriesstate.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (mssq *MetricSeriesStateQuery) OnlyX(ctx context.Context) *MetricSeriesState {
+	node, err := mssq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only MetricSeriesState ID in the query.
+// Returns a *NotSingularError when more than one MetricSeriesState ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (mssq *MetricSeriesStateQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = mssq.Limit(2).IDs(setContextOp(ctx, mssq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{metricseriesstate.Label}
+	default:
+		err = &NotSingularError{metricseriesstate.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (mssq *MetricSeriesStateQuery) OnlyIDX(ctx context.Context) int {
+	id, err := mssq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of MetricSeriesStates.
+func (mssq *MetricSeriesStateQuery) All(ctx context.Context) ([]*MetricSeriesState, error) {
+	ctx = setContextOp(ctx, mssq.ctx, ent.OpQueryAll)
+	if err := mssq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*MetricSeriesState, *MetricSeriesStateQuery]()
+	return withInterceptors[[]*MetricSeriesState](ctx, mssq, qr, mssq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (mssq *MetricSeriesStateQuery) AllX(ctx context.Context) []*MetricSeriesState {
+	nodes, err := mssq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of MetricSeriesState IDs.
+func (mssq *MetricSeriesStateQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if mssq.ctx.Unique == nil && mssq.path != nil {
+		mssq.Unique(true)
+	}
+	ctx = setContextOp(ctx, mssq.ctx, ent.OpQueryIDs)
+	if err = mssq.Select(metricseriesstate.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (mssq *MetricSeriesStateQuery) IDsX(ctx context.Context) []int {
+	ids, err := mssq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (mssq *MetricSeriesStateQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, mssq.ctx, ent.OpQueryCount)
+	if err := mssq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, mssq, querierCount[*MetricSeriesStateQuery](), mssq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (mssq *MetricSeriesStateQuery) CountX(ctx context.Context) int {
+	count, err := mssq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (mssq *MetricSeriesStateQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, mssq.ctx, ent.OpQueryExist)
+	switch _, err := mssq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (mssq *MetricSeriesStateQuery) ExistX(ctx context.Context) bool {
+	exist, err := mssq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MetricSeriesStateQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (mssq *MetricSeriesStateQuery) Clone() *MetricSeriesStateQuery {
+	if mssq == nil {
+		return nil
+	}
+	return &MetricSeriesStateQuery{
+		config:     mssq.config,
+		ctx:        mssq.ctx.Clone(),
+		order:      append([]metricseriesstate.OrderOption{}, mssq.order...),
+		inters:     append([]Interceptor{}, mssq.inters...),
+		predicates: append([]predicate.MetricSeriesState{}, mssq.predicates...),
+		// clone intermediate query.
+		sql:  mssq.sql.Clone(),
+		path: mssq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID string `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.MetricSeriesState.Query().
+//		GroupBy(metricseriesstate.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (mssq *MetricSeriesStateQuery) GroupBy(field string, fields ...string) *MetricSeriesStateGroupBy {
+	mssq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &MetricSeriesStateGroupBy{build: mssq}
+	grbuild.flds = &mssq.ctx.Fields
+	grbuild.label = metricseriesstate.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID string `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.MetricSeriesState.Query().
+//		Select(metricseriesstate.FieldTenantID).
+//		Scan(ctx, &v)
+func (mssq *MetricSeriesStateQuery) Select(fields ...string) *MetricSeriesStateSelect {
+	mssq.ctx.Fields = append(mssq.ctx.Fields, fields...)
+	sbuild := &MetricSeriesStateSelect{MetricSeriesStateQuery: mssq}
+	sbuild.label = metricseriesstate.Label
+	sbuild.flds, sbuild.scan = &mssq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a MetricSeriesStateSelect configured with the given aggregations.
+func (mssq *MetricSeriesStateQuery) Aggregate(fns ...AggregateFunc) *MetricSeriesStateSelect {
+	return mssq.Select().Aggregate(fns...)
+}
+
+func (mssq *MetricSeriesStateQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range mssq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, mssq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range mssq.ctx.Fields {
+		if !metricseriesstate.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if mssq.path != nil {
+		prev, err := mssq.path(ctx)
+		if err != nil {
+			return err
+		}
+		mssq.sql = prev
+	}
+	return nil
+}
+
+func (mssq *MetricSeriesStateQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*MetricSeriesState, error) {
+	var (
+		nodes = []*MetricSeriesState{}
+		_spec = mssq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*MetricSeriesState).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &MetricSeriesState{config: mssq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, mssq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (mssq *MetricSeriesStateQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := mssq.querySpec()
+	_spec.Node.Columns = mssq.ctx.Fields
+	if len(mssq.ctx.Fields) > 0 {
+		_spec.Unique = mssq.ctx.Unique != nil && *mssq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, mssq.driver, _spec)
+}
+
+func (mssq *MetricSeriesStateQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(metricseriesstate.Table, metricseriesstate.Columns, sqlgraph.NewFieldSpec(metricseriesstate.FieldID, field.TypeInt))
+	_spec.From = mssq.sql
+	if unique := mssq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if mssq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := mssq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, metricseriesstate.FieldID)
+		for i := range fields {
+			if fields[i] != metricseriesstate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := mssq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := mssq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := mssq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := mssq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (mssq *MetricSeriesStateQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(mssq.driver.Dialect())
+	t1 := builder.Table(metricseriesstate.Table)
+	columns := mssq.ctx.Fields
+	if len(columns) == 0 {
+		columns = metricseriesstate.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if mssq.sql != nil {
+		selector = mssq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if mssq.ctx.Unique != nil && *mssq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range mssq.predicates {
+		p(selector)
+	}
+	for _, p := range mssq.order {
+		p(selector)
+	}
+	if offset := mssq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := mssq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// MetricSeriesStateGroupBy is the group-by builder for MetricSeriesState entities.
+type MetricSeriesStateGroupBy struct {
+	selector
+	build *MetricSeriesStateQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (mssgb *MetricSeriesStateGroupBy) Aggregate(fns ...AggregateFunc) *MetricSeriesStateGroupBy {
+	mssgb.fns = append(mssgb.fns, fns...)
+	return mssgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mssgb *MetricSeriesStateGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mssgb.build.ctx, ent.OpQueryGroupBy)
+	if err := mssgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MetricSeriesStateQuery, *MetricSeriesStateGroupBy](ctx, mssgb.build, mssgb, mssgb.build.inters, v)
+}
+
+func (mssgb *MetricSeriesStateGroupBy) sqlScan(ctx context.Context, root *MetricSeriesStateQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(mssgb.fns))
+	for _, fn := range mssgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*mssgb.flds)+len(mssgb.fns))
+		for _, f := range *mssgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*mssgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mssgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// MetricSeriesStateSelect is the builder for selecting fields of MetricSeriesState entities.
+type MetricSeriesStateSelect struct {
+	*MetricSeriesStateQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (msss *MetricSeriesStateSelect) Aggregate(fns ...AggregateFunc) *MetricSeriesStateSelect {
+	msss.fns = append(msss.fns, fns...)
+	return msss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (msss *MetricSeriesStateSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, msss.ctx, ent.OpQuerySelect)
+	if err := msss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MetricSeriesStateQuery, *MetricSeriesStateSelect](ctx, msss.MetricSeriesStateQuery, msss, msss.inters, v)
+}
+
+func (msss *MetricSeriesStateSelect) sqlScan(ctx context.Context, root *MetricSeriesStateQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(msss.fns))
+	for _, fn := range msss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*msss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := msss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
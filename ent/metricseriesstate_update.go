@@ -0,0 +1,810 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/metricseriesstate"
+	"github.com/flexprice/flexprice/ent/predicate"
+)
+
+// MetricSeriesStateUpdate is the builder for updating MetricSeriesState entities.
+type MetricSeriesStateUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MetricSeriesStateMutation
+}
+
+// Where appends a list predicates to the MetricSeriesStateUpdate builder.
+func (mssu *MetricSeriesStateUpdate) Where(ps ...predicate.MetricSeriesState) *MetricSeriesStateUpdate {
+	mssu.mutation.Where(ps...)
+	return mssu
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (mssu *MetricSeriesStateUpdate) SetTenantID(s string) *MetricSeriesStateUpdate {
+	mssu.mutation.SetTenantID(s)
+	return mssu
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (mssu *MetricSeriesStateUpdate) SetNillableTenantID(s *string) *MetricSeriesStateUpdate {
+	if s != nil {
+		mssu.SetTenantID(*s)
+	}
+	return mssu
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (mssu *MetricSeriesStateUpdate) SetEnvironmentID(s string) *MetricSeriesStateUpdate {
+	mssu.mutation.SetEnvironmentID(s)
+	return mssu
+}
+
+// SetNillableEnvironmentID sets the "environment_id" field if the given value is not nil.
+func (mssu *MetricSeriesStateUpdate) SetNillableEnvironmentID(s *string) *MetricSeriesStateUpdate {
+	if s != nil {
+		mssu.SetEnvironmentID(*s)
+	}
+	return mssu
+}
+
+// ClearEnvironmentID clears the value of the "environment_id" field.
+func (mssu *MetricSeriesStateUpdate) ClearEnvironmentID() *MetricSeriesStateUpdate {
+	mssu.mutation.ClearEnvironmentID()
+	return mssu
+}
+
+// SetSeriesID sets the "series_id" field.
+func (mssu *MetricSeriesStateUpdate) SetSeriesID(s string) *MetricSeriesStateUpdate {
+	mssu.mutation.SetSeriesID(s)
+	return mssu
+}
+
+// SetNillableSeriesID sets the "series_id" field if the given value is not nil.
+func (mssu *MetricSeriesStateUpdate) SetNillableSeriesID(s *string) *MetricSeriesStateUpdate {
+	if s != nil {
+		mssu.SetSeriesID(*s)
+	}
+	return mssu
+}
+
+// SetValue sets the "value" field.
+func (mssu *MetricSeriesStateUpdate) SetValue(f float64) *MetricSeriesStateUpdate {
+	mssu.mutation.ResetValue()
+	mssu.mutation.SetValue(f)
+	return mssu
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (mssu *MetricSeriesStateUpdate) SetNillableValue(f *float64) *MetricSeriesStateUpdate {
+	if f != nil {
+		mssu.SetValue(*f)
+	}
+	return mssu
+}
+
+// AddValue adds f to the "value" field.
+func (mssu *MetricSeriesStateUpdate) AddValue(f float64) *MetricSeriesStateUpdate {
+	mssu.mutation.AddValue(f)
+	return mssu
+}
+
+// SetCount sets the "count" field.
+func (mssu *MetricSeriesStateUpdate) SetCount(i int64) *MetricSeriesStateUpdate {
+	mssu.mutation.ResetCount()
+	mssu.mutation.SetCount(i)
+	return mssu
+}
+
+// SetNillableCount sets the "count" field if the given value is not nil.
+func (mssu *MetricSeriesStateUpdate) SetNillableCount(i *int64) *MetricSeriesStateUpdate {
+	if i != nil {
+		mssu.SetCount(*i)
+	}
+	return mssu
+}
+
+// AddCount adds i to the "count" field.
+func (mssu *MetricSeriesStateUpdate) AddCount(i int64) *MetricSeriesStateUpdate {
+	mssu.mutation.AddCount(i)
+	return mssu
+}
+
+// SetStartTime sets the "start_time" field.
+func (mssu *MetricSeriesStateUpdate) SetStartTime(t time.Time) *MetricSeriesStateUpdate {
+	mssu.mutation.SetStartTime(t)
+	return mssu
+}
+
+// SetNillableStartTime sets the "start_time" field if the given value is not nil.
+func (mssu *MetricSeriesStateUpdate) SetNillableStartTime(t *time.Time) *MetricSeriesStateUpdate {
+	if t != nil {
+		mssu.SetStartTime(*t)
+	}
+	return mssu
+}
+
+// ClearStartTime clears the value of the "start_time" field.
+func (mssu *MetricSeriesStateUpdate) ClearStartTime() *MetricSeriesStateUpdate {
+	mssu.mutation.ClearStartTime()
+	return mssu
+}
+
+// SetValueAt sets the "value_at" field.
+func (mssu *MetricSeriesStateUpdate) SetValueAt(t time.Time) *MetricSeriesStateUpdate {
+	mssu.mutation.SetValueAt(t)
+	return mssu
+}
+
+// SetNillableValueAt sets the "value_at" field if the given value is not nil.
+func (mssu *MetricSeriesStateUpdate) SetNillableValueAt(t *time.Time) *MetricSeriesStateUpdate {
+	if t != nil {
+		mssu.SetValueAt(*t)
+	}
+	return mssu
+}
+
+// SetPreviousValue sets the "previous_value" field.
+func (mssu *MetricSeriesStateUpdate) SetPreviousValue(f float64) *MetricSeriesStateUpdate {
+	mssu.mutation.ResetPreviousValue()
+	mssu.mutation.SetPreviousValue(f)
+	return mssu
+}
+
+// SetNillablePreviousValue sets the "previous_value" field if the given value is not nil.
+func (mssu *MetricSeriesStateUpdate) SetNillablePreviousValue(f *float64) *MetricSeriesStateUpdate {
+	if f != nil {
+		mssu.SetPreviousValue(*f)
+	}
+	return mssu
+}
+
+// AddPreviousValue adds f to the "previous_value" field.
+func (mssu *MetricSeriesStateUpdate) AddPreviousValue(f float64) *MetricSeriesStateUpdate {
+	mssu.mutation.AddPreviousValue(f)
+	return mssu
+}
+
+// ClearPreviousValue clears the value of the "previous_value" field.
+func (mssu *MetricSeriesStateUpdate) ClearPreviousValue() *MetricSeriesStateUpdate {
+	mssu.mutation.ClearPreviousValue()
+	return mssu
+}
+
+// SetPreviousCount sets the "previous_count" field.
+func (mssu *MetricSeriesStateUpdate) SetPreviousCount(i int64) *MetricSeriesStateUpdate {
+	mssu.mutation.ResetPreviousCount()
+	mssu.mutation.SetPreviousCount(i)
+	return mssu
+}
+
+// SetNillablePreviousCount sets the "previous_count" field if the given value is not nil.
+func (mssu *MetricSeriesStateUpdate) SetNillablePreviousCount(i *int64) *MetricSeriesStateUpdate {
+	if i != nil {
+		mssu.SetPreviousCount(*i)
+	}
+	return mssu
+}
+
+// AddPreviousCount adds i to the "previous_count" field.
+func (mssu *MetricSeriesStateUpdate) AddPreviousCount(i int64) *MetricSeriesStateUpdate {
+	mssu.mutation.AddPreviousCount(i)
+	return mssu
+}
+
+// ClearPreviousCount clears the value of the "previous_count" field.
+func (mssu *MetricSeriesStateUpdate) ClearPreviousCount() *MetricSeriesStateUpdate {
+	mssu.mutation.ClearPreviousCount()
+	return mssu
+}
+
+// SetPreviousStartTime sets the "previous_start_time" field.
+func (mssu *MetricSeriesStateUpdate) SetPreviousStartTime(t time.Time) *MetricSeriesStateUpdate {
+	mssu.mutation.SetPreviousStartTime(t)
+	return mssu
+}
+
+// SetNillablePreviousStartTime sets the "previous_start_time" field if the given value is not nil.
+func (mssu *MetricSeriesStateUpdate) SetNillablePreviousStartTime(t *time.Time) *MetricSeriesStateUpdate {
+	if t != nil {
+		mssu.SetPreviousStartTime(*t)
+	}
+	return mssu
+}
+
+// ClearPreviousStartTime clears the value of the "previous_start_time" field.
+func (mssu *MetricSeriesStateUpdate) ClearPreviousStartTime() *MetricSeriesStateUpdate {
+	mssu.mutation.ClearPreviousStartTime()
+	return mssu
+}
+
+// SetPreviousValueAt sets the "previous_value_at" field.
+func (mssu *MetricSeriesStateUpdate) SetPreviousValueAt(t time.Time) *MetricSeriesStateUpdate {
+	mssu.mutation.SetPreviousValueAt(t)
+	return mssu
+}
+
+// SetNillablePreviousValueAt sets the "previous_value_at" field if the given value is not nil.
+func (mssu *MetricSeriesStateUpdate) SetNillablePreviousValueAt(t *time.Time) *MetricSeriesStateUpdate {
+	if t != nil {
+		mssu.SetPreviousValueAt(*t)
+	}
+	return mssu
+}
+
+// ClearPreviousValueAt clears the value of the "previous_value_at" field.
+func (mssu *MetricSeriesStateUpdate) ClearPreviousValueAt() *MetricSeriesStateUpdate {
+	mssu.mutation.ClearPreviousValueAt()
+	return mssu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mssu *MetricSeriesStateUpdate) SetUpdatedAt(t time.Time) *MetricSeriesStateUpdate {
+	mssu.mutation.SetUpdatedAt(t)
+	return mssu
+}
+
+// Mutation returns the MetricSeriesStateMutation object of the builder.
+func (mssu *MetricSeriesStateUpdate) Mutation() *MetricSeriesStateMutation {
+	return mssu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mssu *MetricSeriesStateUpdate) Save(ctx context.Context) (int, error) {
+	mssu.defaults()
+	return withHooks(ctx, mssu.sqlSave, mssu.mutation, mssu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mssu *MetricSeriesStateUpdate) SaveX(ctx context.Context) int {
+	affected, err := mssu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mssu *MetricSeriesStateUpdate) Exec(ctx context.Context) error {
+	_, err := mssu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mssu *MetricSeriesStateUpdate) ExecX(ctx context.Context) {
+	if err := mssu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mssu *MetricSeriesStateUpdate) defaults() {
+	if _, ok := mssu.mutation.UpdatedAt(); !ok {
+		v := metricseriesstate.UpdateDefaultUpdatedAt()
+		mssu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mssu *MetricSeriesStateUpdate) check() error {
+	if v, ok := mssu.mutation.TenantID(); ok {
+		if err := metricseriesstate.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "MetricSeriesState.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := mssu.mutation.SeriesID(); ok {
+		if err := metricseriesstate.SeriesIDValidator(v); err != nil {
+			return &ValidationError{Name: "series_id", err: fmt.Errorf(`ent: validator failed for field "MetricSeriesState.series_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (mssu *MetricSeriesStateUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mssu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(metricseriesstate.Table, metricseriesstate.Columns, sqlgraph.NewFieldSpec(metricseriesstate.FieldID, field.TypeInt))
+	if ps := mssu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mssu.mutation.TenantID(); ok {
+		_spec.SetField(metricseriesstate.FieldTenantID, field.TypeString, value)
+	}
+	if value, ok := mssu.mutation.EnvironmentID(); ok {
+		_spec.SetField(metricseriesstate.FieldEnvironmentID, field.TypeString, value)
+	}
+	if mssu.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(metricseriesstate.FieldEnvironmentID, field.TypeString)
+	}
+	if value, ok := mssu.mutation.SeriesID(); ok {
+		_spec.SetField(metricseriesstate.FieldSeriesID, field.TypeString, value)
+	}
+	if value, ok := mssu.mutation.Value(); ok {
+		_spec.SetField(metricseriesstate.FieldValue, field.TypeFloat64, value)
+	}
+	if value, ok := mssu.mutation.AddedValue(); ok {
+		_spec.AddField(metricseriesstate.FieldValue, field.TypeFloat64, value)
+	}
+	if value, ok := mssu.mutation.Count(); ok {
+		_spec.SetField(metricseriesstate.FieldCount, field.TypeInt64, value)
+	}
+	if value, ok := mssu.mutation.AddedCount(); ok {
+		_spec.AddField(metricseriesstate.FieldCount, field.TypeInt64, value)
+	}
+	if value, ok := mssu.mutation.StartTime(); ok {
+		_spec.SetField(metricseriesstate.FieldStartTime, field.TypeTime, value)
+	}
+	if mssu.mutation.StartTimeCleared() {
+		_spec.ClearField(metricseriesstate.FieldStartTime, field.TypeTime)
+	}
+	if value, ok := mssu.mutation.ValueAt(); ok {
+		_spec.SetField(metricseriesstate.FieldValueAt, field.TypeTime, value)
+	}
+	if value, ok := mssu.mutation.PreviousValue(); ok {
+		_spec.SetField(metricseriesstate.FieldPreviousValue, field.TypeFloat64, value)
+	}
+	if value, ok := mssu.mutation.AddedPreviousValue(); ok {
+		_spec.AddField(metricseriesstate.FieldPreviousValue, field.TypeFloat64, value)
+	}
+	if mssu.mutation.PreviousValueCleared() {
+		_spec.ClearField(metricseriesstate.FieldPreviousValue, field.TypeFloat64)
+	}
+	if value, ok := mssu.mutation.PreviousCount(); ok {
+		_spec.SetField(metricseriesstate.FieldPreviousCount, field.TypeInt64, value)
+	}
+	if value, ok := mssu.mutation.AddedPreviousCount(); ok {
+		_spec.AddField(metricseriesstate.FieldPreviousCount, field.TypeInt64, value)
+	}
+	if mssu.mutation.PreviousCountCleared() {
+		_spec.ClearField(metricseriesstate.FieldPreviousCount, field.TypeInt64)
+	}
+	if value, ok := mssu.mutation.PreviousStartTime(); ok {
+		_spec.SetField(metricseriesstate.FieldPreviousStartTime, field.TypeTime, value)
+	}
+	if mssu.mutation.PreviousStartTimeCleared() {
+		_spec.ClearField(metricseriesstate.FieldPreviousStartTime, field.TypeTime)
+	}
+	if value, ok := mssu.mutation.PreviousValueAt(); ok {
+		_spec.SetField(metricseriesstate.FieldPreviousValueAt, field.TypeTime, value)
+	}
+	if mssu.mutation.PreviousValueAtCleared() {
+		_spec.ClearField(metricseriesstate.FieldPreviousValueAt, field.TypeTime)
+	}
+	if value, ok := mssu.mutation.UpdatedAt(); ok {
+		_spec.SetField(metricseriesstate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mssu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{metricseriesstate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mssu.mutation.done = true
+	return n, nil
+}
+
+// MetricSeriesStateUpdateOne is the builder for updating a single MetricSeriesState entity.
+type MetricSeriesStateUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MetricSeriesStateMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (mssuo *MetricSeriesStateUpdateOne) SetTenantID(s string) *MetricSeriesStateUpdateOne {
+	mssuo.mutation.SetTenantID(s)
+	return mssuo
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (mssuo *MetricSeriesStateUpdateOne) SetNillableTenantID(s *string) *MetricSeriesStateUpdateOne {
+	if s != nil {
+		mssuo.SetTenantID(*s)
+	}
+	return mssuo
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (mssuo *MetricSeriesStateUpdateOne) SetEnvironmentID(s string) *MetricSeriesStateUpdateOne {
+	mssuo.mutation.SetEnvironmentID(s)
+	return mssuo
+}
+
+// SetNillableEnvironmentID sets the "environment_id" field if the given value is not nil.
+func (mssuo *MetricSeriesStateUpdateOne) SetNillableEnvironmentID(s *string) *MetricSeriesStateUpdateOne {
+	if s != nil {
+		mssuo.SetEnvironmentID(*s)
+	}
+	return mssuo
+}
+
+// ClearEnvironmentID clears the value of the "environment_id" field.
+func (mssuo *MetricSeriesStateUpdateOne) ClearEnvironmentID() *MetricSeriesStateUpdateOne {
+	mssuo.mutation.ClearEnvironmentID()
+	return mssuo
+}
+
+// SetSeriesID sets the "series_id" field.
+func (mssuo *MetricSeriesStateUpdateOne) SetSeriesID(s string) *MetricSeriesStateUpdateOne {
+	mssuo.mutation.SetSeriesID(s)
+	return mssuo
+}
+
+// SetNillableSeriesID sets the "series_id" field if the given value is not nil.
+func (mssuo *MetricSeriesStateUpdateOne) SetNillableSeriesID(s *string) *MetricSeriesStateUpdateOne {
+	if s != nil {
+		mssuo.SetSeriesID(*s)
+	}
+	return mssuo
+}
+
+// SetValue sets the "value" field.
+func (mssuo *MetricSeriesStateUpdateOne) SetValue(f float64) *MetricSeriesStateUpdateOne {
+	mssuo.mutation.ResetValue()
+	mssuo.mutation.SetValue(f)
+	return mssuo
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (mssuo *MetricSeriesStateUpdateOne) SetNillableValue(f *float64) *MetricSeriesStateUpdateOne {
+	if f != nil {
+		mssuo.SetValue(*f)
+	}
+	return mssuo
+}
+
+// AddValue adds f to the "value" field.
+func (mssuo *MetricSeriesStateUpdateOne) AddValue(f float64) *MetricSeriesStateUpdateOne {
+	mssuo.mutation.AddValue(f)
+	return mssuo
+}
+
+// SetCount sets the "count" field.
+func (mssuo *MetricSeriesStateUpdateOne) SetCount(i int64) *MetricSeriesStateUpdateOne {
+	mssuo.mutation.ResetCount()
+	mssuo.mutation.SetCount(i)
+	return mssuo
+}
+
+// SetNillableCount sets the "count" field if the given value is not nil.
+func (mssuo *MetricSeriesStateUpdateOne) SetNillableCount(i *int64) *MetricSeriesStateUpdateOne {
+	if i != nil {
+		mssuo.SetCount(*i)
+	}
+	return mssuo
+}
+
+// AddCount adds i to the "count" field.
+func (mssuo *MetricSeriesStateUpdateOne) AddCount(i int64) *MetricSeriesStateUpdateOne {
+	mssuo.mutation.AddCount(i)
+	return mssuo
+}
+
+// SetStartTime sets the "start_time" field.
+func (mssuo *MetricSeriesStateUpdateOne) SetStartTime(t time.Time) *MetricSeriesStateUpdateOne {
+	mssuo.mutation.SetStartTime(t)
+	return mssuo
+}
+
+// SetNillableStartTime sets the "start_time" field if the given value is not nil.
+func (mssuo *MetricSeriesStateUpdateOne) SetNillableStartTime(t *time.Time) *MetricSeriesStateUpdateOne {
+	if t != nil {
+		mssuo.SetStartTime(*t)
+	}
+	return mssuo
+}
+
+// ClearStartTime clears the value of the "start_time" field.
+func (mssuo *MetricSeriesStateUpdateOne) ClearStartTime() *MetricSeriesStateUpdateOne {
+	mssuo.mutation.ClearStartTime()
+	return mssuo
+}
+
+// SetValueAt sets the "value_at" field.
+func (mssuo *MetricSeriesStateUpdateOne) SetValueAt(t time.Time) *MetricSeriesStateUpdateOne {
+	mssuo.mutation.SetValueAt(t)
+	return mssuo
+}
+
+// SetNillableValueAt sets the "value_at" field if the given value is not nil.
+func (mssuo *MetricSeriesStateUpdateOne) SetNillableValueAt(t *time.Time) *MetricSeriesStateUpdateOne {
+	if t != nil {
+		mssuo.SetValueAt(*t)
+	}
+	return mssuo
+}
+
+// SetPreviousValue sets the "previous_value" field.
+func (mssuo *MetricSeriesStateUpdateOne) SetPreviousValue(f float64) *MetricSeriesStateUpdateOne {
+	mssuo.mutation.ResetPreviousValue()
+	mssuo.mutation.SetPreviousValue(f)
+	return mssuo
+}
+
+// SetNillablePreviousValue sets the "previous_value" field if the given value is not nil.
+func (mssuo *MetricSeriesStateUpdateOne) SetNillablePreviousValue(f *float64) *MetricSeriesStateUpdateOne {
+	if f != nil {
+		mssuo.SetPreviousValue(*f)
+	}
+	return mssuo
+}
+
+// AddPreviousValue adds f to the "previous_value" field.
+func (mssuo *MetricSeriesStateUpdateOne) AddPreviousValue(f float64) *MetricSeriesStateUpdateOne {
+	mssuo.mutation.AddPreviousValue(f)
+	return mssuo
+}
+
+// ClearPreviousValue clears the value of the "previous_value" field.
+func (mssuo *MetricSeriesStateUpdateOne) ClearPreviousValue() *MetricSeriesStateUpdateOne {
+	mssuo.mutation.ClearPreviousValue()
+	return mssuo
+}
+
+// SetPreviousCount sets the "previous_count" field.
+func (mssuo *MetricSeriesStateUpdateOne) SetPreviousCount(i int64) *MetricSeriesStateUpdateOne {
+	mssuo.mutation.ResetPreviousCount()
+	mssuo.mutation.SetPreviousCount(i)
+	return mssuo
+}
+
+// SetNillablePreviousCount sets the "previous_count" field if the given value is not nil.
+func (mssuo *MetricSeriesStateUpdateOne) SetNillablePreviousCount(i *int64) *MetricSeriesStateUpdateOne {
+	if i != nil {
+		mssuo.SetPreviousCount(*i)
+	}
+	return mssuo
+}
+
+// AddPreviousCount adds i to the "previous_count" field.
+func (mssuo *MetricSeriesStateUpdateOne) AddPreviousCount(i int64) *MetricSeriesStateUpdateOne {
+	mssuo.mutation.AddPreviousCount(i)
+	return mssuo
+}
+
+// ClearPreviousCount clears the value of the "previous_count" field.
+func (mssuo *MetricSeriesStateUpdateOne) ClearPreviousCount() *MetricSeriesStateUpdateOne {
+	mssuo.mutation.ClearPreviousCount()
+	return mssuo
+}
+
+// SetPreviousStartTime sets the "previous_start_time" field.
+func (mssuo *MetricSeriesStateUpdateOne) SetPreviousStartTime(t time.Time) *MetricSeriesStateUpdateOne {
+	mssuo.mutation.SetPreviousStartTime(t)
+	return mssuo
+}
+
+// SetNillablePreviousStartTime sets the "previous_start_time" field if the given value is not nil.
+func (mssuo *MetricSeriesStateUpdateOne) SetNillablePreviousStartTime(t *time.Time) *MetricSeriesStateUpdateOne {
+	if t != nil {
+		mssuo.SetPreviousStartTime(*t)
+	}
+	return mssuo
+}
+
+// ClearPreviousStartTime clears the value of the "previous_start_time" field.
+func (mssuo *MetricSeriesStateUpdateOne) ClearPreviousStartTime() *MetricSeriesStateUpdateOne {
+	mssuo.mutation.ClearPreviousStartTime()
+	return mssuo
+}
+
+// SetPreviousValueAt sets the "previous_value_at" field.
+func (mssuo *MetricSeriesStateUpdateOne) SetPreviousValueAt(t time.Time) *MetricSeriesStateUpdateOne {
+	mssuo.mutation.SetPreviousValueAt(t)
+	return mssuo
+}
+
+// SetNillablePreviousValueAt sets the "previous_value_at" field if the given value is not nil.
+func (mssuo *MetricSeriesStateUpdateOne) SetNillablePreviousValueAt(t *time.Time) *MetricSeriesStateUpdateOne {
+	if t != nil {
+		mssuo.SetPreviousValueAt(*t)
+	}
+	return mssuo
+}
+
+// ClearPreviousValueAt clears the value of the "previous_value_at" field.
+func (mssuo *MetricSeriesStateUpdateOne) ClearPreviousValueAt() *MetricSeriesStateUpdateOne {
+	mssuo.mutation.ClearPreviousValueAt()
+	return mssuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mssuo *MetricSeriesStateUpdateOne) SetUpdatedAt(t time.Time) *MetricSeriesStateUpdateOne {
+	mssuo.mutation.SetUpdatedAt(t)
+	return mssuo
+}
+
+// Mutation returns the MetricSeriesStateMutation object of the builder.
+func (mssuo *MetricSeriesStateUpdateOne) Mutation() *MetricSeriesStateMutation {
+	return mssuo.mutation
+}
+
+// Where appends a list predicates to the MetricSeriesStateUpdate builder.
+func (mssuo *MetricSeriesStateUpdateOne) Where(ps ...predicate.MetricSeriesState) *MetricSeriesStateUpdateOne {
+	mssuo.mutation.Where(ps...)
+	return mssuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mssuo *MetricSeriesStateUpdateOne) Select(field string, fields ...string) *MetricSeriesStateUpdateOne {
+	mssuo.fields = append([]string{field}, fields...)
+	return mssuo
+}
+
+// Save executes the query and returns the updated MetricSeriesState entity.
+func (mssuo *MetricSeriesStateUpdateOne) Save(ctx context.Context) (*MetricSeriesState, error) {
+	mssuo.defaults()
+	return withHooks(ctx, mssuo.sqlSave, mssuo.mutation, mssuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mssuo *MetricSeriesStateUpdateOne) SaveX(ctx context.Context) *MetricSeriesState {
+	node, err := mssuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mssuo *MetricSeriesStateUpdateOne) Exec(ctx context.Context) error {
+	_, err := mssuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mssuo *MetricSeriesStateUpdateOne) ExecX(ctx context.Context) {
+	if err := mssuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mssuo *MetricSeriesStateUpdateOne) defaults() {
+	if _, ok := mssuo.mutation.UpdatedAt(); !ok {
+		v := metricseriesstate.UpdateDefaultUpdatedAt()
+		mssuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mssuo *MetricSeriesStateUpdateOne) check() error {
+	if v, ok := mssuo.mutation.TenantID(); ok {
+		if err := metricseriesstate.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "MetricSeriesState.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := mssuo.mutation.SeriesID(); ok {
+		if err := metricseriesstate.SeriesIDValidator(v); err != nil {
+			return &ValidationError{Name: "series_id", err: fmt.Errorf(`ent: validator failed for field "MetricSeriesState.series_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (mssuo *MetricSeriesStateUpdateOne) sqlSave(ctx context.Context) (_node *MetricSeriesState, err error) {
+	if err := mssuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(metricseriesstate.Table, metricseriesstate.Columns, sqlgraph.NewFieldSpec(metricseriesstate.FieldID, field.TypeInt))
+	id, ok := mssuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MetricSeriesState.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mssuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, metricseriesstate.FieldID)
+		for _, f := range fields {
+			if !metricseriesstate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != metricseriesstate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mssuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mssuo.mutation.TenantID(); ok {
+		_spec.SetField(metricseriesstate.FieldTenantID, field.TypeString, value)
+	}
+	if value, ok := mssuo.mutation.EnvironmentID(); ok {
+		_spec.SetField(metricseriesstate.FieldEnvironmentID, field.TypeString, value)
+	}
+	if mssuo.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(metricseriesstate.FieldEnvironmentID, field.TypeString)
+	}
+	if value, ok := mssuo.mutation.SeriesID(); ok {
+		_spec.SetField(metricseriesstate.FieldSeriesID, field.TypeString, value)
+	}
+	if value, ok := mssuo.mutation.Value(); ok {
+		_spec.SetField(metricseriesstate.FieldValue, field.TypeFloat64, value)
+	}
+	if value, ok := mssuo.mutation.AddedValue(); ok {
+		_spec.AddField(metricseriesstate.FieldValue, field.TypeFloat64, value)
+	}
+	if value, ok := mssuo.mutation.Count(); ok {
+		_spec.SetField(metricseriesstate.FieldCount, field.TypeInt64, value)
+	}
+	if value, ok := mssuo.mutation.AddedCount(); ok {
+		_spec.AddField(metricseriesstate.FieldCount, field.TypeInt64, value)
+	}
+	if value, ok := mssuo.mutation.StartTime(); ok {
+		_spec.SetField(metricseriesstate.FieldStartTime, field.TypeTime, value)
+	}
+	if mssuo.mutation.StartTimeCleared() {
+		_spec.ClearField(metricseriesstate.FieldStartTime, field.TypeTime)
+	}
+	if value, ok := mssuo.mutation.ValueAt(); ok {
+		_spec.SetField(metricseriesstate.FieldValueAt, field.TypeTime, value)
+	}
+	if value, ok := mssuo.mutation.PreviousValue(); ok {
+		_spec.SetField(metricseriesstate.FieldPreviousValue, field.TypeFloat64, value)
+	}
+	if value, ok := mssuo.mutation.AddedPreviousValue(); ok {
+		_spec.AddField(metricseriesstate.FieldPreviousValue, field.TypeFloat64, value)
+	}
+	if mssuo.mutation.PreviousValueCleared() {
+		_spec.ClearField(metricseriesstate.FieldPreviousValue, field.TypeFloat64)
+	}
+	if value, ok := mssuo.mutation.PreviousCount(); ok {
+		_spec.SetField(metricseriesstate.FieldPreviousCount, field.TypeInt64, value)
+	}
+	if value, ok := mssuo.mutation.AddedPreviousCount(); ok {
+		_spec.AddField(metricseriesstate.FieldPreviousCount, field.TypeInt64, value)
+	}
+	if mssuo.mutation.PreviousCountCleared() {
+		_spec.ClearField(metricseriesstate.FieldPreviousCount, field.TypeInt64)
+	}
+	if value, ok := mssuo.mutation.PreviousStartTime(); ok {
+		_spec.SetField(metricseriesstate.FieldPreviousStartTime, field.TypeTime, value)
+	}
+	if mssuo.mutation.PreviousStartTimeCleared() {
+		_spec.ClearField(metricseriesstate.FieldPreviousStartTime, field.TypeTime)
+	}
+	if value, ok := mssuo.mutation.PreviousValueAt(); ok {
+		_spec.SetField(metricseriesstate.FieldPreviousValueAt, field.TypeTime, value)
+	}
+	if mssuo.mutation.PreviousValueAtCleared() {
+		_spec.ClearField(metricseriesstate.FieldPreviousValueAt, field.TypeTime)
+	}
+	if value, ok := mssuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(metricseriesstate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &MetricSeriesState{config: mssuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mssuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{metricseriesstate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mssuo.mutation.done = true
+	return _node, nil
+}
@@ -552,6 +552,7 @@ var (
 		{Name: "credit_note_status", Type: field.TypeString, Default: "DRAFT", SchemaType: map[string]string{"postgres": "varchar(50)"}},
 		{Name: "credit_note_type", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
 		{Name: "refund_status", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "refund_method", Type: field.TypeString, Default: "WALLET", SchemaType: map[string]string{"postgres": "varchar(50)"}},
 		{Name: "reason", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
 		{Name: "memo", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
 		{Name: "currency", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
@@ -578,7 +579,7 @@ var (
 			{
 				Name:    "creditnote_tenant_id_environment_id_idempotency_key",
 				Unique:  false,
-				Columns: []*schema.Column{CreditNotesColumns[1], CreditNotesColumns[7], CreditNotesColumns[18]},
+				Columns: []*schema.Column{CreditNotesColumns[1], CreditNotesColumns[7], CreditNotesColumns[19]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "idempotency_key IS NOT NULL AND idempotency_key != ''",
 				},
@@ -661,6 +662,7 @@ var (
 		{Name: "address_state", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(100)"}},
 		{Name: "address_postal_code", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(20)"}},
 		{Name: "address_country", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(2)"}},
+		{Name: "parent_customer_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
 	}
 	// CustomersTable holds the schema information for the "customers" table.
 	CustomersTable = &schema.Table{
@@ -681,6 +683,14 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{CustomersColumns[1], CustomersColumns[7]},
 			},
+			{
+				Name:    "customer_tenant_id_environment_id_parent_customer_id",
+				Unique:  false,
+				Columns: []*schema.Column{CustomersColumns[1], CustomersColumns[7], CustomersColumns[18]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "parent_customer_id IS NOT NULL AND status = 'published'",
+				},
+			},
 			{
 				Name:    "idx_customer_tenant_environment_email",
 				Unique:  false,
@@ -747,6 +757,33 @@ var (
 			},
 		},
 	}
+	// EntitlementUsageCountersColumns holds the columns for the "entitlement_usage_counters" table.
+	EntitlementUsageCountersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "tenant_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "environment_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "customer_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "feature_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "period_start", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamp"}},
+		{Name: "baseline", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(25,15)"}},
+		{Name: "reserved", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(25,15)"}},
+		{Name: "limit_reached_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamp"}},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamp"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamp"}},
+	}
+	// EntitlementUsageCountersTable holds the schema information for the "entitlement_usage_counters" table.
+	EntitlementUsageCountersTable = &schema.Table{
+		Name:       "entitlement_usage_counters",
+		Columns:    EntitlementUsageCountersColumns,
+		PrimaryKey: []*schema.Column{EntitlementUsageCountersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "entitlementusagecounter_tenant_id_environment_id_customer_id_feature_id_period_start",
+				Unique:  true,
+				Columns: []*schema.Column{EntitlementUsageCountersColumns[1], EntitlementUsageCountersColumns[2], EntitlementUsageCountersColumns[3], EntitlementUsageCountersColumns[4], EntitlementUsageCountersColumns[5]},
+			},
+		},
+	}
 	// EntityIntegrationMappingsColumns holds the columns for the "entity_integration_mappings" table.
 	EntityIntegrationMappingsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
@@ -819,6 +856,60 @@ var (
 			},
 		},
 	}
+	// EventCorrectionClaimsColumns holds the columns for the "event_correction_claims" table.
+	EventCorrectionClaimsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "tenant_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "environment_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "event_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "action", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamp"}},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+	}
+	// EventCorrectionClaimsTable holds the schema information for the "event_correction_claims" table.
+	EventCorrectionClaimsTable = &schema.Table{
+		Name:       "event_correction_claims",
+		Columns:    EventCorrectionClaimsColumns,
+		PrimaryKey: []*schema.Column{EventCorrectionClaimsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "eventcorrectionclaim_tenant_id_environment_id_event_id",
+				Unique:  true,
+				Columns: []*schema.Column{EventCorrectionClaimsColumns[1], EventCorrectionClaimsColumns[2], EventCorrectionClaimsColumns[3]},
+			},
+		},
+	}
+	// EventSchemasColumns holds the columns for the "event_schemas" table.
+	EventSchemasColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "tenant_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "environment_id", Type: field.TypeString, Nullable: true, Default: "", SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "event_name", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "validation_mode", Type: field.TypeString, Default: "reject", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "properties", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+	}
+	// EventSchemasTable holds the schema information for the "event_schemas" table.
+	EventSchemasTable = &schema.Table{
+		Name:       "event_schemas",
+		Columns:    EventSchemasColumns,
+		PrimaryKey: []*schema.Column{EventSchemasColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_event_schema_tenant_environment_event_name",
+				Unique:  true,
+				Columns: []*schema.Column{EventSchemasColumns[1], EventSchemasColumns[7], EventSchemasColumns[8]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "status = 'published'",
+				},
+			},
+		},
+	}
 	// FeaturesColumns holds the columns for the "features" table.
 	FeaturesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
@@ -1139,6 +1230,36 @@ var (
 			},
 		},
 	}
+	// MetricSeriesStatesColumns holds the columns for the "metric_series_states" table.
+	MetricSeriesStatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "tenant_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "environment_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "series_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(64)"}},
+		{Name: "value", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "double precision"}},
+		{Name: "count", Type: field.TypeInt64, Default: 0, SchemaType: map[string]string{"postgres": "bigint"}},
+		{Name: "start_time", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamp"}},
+		{Name: "value_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamp"}},
+		{Name: "previous_value", Type: field.TypeFloat64, Nullable: true, SchemaType: map[string]string{"postgres": "double precision"}},
+		{Name: "previous_count", Type: field.TypeInt64, Nullable: true, SchemaType: map[string]string{"postgres": "bigint"}},
+		{Name: "previous_start_time", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamp"}},
+		{Name: "previous_value_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamp"}},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamp"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamp"}},
+	}
+	// MetricSeriesStatesTable holds the schema information for the "metric_series_states" table.
+	MetricSeriesStatesTable = &schema.Table{
+		Name:       "metric_series_states",
+		Columns:    MetricSeriesStatesColumns,
+		PrimaryKey: []*schema.Column{MetricSeriesStatesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "metricseriesstate_tenant_id_environment_id_series_id",
+				Unique:  true,
+				Columns: []*schema.Column{MetricSeriesStatesColumns[1], MetricSeriesStatesColumns[2], MetricSeriesStatesColumns[3]},
+			},
+		},
+	}
 	// PaymentsColumns holds the columns for the "payments" table.
 	PaymentsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
@@ -1246,6 +1367,66 @@ var (
 			},
 		},
 	}
+	// PaymentRefundsColumns holds the columns for the "payment_refunds" table.
+	PaymentRefundsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "tenant_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "environment_id", Type: field.TypeString, Nullable: true, Default: "", SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "credit_note_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "amount", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(20,8)"}},
+		{Name: "currency", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(10)"}},
+		{Name: "refund_status", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "payment_gateway", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "gateway_refund_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "reason", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "failure_reason", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "succeeded_at", Type: field.TypeTime, Nullable: true},
+		{Name: "failed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "payment_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+	}
+	// PaymentRefundsTable holds the schema information for the "payment_refunds" table.
+	PaymentRefundsTable = &schema.Table{
+		Name:       "payment_refunds",
+		Columns:    PaymentRefundsColumns,
+		PrimaryKey: []*schema.Column{PaymentRefundsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "payment_refunds_payments_refunds",
+				Columns:    []*schema.Column{PaymentRefundsColumns[19]},
+				RefColumns: []*schema.Column{PaymentsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_payment_refund_status",
+				Unique:  false,
+				Columns: []*schema.Column{PaymentRefundsColumns[1], PaymentRefundsColumns[7], PaymentRefundsColumns[19], PaymentRefundsColumns[11]},
+			},
+			{
+				Name:    "idx_payment_refund_credit_note",
+				Unique:  false,
+				Columns: []*schema.Column{PaymentRefundsColumns[1], PaymentRefundsColumns[7], PaymentRefundsColumns[8]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "credit_note_id IS NOT NULL",
+				},
+			},
+			{
+				Name:    "idx_gateway_refund",
+				Unique:  false,
+				Columns: []*schema.Column{PaymentRefundsColumns[13]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "gateway_refund_id IS NOT NULL",
+				},
+			},
+		},
+	}
 	// PlansColumns holds the columns for the "plans" table.
 	PlansColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
@@ -1314,6 +1495,9 @@ var (
 		{Name: "tiers", Type: field.TypeJSON, Nullable: true},
 		{Name: "price_unit_tiers", Type: field.TypeJSON, Nullable: true},
 		{Name: "transform_quantity", Type: field.TypeJSON, Nullable: true},
+		{Name: "matrix", Type: field.TypeJSON, Nullable: true},
+		{Name: "min_charge", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "numeric(25,15)"}},
+		{Name: "max_charge", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "numeric(25,15)"}},
 		{Name: "lookup_key", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
@@ -1334,19 +1518,19 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "prices_addons_prices",
-				Columns:    []*schema.Column{PricesColumns[37]},
+				Columns:    []*schema.Column{PricesColumns[40]},
 				RefColumns: []*schema.Column{AddonsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "prices_price_unit_price_unit_edge",
-				Columns:    []*schema.Column{PricesColumns[38]},
+				Columns:    []*schema.Column{PricesColumns[41]},
 				RefColumns: []*schema.Column{PriceUnitColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "prices_groups_group",
-				Columns:    []*schema.Column{PricesColumns[39]},
+				Columns:    []*schema.Column{PricesColumns[42]},
 				RefColumns: []*schema.Column{GroupsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -1355,7 +1539,7 @@ var (
 			{
 				Name:    "price_tenant_id_environment_id_lookup_key",
 				Unique:  true,
-				Columns: []*schema.Column{PricesColumns[1], PricesColumns[7], PricesColumns[29]},
+				Columns: []*schema.Column{PricesColumns[1], PricesColumns[7], PricesColumns[32]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "status = 'published' AND lookup_key IS NOT NULL AND lookup_key != ''",
 				},
@@ -1368,17 +1552,17 @@ var (
 			{
 				Name:    "price_start_date_end_date",
 				Unique:  false,
-				Columns: []*schema.Column{PricesColumns[35], PricesColumns[36]},
+				Columns: []*schema.Column{PricesColumns[38], PricesColumns[39]},
 			},
 			{
 				Name:    "price_group_id",
 				Unique:  false,
-				Columns: []*schema.Column{PricesColumns[39]},
+				Columns: []*schema.Column{PricesColumns[42]},
 			},
 			{
 				Name:    "price_tenant_id_environment_id_group_id",
 				Unique:  false,
-				Columns: []*schema.Column{PricesColumns[1], PricesColumns[7], PricesColumns[39]},
+				Columns: []*schema.Column{PricesColumns[1], PricesColumns[7], PricesColumns[42]},
 			},
 		},
 	}
@@ -1420,6 +1604,53 @@ var (
 			},
 		},
 	}
+	// PromotionCodesColumns holds the columns for the "promotion_codes" table.
+	PromotionCodesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "tenant_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "environment_id", Type: field.TypeString, Nullable: true, Default: "", SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "code", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(100)"}},
+		{Name: "customer_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "max_redemptions", Type: field.TypeInt, Nullable: true},
+		{Name: "total_redemptions", Type: field.TypeInt, Default: 0},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "coupon_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+	}
+	// PromotionCodesTable holds the schema information for the "promotion_codes" table.
+	PromotionCodesTable = &schema.Table{
+		Name:       "promotion_codes",
+		Columns:    PromotionCodesColumns,
+		PrimaryKey: []*schema.Column{PromotionCodesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "promotion_codes_coupons_promotion_codes",
+				Columns:    []*schema.Column{PromotionCodesColumns[14]},
+				RefColumns: []*schema.Column{CouponsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_tenant_environment_promotion_code_unique",
+				Unique:  true,
+				Columns: []*schema.Column{PromotionCodesColumns[1], PromotionCodesColumns[7], PromotionCodesColumns[8]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "status = 'published'",
+				},
+			},
+			{
+				Name:    "promotioncode_tenant_id_environment_id_coupon_id",
+				Unique:  false,
+				Columns: []*schema.Column{PromotionCodesColumns[1], PromotionCodesColumns[7], PromotionCodesColumns[14]},
+			},
+		},
+	}
 	// ScheduledTasksColumns holds the columns for the "scheduled_tasks" table.
 	ScheduledTasksColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
@@ -1569,11 +1800,16 @@ var (
 		{Name: "billing_cycle", Type: field.TypeString, Default: "anniversary"},
 		{Name: "commitment_amount", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "decimal(20,6)"}},
 		{Name: "overage_factor", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "decimal(10,6)"}},
+		{Name: "commitment_type", Type: field.TypeString, Default: "billing_period", SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "commitment_duration", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "commitment_start_date", Type: field.TypeTime, Nullable: true},
+		{Name: "commitment_end_date", Type: field.TypeTime, Nullable: true},
 		{Name: "payment_behavior", Type: field.TypeEnum, Enums: []string{"allow_incomplete", "default_incomplete", "error_if_incomplete", "default_active"}, Default: "default_active"},
 		{Name: "collection_method", Type: field.TypeEnum, Enums: []string{"charge_automatically", "send_invoice"}, Default: "charge_automatically"},
 		{Name: "gateway_payment_method_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
 		{Name: "customer_timezone", Type: field.TypeString, Default: "UTC"},
 		{Name: "proration_behavior", Type: field.TypeString, Default: "none"},
+		{Name: "usage_alerts", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
 	}
 	// SubscriptionsTable holds the schema information for the "subscriptions" table.
 	SubscriptionsTable = &schema.Table{
@@ -1617,17 +1853,17 @@ var (
 			{
 				Name:    "subscription_tenant_id_environment_id_payment_behavior_status",
 				Unique:  false,
-				Columns: []*schema.Column{SubscriptionsColumns[1], SubscriptionsColumns[7], SubscriptionsColumns[33], SubscriptionsColumns[2]},
+				Columns: []*schema.Column{SubscriptionsColumns[1], SubscriptionsColumns[7], SubscriptionsColumns[37], SubscriptionsColumns[2]},
 			},
 			{
 				Name:    "subscription_tenant_id_environment_id_collection_method_status",
 				Unique:  false,
-				Columns: []*schema.Column{SubscriptionsColumns[1], SubscriptionsColumns[7], SubscriptionsColumns[34], SubscriptionsColumns[2]},
+				Columns: []*schema.Column{SubscriptionsColumns[1], SubscriptionsColumns[7], SubscriptionsColumns[38], SubscriptionsColumns[2]},
 			},
 			{
 				Name:    "subscription_tenant_id_environment_id_subscription_status_collection_method_status",
 				Unique:  false,
-				Columns: []*schema.Column{SubscriptionsColumns[1], SubscriptionsColumns[7], SubscriptionsColumns[11], SubscriptionsColumns[34], SubscriptionsColumns[2]},
+				Columns: []*schema.Column{SubscriptionsColumns[1], SubscriptionsColumns[7], SubscriptionsColumns[11], SubscriptionsColumns[38], SubscriptionsColumns[2]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "subscription_status IN ('incomplete', 'past_due')",
 				},
@@ -2235,19 +2471,25 @@ var (
 		CreditNoteLineItemsTable,
 		CustomersTable,
 		EntitlementsTable,
+		EntitlementUsageCountersTable,
 		EntityIntegrationMappingsTable,
 		EnvironmentsTable,
+		EventCorrectionClaimsTable,
+		EventSchemasTable,
 		FeaturesTable,
 		GroupsTable,
 		InvoicesTable,
 		InvoiceLineItemsTable,
 		InvoiceSequencesTable,
 		MetersTable,
+		MetricSeriesStatesTable,
 		PaymentsTable,
 		PaymentAttemptsTable,
+		PaymentRefundsTable,
 		PlansTable,
 		PricesTable,
 		PriceUnitTable,
+		PromotionCodesTable,
 		ScheduledTasksTable,
 		SecretsTable,
 		SettingsTable,
@@ -2282,12 +2524,14 @@ func init() {
 	EntitlementsTable.ForeignKeys[0].RefTable = AddonsTable
 	InvoiceLineItemsTable.ForeignKeys[0].RefTable = InvoicesTable
 	PaymentAttemptsTable.ForeignKeys[0].RefTable = PaymentsTable
+	PaymentRefundsTable.ForeignKeys[0].RefTable = PaymentsTable
 	PricesTable.ForeignKeys[0].RefTable = AddonsTable
 	PricesTable.ForeignKeys[1].RefTable = PriceUnitTable
 	PricesTable.ForeignKeys[2].RefTable = GroupsTable
 	PriceUnitTable.Annotation = &entsql.Annotation{
 		Table: "price_unit",
 	}
+	PromotionCodesTable.ForeignKeys[0].RefTable = CouponsTable
 	SubscriptionLineItemsTable.ForeignKeys[0].RefTable = SubscriptionsTable
 	SubscriptionPausesTable.ForeignKeys[0].RefTable = SubscriptionsTable
 	SubscriptionSchedulesTable.ForeignKeys[0].RefTable = SubscriptionsTable
@@ -81,9 +81,11 @@ type Payment struct {
 type PaymentEdges struct {
 	// Attempts holds the value of the attempts edge.
 	Attempts []*PaymentAttempt `json:"attempts,omitempty"`
+	// Refunds holds the value of the refunds edge.
+	Refunds []*PaymentRefund `json:"refunds,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // AttemptsOrErr returns the Attempts value or an error if the edge
@@ -95,6 +97,15 @@ func (e PaymentEdges) AttemptsOrErr() ([]*PaymentAttempt, error) {
 	return nil, &NotLoadedError{edge: "attempts"}
 }
 
+// RefundsOrErr returns the Refunds value or an error if the edge
+// was not loaded in eager-loading.
+func (e PaymentEdges) RefundsOrErr() ([]*PaymentRefund, error) {
+	if e.loadedTypes[1] {
+		return e.Refunds, nil
+	}
+	return nil, &NotLoadedError{edge: "refunds"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Payment) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -317,6 +328,11 @@ func (pa *Payment) QueryAttempts() *PaymentAttemptQuery {
 	return NewPaymentClient(pa.config).QueryAttempts(pa)
 }
 
+// QueryRefunds queries the "refunds" edge of the Payment entity.
+func (pa *Payment) QueryRefunds() *PaymentRefundQuery {
+	return NewPaymentClient(pa.config).QueryRefunds(pa)
+}
+
 // Update returns a builder for updating this Payment.
 // Note that you need to call Payment.Unwrap() before calling this method if this Payment
 // was returned from a transaction, and the transaction was committed or rolled back.
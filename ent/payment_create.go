@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/flexprice/flexprice/ent/payment"
 	"github.com/flexprice/flexprice/ent/paymentattempt"
+	"github.com/flexprice/flexprice/ent/paymentrefund"
 	"github.com/shopspring/decimal"
 )
 
@@ -335,6 +336,21 @@ func (pc *PaymentCreate) AddAttempts(p ...*PaymentAttempt) *PaymentCreate {
 	return pc.AddAttemptIDs(ids...)
 }
 
+// AddRefundIDs adds the "refunds" edge to the PaymentRefund entity by IDs.
+func (pc *PaymentCreate) AddRefundIDs(ids ...string) *PaymentCreate {
+	pc.mutation.AddRefundIDs(ids...)
+	return pc
+}
+
+// AddRefunds adds the "refunds" edges to the PaymentRefund entity.
+func (pc *PaymentCreate) AddRefunds(p ...*PaymentRefund) *PaymentCreate {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pc.AddRefundIDs(ids...)
+}
+
 // Mutation returns the PaymentMutation object of the builder.
 func (pc *PaymentCreate) Mutation() *PaymentMutation {
 	return pc.mutation
@@ -619,6 +635,22 @@ func (pc *PaymentCreate) createSpec() (*Payment, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := pc.mutation.RefundsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payment.RefundsTable,
+			Columns: []string{payment.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrefund.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/flexprice/flexprice/ent/payment"
 	"github.com/flexprice/flexprice/ent/paymentattempt"
+	"github.com/flexprice/flexprice/ent/paymentrefund"
 	"github.com/flexprice/flexprice/ent/predicate"
 	"github.com/shopspring/decimal"
 )
@@ -373,6 +374,21 @@ func (pu *PaymentUpdate) AddAttempts(p ...*PaymentAttempt) *PaymentUpdate {
 	return pu.AddAttemptIDs(ids...)
 }
 
+// AddRefundIDs adds the "refunds" edge to the PaymentRefund entity by IDs.
+func (pu *PaymentUpdate) AddRefundIDs(ids ...string) *PaymentUpdate {
+	pu.mutation.AddRefundIDs(ids...)
+	return pu
+}
+
+// AddRefunds adds the "refunds" edges to the PaymentRefund entity.
+func (pu *PaymentUpdate) AddRefunds(p ...*PaymentRefund) *PaymentUpdate {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.AddRefundIDs(ids...)
+}
+
 // Mutation returns the PaymentMutation object of the builder.
 func (pu *PaymentUpdate) Mutation() *PaymentMutation {
 	return pu.mutation
@@ -399,6 +415,27 @@ func (pu *PaymentUpdate) RemoveAttempts(p ...*PaymentAttempt) *PaymentUpdate {
 	return pu.RemoveAttemptIDs(ids...)
 }
 
+// ClearRefunds clears all "refunds" edges to the PaymentRefund entity.
+func (pu *PaymentUpdate) ClearRefunds() *PaymentUpdate {
+	pu.mutation.ClearRefunds()
+	return pu
+}
+
+// RemoveRefundIDs removes the "refunds" edge to PaymentRefund entities by IDs.
+func (pu *PaymentUpdate) RemoveRefundIDs(ids ...string) *PaymentUpdate {
+	pu.mutation.RemoveRefundIDs(ids...)
+	return pu
+}
+
+// RemoveRefunds removes "refunds" edges to PaymentRefund entities.
+func (pu *PaymentUpdate) RemoveRefunds(p ...*PaymentRefund) *PaymentUpdate {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.RemoveRefundIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (pu *PaymentUpdate) Save(ctx context.Context) (int, error) {
 	pu.defaults()
@@ -619,6 +656,51 @@ func (pu *PaymentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if pu.mutation.RefundsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payment.RefundsTable,
+			Columns: []string{payment.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrefund.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedRefundsIDs(); len(nodes) > 0 && !pu.mutation.RefundsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payment.RefundsTable,
+			Columns: []string{payment.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrefund.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RefundsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payment.RefundsTable,
+			Columns: []string{payment.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrefund.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{payment.Label}
@@ -982,6 +1064,21 @@ func (puo *PaymentUpdateOne) AddAttempts(p ...*PaymentAttempt) *PaymentUpdateOne
 	return puo.AddAttemptIDs(ids...)
 }
 
+// AddRefundIDs adds the "refunds" edge to the PaymentRefund entity by IDs.
+func (puo *PaymentUpdateOne) AddRefundIDs(ids ...string) *PaymentUpdateOne {
+	puo.mutation.AddRefundIDs(ids...)
+	return puo
+}
+
+// AddRefunds adds the "refunds" edges to the PaymentRefund entity.
+func (puo *PaymentUpdateOne) AddRefunds(p ...*PaymentRefund) *PaymentUpdateOne {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.AddRefundIDs(ids...)
+}
+
 // Mutation returns the PaymentMutation object of the builder.
 func (puo *PaymentUpdateOne) Mutation() *PaymentMutation {
 	return puo.mutation
@@ -1008,6 +1105,27 @@ func (puo *PaymentUpdateOne) RemoveAttempts(p ...*PaymentAttempt) *PaymentUpdate
 	return puo.RemoveAttemptIDs(ids...)
 }
 
+// ClearRefunds clears all "refunds" edges to the PaymentRefund entity.
+func (puo *PaymentUpdateOne) ClearRefunds() *PaymentUpdateOne {
+	puo.mutation.ClearRefunds()
+	return puo
+}
+
+// RemoveRefundIDs removes the "refunds" edge to PaymentRefund entities by IDs.
+func (puo *PaymentUpdateOne) RemoveRefundIDs(ids ...string) *PaymentUpdateOne {
+	puo.mutation.RemoveRefundIDs(ids...)
+	return puo
+}
+
+// RemoveRefunds removes "refunds" edges to PaymentRefund entities.
+func (puo *PaymentUpdateOne) RemoveRefunds(p ...*PaymentRefund) *PaymentUpdateOne {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.RemoveRefundIDs(ids...)
+}
+
 // Where appends a list predicates to the PaymentUpdate builder.
 func (puo *PaymentUpdateOne) Where(ps ...predicate.Payment) *PaymentUpdateOne {
 	puo.mutation.Where(ps...)
@@ -1258,6 +1376,51 @@ func (puo *PaymentUpdateOne) sqlSave(ctx context.Context) (_node *Payment, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if puo.mutation.RefundsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payment.RefundsTable,
+			Columns: []string{payment.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrefund.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedRefundsIDs(); len(nodes) > 0 && !puo.mutation.RefundsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payment.RefundsTable,
+			Columns: []string{payment.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrefund.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RefundsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payment.RefundsTable,
+			Columns: []string{payment.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrefund.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Payment{config: puo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
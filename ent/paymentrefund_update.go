@@ -0,0 +1,730 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/paymentrefund"
+	"github.com/flexprice/flexprice/ent/predicate"
+)
+
+// PaymentRefundUpdate is the builder for updating PaymentRefund entities.
+type PaymentRefundUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PaymentRefundMutation
+}
+
+// Where appends a list predicates to the PaymentRefundUpdate builder.
+func (pru *PaymentRefundUpdate) Where(ps ...predicate.PaymentRefund) *PaymentRefundUpdate {
+	pru.mutation.Where(ps...)
+	return pru
+}
+
+// SetStatus sets the "status" field.
+func (pru *PaymentRefundUpdate) SetStatus(s string) *PaymentRefundUpdate {
+	pru.mutation.SetStatus(s)
+	return pru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (pru *PaymentRefundUpdate) SetNillableStatus(s *string) *PaymentRefundUpdate {
+	if s != nil {
+		pru.SetStatus(*s)
+	}
+	return pru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pru *PaymentRefundUpdate) SetUpdatedAt(t time.Time) *PaymentRefundUpdate {
+	pru.mutation.SetUpdatedAt(t)
+	return pru
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (pru *PaymentRefundUpdate) SetUpdatedBy(s string) *PaymentRefundUpdate {
+	pru.mutation.SetUpdatedBy(s)
+	return pru
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (pru *PaymentRefundUpdate) SetNillableUpdatedBy(s *string) *PaymentRefundUpdate {
+	if s != nil {
+		pru.SetUpdatedBy(*s)
+	}
+	return pru
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (pru *PaymentRefundUpdate) ClearUpdatedBy() *PaymentRefundUpdate {
+	pru.mutation.ClearUpdatedBy()
+	return pru
+}
+
+// SetRefundStatus sets the "refund_status" field.
+func (pru *PaymentRefundUpdate) SetRefundStatus(s string) *PaymentRefundUpdate {
+	pru.mutation.SetRefundStatus(s)
+	return pru
+}
+
+// SetNillableRefundStatus sets the "refund_status" field if the given value is not nil.
+func (pru *PaymentRefundUpdate) SetNillableRefundStatus(s *string) *PaymentRefundUpdate {
+	if s != nil {
+		pru.SetRefundStatus(*s)
+	}
+	return pru
+}
+
+// SetPaymentGateway sets the "payment_gateway" field.
+func (pru *PaymentRefundUpdate) SetPaymentGateway(s string) *PaymentRefundUpdate {
+	pru.mutation.SetPaymentGateway(s)
+	return pru
+}
+
+// SetNillablePaymentGateway sets the "payment_gateway" field if the given value is not nil.
+func (pru *PaymentRefundUpdate) SetNillablePaymentGateway(s *string) *PaymentRefundUpdate {
+	if s != nil {
+		pru.SetPaymentGateway(*s)
+	}
+	return pru
+}
+
+// ClearPaymentGateway clears the value of the "payment_gateway" field.
+func (pru *PaymentRefundUpdate) ClearPaymentGateway() *PaymentRefundUpdate {
+	pru.mutation.ClearPaymentGateway()
+	return pru
+}
+
+// SetGatewayRefundID sets the "gateway_refund_id" field.
+func (pru *PaymentRefundUpdate) SetGatewayRefundID(s string) *PaymentRefundUpdate {
+	pru.mutation.SetGatewayRefundID(s)
+	return pru
+}
+
+// SetNillableGatewayRefundID sets the "gateway_refund_id" field if the given value is not nil.
+func (pru *PaymentRefundUpdate) SetNillableGatewayRefundID(s *string) *PaymentRefundUpdate {
+	if s != nil {
+		pru.SetGatewayRefundID(*s)
+	}
+	return pru
+}
+
+// ClearGatewayRefundID clears the value of the "gateway_refund_id" field.
+func (pru *PaymentRefundUpdate) ClearGatewayRefundID() *PaymentRefundUpdate {
+	pru.mutation.ClearGatewayRefundID()
+	return pru
+}
+
+// SetReason sets the "reason" field.
+func (pru *PaymentRefundUpdate) SetReason(s string) *PaymentRefundUpdate {
+	pru.mutation.SetReason(s)
+	return pru
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (pru *PaymentRefundUpdate) SetNillableReason(s *string) *PaymentRefundUpdate {
+	if s != nil {
+		pru.SetReason(*s)
+	}
+	return pru
+}
+
+// ClearReason clears the value of the "reason" field.
+func (pru *PaymentRefundUpdate) ClearReason() *PaymentRefundUpdate {
+	pru.mutation.ClearReason()
+	return pru
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (pru *PaymentRefundUpdate) SetFailureReason(s string) *PaymentRefundUpdate {
+	pru.mutation.SetFailureReason(s)
+	return pru
+}
+
+// SetNillableFailureReason sets the "failure_reason" field if the given value is not nil.
+func (pru *PaymentRefundUpdate) SetNillableFailureReason(s *string) *PaymentRefundUpdate {
+	if s != nil {
+		pru.SetFailureReason(*s)
+	}
+	return pru
+}
+
+// ClearFailureReason clears the value of the "failure_reason" field.
+func (pru *PaymentRefundUpdate) ClearFailureReason() *PaymentRefundUpdate {
+	pru.mutation.ClearFailureReason()
+	return pru
+}
+
+// SetSucceededAt sets the "succeeded_at" field.
+func (pru *PaymentRefundUpdate) SetSucceededAt(t time.Time) *PaymentRefundUpdate {
+	pru.mutation.SetSucceededAt(t)
+	return pru
+}
+
+// SetNillableSucceededAt sets the "succeeded_at" field if the given value is not nil.
+func (pru *PaymentRefundUpdate) SetNillableSucceededAt(t *time.Time) *PaymentRefundUpdate {
+	if t != nil {
+		pru.SetSucceededAt(*t)
+	}
+	return pru
+}
+
+// ClearSucceededAt clears the value of the "succeeded_at" field.
+func (pru *PaymentRefundUpdate) ClearSucceededAt() *PaymentRefundUpdate {
+	pru.mutation.ClearSucceededAt()
+	return pru
+}
+
+// SetFailedAt sets the "failed_at" field.
+func (pru *PaymentRefundUpdate) SetFailedAt(t time.Time) *PaymentRefundUpdate {
+	pru.mutation.SetFailedAt(t)
+	return pru
+}
+
+// SetNillableFailedAt sets the "failed_at" field if the given value is not nil.
+func (pru *PaymentRefundUpdate) SetNillableFailedAt(t *time.Time) *PaymentRefundUpdate {
+	if t != nil {
+		pru.SetFailedAt(*t)
+	}
+	return pru
+}
+
+// ClearFailedAt clears the value of the "failed_at" field.
+func (pru *PaymentRefundUpdate) ClearFailedAt() *PaymentRefundUpdate {
+	pru.mutation.ClearFailedAt()
+	return pru
+}
+
+// SetMetadata sets the "metadata" field.
+func (pru *PaymentRefundUpdate) SetMetadata(m map[string]string) *PaymentRefundUpdate {
+	pru.mutation.SetMetadata(m)
+	return pru
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (pru *PaymentRefundUpdate) ClearMetadata() *PaymentRefundUpdate {
+	pru.mutation.ClearMetadata()
+	return pru
+}
+
+// Mutation returns the PaymentRefundMutation object of the builder.
+func (pru *PaymentRefundUpdate) Mutation() *PaymentRefundMutation {
+	return pru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pru *PaymentRefundUpdate) Save(ctx context.Context) (int, error) {
+	pru.defaults()
+	return withHooks(ctx, pru.sqlSave, pru.mutation, pru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pru *PaymentRefundUpdate) SaveX(ctx context.Context) int {
+	affected, err := pru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pru *PaymentRefundUpdate) Exec(ctx context.Context) error {
+	_, err := pru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pru *PaymentRefundUpdate) ExecX(ctx context.Context) {
+	if err := pru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pru *PaymentRefundUpdate) defaults() {
+	if _, ok := pru.mutation.UpdatedAt(); !ok {
+		v := paymentrefund.UpdateDefaultUpdatedAt()
+		pru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pru *PaymentRefundUpdate) check() error {
+	if v, ok := pru.mutation.RefundStatus(); ok {
+		if err := paymentrefund.RefundStatusValidator(v); err != nil {
+			return &ValidationError{Name: "refund_status", err: fmt.Errorf(`ent: validator failed for field "PaymentRefund.refund_status": %w`, err)}
+		}
+	}
+	if pru.mutation.PaymentCleared() && len(pru.mutation.PaymentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PaymentRefund.payment"`)
+	}
+	return nil
+}
+
+func (pru *PaymentRefundUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(paymentrefund.Table, paymentrefund.Columns, sqlgraph.NewFieldSpec(paymentrefund.FieldID, field.TypeString))
+	if ps := pru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pru.mutation.Status(); ok {
+		_spec.SetField(paymentrefund.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := pru.mutation.UpdatedAt(); ok {
+		_spec.SetField(paymentrefund.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if pru.mutation.CreatedByCleared() {
+		_spec.ClearField(paymentrefund.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := pru.mutation.UpdatedBy(); ok {
+		_spec.SetField(paymentrefund.FieldUpdatedBy, field.TypeString, value)
+	}
+	if pru.mutation.UpdatedByCleared() {
+		_spec.ClearField(paymentrefund.FieldUpdatedBy, field.TypeString)
+	}
+	if pru.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(paymentrefund.FieldEnvironmentID, field.TypeString)
+	}
+	if pru.mutation.CreditNoteIDCleared() {
+		_spec.ClearField(paymentrefund.FieldCreditNoteID, field.TypeString)
+	}
+	if value, ok := pru.mutation.RefundStatus(); ok {
+		_spec.SetField(paymentrefund.FieldRefundStatus, field.TypeString, value)
+	}
+	if value, ok := pru.mutation.PaymentGateway(); ok {
+		_spec.SetField(paymentrefund.FieldPaymentGateway, field.TypeString, value)
+	}
+	if pru.mutation.PaymentGatewayCleared() {
+		_spec.ClearField(paymentrefund.FieldPaymentGateway, field.TypeString)
+	}
+	if value, ok := pru.mutation.GatewayRefundID(); ok {
+		_spec.SetField(paymentrefund.FieldGatewayRefundID, field.TypeString, value)
+	}
+	if pru.mutation.GatewayRefundIDCleared() {
+		_spec.ClearField(paymentrefund.FieldGatewayRefundID, field.TypeString)
+	}
+	if value, ok := pru.mutation.Reason(); ok {
+		_spec.SetField(paymentrefund.FieldReason, field.TypeString, value)
+	}
+	if pru.mutation.ReasonCleared() {
+		_spec.ClearField(paymentrefund.FieldReason, field.TypeString)
+	}
+	if value, ok := pru.mutation.FailureReason(); ok {
+		_spec.SetField(paymentrefund.FieldFailureReason, field.TypeString, value)
+	}
+	if pru.mutation.FailureReasonCleared() {
+		_spec.ClearField(paymentrefund.FieldFailureReason, field.TypeString)
+	}
+	if value, ok := pru.mutation.SucceededAt(); ok {
+		_spec.SetField(paymentrefund.FieldSucceededAt, field.TypeTime, value)
+	}
+	if pru.mutation.SucceededAtCleared() {
+		_spec.ClearField(paymentrefund.FieldSucceededAt, field.TypeTime)
+	}
+	if value, ok := pru.mutation.FailedAt(); ok {
+		_spec.SetField(paymentrefund.FieldFailedAt, field.TypeTime, value)
+	}
+	if pru.mutation.FailedAtCleared() {
+		_spec.ClearField(paymentrefund.FieldFailedAt, field.TypeTime)
+	}
+	if value, ok := pru.mutation.Metadata(); ok {
+		_spec.SetField(paymentrefund.FieldMetadata, field.TypeJSON, value)
+	}
+	if pru.mutation.MetadataCleared() {
+		_spec.ClearField(paymentrefund.FieldMetadata, field.TypeJSON)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{paymentrefund.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pru.mutation.done = true
+	return n, nil
+}
+
+// PaymentRefundUpdateOne is the builder for updating a single PaymentRefund entity.
+type PaymentRefundUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PaymentRefundMutation
+}
+
+// SetStatus sets the "status" field.
+func (pruo *PaymentRefundUpdateOne) SetStatus(s string) *PaymentRefundUpdateOne {
+	pruo.mutation.SetStatus(s)
+	return pruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (pruo *PaymentRefundUpdateOne) SetNillableStatus(s *string) *PaymentRefundUpdateOne {
+	if s != nil {
+		pruo.SetStatus(*s)
+	}
+	return pruo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pruo *PaymentRefundUpdateOne) SetUpdatedAt(t time.Time) *PaymentRefundUpdateOne {
+	pruo.mutation.SetUpdatedAt(t)
+	return pruo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (pruo *PaymentRefundUpdateOne) SetUpdatedBy(s string) *PaymentRefundUpdateOne {
+	pruo.mutation.SetUpdatedBy(s)
+	return pruo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (pruo *PaymentRefundUpdateOne) SetNillableUpdatedBy(s *string) *PaymentRefundUpdateOne {
+	if s != nil {
+		pruo.SetUpdatedBy(*s)
+	}
+	return pruo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (pruo *PaymentRefundUpdateOne) ClearUpdatedBy() *PaymentRefundUpdateOne {
+	pruo.mutation.ClearUpdatedBy()
+	return pruo
+}
+
+// SetRefundStatus sets the "refund_status" field.
+func (pruo *PaymentRefundUpdateOne) SetRefundStatus(s string) *PaymentRefundUpdateOne {
+	pruo.mutation.SetRefundStatus(s)
+	return pruo
+}
+
+// SetNillableRefundStatus sets the "refund_status" field if the given value is not nil.
+func (pruo *PaymentRefundUpdateOne) SetNillableRefundStatus(s *string) *PaymentRefundUpdateOne {
+	if s != nil {
+		pruo.SetRefundStatus(*s)
+	}
+	return pruo
+}
+
+// SetPaymentGateway sets the "payment_gateway" field.
+func (pruo *PaymentRefundUpdateOne) SetPaymentGateway(s string) *PaymentRefundUpdateOne {
+	pruo.mutation.SetPaymentGateway(s)
+	return pruo
+}
+
+// SetNillablePaymentGateway sets the "payment_gateway" field if the given value is not nil.
+func (pruo *PaymentRefundUpdateOne) SetNillablePaymentGateway(s *string) *PaymentRefundUpdateOne {
+	if s != nil {
+		pruo.SetPaymentGateway(*s)
+	}
+	return pruo
+}
+
+// ClearPaymentGateway clears the value of the "payment_gateway" field.
+func (pruo *PaymentRefundUpdateOne) ClearPaymentGateway() *PaymentRefundUpdateOne {
+	pruo.mutation.ClearPaymentGateway()
+	return pruo
+}
+
+// SetGatewayRefundID sets the "gateway_refund_id" field.
+func (pruo *PaymentRefundUpdateOne) SetGatewayRefundID(s string) *PaymentRefundUpdateOne {
+	pruo.mutation.SetGatewayRefundID(s)
+	return pruo
+}
+
+// SetNillableGatewayRefundID sets the "gateway_refund_id" field if the given value is not nil.
+func (pruo *PaymentRefundUpdateOne) SetNillableGatewayRefundID(s *string) *PaymentRefundUpdateOne {
+	if s != nil {
+		pruo.SetGatewayRefundID(*s)
+	}
+	return pruo
+}
+
+// ClearGatewayRefundID clears the value of the "gateway_refund_id" field.
+func (pruo *PaymentRefundUpdateOne) ClearGatewayRefundID() *PaymentRefundUpdateOne {
+	pruo.mutation.ClearGatewayRefundID()
+	return pruo
+}
+
+// SetReason sets the "reason" field.
+func (pruo *PaymentRefundUpdateOne) SetReason(s string) *PaymentRefundUpdateOne {
+	pruo.mutation.SetReason(s)
+	return pruo
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (pruo *PaymentRefundUpdateOne) SetNillableReason(s *string) *PaymentRefundUpdateOne {
+	if s != nil {
+		pruo.SetReason(*s)
+	}
+	return pruo
+}
+
+// ClearReason clears the value of the "reason" field.
+func (pruo *PaymentRefundUpdateOne) ClearReason() *PaymentRefundUpdateOne {
+	pruo.mutation.ClearReason()
+	return pruo
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (pruo *PaymentRefundUpdateOne) SetFailureReason(s string) *PaymentRefundUpdateOne {
+	pruo.mutation.SetFailureReason(s)
+	return pruo
+}
+
+// SetNillableFailureReason sets the "failure_reason" field if the given value is not nil.
+func (pruo *PaymentRefundUpdateOne) SetNillableFailureReason(s *string) *PaymentRefundUpdateOne {
+	if s != nil {
+		pruo.SetFailureReason(*s)
+	}
+	return pruo
+}
+
+// ClearFailureReason clears the value of the "failure_reason" field.
+func (pruo *PaymentRefundUpdateOne) ClearFailureReason() *PaymentRefundUpdateOne {
+	pruo.mutation.ClearFailureReason()
+	return pruo
+}
+
+// SetSucceededAt sets the "succeeded_at" field.
+func (pruo *PaymentRefundUpdateOne) SetSucceededAt(t time.Time) *PaymentRefundUpdateOne {
+	pruo.mutation.SetSucceededAt(t)
+	return pruo
+}
+
+// SetNillableSucceededAt sets the "succeeded_at" field if the given value is not nil.
+func (pruo *PaymentRefundUpdateOne) SetNillableSucceededAt(t *time.Time) *PaymentRefundUpdateOne {
+	if t != nil {
+		pruo.SetSucceededAt(*t)
+	}
+	return pruo
+}
+
+// ClearSucceededAt clears the value of the "succeeded_at" field.
+func (pruo *PaymentRefundUpdateOne) ClearSucceededAt() *PaymentRefundUpdateOne {
+	pruo.mutation.ClearSucceededAt()
+	return pruo
+}
+
+// SetFailedAt sets the "failed_at" field.
+func (pruo *PaymentRefundUpdateOne) SetFailedAt(t time.Time) *PaymentRefundUpdateOne {
+	pruo.mutation.SetFailedAt(t)
+	return pruo
+}
+
+// SetNillableFailedAt sets the "failed_at" field if the given value is not nil.
+func (pruo *PaymentRefundUpdateOne) SetNillableFailedAt(t *time.Time) *PaymentRefundUpdateOne {
+	if t != nil {
+		pruo.SetFailedAt(*t)
+	}
+	return pruo
+}
+
+// ClearFailedAt clears the value of the "failed_at" field.
+func (pruo *PaymentRefundUpdateOne) ClearFailedAt() *PaymentRefundUpdateOne {
+	pruo.mutation.ClearFailedAt()
+	return pruo
+}
+
+// SetMetadata sets the "metadata" field.
+func (pruo *PaymentRefundUpdateOne) SetMetadata(m map[string]string) *PaymentRefundUpdateOne {
+	pruo.mutation.SetMetadata(m)
+	return pruo
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (pruo *PaymentRefundUpdateOne) ClearMetadata() *PaymentRefundUpdateOne {
+	pruo.mutation.ClearMetadata()
+	return pruo
+}
+
+// Mutation returns the PaymentRefundMutation object of the builder.
+func (pruo *PaymentRefundUpdateOne) Mutation() *PaymentRefundMutation {
+	return pruo.mutation
+}
+
+// Where appends a list predicates to the PaymentRefundUpdate builder.
+func (pruo *PaymentRefundUpdateOne) Where(ps ...predicate.PaymentRefund) *PaymentRefundUpdateOne {
+	pruo.mutation.Where(ps...)
+	return pruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pruo *PaymentRefundUpdateOne) Select(field string, fields ...string) *PaymentRefundUpdateOne {
+	pruo.fields = append([]string{field}, fields...)
+	return pruo
+}
+
+// Save executes the query and returns the updated PaymentRefund entity.
+func (pruo *PaymentRefundUpdateOne) Save(ctx context.Context) (*PaymentRefund, error) {
+	pruo.defaults()
+	return withHooks(ctx, pruo.sqlSave, pruo.mutation, pruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pruo *PaymentRefundUpdateOne) SaveX(ctx context.Context) *PaymentRefund {
+	node, err := pruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pruo *PaymentRefundUpdateOne) Exec(ctx context.Context) error {
+	_, err := pruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pruo *PaymentRefundUpdateOne) ExecX(ctx context.Context) {
+	if err := pruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pruo *PaymentRefundUpdateOne) defaults() {
+	if _, ok := pruo.mutation.UpdatedAt(); !ok {
+		v := paymentrefund.UpdateDefaultUpdatedAt()
+		pruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pruo *PaymentRefundUpdateOne) check() error {
+	if v, ok := pruo.mutation.RefundStatus(); ok {
+		if err := paymentrefund.RefundStatusValidator(v); err != nil {
+			return &ValidationError{Name: "refund_status", err: fmt.Errorf(`ent: validator failed for field "PaymentRefund.refund_status": %w`, err)}
+		}
+	}
+	if pruo.mutation.PaymentCleared() && len(pruo.mutation.PaymentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PaymentRefund.payment"`)
+	}
+	return nil
+}
+
+func (pruo *PaymentRefundUpdateOne) sqlSave(ctx context.Context) (_node *PaymentRefund, err error) {
+	if err := pruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(paymentrefund.Table, paymentrefund.Columns, sqlgraph.NewFieldSpec(paymentrefund.FieldID, field.TypeString))
+	id, ok := pruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PaymentRefund.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, paymentrefund.FieldID)
+		for _, f := range fields {
+			if !paymentrefund.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != paymentrefund.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pruo.mutation.Status(); ok {
+		_spec.SetField(paymentrefund.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := pruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(paymentrefund.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if pruo.mutation.CreatedByCleared() {
+		_spec.ClearField(paymentrefund.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := pruo.mutation.UpdatedBy(); ok {
+		_spec.SetField(paymentrefund.FieldUpdatedBy, field.TypeString, value)
+	}
+	if pruo.mutation.UpdatedByCleared() {
+		_spec.ClearField(paymentrefund.FieldUpdatedBy, field.TypeString)
+	}
+	if pruo.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(paymentrefund.FieldEnvironmentID, field.TypeString)
+	}
+	if pruo.mutation.CreditNoteIDCleared() {
+		_spec.ClearField(paymentrefund.FieldCreditNoteID, field.TypeString)
+	}
+	if value, ok := pruo.mutation.RefundStatus(); ok {
+		_spec.SetField(paymentrefund.FieldRefundStatus, field.TypeString, value)
+	}
+	if value, ok := pruo.mutation.PaymentGateway(); ok {
+		_spec.SetField(paymentrefund.FieldPaymentGateway, field.TypeString, value)
+	}
+	if pruo.mutation.PaymentGatewayCleared() {
+		_spec.ClearField(paymentrefund.FieldPaymentGateway, field.TypeString)
+	}
+	if value, ok := pruo.mutation.GatewayRefundID(); ok {
+		_spec.SetField(paymentrefund.FieldGatewayRefundID, field.TypeString, value)
+	}
+	if pruo.mutation.GatewayRefundIDCleared() {
+		_spec.ClearField(paymentrefund.FieldGatewayRefundID, field.TypeString)
+	}
+	if value, ok := pruo.mutation.Reason(); ok {
+		_spec.SetField(paymentrefund.FieldReason, field.TypeString, value)
+	}
+	if pruo.mutation.ReasonCleared() {
+		_spec.ClearField(paymentrefund.FieldReason, field.TypeString)
+	}
+	if value, ok := pruo.mutation.FailureReason(); ok {
+		_spec.SetField(paymentrefund.FieldFailureReason, field.TypeString, value)
+	}
+	if pruo.mutation.FailureReasonCleared() {
+		_spec.ClearField(paymentrefund.FieldFailureReason, field.TypeString)
+	}
+	if value, ok := pruo.mutation.SucceededAt(); ok {
+		_spec.SetField(paymentrefund.FieldSucceededAt, field.TypeTime, value)
+	}
+	if pruo.mutation.SucceededAtCleared() {
+		_spec.ClearField(paymentrefund.FieldSucceededAt, field.TypeTime)
+	}
+	if value, ok := pruo.mutation.FailedAt(); ok {
+		_spec.SetField(paymentrefund.FieldFailedAt, field.TypeTime, value)
+	}
+	if pruo.mutation.FailedAtCleared() {
+		_spec.ClearField(paymentrefund.FieldFailedAt, field.TypeTime)
+	}
+	if value, ok := pruo.mutation.Metadata(); ok {
+		_spec.SetField(paymentrefund.FieldMetadata, field.TypeJSON, value)
+	}
+	if pruo.mutation.MetadataCleared() {
+		_spec.ClearField(paymentrefund.FieldMetadata, field.TypeJSON)
+	}
+	_node = &PaymentRefund{config: pruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{paymentrefund.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pruo.mutation.done = true
+	return _node, nil
+}
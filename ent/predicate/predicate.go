@@ -54,12 +54,21 @@ type Customer func(*sql.Selector)
 // Entitlement is the predicate function for entitlement builders.
 type Entitlement func(*sql.Selector)
 
+// EntitlementUsageCounter is the predicate function for entitlementusagecounter builders.
+type EntitlementUsageCounter func(*sql.Selector)
+
 // EntityIntegrationMapping is the predicate function for entityintegrationmapping builders.
 type EntityIntegrationMapping func(*sql.Selector)
 
 // Environment is the predicate function for environment builders.
 type Environment func(*sql.Selector)
 
+// EventCorrectionClaim is the predicate function for eventcorrectionclaim builders.
+type EventCorrectionClaim func(*sql.Selector)
+
+// EventSchema is the predicate function for eventschema builders.
+type EventSchema func(*sql.Selector)
+
 // Feature is the predicate function for feature builders.
 type Feature func(*sql.Selector)
 
@@ -78,12 +87,18 @@ type InvoiceSequence func(*sql.Selector)
 // Meter is the predicate function for meter builders.
 type Meter func(*sql.Selector)
 
+// MetricSeriesState is the predicate function for metricseriesstate builders.
+type MetricSeriesState func(*sql.Selector)
+
 // Payment is the predicate function for payment builders.
 type Payment func(*sql.Selector)
 
 // PaymentAttempt is the predicate function for paymentattempt builders.
 type PaymentAttempt func(*sql.Selector)
 
+// PaymentRefund is the predicate function for paymentrefund builders.
+type PaymentRefund func(*sql.Selector)
+
 // Plan is the predicate function for plan builders.
 type Plan func(*sql.Selector)
 
@@ -93,6 +108,9 @@ type Price func(*sql.Selector)
 // PriceUnit is the predicate function for priceunit builders.
 type PriceUnit func(*sql.Selector)
 
+// PromotionCode is the predicate function for promotioncode builders.
+type PromotionCode func(*sql.Selector)
+
 // ScheduledTask is the predicate function for scheduledtask builders.
 type ScheduledTask func(*sql.Selector)
 
@@ -14,6 +14,7 @@ import (
 	"github.com/flexprice/flexprice/ent/price"
 	"github.com/flexprice/flexprice/ent/priceunit"
 	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
 )
 
 // Price is the model entity for the Price schema.
@@ -79,6 +80,12 @@ type Price struct {
 	PriceUnitTiers []*types.PriceTier `json:"price_unit_tiers,omitempty"`
 	// TransformQuantity holds the value of the "transform_quantity" field.
 	TransformQuantity types.TransformQuantity `json:"transform_quantity,omitempty"`
+	// Matrix holds the value of the "matrix" field.
+	Matrix *types.PriceMatrix `json:"matrix,omitempty"`
+	// MinCharge holds the value of the "min_charge" field.
+	MinCharge *decimal.Decimal `json:"min_charge,omitempty"`
+	// MaxCharge holds the value of the "max_charge" field.
+	MaxCharge *decimal.Decimal `json:"max_charge,omitempty"`
 	// LookupKey holds the value of the "lookup_key" field.
 	LookupKey string `json:"lookup_key,omitempty"`
 	// Description holds the value of the "description" field.
@@ -142,7 +149,9 @@ func (*Price) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case price.FieldFilterValues, price.FieldTiers, price.FieldPriceUnitTiers, price.FieldTransformQuantity, price.FieldMetadata:
+		case price.FieldMinCharge, price.FieldMaxCharge:
+			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
+		case price.FieldFilterValues, price.FieldTiers, price.FieldPriceUnitTiers, price.FieldTransformQuantity, price.FieldMatrix, price.FieldMetadata:
 			values[i] = new([]byte)
 		case price.FieldAmount, price.FieldPriceUnitAmount, price.FieldConversionRate:
 			values[i] = new(sql.NullFloat64)
@@ -359,6 +368,28 @@ func (pr *Price) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field transform_quantity: %w", err)
 				}
 			}
+		case price.FieldMatrix:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field matrix", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pr.Matrix); err != nil {
+					return fmt.Errorf("unmarshal field matrix: %w", err)
+				}
+			}
+		case price.FieldMinCharge:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field min_charge", values[i])
+			} else if value.Valid {
+				pr.MinCharge = new(decimal.Decimal)
+				*pr.MinCharge = *value.S.(*decimal.Decimal)
+			}
+		case price.FieldMaxCharge:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field max_charge", values[i])
+			} else if value.Valid {
+				pr.MaxCharge = new(decimal.Decimal)
+				*pr.MaxCharge = *value.S.(*decimal.Decimal)
+			}
 		case price.FieldLookupKey:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field lookup_key", values[i])
@@ -565,6 +596,19 @@ func (pr *Price) String() string {
 	builder.WriteString("transform_quantity=")
 	builder.WriteString(fmt.Sprintf("%v", pr.TransformQuantity))
 	builder.WriteString(", ")
+	builder.WriteString("matrix=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Matrix))
+	builder.WriteString(", ")
+	if v := pr.MinCharge; v != nil {
+		builder.WriteString("min_charge=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := pr.MaxCharge; v != nil {
+		builder.WriteString("max_charge=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("lookup_key=")
 	builder.WriteString(pr.LookupKey)
 	builder.WriteString(", ")
@@ -72,6 +72,12 @@ const (
 	FieldPriceUnitTiers = "price_unit_tiers"
 	// FieldTransformQuantity holds the string denoting the transform_quantity field in the database.
 	FieldTransformQuantity = "transform_quantity"
+	// FieldMatrix holds the string denoting the matrix field in the database.
+	FieldMatrix = "matrix"
+	// FieldMinCharge holds the string denoting the min_charge field in the database.
+	FieldMinCharge = "min_charge"
+	// FieldMaxCharge holds the string denoting the max_charge field in the database.
+	FieldMaxCharge = "max_charge"
 	// FieldLookupKey holds the string denoting the lookup_key field in the database.
 	FieldLookupKey = "lookup_key"
 	// FieldDescription holds the string denoting the description field in the database.
@@ -144,6 +150,9 @@ var Columns = []string{
 	FieldTiers,
 	FieldPriceUnitTiers,
 	FieldTransformQuantity,
+	FieldMatrix,
+	FieldMinCharge,
+	FieldMaxCharge,
 	FieldLookupKey,
 	FieldDescription,
 	FieldMetadata,
@@ -348,6 +357,16 @@ func ByTierMode(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTierMode, opts...).ToFunc()
 }
 
+// ByMinCharge orders the results by the min_charge field.
+func ByMinCharge(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMinCharge, opts...).ToFunc()
+}
+
+// ByMaxCharge orders the results by the max_charge field.
+func ByMaxCharge(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxCharge, opts...).ToFunc()
+}
+
 // ByLookupKey orders the results by the lookup_key field.
 func ByLookupKey(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLookupKey, opts...).ToFunc()
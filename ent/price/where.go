@@ -8,6 +8,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/shopspring/decimal"
 )
 
 // ID filters vertices based on their ID field.
@@ -190,6 +191,16 @@ func TierMode(v string) predicate.Price {
 	return predicate.Price(sql.FieldEQ(FieldTierMode, v))
 }
 
+// MinCharge applies equality check predicate on the "min_charge" field. It's identical to MinChargeEQ.
+func MinCharge(v decimal.Decimal) predicate.Price {
+	return predicate.Price(sql.FieldEQ(FieldMinCharge, v))
+}
+
+// MaxCharge applies equality check predicate on the "max_charge" field. It's identical to MaxChargeEQ.
+func MaxCharge(v decimal.Decimal) predicate.Price {
+	return predicate.Price(sql.FieldEQ(FieldMaxCharge, v))
+}
+
 // LookupKey applies equality check predicate on the "lookup_key" field. It's identical to LookupKeyEQ.
 func LookupKey(v string) predicate.Price {
 	return predicate.Price(sql.FieldEQ(FieldLookupKey, v))
@@ -1830,6 +1841,116 @@ func TransformQuantityNotNil() predicate.Price {
 	return predicate.Price(sql.FieldNotNull(FieldTransformQuantity))
 }
 
+// MatrixIsNil applies the IsNil predicate on the "matrix" field.
+func MatrixIsNil() predicate.Price {
+	return predicate.Price(sql.FieldIsNull(FieldMatrix))
+}
+
+// MatrixNotNil applies the NotNil predicate on the "matrix" field.
+func MatrixNotNil() predicate.Price {
+	return predicate.Price(sql.FieldNotNull(FieldMatrix))
+}
+
+// MinChargeEQ applies the EQ predicate on the "min_charge" field.
+func MinChargeEQ(v decimal.Decimal) predicate.Price {
+	return predicate.Price(sql.FieldEQ(FieldMinCharge, v))
+}
+
+// MinChargeNEQ applies the NEQ predicate on the "min_charge" field.
+func MinChargeNEQ(v decimal.Decimal) predicate.Price {
+	return predicate.Price(sql.FieldNEQ(FieldMinCharge, v))
+}
+
+// MinChargeIn applies the In predicate on the "min_charge" field.
+func MinChargeIn(vs ...decimal.Decimal) predicate.Price {
+	return predicate.Price(sql.FieldIn(FieldMinCharge, vs...))
+}
+
+// MinChargeNotIn applies the NotIn predicate on the "min_charge" field.
+func MinChargeNotIn(vs ...decimal.Decimal) predicate.Price {
+	return predicate.Price(sql.FieldNotIn(FieldMinCharge, vs...))
+}
+
+// MinChargeGT applies the GT predicate on the "min_charge" field.
+func MinChargeGT(v decimal.Decimal) predicate.Price {
+	return predicate.Price(sql.FieldGT(FieldMinCharge, v))
+}
+
+// MinChargeGTE applies the GTE predicate on the "min_charge" field.
+func MinChargeGTE(v decimal.Decimal) predicate.Price {
+	return predicate.Price(sql.FieldGTE(FieldMinCharge, v))
+}
+
+// MinChargeLT applies the LT predicate on the "min_charge" field.
+func MinChargeLT(v decimal.Decimal) predicate.Price {
+	return predicate.Price(sql.FieldLT(FieldMinCharge, v))
+}
+
+// MinChargeLTE applies the LTE predicate on the "min_charge" field.
+func MinChargeLTE(v decimal.Decimal) predicate.Price {
+	return predicate.Price(sql.FieldLTE(FieldMinCharge, v))
+}
+
+// MinChargeIsNil applies the IsNil predicate on the "min_charge" field.
+func MinChargeIsNil() predicate.Price {
+	return predicate.Price(sql.FieldIsNull(FieldMinCharge))
+}
+
+// MinChargeNotNil applies the NotNil predicate on the "min_charge" field.
+func MinChargeNotNil() predicate.Price {
+	return predicate.Price(sql.FieldNotNull(FieldMinCharge))
+}
+
+// MaxChargeEQ applies the EQ predicate on the "max_charge" field.
+func MaxChargeEQ(v decimal.Decimal) predicate.Price {
+	return predicate.Price(sql.FieldEQ(FieldMaxCharge, v))
+}
+
+// MaxChargeNEQ applies the NEQ predicate on the "max_charge" field.
+func MaxChargeNEQ(v decimal.Decimal) predicate.Price {
+	return predicate.Price(sql.FieldNEQ(FieldMaxCharge, v))
+}
+
+// MaxChargeIn applies the In predicate on the "max_charge" field.
+func MaxChargeIn(vs ...decimal.Decimal) predicate.Price {
+	return predicate.Price(sql.FieldIn(FieldMaxCharge, vs...))
+}
+
+// MaxChargeNotIn applies the NotIn predicate on the "max_charge" field.
+func MaxChargeNotIn(vs ...decimal.Decimal) predicate.Price {
+	return predicate.Price(sql.FieldNotIn(FieldMaxCharge, vs...))
+}
+
+// MaxChargeGT applies the GT predicate on the "max_charge" field.
+func MaxChargeGT(v decimal.Decimal) predicate.Price {
+	return predicate.Price(sql.FieldGT(FieldMaxCharge, v))
+}
+
+// MaxChargeGTE applies the GTE predicate on the "max_charge" field.
+func MaxChargeGTE(v decimal.Decimal) predicate.Price {
+	return predicate.Price(sql.FieldGTE(FieldMaxCharge, v))
+}
+
+// MaxChargeLT applies the LT predicate on the "max_charge" field.
+func MaxChargeLT(v decimal.Decimal) predicate.Price {
+	return predicate.Price(sql.FieldLT(FieldMaxCharge, v))
+}
+
+// MaxChargeLTE applies the LTE predicate on the "max_charge" field.
+func MaxChargeLTE(v decimal.Decimal) predicate.Price {
+	return predicate.Price(sql.FieldLTE(FieldMaxCharge, v))
+}
+
+// MaxChargeIsNil applies the IsNil predicate on the "max_charge" field.
+func MaxChargeIsNil() predicate.Price {
+	return predicate.Price(sql.FieldIsNull(FieldMaxCharge))
+}
+
+// MaxChargeNotNil applies the NotNil predicate on the "max_charge" field.
+func MaxChargeNotNil() predicate.Price {
+	return predicate.Price(sql.FieldNotNull(FieldMaxCharge))
+}
+
 // LookupKeyEQ applies the EQ predicate on the "lookup_key" field.
 func LookupKeyEQ(v string) predicate.Price {
 	return predicate.Price(sql.FieldEQ(FieldLookupKey, v))
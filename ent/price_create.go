@@ -14,6 +14,7 @@ import (
 	"github.com/flexprice/flexprice/ent/price"
 	"github.com/flexprice/flexprice/ent/priceunit"
 	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
 )
 
 // PriceCreate is the builder for creating a Price entity.
@@ -333,6 +334,40 @@ func (pc *PriceCreate) SetNillableTransformQuantity(tq *types.TransformQuantity)
 	return pc
 }
 
+// SetMatrix sets the "matrix" field.
+func (pc *PriceCreate) SetMatrix(tm *types.PriceMatrix) *PriceCreate {
+	pc.mutation.SetMatrix(tm)
+	return pc
+}
+
+// SetMinCharge sets the "min_charge" field.
+func (pc *PriceCreate) SetMinCharge(d decimal.Decimal) *PriceCreate {
+	pc.mutation.SetMinCharge(d)
+	return pc
+}
+
+// SetNillableMinCharge sets the "min_charge" field if the given value is not nil.
+func (pc *PriceCreate) SetNillableMinCharge(d *decimal.Decimal) *PriceCreate {
+	if d != nil {
+		pc.SetMinCharge(*d)
+	}
+	return pc
+}
+
+// SetMaxCharge sets the "max_charge" field.
+func (pc *PriceCreate) SetMaxCharge(d decimal.Decimal) *PriceCreate {
+	pc.mutation.SetMaxCharge(d)
+	return pc
+}
+
+// SetNillableMaxCharge sets the "max_charge" field if the given value is not nil.
+func (pc *PriceCreate) SetNillableMaxCharge(d *decimal.Decimal) *PriceCreate {
+	if d != nil {
+		pc.SetMaxCharge(*d)
+	}
+	return pc
+}
+
 // SetLookupKey sets the "lookup_key" field.
 func (pc *PriceCreate) SetLookupKey(s string) *PriceCreate {
 	pc.mutation.SetLookupKey(s)
@@ -639,6 +674,11 @@ func (pc *PriceCreate) check() error {
 	if _, ok := pc.mutation.TrialPeriod(); !ok {
 		return &ValidationError{Name: "trial_period", err: errors.New(`ent: missing required field "Price.trial_period"`)}
 	}
+	if v, ok := pc.mutation.Matrix(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "matrix", err: fmt.Errorf(`ent: validator failed for field "Price.matrix": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -786,6 +826,18 @@ func (pc *PriceCreate) createSpec() (*Price, *sqlgraph.CreateSpec) {
 		_spec.SetField(price.FieldTransformQuantity, field.TypeJSON, value)
 		_node.TransformQuantity = value
 	}
+	if value, ok := pc.mutation.Matrix(); ok {
+		_spec.SetField(price.FieldMatrix, field.TypeJSON, value)
+		_node.Matrix = value
+	}
+	if value, ok := pc.mutation.MinCharge(); ok {
+		_spec.SetField(price.FieldMinCharge, field.TypeOther, value)
+		_node.MinCharge = &value
+	}
+	if value, ok := pc.mutation.MaxCharge(); ok {
+		_spec.SetField(price.FieldMaxCharge, field.TypeOther, value)
+		_node.MaxCharge = &value
+	}
 	if value, ok := pc.mutation.LookupKey(); ok {
 		_spec.SetField(price.FieldLookupKey, field.TypeString, value)
 		_node.LookupKey = value
@@ -17,6 +17,7 @@ import (
 	"github.com/flexprice/flexprice/ent/price"
 	"github.com/flexprice/flexprice/ent/priceunit"
 	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
 )
 
 // PriceUpdate is the builder for updating Price entities.
@@ -434,6 +435,58 @@ func (pu *PriceUpdate) ClearTransformQuantity() *PriceUpdate {
 	return pu
 }
 
+// SetMatrix sets the "matrix" field.
+func (pu *PriceUpdate) SetMatrix(tm *types.PriceMatrix) *PriceUpdate {
+	pu.mutation.SetMatrix(tm)
+	return pu
+}
+
+// ClearMatrix clears the value of the "matrix" field.
+func (pu *PriceUpdate) ClearMatrix() *PriceUpdate {
+	pu.mutation.ClearMatrix()
+	return pu
+}
+
+// SetMinCharge sets the "min_charge" field.
+func (pu *PriceUpdate) SetMinCharge(d decimal.Decimal) *PriceUpdate {
+	pu.mutation.SetMinCharge(d)
+	return pu
+}
+
+// SetNillableMinCharge sets the "min_charge" field if the given value is not nil.
+func (pu *PriceUpdate) SetNillableMinCharge(d *decimal.Decimal) *PriceUpdate {
+	if d != nil {
+		pu.SetMinCharge(*d)
+	}
+	return pu
+}
+
+// ClearMinCharge clears the value of the "min_charge" field.
+func (pu *PriceUpdate) ClearMinCharge() *PriceUpdate {
+	pu.mutation.ClearMinCharge()
+	return pu
+}
+
+// SetMaxCharge sets the "max_charge" field.
+func (pu *PriceUpdate) SetMaxCharge(d decimal.Decimal) *PriceUpdate {
+	pu.mutation.SetMaxCharge(d)
+	return pu
+}
+
+// SetNillableMaxCharge sets the "max_charge" field if the given value is not nil.
+func (pu *PriceUpdate) SetNillableMaxCharge(d *decimal.Decimal) *PriceUpdate {
+	if d != nil {
+		pu.SetMaxCharge(*d)
+	}
+	return pu
+}
+
+// ClearMaxCharge clears the value of the "max_charge" field.
+func (pu *PriceUpdate) ClearMaxCharge() *PriceUpdate {
+	pu.mutation.ClearMaxCharge()
+	return pu
+}
+
 // SetLookupKey sets the "lookup_key" field.
 func (pu *PriceUpdate) SetLookupKey(s string) *PriceUpdate {
 	pu.mutation.SetLookupKey(s)
@@ -665,6 +718,11 @@ func (pu *PriceUpdate) check() error {
 			return &ValidationError{Name: "billing_cadence", err: fmt.Errorf(`ent: validator failed for field "Price.billing_cadence": %w`, err)}
 		}
 	}
+	if v, ok := pu.mutation.Matrix(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "matrix", err: fmt.Errorf(`ent: validator failed for field "Price.matrix": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -810,6 +868,24 @@ func (pu *PriceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if pu.mutation.TransformQuantityCleared() {
 		_spec.ClearField(price.FieldTransformQuantity, field.TypeJSON)
 	}
+	if value, ok := pu.mutation.Matrix(); ok {
+		_spec.SetField(price.FieldMatrix, field.TypeJSON, value)
+	}
+	if pu.mutation.MatrixCleared() {
+		_spec.ClearField(price.FieldMatrix, field.TypeJSON)
+	}
+	if value, ok := pu.mutation.MinCharge(); ok {
+		_spec.SetField(price.FieldMinCharge, field.TypeOther, value)
+	}
+	if pu.mutation.MinChargeCleared() {
+		_spec.ClearField(price.FieldMinCharge, field.TypeOther)
+	}
+	if value, ok := pu.mutation.MaxCharge(); ok {
+		_spec.SetField(price.FieldMaxCharge, field.TypeOther, value)
+	}
+	if pu.mutation.MaxChargeCleared() {
+		_spec.ClearField(price.FieldMaxCharge, field.TypeOther)
+	}
 	if value, ok := pu.mutation.LookupKey(); ok {
 		_spec.SetField(price.FieldLookupKey, field.TypeString, value)
 	}
@@ -1329,6 +1405,58 @@ func (puo *PriceUpdateOne) ClearTransformQuantity() *PriceUpdateOne {
 	return puo
 }
 
+// SetMatrix sets the "matrix" field.
+func (puo *PriceUpdateOne) SetMatrix(tm *types.PriceMatrix) *PriceUpdateOne {
+	puo.mutation.SetMatrix(tm)
+	return puo
+}
+
+// ClearMatrix clears the value of the "matrix" field.
+func (puo *PriceUpdateOne) ClearMatrix() *PriceUpdateOne {
+	puo.mutation.ClearMatrix()
+	return puo
+}
+
+// SetMinCharge sets the "min_charge" field.
+func (puo *PriceUpdateOne) SetMinCharge(d decimal.Decimal) *PriceUpdateOne {
+	puo.mutation.SetMinCharge(d)
+	return puo
+}
+
+// SetNillableMinCharge sets the "min_charge" field if the given value is not nil.
+func (puo *PriceUpdateOne) SetNillableMinCharge(d *decimal.Decimal) *PriceUpdateOne {
+	if d != nil {
+		puo.SetMinCharge(*d)
+	}
+	return puo
+}
+
+// ClearMinCharge clears the value of the "min_charge" field.
+func (puo *PriceUpdateOne) ClearMinCharge() *PriceUpdateOne {
+	puo.mutation.ClearMinCharge()
+	return puo
+}
+
+// SetMaxCharge sets the "max_charge" field.
+func (puo *PriceUpdateOne) SetMaxCharge(d decimal.Decimal) *PriceUpdateOne {
+	puo.mutation.SetMaxCharge(d)
+	return puo
+}
+
+// SetNillableMaxCharge sets the "max_charge" field if the given value is not nil.
+func (puo *PriceUpdateOne) SetNillableMaxCharge(d *decimal.Decimal) *PriceUpdateOne {
+	if d != nil {
+		puo.SetMaxCharge(*d)
+	}
+	return puo
+}
+
+// ClearMaxCharge clears the value of the "max_charge" field.
+func (puo *PriceUpdateOne) ClearMaxCharge() *PriceUpdateOne {
+	puo.mutation.ClearMaxCharge()
+	return puo
+}
+
 // SetLookupKey sets the "lookup_key" field.
 func (puo *PriceUpdateOne) SetLookupKey(s string) *PriceUpdateOne {
 	puo.mutation.SetLookupKey(s)
@@ -1573,6 +1701,11 @@ func (puo *PriceUpdateOne) check() error {
 			return &ValidationError{Name: "billing_cadence", err: fmt.Errorf(`ent: validator failed for field "Price.billing_cadence": %w`, err)}
 		}
 	}
+	if v, ok := puo.mutation.Matrix(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "matrix", err: fmt.Errorf(`ent: validator failed for field "Price.matrix": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -1735,6 +1868,24 @@ func (puo *PriceUpdateOne) sqlSave(ctx context.Context) (_node *Price, err error
 	if puo.mutation.TransformQuantityCleared() {
 		_spec.ClearField(price.FieldTransformQuantity, field.TypeJSON)
 	}
+	if value, ok := puo.mutation.Matrix(); ok {
+		_spec.SetField(price.FieldMatrix, field.TypeJSON, value)
+	}
+	if puo.mutation.MatrixCleared() {
+		_spec.ClearField(price.FieldMatrix, field.TypeJSON)
+	}
+	if value, ok := puo.mutation.MinCharge(); ok {
+		_spec.SetField(price.FieldMinCharge, field.TypeOther, value)
+	}
+	if puo.mutation.MinChargeCleared() {
+		_spec.ClearField(price.FieldMinCharge, field.TypeOther)
+	}
+	if value, ok := puo.mutation.MaxCharge(); ok {
+		_spec.SetField(price.FieldMaxCharge, field.TypeOther, value)
+	}
+	if puo.mutation.MaxChargeCleared() {
+		_spec.ClearField(price.FieldMaxCharge, field.TypeOther)
+	}
 	if value, ok := puo.mutation.LookupKey(); ok {
 		_spec.SetField(price.FieldLookupKey, field.TypeString, value)
 	}
@@ -0,0 +1,292 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/flexprice/flexprice/ent/coupon"
+	"github.com/flexprice/flexprice/ent/promotioncode"
+)
+
+// PromotionCode is the model entity for the PromotionCode schema.
+type PromotionCode struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID string `json:"tenant_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// EnvironmentID holds the value of the "environment_id" field.
+	EnvironmentID string `json:"environment_id,omitempty"`
+	// Coupon redeemed with the promotion code
+	CouponID string `json:"coupon_id,omitempty"`
+	// Customer facing code, stored in upper case
+	Code string `json:"code,omitempty"`
+	// Customer the promotion code is restricted to
+	CustomerID *string `json:"customer_id,omitempty"`
+	// Promotion code expiry date
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// Promotion code max redemptions
+	MaxRedemptions *int `json:"max_redemptions,omitempty"`
+	// Promotion code total redemptions
+	TotalRedemptions int `json:"total_redemptions,omitempty"`
+	// Additional metadata for promotion code
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PromotionCodeQuery when eager-loading is set.
+	Edges        PromotionCodeEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PromotionCodeEdges holds the relations/edges for other nodes in the graph.
+type PromotionCodeEdges struct {
+	// Coupon holds the value of the coupon edge.
+	Coupon *Coupon `json:"coupon,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// CouponOrErr returns the Coupon value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PromotionCodeEdges) CouponOrErr() (*Coupon, error) {
+	if e.Coupon != nil {
+		return e.Coupon, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: coupon.Label}
+	}
+	return nil, &NotLoadedError{edge: "coupon"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PromotionCode) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case promotioncode.FieldMetadata:
+			values[i] = new([]byte)
+		case promotioncode.FieldMaxRedemptions, promotioncode.FieldTotalRedemptions:
+			values[i] = new(sql.NullInt64)
+		case promotioncode.FieldID, promotioncode.FieldTenantID, promotioncode.FieldStatus, promotioncode.FieldCreatedBy, promotioncode.FieldUpdatedBy, promotioncode.FieldEnvironmentID, promotioncode.FieldCouponID, promotioncode.FieldCode, promotioncode.FieldCustomerID:
+			values[i] = new(sql.NullString)
+		case promotioncode.FieldCreatedAt, promotioncode.FieldUpdatedAt, promotioncode.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PromotionCode fields.
+func (pc *PromotionCode) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case promotioncode.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				pc.ID = value.String
+			}
+		case promotioncode.FieldTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				pc.TenantID = value.String
+			}
+		case promotioncode.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				pc.Status = value.String
+			}
+		case promotioncode.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pc.CreatedAt = value.Time
+			}
+		case promotioncode.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pc.UpdatedAt = value.Time
+			}
+		case promotioncode.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				pc.CreatedBy = value.String
+			}
+		case promotioncode.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				pc.UpdatedBy = value.String
+			}
+		case promotioncode.FieldEnvironmentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field environment_id", values[i])
+			} else if value.Valid {
+				pc.EnvironmentID = value.String
+			}
+		case promotioncode.FieldCouponID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field coupon_id", values[i])
+			} else if value.Valid {
+				pc.CouponID = value.String
+			}
+		case promotioncode.FieldCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code", values[i])
+			} else if value.Valid {
+				pc.Code = value.String
+			}
+		case promotioncode.FieldCustomerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field customer_id", values[i])
+			} else if value.Valid {
+				pc.CustomerID = new(string)
+				*pc.CustomerID = value.String
+			}
+		case promotioncode.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				pc.ExpiresAt = new(time.Time)
+				*pc.ExpiresAt = value.Time
+			}
+		case promotioncode.FieldMaxRedemptions:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_redemptions", values[i])
+			} else if value.Valid {
+				pc.MaxRedemptions = new(int)
+				*pc.MaxRedemptions = int(value.Int64)
+			}
+		case promotioncode.FieldTotalRedemptions:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_redemptions", values[i])
+			} else if value.Valid {
+				pc.TotalRedemptions = int(value.Int64)
+			}
+		case promotioncode.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pc.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		default:
+			pc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PromotionCode.
+// This includes values selected through modifiers, order, etc.
+func (pc *PromotionCode) Value(name string) (ent.Value, error) {
+	return pc.selectValues.Get(name)
+}
+
+// QueryCoupon queries the "coupon" edge of the PromotionCode entity.
+func (pc *PromotionCode) QueryCoupon() *CouponQuery {
+	return NewPromotionCodeClient(pc.config).QueryCoupon(pc)
+}
+
+// Update returns a builder for updating this PromotionCode.
+// Note that you need to call PromotionCode.Unwrap() before calling this method if this PromotionCode
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pc *PromotionCode) Update() *PromotionCodeUpdateOne {
+	return NewPromotionCodeClient(pc.config).UpdateOne(pc)
+}
+
+// Unwrap unwraps the PromotionCode entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pc *PromotionCode) Unwrap() *PromotionCode {
+	_tx, ok := pc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PromotionCode is not a transactional entity")
+	}
+	pc.config.driver = _tx.drv
+	return pc
+}
+
+// String implements the fmt.Stringer.
+func (pc *PromotionCode) String() string {
+	var builder strings.Builder
+	builder.WriteString("PromotionCode(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pc.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(pc.TenantID)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(pc.Status)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pc.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pc.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(pc.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(pc.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("environment_id=")
+	builder.WriteString(pc.EnvironmentID)
+	builder.WriteString(", ")
+	builder.WriteString("coupon_id=")
+	builder.WriteString(pc.CouponID)
+	builder.WriteString(", ")
+	builder.WriteString("code=")
+	builder.WriteString(pc.Code)
+	builder.WriteString(", ")
+	if v := pc.CustomerID; v != nil {
+		builder.WriteString("customer_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := pc.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := pc.MaxRedemptions; v != nil {
+		builder.WriteString("max_redemptions=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("total_redemptions=")
+	builder.WriteString(fmt.Sprintf("%v", pc.TotalRedemptions))
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", pc.Metadata))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PromotionCodes is a parsable slice of PromotionCode.
+type PromotionCodes []*PromotionCode
@@ -0,0 +1,598 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/ent/promotioncode"
+)
+
+// PromotionCodeUpdate is the builder for updating PromotionCode entities.
+type PromotionCodeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PromotionCodeMutation
+}
+
+// Where appends a list predicates to the PromotionCodeUpdate builder.
+func (pcu *PromotionCodeUpdate) Where(ps ...predicate.PromotionCode) *PromotionCodeUpdate {
+	pcu.mutation.Where(ps...)
+	return pcu
+}
+
+// SetStatus sets the "status" field.
+func (pcu *PromotionCodeUpdate) SetStatus(s string) *PromotionCodeUpdate {
+	pcu.mutation.SetStatus(s)
+	return pcu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (pcu *PromotionCodeUpdate) SetNillableStatus(s *string) *PromotionCodeUpdate {
+	if s != nil {
+		pcu.SetStatus(*s)
+	}
+	return pcu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pcu *PromotionCodeUpdate) SetUpdatedAt(t time.Time) *PromotionCodeUpdate {
+	pcu.mutation.SetUpdatedAt(t)
+	return pcu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (pcu *PromotionCodeUpdate) SetUpdatedBy(s string) *PromotionCodeUpdate {
+	pcu.mutation.SetUpdatedBy(s)
+	return pcu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (pcu *PromotionCodeUpdate) SetNillableUpdatedBy(s *string) *PromotionCodeUpdate {
+	if s != nil {
+		pcu.SetUpdatedBy(*s)
+	}
+	return pcu
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (pcu *PromotionCodeUpdate) ClearUpdatedBy() *PromotionCodeUpdate {
+	pcu.mutation.ClearUpdatedBy()
+	return pcu
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (pcu *PromotionCodeUpdate) SetCustomerID(s string) *PromotionCodeUpdate {
+	pcu.mutation.SetCustomerID(s)
+	return pcu
+}
+
+// SetNillableCustomerID sets the "customer_id" field if the given value is not nil.
+func (pcu *PromotionCodeUpdate) SetNillableCustomerID(s *string) *PromotionCodeUpdate {
+	if s != nil {
+		pcu.SetCustomerID(*s)
+	}
+	return pcu
+}
+
+// ClearCustomerID clears the value of the "customer_id" field.
+func (pcu *PromotionCodeUpdate) ClearCustomerID() *PromotionCodeUpdate {
+	pcu.mutation.ClearCustomerID()
+	return pcu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (pcu *PromotionCodeUpdate) SetExpiresAt(t time.Time) *PromotionCodeUpdate {
+	pcu.mutation.SetExpiresAt(t)
+	return pcu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (pcu *PromotionCodeUpdate) SetNillableExpiresAt(t *time.Time) *PromotionCodeUpdate {
+	if t != nil {
+		pcu.SetExpiresAt(*t)
+	}
+	return pcu
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (pcu *PromotionCodeUpdate) ClearExpiresAt() *PromotionCodeUpdate {
+	pcu.mutation.ClearExpiresAt()
+	return pcu
+}
+
+// SetMaxRedemptions sets the "max_redemptions" field.
+func (pcu *PromotionCodeUpdate) SetMaxRedemptions(i int) *PromotionCodeUpdate {
+	pcu.mutation.ResetMaxRedemptions()
+	pcu.mutation.SetMaxRedemptions(i)
+	return pcu
+}
+
+// SetNillableMaxRedemptions sets the "max_redemptions" field if the given value is not nil.
+func (pcu *PromotionCodeUpdate) SetNillableMaxRedemptions(i *int) *PromotionCodeUpdate {
+	if i != nil {
+		pcu.SetMaxRedemptions(*i)
+	}
+	return pcu
+}
+
+// AddMaxRedemptions adds i to the "max_redemptions" field.
+func (pcu *PromotionCodeUpdate) AddMaxRedemptions(i int) *PromotionCodeUpdate {
+	pcu.mutation.AddMaxRedemptions(i)
+	return pcu
+}
+
+// ClearMaxRedemptions clears the value of the "max_redemptions" field.
+func (pcu *PromotionCodeUpdate) ClearMaxRedemptions() *PromotionCodeUpdate {
+	pcu.mutation.ClearMaxRedemptions()
+	return pcu
+}
+
+// SetTotalRedemptions sets the "total_redemptions" field.
+func (pcu *PromotionCodeUpdate) SetTotalRedemptions(i int) *PromotionCodeUpdate {
+	pcu.mutation.ResetTotalRedemptions()
+	pcu.mutation.SetTotalRedemptions(i)
+	return pcu
+}
+
+// SetNillableTotalRedemptions sets the "total_redemptions" field if the given value is not nil.
+func (pcu *PromotionCodeUpdate) SetNillableTotalRedemptions(i *int) *PromotionCodeUpdate {
+	if i != nil {
+		pcu.SetTotalRedemptions(*i)
+	}
+	return pcu
+}
+
+// AddTotalRedemptions adds i to the "total_redemptions" field.
+func (pcu *PromotionCodeUpdate) AddTotalRedemptions(i int) *PromotionCodeUpdate {
+	pcu.mutation.AddTotalRedemptions(i)
+	return pcu
+}
+
+// SetMetadata sets the "metadata" field.
+func (pcu *PromotionCodeUpdate) SetMetadata(m map[string]string) *PromotionCodeUpdate {
+	pcu.mutation.SetMetadata(m)
+	return pcu
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (pcu *PromotionCodeUpdate) ClearMetadata() *PromotionCodeUpdate {
+	pcu.mutation.ClearMetadata()
+	return pcu
+}
+
+// Mutation returns the PromotionCodeMutation object of the builder.
+func (pcu *PromotionCodeUpdate) Mutation() *PromotionCodeMutation {
+	return pcu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pcu *PromotionCodeUpdate) Save(ctx context.Context) (int, error) {
+	pcu.defaults()
+	return withHooks(ctx, pcu.sqlSave, pcu.mutation, pcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcu *PromotionCodeUpdate) SaveX(ctx context.Context) int {
+	affected, err := pcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pcu *PromotionCodeUpdate) Exec(ctx context.Context) error {
+	_, err := pcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcu *PromotionCodeUpdate) ExecX(ctx context.Context) {
+	if err := pcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pcu *PromotionCodeUpdate) defaults() {
+	if _, ok := pcu.mutation.UpdatedAt(); !ok {
+		v := promotioncode.UpdateDefaultUpdatedAt()
+		pcu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pcu *PromotionCodeUpdate) check() error {
+	if pcu.mutation.CouponCleared() && len(pcu.mutation.CouponIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PromotionCode.coupon"`)
+	}
+	return nil
+}
+
+func (pcu *PromotionCodeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pcu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(promotioncode.Table, promotioncode.Columns, sqlgraph.NewFieldSpec(promotioncode.FieldID, field.TypeString))
+	if ps := pcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pcu.mutation.Status(); ok {
+		_spec.SetField(promotioncode.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := pcu.mutation.UpdatedAt(); ok {
+		_spec.SetField(promotioncode.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if pcu.mutation.CreatedByCleared() {
+		_spec.ClearField(promotioncode.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := pcu.mutation.UpdatedBy(); ok {
+		_spec.SetField(promotioncode.FieldUpdatedBy, field.TypeString, value)
+	}
+	if pcu.mutation.UpdatedByCleared() {
+		_spec.ClearField(promotioncode.FieldUpdatedBy, field.TypeString)
+	}
+	if pcu.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(promotioncode.FieldEnvironmentID, field.TypeString)
+	}
+	if value, ok := pcu.mutation.CustomerID(); ok {
+		_spec.SetField(promotioncode.FieldCustomerID, field.TypeString, value)
+	}
+	if pcu.mutation.CustomerIDCleared() {
+		_spec.ClearField(promotioncode.FieldCustomerID, field.TypeString)
+	}
+	if value, ok := pcu.mutation.ExpiresAt(); ok {
+		_spec.SetField(promotioncode.FieldExpiresAt, field.TypeTime, value)
+	}
+	if pcu.mutation.ExpiresAtCleared() {
+		_spec.ClearField(promotioncode.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := pcu.mutation.MaxRedemptions(); ok {
+		_spec.SetField(promotioncode.FieldMaxRedemptions, field.TypeInt, value)
+	}
+	if value, ok := pcu.mutation.AddedMaxRedemptions(); ok {
+		_spec.AddField(promotioncode.FieldMaxRedemptions, field.TypeInt, value)
+	}
+	if pcu.mutation.MaxRedemptionsCleared() {
+		_spec.ClearField(promotioncode.FieldMaxRedemptions, field.TypeInt)
+	}
+	if value, ok := pcu.mutation.TotalRedemptions(); ok {
+		_spec.SetField(promotioncode.FieldTotalRedemptions, field.TypeInt, value)
+	}
+	if value, ok := pcu.mutation.AddedTotalRedemptions(); ok {
+		_spec.AddField(promotioncode.FieldTotalRedemptions, field.TypeInt, value)
+	}
+	if value, ok := pcu.mutation.Metadata(); ok {
+		_spec.SetField(promotioncode.FieldMetadata, field.TypeJSON, value)
+	}
+	if pcu.mutation.MetadataCleared() {
+		_spec.ClearField(promotioncode.FieldMetadata, field.TypeJSON)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{promotioncode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pcu.mutation.done = true
+	return n, nil
+}
+
+// PromotionCodeUpdateOne is the builder for updating a single PromotionCode entity.
+type PromotionCodeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PromotionCodeMutation
+}
+
+// SetStatus sets the "status" field.
+func (pcuo *PromotionCodeUpdateOne) SetStatus(s string) *PromotionCodeUpdateOne {
+	pcuo.mutation.SetStatus(s)
+	return pcuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (pcuo *PromotionCodeUpdateOne) SetNillableStatus(s *string) *PromotionCodeUpdateOne {
+	if s != nil {
+		pcuo.SetStatus(*s)
+	}
+	return pcuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pcuo *PromotionCodeUpdateOne) SetUpdatedAt(t time.Time) *PromotionCodeUpdateOne {
+	pcuo.mutation.SetUpdatedAt(t)
+	return pcuo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (pcuo *PromotionCodeUpdateOne) SetUpdatedBy(s string) *PromotionCodeUpdateOne {
+	pcuo.mutation.SetUpdatedBy(s)
+	return pcuo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (pcuo *PromotionCodeUpdateOne) SetNillableUpdatedBy(s *string) *PromotionCodeUpdateOne {
+	if s != nil {
+		pcuo.SetUpdatedBy(*s)
+	}
+	return pcuo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (pcuo *PromotionCodeUpdateOne) ClearUpdatedBy() *PromotionCodeUpdateOne {
+	pcuo.mutation.ClearUpdatedBy()
+	return pcuo
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (pcuo *PromotionCodeUpdateOne) SetCustomerID(s string) *PromotionCodeUpdateOne {
+	pcuo.mutation.SetCustomerID(s)
+	return pcuo
+}
+
+// SetNillableCustomerID sets the "customer_id" field if the given value is not nil.
+func (pcuo *PromotionCodeUpdateOne) SetNillableCustomerID(s *string) *PromotionCodeUpdateOne {
+	if s != nil {
+		pcuo.SetCustomerID(*s)
+	}
+	return pcuo
+}
+
+// ClearCustomerID clears the value of the "customer_id" field.
+func (pcuo *PromotionCodeUpdateOne) ClearCustomerID() *PromotionCodeUpdateOne {
+	pcuo.mutation.ClearCustomerID()
+	return pcuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (pcuo *PromotionCodeUpdateOne) SetExpiresAt(t time.Time) *PromotionCodeUpdateOne {
+	pcuo.mutation.SetExpiresAt(t)
+	return pcuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (pcuo *PromotionCodeUpdateOne) SetNillableExpiresAt(t *time.Time) *PromotionCodeUpdateOne {
+	if t != nil {
+		pcuo.SetExpiresAt(*t)
+	}
+	return pcuo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (pcuo *PromotionCodeUpdateOne) ClearExpiresAt() *PromotionCodeUpdateOne {
+	pcuo.mutation.ClearExpiresAt()
+	return pcuo
+}
+
+// SetMaxRedemptions sets the "max_redemptions" field.
+func (pcuo *PromotionCodeUpdateOne) SetMaxRedemptions(i int) *PromotionCodeUpdateOne {
+	pcuo.mutation.ResetMaxRedemptions()
+	pcuo.mutation.SetMaxRedemptions(i)
+	return pcuo
+}
+
+// SetNillableMaxRedemptions sets the "max_redemptions" field if the given value is not nil.
+func (pcuo *PromotionCodeUpdateOne) SetNillableMaxRedemptions(i *int) *PromotionCodeUpdateOne {
+	if i != nil {
+		pcuo.SetMaxRedemptions(*i)
+	}
+	return pcuo
+}
+
+// AddMaxRedemptions adds i to the "max_redemptions" field.
+func (pcuo *PromotionCodeUpdateOne) AddMaxRedemptions(i int) *PromotionCodeUpdateOne {
+	pcuo.mutation.AddMaxRedemptions(i)
+	return pcuo
+}
+
+// ClearMaxRedemptions clears the value of the "max_redemptions" field.
+func (pcuo *PromotionCodeUpdateOne) ClearMaxRedemptions() *PromotionCodeUpdateOne {
+	pcuo.mutation.ClearMaxRedemptions()
+	return pcuo
+}
+
+// SetTotalRedemptions sets the "total_redemptions" field.
+func (pcuo *PromotionCodeUpdateOne) SetTotalRedemptions(i int) *PromotionCodeUpdateOne {
+	pcuo.mutation.ResetTotalRedemptions()
+	pcuo.mutation.SetTotalRedemptions(i)
+	return pcuo
+}
+
+// SetNillableTotalRedemptions sets the "total_redemptions" field if the given value is not nil.
+func (pcuo *PromotionCodeUpdateOne) SetNillableTotalRedemptions(i *int) *PromotionCodeUpdateOne {
+	if i != nil {
+		pcuo.SetTotalRedemptions(*i)
+	}
+	return pcuo
+}
+
+// AddTotalRedemptions adds i to the "total_redemptions" field.
+func (pcuo *PromotionCodeUpdateOne) AddTotalRedemptions(i int) *PromotionCodeUpdateOne {
+	pcuo.mutation.AddTotalRedemptions(i)
+	return pcuo
+}
+
+// SetMetadata sets the "metadata" field.
+func (pcuo *PromotionCodeUpdateOne) SetMetadata(m map[string]string) *PromotionCodeUpdateOne {
+	pcuo.mutation.SetMetadata(m)
+	return pcuo
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (pcuo *PromotionCodeUpdateOne) ClearMetadata() *PromotionCodeUpdateOne {
+	pcuo.mutation.ClearMetadata()
+	return pcuo
+}
+
+// Mutation returns the PromotionCodeMutation object of the builder.
+func (pcuo *PromotionCodeUpdateOne) Mutation() *PromotionCodeMutation {
+	return pcuo.mutation
+}
+
+// Where appends a list predicates to the PromotionCodeUpdate builder.
+func (pcuo *PromotionCodeUpdateOne) Where(ps ...predicate.PromotionCode) *PromotionCodeUpdateOne {
+	pcuo.mutation.Where(ps...)
+	return pcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pcuo *PromotionCodeUpdateOne) Select(field string, fields ...string) *PromotionCodeUpdateOne {
+	pcuo.fields = append([]string{field}, fields...)
+	return pcuo
+}
+
+// Save executes the query and returns the updated PromotionCode entity.
+func (pcuo *PromotionCodeUpdateOne) Save(ctx context.Context) (*PromotionCode, error) {
+	pcuo.defaults()
+	return withHooks(ctx, pcuo.sqlSave, pcuo.mutation, pcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcuo *PromotionCodeUpdateOne) SaveX(ctx context.Context) *PromotionCode {
+	node, err := pcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pcuo *PromotionCodeUpdateOne) Exec(ctx context.Context) error {
+	_, err := pcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcuo *PromotionCodeUpdateOne) ExecX(ctx context.Context) {
+	if err := pcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pcuo *PromotionCodeUpdateOne) defaults() {
+	if _, ok := pcuo.mutation.UpdatedAt(); !ok {
+		v := promotioncode.UpdateDefaultUpdatedAt()
+		pcuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pcuo *PromotionCodeUpdateOne) check() error {
+	if pcuo.mutation.CouponCleared() && len(pcuo.mutation.CouponIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PromotionCode.coupon"`)
+	}
+	return nil
+}
+
+func (pcuo *PromotionCodeUpdateOne) sqlSave(ctx context.Context) (_node *PromotionCode, err error) {
+	if err := pcuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(promotioncode.Table, promotioncode.Columns, sqlgraph.NewFieldSpec(promotioncode.FieldID, field.TypeString))
+	id, ok := pcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PromotionCode.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, promotioncode.FieldID)
+		for _, f := range fields {
+			if !promotioncode.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != promotioncode.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pcuo.mutation.Status(); ok {
+		_spec.SetField(promotioncode.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := pcuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(promotioncode.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if pcuo.mutation.CreatedByCleared() {
+		_spec.ClearField(promotioncode.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := pcuo.mutation.UpdatedBy(); ok {
+		_spec.SetField(promotioncode.FieldUpdatedBy, field.TypeString, value)
+	}
+	if pcuo.mutation.UpdatedByCleared() {
+		_spec.ClearField(promotioncode.FieldUpdatedBy, field.TypeString)
+	}
+	if pcuo.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(promotioncode.FieldEnvironmentID, field.TypeString)
+	}
+	if value, ok := pcuo.mutation.CustomerID(); ok {
+		_spec.SetField(promotioncode.FieldCustomerID, field.TypeString, value)
+	}
+	if pcuo.mutation.CustomerIDCleared() {
+		_spec.ClearField(promotioncode.FieldCustomerID, field.TypeString)
+	}
+	if value, ok := pcuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(promotioncode.FieldExpiresAt, field.TypeTime, value)
+	}
+	if pcuo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(promotioncode.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := pcuo.mutation.MaxRedemptions(); ok {
+		_spec.SetField(promotioncode.FieldMaxRedemptions, field.TypeInt, value)
+	}
+	if value, ok := pcuo.mutation.AddedMaxRedemptions(); ok {
+		_spec.AddField(promotioncode.FieldMaxRedemptions, field.TypeInt, value)
+	}
+	if pcuo.mutation.MaxRedemptionsCleared() {
+		_spec.ClearField(promotioncode.FieldMaxRedemptions, field.TypeInt)
+	}
+	if value, ok := pcuo.mutation.TotalRedemptions(); ok {
+		_spec.SetField(promotioncode.FieldTotalRedemptions, field.TypeInt, value)
+	}
+	if value, ok := pcuo.mutation.AddedTotalRedemptions(); ok {
+		_spec.AddField(promotioncode.FieldTotalRedemptions, field.TypeInt, value)
+	}
+	if value, ok := pcuo.mutation.Metadata(); ok {
+		_spec.SetField(promotioncode.FieldMetadata, field.TypeJSON, value)
+	}
+	if pcuo.mutation.MetadataCleared() {
+		_spec.ClearField(promotioncode.FieldMetadata, field.TypeJSON)
+	}
+	_node = &PromotionCode{config: pcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{promotioncode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pcuo.mutation.done = true
+	return _node, nil
+}
@@ -21,19 +21,25 @@ import (
 	"github.com/flexprice/flexprice/ent/creditnotelineitem"
 	"github.com/flexprice/flexprice/ent/customer"
 	"github.com/flexprice/flexprice/ent/entitlement"
+	"github.com/flexprice/flexprice/ent/entitlementusagecounter"
 	"github.com/flexprice/flexprice/ent/entityintegrationmapping"
 	"github.com/flexprice/flexprice/ent/environment"
+	"github.com/flexprice/flexprice/ent/eventcorrectionclaim"
+	"github.com/flexprice/flexprice/ent/eventschema"
 	"github.com/flexprice/flexprice/ent/feature"
 	"github.com/flexprice/flexprice/ent/group"
 	"github.com/flexprice/flexprice/ent/invoice"
 	"github.com/flexprice/flexprice/ent/invoicelineitem"
 	"github.com/flexprice/flexprice/ent/invoicesequence"
 	"github.com/flexprice/flexprice/ent/meter"
+	"github.com/flexprice/flexprice/ent/metricseriesstate"
 	"github.com/flexprice/flexprice/ent/payment"
 	"github.com/flexprice/flexprice/ent/paymentattempt"
+	"github.com/flexprice/flexprice/ent/paymentrefund"
 	"github.com/flexprice/flexprice/ent/plan"
 	"github.com/flexprice/flexprice/ent/price"
 	"github.com/flexprice/flexprice/ent/priceunit"
+	"github.com/flexprice/flexprice/ent/promotioncode"
 	"github.com/flexprice/flexprice/ent/scheduledtask"
 	"github.com/flexprice/flexprice/ent/schema"
 	"github.com/flexprice/flexprice/ent/secret"
@@ -610,12 +616,16 @@ func init() {
 	creditnoteDescCreditNoteType := creditnoteFields[6].Descriptor()
 	// creditnote.CreditNoteTypeValidator is a validator for the "credit_note_type" field. It is called by the builders before save.
 	creditnote.CreditNoteTypeValidator = creditnoteDescCreditNoteType.Validators[0].(func(string) error)
+	// creditnoteDescRefundMethod is the schema descriptor for refund_method field.
+	creditnoteDescRefundMethod := creditnoteFields[8].Descriptor()
+	// creditnote.DefaultRefundMethod holds the default value on creation for the refund_method field.
+	creditnote.DefaultRefundMethod = types.CreditNoteRefundMethod(creditnoteDescRefundMethod.Default.(string))
 	// creditnoteDescReason is the schema descriptor for reason field.
-	creditnoteDescReason := creditnoteFields[8].Descriptor()
+	creditnoteDescReason := creditnoteFields[9].Descriptor()
 	// creditnote.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
 	creditnote.ReasonValidator = creditnoteDescReason.Validators[0].(func(string) error)
 	// creditnoteDescTotalAmount is the schema descriptor for total_amount field.
-	creditnoteDescTotalAmount := creditnoteFields[15].Descriptor()
+	creditnoteDescTotalAmount := creditnoteFields[16].Descriptor()
 	// creditnote.DefaultTotalAmount holds the default value on creation for the total_amount field.
 	creditnote.DefaultTotalAmount = creditnoteDescTotalAmount.Default.(decimal.Decimal)
 	creditnotelineitemMixin := schema.CreditNoteLineItem{}.Mixin()
@@ -761,6 +771,38 @@ func init() {
 	entitlementDescID := entitlementFields[0].Descriptor()
 	// entitlement.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	entitlement.IDValidator = entitlementDescID.Validators[0].(func(string) error)
+	entitlementusagecounterFields := schema.EntitlementUsageCounter{}.Fields()
+	_ = entitlementusagecounterFields
+	// entitlementusagecounterDescTenantID is the schema descriptor for tenant_id field.
+	entitlementusagecounterDescTenantID := entitlementusagecounterFields[0].Descriptor()
+	// entitlementusagecounter.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	entitlementusagecounter.TenantIDValidator = entitlementusagecounterDescTenantID.Validators[0].(func(string) error)
+	// entitlementusagecounterDescCustomerID is the schema descriptor for customer_id field.
+	entitlementusagecounterDescCustomerID := entitlementusagecounterFields[2].Descriptor()
+	// entitlementusagecounter.CustomerIDValidator is a validator for the "customer_id" field. It is called by the builders before save.
+	entitlementusagecounter.CustomerIDValidator = entitlementusagecounterDescCustomerID.Validators[0].(func(string) error)
+	// entitlementusagecounterDescFeatureID is the schema descriptor for feature_id field.
+	entitlementusagecounterDescFeatureID := entitlementusagecounterFields[3].Descriptor()
+	// entitlementusagecounter.FeatureIDValidator is a validator for the "feature_id" field. It is called by the builders before save.
+	entitlementusagecounter.FeatureIDValidator = entitlementusagecounterDescFeatureID.Validators[0].(func(string) error)
+	// entitlementusagecounterDescBaseline is the schema descriptor for baseline field.
+	entitlementusagecounterDescBaseline := entitlementusagecounterFields[5].Descriptor()
+	// entitlementusagecounter.DefaultBaseline holds the default value on creation for the baseline field.
+	entitlementusagecounter.DefaultBaseline = entitlementusagecounterDescBaseline.Default.(decimal.Decimal)
+	// entitlementusagecounterDescReserved is the schema descriptor for reserved field.
+	entitlementusagecounterDescReserved := entitlementusagecounterFields[6].Descriptor()
+	// entitlementusagecounter.DefaultReserved holds the default value on creation for the reserved field.
+	entitlementusagecounter.DefaultReserved = entitlementusagecounterDescReserved.Default.(decimal.Decimal)
+	// entitlementusagecounterDescCreatedAt is the schema descriptor for created_at field.
+	entitlementusagecounterDescCreatedAt := entitlementusagecounterFields[8].Descriptor()
+	// entitlementusagecounter.DefaultCreatedAt holds the default value on creation for the created_at field.
+	entitlementusagecounter.DefaultCreatedAt = entitlementusagecounterDescCreatedAt.Default.(func() time.Time)
+	// entitlementusagecounterDescUpdatedAt is the schema descriptor for updated_at field.
+	entitlementusagecounterDescUpdatedAt := entitlementusagecounterFields[9].Descriptor()
+	// entitlementusagecounter.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	entitlementusagecounter.DefaultUpdatedAt = entitlementusagecounterDescUpdatedAt.Default.(func() time.Time)
+	// entitlementusagecounter.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	entitlementusagecounter.UpdateDefaultUpdatedAt = entitlementusagecounterDescUpdatedAt.UpdateDefault.(func() time.Time)
 	entityintegrationmappingMixin := schema.EntityIntegrationMapping{}.Mixin()
 	entityintegrationmappingMixinFields0 := entityintegrationmappingMixin[0].Fields()
 	_ = entityintegrationmappingMixinFields0
@@ -837,6 +879,61 @@ func init() {
 	environmentDescType := environmentFields[2].Descriptor()
 	// environment.TypeValidator is a validator for the "type" field. It is called by the builders before save.
 	environment.TypeValidator = environmentDescType.Validators[0].(func(string) error)
+	eventcorrectionclaimFields := schema.EventCorrectionClaim{}.Fields()
+	_ = eventcorrectionclaimFields
+	// eventcorrectionclaimDescTenantID is the schema descriptor for tenant_id field.
+	eventcorrectionclaimDescTenantID := eventcorrectionclaimFields[1].Descriptor()
+	// eventcorrectionclaim.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	eventcorrectionclaim.TenantIDValidator = eventcorrectionclaimDescTenantID.Validators[0].(func(string) error)
+	// eventcorrectionclaimDescEventID is the schema descriptor for event_id field.
+	eventcorrectionclaimDescEventID := eventcorrectionclaimFields[3].Descriptor()
+	// eventcorrectionclaim.EventIDValidator is a validator for the "event_id" field. It is called by the builders before save.
+	eventcorrectionclaim.EventIDValidator = eventcorrectionclaimDescEventID.Validators[0].(func(string) error)
+	// eventcorrectionclaimDescAction is the schema descriptor for action field.
+	eventcorrectionclaimDescAction := eventcorrectionclaimFields[4].Descriptor()
+	// eventcorrectionclaim.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	eventcorrectionclaim.ActionValidator = eventcorrectionclaimDescAction.Validators[0].(func(string) error)
+	// eventcorrectionclaimDescCreatedAt is the schema descriptor for created_at field.
+	eventcorrectionclaimDescCreatedAt := eventcorrectionclaimFields[5].Descriptor()
+	// eventcorrectionclaim.DefaultCreatedAt holds the default value on creation for the created_at field.
+	eventcorrectionclaim.DefaultCreatedAt = eventcorrectionclaimDescCreatedAt.Default.(func() time.Time)
+	eventschemaMixin := schema.EventSchema{}.Mixin()
+	eventschemaMixinFields0 := eventschemaMixin[0].Fields()
+	_ = eventschemaMixinFields0
+	eventschemaMixinFields1 := eventschemaMixin[1].Fields()
+	_ = eventschemaMixinFields1
+	eventschemaFields := schema.EventSchema{}.Fields()
+	_ = eventschemaFields
+	// eventschemaDescTenantID is the schema descriptor for tenant_id field.
+	eventschemaDescTenantID := eventschemaMixinFields0[0].Descriptor()
+	// eventschema.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	eventschema.TenantIDValidator = eventschemaDescTenantID.Validators[0].(func(string) error)
+	// eventschemaDescStatus is the schema descriptor for status field.
+	eventschemaDescStatus := eventschemaMixinFields0[1].Descriptor()
+	// eventschema.DefaultStatus holds the default value on creation for the status field.
+	eventschema.DefaultStatus = eventschemaDescStatus.Default.(string)
+	// eventschemaDescCreatedAt is the schema descriptor for created_at field.
+	eventschemaDescCreatedAt := eventschemaMixinFields0[2].Descriptor()
+	// eventschema.DefaultCreatedAt holds the default value on creation for the created_at field.
+	eventschema.DefaultCreatedAt = eventschemaDescCreatedAt.Default.(func() time.Time)
+	// eventschemaDescUpdatedAt is the schema descriptor for updated_at field.
+	eventschemaDescUpdatedAt := eventschemaMixinFields0[3].Descriptor()
+	// eventschema.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	eventschema.DefaultUpdatedAt = eventschemaDescUpdatedAt.Default.(func() time.Time)
+	// eventschema.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	eventschema.UpdateDefaultUpdatedAt = eventschemaDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// eventschemaDescEnvironmentID is the schema descriptor for environment_id field.
+	eventschemaDescEnvironmentID := eventschemaMixinFields1[0].Descriptor()
+	// eventschema.DefaultEnvironmentID holds the default value on creation for the environment_id field.
+	eventschema.DefaultEnvironmentID = eventschemaDescEnvironmentID.Default.(string)
+	// eventschemaDescEventName is the schema descriptor for event_name field.
+	eventschemaDescEventName := eventschemaFields[1].Descriptor()
+	// eventschema.EventNameValidator is a validator for the "event_name" field. It is called by the builders before save.
+	eventschema.EventNameValidator = eventschemaDescEventName.Validators[0].(func(string) error)
+	// eventschemaDescValidationMode is the schema descriptor for validation_mode field.
+	eventschemaDescValidationMode := eventschemaFields[3].Descriptor()
+	// eventschema.DefaultValidationMode holds the default value on creation for the validation_mode field.
+	eventschema.DefaultValidationMode = eventschemaDescValidationMode.Default.(string)
 	featureMixin := schema.Feature{}.Mixin()
 	featureMixinFields0 := featureMixin[0].Fields()
 	_ = featureMixinFields0
@@ -1122,6 +1219,30 @@ func init() {
 	meterDescResetUsage := meterFields[5].Descriptor()
 	// meter.DefaultResetUsage holds the default value on creation for the reset_usage field.
 	meter.DefaultResetUsage = meterDescResetUsage.Default.(string)
+	metricseriesstateFields := schema.MetricSeriesState{}.Fields()
+	_ = metricseriesstateFields
+	// metricseriesstateDescTenantID is the schema descriptor for tenant_id field.
+	metricseriesstateDescTenantID := metricseriesstateFields[0].Descriptor()
+	// metricseriesstate.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	metricseriesstate.TenantIDValidator = metricseriesstateDescTenantID.Validators[0].(func(string) error)
+	// metricseriesstateDescSeriesID is the schema descriptor for series_id field.
+	metricseriesstateDescSeriesID := metricseriesstateFields[2].Descriptor()
+	// metricseriesstate.SeriesIDValidator is a validator for the "series_id" field. It is called by the builders before save.
+	metricseriesstate.SeriesIDValidator = metricseriesstateDescSeriesID.Validators[0].(func(string) error)
+	// metricseriesstateDescCount is the schema descriptor for count field.
+	metricseriesstateDescCount := metricseriesstateFields[4].Descriptor()
+	// metricseriesstate.DefaultCount holds the default value on creation for the count field.
+	metricseriesstate.DefaultCount = metricseriesstateDescCount.Default.(int64)
+	// metricseriesstateDescCreatedAt is the schema descriptor for created_at field.
+	metricseriesstateDescCreatedAt := metricseriesstateFields[11].Descriptor()
+	// metricseriesstate.DefaultCreatedAt holds the default value on creation for the created_at field.
+	metricseriesstate.DefaultCreatedAt = metricseriesstateDescCreatedAt.Default.(func() time.Time)
+	// metricseriesstateDescUpdatedAt is the schema descriptor for updated_at field.
+	metricseriesstateDescUpdatedAt := metricseriesstateFields[12].Descriptor()
+	// metricseriesstate.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	metricseriesstate.DefaultUpdatedAt = metricseriesstateDescUpdatedAt.Default.(func() time.Time)
+	// metricseriesstate.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	metricseriesstate.UpdateDefaultUpdatedAt = metricseriesstateDescUpdatedAt.UpdateDefault.(func() time.Time)
 	paymentMixin := schema.Payment{}.Mixin()
 	paymentMixinFields0 := paymentMixin[0].Fields()
 	_ = paymentMixinFields0
@@ -1222,6 +1343,51 @@ func init() {
 	paymentattempt.DefaultAttemptNumber = paymentattemptDescAttemptNumber.Default.(int)
 	// paymentattempt.AttemptNumberValidator is a validator for the "attempt_number" field. It is called by the builders before save.
 	paymentattempt.AttemptNumberValidator = paymentattemptDescAttemptNumber.Validators[0].(func(int) error)
+	paymentrefundMixin := schema.PaymentRefund{}.Mixin()
+	paymentrefundMixinFields0 := paymentrefundMixin[0].Fields()
+	_ = paymentrefundMixinFields0
+	paymentrefundMixinFields1 := paymentrefundMixin[1].Fields()
+	_ = paymentrefundMixinFields1
+	paymentrefundFields := schema.PaymentRefund{}.Fields()
+	_ = paymentrefundFields
+	// paymentrefundDescTenantID is the schema descriptor for tenant_id field.
+	paymentrefundDescTenantID := paymentrefundMixinFields0[0].Descriptor()
+	// paymentrefund.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	paymentrefund.TenantIDValidator = paymentrefundDescTenantID.Validators[0].(func(string) error)
+	// paymentrefundDescStatus is the schema descriptor for status field.
+	paymentrefundDescStatus := paymentrefundMixinFields0[1].Descriptor()
+	// paymentrefund.DefaultStatus holds the default value on creation for the status field.
+	paymentrefund.DefaultStatus = paymentrefundDescStatus.Default.(string)
+	// paymentrefundDescCreatedAt is the schema descriptor for created_at field.
+	paymentrefundDescCreatedAt := paymentrefundMixinFields0[2].Descriptor()
+	// paymentrefund.DefaultCreatedAt holds the default value on creation for the created_at field.
+	paymentrefund.DefaultCreatedAt = paymentrefundDescCreatedAt.Default.(func() time.Time)
+	// paymentrefundDescUpdatedAt is the schema descriptor for updated_at field.
+	paymentrefundDescUpdatedAt := paymentrefundMixinFields0[3].Descriptor()
+	// paymentrefund.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	paymentrefund.DefaultUpdatedAt = paymentrefundDescUpdatedAt.Default.(func() time.Time)
+	// paymentrefund.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	paymentrefund.UpdateDefaultUpdatedAt = paymentrefundDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// paymentrefundDescEnvironmentID is the schema descriptor for environment_id field.
+	paymentrefundDescEnvironmentID := paymentrefundMixinFields1[0].Descriptor()
+	// paymentrefund.DefaultEnvironmentID holds the default value on creation for the environment_id field.
+	paymentrefund.DefaultEnvironmentID = paymentrefundDescEnvironmentID.Default.(string)
+	// paymentrefundDescPaymentID is the schema descriptor for payment_id field.
+	paymentrefundDescPaymentID := paymentrefundFields[1].Descriptor()
+	// paymentrefund.PaymentIDValidator is a validator for the "payment_id" field. It is called by the builders before save.
+	paymentrefund.PaymentIDValidator = paymentrefundDescPaymentID.Validators[0].(func(string) error)
+	// paymentrefundDescAmount is the schema descriptor for amount field.
+	paymentrefundDescAmount := paymentrefundFields[3].Descriptor()
+	// paymentrefund.DefaultAmount holds the default value on creation for the amount field.
+	paymentrefund.DefaultAmount = paymentrefundDescAmount.Default.(decimal.Decimal)
+	// paymentrefundDescCurrency is the schema descriptor for currency field.
+	paymentrefundDescCurrency := paymentrefundFields[4].Descriptor()
+	// paymentrefund.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	paymentrefund.CurrencyValidator = paymentrefundDescCurrency.Validators[0].(func(string) error)
+	// paymentrefundDescRefundStatus is the schema descriptor for refund_status field.
+	paymentrefundDescRefundStatus := paymentrefundFields[5].Descriptor()
+	// paymentrefund.RefundStatusValidator is a validator for the "refund_status" field. It is called by the builders before save.
+	paymentrefund.RefundStatusValidator = paymentrefundDescRefundStatus.Validators[0].(func(string) error)
 	planMixin := schema.Plan{}.Mixin()
 	planMixinFields0 := planMixin[0].Fields()
 	_ = planMixinFields0
@@ -1327,11 +1493,11 @@ func init() {
 	// price.DefaultTrialPeriod holds the default value on creation for the trial_period field.
 	price.DefaultTrialPeriod = priceDescTrialPeriod.Default.(int)
 	// priceDescEntityType is the schema descriptor for entity_type field.
-	priceDescEntityType := priceFields[26].Descriptor()
+	priceDescEntityType := priceFields[29].Descriptor()
 	// price.DefaultEntityType holds the default value on creation for the entity_type field.
 	price.DefaultEntityType = priceDescEntityType.Default.(string)
 	// priceDescStartDate is the schema descriptor for start_date field.
-	priceDescStartDate := priceFields[29].Descriptor()
+	priceDescStartDate := priceFields[32].Descriptor()
 	// price.DefaultStartDate holds the default value on creation for the start_date field.
 	price.DefaultStartDate = priceDescStartDate.Default.(func() time.Time)
 	priceunitMixin := schema.PriceUnit{}.Mixin()
@@ -1403,6 +1569,47 @@ func init() {
 			return nil
 		}
 	}()
+	promotioncodeMixin := schema.PromotionCode{}.Mixin()
+	promotioncodeMixinFields0 := promotioncodeMixin[0].Fields()
+	_ = promotioncodeMixinFields0
+	promotioncodeMixinFields1 := promotioncodeMixin[1].Fields()
+	_ = promotioncodeMixinFields1
+	promotioncodeFields := schema.PromotionCode{}.Fields()
+	_ = promotioncodeFields
+	// promotioncodeDescTenantID is the schema descriptor for tenant_id field.
+	promotioncodeDescTenantID := promotioncodeMixinFields0[0].Descriptor()
+	// promotioncode.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	promotioncode.TenantIDValidator = promotioncodeDescTenantID.Validators[0].(func(string) error)
+	// promotioncodeDescStatus is the schema descriptor for status field.
+	promotioncodeDescStatus := promotioncodeMixinFields0[1].Descriptor()
+	// promotioncode.DefaultStatus holds the default value on creation for the status field.
+	promotioncode.DefaultStatus = promotioncodeDescStatus.Default.(string)
+	// promotioncodeDescCreatedAt is the schema descriptor for created_at field.
+	promotioncodeDescCreatedAt := promotioncodeMixinFields0[2].Descriptor()
+	// promotioncode.DefaultCreatedAt holds the default value on creation for the created_at field.
+	promotioncode.DefaultCreatedAt = promotioncodeDescCreatedAt.Default.(func() time.Time)
+	// promotioncodeDescUpdatedAt is the schema descriptor for updated_at field.
+	promotioncodeDescUpdatedAt := promotioncodeMixinFields0[3].Descriptor()
+	// promotioncode.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	promotioncode.DefaultUpdatedAt = promotioncodeDescUpdatedAt.Default.(func() time.Time)
+	// promotioncode.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	promotioncode.UpdateDefaultUpdatedAt = promotioncodeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// promotioncodeDescEnvironmentID is the schema descriptor for environment_id field.
+	promotioncodeDescEnvironmentID := promotioncodeMixinFields1[0].Descriptor()
+	// promotioncode.DefaultEnvironmentID holds the default value on creation for the environment_id field.
+	promotioncode.DefaultEnvironmentID = promotioncodeDescEnvironmentID.Default.(string)
+	// promotioncodeDescCouponID is the schema descriptor for coupon_id field.
+	promotioncodeDescCouponID := promotioncodeFields[1].Descriptor()
+	// promotioncode.CouponIDValidator is a validator for the "coupon_id" field. It is called by the builders before save.
+	promotioncode.CouponIDValidator = promotioncodeDescCouponID.Validators[0].(func(string) error)
+	// promotioncodeDescCode is the schema descriptor for code field.
+	promotioncodeDescCode := promotioncodeFields[2].Descriptor()
+	// promotioncode.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	promotioncode.CodeValidator = promotioncodeDescCode.Validators[0].(func(string) error)
+	// promotioncodeDescTotalRedemptions is the schema descriptor for total_redemptions field.
+	promotioncodeDescTotalRedemptions := promotioncodeFields[6].Descriptor()
+	// promotioncode.DefaultTotalRedemptions holds the default value on creation for the total_redemptions field.
+	promotioncode.DefaultTotalRedemptions = promotioncodeDescTotalRedemptions.Default.(int)
 	scheduledtaskMixin := schema.ScheduledTask{}.Mixin()
 	scheduledtaskMixinFields0 := scheduledtaskMixin[0].Fields()
 	_ = scheduledtaskMixinFields0
@@ -1625,12 +1832,16 @@ func init() {
 	subscriptionDescOverageFactor := subscriptionFields[25].Descriptor()
 	// subscription.DefaultOverageFactor holds the default value on creation for the overage_factor field.
 	subscription.DefaultOverageFactor = subscriptionDescOverageFactor.Default.(decimal.Decimal)
+	// subscriptionDescCommitmentType is the schema descriptor for commitment_type field.
+	subscriptionDescCommitmentType := subscriptionFields[26].Descriptor()
+	// subscription.DefaultCommitmentType holds the default value on creation for the commitment_type field.
+	subscription.DefaultCommitmentType = subscriptionDescCommitmentType.Default.(string)
 	// subscriptionDescCustomerTimezone is the schema descriptor for customer_timezone field.
-	subscriptionDescCustomerTimezone := subscriptionFields[29].Descriptor()
+	subscriptionDescCustomerTimezone := subscriptionFields[33].Descriptor()
 	// subscription.DefaultCustomerTimezone holds the default value on creation for the customer_timezone field.
 	subscription.DefaultCustomerTimezone = subscriptionDescCustomerTimezone.Default.(string)
 	// subscriptionDescProrationBehavior is the schema descriptor for proration_behavior field.
-	subscriptionDescProrationBehavior := subscriptionFields[30].Descriptor()
+	subscriptionDescProrationBehavior := subscriptionFields[34].Descriptor()
 	// subscription.DefaultProrationBehavior holds the default value on creation for the proration_behavior field.
 	subscription.DefaultProrationBehavior = subscriptionDescProrationBehavior.Default.(string)
 	// subscription.ProrationBehaviorValidator is a validator for the "proration_behavior" field. It is called by the builders before save.
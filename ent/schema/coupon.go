@@ -107,6 +107,8 @@ func (Coupon) Edges() []ent.Edge {
 			Comment("Coupon can be associated with multiple coupon associations"),
 		edge.To("coupon_applications", CouponApplication.Type).
 			Comment("Coupon can have multiple coupon applications"),
+		edge.To("promotion_codes", PromotionCode.Type).
+			Comment("Coupon can be redeemed with multiple promotion codes"),
 	}
 }
 
@@ -89,6 +89,14 @@ func (CreditNote) Fields() []ent.Field {
 			Optional().
 			Nillable(),
 
+		field.String("refund_method").
+			GoType(types.CreditNoteRefundMethod("")).
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Default(string(types.CreditNoteRefundMethodWallet)).
+			Immutable(),
+
 		field.String("reason").
 			GoType(types.CreditNoteReason("")).
 			SchemaType(map[string]string{
@@ -79,6 +79,13 @@ func (Customer) Fields() []ent.Field {
 				"postgres": "varchar(2)",
 			}).
 			Optional(),
+		field.String("parent_customer_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Optional().
+			Nillable().
+			Comment("Parent customer billing the usage of this customer"),
 	}
 }
 
@@ -95,6 +102,8 @@ func (Customer) Indexes() []ent.Index {
 			Annotations(entsql.IndexWhere("(external_id IS NOT NULL AND external_id != '') AND status = 'published'")).
 			StorageKey(Idx_tenant_environment_external_id_unique),
 		index.Fields("tenant_id", "environment_id"),
+		index.Fields("tenant_id", "environment_id", "parent_customer_id").
+			Annotations(entsql.IndexWhere("parent_customer_id IS NOT NULL AND status = 'published'")),
 		// Add email index for efficient email-based lookups
 		index.Fields("tenant_id", "environment_id", "email").
 			Annotations(entsql.IndexWhere("email IS NOT NULL AND email != '' AND status = 'published'")).
@@ -0,0 +1,91 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/shopspring/decimal"
+)
+
+// EntitlementUsageCounter holds the schema definition for the EntitlementUsageCounter entity.
+// It keeps the usage reserved by entitlement checks of a customer feature in a usage reset period,
+// which is not yet reflected in the aggregated feature usage, and whether the limit reached webhook
+// was published for the period.
+type EntitlementUsageCounter struct {
+	ent.Schema
+}
+
+// Fields of the EntitlementUsageCounter.
+func (EntitlementUsageCounter) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("tenant_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			NotEmpty(),
+		field.String("environment_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Optional(),
+		field.String("customer_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			NotEmpty(),
+		field.String("feature_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			NotEmpty(),
+		field.Time("period_start").
+			SchemaType(map[string]string{
+				"postgres": "timestamp",
+			}),
+		field.Other("baseline", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(25,15)",
+			}).
+			Default(decimal.Zero).
+			Comment("Aggregated usage at the time of the last reservation"),
+		field.Other("reserved", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(25,15)",
+			}).
+			Default(decimal.Zero).
+			Comment("Usage reserved since the baseline was aggregated"),
+		field.Time("limit_reached_at").
+			SchemaType(map[string]string{
+				"postgres": "timestamp",
+			}).
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			SchemaType(map[string]string{
+				"postgres": "timestamp",
+			}).
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			SchemaType(map[string]string{
+				"postgres": "timestamp",
+			}).
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the EntitlementUsageCounter.
+func (EntitlementUsageCounter) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the EntitlementUsageCounter.
+func (EntitlementUsageCounter) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "environment_id", "customer_id", "feature_id", "period_start").
+			Unique(),
+	}
+}
@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// EventCorrectionClaim holds the schema definition for the EventCorrectionClaim entity.
+// Event corrections are stored in ClickHouse, which cannot enforce that an event is corrected at most
+// once, so a correction first claims its event here.
+type EventCorrectionClaim struct {
+	ent.Schema
+}
+
+// Fields of the EventCorrectionClaim.
+func (EventCorrectionClaim) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Unique().
+			Immutable().
+			Comment("ID of the event correction"),
+		field.String("tenant_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.String("environment_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Optional().
+			Immutable(),
+		field.String("event_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(255)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.String("action").
+			SchemaType(map[string]string{
+				"postgres": "varchar(20)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.Time("created_at").
+			SchemaType(map[string]string{
+				"postgres": "timestamp",
+			}).
+			Default(time.Now).
+			Immutable(),
+		field.String("created_by").
+			Optional().
+			Immutable(),
+	}
+}
+
+// Edges of the EventCorrectionClaim.
+func (EventCorrectionClaim) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the EventCorrectionClaim.
+func (EventCorrectionClaim) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "environment_id", "event_id").
+			Unique(),
+	}
+}
@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/flexprice/flexprice/ent/schema/mixin"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+var Idx_event_schema_tenant_environment_event_name = "idx_event_schema_tenant_environment_event_name"
+
+// EventSchema holds the schema definition for the EventSchema entity.
+type EventSchema struct {
+	ent.Schema
+}
+
+// Mixin of the EventSchema.
+func (EventSchema) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+		baseMixin.EnvironmentMixin{},
+	}
+}
+
+// Fields of the EventSchema.
+func (EventSchema) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Unique().
+			Immutable(),
+		field.String("event_name").
+			SchemaType(map[string]string{
+				"postgres": "varchar(255)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.String("description").
+			Optional(),
+		field.String("validation_mode").
+			SchemaType(map[string]string{
+				"postgres": "varchar(20)",
+			}).
+			Default(string(types.EventSchemaValidationModeReject)),
+		field.JSON("properties", []types.EventPropertySchema{}).
+			SchemaType(map[string]string{
+				"postgres": "jsonb",
+			}).
+			Optional().
+			Comment("Properties of the event with their types, requiredness and allowed values"),
+	}
+}
+
+// Edges of the EventSchema.
+func (EventSchema) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the EventSchema.
+func (EventSchema) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "environment_id", "event_name").
+			Unique().
+			StorageKey(Idx_event_schema_tenant_environment_event_name).
+			Annotations(entsql.IndexWhere("status = 'published'")),
+	}
+}
@@ -65,8 +65,11 @@ func (Meter) Indexes() []ent.Index {
 
 // Additional types needed for JSON fields
 type MeterFilter struct {
-	Key    string   `json:"key"`
-	Values []string `json:"values"`
+	Key      string                    `json:"key"`
+	Operator types.MeterFilterOperator `json:"operator,omitempty"`
+	Values   []string                  `json:"values"`
+	Min      *decimal.Decimal          `json:"min,omitempty"`
+	Max      *decimal.Decimal          `json:"max,omitempty"`
 }
 
 // MeterAggregation defines the aggregation configuration for a meter
@@ -75,4 +78,6 @@ type MeterAggregation struct {
 	Field      string                `json:"field,omitempty"`
 	Multiplier *decimal.Decimal      `json:"multiplier,omitempty"`
 	BucketSize types.WindowSize      `json:"bucket_size,omitempty"`
+	Percentile *decimal.Decimal      `json:"percentile,omitempty"`
+	Expression string                `json:"expression,omitempty"`
 }
@@ -0,0 +1,107 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// MetricSeriesState holds the schema definition for the MetricSeriesState entity.
+// It keeps the last cumulative point of every ingested metric series so that the next point is
+// converted to the increase since it. The previous_* fields hold the point replaced by the last update,
+// they are returned by the upsert that advances the series.
+type MetricSeriesState struct {
+	ent.Schema
+}
+
+// Fields of the MetricSeriesState.
+func (MetricSeriesState) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("tenant_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			NotEmpty(),
+		field.String("environment_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Optional(),
+		field.String("series_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(64)",
+			}).
+			NotEmpty().
+			Comment("Hash of the metric name and the attributes of the series"),
+		field.Float("value").
+			SchemaType(map[string]string{
+				"postgres": "double precision",
+			}),
+		field.Int64("count").
+			SchemaType(map[string]string{
+				"postgres": "bigint",
+			}).
+			Default(0),
+		field.Time("start_time").
+			SchemaType(map[string]string{
+				"postgres": "timestamp",
+			}).
+			Optional().
+			Nillable(),
+		field.Time("value_at").
+			SchemaType(map[string]string{
+				"postgres": "timestamp",
+			}),
+		field.Float("previous_value").
+			SchemaType(map[string]string{
+				"postgres": "double precision",
+			}).
+			Optional().
+			Nillable(),
+		field.Int64("previous_count").
+			SchemaType(map[string]string{
+				"postgres": "bigint",
+			}).
+			Optional().
+			Nillable(),
+		field.Time("previous_start_time").
+			SchemaType(map[string]string{
+				"postgres": "timestamp",
+			}).
+			Optional().
+			Nillable(),
+		field.Time("previous_value_at").
+			SchemaType(map[string]string{
+				"postgres": "timestamp",
+			}).
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			SchemaType(map[string]string{
+				"postgres": "timestamp",
+			}).
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			SchemaType(map[string]string{
+				"postgres": "timestamp",
+			}).
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the MetricSeriesState.
+func (MetricSeriesState) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the MetricSeriesState.
+func (MetricSeriesState) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "environment_id", "series_id").
+			Unique(),
+	}
+}
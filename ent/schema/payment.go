@@ -129,6 +129,7 @@ func (Payment) Fields() []ent.Field {
 func (Payment) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("attempts", PaymentAttempt.Type),
+		edge.To("refunds", PaymentRefund.Type),
 	}
 }
 
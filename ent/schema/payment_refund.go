@@ -0,0 +1,126 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/flexprice/flexprice/ent/schema/mixin"
+	"github.com/shopspring/decimal"
+)
+
+// PaymentRefund holds the schema definition for the PaymentRefund entity.
+type PaymentRefund struct {
+	ent.Schema
+}
+
+// Mixin of the PaymentRefund.
+func (PaymentRefund) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+		baseMixin.EnvironmentMixin{},
+	}
+}
+
+// Fields of the PaymentRefund.
+func (PaymentRefund) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Unique().
+			Immutable(),
+		field.String("payment_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.String("credit_note_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Optional().
+			Nillable().
+			Immutable(),
+		field.Other("amount", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(20,8)",
+			}).
+			Default(decimal.Zero).
+			Immutable(),
+		field.String("currency").
+			SchemaType(map[string]string{
+				"postgres": "varchar(10)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.String("refund_status").
+			SchemaType(map[string]string{
+				"postgres": "varchar(20)",
+			}).
+			NotEmpty(),
+		field.String("payment_gateway").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Optional().
+			Nillable(),
+		field.String("gateway_refund_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(255)",
+			}).
+			Optional().
+			Nillable(),
+		field.String("reason").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Optional(),
+		field.String("failure_reason").
+			SchemaType(map[string]string{
+				"postgres": "text",
+			}).
+			Optional().
+			Nillable(),
+		field.Time("succeeded_at").
+			Optional().
+			Nillable(),
+		field.Time("failed_at").
+			Optional().
+			Nillable(),
+		field.JSON("metadata", map[string]string{}).
+			Optional().
+			SchemaType(map[string]string{
+				"postgres": "jsonb",
+			}),
+	}
+}
+
+// Edges of the PaymentRefund.
+func (PaymentRefund) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("payment", Payment.Type).
+			Ref("refunds").
+			Field("payment_id").
+			Immutable().
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the PaymentRefund.
+func (PaymentRefund) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "environment_id", "payment_id", "refund_status").
+			StorageKey("idx_payment_refund_status"),
+		index.Fields("tenant_id", "environment_id", "credit_note_id").
+			StorageKey("idx_payment_refund_credit_note").
+			Annotations(entsql.IndexWhere("credit_note_id IS NOT NULL")),
+		index.Fields("gateway_refund_id").
+			StorageKey("idx_gateway_refund").
+			Annotations(entsql.IndexWhere("gateway_refund_id IS NOT NULL")),
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"entgo.io/ent/schema/index"
 	baseMixin "github.com/flexprice/flexprice/ent/schema/mixin"
 	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
 )
 
 // Price holds the schema definition for the Price entity.
@@ -157,6 +158,25 @@ func (Price) Fields() []ent.Field {
 		field.JSON("transform_quantity", types.TransformQuantity{}).
 			Optional(),
 
+		field.JSON("matrix", &types.PriceMatrix{}).
+			Optional(),
+
+		// min_charge is the minimum amount charged for the price in a billing period
+		field.Other("min_charge", decimal.Decimal{}).
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{
+				"postgres": "numeric(25,15)",
+			}),
+
+		// max_charge caps the amount charged for the price in a billing period
+		field.Other("max_charge", decimal.Decimal{}).
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{
+				"postgres": "numeric(25,15)",
+			}),
+
 		field.String("lookup_key").
 			SchemaType(map[string]string{
 				"postgres": "varchar(255)",
@@ -0,0 +1,97 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/flexprice/flexprice/ent/schema/mixin"
+)
+
+const (
+	Idx_tenant_environment_promotion_code_unique = "idx_tenant_environment_promotion_code_unique"
+)
+
+// PromotionCode holds the schema definition for the PromotionCode entity.
+type PromotionCode struct {
+	ent.Schema
+}
+
+// Mixin of the PromotionCode.
+func (PromotionCode) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+		baseMixin.EnvironmentMixin{},
+	}
+}
+
+// Fields of the PromotionCode.
+func (PromotionCode) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Unique().
+			Immutable(),
+		field.String("coupon_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			NotEmpty().
+			Immutable().
+			Comment("Coupon redeemed with the promotion code"),
+		field.String("code").
+			SchemaType(map[string]string{
+				"postgres": "varchar(100)",
+			}).
+			NotEmpty().
+			Immutable().
+			Comment("Customer facing code, stored in upper case"),
+		field.String("customer_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Optional().
+			Nillable().
+			Comment("Customer the promotion code is restricted to"),
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			Comment("Promotion code expiry date"),
+		field.Int("max_redemptions").
+			Optional().
+			Nillable().
+			Comment("Promotion code max redemptions"),
+		field.Int("total_redemptions").
+			Default(0).
+			Comment("Promotion code total redemptions"),
+		field.JSON("metadata", map[string]string{}).
+			Optional().
+			Comment("Additional metadata for promotion code"),
+	}
+}
+
+// Edges of the PromotionCode.
+func (PromotionCode) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("coupon", Coupon.Type).
+			Ref("promotion_codes").
+			Field("coupon_id").
+			Immutable().
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the PromotionCode.
+func (PromotionCode) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "environment_id", "code").
+			Unique().
+			StorageKey(Idx_tenant_environment_promotion_code_unique).
+			Annotations(entsql.IndexWhere("status = 'published'")),
+		index.Fields("tenant_id", "environment_id", "coupon_id"),
+	}
+}
@@ -131,6 +131,23 @@ func (Subscription) Fields() []ent.Field {
 			SchemaType(map[string]string{
 				"postgres": "decimal(10,6)",
 			}),
+		field.String("commitment_type").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Default(string(types.CommitmentTypeBillingPeriod)),
+		field.String("commitment_duration").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Optional().
+			Nillable(),
+		field.Time("commitment_start_date").
+			Optional().
+			Nillable(),
+		field.Time("commitment_end_date").
+			Optional().
+			Nillable(),
 		// Payment behavior and collection method fields
 		field.Enum("payment_behavior").
 			Values("allow_incomplete", "default_incomplete", "error_if_incomplete", "default_active").
@@ -152,6 +169,12 @@ func (Subscription) Fields() []ent.Field {
 			NotEmpty().
 			Immutable().
 			Default(string(types.ProrationBehaviorNone)),
+		field.JSON("usage_alerts", []types.UsageAlert{}).
+			Optional().
+			SchemaType(map[string]string{
+				"postgres": "jsonb",
+			}).
+			Comment("Spend and usage thresholds evaluated against the current billing period"),
 	}
 }
 
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/flexprice/flexprice/ent/subscription"
 	"github.com/flexprice/flexprice/ent/subscriptionschedule"
+	"github.com/flexprice/flexprice/internal/types"
 	"github.com/shopspring/decimal"
 )
 
@@ -84,6 +85,14 @@ type Subscription struct {
 	CommitmentAmount *decimal.Decimal `json:"commitment_amount,omitempty"`
 	// OverageFactor holds the value of the "overage_factor" field.
 	OverageFactor *decimal.Decimal `json:"overage_factor,omitempty"`
+	// CommitmentType holds the value of the "commitment_type" field.
+	CommitmentType string `json:"commitment_type,omitempty"`
+	// CommitmentDuration holds the value of the "commitment_duration" field.
+	CommitmentDuration *string `json:"commitment_duration,omitempty"`
+	// CommitmentStartDate holds the value of the "commitment_start_date" field.
+	CommitmentStartDate *time.Time `json:"commitment_start_date,omitempty"`
+	// CommitmentEndDate holds the value of the "commitment_end_date" field.
+	CommitmentEndDate *time.Time `json:"commitment_end_date,omitempty"`
 	// Determines how subscription payments are handled
 	PaymentBehavior subscription.PaymentBehavior `json:"payment_behavior,omitempty"`
 	// Determines how invoices are collected
@@ -94,6 +103,8 @@ type Subscription struct {
 	CustomerTimezone string `json:"customer_timezone,omitempty"`
 	// ProrationBehavior holds the value of the "proration_behavior" field.
 	ProrationBehavior string `json:"proration_behavior,omitempty"`
+	// Spend and usage thresholds evaluated against the current billing period
+	UsageAlerts []types.UsageAlert `json:"usage_alerts,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the SubscriptionQuery when eager-loading is set.
 	Edges        SubscriptionEdges `json:"edges"`
@@ -182,15 +193,15 @@ func (*Subscription) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case subscription.FieldCommitmentAmount, subscription.FieldOverageFactor:
 			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
-		case subscription.FieldMetadata:
+		case subscription.FieldMetadata, subscription.FieldUsageAlerts:
 			values[i] = new([]byte)
 		case subscription.FieldCancelAtPeriodEnd:
 			values[i] = new(sql.NullBool)
 		case subscription.FieldBillingPeriodCount, subscription.FieldVersion:
 			values[i] = new(sql.NullInt64)
-		case subscription.FieldID, subscription.FieldTenantID, subscription.FieldStatus, subscription.FieldCreatedBy, subscription.FieldUpdatedBy, subscription.FieldEnvironmentID, subscription.FieldLookupKey, subscription.FieldCustomerID, subscription.FieldPlanID, subscription.FieldSubscriptionStatus, subscription.FieldCurrency, subscription.FieldBillingCadence, subscription.FieldBillingPeriod, subscription.FieldPauseStatus, subscription.FieldActivePauseID, subscription.FieldBillingCycle, subscription.FieldPaymentBehavior, subscription.FieldCollectionMethod, subscription.FieldGatewayPaymentMethodID, subscription.FieldCustomerTimezone, subscription.FieldProrationBehavior:
+		case subscription.FieldID, subscription.FieldTenantID, subscription.FieldStatus, subscription.FieldCreatedBy, subscription.FieldUpdatedBy, subscription.FieldEnvironmentID, subscription.FieldLookupKey, subscription.FieldCustomerID, subscription.FieldPlanID, subscription.FieldSubscriptionStatus, subscription.FieldCurrency, subscription.FieldBillingCadence, subscription.FieldBillingPeriod, subscription.FieldPauseStatus, subscription.FieldActivePauseID, subscription.FieldBillingCycle, subscription.FieldCommitmentType, subscription.FieldCommitmentDuration, subscription.FieldPaymentBehavior, subscription.FieldCollectionMethod, subscription.FieldGatewayPaymentMethodID, subscription.FieldCustomerTimezone, subscription.FieldProrationBehavior:
 			values[i] = new(sql.NullString)
-		case subscription.FieldCreatedAt, subscription.FieldUpdatedAt, subscription.FieldBillingAnchor, subscription.FieldStartDate, subscription.FieldEndDate, subscription.FieldCurrentPeriodStart, subscription.FieldCurrentPeriodEnd, subscription.FieldCancelledAt, subscription.FieldCancelAt, subscription.FieldTrialStart, subscription.FieldTrialEnd:
+		case subscription.FieldCreatedAt, subscription.FieldUpdatedAt, subscription.FieldBillingAnchor, subscription.FieldStartDate, subscription.FieldEndDate, subscription.FieldCurrentPeriodStart, subscription.FieldCurrentPeriodEnd, subscription.FieldCancelledAt, subscription.FieldCancelAt, subscription.FieldTrialStart, subscription.FieldTrialEnd, subscription.FieldCommitmentStartDate, subscription.FieldCommitmentEndDate:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -415,6 +426,33 @@ func (s *Subscription) assignValues(columns []string, values []any) error {
 				s.OverageFactor = new(decimal.Decimal)
 				*s.OverageFactor = *value.S.(*decimal.Decimal)
 			}
+		case subscription.FieldCommitmentType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field commitment_type", values[i])
+			} else if value.Valid {
+				s.CommitmentType = value.String
+			}
+		case subscription.FieldCommitmentDuration:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field commitment_duration", values[i])
+			} else if value.Valid {
+				s.CommitmentDuration = new(string)
+				*s.CommitmentDuration = value.String
+			}
+		case subscription.FieldCommitmentStartDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field commitment_start_date", values[i])
+			} else if value.Valid {
+				s.CommitmentStartDate = new(time.Time)
+				*s.CommitmentStartDate = value.Time
+			}
+		case subscription.FieldCommitmentEndDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field commitment_end_date", values[i])
+			} else if value.Valid {
+				s.CommitmentEndDate = new(time.Time)
+				*s.CommitmentEndDate = value.Time
+			}
 		case subscription.FieldPaymentBehavior:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field payment_behavior", values[i])
@@ -445,6 +483,14 @@ func (s *Subscription) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				s.ProrationBehavior = value.String
 			}
+		case subscription.FieldUsageAlerts:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field usage_alerts", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &s.UsageAlerts); err != nil {
+					return fmt.Errorf("unmarshal field usage_alerts: %w", err)
+				}
+			}
 		default:
 			s.selectValues.Set(columns[i], values[i])
 		}
@@ -623,6 +669,24 @@ func (s *Subscription) String() string {
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
+	builder.WriteString("commitment_type=")
+	builder.WriteString(s.CommitmentType)
+	builder.WriteString(", ")
+	if v := s.CommitmentDuration; v != nil {
+		builder.WriteString("commitment_duration=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := s.CommitmentStartDate; v != nil {
+		builder.WriteString("commitment_start_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := s.CommitmentEndDate; v != nil {
+		builder.WriteString("commitment_end_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("payment_behavior=")
 	builder.WriteString(fmt.Sprintf("%v", s.PaymentBehavior))
 	builder.WriteString(", ")
@@ -637,6 +701,9 @@ func (s *Subscription) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("proration_behavior=")
 	builder.WriteString(s.ProrationBehavior)
+	builder.WriteString(", ")
+	builder.WriteString("usage_alerts=")
+	builder.WriteString(fmt.Sprintf("%v", s.UsageAlerts))
 	builder.WriteByte(')')
 	return builder.String()
 }
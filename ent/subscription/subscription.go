@@ -80,6 +80,14 @@ const (
 	FieldCommitmentAmount = "commitment_amount"
 	// FieldOverageFactor holds the string denoting the overage_factor field in the database.
 	FieldOverageFactor = "overage_factor"
+	// FieldCommitmentType holds the string denoting the commitment_type field in the database.
+	FieldCommitmentType = "commitment_type"
+	// FieldCommitmentDuration holds the string denoting the commitment_duration field in the database.
+	FieldCommitmentDuration = "commitment_duration"
+	// FieldCommitmentStartDate holds the string denoting the commitment_start_date field in the database.
+	FieldCommitmentStartDate = "commitment_start_date"
+	// FieldCommitmentEndDate holds the string denoting the commitment_end_date field in the database.
+	FieldCommitmentEndDate = "commitment_end_date"
 	// FieldPaymentBehavior holds the string denoting the payment_behavior field in the database.
 	FieldPaymentBehavior = "payment_behavior"
 	// FieldCollectionMethod holds the string denoting the collection_method field in the database.
@@ -90,6 +98,8 @@ const (
 	FieldCustomerTimezone = "customer_timezone"
 	// FieldProrationBehavior holds the string denoting the proration_behavior field in the database.
 	FieldProrationBehavior = "proration_behavior"
+	// FieldUsageAlerts holds the string denoting the usage_alerts field in the database.
+	FieldUsageAlerts = "usage_alerts"
 	// EdgeLineItems holds the string denoting the line_items edge name in mutations.
 	EdgeLineItems = "line_items"
 	// EdgePauses holds the string denoting the pauses edge name in mutations.
@@ -183,11 +193,16 @@ var Columns = []string{
 	FieldBillingCycle,
 	FieldCommitmentAmount,
 	FieldOverageFactor,
+	FieldCommitmentType,
+	FieldCommitmentDuration,
+	FieldCommitmentStartDate,
+	FieldCommitmentEndDate,
 	FieldPaymentBehavior,
 	FieldCollectionMethod,
 	FieldGatewayPaymentMethodID,
 	FieldCustomerTimezone,
 	FieldProrationBehavior,
+	FieldUsageAlerts,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -247,6 +262,8 @@ var (
 	BillingCycleValidator func(string) error
 	// DefaultOverageFactor holds the default value on creation for the "overage_factor" field.
 	DefaultOverageFactor decimal.Decimal
+	// DefaultCommitmentType holds the default value on creation for the "commitment_type" field.
+	DefaultCommitmentType string
 	// DefaultCustomerTimezone holds the default value on creation for the "customer_timezone" field.
 	DefaultCustomerTimezone string
 	// DefaultProrationBehavior holds the default value on creation for the "proration_behavior" field.
@@ -472,6 +489,26 @@ func ByOverageFactor(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldOverageFactor, opts...).ToFunc()
 }
 
+// ByCommitmentType orders the results by the commitment_type field.
+func ByCommitmentType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCommitmentType, opts...).ToFunc()
+}
+
+// ByCommitmentDuration orders the results by the commitment_duration field.
+func ByCommitmentDuration(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCommitmentDuration, opts...).ToFunc()
+}
+
+// ByCommitmentStartDate orders the results by the commitment_start_date field.
+func ByCommitmentStartDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCommitmentStartDate, opts...).ToFunc()
+}
+
+// ByCommitmentEndDate orders the results by the commitment_end_date field.
+func ByCommitmentEndDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCommitmentEndDate, opts...).ToFunc()
+}
+
 // ByPaymentBehavior orders the results by the payment_behavior field.
 func ByPaymentBehavior(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPaymentBehavior, opts...).ToFunc()
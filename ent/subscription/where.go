@@ -221,6 +221,26 @@ func OverageFactor(v decimal.Decimal) predicate.Subscription {
 	return predicate.Subscription(sql.FieldEQ(FieldOverageFactor, v))
 }
 
+// CommitmentType applies equality check predicate on the "commitment_type" field. It's identical to CommitmentTypeEQ.
+func CommitmentType(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCommitmentType, v))
+}
+
+// CommitmentDuration applies equality check predicate on the "commitment_duration" field. It's identical to CommitmentDurationEQ.
+func CommitmentDuration(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCommitmentDuration, v))
+}
+
+// CommitmentStartDate applies equality check predicate on the "commitment_start_date" field. It's identical to CommitmentStartDateEQ.
+func CommitmentStartDate(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCommitmentStartDate, v))
+}
+
+// CommitmentEndDate applies equality check predicate on the "commitment_end_date" field. It's identical to CommitmentEndDateEQ.
+func CommitmentEndDate(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCommitmentEndDate, v))
+}
+
 // GatewayPaymentMethodID applies equality check predicate on the "gateway_payment_method_id" field. It's identical to GatewayPaymentMethodIDEQ.
 func GatewayPaymentMethodID(v string) predicate.Subscription {
 	return predicate.Subscription(sql.FieldEQ(FieldGatewayPaymentMethodID, v))
@@ -1951,6 +1971,246 @@ func OverageFactorNotNil() predicate.Subscription {
 	return predicate.Subscription(sql.FieldNotNull(FieldOverageFactor))
 }
 
+// CommitmentTypeEQ applies the EQ predicate on the "commitment_type" field.
+func CommitmentTypeEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCommitmentType, v))
+}
+
+// CommitmentTypeNEQ applies the NEQ predicate on the "commitment_type" field.
+func CommitmentTypeNEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldCommitmentType, v))
+}
+
+// CommitmentTypeIn applies the In predicate on the "commitment_type" field.
+func CommitmentTypeIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldCommitmentType, vs...))
+}
+
+// CommitmentTypeNotIn applies the NotIn predicate on the "commitment_type" field.
+func CommitmentTypeNotIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldCommitmentType, vs...))
+}
+
+// CommitmentTypeGT applies the GT predicate on the "commitment_type" field.
+func CommitmentTypeGT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldCommitmentType, v))
+}
+
+// CommitmentTypeGTE applies the GTE predicate on the "commitment_type" field.
+func CommitmentTypeGTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldCommitmentType, v))
+}
+
+// CommitmentTypeLT applies the LT predicate on the "commitment_type" field.
+func CommitmentTypeLT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldCommitmentType, v))
+}
+
+// CommitmentTypeLTE applies the LTE predicate on the "commitment_type" field.
+func CommitmentTypeLTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldCommitmentType, v))
+}
+
+// CommitmentTypeContains applies the Contains predicate on the "commitment_type" field.
+func CommitmentTypeContains(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContains(FieldCommitmentType, v))
+}
+
+// CommitmentTypeHasPrefix applies the HasPrefix predicate on the "commitment_type" field.
+func CommitmentTypeHasPrefix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasPrefix(FieldCommitmentType, v))
+}
+
+// CommitmentTypeHasSuffix applies the HasSuffix predicate on the "commitment_type" field.
+func CommitmentTypeHasSuffix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasSuffix(FieldCommitmentType, v))
+}
+
+// CommitmentTypeEqualFold applies the EqualFold predicate on the "commitment_type" field.
+func CommitmentTypeEqualFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEqualFold(FieldCommitmentType, v))
+}
+
+// CommitmentTypeContainsFold applies the ContainsFold predicate on the "commitment_type" field.
+func CommitmentTypeContainsFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContainsFold(FieldCommitmentType, v))
+}
+
+// CommitmentDurationEQ applies the EQ predicate on the "commitment_duration" field.
+func CommitmentDurationEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCommitmentDuration, v))
+}
+
+// CommitmentDurationNEQ applies the NEQ predicate on the "commitment_duration" field.
+func CommitmentDurationNEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldCommitmentDuration, v))
+}
+
+// CommitmentDurationIn applies the In predicate on the "commitment_duration" field.
+func CommitmentDurationIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldCommitmentDuration, vs...))
+}
+
+// CommitmentDurationNotIn applies the NotIn predicate on the "commitment_duration" field.
+func CommitmentDurationNotIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldCommitmentDuration, vs...))
+}
+
+// CommitmentDurationGT applies the GT predicate on the "commitment_duration" field.
+func CommitmentDurationGT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldCommitmentDuration, v))
+}
+
+// CommitmentDurationGTE applies the GTE predicate on the "commitment_duration" field.
+func CommitmentDurationGTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldCommitmentDuration, v))
+}
+
+// CommitmentDurationLT applies the LT predicate on the "commitment_duration" field.
+func CommitmentDurationLT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldCommitmentDuration, v))
+}
+
+// CommitmentDurationLTE applies the LTE predicate on the "commitment_duration" field.
+func CommitmentDurationLTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldCommitmentDuration, v))
+}
+
+// CommitmentDurationContains applies the Contains predicate on the "commitment_duration" field.
+func CommitmentDurationContains(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContains(FieldCommitmentDuration, v))
+}
+
+// CommitmentDurationHasPrefix applies the HasPrefix predicate on the "commitment_duration" field.
+func CommitmentDurationHasPrefix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasPrefix(FieldCommitmentDuration, v))
+}
+
+// CommitmentDurationHasSuffix applies the HasSuffix predicate on the "commitment_duration" field.
+func CommitmentDurationHasSuffix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasSuffix(FieldCommitmentDuration, v))
+}
+
+// CommitmentDurationIsNil applies the IsNil predicate on the "commitment_duration" field.
+func CommitmentDurationIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldCommitmentDuration))
+}
+
+// CommitmentDurationNotNil applies the NotNil predicate on the "commitment_duration" field.
+func CommitmentDurationNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldCommitmentDuration))
+}
+
+// CommitmentDurationEqualFold applies the EqualFold predicate on the "commitment_duration" field.
+func CommitmentDurationEqualFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEqualFold(FieldCommitmentDuration, v))
+}
+
+// CommitmentDurationContainsFold applies the ContainsFold predicate on the "commitment_duration" field.
+func CommitmentDurationContainsFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContainsFold(FieldCommitmentDuration, v))
+}
+
+// CommitmentStartDateEQ applies the EQ predicate on the "commitment_start_date" field.
+func CommitmentStartDateEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCommitmentStartDate, v))
+}
+
+// CommitmentStartDateNEQ applies the NEQ predicate on the "commitment_start_date" field.
+func CommitmentStartDateNEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldCommitmentStartDate, v))
+}
+
+// CommitmentStartDateIn applies the In predicate on the "commitment_start_date" field.
+func CommitmentStartDateIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldCommitmentStartDate, vs...))
+}
+
+// CommitmentStartDateNotIn applies the NotIn predicate on the "commitment_start_date" field.
+func CommitmentStartDateNotIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldCommitmentStartDate, vs...))
+}
+
+// CommitmentStartDateGT applies the GT predicate on the "commitment_start_date" field.
+func CommitmentStartDateGT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldCommitmentStartDate, v))
+}
+
+// CommitmentStartDateGTE applies the GTE predicate on the "commitment_start_date" field.
+func CommitmentStartDateGTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldCommitmentStartDate, v))
+}
+
+// CommitmentStartDateLT applies the LT predicate on the "commitment_start_date" field.
+func CommitmentStartDateLT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldCommitmentStartDate, v))
+}
+
+// CommitmentStartDateLTE applies the LTE predicate on the "commitment_start_date" field.
+func CommitmentStartDateLTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldCommitmentStartDate, v))
+}
+
+// CommitmentStartDateIsNil applies the IsNil predicate on the "commitment_start_date" field.
+func CommitmentStartDateIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldCommitmentStartDate))
+}
+
+// CommitmentStartDateNotNil applies the NotNil predicate on the "commitment_start_date" field.
+func CommitmentStartDateNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldCommitmentStartDate))
+}
+
+// CommitmentEndDateEQ applies the EQ predicate on the "commitment_end_date" field.
+func CommitmentEndDateEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCommitmentEndDate, v))
+}
+
+// CommitmentEndDateNEQ applies the NEQ predicate on the "commitment_end_date" field.
+func CommitmentEndDateNEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldCommitmentEndDate, v))
+}
+
+// CommitmentEndDateIn applies the In predicate on the "commitment_end_date" field.
+func CommitmentEndDateIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldCommitmentEndDate, vs...))
+}
+
+// CommitmentEndDateNotIn applies the NotIn predicate on the "commitment_end_date" field.
+func CommitmentEndDateNotIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldCommitmentEndDate, vs...))
+}
+
+// CommitmentEndDateGT applies the GT predicate on the "commitment_end_date" field.
+func CommitmentEndDateGT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldCommitmentEndDate, v))
+}
+
+// CommitmentEndDateGTE applies the GTE predicate on the "commitment_end_date" field.
+func CommitmentEndDateGTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldCommitmentEndDate, v))
+}
+
+// CommitmentEndDateLT applies the LT predicate on the "commitment_end_date" field.
+func CommitmentEndDateLT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldCommitmentEndDate, v))
+}
+
+// CommitmentEndDateLTE applies the LTE predicate on the "commitment_end_date" field.
+func CommitmentEndDateLTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldCommitmentEndDate, v))
+}
+
+// CommitmentEndDateIsNil applies the IsNil predicate on the "commitment_end_date" field.
+func CommitmentEndDateIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldCommitmentEndDate))
+}
+
+// CommitmentEndDateNotNil applies the NotNil predicate on the "commitment_end_date" field.
+func CommitmentEndDateNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldCommitmentEndDate))
+}
+
 // PaymentBehaviorEQ applies the EQ predicate on the "payment_behavior" field.
 func PaymentBehaviorEQ(v PaymentBehavior) predicate.Subscription {
 	return predicate.Subscription(sql.FieldEQ(FieldPaymentBehavior, v))
@@ -2196,6 +2456,16 @@ func ProrationBehaviorContainsFold(v string) predicate.Subscription {
 	return predicate.Subscription(sql.FieldContainsFold(FieldProrationBehavior, v))
 }
 
+// UsageAlertsIsNil applies the IsNil predicate on the "usage_alerts" field.
+func UsageAlertsIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldUsageAlerts))
+}
+
+// UsageAlertsNotNil applies the NotNil predicate on the "usage_alerts" field.
+func UsageAlertsNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldUsageAlerts))
+}
+
 // HasLineItems applies the HasEdge predicate on the "line_items" edge.
 func HasLineItems() predicate.Subscription {
 	return predicate.Subscription(func(s *sql.Selector) {
@@ -17,6 +17,7 @@ import (
 	"github.com/flexprice/flexprice/ent/subscriptionlineitem"
 	"github.com/flexprice/flexprice/ent/subscriptionpause"
 	"github.com/flexprice/flexprice/ent/subscriptionschedule"
+	"github.com/flexprice/flexprice/internal/types"
 	"github.com/shopspring/decimal"
 )
 
@@ -419,6 +420,62 @@ func (sc *SubscriptionCreate) SetNillableOverageFactor(d *decimal.Decimal) *Subs
 	return sc
 }
 
+// SetCommitmentType sets the "commitment_type" field.
+func (sc *SubscriptionCreate) SetCommitmentType(s string) *SubscriptionCreate {
+	sc.mutation.SetCommitmentType(s)
+	return sc
+}
+
+// SetNillableCommitmentType sets the "commitment_type" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableCommitmentType(s *string) *SubscriptionCreate {
+	if s != nil {
+		sc.SetCommitmentType(*s)
+	}
+	return sc
+}
+
+// SetCommitmentDuration sets the "commitment_duration" field.
+func (sc *SubscriptionCreate) SetCommitmentDuration(s string) *SubscriptionCreate {
+	sc.mutation.SetCommitmentDuration(s)
+	return sc
+}
+
+// SetNillableCommitmentDuration sets the "commitment_duration" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableCommitmentDuration(s *string) *SubscriptionCreate {
+	if s != nil {
+		sc.SetCommitmentDuration(*s)
+	}
+	return sc
+}
+
+// SetCommitmentStartDate sets the "commitment_start_date" field.
+func (sc *SubscriptionCreate) SetCommitmentStartDate(t time.Time) *SubscriptionCreate {
+	sc.mutation.SetCommitmentStartDate(t)
+	return sc
+}
+
+// SetNillableCommitmentStartDate sets the "commitment_start_date" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableCommitmentStartDate(t *time.Time) *SubscriptionCreate {
+	if t != nil {
+		sc.SetCommitmentStartDate(*t)
+	}
+	return sc
+}
+
+// SetCommitmentEndDate sets the "commitment_end_date" field.
+func (sc *SubscriptionCreate) SetCommitmentEndDate(t time.Time) *SubscriptionCreate {
+	sc.mutation.SetCommitmentEndDate(t)
+	return sc
+}
+
+// SetNillableCommitmentEndDate sets the "commitment_end_date" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableCommitmentEndDate(t *time.Time) *SubscriptionCreate {
+	if t != nil {
+		sc.SetCommitmentEndDate(*t)
+	}
+	return sc
+}
+
 // SetPaymentBehavior sets the "payment_behavior" field.
 func (sc *SubscriptionCreate) SetPaymentBehavior(sb subscription.PaymentBehavior) *SubscriptionCreate {
 	sc.mutation.SetPaymentBehavior(sb)
@@ -489,6 +546,12 @@ func (sc *SubscriptionCreate) SetNillableProrationBehavior(s *string) *Subscript
 	return sc
 }
 
+// SetUsageAlerts sets the "usage_alerts" field.
+func (sc *SubscriptionCreate) SetUsageAlerts(ta []types.UsageAlert) *SubscriptionCreate {
+	sc.mutation.SetUsageAlerts(ta)
+	return sc
+}
+
 // SetID sets the "id" field.
 func (sc *SubscriptionCreate) SetID(s string) *SubscriptionCreate {
 	sc.mutation.SetID(s)
@@ -684,6 +747,10 @@ func (sc *SubscriptionCreate) defaults() {
 		v := subscription.DefaultOverageFactor
 		sc.mutation.SetOverageFactor(v)
 	}
+	if _, ok := sc.mutation.CommitmentType(); !ok {
+		v := subscription.DefaultCommitmentType
+		sc.mutation.SetCommitmentType(v)
+	}
 	if _, ok := sc.mutation.PaymentBehavior(); !ok {
 		v := subscription.DefaultPaymentBehavior
 		sc.mutation.SetPaymentBehavior(v)
@@ -796,6 +863,9 @@ func (sc *SubscriptionCreate) check() error {
 			return &ValidationError{Name: "billing_cycle", err: fmt.Errorf(`ent: validator failed for field "Subscription.billing_cycle": %w`, err)}
 		}
 	}
+	if _, ok := sc.mutation.CommitmentType(); !ok {
+		return &ValidationError{Name: "commitment_type", err: errors.New(`ent: missing required field "Subscription.commitment_type"`)}
+	}
 	if _, ok := sc.mutation.PaymentBehavior(); !ok {
 		return &ValidationError{Name: "payment_behavior", err: errors.New(`ent: missing required field "Subscription.payment_behavior"`)}
 	}
@@ -986,6 +1056,22 @@ func (sc *SubscriptionCreate) createSpec() (*Subscription, *sqlgraph.CreateSpec)
 		_spec.SetField(subscription.FieldOverageFactor, field.TypeOther, value)
 		_node.OverageFactor = &value
 	}
+	if value, ok := sc.mutation.CommitmentType(); ok {
+		_spec.SetField(subscription.FieldCommitmentType, field.TypeString, value)
+		_node.CommitmentType = value
+	}
+	if value, ok := sc.mutation.CommitmentDuration(); ok {
+		_spec.SetField(subscription.FieldCommitmentDuration, field.TypeString, value)
+		_node.CommitmentDuration = &value
+	}
+	if value, ok := sc.mutation.CommitmentStartDate(); ok {
+		_spec.SetField(subscription.FieldCommitmentStartDate, field.TypeTime, value)
+		_node.CommitmentStartDate = &value
+	}
+	if value, ok := sc.mutation.CommitmentEndDate(); ok {
+		_spec.SetField(subscription.FieldCommitmentEndDate, field.TypeTime, value)
+		_node.CommitmentEndDate = &value
+	}
 	if value, ok := sc.mutation.PaymentBehavior(); ok {
 		_spec.SetField(subscription.FieldPaymentBehavior, field.TypeEnum, value)
 		_node.PaymentBehavior = value
@@ -1006,6 +1092,10 @@ func (sc *SubscriptionCreate) createSpec() (*Subscription, *sqlgraph.CreateSpec)
 		_spec.SetField(subscription.FieldProrationBehavior, field.TypeString, value)
 		_node.ProrationBehavior = value
 	}
+	if value, ok := sc.mutation.UsageAlerts(); ok {
+		_spec.SetField(subscription.FieldUsageAlerts, field.TypeJSON, value)
+		_node.UsageAlerts = value
+	}
 	if nodes := sc.mutation.LineItemsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/flexprice/flexprice/ent/couponapplication"
 	"github.com/flexprice/flexprice/ent/couponassociation"
@@ -19,6 +20,7 @@ import (
 	"github.com/flexprice/flexprice/ent/subscriptionlineitem"
 	"github.com/flexprice/flexprice/ent/subscriptionpause"
 	"github.com/flexprice/flexprice/ent/subscriptionschedule"
+	"github.com/flexprice/flexprice/internal/types"
 	"github.com/shopspring/decimal"
 )
 
@@ -358,6 +360,80 @@ func (su *SubscriptionUpdate) ClearOverageFactor() *SubscriptionUpdate {
 	return su
 }
 
+// SetCommitmentType sets the "commitment_type" field.
+func (su *SubscriptionUpdate) SetCommitmentType(s string) *SubscriptionUpdate {
+	su.mutation.SetCommitmentType(s)
+	return su
+}
+
+// SetNillableCommitmentType sets the "commitment_type" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableCommitmentType(s *string) *SubscriptionUpdate {
+	if s != nil {
+		su.SetCommitmentType(*s)
+	}
+	return su
+}
+
+// SetCommitmentDuration sets the "commitment_duration" field.
+func (su *SubscriptionUpdate) SetCommitmentDuration(s string) *SubscriptionUpdate {
+	su.mutation.SetCommitmentDuration(s)
+	return su
+}
+
+// SetNillableCommitmentDuration sets the "commitment_duration" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableCommitmentDuration(s *string) *SubscriptionUpdate {
+	if s != nil {
+		su.SetCommitmentDuration(*s)
+	}
+	return su
+}
+
+// ClearCommitmentDuration clears the value of the "commitment_duration" field.
+func (su *SubscriptionUpdate) ClearCommitmentDuration() *SubscriptionUpdate {
+	su.mutation.ClearCommitmentDuration()
+	return su
+}
+
+// SetCommitmentStartDate sets the "commitment_start_date" field.
+func (su *SubscriptionUpdate) SetCommitmentStartDate(t time.Time) *SubscriptionUpdate {
+	su.mutation.SetCommitmentStartDate(t)
+	return su
+}
+
+// SetNillableCommitmentStartDate sets the "commitment_start_date" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableCommitmentStartDate(t *time.Time) *SubscriptionUpdate {
+	if t != nil {
+		su.SetCommitmentStartDate(*t)
+	}
+	return su
+}
+
+// ClearCommitmentStartDate clears the value of the "commitment_start_date" field.
+func (su *SubscriptionUpdate) ClearCommitmentStartDate() *SubscriptionUpdate {
+	su.mutation.ClearCommitmentStartDate()
+	return su
+}
+
+// SetCommitmentEndDate sets the "commitment_end_date" field.
+func (su *SubscriptionUpdate) SetCommitmentEndDate(t time.Time) *SubscriptionUpdate {
+	su.mutation.SetCommitmentEndDate(t)
+	return su
+}
+
+// SetNillableCommitmentEndDate sets the "commitment_end_date" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableCommitmentEndDate(t *time.Time) *SubscriptionUpdate {
+	if t != nil {
+		su.SetCommitmentEndDate(*t)
+	}
+	return su
+}
+
+// ClearCommitmentEndDate clears the value of the "commitment_end_date" field.
+func (su *SubscriptionUpdate) ClearCommitmentEndDate() *SubscriptionUpdate {
+	su.mutation.ClearCommitmentEndDate()
+	return su
+}
+
 // SetPaymentBehavior sets the "payment_behavior" field.
 func (su *SubscriptionUpdate) SetPaymentBehavior(sb subscription.PaymentBehavior) *SubscriptionUpdate {
 	su.mutation.SetPaymentBehavior(sb)
@@ -420,6 +496,24 @@ func (su *SubscriptionUpdate) SetNillableCustomerTimezone(s *string) *Subscripti
 	return su
 }
 
+// SetUsageAlerts sets the "usage_alerts" field.
+func (su *SubscriptionUpdate) SetUsageAlerts(ta []types.UsageAlert) *SubscriptionUpdate {
+	su.mutation.SetUsageAlerts(ta)
+	return su
+}
+
+// AppendUsageAlerts appends ta to the "usage_alerts" field.
+func (su *SubscriptionUpdate) AppendUsageAlerts(ta []types.UsageAlert) *SubscriptionUpdate {
+	su.mutation.AppendUsageAlerts(ta)
+	return su
+}
+
+// ClearUsageAlerts clears the value of the "usage_alerts" field.
+func (su *SubscriptionUpdate) ClearUsageAlerts() *SubscriptionUpdate {
+	su.mutation.ClearUsageAlerts()
+	return su
+}
+
 // AddLineItemIDs adds the "line_items" edge to the SubscriptionLineItem entity by IDs.
 func (su *SubscriptionUpdate) AddLineItemIDs(ids ...string) *SubscriptionUpdate {
 	su.mutation.AddLineItemIDs(ids...)
@@ -792,6 +886,27 @@ func (su *SubscriptionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if su.mutation.OverageFactorCleared() {
 		_spec.ClearField(subscription.FieldOverageFactor, field.TypeOther)
 	}
+	if value, ok := su.mutation.CommitmentType(); ok {
+		_spec.SetField(subscription.FieldCommitmentType, field.TypeString, value)
+	}
+	if value, ok := su.mutation.CommitmentDuration(); ok {
+		_spec.SetField(subscription.FieldCommitmentDuration, field.TypeString, value)
+	}
+	if su.mutation.CommitmentDurationCleared() {
+		_spec.ClearField(subscription.FieldCommitmentDuration, field.TypeString)
+	}
+	if value, ok := su.mutation.CommitmentStartDate(); ok {
+		_spec.SetField(subscription.FieldCommitmentStartDate, field.TypeTime, value)
+	}
+	if su.mutation.CommitmentStartDateCleared() {
+		_spec.ClearField(subscription.FieldCommitmentStartDate, field.TypeTime)
+	}
+	if value, ok := su.mutation.CommitmentEndDate(); ok {
+		_spec.SetField(subscription.FieldCommitmentEndDate, field.TypeTime, value)
+	}
+	if su.mutation.CommitmentEndDateCleared() {
+		_spec.ClearField(subscription.FieldCommitmentEndDate, field.TypeTime)
+	}
 	if value, ok := su.mutation.PaymentBehavior(); ok {
 		_spec.SetField(subscription.FieldPaymentBehavior, field.TypeEnum, value)
 	}
@@ -807,6 +922,17 @@ func (su *SubscriptionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := su.mutation.CustomerTimezone(); ok {
 		_spec.SetField(subscription.FieldCustomerTimezone, field.TypeString, value)
 	}
+	if value, ok := su.mutation.UsageAlerts(); ok {
+		_spec.SetField(subscription.FieldUsageAlerts, field.TypeJSON, value)
+	}
+	if value, ok := su.mutation.AppendedUsageAlerts(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, subscription.FieldUsageAlerts, value)
+		})
+	}
+	if su.mutation.UsageAlertsCleared() {
+		_spec.ClearField(subscription.FieldUsageAlerts, field.TypeJSON)
+	}
 	if su.mutation.LineItemsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1404,6 +1530,80 @@ func (suo *SubscriptionUpdateOne) ClearOverageFactor() *SubscriptionUpdateOne {
 	return suo
 }
 
+// SetCommitmentType sets the "commitment_type" field.
+func (suo *SubscriptionUpdateOne) SetCommitmentType(s string) *SubscriptionUpdateOne {
+	suo.mutation.SetCommitmentType(s)
+	return suo
+}
+
+// SetNillableCommitmentType sets the "commitment_type" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableCommitmentType(s *string) *SubscriptionUpdateOne {
+	if s != nil {
+		suo.SetCommitmentType(*s)
+	}
+	return suo
+}
+
+// SetCommitmentDuration sets the "commitment_duration" field.
+func (suo *SubscriptionUpdateOne) SetCommitmentDuration(s string) *SubscriptionUpdateOne {
+	suo.mutation.SetCommitmentDuration(s)
+	return suo
+}
+
+// SetNillableCommitmentDuration sets the "commitment_duration" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableCommitmentDuration(s *string) *SubscriptionUpdateOne {
+	if s != nil {
+		suo.SetCommitmentDuration(*s)
+	}
+	return suo
+}
+
+// ClearCommitmentDuration clears the value of the "commitment_duration" field.
+func (suo *SubscriptionUpdateOne) ClearCommitmentDuration() *SubscriptionUpdateOne {
+	suo.mutation.ClearCommitmentDuration()
+	return suo
+}
+
+// SetCommitmentStartDate sets the "commitment_start_date" field.
+func (suo *SubscriptionUpdateOne) SetCommitmentStartDate(t time.Time) *SubscriptionUpdateOne {
+	suo.mutation.SetCommitmentStartDate(t)
+	return suo
+}
+
+// SetNillableCommitmentStartDate sets the "commitment_start_date" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableCommitmentStartDate(t *time.Time) *SubscriptionUpdateOne {
+	if t != nil {
+		suo.SetCommitmentStartDate(*t)
+	}
+	return suo
+}
+
+// ClearCommitmentStartDate clears the value of the "commitment_start_date" field.
+func (suo *SubscriptionUpdateOne) ClearCommitmentStartDate() *SubscriptionUpdateOne {
+	suo.mutation.ClearCommitmentStartDate()
+	return suo
+}
+
+// SetCommitmentEndDate sets the "commitment_end_date" field.
+func (suo *SubscriptionUpdateOne) SetCommitmentEndDate(t time.Time) *SubscriptionUpdateOne {
+	suo.mutation.SetCommitmentEndDate(t)
+	return suo
+}
+
+// SetNillableCommitmentEndDate sets the "commitment_end_date" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableCommitmentEndDate(t *time.Time) *SubscriptionUpdateOne {
+	if t != nil {
+		suo.SetCommitmentEndDate(*t)
+	}
+	return suo
+}
+
+// ClearCommitmentEndDate clears the value of the "commitment_end_date" field.
+func (suo *SubscriptionUpdateOne) ClearCommitmentEndDate() *SubscriptionUpdateOne {
+	suo.mutation.ClearCommitmentEndDate()
+	return suo
+}
+
 // SetPaymentBehavior sets the "payment_behavior" field.
 func (suo *SubscriptionUpdateOne) SetPaymentBehavior(sb subscription.PaymentBehavior) *SubscriptionUpdateOne {
 	suo.mutation.SetPaymentBehavior(sb)
@@ -1466,6 +1666,24 @@ func (suo *SubscriptionUpdateOne) SetNillableCustomerTimezone(s *string) *Subscr
 	return suo
 }
 
+// SetUsageAlerts sets the "usage_alerts" field.
+func (suo *SubscriptionUpdateOne) SetUsageAlerts(ta []types.UsageAlert) *SubscriptionUpdateOne {
+	suo.mutation.SetUsageAlerts(ta)
+	return suo
+}
+
+// AppendUsageAlerts appends ta to the "usage_alerts" field.
+func (suo *SubscriptionUpdateOne) AppendUsageAlerts(ta []types.UsageAlert) *SubscriptionUpdateOne {
+	suo.mutation.AppendUsageAlerts(ta)
+	return suo
+}
+
+// ClearUsageAlerts clears the value of the "usage_alerts" field.
+func (suo *SubscriptionUpdateOne) ClearUsageAlerts() *SubscriptionUpdateOne {
+	suo.mutation.ClearUsageAlerts()
+	return suo
+}
+
 // AddLineItemIDs adds the "line_items" edge to the SubscriptionLineItem entity by IDs.
 func (suo *SubscriptionUpdateOne) AddLineItemIDs(ids ...string) *SubscriptionUpdateOne {
 	suo.mutation.AddLineItemIDs(ids...)
@@ -1868,6 +2086,27 @@ func (suo *SubscriptionUpdateOne) sqlSave(ctx context.Context) (_node *Subscript
 	if suo.mutation.OverageFactorCleared() {
 		_spec.ClearField(subscription.FieldOverageFactor, field.TypeOther)
 	}
+	if value, ok := suo.mutation.CommitmentType(); ok {
+		_spec.SetField(subscription.FieldCommitmentType, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.CommitmentDuration(); ok {
+		_spec.SetField(subscription.FieldCommitmentDuration, field.TypeString, value)
+	}
+	if suo.mutation.CommitmentDurationCleared() {
+		_spec.ClearField(subscription.FieldCommitmentDuration, field.TypeString)
+	}
+	if value, ok := suo.mutation.CommitmentStartDate(); ok {
+		_spec.SetField(subscription.FieldCommitmentStartDate, field.TypeTime, value)
+	}
+	if suo.mutation.CommitmentStartDateCleared() {
+		_spec.ClearField(subscription.FieldCommitmentStartDate, field.TypeTime)
+	}
+	if value, ok := suo.mutation.CommitmentEndDate(); ok {
+		_spec.SetField(subscription.FieldCommitmentEndDate, field.TypeTime, value)
+	}
+	if suo.mutation.CommitmentEndDateCleared() {
+		_spec.ClearField(subscription.FieldCommitmentEndDate, field.TypeTime)
+	}
 	if value, ok := suo.mutation.PaymentBehavior(); ok {
 		_spec.SetField(subscription.FieldPaymentBehavior, field.TypeEnum, value)
 	}
@@ -1883,6 +2122,17 @@ func (suo *SubscriptionUpdateOne) sqlSave(ctx context.Context) (_node *Subscript
 	if value, ok := suo.mutation.CustomerTimezone(); ok {
 		_spec.SetField(subscription.FieldCustomerTimezone, field.TypeString, value)
 	}
+	if value, ok := suo.mutation.UsageAlerts(); ok {
+		_spec.SetField(subscription.FieldUsageAlerts, field.TypeJSON, value)
+	}
+	if value, ok := suo.mutation.AppendedUsageAlerts(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, subscription.FieldUsageAlerts, value)
+		})
+	}
+	if suo.mutation.UsageAlertsCleared() {
+		_spec.ClearField(subscription.FieldUsageAlerts, field.TypeJSON)
+	}
 	if suo.mutation.LineItemsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -70,3 +70,18 @@ func (h *SubscriptionHandler) ProcessSubscriptionRenewalDueAlerts(c *gin.Context
 	h.logger.Infow("completed subscription renewal due alerts cron job")
 	c.JSON(http.StatusOK, gin.H{"status": "completed"})
 }
+
+// ProcessSubscriptionUsageAlerts evaluates the spend and usage alerts of active subscriptions
+func (h *SubscriptionHandler) ProcessSubscriptionUsageAlerts(c *gin.Context) {
+	h.logger.Infow("starting subscription usage alerts cron job")
+
+	if err := h.subscriptionService.ProcessSubscriptionUsageAlerts(c.Request.Context()); err != nil {
+		h.logger.Errorw("failed to process subscription usage alerts",
+			"error", err)
+		c.Error(err)
+		return
+	}
+
+	h.logger.Infow("completed subscription usage alerts cron job")
+	c.JSON(http.StatusOK, gin.H{"status": "completed"})
+}
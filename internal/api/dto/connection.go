@@ -102,6 +102,23 @@ func convertFlatMetadataToStructured(flatMetadata map[string]interface{}, provid
 			HubSpot: hubspotMetadata,
 		}
 
+	case types.SecretProviderRazorpay:
+		razorpayMetadata := &types.RazorpayConnectionMetadata{}
+
+		if keyID, ok := flatMetadata["key_id"].(string); ok {
+			razorpayMetadata.KeyID = keyID
+		}
+		if keySecret, ok := flatMetadata["key_secret"].(string); ok {
+			razorpayMetadata.KeySecret = keySecret
+		}
+		if ws, ok := flatMetadata["webhook_secret"].(string); ok {
+			razorpayMetadata.WebhookSecret = ws
+		}
+
+		return types.ConnectionMetadata{
+			Razorpay: razorpayMetadata,
+		}
+
 	default:
 		// For other providers or unknown types, use generic format
 		return types.ConnectionMetadata{
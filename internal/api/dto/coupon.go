@@ -85,6 +85,12 @@ func (r *CreateCouponRequest) Validate() error {
 			Mark(ierr.ErrValidation)
 	}
 
+	if r.Rules != nil {
+		if _, err := types.ParseCouponRules(*r.Rules); err != nil {
+			return err
+		}
+	}
+
 	// Validate duration_in_periods based on cadence
 	if r.Cadence == types.CouponCadenceRepeated {
 		if r.DurationInPeriods == nil {
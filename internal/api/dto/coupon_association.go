@@ -10,10 +10,12 @@ import (
 
 // CreateCouponAssociationRequest represents the request to create a new coupon association
 type CreateCouponAssociationRequest struct {
-	CouponID               string            `json:"coupon_id" validate:"required"`
-	SubscriptionID         string            `json:"subscription_id" validate:"required"`
-	SubscriptionLineItemID *string           `json:"subscription_line_item_id,omitempty"`
-	Metadata               map[string]string `json:"metadata,omitempty"`
+	CouponID               string  `json:"coupon_id" validate:"required"`
+	SubscriptionID         string  `json:"subscription_id" validate:"required"`
+	SubscriptionLineItemID *string `json:"subscription_line_item_id,omitempty"`
+	// PromotionCodeID is the promotion code the coupon is redeemed through, if any
+	PromotionCodeID *string           `json:"promotion_code_id,omitempty"`
+	Metadata        map[string]string `json:"metadata,omitempty"`
 }
 
 // CouponAssociationResponse represents the response for coupon association data
@@ -37,6 +37,10 @@ type CreateCreditNoteRequest struct {
 
 	// process_credit_note is a flag to process the credit note after creation
 	ProcessCreditNote bool `json:"process_credit_note" validate:"omitempty" default:"true"`
+
+	// refund_method is where the amount of a refund credit note goes, WALLET credits the customer wallet
+	// and ORIGINAL_PAYMENT_METHOD refunds the gateway payments of the invoice. Defaults to WALLET.
+	RefundMethod types.CreditNoteRefundMethod `json:"refund_method,omitempty" validate:"omitempty"`
 }
 
 func (r *CreateCreditNoteRequest) Validate() error {
@@ -48,6 +52,12 @@ func (r *CreateCreditNoteRequest) Validate() error {
 		return err
 	}
 
+	if r.RefundMethod != "" {
+		if err := r.RefundMethod.Validate(); err != nil {
+			return err
+		}
+	}
+
 	if len(r.LineItems) == 0 {
 		return ierr.NewError("line_items is required").
 			WithHint("Please provide at least one line item").
@@ -75,6 +85,7 @@ func (r *CreateCreditNoteRequest) ToCreditNote(ctx context.Context, inv *invoice
 		CreditNoteNumber: r.CreditNoteNumber,
 		CreditNoteStatus: types.CreditNoteStatusDraft,
 		CreditNoteType:   types.CreditNoteTypeAdjustment,
+		RefundMethod:     r.RefundMethod,
 		Reason:           r.Reason,
 		Currency:         inv.Currency,
 		TotalAmount:      decimal.Zero,
@@ -84,6 +95,10 @@ func (r *CreateCreditNoteRequest) ToCreditNote(ctx context.Context, inv *invoice
 		BaseModel:        types.GetDefaultBaseModel(ctx),
 	}
 
+	if cn.RefundMethod == "" {
+		cn.RefundMethod = types.CreditNoteRefundMethodWallet
+	}
+
 	for _, lineItem := range r.LineItems {
 		cn.LineItems = append(cn.LineItems, lineItem.ToCreditNoteLineItem(ctx, cn))
 		cn.TotalAmount = cn.TotalAmount.Add(lineItem.Amount)
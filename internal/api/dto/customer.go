@@ -7,6 +7,7 @@ import (
 	ierr "github.com/flexprice/flexprice/internal/errors"
 	"github.com/flexprice/flexprice/internal/types"
 	"github.com/flexprice/flexprice/internal/validator"
+	"github.com/samber/lo"
 )
 
 // IntegrationEntityMapping represents a provider integration mapping
@@ -52,6 +53,9 @@ type CreateCustomerRequest struct {
 	// metadata contains additional key-value pairs for storing extra information
 	Metadata map[string]string `json:"metadata,omitempty"`
 
+	// parent_customer_id is the ID of the parent customer whose subscription bills the usage of this customer
+	ParentCustomerID *string `json:"parent_customer_id,omitempty"`
+
 	// tax_rate_overrides contains tax rate configurations to be linked to this customer
 	TaxRateOverrides []*TaxRateOverride `json:"tax_rate_overrides,omitempty"`
 
@@ -92,6 +96,9 @@ type UpdateCustomerRequest struct {
 	// metadata contains updated key-value pairs that will replace existing metadata
 	Metadata map[string]string `json:"metadata,omitempty"`
 
+	// parent_customer_id is the updated parent customer ID, an empty string detaches the customer from its parent
+	ParentCustomerID *string `json:"parent_customer_id,omitempty"`
+
 	// integration_entity_mapping contains provider integration mappings for this customer
 	IntegrationEntityMapping []*IntegrationEntityMapping `json:"integration_entity_mapping,omitempty"`
 }
@@ -149,6 +156,7 @@ func (r *CreateCustomerRequest) ToCustomer(ctx context.Context) *customer.Custom
 		AddressPostalCode: r.AddressPostalCode,
 		AddressCountry:    r.AddressCountry,
 		Metadata:          r.Metadata,
+		ParentCustomerID:  lo.EmptyableToPtr(lo.FromPtr(r.ParentCustomerID)),
 		EnvironmentID:     types.GetEnvironmentID(ctx),
 		BaseModel:         types.GetDefaultBaseModel(ctx),
 	}
@@ -132,3 +132,66 @@ type FeatureUsageSummary struct {
 	NextUsageResetAt *time.Time           `json:"next_usage_reset_at"`
 	Sources          []*EntitlementSource `json:"sources"`
 }
+
+// CheckEntitlementRequest represents the request for checking if a customer can use
+// a given quantity of a metered feature
+type CheckEntitlementRequest struct {
+	CustomerID        string `json:"customer_id,omitempty"`
+	CustomerLookupKey string `json:"customer_lookup_key,omitempty"`
+	FeatureID         string `json:"feature_id,omitempty"`
+	FeatureLookupKey  string `json:"feature_lookup_key,omitempty"`
+	// Quantity is the number of units the customer intends to use, defaults to 1
+	Quantity decimal.Decimal `json:"quantity" swaggertype:"string"`
+	// Reserve adds the quantity to the in-flight usage of the customer when allowed, so that
+	// concurrent checks account for usage which is not yet reflected in the aggregated usage
+	Reserve bool `json:"reserve,omitempty"`
+}
+
+func (r *CheckEntitlementRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+
+	if r.CustomerID == "" && r.CustomerLookupKey == "" {
+		return ierr.NewError("customer_id or customer_lookup_key is required").
+			WithHint("Please provide either customer_id or customer_lookup_key").
+			Mark(ierr.ErrValidation)
+	}
+
+	if r.FeatureID == "" && r.FeatureLookupKey == "" {
+		return ierr.NewError("feature_id or feature_lookup_key is required").
+			WithHint("Please provide either feature_id or feature_lookup_key").
+			Mark(ierr.ErrValidation)
+	}
+
+	if r.Quantity.IsNegative() {
+		return ierr.NewError("quantity cannot be negative").
+			WithHint("Please provide a positive quantity").
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+// CheckEntitlementResponse represents the result of an entitlement check
+type CheckEntitlementResponse struct {
+	CustomerID  string `json:"customer_id"`
+	FeatureID   string `json:"feature_id"`
+	Allowed     bool   `json:"allowed"`
+	IsEnabled   bool   `json:"is_enabled"`
+	IsSoftLimit bool   `json:"is_soft_limit"`
+	// UsageLimit is nil for unlimited usage
+	UsageLimit        *int64          `json:"usage_limit"`
+	RequestedQuantity decimal.Decimal `json:"requested_quantity" swaggertype:"string"`
+	// CurrentUsage is the aggregated usage of the current usage reset period
+	CurrentUsage decimal.Decimal `json:"current_usage" swaggertype:"string"`
+	// InFlightUsage is the usage reserved by previous checks which is not yet aggregated
+	InFlightUsage decimal.Decimal `json:"in_flight_usage" swaggertype:"string"`
+	// Remaining is the quantity left after the requested quantity, nil for unlimited usage
+	Remaining        *decimal.Decimal                  `json:"remaining" swaggertype:"string"`
+	UsageResetPeriod types.EntitlementUsageResetPeriod `json:"usage_reset_period,omitempty"`
+	PeriodStart      time.Time                         `json:"period_start"`
+	PeriodEnd        time.Time                         `json:"period_end"`
+	// Reason explains why the usage is denied
+	Reason string `json:"reason,omitempty"`
+}
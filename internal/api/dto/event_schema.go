@@ -0,0 +1,120 @@
+package dto
+
+import (
+	"context"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/domain/eventschema"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/flexprice/flexprice/internal/validator"
+)
+
+// CreateEventSchemaRequest represents the request to register the schema of an event name
+type CreateEventSchemaRequest struct {
+	EventName      string                          `json:"event_name" validate:"required"`
+	Description    string                          `json:"description,omitempty"`
+	ValidationMode types.EventSchemaValidationMode `json:"validation_mode,omitempty"`
+	Properties     []types.EventPropertySchema     `json:"properties" validate:"required,min=1"`
+}
+
+func (r *CreateEventSchemaRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+
+	if r.ValidationMode != "" {
+		if err := r.ValidationMode.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return validateEventPropertySchemas(r.Properties)
+}
+
+func (r *CreateEventSchemaRequest) ToEventSchema(ctx context.Context) *eventschema.EventSchema {
+	validationMode := r.ValidationMode
+	if validationMode == "" {
+		validationMode = types.EventSchemaValidationModeReject
+	}
+
+	return &eventschema.EventSchema{
+		ID:             types.GenerateUUIDWithPrefix(types.UUID_PREFIX_EVENT_SCHEMA),
+		EventName:      r.EventName,
+		Description:    r.Description,
+		ValidationMode: validationMode,
+		Properties:     r.Properties,
+		EnvironmentID:  types.GetEnvironmentID(ctx),
+		BaseModel:      types.GetDefaultBaseModel(ctx),
+	}
+}
+
+// UpdateEventSchemaRequest represents the request to update an event schema
+type UpdateEventSchemaRequest struct {
+	Description    *string                          `json:"description,omitempty"`
+	ValidationMode *types.EventSchemaValidationMode `json:"validation_mode,omitempty"`
+	Properties     []types.EventPropertySchema      `json:"properties,omitempty"`
+}
+
+func (r *UpdateEventSchemaRequest) Validate() error {
+	if r.ValidationMode != nil {
+		if err := r.ValidationMode.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if r.Properties != nil {
+		return validateEventPropertySchemas(r.Properties)
+	}
+
+	return nil
+}
+
+func validateEventPropertySchemas(properties []types.EventPropertySchema) error {
+	seen := make(map[string]bool, len(properties))
+	for _, property := range properties {
+		if err := property.Validate(); err != nil {
+			return err
+		}
+		if seen[property.Name] {
+			return ierr.NewError("duplicate property in event schema").
+				WithHint("Each property can only be defined once").
+				WithReportableDetails(map[string]interface{}{
+					"property": property.Name,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		seen[property.Name] = true
+	}
+	return nil
+}
+
+// EventSchemaResponse represents the event schema response
+type EventSchemaResponse struct {
+	ID             string                          `json:"id"`
+	EventName      string                          `json:"event_name"`
+	Description    string                          `json:"description,omitempty"`
+	ValidationMode types.EventSchemaValidationMode `json:"validation_mode"`
+	Properties     []types.EventPropertySchema     `json:"properties"`
+	EnvironmentID  string                          `json:"environment_id"`
+	Status         string                          `json:"status"`
+	CreatedAt      time.Time                       `json:"created_at"`
+	UpdatedAt      time.Time                       `json:"updated_at"`
+}
+
+// ListEventSchemasResponse represents the response for listing event schemas
+type ListEventSchemasResponse = types.ListResponse[*EventSchemaResponse]
+
+func ToEventSchemaResponse(schema *eventschema.EventSchema) *EventSchemaResponse {
+	return &EventSchemaResponse{
+		ID:             schema.ID,
+		EventName:      schema.EventName,
+		Description:    schema.Description,
+		ValidationMode: schema.ValidationMode,
+		Properties:     schema.Properties,
+		EnvironmentID:  schema.EnvironmentID,
+		Status:         string(schema.Status),
+		CreatedAt:      schema.CreatedAt,
+		UpdatedAt:      schema.UpdatedAt,
+	}
+}
@@ -41,6 +41,120 @@ func (r *BulkIngestEventRequest) Validate() error {
 	return validator.ValidateRequest(r)
 }
 
+// BulkIngestEventResponse summarises the outcome of a bulk ingestion against the event schemas
+type BulkIngestEventResponse struct {
+	Message string `json:"message"`
+	// Accepted is the number of events accepted for processing, including flagged events
+	Accepted int `json:"accepted"`
+	// Rejected is the number of events dropped because they violated their event schema
+	Rejected int `json:"rejected"`
+	// Flagged is the number of accepted events that violated their event schema
+	Flagged int `json:"flagged"`
+	// Violations lists the schema violations of the rejected and flagged events
+	Violations []*EventSchemaViolationResult `json:"violations,omitempty"`
+}
+
+// EventSchemaViolationResult describes the schema violations of a single event of a bulk request
+type EventSchemaViolationResult struct {
+	// Index is the position of the event in the request
+	Index      int                          `json:"index"`
+	EventID    string                       `json:"event_id"`
+	EventName  string                       `json:"event_name"`
+	Action     types.RejectedEventAction    `json:"action"`
+	Violations []types.EventSchemaViolation `json:"violations"`
+}
+
+// StreamIngestEventResponse summarises the ingestion of an NDJSON stream of events
+type StreamIngestEventResponse struct {
+	Message string `json:"message"`
+	// Lines is the number of non empty lines read from the stream
+	Lines int `json:"lines"`
+	// Accepted is the number of events published for processing, including flagged events
+	Accepted int `json:"accepted"`
+	// Rejected is the number of events dropped because they violated their event schema
+	Rejected int `json:"rejected"`
+	// Flagged is the number of accepted events that violated their event schema
+	Flagged int `json:"flagged"`
+	// Failed is the number of lines that could not be parsed, validated or published
+	Failed int `json:"failed"`
+	// Errors lists the failed, rejected and flagged lines, capped to the first 1000 lines
+	Errors []*StreamIngestLineError `json:"errors,omitempty"`
+	// ErrorsTruncated is set when more lines had errors than listed
+	ErrorsTruncated bool `json:"errors_truncated,omitempty"`
+}
+
+// StreamIngestLineError describes why a line of an NDJSON stream was not ingested as is
+type StreamIngestLineError struct {
+	// Line is the 1-based line number in the stream
+	Line       int                          `json:"line"`
+	EventID    string                       `json:"event_id,omitempty"`
+	EventName  string                       `json:"event_name,omitempty"`
+	Error      string                       `json:"error"`
+	Action     types.RejectedEventAction    `json:"action,omitempty"`
+	Violations []types.EventSchemaViolation `json:"violations,omitempty"`
+}
+
+// IngestMetricsResponse summarises the ingestion of an OTLP or Prometheus remote-write request, the
+// violation indexes are the positions of the data points in the decoded request
+type IngestMetricsResponse struct {
+	BulkIngestEventResponse
+	// Unmapped is the number of data points dropped because no mapping rule or customer matched them
+	Unmapped int `json:"unmapped"`
+	// Skipped is the number of cumulative data points that only set the baseline of their series, or
+	// that were already counted or did not increase it
+	Skipped int `json:"skipped"`
+}
+
+// GetRejectedEventsRequest represents the request to list the events that violated their schema
+type GetRejectedEventsRequest struct {
+	EventName          string                    `json:"event_name,omitempty"`
+	ExternalCustomerID string                    `json:"external_customer_id,omitempty"`
+	Action             types.RejectedEventAction `json:"action,omitempty"`
+	StartTime          time.Time                 `json:"start_time,omitempty" example:"2024-11-09T00:00:00Z"`
+	EndTime            time.Time                 `json:"end_time,omitempty" example:"2024-12-09T00:00:00Z"`
+	// Page size to fetch the rejected events and is set to 50 by default
+	PageSize int `json:"page_size"`
+	// Offset to fetch the rejected events and is set to 0 by default
+	Offset int `json:"offset"`
+}
+
+func (r *GetRejectedEventsRequest) Validate() error {
+	if r.Action != "" && r.Action != types.RejectedEventActionRejected && r.Action != types.RejectedEventActionFlagged {
+		return ierr.NewError("invalid action").
+			WithHint("Action must be one of rejected or flagged").
+			WithReportableDetails(map[string]interface{}{
+				"action": r.Action,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	if !r.StartTime.IsZero() && !r.EndTime.IsZero() && r.EndTime.Before(r.StartTime) {
+		return ierr.NewError("end time must be after start time").
+			WithHint("End time must be after start time").
+			Mark(ierr.ErrValidation)
+	}
+	if r.PageSize < 0 || r.Offset < 0 {
+		return ierr.NewError("invalid pagination").
+			WithHint("Page size and offset must not be negative").
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+type GetRejectedEventsResponse struct {
+	Events     []*RejectedEvent `json:"events"`
+	HasMore    bool             `json:"has_more"`
+	TotalCount uint64           `json:"total_count"`
+	Offset     int              `json:"offset"`
+}
+
+type RejectedEvent struct {
+	Event
+	SchemaID   string                       `json:"schema_id"`
+	Action     types.RejectedEventAction    `json:"action"`
+	Violations []types.EventSchemaViolation `json:"violations"`
+	RejectedAt time.Time                    `json:"rejected_at"`
+}
+
 func (r *IngestEventRequest) ToEvent(ctx context.Context) *events.Event {
 	return events.NewEvent(
 		r.EventName,
@@ -59,16 +173,19 @@ type GetUsageRequest struct {
 	ExternalCustomerID string                `form:"external_customer_id" json:"external_customer_id" example:"customer456"`
 	CustomerID         string                `form:"customer_id" json:"customer_id" example:"customer456"`
 	EventName          string                `form:"event_name" json:"event_name" binding:"required" required:"true" example:"api_request"`
-	PropertyName       string                `form:"property_name" json:"property_name" example:"request_size"` // will be empty/ignored in case of COUNT
+	PropertyName       string                `form:"property_name" json:"property_name" example:"request_size"`                         // will be empty/ignored in case of COUNT
+	Expression         string                `form:"expression" json:"expression,omitempty" example:"input_tokens + 2 * output_tokens"` // Optional, computes the value from multiple properties instead of property_name
 	AggregationType    types.AggregationType `form:"aggregation_type" json:"aggregation_type" binding:"required"`
 	StartTime          time.Time             `form:"start_time" json:"start_time" example:"2024-03-13T00:00:00Z"`
 	EndTime            time.Time             `form:"end_time" json:"end_time" example:"2024-03-20T00:00:00Z"`
 	WindowSize         types.WindowSize      `form:"window_size" json:"window_size"`
-	BucketSize         types.WindowSize      `form:"bucket_size" json:"bucket_size,omitempty" example:"HOUR"` // Optional, only used for MAX aggregation with windowing
+	BucketSize         types.WindowSize      `form:"bucket_size" json:"bucket_size,omitempty" example:"HOUR"` // Optional, only used for MAX and PERCENTILE aggregation with windowing
 	Filters            map[string][]string   `form:"filters,omitempty" json:"filters,omitempty"`
-	PriceID            string                `form:"-" json:"-"` // this is just for internal use to store the price id
-	MeterID            string                `form:"-" json:"-"` // this is just for internal use to store the meter id
+	FilterConditions   []meter.Filter        `form:"-" json:"filter_conditions,omitempty"` // Optional, filters with operators e.g. not_in, range, prefix, exists
+	PriceID            string                `form:"-" json:"-"`                           // this is just for internal use to store the price id
+	MeterID            string                `form:"-" json:"-"`                           // this is just for internal use to store the meter id
 	Multiplier         *decimal.Decimal      `form:"multiplier" json:"multiplier,omitempty"`
+	Percentile         *decimal.Decimal      `form:"percentile" json:"percentile,omitempty"` // Required for PERCENTILE aggregation, e.g. 95
 	// BillingAnchor enables custom monthly billing periods for usage aggregation.
 	//
 	// When to use:
@@ -96,8 +213,9 @@ type GetUsageByMeterRequest struct {
 	StartTime          time.Time           `form:"start_time" json:"start_time" example:"2024-11-09T00:00:00Z"`
 	EndTime            time.Time           `form:"end_time" json:"end_time" example:"2024-12-09T00:00:00Z"`
 	WindowSize         types.WindowSize    `form:"window_size" json:"window_size"`
-	BucketSize         types.WindowSize    `form:"bucket_size" json:"bucket_size,omitempty" example:"HOUR"` // Optional, only used for MAX aggregation with windowing
+	BucketSize         types.WindowSize    `form:"bucket_size" json:"bucket_size,omitempty" example:"HOUR"` // Optional, only used for MAX and PERCENTILE aggregation with windowing
 	Filters            map[string][]string `form:"filters,omitempty" json:"filters,omitempty"`
+	FilterConditions   []meter.Filter      `form:"-" json:"-"` // meter filters using operators other than exact match, set internally from the meter
 	// BillingAnchor enables custom monthly billing periods for meter usage aggregation.
 	//
 	// Usage guidelines:
@@ -170,6 +288,158 @@ type Event struct {
 	EnvironmentID      string                 `json:"environment_id"`
 }
 
+// GetDeadLetterEventsRequest represents the request to list the events that failed feature usage processing
+type GetDeadLetterEventsRequest struct {
+	EventIDs           []string                    `json:"event_ids,omitempty"`
+	EventName          string                      `json:"event_name,omitempty"`
+	ExternalCustomerID string                      `json:"external_customer_id,omitempty"`
+	Reason             types.DeadLetterReason      `json:"reason,omitempty"`
+	Status             types.DeadLetterEventStatus `json:"status,omitempty"`
+	StartTime          time.Time                   `json:"start_time,omitempty" example:"2024-11-09T00:00:00Z"`
+	EndTime            time.Time                   `json:"end_time,omitempty" example:"2024-12-09T00:00:00Z"`
+	// Page size to fetch the dead letter events and is set to 50 by default
+	PageSize int `json:"page_size"`
+	// Offset to fetch the dead letter events and is set to 0 by default
+	Offset int `json:"offset"`
+}
+
+func (r *GetDeadLetterEventsRequest) Validate() error {
+	if r.Reason != "" {
+		if err := r.Reason.Validate(); err != nil {
+			return err
+		}
+	}
+	if r.Status != "" {
+		if err := r.Status.Validate(); err != nil {
+			return err
+		}
+	}
+	if !r.StartTime.IsZero() && !r.EndTime.IsZero() && r.EndTime.Before(r.StartTime) {
+		return ierr.NewError("end time must be after start time").
+			WithHint("End time must be after start time").
+			Mark(ierr.ErrValidation)
+	}
+	if r.PageSize < 0 || r.Offset < 0 {
+		return ierr.NewError("invalid pagination").
+			WithHint("Page size and offset must not be negative").
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+type GetDeadLetterEventsResponse struct {
+	Events     []*DeadLetterEvent `json:"events"`
+	HasMore    bool               `json:"has_more"`
+	TotalCount uint64             `json:"total_count"`
+	Offset     int                `json:"offset"`
+}
+
+type DeadLetterEvent struct {
+	Event
+	Reason      types.DeadLetterReason      `json:"reason"`
+	Error       string                      `json:"error,omitempty"`
+	Status      types.DeadLetterEventStatus `json:"status"`
+	ReplayCount uint32                      `json:"replay_count"`
+	FailedAt    time.Time                   `json:"failed_at"`
+	ReplayedAt  *time.Time                  `json:"replayed_at,omitempty"`
+}
+
+func ToDeadLetterEventResponse(event *events.DeadLetterEvent) *DeadLetterEvent {
+	return &DeadLetterEvent{
+		Event: Event{
+			ID:                 event.ID,
+			ExternalCustomerID: event.ExternalCustomerID,
+			CustomerID:         event.CustomerID,
+			EventName:          event.EventName,
+			Timestamp:          event.Timestamp,
+			Properties:         event.Properties,
+			Source:             event.Source,
+			EnvironmentID:      event.EnvironmentID,
+		},
+		Reason:      event.Reason,
+		Error:       event.Error,
+		Status:      event.Status,
+		ReplayCount: event.ReplayCount,
+		FailedAt:    event.FailedAt,
+		ReplayedAt:  event.ReplayedAt,
+	}
+}
+
+// ReplayDeadLetterEventsRequest represents the request to process dead-lettered events again
+type ReplayDeadLetterEventsRequest struct {
+	EventIDs []string `json:"event_ids" validate:"required,min=1,max=100"`
+}
+
+func (r *ReplayDeadLetterEventsRequest) Validate() error {
+	return validator.ValidateRequest(r)
+}
+
+type ReplayDeadLetterEventsResponse struct {
+	// Replayed is the number of events turned into feature usage
+	Replayed int `json:"replayed"`
+	// Failed lists the events that failed again, with the reason of the latest attempt
+	Failed []*DeadLetterEvent `json:"failed"`
+	// NotFound lists the requested event ids without a pending dead letter event
+	NotFound []string `json:"not_found"`
+}
+
+// AmendEventRequest replaces the properties of an ingested event. The event is retracted and a
+// replacement event with the amended properties is ingested under a new id.
+type AmendEventRequest struct {
+	Properties map[string]interface{} `json:"properties" validate:"required"`
+	Reason     string                 `json:"reason,omitempty" validate:"omitempty,max=255"`
+}
+
+func (r *AmendEventRequest) Validate() error {
+	return validator.ValidateRequest(r)
+}
+
+// RetractEventRequest removes an ingested event from usage
+type RetractEventRequest struct {
+	Reason string `json:"reason,omitempty" validate:"omitempty,max=255"`
+}
+
+func (r *RetractEventRequest) Validate() error {
+	return validator.ValidateRequest(r)
+}
+
+type EventCorrectionResponse struct {
+	ID                 string                      `json:"id"`
+	EventID            string                      `json:"event_id"`
+	EventName          string                      `json:"event_name"`
+	ExternalCustomerID string                      `json:"external_customer_id"`
+	EventTimestamp     time.Time                   `json:"event_timestamp"`
+	Action             types.EventCorrectionAction `json:"action"`
+	ReplacementEventID string                      `json:"replacement_event_id,omitempty"`
+	Reason             string                      `json:"reason,omitempty"`
+	CreatedAt          time.Time                   `json:"created_at"`
+	CreatedBy          string                      `json:"created_by,omitempty"`
+	// RecalculatedInvoiceIDs lists the draft invoices recalculated with the corrected usage
+	RecalculatedInvoiceIDs []string `json:"recalculated_invoice_ids"`
+	// CreditNoteIDs lists the draft credit notes for finalized invoices that overcharged the corrected usage
+	CreditNoteIDs []string `json:"credit_note_ids"`
+	// AdjustmentInvoiceIDs lists the draft invoices for finalized invoices that undercharged the corrected usage
+	AdjustmentInvoiceIDs []string `json:"adjustment_invoice_ids"`
+}
+
+func ToEventCorrectionResponse(correction *events.EventCorrection) *EventCorrectionResponse {
+	return &EventCorrectionResponse{
+		ID:                     correction.ID,
+		EventID:                correction.EventID,
+		EventName:              correction.EventName,
+		ExternalCustomerID:     correction.ExternalCustomerID,
+		EventTimestamp:         correction.EventTimestamp,
+		Action:                 correction.Action,
+		ReplacementEventID:     correction.ReplacementEventID,
+		Reason:                 correction.Reason,
+		CreatedAt:              correction.CreatedAt,
+		CreatedBy:              correction.CreatedBy,
+		RecalculatedInvoiceIDs: make([]string, 0),
+		CreditNoteIDs:          make([]string, 0),
+		AdjustmentInvoiceIDs:   make([]string, 0),
+	}
+}
+
 type GetUsageResponse struct {
 	Results   []UsageResult         `json:"results,omitempty"`
 	Value     float64               `json:"value,omitempty"`
@@ -207,11 +477,20 @@ func FromAggregationResult(result *events.AggregationResult) *GetUsageResponse {
 }
 
 func (r *GetUsageRequest) Validate() error {
-	return validator.ValidateRequest(r)
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+
+	for _, filter := range r.FilterConditions {
+		if err := filter.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (r *GetUsageRequest) ToUsageParams() *events.UsageParams {
-	if r.AggregationType == "" || r.PropertyName == "" {
+	if r.AggregationType == "" || (r.PropertyName == "" && r.Expression == "") {
 		r.AggregationType = types.AggregationCount
 	}
 
@@ -220,13 +499,16 @@ func (r *GetUsageRequest) ToUsageParams() *events.UsageParams {
 		CustomerID:         r.CustomerID,
 		EventName:          r.EventName,
 		PropertyName:       r.PropertyName,
+		Expression:         r.Expression,
 		AggregationType:    types.AggregationType(strings.ToUpper(string(r.AggregationType))),
 		StartTime:          r.StartTime,
 		EndTime:            r.EndTime,
 		WindowSize:         r.WindowSize,
 		BucketSize:         r.BucketSize,
 		Filters:            r.Filters,
+		FilterConditions:   r.FilterConditions,
 		Multiplier:         r.Multiplier,
+		Percentile:         r.Percentile,
 		BillingAnchor:      r.BillingAnchor,
 	}
 }
@@ -288,6 +570,69 @@ type GetUsageAnalyticsRequest struct {
 	PropertyFilters map[string][]string `json:"property_filters,omitempty"`
 }
 
+// StreamUsageRequest represents the query of a live usage stream
+type StreamUsageRequest struct {
+	ExternalCustomerID string   `form:"external_customer_id" binding:"required"`
+	FeatureIDs         []string `form:"feature_ids"`
+	// StartTime is the start of the usage totals, 7 days ago by default
+	StartTime time.Time `form:"start_time" time_format:"2006-01-02T15:04:05Z07:00"`
+}
+
+// FeatureUsageUpdate is published for every event processed into feature usage, live usage streams use
+// it to push the usage increments and to know when the usage and cost of a customer changed
+type FeatureUsageUpdate struct {
+	TenantID           string                   `json:"-"`
+	EnvironmentID      string                   `json:"-"`
+	EventID            string                   `json:"event_id"`
+	EventName          string                   `json:"event_name"`
+	CustomerID         string                   `json:"customer_id"`
+	ExternalCustomerID string                   `json:"external_customer_id"`
+	Source             string                   `json:"source,omitempty"`
+	Timestamp          time.Time                `json:"timestamp"`
+	ProcessedAt        time.Time                `json:"processed_at"`
+	Items              []FeatureUsageUpdateItem `json:"items"`
+}
+
+// FeatureUsageUpdateItem is the usage recorded for one subscription line item of the event
+type FeatureUsageUpdateItem struct {
+	SubscriptionID string          `json:"subscription_id"`
+	FeatureID      string          `json:"feature_id"`
+	MeterID        string          `json:"meter_id"`
+	PriceID        string          `json:"price_id"`
+	Quantity       decimal.Decimal `json:"quantity"`
+}
+
+// ToFeatureUsageUpdate builds the update of the feature usage rows of a single event
+func ToFeatureUsageUpdate(featureUsage []*events.FeatureUsage) *FeatureUsageUpdate {
+	if len(featureUsage) == 0 {
+		return nil
+	}
+
+	first := featureUsage[0]
+	update := &FeatureUsageUpdate{
+		TenantID:           first.TenantID,
+		EnvironmentID:      first.EnvironmentID,
+		EventID:            first.ID,
+		EventName:          first.EventName,
+		CustomerID:         first.CustomerID,
+		ExternalCustomerID: first.ExternalCustomerID,
+		Source:             first.Source,
+		Timestamp:          first.Timestamp,
+		ProcessedAt:        first.ProcessedAt,
+		Items:              make([]FeatureUsageUpdateItem, 0, len(featureUsage)),
+	}
+	for _, usage := range featureUsage {
+		update.Items = append(update.Items, FeatureUsageUpdateItem{
+			SubscriptionID: usage.SubscriptionID,
+			FeatureID:      usage.FeatureID,
+			MeterID:        usage.MeterID,
+			PriceID:        usage.PriceID,
+			Quantity:       usage.QtyTotal,
+		})
+	}
+	return update
+}
+
 // GetUsageAnalyticsResponse represents the response for the usage analytics API
 type GetUsageAnalyticsResponse struct {
 	TotalCost decimal.Decimal     `json:"total_cost"`
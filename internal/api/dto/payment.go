@@ -38,9 +38,17 @@ type UpdatePaymentRequest struct {
 	Metadata         *types.Metadata `json:"metadata,omitempty"`
 	SucceededAt      *time.Time      `json:"succeeded_at,omitempty"`
 	FailedAt         *time.Time      `json:"failed_at,omitempty"`
+	RefundedAt       *time.Time      `json:"refunded_at,omitempty"`
 	ErrorMessage     *string         `json:"error_message,omitempty"`
 }
 
+// UpdatePaymentRefundRequest represents the outcome of a payment refund reported by the gateway
+type UpdatePaymentRefundRequest struct {
+	RefundStatus    types.PaymentStatus `json:"refund_status"`
+	GatewayRefundID *string             `json:"gateway_refund_id,omitempty"`
+	FailureReason   *string             `json:"failure_reason,omitempty"`
+}
+
 // PaymentResponse represents a payment response
 type PaymentResponse struct {
 	ID                     string                       `json:"id"`
@@ -64,6 +72,7 @@ type PaymentResponse struct {
 	RefundedAt             *time.Time                   `json:"refunded_at,omitempty"`
 	ErrorMessage           *string                      `json:"error_message,omitempty"`
 	Attempts               []*PaymentAttemptResponse    `json:"attempts,omitempty"`
+	Refunds                []*payment.PaymentRefund     `json:"refunds,omitempty"`
 	InvoiceNumber          *string                      `json:"invoice_number,omitempty"`
 	TenantID               string                       `json:"tenant_id"`
 	SaveCardAndMakeDefault bool                         `json:"save_card_and_make_default"`
@@ -143,6 +152,8 @@ func NewPaymentResponse(p *payment.Payment) *PaymentResponse {
 		}
 	}
 
+	resp.Refunds = p.Refunds
+
 	return resp
 }
 
@@ -288,13 +299,34 @@ type ChargeSavedPaymentMethodRequest struct {
 
 // PaymentIntentResponse represents a payment intent response
 type PaymentIntentResponse struct {
-	ID            string          `json:"id"`
-	Status        string          `json:"status"`
-	Amount        decimal.Decimal `json:"amount"`
-	Currency      string          `json:"currency"`
-	CustomerID    string          `json:"customer_id"`
-	PaymentMethod string          `json:"payment_method"`
-	CreatedAt     int64           `json:"created_at"`
+	ID     string `json:"id"`
+	Status string `json:"status"`
+	// PaymentStatus is pending when the gateway completes the charge asynchronously,
+	// the payment is then completed by the gateway webhook. Empty means succeeded.
+	PaymentStatus types.PaymentStatus `json:"payment_status,omitempty"`
+	Amount        decimal.Decimal     `json:"amount"`
+	Currency      string              `json:"currency"`
+	CustomerID    string              `json:"customer_id"`
+	PaymentMethod string              `json:"payment_method"`
+	CreatedAt     int64               `json:"created_at"`
+}
+
+// CreateGatewayRefundRequest represents a request to refund a payment through its payment gateway
+type CreateGatewayRefundRequest struct {
+	RefundID         string                 `json:"refund_id"`
+	PaymentID        string                 `json:"payment_id"`
+	GatewayPaymentID string                 `json:"gateway_payment_id"`
+	CreditNoteID     string                 `json:"credit_note_id,omitempty"`
+	Amount           decimal.Decimal        `json:"amount"`
+	Currency         string                 `json:"currency"`
+	Reason           types.CreditNoteReason `json:"reason,omitempty"`
+}
+
+// GatewayRefundResponse represents a refund created at the payment gateway
+type GatewayRefundResponse struct {
+	ID            string              `json:"id"`
+	Status        types.PaymentStatus `json:"status"`
+	FailureReason string              `json:"failure_reason,omitempty"`
 }
 
 // SetDefaultPaymentMethodRequest represents a request to set default payment method
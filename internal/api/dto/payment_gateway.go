@@ -17,6 +17,7 @@ type CreatePaymentLinkRequest struct {
 	Metadata               types.Metadata            `json:"metadata,omitempty"`
 	Description            string                    `json:"description,omitempty"`
 	SaveCardAndMakeDefault bool                      `json:"save_card_and_make_default" default:"false"`
+	PaymentID              string                    `json:"payment_id,omitempty"`
 }
 
 // Validate validates the payment link request
@@ -81,6 +82,14 @@ type GenericPaymentStatusResponse struct {
 	Gateway         string            `json:"gateway"`
 }
 
+// GatewayWebhookEvent represents a webhook event whose signature was verified by its payment gateway
+type GatewayWebhookEvent struct {
+	ID      string                   `json:"id,omitempty"`
+	Type    string                   `json:"type"`
+	Gateway types.PaymentGatewayType `json:"gateway"`
+	Payload []byte                   `json:"payload"`
+}
+
 // GetSupportedGatewaysResponse represents the list of supported gateways
 type GetSupportedGatewaysResponse struct {
 	Gateways []GatewayInfo `json:"gateways"`
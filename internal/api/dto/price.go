@@ -36,6 +36,9 @@ type CreatePriceRequest struct {
 	TierMode           types.BillingTier        `json:"tier_mode,omitempty"`
 	Tiers              []CreatePriceTier        `json:"tiers,omitempty"`
 	TransformQuantity  *price.TransformQuantity `json:"transform_quantity,omitempty"`
+	Matrix             *types.PriceMatrix       `json:"matrix,omitempty"`
+	MinCharge          *decimal.Decimal         `json:"min_charge,omitempty"`
+	MaxCharge          *decimal.Decimal         `json:"max_charge,omitempty"`
 	PriceUnitConfig    *PriceUnitConfig         `json:"price_unit_config,omitempty"`
 	StartDate          *time.Time               `json:"start_date,omitempty"`
 	EndDate            *time.Time               `json:"end_date,omitempty"`
@@ -71,6 +74,37 @@ type CreatePriceTier struct {
 	// flat_amount is the flat amount for the given tier (optional)
 	// Applied on top of unit_amount*quantity. Useful for cases like "2.7$ + 5c"
 	FlatAmount *string `json:"flat_amount" validate:"omitempty"`
+
+	// min_amount is the minimum amount charged for the tier once any usage falls in it (optional)
+	MinAmount *string `json:"min_amount,omitempty" validate:"omitempty"`
+}
+
+// parseMinAmount parses and validates the tier minimum amount
+func (t CreatePriceTier) parseMinAmount() (*decimal.Decimal, error) {
+	if t.MinAmount == nil {
+		return nil, nil
+	}
+
+	minAmount, err := decimal.NewFromString(*t.MinAmount)
+	if err != nil {
+		return nil, ierr.NewError("invalid tier min amount format").
+			WithHint("Tier min amount must be a valid decimal number").
+			WithReportableDetails(map[string]interface{}{
+				"min_amount": t.MinAmount,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if minAmount.LessThan(decimal.Zero) {
+		return nil, ierr.NewError("tier min amount cannot be negative").
+			WithHint("Tier min amount cannot be negative").
+			WithReportableDetails(map[string]interface{}{
+				"min_amount": t.MinAmount,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return &minAmount, nil
 }
 
 // TODO : add all price validations
@@ -272,6 +306,33 @@ func (r *CreatePriceRequest) Validate() error {
 				}).
 				Mark(ierr.ErrValidation)
 		}
+
+	case types.BILLING_MODEL_MATRIX:
+		if r.Type != types.PRICE_TYPE_USAGE {
+			return ierr.NewError("matrix pricing is only supported for usage prices").
+				WithHint("Please set the price type to USAGE to set up matrix pricing").
+				Mark(ierr.ErrValidation)
+		}
+
+		if r.PriceUnitConfig != nil {
+			return ierr.NewError("price_unit_config is not supported when billing model is MATRIX").
+				WithHint("Matrix cell unit amounts must be provided in the price currency").
+				Mark(ierr.ErrValidation)
+		}
+
+		if err := r.Matrix.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if r.Matrix != nil && r.BillingModel != types.BILLING_MODEL_MATRIX {
+		return ierr.NewError("matrix can only be provided when billing model is MATRIX").
+			WithHint("Please set the billing model to MATRIX or remove the matrix").
+			Mark(ierr.ErrValidation)
+	}
+
+	if err := r.validateChargeLimits(); err != nil {
+		return err
 	}
 
 	switch r.Type {
@@ -492,6 +553,64 @@ func (r *CreatePriceRequest) Validate() error {
 	return nil
 }
 
+// validateChargeLimits validates the price level minimum and maximum charge and the tier minimums
+func (r *CreatePriceRequest) validateChargeLimits() error {
+	if r.MinCharge != nil || r.MaxCharge != nil {
+		if r.Type != types.PRICE_TYPE_USAGE {
+			return ierr.NewError("min_charge and max_charge are only supported for usage prices").
+				WithHint("Please remove min_charge and max_charge or set the price type to USAGE").
+				Mark(ierr.ErrValidation)
+		}
+
+		if r.MinCharge != nil && r.MinCharge.LessThan(decimal.Zero) {
+			return ierr.NewError("min_charge cannot be negative").
+				WithHint("Please provide a non-negative minimum charge").
+				WithReportableDetails(map[string]interface{}{
+					"min_charge": r.MinCharge.String(),
+				}).
+				Mark(ierr.ErrValidation)
+		}
+
+		if r.MaxCharge != nil && !r.MaxCharge.GreaterThan(decimal.Zero) {
+			return ierr.NewError("max_charge must be greater than 0").
+				WithHint("Please provide a positive maximum charge").
+				WithReportableDetails(map[string]interface{}{
+					"max_charge": r.MaxCharge.String(),
+				}).
+				Mark(ierr.ErrValidation)
+		}
+
+		if r.MinCharge != nil && r.MaxCharge != nil && r.MinCharge.GreaterThan(*r.MaxCharge) {
+			return ierr.NewError("min_charge cannot be greater than max_charge").
+				WithHint("Please provide a minimum charge lower than the maximum charge").
+				WithReportableDetails(map[string]interface{}{
+					"min_charge": r.MinCharge.String(),
+					"max_charge": r.MaxCharge.String(),
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	tiers := r.Tiers
+	if r.PriceUnitConfig != nil {
+		tiers = append(tiers, r.PriceUnitConfig.PriceUnitTiers...)
+	}
+
+	for _, tier := range tiers {
+		if tier.MinAmount != nil && r.BillingModel != types.BILLING_MODEL_TIERED {
+			return ierr.NewError("tier min_amount is only supported when billing model is TIERED").
+				WithHint("Please remove min_amount from the tiers").
+				Mark(ierr.ErrValidation)
+		}
+
+		if _, err := tier.parseMinAmount(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (r *CreatePriceRequest) ToPrice(ctx context.Context) (*priceDomain.Price, error) {
 	// Ensure price unit type is set to FIAT if not provided
 	if r.PriceUnitType == "" {
@@ -559,10 +678,16 @@ func (r *CreatePriceRequest) ToPrice(ctx context.Context) (*priceDomain.Price, e
 				flatAmount = &parsed
 			}
 
+			minAmount, err := tier.parseMinAmount()
+			if err != nil {
+				return nil, err
+			}
+
 			priceTiers[i] = priceDomain.PriceTier{
 				UpTo:       tier.UpTo,
 				UnitAmount: unitAmount,
 				FlatAmount: flatAmount,
+				MinAmount:  minAmount,
 			}
 		}
 
@@ -597,10 +722,16 @@ func (r *CreatePriceRequest) ToPrice(ctx context.Context) (*priceDomain.Price, e
 				flatAmount = &parsed
 			}
 
+			minAmount, err := tier.parseMinAmount()
+			if err != nil {
+				return nil, err
+			}
+
 			priceTiers[i] = priceDomain.PriceTier{
 				UpTo:       tier.UpTo,
 				UnitAmount: unitAmount,
 				FlatAmount: flatAmount,
+				MinAmount:  minAmount,
 			}
 		}
 
@@ -633,6 +764,9 @@ func (r *CreatePriceRequest) ToPrice(ctx context.Context) (*priceDomain.Price, e
 		Tiers:              tiers,
 		PriceUnitTiers:     priceUnitTiers,
 		TransformQuantity:  transformQuantity,
+		Matrix:             r.Matrix,
+		MinCharge:          r.MinCharge,
+		MaxCharge:          r.MaxCharge,
 		EntityType:         r.EntityType,
 		EntityID:           r.EntityID,
 		StartDate:          startDate,
@@ -669,6 +803,15 @@ type UpdatePriceRequest struct {
 	// TransformQuantity determines how to transform the quantity for this line item
 	TransformQuantity *price.TransformQuantity `json:"transform_quantity,omitempty"`
 
+	// Matrix determines the rate table for this line item in case of MATRIX billing model
+	Matrix *types.PriceMatrix `json:"matrix,omitempty"`
+
+	// MinCharge is the new minimum amount charged for the price in a billing period
+	MinCharge *decimal.Decimal `json:"min_charge,omitempty"`
+
+	// MaxCharge is the new cap on the amount charged for the price in a billing period
+	MaxCharge *decimal.Decimal `json:"max_charge,omitempty"`
+
 	// GroupID is the id of the group to update the price in
 	GroupID string `json:"group_id,omitempty"`
 }
@@ -677,7 +820,7 @@ func (r *UpdatePriceRequest) Validate() error {
 	// If EffectiveFrom is provided, at least one critical field must be present
 	if r.EffectiveFrom != nil && !r.ShouldCreateNewPrice() {
 		return ierr.NewError("effective_from requires at least one critical field").
-			WithHint("When providing effective_from, you must also provide one of: amount, billing_model, tier_mode, tiers, or transform_quantity").
+			WithHint("When providing effective_from, you must also provide one of: amount, billing_model, tier_mode, tiers, transform_quantity, matrix, min_charge or max_charge").
 			Mark(ierr.ErrValidation)
 	}
 
@@ -696,7 +839,10 @@ func (r *UpdatePriceRequest) ShouldCreateNewPrice() bool {
 		r.Amount != nil ||
 		r.TierMode != "" ||
 		len(r.Tiers) > 0 ||
-		r.TransformQuantity != nil
+		r.TransformQuantity != nil ||
+		r.Matrix != nil ||
+		r.MinCharge != nil ||
+		r.MaxCharge != nil
 }
 
 // ToCreatePriceRequest converts the update request to a create request for the new price
@@ -720,6 +866,16 @@ func (r *UpdatePriceRequest) ToCreatePriceRequest(existingPrice *price.Price) Cr
 	createReq.MeterID = existingPrice.MeterID
 	createReq.ParentPriceID = existingPrice.GetRootPriceID()
 
+	// Charge limits apply to every billing model
+	createReq.MinCharge = existingPrice.MinCharge
+	if r.MinCharge != nil {
+		createReq.MinCharge = r.MinCharge
+	}
+	createReq.MaxCharge = existingPrice.MaxCharge
+	if r.MaxCharge != nil {
+		createReq.MaxCharge = r.MaxCharge
+	}
+
 	// GroupID is the id of the group to update the price in
 	if r.GroupID != "" {
 		createReq.GroupID = r.GroupID
@@ -780,8 +936,20 @@ func (r *UpdatePriceRequest) ToCreatePriceRequest(existingPrice *price.Price) Cr
 					flatAmountStr := tier.FlatAmount.String()
 					createReq.Tiers[i].FlatAmount = &flatAmountStr
 				}
+				if tier.MinAmount != nil {
+					minAmountStr := tier.MinAmount.String()
+					createReq.Tiers[i].MinAmount = &minAmountStr
+				}
 			}
 		}
+
+	case types.BILLING_MODEL_MATRIX:
+		// For MATRIX, only the rate table is relevant
+		if r.Matrix != nil {
+			createReq.Matrix = r.Matrix
+		} else {
+			createReq.Matrix = existingPrice.Matrix
+		}
 	}
 
 	// Apply non-critical field updates from request
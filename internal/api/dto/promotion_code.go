@@ -0,0 +1,146 @@
+package dto
+
+import (
+	"context"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/domain/promotion_code"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// CreatePromotionCodeRequest represents the request to create a single promotion code for a coupon
+type CreatePromotionCodeRequest struct {
+	CouponID       string            `json:"coupon_id" validate:"required"`
+	Code           string            `json:"code" validate:"required"`
+	CustomerID     *string           `json:"customer_id,omitempty"`
+	ExpiresAt      *time.Time        `json:"expires_at,omitempty"`
+	MaxRedemptions *int              `json:"max_redemptions,omitempty"`
+	Metadata       map[string]string `json:"metadata,omitempty"`
+}
+
+// Validate validates the CreatePromotionCodeRequest
+func (r *CreatePromotionCodeRequest) Validate() error {
+	if r.CouponID == "" {
+		return ierr.NewError("coupon_id is required").
+			WithHint("Please provide a valid coupon ID").
+			Mark(ierr.ErrValidation)
+	}
+
+	r.Code = types.NormalizePromotionCode(r.Code)
+	if err := types.ValidatePromotionCode(r.Code); err != nil {
+		return err
+	}
+
+	return validatePromotionCodeLimits(r.ExpiresAt, r.MaxRedemptions)
+}
+
+// ToPromotionCode converts the request to a domain PromotionCode
+func (r *CreatePromotionCodeRequest) ToPromotionCode(ctx context.Context) *promotion_code.PromotionCode {
+	return &promotion_code.PromotionCode{
+		ID:             types.GenerateUUIDWithPrefix(types.UUID_PREFIX_PROMOTION_CODE),
+		CouponID:       r.CouponID,
+		Code:           r.Code,
+		CustomerID:     r.CustomerID,
+		ExpiresAt:      r.ExpiresAt,
+		MaxRedemptions: r.MaxRedemptions,
+		Metadata:       r.Metadata,
+		EnvironmentID:  types.GetEnvironmentID(ctx),
+		BaseModel:      types.GetDefaultBaseModel(ctx),
+	}
+}
+
+// BulkGeneratePromotionCodesRequest represents the request to generate many random promotion codes for a coupon
+type BulkGeneratePromotionCodesRequest struct {
+	CouponID string `json:"coupon_id" validate:"required"`
+	// Count is the number of codes to generate
+	Count int `json:"count" validate:"required,min=1,max=10000"`
+	// Prefix is prepended to every generated code, e.g. SUMMER24-
+	Prefix string `json:"prefix,omitempty"`
+	// Length is the length of the random part of each code, defaults to 8
+	Length int `json:"length,omitempty" validate:"omitempty,min=4,max=32"`
+	// MaxRedemptions applies to each generated code, defaults to a single redemption per code
+	MaxRedemptions *int              `json:"max_redemptions,omitempty"`
+	ExpiresAt      *time.Time        `json:"expires_at,omitempty"`
+	Metadata       map[string]string `json:"metadata,omitempty"`
+}
+
+// Validate validates the BulkGeneratePromotionCodesRequest
+func (r *BulkGeneratePromotionCodesRequest) Validate() error {
+	if r.CouponID == "" {
+		return ierr.NewError("coupon_id is required").
+			WithHint("Please provide a valid coupon ID").
+			Mark(ierr.ErrValidation)
+	}
+
+	if r.Count <= 0 || r.Count > types.PromotionCodeMaxBulkGenerateCount {
+		return ierr.NewError("invalid count").
+			WithHintf("Count must be between 1 and %d", types.PromotionCodeMaxBulkGenerateCount).
+			WithReportableDetails(map[string]interface{}{
+				"count": r.Count,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if r.Length == 0 {
+		r.Length = types.PromotionCodeDefaultGeneratedLength
+	}
+	if r.Length < 4 || r.Length > 32 {
+		return ierr.NewError("invalid length").
+			WithHint("Length must be between 4 and 32").
+			Mark(ierr.ErrValidation)
+	}
+
+	r.Prefix = types.NormalizePromotionCode(r.Prefix)
+	if r.Prefix != "" {
+		// The prefix has to form a valid code together with the random part
+		if err := types.ValidatePromotionCode(r.Prefix + "XXX"); err != nil {
+			return ierr.WithError(err).
+				WithHint("Prefix may contain only letters, digits, '-' and '_'").
+				Mark(ierr.ErrValidation)
+		}
+		if len(r.Prefix)+r.Length > 100 {
+			return ierr.NewError("promotion codes too long").
+				WithHint("Prefix and length together must not exceed 100 characters").
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	if r.MaxRedemptions == nil {
+		r.MaxRedemptions = lo.ToPtr(1)
+	}
+
+	return validatePromotionCodeLimits(r.ExpiresAt, r.MaxRedemptions)
+}
+
+func validatePromotionCodeLimits(expiresAt *time.Time, maxRedemptions *int) error {
+	if maxRedemptions != nil && *maxRedemptions <= 0 {
+		return ierr.NewError("max_redemptions must be greater than zero").
+			WithHint("Please provide a valid maximum redemption count").
+			Mark(ierr.ErrValidation)
+	}
+
+	if expiresAt != nil && expiresAt.Before(time.Now()) {
+		return ierr.NewError("expires_at must be in the future").
+			WithHint("Please provide an expiry date in the future").
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+// PromotionCodeResponse represents the response for promotion code data
+type PromotionCodeResponse struct {
+	*promotion_code.PromotionCode `json:",inline"`
+}
+
+// ListPromotionCodesResponse represents the response for listing promotion codes
+type ListPromotionCodesResponse = types.ListResponse[*PromotionCodeResponse]
+
+// BulkGeneratePromotionCodesResponse represents the response for bulk generated promotion codes
+type BulkGeneratePromotionCodesResponse struct {
+	CouponID string                   `json:"coupon_id"`
+	Count    int                      `json:"count"`
+	Items    []*PromotionCodeResponse `json:"items"`
+}
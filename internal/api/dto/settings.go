@@ -2,6 +2,7 @@ package dto
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"time"
 
@@ -75,6 +76,46 @@ func ConvertToInvoiceConfig(value map[string]interface{}) (*types.InvoiceConfig,
 	return invoiceConfig, nil
 }
 
+func ConvertToCustomerConfig(value map[string]interface{}) *types.CustomerConfig {
+	customerConfig := &types.CustomerConfig{}
+	if autoCreate, ok := value["auto_create_on_event"].(bool); ok {
+		customerConfig.AutoCreateOnEvent = autoCreate
+	}
+	if defaultPlanID, ok := value["default_plan_id"].(string); ok {
+		customerConfig.DefaultPlanID = defaultPlanID
+	}
+	return customerConfig
+}
+
+func ConvertToMetricsIngestionConfig(value map[string]interface{}) (*types.MetricsIngestionConfig, error) {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &types.MetricsIngestionConfig{}
+	if err := json.Unmarshal(raw, config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+func ConvertToDunningConfig(value map[string]interface{}) (*types.DunningConfig, error) {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &types.DunningConfig{}
+	if err := json.Unmarshal(raw, config); err != nil {
+		return nil, err
+	}
+	if config.FinalAction == "" {
+		config.FinalAction = types.DunningFinalActionMarkUnpaid
+	}
+	return config, nil
+}
+
 // CreateSettingRequest represents the request to create a new setting
 type CreateSettingRequest struct {
 	Key   string                 `json:"key" validate:"required,min=1,max=255"`
@@ -183,13 +183,13 @@ func (r *CreateSetupIntentRequest) Validate() error {
 	}
 
 	switch r.Provider {
-	case string(types.PaymentMethodProviderStripe):
+	case string(types.PaymentMethodProviderStripe), string(types.PaymentMethodProviderRazorpay):
 	default:
 		return errors.NewError("unsupported payment provider").
-			WithHint("Currently only 'stripe' provider is supported").
+			WithHint("Supported providers are 'stripe' and 'razorpay'").
 			WithReportableDetails(map[string]interface{}{
 				"provider":            r.Provider,
-				"supported_providers": []types.PaymentMethodProvider{types.PaymentMethodProviderStripe},
+				"supported_providers": []types.PaymentMethodProvider{types.PaymentMethodProviderStripe, types.PaymentMethodProviderRazorpay},
 			}).
 			Mark(errors.ErrValidation)
 	}
@@ -48,13 +48,18 @@ type CreateSubscriptionRequest struct {
 	CommitmentAmount *decimal.Decimal `json:"commitment_amount,omitempty"`
 	// OverageFactor is a multiplier applied to usage beyond the commitment amount
 	OverageFactor *decimal.Decimal `json:"overage_factor,omitempty"`
+	// CommitmentType determines if the commitment applies to each billing period (billing_period, default)
+	// or is drawn down across the billing periods of a commitment term (prepaid or postpaid)
+	CommitmentType types.CommitmentType `json:"commitment_type,omitempty"`
+	// CommitmentDuration is the length of the commitment term for prepaid and postpaid commitments, defaults to ANNUAL
+	CommitmentDuration *types.BillingPeriod `json:"commitment_duration,omitempty"`
 	// Phases represents an optional timeline of subscription phases
 	Phases []SubscriptionSchedulePhaseInput `json:"phases,omitempty" validate:"omitempty,dive"`
 	// tax_rate_overrides is the tax rate overrides	to be applied to the subscription
 	TaxRateOverrides []*TaxRateOverride `json:"tax_rate_overrides,omitempty"`
-	// SubscriptionCoupons is a list of coupon IDs to be applied to the subscription
+	// SubscriptionCoupons is a list of coupon IDs or promotion codes to be applied to the subscription
 	Coupons []string `json:"coupons,omitempty"`
-	// SubscriptionLineItemsCoupons is a list of coupon IDs to be applied to the subscription line items
+	// SubscriptionLineItemsCoupons is a list of coupon IDs or promotion codes to be applied to the subscription line items
 	LineItemCoupons map[string][]string `json:"line_item_coupons,omitempty"`
 	// OverrideLineItems allows customizing specific prices for this subscription
 	OverrideLineItems []OverrideLineItemRequest `json:"override_line_items,omitempty" validate:"omitempty,dive"`
@@ -80,6 +85,9 @@ type CreateSubscriptionRequest struct {
 	// If not set, the default value is UTC.
 	CustomerTimezone string `json:"customer_timezone" validate:"omitempty,timezone"`
 
+	// UsageAlerts are spend and usage thresholds evaluated against the current billing period
+	UsageAlerts []types.UsageAlert `json:"usage_alerts,omitempty"`
+
 	//Billing Anchor
 	BillingAnchor *time.Time `json:"-"`
 
@@ -107,6 +115,15 @@ type UpdateSubscriptionRequest struct {
 	CancelAtPeriodEnd bool                     `json:"cancel_at_period_end,omitempty"`
 }
 
+// UpdateSubscriptionUsageAlertsRequest replaces the usage alerts of a subscription
+type UpdateSubscriptionUsageAlertsRequest struct {
+	UsageAlerts []types.UsageAlert `json:"usage_alerts"`
+}
+
+func (r *UpdateSubscriptionUsageAlertsRequest) Validate() error {
+	return types.ValidateUsageAlerts(r.UsageAlerts)
+}
+
 // CancelSubscriptionRequest represents the enhanced cancellation request
 type CancelSubscriptionRequest struct {
 
@@ -355,6 +372,41 @@ func (r *CreateSubscriptionRequest) Validate() error {
 			Mark(ierr.ErrValidation)
 	}
 
+	if r.CommitmentType != "" {
+		if err := r.CommitmentType.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if r.CommitmentType.IsTermCommitment() {
+		if r.CommitmentAmount == nil || !r.CommitmentAmount.IsPositive() {
+			return ierr.NewError("commitment_amount is required for term commitments").
+				WithHint("Please provide a commitment amount greater than 0 for prepaid and postpaid commitments").
+				WithReportableDetails(map[string]interface{}{
+					"commitment_type": r.CommitmentType,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+
+		if r.CommitmentDuration != nil {
+			if err := r.CommitmentDuration.Validate(); err != nil {
+				return err
+			}
+		}
+	} else if r.CommitmentDuration != nil {
+		return ierr.NewError("commitment_duration is only allowed for term commitments").
+			WithHint("Please set the commitment type to prepaid or postpaid or remove the commitment duration").
+			WithReportableDetails(map[string]interface{}{
+				"commitment_type":     r.CommitmentType,
+				"commitment_duration": *r.CommitmentDuration,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if err := types.ValidateUsageAlerts(r.UsageAlerts); err != nil {
+		return err
+	}
+
 	// Validate credit grants if provided
 	if len(r.CreditGrants) > 0 {
 		for i, grant := range r.CreditGrants {
@@ -654,6 +706,17 @@ func (r *CreateSubscriptionRequest) ToSubscription(ctx context.Context) *subscri
 		sub.OverageFactor = lo.ToPtr(decimal.NewFromInt(1)) // Default value
 	}
 
+	sub.CommitmentType = types.CommitmentTypeBillingPeriod
+	if r.CommitmentType != "" {
+		sub.CommitmentType = r.CommitmentType
+	}
+
+	if sub.CommitmentType.IsTermCommitment() {
+		sub.CommitmentDuration = lo.ToPtr(lo.FromPtrOr(r.CommitmentDuration, types.BILLING_PERIOD_ANNUAL))
+	}
+
+	sub.UsageAlerts = r.UsageAlerts
+
 	return sub
 }
 
@@ -951,11 +1014,32 @@ type SubscriptionUsageByMetersResponse struct {
 	DisplayAmount    string             `json:"display_amount"`
 	Quantity         float64            `json:"quantity"`
 	FilterValues     price.JSONBFilters `json:"filter_values"`
+	DimensionValues  map[string]string  `json:"dimension_values,omitempty"` // Matrix cell of the charge in case of MATRIX billing model
 	MeterID          string             `json:"meter_id"`
 	MeterDisplayName string             `json:"meter_display_name"`
 	Price            *price.Price       `json:"price"`
 	IsOverage        bool               `json:"is_overage"`               // Whether this charge is at overage rate
 	OverageFactor    float64            `json:"overage_factor,omitempty"` // Factor applied to this charge if in overage
+	CustomerID       string             `json:"customer_id,omitempty"`    // Child customer of the usage in case of parent billing
+	CustomerName     string             `json:"customer_name,omitempty"`  // Name of the child customer of the usage
+}
+
+// SubscriptionCommitmentResponse represents the commitment of a subscription for the current commitment term,
+// which is the current billing period for billing_period commitments
+type SubscriptionCommitmentResponse struct {
+	SubscriptionID      string               `json:"subscription_id"`
+	CommitmentType      types.CommitmentType `json:"commitment_type"`
+	CommitmentAmount    decimal.Decimal      `json:"commitment_amount"`
+	OverageFactor       decimal.Decimal      `json:"overage_factor"`
+	Currency            string               `json:"currency"`
+	CommitmentStartDate time.Time            `json:"commitment_start_date"`
+	CommitmentEndDate   time.Time            `json:"commitment_end_date"`
+	// CommitmentInvoiced is the commitment drawn down by the usage of the already invoiced billing periods of the term
+	CommitmentInvoiced decimal.Decimal `json:"commitment_invoiced"`
+	// CommitmentUtilized is the commitment drawn down so far, including the usage of the current billing period
+	CommitmentUtilized decimal.Decimal `json:"commitment_utilized"`
+	// CommitmentRemaining is the commitment left to be drawn down in the term
+	CommitmentRemaining decimal.Decimal `json:"commitment_remaining"`
 }
 
 type SubscriptionUpdatePeriodResponse struct {
@@ -440,6 +440,51 @@ func (r *TopUpWalletRequest) Validate() error {
 	return nil
 }
 
+// TransferWalletRequest represents a request to transfer credits from one wallet to another
+type TransferWalletRequest struct {
+	// to_wallet_id is the id of the wallet receiving the credits
+	ToWalletID string `json:"to_wallet_id" binding:"required"`
+	// credits_to_transfer is the number of credits to debit from the source wallet
+	// the destination wallet is credited with the same currency amount
+	// converted using its own conversion_rate
+	CreditsToTransfer decimal.Decimal `json:"credits_to_transfer" binding:"required"`
+	// idempotency_key is a unique key for the transfer
+	IdempotencyKey *string `json:"idempotency_key,omitempty"`
+	// description to add any specific details about the transfer
+	Description string `json:"description,omitempty"`
+	// metadata is a map of key-value pairs to store any additional information about the transfer
+	Metadata types.Metadata `json:"metadata,omitempty"`
+}
+
+func (r *TransferWalletRequest) Validate() error {
+	if r.ToWalletID == "" {
+		return ierr.NewError("to_wallet_id is required").
+			WithHint("Destination wallet ID is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	if r.CreditsToTransfer.LessThanOrEqual(decimal.Zero) {
+		return ierr.NewError("credits_to_transfer must be greater than 0").
+			WithHint("Credits to transfer must be a positive value").
+			WithReportableDetails(map[string]interface{}{
+				"credits_to_transfer": r.CreditsToTransfer,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+// WalletTransferResponse represents the response for a wallet to wallet transfer
+type WalletTransferResponse struct {
+	TransferID string `json:"transfer_id"`
+	// debit_transaction is the transaction debiting the source wallet
+	DebitTransaction *WalletTransactionResponse `json:"debit_transaction"`
+	// credit_transactions are the transactions crediting the destination wallet,
+	// one for each expiry date of the transferred credits
+	CreditTransactions []*WalletTransactionResponse `json:"credit_transactions"`
+}
+
 // WalletBalanceResponse represents the response for getting wallet balance
 type WalletBalanceResponse struct {
 	*wallet.Wallet
@@ -41,6 +41,7 @@ type Handlers struct {
 	CreditNote               *v1.CreditNoteHandler
 	Tax                      *v1.TaxHandler
 	Coupon                   *v1.CouponHandler
+	PromotionCode            *v1.PromotionCodeHandler
 	PriceUnit                *v1.PriceUnitHandler
 	Webhook                  *v1.WebhookHandler
 	Addon                    *v1.AddonHandler
@@ -48,6 +49,10 @@ type Handlers struct {
 	Settings                 *v1.SettingsHandler
 	SetupIntent              *v1.SetupIntentHandler
 	Group                    *v1.GroupHandler
+	EventSchema              *v1.EventSchemaHandler
+	MetricsIngestion         *v1.MetricsIngestionHandler
+	ForwardedEvents          *v1.ForwardedEventsHandler
+	UsageStream              *v1.UsageStreamHandler
 	ScheduledTask            *v1.ScheduledTaskHandler
 
 	// Portal handlers
@@ -94,6 +99,8 @@ func NewRouter(handlers Handlers, cfg *config.Configuration, logger *logger.Logg
 		// Auth routes
 		v1Public.POST("/auth/signup", handlers.Auth.SignUp)
 		v1Public.POST("/auth/login", handlers.Auth.Login)
+		// Events forwarded by the http forwarder of another deployment, authenticated by their signature
+		v1Public.POST("/events/forwarded", handlers.ForwardedEvents.ReceiveForwardedEvents)
 	}
 
 	private := router.Group("/", middleware.AuthenticateMiddleware(cfg, secretService, logger))
@@ -120,10 +127,19 @@ func NewRouter(handlers Handlers, cfg *config.Configuration, logger *logger.Logg
 		{
 			events.POST("", handlers.Events.IngestEvent)
 			events.POST("/bulk", handlers.Events.BulkIngestEvent)
+			events.POST("/stream", handlers.Events.StreamIngestEvents)
+			events.POST("/otlp/v1/metrics", handlers.MetricsIngestion.IngestOTLPMetrics)
+			events.POST("/prometheus/write", handlers.MetricsIngestion.IngestPrometheusRemoteWrite)
 			events.GET("", handlers.Events.GetEvents)
 			events.POST("/query", handlers.Events.QueryEvents)
+			events.POST("/rejected", handlers.Events.GetRejectedEvents)
+			events.POST("/dead-letter", handlers.Events.GetDeadLetterEvents)
+			events.POST("/dead-letter/replay", handlers.Events.ReplayDeadLetterEvents)
+			events.POST("/:id/amend", handlers.Events.AmendEvent)
+			events.POST("/:id/retract", handlers.Events.RetractEvent)
 			events.POST("/usage", handlers.Events.GetUsage)
 			events.POST("/usage/meter", handlers.Events.GetUsageByMeter)
+			events.GET("/usage/stream", handlers.UsageStream.StreamUsage)
 			events.POST("/analytics", handlers.Events.GetUsageAnalytics)
 			events.POST("/analytics-v2", handlers.Events.GetUsageAnalyticsV2)
 		}
@@ -176,6 +192,7 @@ func NewRouter(handlers Handlers, cfg *config.Configuration, logger *logger.Logg
 			customer.GET("/:id/entitlements", handlers.Customer.GetCustomerEntitlements)
 			customer.GET("/usage", handlers.Customer.GetCustomerUsageSummary)     // New route with query parameters (must come first!)
 			customer.GET("/:id/usage", handlers.Customer.GetCustomerUsageSummary) // Deprecated route with path parameter
+			customer.POST("/entitlements/check", handlers.Customer.CheckEntitlement)
 
 			// other routes for customer
 			customer.GET("/:id/wallets", handlers.Wallet.GetWalletsByCustomerID)
@@ -222,6 +239,15 @@ func NewRouter(handlers Handlers, cfg *config.Configuration, logger *logger.Logg
 			group.DELETE("/:id", handlers.Group.DeleteGroup)
 		}
 
+		eventSchema := v1Private.Group("/event-schemas")
+		{
+			eventSchema.POST("", handlers.EventSchema.CreateEventSchema)
+			eventSchema.POST("/search", handlers.EventSchema.ListEventSchemas)
+			eventSchema.GET("/:id", handlers.EventSchema.GetEventSchema)
+			eventSchema.PUT("/:id", handlers.EventSchema.UpdateEventSchema)
+			eventSchema.DELETE("/:id", handlers.EventSchema.DeleteEventSchema)
+		}
+
 		subscription := v1Private.Group("/subscriptions")
 		{
 			subscription.POST("/search", handlers.Subscription.ListSubscriptionsByFilter)
@@ -229,6 +255,8 @@ func NewRouter(handlers Handlers, cfg *config.Configuration, logger *logger.Logg
 			subscription.GET("", handlers.Subscription.GetSubscriptions)
 			subscription.GET("/:id", handlers.Subscription.GetSubscription)
 			subscription.POST("/:id/cancel", handlers.Subscription.CancelSubscription)
+			subscription.GET("/:id/commitment", handlers.Subscription.GetSubscriptionCommitment)
+			subscription.PUT("/:id/usage-alerts", handlers.Subscription.UpdateSubscriptionUsageAlerts)
 			subscription.POST("/usage", handlers.Subscription.GetUsageBySubscription)
 
 			subscription.POST("/:id/pause", handlers.SubscriptionPause.PauseSubscription)
@@ -255,6 +283,7 @@ func NewRouter(handlers Handlers, cfg *config.Configuration, logger *logger.Logg
 			wallet.GET("/:id", handlers.Wallet.GetWalletByID)
 			wallet.GET("/:id/transactions", handlers.Wallet.GetWalletTransactions)
 			wallet.POST("/:id/top-up", handlers.Wallet.TopUpWallet)
+			wallet.POST("/:id/transfer", handlers.Wallet.TransferWallet)
 			wallet.POST("/:id/terminate", handlers.Wallet.TerminateWallet)
 			wallet.GET("/:id/balance/real-time", handlers.Wallet.GetWalletBalance)
 			wallet.GET("/:id/balance/real-time-v2", handlers.Wallet.GetWalletBalanceV2)
@@ -447,6 +476,17 @@ func NewRouter(handlers Handlers, cfg *config.Configuration, logger *logger.Logg
 			coupon.POST("/search", handlers.Coupon.ListCouponsByFilter)
 		}
 
+		// Promotion code routes
+		promotionCode := v1Private.Group("/promotion-codes")
+		{
+			promotionCode.POST("", handlers.PromotionCode.CreatePromotionCode)
+			promotionCode.POST("/bulk", handlers.PromotionCode.BulkGeneratePromotionCodes)
+			promotionCode.GET("", handlers.PromotionCode.ListPromotionCodes)
+			promotionCode.GET("/export", handlers.PromotionCode.ExportPromotionCodes)
+			promotionCode.GET("/:id", handlers.PromotionCode.GetPromotionCode)
+			promotionCode.DELETE("/:id", handlers.PromotionCode.DeletePromotionCode)
+		}
+
 		// Admin routes (API Key only)
 		adminRoutes := v1Private.Group("/admin")
 		adminRoutes.Use(middleware.APIKeyAuthMiddleware(cfg, secretService, logger))
@@ -478,6 +518,8 @@ func NewRouter(handlers Handlers, cfg *config.Configuration, logger *logger.Logg
 		webhooks.POST("/stripe/:tenant_id/:environment_id", handlers.Webhook.HandleStripeWebhook)
 		// HubSpot webhook endpoint: POST /v1/webhooks/hubspot/{tenant_id}/{environment_id}
 		webhooks.POST("/hubspot/:tenant_id/:environment_id", handlers.Webhook.HandleHubSpotWebhook)
+		// Razorpay webhook endpoint: POST /v1/webhooks/razorpay/{tenant_id}/{environment_id}
+		webhooks.POST("/razorpay/:tenant_id/:environment_id", handlers.Webhook.HandleRazorpayWebhook)
 	}
 
 	// Cron routes
@@ -489,6 +531,7 @@ func NewRouter(handlers Handlers, cfg *config.Configuration, logger *logger.Logg
 		subscriptionGroup.POST("/update-periods", handlers.CronSubscription.UpdateBillingPeriods)
 		subscriptionGroup.POST("/process-auto-cancellation", handlers.CronSubscription.ProcessAutoCancellationSubscriptions)
 		subscriptionGroup.POST("/renewal-due-alerts", handlers.CronSubscription.ProcessSubscriptionRenewalDueAlerts)
+		subscriptionGroup.POST("/check-usage-alerts", handlers.CronSubscription.ProcessSubscriptionUsageAlerts)
 	}
 
 	// Wallet related cron jobs
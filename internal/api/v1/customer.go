@@ -234,6 +234,36 @@ func (h *CustomerHandler) GetCustomerEntitlements(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
+// @Summary Check customer entitlement
+// @Description Check if a customer can use the requested quantity of a feature. The response tells if the usage is allowed and the quantity remaining within the usage limit. When reserve is set, the allowed quantity is counted as in-flight usage until it is aggregated.
+// @Tags Customers
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body dto.CheckEntitlementRequest true "Entitlement check request"
+// @Success 200 {object} dto.CheckEntitlementResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /customers/entitlements/check [post]
+func (h *CustomerHandler) CheckEntitlement(c *gin.Context) {
+	var req dto.CheckEntitlementRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	response, err := h.billing.CheckEntitlement(c.Request.Context(), &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
 // @Summary Get customer usage summary
 // @Description Get customer usage summary by customer_id or customer_lookup_key (external_customer_id)
 // @Tags Customers
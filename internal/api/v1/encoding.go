@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"compress/gzip"
+	"io"
+	"strings"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/gin-gonic/gin"
+	"github.com/klauspost/compress/zstd"
+)
+
+// decodedRequestBody returns the request body decompressed according to its Content-Encoding header,
+// gzip and zstd are supported. The body is streamed, callers must close the returned reader.
+func decodedRequestBody(c *gin.Context) (io.ReadCloser, error) {
+	encoding := strings.ToLower(strings.TrimSpace(c.GetHeader("Content-Encoding")))
+	switch encoding {
+	case "", "identity":
+		return c.Request.Body, nil
+	case "gzip":
+		reader, err := gzip.NewReader(c.Request.Body)
+		if err != nil {
+			return nil, ierr.WithError(err).
+				WithHint("Request body is not valid gzip").
+				Mark(ierr.ErrValidation)
+		}
+		return reader, nil
+	case "zstd":
+		reader, err := zstd.NewReader(c.Request.Body)
+		if err != nil {
+			return nil, ierr.WithError(err).
+				WithHint("Request body is not valid zstd").
+				Mark(ierr.ErrValidation)
+		}
+		return reader.IOReadCloser(), nil
+	default:
+		return nil, ierr.NewErrorf("unsupported content encoding: %s", encoding).
+			WithHint("Content-Encoding must be gzip or zstd").
+			Mark(ierr.ErrValidation)
+	}
+}
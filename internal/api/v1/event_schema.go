@@ -0,0 +1,154 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/service"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/gin-gonic/gin"
+)
+
+type EventSchemaHandler struct {
+	service service.EventSchemaService
+	log     *logger.Logger
+}
+
+func NewEventSchemaHandler(service service.EventSchemaService, log *logger.Logger) *EventSchemaHandler {
+	return &EventSchemaHandler{service: service, log: log}
+}
+
+// @Summary Create an event schema
+// @Description Register the expected properties of an event name, events are validated against it during ingestion
+// @Tags Event Schemas
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param event_schema body dto.CreateEventSchemaRequest true "Event schema"
+// @Success 201 {object} dto.EventSchemaResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 409 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /event-schemas [post]
+func (h *EventSchemaHandler) CreateEventSchema(c *gin.Context) {
+	var req dto.CreateEventSchemaRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.service.CreateEventSchema(c.Request.Context(), req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, resp)
+}
+
+// @Summary Get an event schema
+// @Description Get an event schema by ID
+// @Tags Event Schemas
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Event schema ID"
+// @Success 200 {object} dto.EventSchemaResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /event-schemas/{id} [get]
+func (h *EventSchemaHandler) GetEventSchema(c *gin.Context) {
+	resp, err := h.service.GetEventSchema(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary Update an event schema
+// @Description Update the properties or validation mode of an event schema
+// @Tags Event Schemas
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Event schema ID"
+// @Param event_schema body dto.UpdateEventSchemaRequest true "Event schema"
+// @Success 200 {object} dto.EventSchemaResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /event-schemas/{id} [put]
+func (h *EventSchemaHandler) UpdateEventSchema(c *gin.Context) {
+	var req dto.UpdateEventSchemaRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.service.UpdateEventSchema(c.Request.Context(), c.Param("id"), req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary Delete an event schema
+// @Description Delete an event schema, events of its event name are no longer validated
+// @Tags Event Schemas
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Event schema ID"
+// @Success 204 "No Content"
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /event-schemas/{id} [delete]
+func (h *EventSchemaHandler) DeleteEventSchema(c *gin.Context) {
+	if err := h.service.DeleteEventSchema(c.Request.Context(), c.Param("id")); err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary List event schemas
+// @Description List event schemas with optional filtering
+// @Tags Event Schemas
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param filter body types.EventSchemaFilter true "Filter"
+// @Success 200 {object} dto.ListEventSchemasResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /event-schemas/search [post]
+func (h *EventSchemaHandler) ListEventSchemas(c *gin.Context) {
+	var filter types.EventSchemaFilter
+	if err := c.ShouldBindJSON(&filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid filter parameters").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if filter.GetLimit() == 0 {
+		filter.QueryFilter = types.NewDefaultQueryFilter()
+	}
+
+	resp, err := h.service.ListEventSchemas(c.Request.Context(), &filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
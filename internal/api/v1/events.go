@@ -21,15 +21,17 @@ type EventsHandler struct {
 	eventService                service.EventService
 	eventPostProcessingService  service.EventPostProcessingService
 	featureUsageTrackingService service.FeatureUsageTrackingService
+	eventCorrectionService      service.EventCorrectionService
 	config                      *config.Configuration
 	log                         *logger.Logger
 }
 
-func NewEventsHandler(eventService service.EventService, eventPostProcessingService service.EventPostProcessingService, featureUsageTrackingService service.FeatureUsageTrackingService, config *config.Configuration, log *logger.Logger) *EventsHandler {
+func NewEventsHandler(eventService service.EventService, eventPostProcessingService service.EventPostProcessingService, featureUsageTrackingService service.FeatureUsageTrackingService, eventCorrectionService service.EventCorrectionService, config *config.Configuration, log *logger.Logger) *EventsHandler {
 	return &EventsHandler{
 		eventService:                eventService,
 		eventPostProcessingService:  eventPostProcessingService,
 		featureUsageTrackingService: featureUsageTrackingService,
+		eventCorrectionService:      eventCorrectionService,
 		config:                      config,
 		log:                         log,
 	}
@@ -79,7 +81,7 @@ func (h *EventsHandler) IngestEvent(c *gin.Context) {
 // @Produce json
 // @Security ApiKeyAuth
 // @Param event body dto.BulkIngestEventRequest true "Event data"
-// @Success 202 {object} map[string]string "message:Event accepted for processing"
+// @Success 202 {object} dto.BulkIngestEventResponse
 // @Failure 400 {object} ierr.ErrorResponse
 // @Failure 500 {object} ierr.ErrorResponse
 // @Router /events/bulk [post]
@@ -94,14 +96,202 @@ func (h *EventsHandler) BulkIngestEvent(c *gin.Context) {
 		return
 	}
 
-	err := h.eventService.BulkCreateEvents(ctx, &req)
+	resp, err := h.eventService.BulkCreateEvents(ctx, &req)
 	if err != nil {
 		h.log.Error("Failed to bulk ingest events", "error", err)
 		c.Error(err)
 		return
 	}
 
-	c.JSON(http.StatusAccepted, gin.H{"message": "Events accepted for processing"})
+	c.JSON(http.StatusAccepted, resp)
+}
+
+// @Summary Stream ingest events
+// @Description Ingest a newline delimited JSON stream of events, one event per line. The body can be gzip or zstd compressed through the Content-Encoding header. Lines are validated as they are read and events are published in batches, lines that fail are reported with their line number.
+// @Tags Events
+// @Accept application/x-ndjson
+// @Produce json
+// @Security ApiKeyAuth
+// @Param Content-Encoding header string false "gzip or zstd"
+// @Success 202 {object} dto.StreamIngestEventResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /events/stream [post]
+func (h *EventsHandler) StreamIngestEvents(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	body, err := decodedRequestBody(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	defer body.Close()
+
+	resp, err := h.eventService.StreamIngestEvents(ctx, body)
+	if err != nil {
+		h.log.Error("Failed to stream ingest events", "error", err)
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, resp)
+}
+
+// @Summary List rejected events
+// @Description List the events that violated the schema of their event name, either rejected or flagged
+// @Tags Events
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body dto.GetRejectedEventsRequest true "Request body"
+// @Success 200 {object} dto.GetRejectedEventsResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /events/rejected [post]
+func (h *EventsHandler) GetRejectedEvents(c *gin.Context) {
+	ctx := c.Request.Context()
+	var req dto.GetRejectedEventsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request payload").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.eventService.GetRejectedEvents(ctx, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary List dead letter events
+// @Description List the events that could not be turned into feature usage along with the reason of the failure
+// @Tags Events
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body dto.GetDeadLetterEventsRequest true "Request body"
+// @Success 200 {object} dto.GetDeadLetterEventsResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /events/dead-letter [post]
+func (h *EventsHandler) GetDeadLetterEvents(c *gin.Context) {
+	ctx := c.Request.Context()
+	var req dto.GetDeadLetterEventsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request payload").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.featureUsageTrackingService.GetDeadLetterEvents(ctx, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary Replay dead letter events
+// @Description Process pending dead letter events again, e.g. after creating the missing customer or price
+// @Tags Events
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body dto.ReplayDeadLetterEventsRequest true "Request body"
+// @Success 200 {object} dto.ReplayDeadLetterEventsResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /events/dead-letter/replay [post]
+func (h *EventsHandler) ReplayDeadLetterEvents(c *gin.Context) {
+	ctx := c.Request.Context()
+	var req dto.ReplayDeadLetterEventsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request payload").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.featureUsageTrackingService.ReplayDeadLetterEvents(ctx, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary Amend event
+// @Description Replace the properties of an ingested event. The event is retracted, a replacement event is ingested and the invoices billing it are re-rated
+// @Tags Events
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Event ID"
+// @Param request body dto.AmendEventRequest true "Request body"
+// @Success 200 {object} dto.EventCorrectionResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 409 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /events/{id}/amend [post]
+func (h *EventsHandler) AmendEvent(c *gin.Context) {
+	var req dto.AmendEventRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request payload").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.eventCorrectionService.AmendEvent(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary Retract event
+// @Description Remove an ingested event from usage and re-rate the invoices billing it
+// @Tags Events
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Event ID"
+// @Param request body dto.RetractEventRequest false "Request body"
+// @Success 200 {object} dto.EventCorrectionResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 409 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /events/{id}/retract [post]
+func (h *EventsHandler) RetractEvent(c *gin.Context) {
+	var req dto.RetractEventRequest
+	// The body is optional, it only carries the reason of the retraction
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.Error(ierr.WithError(err).
+				WithHint("Invalid request payload").
+				Mark(ierr.ErrValidation))
+			return
+		}
+	}
+
+	resp, err := h.eventCorrectionService.RetractEvent(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
 }
 
 // @Summary Get usage by meter
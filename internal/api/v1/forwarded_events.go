@@ -0,0 +1,85 @@
+package v1
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/config"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/httpforwarder"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+type ForwardedEventsHandler struct {
+	service service.EventQueueConsumptionService
+	config  *config.Configuration
+	log     *logger.Logger
+}
+
+func NewForwardedEventsHandler(service service.EventQueueConsumptionService, config *config.Configuration, log *logger.Logger) *ForwardedEventsHandler {
+	return &ForwardedEventsHandler{service: service, config: config, log: log}
+}
+
+// @Summary Receive forwarded events
+// @Description Receive the events posted by the HTTP forwarder of another deployment, the timestamp and the body must be signed with the http_forwarder secret in the X-Flexprice-Signature header. The events are ingested into the configured http_forwarder tenant and environment.
+// @Tags Events
+// @Accept json
+// @Produce json
+// @Param X-Flexprice-Signature header string true "HMAC-SHA256 signature of the timestamp and the body"
+// @Param X-Flexprice-Timestamp header string true "Unix time the request was signed at"
+// @Param request body httpforwarder.ForwardRequest true "Forwarded events"
+// @Success 202 {object} map[string]interface{} "Events processed"
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /events/forwarded [post]
+func (h *ForwardedEventsHandler) ReceiveForwardedEvents(c *gin.Context) {
+	cfg := h.config.HTTPForwarder
+	if cfg.Secret == "" || cfg.TenantID == "" {
+		c.Error(ierr.NewError("forwarded events are not accepted").
+			WithHint("Configure the http_forwarder secret and tenant to receive forwarded events").
+			Mark(ierr.ErrPermissionDenied))
+		return
+	}
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Failed to read request body").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	tolerance := time.Duration(cfg.ToleranceSeconds) * time.Second
+	if tolerance <= 0 {
+		tolerance = 5 * time.Minute
+	}
+
+	if !httpforwarder.Verify(cfg.Secret, c.GetHeader(httpforwarder.TimestampHeader), body,
+		c.GetHeader(httpforwarder.SignatureHeader), tolerance, time.Now()) {
+		c.Error(ierr.NewError("invalid signature").
+			WithHint("The X-Flexprice-Signature header does not match the request or the request has expired").
+			Mark(ierr.ErrPermissionDenied))
+		return
+	}
+
+	var req httpforwarder.ForwardRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if err := h.service.ProcessForwardedEvents(c.Request.Context(), req.Events); err != nil {
+		h.log.Error("Failed to process forwarded events", "error", err)
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{"message": "events processed"})
+}
@@ -0,0 +1,105 @@
+package v1
+
+import (
+	"io"
+	"net/http"
+	"strings"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+type MetricsIngestionHandler struct {
+	service service.MetricsIngestionService
+	log     *logger.Logger
+}
+
+func NewMetricsIngestionHandler(service service.MetricsIngestionService, log *logger.Logger) *MetricsIngestionHandler {
+	return &MetricsIngestionHandler{service: service, log: log}
+}
+
+// @Summary Ingest OTLP metrics
+// @Description Ingest an OTLP/HTTP metrics export request in the JSON encoding, data points are converted to events through the metrics_ingestion_config mapping rules
+// @Tags Events
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body object true "OTLP ExportMetricsServiceRequest"
+// @Success 202 {object} dto.IngestMetricsResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /events/otlp/v1/metrics [post]
+func (h *MetricsIngestionHandler) IngestOTLPMetrics(c *gin.Context) {
+	if strings.HasPrefix(c.ContentType(), "application/x-protobuf") {
+		c.Error(ierr.NewError("unsupported otlp encoding").
+			WithHint("Only the JSON encoding of OTLP/HTTP is supported, configure the exporter to use http/json").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	body, err := readMetricsBody(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	resp, err := h.service.IngestOTLPMetrics(c.Request.Context(), body)
+	if err != nil {
+		h.log.Error("Failed to ingest otlp metrics", "error", err)
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, resp)
+}
+
+// @Summary Ingest Prometheus remote-write
+// @Description Ingest a snappy compressed Prometheus remote-write request, samples are converted to events through the metrics_ingestion_config mapping rules
+// @Tags Events
+// @Accept application/x-protobuf
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 202 {object} dto.IngestMetricsResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /events/prometheus/write [post]
+func (h *MetricsIngestionHandler) IngestPrometheusRemoteWrite(c *gin.Context) {
+	body, err := readMetricsBody(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	resp, err := h.service.IngestPrometheusRemoteWrite(c.Request.Context(), body)
+	if err != nil {
+		h.log.Error("Failed to ingest prometheus remote-write", "error", err)
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, resp)
+}
+
+// readMetricsBody reads the request body, decompressing it when the exporter sent it compressed. The
+// snappy encoding of remote-write is part of the protocol and left to the decoder.
+func readMetricsBody(c *gin.Context) ([]byte, error) {
+	var reader io.ReadCloser = c.Request.Body
+	if !strings.EqualFold(c.GetHeader("Content-Encoding"), "snappy") {
+		var err error
+		reader, err = decodedRequestBody(c)
+		if err != nil {
+			return nil, err
+		}
+	}
+	defer reader.Close()
+
+	body, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to read the request body").
+			Mark(ierr.ErrValidation)
+	}
+	return body, nil
+}
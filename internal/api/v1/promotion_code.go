@@ -0,0 +1,230 @@
+package v1
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/service"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+type PromotionCodeHandler struct {
+	promotionCodeService service.PromotionCodeService
+	logger               *logger.Logger
+}
+
+func NewPromotionCodeHandler(promotionCodeService service.PromotionCodeService, logger *logger.Logger) *PromotionCodeHandler {
+	return &PromotionCodeHandler{
+		promotionCodeService: promotionCodeService,
+		logger:               logger,
+	}
+}
+
+// @Summary Create a promotion code
+// @Description Creates a promotion code that redeems a coupon
+// @Tags Promotion Codes
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param promotion_code body dto.CreatePromotionCodeRequest true "Promotion code request"
+// @Success 201 {object} dto.PromotionCodeResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 401 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 409 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /promotion-codes [post]
+// @Security ApiKeyAuth
+func (h *PromotionCodeHandler) CreatePromotionCode(c *gin.Context) {
+	var req dto.CreatePromotionCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	response, err := h.promotionCodeService.CreatePromotionCode(c.Request.Context(), req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+// @Summary Bulk generate promotion codes
+// @Description Generates up to 10000 random promotion codes for a coupon
+// @Tags Promotion Codes
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body dto.BulkGeneratePromotionCodesRequest true "Bulk generate request"
+// @Success 201 {object} dto.BulkGeneratePromotionCodesResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 401 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /promotion-codes/bulk [post]
+// @Security ApiKeyAuth
+func (h *PromotionCodeHandler) BulkGeneratePromotionCodes(c *gin.Context) {
+	var req dto.BulkGeneratePromotionCodesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	response, err := h.promotionCodeService.BulkGeneratePromotionCodes(c.Request.Context(), req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+// @Summary Get a promotion code by ID
+// @Description Retrieves a promotion code by ID
+// @Tags Promotion Codes
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Promotion code ID"
+// @Success 200 {object} dto.PromotionCodeResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 401 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /promotion-codes/{id} [get]
+// @Security ApiKeyAuth
+func (h *PromotionCodeHandler) GetPromotionCode(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("promotion code ID is required").
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	response, err := h.promotionCodeService.GetPromotionCode(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Delete a promotion code
+// @Description Archives a promotion code so that it can no longer be redeemed
+// @Tags Promotion Codes
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Promotion code ID"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 401 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /promotion-codes/{id} [delete]
+// @Security ApiKeyAuth
+func (h *PromotionCodeHandler) DeletePromotionCode(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("promotion code ID is required").
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if err := h.promotionCodeService.DeletePromotionCode(c.Request.Context(), id); err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Promotion code deleted successfully"})
+}
+
+// @Summary List promotion codes with filtering
+// @Description Lists promotion codes with filtering
+// @Tags Promotion Codes
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param filter query types.PromotionCodeFilter true "Filter options"
+// @Success 200 {object} dto.ListPromotionCodesResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 401 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /promotion-codes [get]
+// @Security ApiKeyAuth
+func (h *PromotionCodeHandler) ListPromotionCodes(c *gin.Context) {
+	var filter types.PromotionCodeFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if filter.GetLimit() == 0 {
+		filter.Limit = lo.ToPtr(types.GetDefaultFilter().Limit)
+	}
+
+	response, err := h.promotionCodeService.ListPromotionCodes(c.Request.Context(), &filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Export promotion codes as CSV
+// @Description Exports all promotion codes matching the filter as a CSV file, ignoring pagination
+// @Tags Promotion Codes
+// @Produce text/csv
+// @Security ApiKeyAuth
+// @Param filter query types.PromotionCodeFilter false "Filter options"
+// @Success 200 {file} file
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 401 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /promotion-codes/export [get]
+// @Security ApiKeyAuth
+func (h *PromotionCodeHandler) ExportPromotionCodes(c *gin.Context) {
+	var filter types.PromotionCodeFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	// Buffer the export so that a failure can still be reported as an error response
+	var buf bytes.Buffer
+	if err := h.promotionCodeService.ExportPromotionCodesCSV(c.Request.Context(), &filter, &buf); err != nil {
+		c.Error(err)
+		return
+	}
+
+	filename := fmt.Sprintf("promotion_codes_%s.csv", time.Now().UTC().Format("20060102150405"))
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	c.Data(http.StatusOK, "text/csv", buf.Bytes())
+}
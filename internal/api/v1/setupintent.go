@@ -10,6 +10,7 @@ import (
 	"github.com/flexprice/flexprice/internal/integration"
 	"github.com/flexprice/flexprice/internal/interfaces"
 	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/types"
 	"github.com/gin-gonic/gin"
 )
 
@@ -58,15 +59,15 @@ func (h *SetupIntentHandler) CreateSetupIntentSession(c *gin.Context) {
 		return
 	}
 
-	// Get Stripe integration
-	stripeIntegration, err := h.integrationFactory.GetStripeIntegration(c.Request.Context())
+	// Get the payment gateway of the requested provider
+	gateway, err := h.integrationFactory.GetPaymentGatewayByType(c.Request.Context(), types.PaymentGatewayType(req.Provider))
 	if err != nil {
-		h.log.Error("Failed to get Stripe integration", "error", err)
+		h.log.Error("Failed to get payment gateway", "error", err)
 		c.Error(err)
 		return
 	}
 
-	resp, err := stripeIntegration.PaymentSvc.SetupIntent(c.Request.Context(), customerID, &req, h.customerService)
+	resp, err := gateway.CreateSetupIntent(c.Request.Context(), customerID, &req, h.customerService)
 	if err != nil {
 		h.log.Error("Failed to create Setup Intent", "error", err)
 		c.Error(err)
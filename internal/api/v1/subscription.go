@@ -152,6 +152,74 @@ func (h *SubscriptionHandler) CancelSubscription(c *gin.Context) {
 
 }
 
+// @Summary Get subscription commitment
+// @Description Get the utilized and remaining commitment of a subscription for the current commitment term
+// @Tags Subscriptions
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Subscription ID"
+// @Success 200 {object} dto.SubscriptionCommitmentResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /subscriptions/{id}/commitment [get]
+func (h *SubscriptionHandler) GetSubscriptionCommitment(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("subscription ID is required").
+			WithHint("Please provide a valid subscription ID").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.service.GetSubscriptionCommitment(c.Request.Context(), id)
+	if err != nil {
+		h.log.Error("Failed to get subscription commitment", "error", err)
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary Update subscription usage alerts
+// @Description Replace the spend and usage alerts evaluated against the current billing period of a subscription
+// @Tags Subscriptions
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Subscription ID"
+// @Param request body dto.UpdateSubscriptionUsageAlertsRequest true "Usage alerts"
+// @Success 200 {object} dto.SubscriptionResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /subscriptions/{id}/usage-alerts [put]
+func (h *SubscriptionHandler) UpdateSubscriptionUsageAlerts(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("subscription ID is required").
+			WithHint("Please provide a valid subscription ID").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.UpdateSubscriptionUsageAlertsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.service.UpdateSubscriptionUsageAlerts(c.Request.Context(), id, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
 // @Summary Get usage by subscription
 // @Description Get usage for a subscription
 // @Tags Subscriptions
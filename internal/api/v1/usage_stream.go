@@ -0,0 +1,139 @@
+package v1
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// usageStreamRefreshInterval throttles the recomputation of the usage totals after updates
+	usageStreamRefreshInterval = 5 * time.Second
+	// usageStreamHeartbeatInterval keeps idle connections open through proxies
+	usageStreamHeartbeatInterval = 15 * time.Second
+)
+
+type UsageStreamHandler struct {
+	usageStreamService          service.UsageStreamService
+	featureUsageTrackingService service.FeatureUsageTrackingService
+	log                         *logger.Logger
+}
+
+func NewUsageStreamHandler(usageStreamService service.UsageStreamService, featureUsageTrackingService service.FeatureUsageTrackingService, log *logger.Logger) *UsageStreamHandler {
+	return &UsageStreamHandler{
+		usageStreamService:          usageStreamService,
+		featureUsageTrackingService: featureUsageTrackingService,
+		log:                         log,
+	}
+}
+
+// @Summary Stream live usage
+// @Description Stream the usage of a customer over Server-Sent Events. A "usage" event with the usage and cost totals per feature is sent on connect and at most every 5 seconds while usage changes, an "update" event is sent for every event processed into feature usage.
+// @Tags Events
+// @Produce text/event-stream
+// @Security ApiKeyAuth
+// @Param external_customer_id query string true "External customer ID"
+// @Param feature_ids query []string false "Feature IDs"
+// @Param start_time query string false "Start of the usage totals (RFC3339), 7 days ago by default"
+// @Success 200 {object} dto.GetUsageAnalyticsResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /events/usage/stream [get]
+func (h *UsageStreamHandler) StreamUsage(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req dto.StreamUsageRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Please check the query parameters").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	startTime, _, err := validateStartAndEndTime(req.StartTime, time.Time{})
+	if err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Start time must be in the past").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	updates, unsubscribe, err := h.usageStreamService.Subscribe(ctx, req.ExternalCustomerID, req.FeatureIDs)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	defer unsubscribe()
+
+	analyticsReq := &dto.GetUsageAnalyticsRequest{
+		ExternalCustomerID: req.ExternalCustomerID,
+		FeatureIDs:         req.FeatureIDs,
+		StartTime:          startTime,
+		GroupBy:            []string{"feature_id"},
+	}
+
+	// Subscribing before the first snapshot ensures no update is missed in between
+	snapshot, err := h.usageSnapshot(c, analyticsReq)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+
+	c.SSEvent("usage", snapshot)
+	c.Writer.Flush()
+
+	refresh := time.NewTicker(usageStreamRefreshInterval)
+	defer refresh.Stop()
+	heartbeat := time.NewTicker(usageStreamHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	changed := false
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case update, ok := <-updates:
+			if !ok {
+				return
+			}
+			c.SSEvent("update", update)
+			c.Writer.Flush()
+			changed = true
+		case <-refresh.C:
+			if !changed {
+				continue
+			}
+			snapshot, err := h.usageSnapshot(c, analyticsReq)
+			if err != nil {
+				h.log.Errorw("failed to refresh usage stream",
+					"external_customer_id", req.ExternalCustomerID,
+					"error", err)
+				continue
+			}
+			c.SSEvent("usage", snapshot)
+			c.Writer.Flush()
+			changed = false
+		case <-heartbeat.C:
+			fmt.Fprint(c.Writer, ": ping\n\n")
+			c.Writer.Flush()
+		}
+	}
+}
+
+// usageSnapshot computes the usage and cost totals up to now
+func (h *UsageStreamHandler) usageSnapshot(c *gin.Context, req *dto.GetUsageAnalyticsRequest) (*dto.GetUsageAnalyticsResponse, error) {
+	req.EndTime = time.Now().UTC()
+	return h.featureUsageTrackingService.GetDetailedUsageAnalytics(c.Request.Context(), req)
+}
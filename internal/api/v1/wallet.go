@@ -243,6 +243,48 @@ func (h *WalletHandler) TopUpWallet(c *gin.Context) {
 	c.JSON(http.StatusOK, wallet)
 }
 
+// TransferWallet godoc
+// @Summary Transfer wallet credits
+// @Description Move credits from a wallet to another wallet, converting between the wallets' conversion rates
+// @Tags Wallets
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Source wallet ID"
+// @Param request body dto.TransferWalletRequest true "Transfer request"
+// @Success 200 {object} dto.WalletTransferResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /wallets/{id}/transfer [post]
+func (h *WalletHandler) TransferWallet(c *gin.Context) {
+	walletID := c.Param("id")
+	if walletID == "" {
+		c.Error(ierr.NewError("wallet_id is required").
+			WithHint("Wallet ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.TransferWalletRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Failed to bind JSON", "error", err)
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	transfer, err := h.walletService.TransferWallet(c.Request.Context(), walletID, &req)
+	if err != nil {
+		h.logger.Error("Failed to transfer wallet credits", "error", err)
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, transfer)
+}
+
 // GetWalletBalance godoc
 // @Summary Get wallet balance
 // @Description Get real-time balance of a wallet
@@ -8,6 +8,7 @@ import (
 
 	"github.com/flexprice/flexprice/internal/config"
 	"github.com/flexprice/flexprice/internal/integration"
+	"github.com/flexprice/flexprice/internal/integration/razorpay"
 	"github.com/flexprice/flexprice/internal/integration/stripe/webhook"
 	"github.com/flexprice/flexprice/internal/interfaces"
 	"github.com/flexprice/flexprice/internal/logger"
@@ -231,6 +232,96 @@ func (h *WebhookHandler) HandleStripeWebhook(c *gin.Context) {
 	})
 }
 
+// @Summary Handle Razorpay webhook events
+// @Description Process incoming Razorpay webhook events for payment link and refund status updates
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Param tenant_id path string true "Tenant ID"
+// @Param environment_id path string true "Environment ID"
+// @Param X-Razorpay-Signature header string true "Razorpay webhook signature"
+// @Success 200 {object} map[string]interface{} "Webhook processed successfully"
+// @Failure 400 {object} map[string]interface{} "Bad request - missing parameters or invalid signature"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /webhooks/razorpay/{tenant_id}/{environment_id} [post]
+func (h *WebhookHandler) HandleRazorpayWebhook(c *gin.Context) {
+	tenantID := c.Param("tenant_id")
+	environmentID := c.Param("environment_id")
+
+	if tenantID == "" || environmentID == "" {
+		h.logger.Errorw("missing tenant_id or environment_id in webhook URL")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "tenant_id and environment_id are required",
+		})
+		return
+	}
+
+	// Read the raw request body
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		h.logger.Errorw("failed to read request body", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Failed to read request body",
+		})
+		return
+	}
+
+	// Get Razorpay signature from headers
+	signature := c.GetHeader(razorpay.HeaderSignature)
+	if signature == "" {
+		h.logger.Errorw("missing X-Razorpay-Signature header")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Missing X-Razorpay-Signature header",
+		})
+		return
+	}
+
+	// Set context with tenant and environment IDs
+	ctx := types.SetTenantID(c.Request.Context(), tenantID)
+	ctx = types.SetEnvironmentID(ctx, environmentID)
+	c.Request = c.Request.WithContext(ctx)
+
+	razorpayIntegration, err := h.integrationFactory.GetRazorpayIntegration(ctx)
+	if err != nil {
+		h.logger.Errorw("failed to get Razorpay integration", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Razorpay integration not available",
+		})
+		return
+	}
+
+	// Verify the signature with the webhook secret of the Razorpay connection
+	event, err := razorpayIntegration.Gateway.ParseWebhookEvent(ctx, body, signature)
+	if err != nil {
+		h.logger.Errorw("failed to parse/verify Razorpay webhook event",
+			"error", err,
+			"environment_id", environmentID,
+		)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Failed to verify webhook signature or parse event",
+		})
+		return
+	}
+	event.ID = c.GetHeader(razorpay.HeaderEventID)
+
+	err = razorpayIntegration.WebhookHandler.HandleWebhookEvent(ctx, event, h.paymentService, h.invoiceService)
+	if err != nil {
+		h.logger.Errorw("failed to handle webhook event",
+			"error", err,
+			"event_id", event.ID,
+			"event_type", event.Type,
+		)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to process webhook event",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Webhook processed successfully",
+	})
+}
+
 // @Summary Handle HubSpot webhook events
 // @Description Process incoming HubSpot webhook events for deal closed won and customer creation
 // @Tags Webhooks
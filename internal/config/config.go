@@ -27,6 +27,9 @@ type Configuration struct {
 	Pyroscope                PyroscopeConfig                `validate:"required"`
 	Event                    EventConfig                    `validate:"required"`
 	DynamoDB                 DynamoDBConfig                 `validate:"required"`
+	NATS                     NATSConfig                     `mapstructure:"nats" validate:"omitempty"`
+	SQS                      SQSConfig                      `mapstructure:"sqs" validate:"omitempty"`
+	HTTPForwarder            HTTPForwarderConfig            `mapstructure:"http_forwarder" validate:"omitempty"`
 	Temporal                 TemporalConfig                 `validate:"required"`
 	Webhook                  Webhook                        `validate:"omitempty"`
 	Secrets                  SecretsConfig                  `validate:"required"`
@@ -206,6 +209,10 @@ type FeatureUsageTrackingConfig struct {
 	TopicBackfill         string `mapstructure:"topic_backfill" default:"v1_feature_tracking_service_backfill"`
 	RateLimitBackfill     int64  `mapstructure:"rate_limit_backfill" default:"1"`
 	ConsumerGroupBackfill string `mapstructure:"consumer_group_backfill" default:"v1_feature_tracking_service_backfill"`
+	// Topic receiving an update for every event processed into feature usage, consumed by live usage streams
+	TopicUsageUpdates string `mapstructure:"topic_usage_updates" default:"v1_feature_usage_updates"`
+	// Prefix of the per instance consumer groups of the live usage streams
+	ConsumerGroupUsageUpdates string `mapstructure:"consumer_group_usage_updates" default:"v1_usage_stream"`
 }
 
 type FeatureUsageTrackingLazyConfig struct {
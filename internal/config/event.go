@@ -2,9 +2,86 @@ package config
 
 import (
 	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
 )
 
 // EventConfig holds configuration for event processing
 type EventConfig struct {
 	PublishDestination types.PublishDestination `mapstructure:"publish_destination" default:"kafka"`
+	// Destinations lists the destinations events are published to, it takes precedence over
+	// publish_destination when set
+	Destinations []types.PublishDestination `mapstructure:"destinations"`
+	// StreamBatchSize is the number of events published at once by the NDJSON stream ingestion
+	StreamBatchSize int `mapstructure:"stream_batch_size" default:"500"`
+}
+
+// GetDestinations returns the destinations events are published to, "all" expands to kafka and dynamodb
+func (c EventConfig) GetDestinations() []types.PublishDestination {
+	destinations := c.Destinations
+	if len(destinations) == 0 {
+		destinations = []types.PublishDestination{c.PublishDestination}
+	}
+
+	result := make([]types.PublishDestination, 0, len(destinations))
+	for _, destination := range destinations {
+		if destination == types.PublishToAll {
+			result = append(result, types.PublishToKafka, types.PublishToDynamoDB)
+			continue
+		}
+		result = append(result, destination)
+	}
+	return lo.Uniq(result)
+}
+
+// NATSConfig configures publishing events to a NATS JetStream stream
+type NATSConfig struct {
+	// URL of the NATS server, e.g. nats://localhost:4222, tls:// enables TLS
+	URL string `mapstructure:"url" default:"nats://localhost:4222"`
+	// Subject the events are published on, it must be captured by a JetStream stream
+	Subject  string `mapstructure:"subject" default:"flexprice.events"`
+	Username string `mapstructure:"username"`
+	Password string `mapstructure:"password"`
+	Token    string `mapstructure:"token"`
+	// BatchSize is the number of events published before waiting for the JetStream acknowledgements
+	BatchSize  int `mapstructure:"batch_size" default:"100"`
+	MaxRetries int `mapstructure:"max_retries" default:"3"`
+	// TimeoutSeconds bounds the connection and the wait for the acknowledgements of a batch
+	TimeoutSeconds int `mapstructure:"timeout_seconds" default:"5"`
+	// Consumer is the durable JetStream consumer feeding the events to the ClickHouse and the feature
+	// usage pipelines when nats is a publish destination
+	Consumer string `mapstructure:"consumer" default:"flexprice-event-consumer"`
+}
+
+// SQSConfig configures publishing events to an SQS compatible queue
+type SQSConfig struct {
+	QueueURL string `mapstructure:"queue_url"`
+	Region   string `mapstructure:"region" default:"us-east-1"`
+	// Endpoint overrides the regional AWS endpoint, e.g. for ElasticMQ or LocalStack
+	Endpoint string `mapstructure:"endpoint"`
+	// Static credentials, the default AWS credential chain is used when empty
+	AccessKeyID     string `mapstructure:"access_key_id"`
+	SecretAccessKey string `mapstructure:"secret_access_key"`
+	// FIFO sets the message group (tenant and customer) and deduplication (event id) of FIFO queues
+	FIFO           bool `mapstructure:"fifo"`
+	MaxRetries     int  `mapstructure:"max_retries" default:"3"`
+	TimeoutSeconds int  `mapstructure:"timeout_seconds" default:"10"`
+}
+
+// HTTPForwarderConfig configures forwarding events to an HTTP endpoint
+type HTTPForwarderConfig struct {
+	URL string `mapstructure:"url"`
+	// Headers are added to every request, e.g. for authentication
+	Headers map[string]string `mapstructure:"headers"`
+	// Secret signs the request timestamps and bodies with HMAC-SHA256 in the X-Flexprice-Signature header
+	// when set. The receiving deployment verifies the events posted to /v1/events/forwarded with the same secret.
+	Secret         string `mapstructure:"secret"`
+	BatchSize      int    `mapstructure:"batch_size" default:"100"`
+	MaxRetries     int    `mapstructure:"max_retries" default:"3"`
+	TimeoutSeconds int    `mapstructure:"timeout_seconds" default:"10"`
+	// ToleranceSeconds is the maximum age of the signed timestamp of a received request
+	ToleranceSeconds int `mapstructure:"tolerance_seconds" default:"300"`
+	// TenantID and EnvironmentID are the tenant and the environment the received events are ingested
+	// into, the tenant and the environment set in the forwarded events are ignored
+	TenantID      string `mapstructure:"tenant_id"`
+	EnvironmentID string `mapstructure:"environment_id"`
 }
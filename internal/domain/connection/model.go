@@ -64,6 +64,20 @@ func (c *Connection) IsInvoiceSyncEnabled() bool {
 	return false // Default to false if not set or not a boolean
 }
 
+// IsDefaultPaymentGateway checks if this connection is the payment gateway the environment collects
+// payments through when it has more than one payment gateway connection
+func (c *Connection) IsDefaultPaymentGateway() bool {
+	if c.Metadata == nil {
+		return false
+	}
+
+	if isDefault, ok := c.Metadata["default_payment_gateway"].(bool); ok {
+		return isDefault
+	}
+
+	return false
+}
+
 // convertMapToConnectionMetadata converts old map format to new structured format
 func convertMapToConnectionMetadata(metadata map[string]interface{}, providerType types.SecretProvider) types.ConnectionMetadata {
 	switch providerType {
@@ -112,6 +126,20 @@ func convertMapToConnectionMetadata(metadata map[string]interface{}, providerTyp
 		return types.ConnectionMetadata{
 			HubSpot: hubspotMetadata,
 		}
+	case types.SecretProviderRazorpay:
+		razorpayMetadata := &types.RazorpayConnectionMetadata{}
+		if keyID, ok := metadata["key_id"].(string); ok {
+			razorpayMetadata.KeyID = keyID
+		}
+		if keySecret, ok := metadata["key_secret"].(string); ok {
+			razorpayMetadata.KeySecret = keySecret
+		}
+		if ws, ok := metadata["webhook_secret"].(string); ok {
+			razorpayMetadata.WebhookSecret = ws
+		}
+		return types.ConnectionMetadata{
+			Razorpay: razorpayMetadata,
+		}
 	default:
 		// For other providers or unknown types, use generic format
 		return types.ConnectionMetadata{
@@ -12,4 +12,5 @@ type Repository interface {
 	Delete(ctx context.Context, id string) error
 	GetBySubscription(ctx context.Context, subscriptionID string) ([]*CouponAssociation, error)
 	GetBySubscriptionForLineItems(ctx context.Context, subID string) ([]*CouponAssociation, error)
+	GetBySubscriptionsAndCoupon(ctx context.Context, subscriptionIDs []string, couponID string) ([]*CouponAssociation, error)
 }
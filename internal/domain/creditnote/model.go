@@ -34,6 +34,9 @@ type CreditNote struct {
 	// refund_status represents the status of any refund associated with this credit note
 	RefundStatus *types.PaymentStatus `json:"refund_status"`
 
+	// refund_method is where the amount of a refund credit note goes (WALLET, ORIGINAL_PAYMENT_METHOD)
+	RefundMethod types.CreditNoteRefundMethod `json:"refund_method"`
+
 	// reason specifies the reason for creating this credit note (duplicate, fraudulent, order_change, product_unsatisfactory)
 	Reason types.CreditNoteReason `json:"reason"`
 
@@ -80,6 +83,7 @@ func FromEnt(e *ent.CreditNote) *CreditNote {
 		CreditNoteStatus: e.CreditNoteStatus,
 		CreditNoteType:   e.CreditNoteType,
 		RefundStatus:     e.RefundStatus,
+		RefundMethod:     e.RefundMethod,
 		Reason:           e.Reason,
 		Memo:             e.Memo,
 		Currency:         e.Currency,
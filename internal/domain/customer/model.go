@@ -38,6 +38,9 @@ type Customer struct {
 	// AddressCountry is the country of the customer's address (ISO 3166-1 alpha-2)
 	AddressCountry string `db:"address_country" json:"address_country"`
 
+	// ParentCustomerID is the identifier of the parent customer billing the usage of this customer
+	ParentCustomerID *string `db:"parent_customer_id" json:"parent_customer_id,omitempty"`
+
 	// Metadata
 	Metadata map[string]string `db:"metadata" json:"metadata"`
 
@@ -63,6 +66,7 @@ func FromEnt(c *ent.Customer) *Customer {
 		AddressState:      c.AddressState,
 		AddressPostalCode: c.AddressPostalCode,
 		AddressCountry:    c.AddressCountry,
+		ParentCustomerID:  c.ParentCustomerID,
 		Metadata:          c.Metadata,
 		EnvironmentID:     c.EnvironmentID,
 		BaseModel: types.BaseModel{
@@ -76,6 +80,20 @@ func FromEnt(c *ent.Customer) *Customer {
 	}
 }
 
+// IsChild returns true if the customer is billed through a parent customer
+func (c *Customer) IsChild() bool {
+	return c.ParentCustomerID != nil && *c.ParentCustomerID != ""
+}
+
+// BillingCustomerID returns the id of the customer whose subscriptions bill the usage of this customer,
+// i.e. the parent customer for a child customer and the customer itself otherwise
+func (c *Customer) BillingCustomerID() string {
+	if c.IsChild() {
+		return *c.ParentCustomerID
+	}
+	return c.ID
+}
+
 // FromEntList converts a list of ent customers to domain customers
 func FromEntList(customers []*ent.Customer) []*Customer {
 	result := make([]*Customer, len(customers))
@@ -0,0 +1,40 @@
+package entitlement
+
+import (
+	"context"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// UsageCounter is the in-flight usage of a customer feature in a usage reset period. Usage reserved by
+// entitlement checks takes a while to show up in the aggregated feature usage, so the aggregated usage at
+// the time of the reservation is kept as a baseline and any usage aggregated since is deducted from the
+// reserved usage.
+type UsageCounter struct {
+	CustomerID  string
+	FeatureID   string
+	PeriodStart time.Time
+	Baseline    decimal.Decimal
+	Reserved    decimal.Decimal
+}
+
+// InFlight returns the reserved usage which is not yet reflected in the aggregated usage
+func (c *UsageCounter) InFlight(aggregated decimal.Decimal) decimal.Decimal {
+	aggregatedSinceReservation := decimal.Max(decimal.Zero, aggregated.Sub(c.Baseline))
+	return decimal.Max(decimal.Zero, c.Reserved.Sub(aggregatedSinceReservation))
+}
+
+// UsageCounterRepository keeps the usage counters of the entitlement checks, shared by every instance
+type UsageCounterRepository interface {
+	// GetForUpdate returns the counter of the customer feature for the period, a zero counter when there
+	// is none yet. The counter is locked until the end of the transaction of ctx.
+	GetForUpdate(ctx context.Context, customerID, featureID string, periodStart time.Time) (*UsageCounter, error)
+
+	// Save stores the baseline and the reserved usage of the counter
+	Save(ctx context.Context, counter *UsageCounter) error
+
+	// MarkLimitReached records that the limit of the customer feature was reached in the period and returns
+	// false when it was already recorded
+	MarkLimitReached(ctx context.Context, customerID, featureID string, periodStart time.Time) (bool, error)
+}
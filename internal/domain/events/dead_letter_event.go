@@ -0,0 +1,40 @@
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// DeadLetterEvent is an event that could not be turned into feature usage. The latest row per event
+// wins, so replays record their outcome by inserting a new version of the row.
+type DeadLetterEvent struct {
+	Event
+	Reason      types.DeadLetterReason      `json:"reason" ch:"reason"`
+	Error       string                      `json:"error" ch:"error"`
+	Status      types.DeadLetterEventStatus `json:"status" ch:"status"`
+	ReplayCount uint32                      `json:"replay_count" ch:"replay_count"`
+	FailedAt    time.Time                   `json:"failed_at" ch:"failed_at,timezone('UTC')"`
+	ReplayedAt  *time.Time                  `json:"replayed_at,omitempty" ch:"replayed_at,timezone('UTC')"`
+	Version     uint64                      `json:"-" ch:"version"`
+}
+
+// GetDeadLetterEventsParams contains the filters for listing dead-lettered events
+type GetDeadLetterEventsParams struct {
+	EventIDs           []string
+	EventName          string
+	ExternalCustomerID string
+	Reason             types.DeadLetterReason
+	Status             types.DeadLetterEventStatus
+	StartTime          time.Time
+	EndTime            time.Time
+	PageSize           int
+	Offset             int
+}
+
+// DeadLetterEventRepository stores events that failed feature usage processing
+type DeadLetterEventRepository interface {
+	InsertDeadLetterEvents(ctx context.Context, events []*DeadLetterEvent) error
+	GetDeadLetterEvents(ctx context.Context, params *GetDeadLetterEventsParams) ([]*DeadLetterEvent, uint64, error)
+}
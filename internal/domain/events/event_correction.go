@@ -0,0 +1,49 @@
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// EventCorrection is the compensating record of an amended or retracted event. Usage queries exclude
+// corrected events, amendments are counted through their replacement event instead.
+type EventCorrection struct {
+	ID                 string                      `json:"id" ch:"id"`
+	TenantID           string                      `json:"tenant_id" ch:"tenant_id"`
+	EnvironmentID      string                      `json:"environment_id" ch:"environment_id"`
+	EventID            string                      `json:"event_id" ch:"event_id"`
+	EventName          string                      `json:"event_name" ch:"event_name"`
+	ExternalCustomerID string                      `json:"external_customer_id" ch:"external_customer_id"`
+	EventTimestamp     time.Time                   `json:"event_timestamp" ch:"event_timestamp,timezone('UTC')"`
+	Action             types.EventCorrectionAction `json:"action" ch:"action"`
+	ReplacementEventID string                      `json:"replacement_event_id" ch:"replacement_event_id"`
+	Reason             string                      `json:"reason" ch:"reason"`
+	CreatedAt          time.Time                   `json:"created_at" ch:"created_at,timezone('UTC')"`
+	CreatedBy          string                      `json:"created_by" ch:"created_by"`
+}
+
+// GetEventCorrectionsParams contains the filters for listing event corrections
+type GetEventCorrectionsParams struct {
+	EventIDs           []string
+	ExternalCustomerID string
+	Action             types.EventCorrectionAction
+	PageSize           int
+	Offset             int
+}
+
+// EventCorrectionRepository stores the corrections applied to ingested events
+type EventCorrectionRepository interface {
+	InsertEventCorrection(ctx context.Context, correction *EventCorrection) error
+	GetEventCorrections(ctx context.Context, params *GetEventCorrectionsParams) ([]*EventCorrection, uint64, error)
+}
+
+// EventCorrectionClaimRepository claims events for correction so that an event is corrected at most once,
+// also when it is corrected by concurrent requests
+type EventCorrectionClaimRepository interface {
+	// ClaimEvent claims the event of the correction, it fails with ErrAlreadyExists when the event is already claimed
+	ClaimEvent(ctx context.Context, correction *EventCorrection) error
+	// ReleaseEvent releases the claim of a correction that could not be recorded
+	ReleaseEvent(ctx context.Context, correction *EventCorrection) error
+}
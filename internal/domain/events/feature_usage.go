@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
 )
 
 // FeatureUsageRepository defines operations for feature usage tracking
@@ -24,18 +25,34 @@ type FeatureUsageRepository interface {
 	// GetDetailedUsageAnalytics provides comprehensive usage analytics with filtering, grouping, and time-series data
 	GetDetailedUsageAnalytics(ctx context.Context, params *UsageAnalyticsParams, maxBucketFeatures map[string]*MaxBucketFeatureInfo) ([]*DetailedUsageAnalytic, error)
 
-	// Get feature usage by subscription
-	GetFeatureUsageBySubscription(ctx context.Context, subscriptionID, externalCustomerID string, startTime, endTime time.Time) (map[string]*UsageByFeatureResult, error)
+	// Get feature usage by subscription, externalCustomerIDs holds the customer of the subscription and its child customers
+	GetFeatureUsageBySubscription(ctx context.Context, subscriptionID string, externalCustomerIDs []string, startTime, endTime time.Time) (map[string]*UsageByFeatureResult, error)
+
+	// GetFeatureUsageByDimensions gets the usage of a subscription line item grouped by the values of the given property dimensions,
+	// the percentile is only used by PERCENTILE meters
+	GetFeatureUsageByDimensions(ctx context.Context, subscriptionID, subLineItemID string, externalCustomerIDs []string, dimensions []string, percentile *decimal.Decimal, startTime, endTime time.Time) ([]*UsageByFeatureResult, error)
+
+	// GetFeatureUsagePercentiles gets the percentile usage of the line items of a subscription billed by PERCENTILE meters,
+	// percentiles maps the meter ID to the percentile of the meter and the result is keyed by sub line item ID
+	GetFeatureUsagePercentiles(ctx context.Context, subscriptionID string, externalCustomerIDs []string, percentiles map[string]decimal.Decimal, startTime, endTime time.Time) (map[string]decimal.Decimal, error)
 
 	// GetFeatureUsageForExport gets feature usage data for export in batches
 	GetFeatureUsageForExport(ctx context.Context, startTime, endTime time.Time, batchSize int, offset int) ([]*FeatureUsage, error)
+
+	// GetTrackedEventIDs returns the IDs among eventIDs which already have feature usage, the events are
+	// looked up between startTime and endTime
+	GetTrackedEventIDs(ctx context.Context, eventIDs []string, startTime, endTime time.Time) ([]string, error)
 }
 
-// MaxBucketFeatureInfo contains information about a feature that uses MAX with bucket aggregation
+// MaxBucketFeatureInfo contains information about a feature that uses bucketed aggregation
+// (MAX or PERCENTILE with bucket size) or PERCENTILE aggregation, all of which are
+// computed separately from the standard SUM based analytics
 type MaxBucketFeatureInfo struct {
-	FeatureID    string
-	MeterID      string
-	BucketSize   types.WindowSize
-	EventName    string
-	PropertyName string
+	FeatureID       string
+	MeterID         string
+	BucketSize      types.WindowSize
+	EventName       string
+	PropertyName    string
+	AggregationType types.AggregationType
+	Percentile      *decimal.Decimal
 }
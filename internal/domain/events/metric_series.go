@@ -0,0 +1,31 @@
+package events
+
+import (
+	"context"
+	"time"
+)
+
+// MetricSeriesState is the last cumulative point of a metric series ingested from OpenTelemetry or Prometheus
+type MetricSeriesState struct {
+	TenantID      string
+	EnvironmentID string
+	// SeriesID identifies the series by its metric name and attributes
+	SeriesID string
+	Value    float64
+	// Count is the number of observations of histogram series
+	Count uint64
+	// StartTime is the start of the series when the exporter sets it, zero otherwise
+	StartTime time.Time
+	ValueAt   time.Time
+}
+
+// MetricSeriesStateRepository keeps the last cumulative point of the metric series, shared by every
+// instance ingesting metrics
+type MetricSeriesStateRepository interface {
+	// Advance stores the point when it is newer than the last point of its series and returns the point
+	// it replaced, nil for the first point of the series. A point equal to the last point of its series
+	// is a retry and returns the point that the last point replaced, so that it converts to the same
+	// increase. Returns false without storing the point when the series already holds another point at
+	// or after its time.
+	Advance(ctx context.Context, state *MetricSeriesState) (*MetricSeriesState, bool, error)
+}
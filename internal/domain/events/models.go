@@ -87,7 +87,15 @@ type UsageByFeatureResult struct {
 	MeterID          string
 	SumTotal         decimal.Decimal
 	MaxTotal         decimal.Decimal
+	MinTotal         decimal.Decimal
 	CountDistinctIDs uint64
 	CountUniqueQty   uint64
 	LatestQty        decimal.Decimal
+	FirstQty         decimal.Decimal
+
+	// PercentileQty is the percentile of the usage, only set for line items billed by PERCENTILE meters
+	PercentileQty decimal.Decimal
+
+	// DimensionValues holds the values of the matrix dimensions when the usage is grouped by dimensions
+	DimensionValues map[string]string
 }
@@ -0,0 +1,35 @@
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// RejectedEvent is an event that violated the schema of its event name. Rejected events were dropped
+// during ingestion while flagged events were ingested and are recorded for inspection.
+type RejectedEvent struct {
+	Event
+	SchemaID   string                       `json:"schema_id" ch:"schema_id"`
+	Action     types.RejectedEventAction    `json:"action" ch:"action"`
+	Violations []types.EventSchemaViolation `json:"violations" ch:"violations"`
+	RejectedAt time.Time                    `json:"rejected_at" ch:"rejected_at,timezone('UTC')"`
+}
+
+// GetRejectedEventsParams contains the filters for listing rejected events
+type GetRejectedEventsParams struct {
+	EventName          string
+	ExternalCustomerID string
+	Action             types.RejectedEventAction
+	StartTime          time.Time
+	EndTime            time.Time
+	PageSize           int
+	Offset             int
+}
+
+// RejectedEventRepository stores events that violated their event schema
+type RejectedEventRepository interface {
+	BulkInsertRejectedEvents(ctx context.Context, events []*RejectedEvent) error
+	GetRejectedEvents(ctx context.Context, params *GetRejectedEventsParams) ([]*RejectedEvent, uint64, error)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/flexprice/flexprice/internal/domain/meter"
 	"github.com/flexprice/flexprice/internal/types"
 	"github.com/shopspring/decimal"
 )
@@ -71,12 +72,15 @@ type UsageParams struct {
 	CustomerID         string                `json:"customer_id"`
 	EventName          string                `json:"event_name" validate:"required"`
 	PropertyName       string                `json:"property_name" validate:"required"`
+	Expression         string                `json:"expression,omitempty"` // Computes the value from multiple properties instead of PropertyName
 	AggregationType    types.AggregationType `json:"aggregation_type" validate:"required"`
 	WindowSize         types.WindowSize      `json:"window_size"`
-	BucketSize         types.WindowSize      `json:"bucket_size,omitempty"` // For windowed MAX aggregation
+	BucketSize         types.WindowSize      `json:"bucket_size,omitempty"` // For windowed MAX and PERCENTILE aggregation
+	Percentile         *decimal.Decimal      `json:"percentile,omitempty"`  // For PERCENTILE aggregation, in the range (0, 100]
 	StartTime          time.Time             `json:"start_time" validate:"required"`
 	EndTime            time.Time             `json:"end_time" validate:"required"`
 	Filters            map[string][]string   `json:"filters"`
+	FilterConditions   []meter.Filter        `json:"filter_conditions,omitempty"` // Meter filters using operators other than exact match (not_in, range, prefix, exists)
 	Multiplier         *decimal.Decimal      `json:"multiplier,omitempty" validate:"omitempty,gt=0"`
 	// BillingAnchor enables custom monthly billing periods for usage aggregation.
 	//
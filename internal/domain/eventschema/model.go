@@ -0,0 +1,135 @@
+package eventschema
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// EventSchema describes the expected properties of the events with a given event name
+type EventSchema struct {
+	ID             string                          `json:"id"`
+	EventName      string                          `json:"event_name"`
+	Description    string                          `json:"description,omitempty"`
+	ValidationMode types.EventSchemaValidationMode `json:"validation_mode"`
+	Properties     []types.EventPropertySchema     `json:"properties"`
+	EnvironmentID  string                          `json:"environment_id"`
+	types.BaseModel
+}
+
+// FromEnt converts an Ent EventSchema to a domain EventSchema
+func FromEnt(e *ent.EventSchema) *EventSchema {
+	if e == nil {
+		return nil
+	}
+	return &EventSchema{
+		ID:             e.ID,
+		EventName:      e.EventName,
+		Description:    e.Description,
+		ValidationMode: types.EventSchemaValidationMode(e.ValidationMode),
+		Properties:     e.Properties,
+		EnvironmentID:  e.EnvironmentID,
+		BaseModel: types.BaseModel{
+			TenantID:  e.TenantID,
+			Status:    types.Status(e.Status),
+			CreatedAt: e.CreatedAt,
+			UpdatedAt: e.UpdatedAt,
+			CreatedBy: e.CreatedBy,
+			UpdatedBy: e.UpdatedBy,
+		},
+	}
+}
+
+// FromEntList converts a list of Ent EventSchemas to domain EventSchemas
+func FromEntList(list []*ent.EventSchema) []*EventSchema {
+	if list == nil {
+		return nil
+	}
+	schemas := make([]*EventSchema, len(list))
+	for i, item := range list {
+		schemas[i] = FromEnt(item)
+	}
+	return schemas
+}
+
+// ValidateProperties returns the violations of the given event properties against the schema.
+// Properties which are not part of the schema are allowed.
+func (s *EventSchema) ValidateProperties(properties map[string]interface{}) []types.EventSchemaViolation {
+	if s.ValidationMode == types.EventSchemaValidationModeAccept {
+		return nil
+	}
+
+	violations := make([]types.EventSchemaViolation, 0)
+	for _, property := range s.Properties {
+		value, ok := properties[property.Name]
+		if !ok || value == nil {
+			if property.Required {
+				violations = append(violations, types.EventSchemaViolation{
+					Property: property.Name,
+					Message:  "required property is missing",
+				})
+			}
+			continue
+		}
+
+		if property.Type != "" && !matchesPropertyType(value, property.Type) {
+			violations = append(violations, types.EventSchemaViolation{
+				Property: property.Name,
+				Message:  fmt.Sprintf("expected a value of type %s", property.Type),
+			})
+			continue
+		}
+
+		if len(property.AllowedValues) > 0 && !lo.Contains(property.AllowedValues, fmt.Sprint(value)) {
+			violations = append(violations, types.EventSchemaViolation{
+				Property: property.Name,
+				Message:  fmt.Sprintf("value %v is not one of the allowed values", value),
+			})
+		}
+	}
+
+	sort.SliceStable(violations, func(i, j int) bool {
+		return violations[i].Property < violations[j].Property
+	})
+	return violations
+}
+
+// matchesPropertyType checks the type of a property value. Numbers and booleans sent as strings
+// are accepted since meters parse them the same way and CSV imports send all values as strings.
+func matchesPropertyType(value interface{}, propertyType types.EventPropertyType) bool {
+	switch propertyType {
+	case types.EventPropertyTypeString:
+		_, ok := value.(string)
+		return ok
+	case types.EventPropertyTypeNumber:
+		switch v := value.(type) {
+		case float64, float32, int, int32, int64, uint, uint32, uint64:
+			return true
+		case string:
+			_, err := decimal.NewFromString(v)
+			return err == nil
+		}
+		return false
+	case types.EventPropertyTypeBoolean:
+		switch v := value.(type) {
+		case bool:
+			return true
+		case string:
+			_, err := strconv.ParseBool(v)
+			return err == nil
+		}
+		return false
+	case types.EventPropertyTypeObject:
+		_, ok := value.(map[string]interface{})
+		return ok
+	case types.EventPropertyTypeArray:
+		_, ok := value.([]interface{})
+		return ok
+	}
+	return true
+}
@@ -0,0 +1,19 @@
+package eventschema
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// Repository defines the interface for event schema persistence operations
+type Repository interface {
+	Create(ctx context.Context, schema *EventSchema) error
+	Get(ctx context.Context, id string) (*EventSchema, error)
+	// GetByEventName returns the published schema of the event name or nil if the event name has no schema
+	GetByEventName(ctx context.Context, eventName string) (*EventSchema, error)
+	Update(ctx context.Context, schema *EventSchema) error
+	Delete(ctx context.Context, id string) error
+	List(ctx context.Context, filter *types.EventSchemaFilter) ([]*EventSchema, error)
+	Count(ctx context.Context, filter *types.EventSchemaFilter) (int, error)
+}
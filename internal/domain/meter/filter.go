@@ -0,0 +1,187 @@
+package meter
+
+import (
+	"fmt"
+	"strings"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// GetOperator returns the filter operator, defaulting to "in" for filters created
+// before operators were supported
+func (f Filter) GetOperator() types.MeterFilterOperator {
+	if f.Operator == "" {
+		return types.MeterFilterOperatorIn
+	}
+	return f.Operator
+}
+
+// IsExactMatch returns true if the filter only matches a list of exact values,
+// i.e. it can be expressed as a plain key => values filter
+func (f Filter) IsExactMatch() bool {
+	return f.GetOperator() == types.MeterFilterOperatorIn
+}
+
+// Validate validates the filter key, operator and operands
+func (f Filter) Validate() error {
+	if f.Key == "" {
+		return ierr.NewError("filter key cannot be empty").
+			WithHint("Please provide a key for each filter").
+			Mark(ierr.ErrValidation)
+	}
+
+	for _, part := range PropertyPath(f.Key) {
+		if part == "" {
+			return ierr.NewError("invalid filter key").
+				WithHint("Nested filter keys must be dot separated property names, e.g. request.region").
+				WithReportableDetails(map[string]interface{}{
+					"filter_key": f.Key,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	operator := f.GetOperator()
+	if !operator.Validate() {
+		return ierr.NewError("invalid filter operator").
+			WithHint("Filter operator must be one of in, not_in, range, prefix or exists").
+			WithReportableDetails(map[string]interface{}{
+				"filter_key": f.Key,
+				"operator":   f.Operator,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if operator.RequiresValues() && len(f.Values) == 0 {
+		return ierr.NewError("filter values cannot be empty").
+			WithHint("Please provide at least one value for each filter").
+			WithReportableDetails(map[string]interface{}{
+				"filter_key": f.Key,
+				"operator":   operator,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if !operator.RequiresValues() && len(f.Values) > 0 {
+		return ierr.NewError("filter values are not allowed for this operator").
+			WithHint("Values can only be used with the in, not_in and prefix operators").
+			WithReportableDetails(map[string]interface{}{
+				"filter_key": f.Key,
+				"operator":   operator,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if operator == types.MeterFilterOperatorRange {
+		if f.Min == nil && f.Max == nil {
+			return ierr.NewError("range filter requires min or max").
+				WithHint("Please provide at least one of min or max for the range filter").
+				WithReportableDetails(map[string]interface{}{
+					"filter_key": f.Key,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		if f.Min != nil && f.Max != nil && !f.Min.LessThan(*f.Max) {
+			return ierr.NewError("range filter min must be less than max").
+				WithHint("Please provide a min value lower than the max value").
+				WithReportableDetails(map[string]interface{}{
+					"filter_key": f.Key,
+					"min":        f.Min,
+					"max":        f.Max,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	} else if f.Min != nil || f.Max != nil {
+		return ierr.NewError("min and max can only be used with the range operator").
+			WithHint("Please set the operator to range or remove min and max").
+			WithReportableDetails(map[string]interface{}{
+				"filter_key": f.Key,
+				"operator":   operator,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+// Matches returns true if the event properties satisfy the filter
+func (f Filter) Matches(properties map[string]interface{}) bool {
+	value, exists := LookupProperty(properties, f.Key)
+	if !exists {
+		return false
+	}
+
+	switch f.GetOperator() {
+	case types.MeterFilterOperatorExists:
+		return true
+	case types.MeterFilterOperatorIn:
+		return lo.Contains(f.Values, fmt.Sprintf("%v", value))
+	case types.MeterFilterOperatorNotIn:
+		return !lo.Contains(f.Values, fmt.Sprintf("%v", value))
+	case types.MeterFilterOperatorPrefix:
+		propStr := fmt.Sprintf("%v", value)
+		return lo.SomeBy(f.Values, func(prefix string) bool {
+			return strings.HasPrefix(propStr, prefix)
+		})
+	case types.MeterFilterOperatorRange:
+		number, err := decimal.NewFromString(fmt.Sprintf("%v", value))
+		if err != nil {
+			return false
+		}
+		if f.Min != nil && number.LessThan(*f.Min) {
+			return false
+		}
+		if f.Max != nil && !number.LessThan(*f.Max) {
+			return false
+		}
+		return true
+	default:
+		return false
+	}
+}
+
+// MatchesAll returns true if the event properties satisfy all the filters
+func MatchesAll(filters []Filter, properties map[string]interface{}) bool {
+	for _, filter := range filters {
+		if !filter.Matches(properties) {
+			return false
+		}
+	}
+	return true
+}
+
+// PropertyPath splits a filter key into the path of property names,
+// e.g. "$.request.region" => ["request", "region"]
+func PropertyPath(key string) []string {
+	return strings.Split(strings.TrimPrefix(key, "$."), ".")
+}
+
+// LookupProperty returns the value of the property identified by the key. A first level
+// property matching the key as-is takes precedence over the nested path.
+func LookupProperty(properties map[string]interface{}, key string) (interface{}, bool) {
+	if value, exists := properties[key]; exists {
+		return value, true
+	}
+
+	path := PropertyPath(key)
+	if len(path) == 1 {
+		value, exists := properties[path[0]]
+		return value, exists
+	}
+
+	var current interface{} = properties
+	for _, part := range path {
+		object, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		current, ok = object[part]
+		if !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/flexprice/flexprice/ent"
 	"github.com/flexprice/flexprice/ent/schema"
 	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/expression"
 	"github.com/flexprice/flexprice/internal/types"
 	"github.com/shopspring/decimal"
 )
@@ -44,12 +45,24 @@ type Meter struct {
 
 type Filter struct {
 	// Key is the key for the filter from $event.properties
-	// Currently we support only first level keys in the properties and not nested keys
+	// Nested keys are supported using a dot separated path, optionally prefixed with "$."
+	// For ex "request.region" or "$.request.region". A first level key containing dots
+	// takes precedence over the nested path when both are present.
 	Key string `json:"key"`
 
+	// Operator is the operator used to match the property value, defaults to "in"
+	Operator types.MeterFilterOperator `json:"operator,omitempty"`
+
 	// Values are the possible values for the filter to be considered for the meter
 	// For ex "model_name" could have values "o1-mini", "gpt-4o" etc
+	// For the prefix operator these are the accepted prefixes
 	Values []string `json:"values"`
+
+	// Min is the inclusive lower bound for the range operator
+	Min *decimal.Decimal `json:"min,omitempty"`
+
+	// Max is the exclusive upper bound for the range operator
+	Max *decimal.Decimal `json:"max,omitempty"`
 }
 
 type Aggregation struct {
@@ -64,9 +77,18 @@ type Aggregation struct {
 	// to scale up by a factor of 1000. If not provided, it will be null.
 	Multiplier *decimal.Decimal `json:"multiplier,omitempty"`
 
-	// BucketSize is used only for MAX aggregation when windowed aggregation is needed
-	// It defines the size of time windows to calculate max values within
+	// BucketSize is used for MAX, MIN, FIRST and PERCENTILE aggregations when windowed aggregation is needed
+	// It defines the size of time windows to calculate the aggregated values within
 	BucketSize types.WindowSize `json:"bucket_size,omitempty"`
+
+	// Expression is an optional arithmetic expression over $event.properties used as the value
+	// to aggregate instead of Field, e.g. "input_tokens + 2 * output_tokens" or
+	// "duration_ms * cpu_count / 1000". Field and Expression are mutually exclusive.
+	Expression string `json:"expression,omitempty"`
+
+	// Percentile is the percentile to compute for PERCENTILE aggregation, in the range (0, 100]
+	// For ex a 95th percentile bandwidth meter would use 95
+	Percentile *decimal.Decimal `json:"percentile,omitempty"`
 }
 
 // FromEnt converts an Ent Meter to a domain Meter
@@ -79,8 +101,11 @@ func FromEnt(e *ent.Meter) *Meter {
 	filters := make([]Filter, len(e.Filters))
 	for i, f := range e.Filters {
 		filters[i] = Filter{
-			Key:    f.Key,
-			Values: f.Values,
+			Key:      f.Key,
+			Operator: f.Operator,
+			Values:   f.Values,
+			Min:      f.Min,
+			Max:      f.Max,
 		}
 	}
 
@@ -93,6 +118,8 @@ func FromEnt(e *ent.Meter) *Meter {
 			Field:      e.Aggregation.Field,
 			Multiplier: e.Aggregation.Multiplier,
 			BucketSize: e.Aggregation.BucketSize,
+			Percentile: e.Aggregation.Percentile,
+			Expression: e.Aggregation.Expression,
 		},
 		Filters:       filters,
 		ResetUsage:    types.ResetUsage(e.ResetUsage),
@@ -128,8 +155,11 @@ func (m *Meter) ToEntFilters() []schema.MeterFilter {
 	filters := make([]schema.MeterFilter, len(m.Filters))
 	for i, f := range m.Filters {
 		filters[i] = schema.MeterFilter{
-			Key:    f.Key,
-			Values: f.Values,
+			Key:      f.Key,
+			Operator: f.Operator,
+			Values:   f.Values,
+			Min:      f.Min,
+			Max:      f.Max,
 		}
 	}
 	return filters
@@ -142,6 +172,8 @@ func (m *Meter) ToEntAggregation() schema.MeterAggregation {
 		Field:      m.Aggregation.Field,
 		Multiplier: m.Aggregation.Multiplier,
 		BucketSize: m.Aggregation.BucketSize,
+		Percentile: m.Aggregation.Percentile,
+		Expression: m.Aggregation.Expression,
 	}
 }
 
@@ -170,7 +202,29 @@ func (m *Meter) Validate() error {
 			}).
 			Mark(ierr.ErrValidation)
 	}
-	if m.Aggregation.Type.RequiresField() && m.Aggregation.Field == "" {
+	if m.Aggregation.Expression != "" {
+		if !m.Aggregation.Type.SupportsExpression() {
+			return ierr.NewError("expression is not supported for aggregation type").
+				WithHint("Expressions can only be used with aggregations on numeric values").
+				WithReportableDetails(map[string]interface{}{
+					"aggregation_type": m.Aggregation.Type,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		if m.Aggregation.Field != "" {
+			return ierr.NewError("field and expression cannot both be set").
+				WithHint("Please provide either a field or an expression for the aggregation").
+				WithReportableDetails(map[string]interface{}{
+					"field":      m.Aggregation.Field,
+					"expression": m.Aggregation.Expression,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		if _, err := expression.Parse(m.Aggregation.Expression); err != nil {
+			return err
+		}
+	}
+	if m.Aggregation.Type.RequiresField() && m.Aggregation.Field == "" && m.Aggregation.Expression == "" {
 		return ierr.NewError("field is required for aggregation type").
 			WithHint("Please specify a field for this aggregation type").
 			WithReportableDetails(map[string]interface{}{
@@ -193,18 +247,40 @@ func (m *Meter) Validate() error {
 				Mark(ierr.ErrValidation)
 		}
 	}
-	// Validate bucket_size is only used with MAX aggregation
-	if m.Aggregation.BucketSize != "" && m.Aggregation.Type != types.AggregationMax {
-		return ierr.NewError("bucket_size can only be used with MAX aggregation").
-			WithHint("BucketSize is only valid for MAX aggregation type").
+	if m.Aggregation.Type == types.AggregationPercentile {
+		if m.Aggregation.Percentile == nil {
+			return ierr.NewError("percentile is required for PERCENTILE").
+				WithHint("Please provide a percentile value, e.g. 95").
+				Mark(ierr.ErrValidation)
+		}
+		if m.Aggregation.Percentile.LessThanOrEqual(decimal.Zero) || m.Aggregation.Percentile.GreaterThan(decimal.NewFromInt(100)) {
+			return ierr.NewError("invalid percentile value").
+				WithHint("Percentile must be greater than 0 and at most 100").
+				WithReportableDetails(map[string]interface{}{
+					"percentile": m.Aggregation.Percentile,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	} else if m.Aggregation.Percentile != nil {
+		return ierr.NewError("percentile can only be used with PERCENTILE aggregation").
+			WithHint("Percentile is only valid for PERCENTILE aggregation type").
+			WithReportableDetails(map[string]interface{}{
+				"aggregation_type": m.Aggregation.Type,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	// Validate bucket_size is only used with aggregations that support bucketing
+	if m.Aggregation.BucketSize != "" && !m.Aggregation.Type.SupportsBucketSize() {
+		return ierr.NewError("bucket_size can only be used with MAX, MIN, FIRST or PERCENTILE aggregation").
+			WithHint("BucketSize is only valid for MAX, MIN, FIRST and PERCENTILE aggregation types").
 			WithReportableDetails(map[string]interface{}{
 				"aggregation_type": m.Aggregation.Type,
 				"bucket_size":      m.Aggregation.BucketSize,
 			}).
 			Mark(ierr.ErrValidation)
 	}
-	// If bucket_size is provided for MAX aggregation, validate it's a valid window size
-	if m.IsBucketedMaxMeter() {
+	// If bucket_size is provided, validate it's a valid window size
+	if m.IsBucketedMeter() {
 		if err := m.Aggregation.BucketSize.Validate(); err != nil {
 			return ierr.NewError("invalid bucket_size").
 				WithHint("Please provide a valid window size for bucket_size").
@@ -216,26 +292,22 @@ func (m *Meter) Validate() error {
 	}
 
 	for _, filter := range m.Filters {
-		if filter.Key == "" {
-			return ierr.NewError("filter key cannot be empty").
-				WithHint("Please provide a key for each filter").
-				Mark(ierr.ErrValidation)
-		}
-		if len(filter.Values) == 0 {
-			return ierr.NewError("filter values cannot be empty").
-				WithHint("Please provide at least one value for each filter").
-				WithReportableDetails(map[string]interface{}{
-					"filter_key": filter.Key,
-				}).
-				Mark(ierr.ErrValidation)
+		if err := filter.Validate(); err != nil {
+			return err
 		}
 	}
 	return nil
 }
 
-// IsBucketedMaxMeter returns true if this is a max aggregation meter with bucket size
-func (m *Meter) IsBucketedMaxMeter() bool {
-	return m.Aggregation.Type == types.AggregationMax && m.Aggregation.BucketSize != ""
+// IsBucketedMeter returns true if this meter aggregates values per bucket and bills
+// each bucket independently (bucketed MAX, MIN, FIRST or PERCENTILE)
+func (m *Meter) IsBucketedMeter() bool {
+	return m.Aggregation.Type.SupportsBucketSize() && m.Aggregation.BucketSize != ""
+}
+
+// HasExpression returns true if this meter computes its value from an expression
+func (m *Meter) HasExpression() bool {
+	return m.Aggregation.Expression != ""
 }
 
 // HasBucketSize returns true if this meter has a bucket size configured
@@ -54,6 +54,8 @@ type Payment struct {
 	ErrorMessage *string `json:"error_message,omitempty"`
 	// The attempts array contains all processing attempts made for this payment (optional)
 	Attempts []*PaymentAttempt `json:"attempts,omitempty"`
+	// The refunds array contains the refunds issued against this payment (optional)
+	Refunds []*PaymentRefund `json:"refunds,omitempty"`
 	// The environment_id identifies which environment this payment belongs to
 	EnvironmentID string `json:"environment_id"`
 
@@ -82,6 +84,40 @@ type PaymentAttempt struct {
 	types.BaseModel
 }
 
+// PaymentRefund represents a refund of a payment through its payment gateway
+type PaymentRefund struct {
+	// Unique identifier for this refund
+	ID string `json:"id"`
+	// The payment_id links this refund to the refunded payment
+	PaymentID string `json:"payment_id"`
+	// The credit_note_id identifies the refund credit note that issued this refund (optional)
+	CreditNoteID *string `json:"credit_note_id,omitempty"`
+	// The amount field specifies the refunded value in the currency of the payment
+	Amount decimal.Decimal `json:"amount"`
+	// The currency field uses a three-letter ISO code (USD, EUR, GBP, etc.)
+	Currency string `json:"currency"`
+	// The refund_status shows the state of the refund at the gateway (pending, succeeded, failed)
+	RefundStatus types.PaymentStatus `json:"refund_status"`
+	// The payment_gateway field contains the name of the gateway processing the refund (optional)
+	PaymentGateway *string `json:"payment_gateway,omitempty"`
+	// The gateway_refund_id is the refund identifier from the external payment gateway (optional)
+	GatewayRefundID *string `json:"gateway_refund_id,omitempty"`
+	// The reason field records why the refund was issued
+	Reason string `json:"reason,omitempty"`
+	// The failure_reason field explains why the gateway failed the refund (optional)
+	FailureReason *string `json:"failure_reason,omitempty"`
+	// The succeeded_at timestamp shows when the gateway confirmed the refund (optional)
+	SucceededAt *time.Time `json:"succeeded_at,omitempty"`
+	// The failed_at timestamp indicates when the refund failed (optional)
+	FailedAt *time.Time `json:"failed_at,omitempty"`
+	// The metadata field stores additional custom data for this refund (optional)
+	Metadata types.Metadata `json:"metadata,omitempty"`
+	// The environment_id specifies which environment this refund belongs to
+	EnvironmentID string `json:"environment_id"`
+
+	types.BaseModel
+}
+
 // Validate validates the payment
 func (p *Payment) Validate() error {
 	if p.Amount.IsZero() || p.Amount.IsNegative() {
@@ -151,6 +187,21 @@ func (pa *PaymentAttempt) Validate() error {
 	return nil
 }
 
+// Validate validates the payment refund
+func (pr *PaymentRefund) Validate() error {
+	if pr.PaymentID == "" {
+		return ierr.NewError("invalid payment id").
+			WithHint("Payment id is invalid").
+			Mark(ierr.ErrValidation)
+	}
+	if !pr.Amount.IsPositive() {
+		return ierr.NewError("invalid refund amount").
+			WithHint("Refund amount must be greater than 0").
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
 // TableName returns the table name for the payment
 func (p *Payment) TableName() string {
 	return "payments"
@@ -161,6 +212,11 @@ func (pa *PaymentAttempt) TableName() string {
 	return "payment_attempts"
 }
 
+// TableName returns the table name for the payment refund
+func (pr *PaymentRefund) TableName() string {
+	return "payment_refunds"
+}
+
 // FromEnt converts an Ent payment to a domain payment
 func FromEnt(p *ent.Payment) *Payment {
 	if p == nil {
@@ -206,6 +262,10 @@ func FromEnt(p *ent.Payment) *Payment {
 		}
 	}
 
+	if p.Edges.Refunds != nil {
+		payment.Refunds = FromEntRefundList(p.Edges.Refunds)
+	}
+
 	return payment
 }
 
@@ -267,3 +327,48 @@ func FromEntAttemptList(attempts []*ent.PaymentAttempt) []*PaymentAttempt {
 	}
 	return result
 }
+
+// FromEntRefund converts an Ent payment refund to a domain payment refund
+func FromEntRefund(r *ent.PaymentRefund) *PaymentRefund {
+	if r == nil {
+		return nil
+	}
+
+	return &PaymentRefund{
+		ID:              r.ID,
+		PaymentID:       r.PaymentID,
+		CreditNoteID:    r.CreditNoteID,
+		Amount:          r.Amount,
+		Currency:        r.Currency,
+		RefundStatus:    types.PaymentStatus(r.RefundStatus),
+		PaymentGateway:  r.PaymentGateway,
+		GatewayRefundID: r.GatewayRefundID,
+		Reason:          r.Reason,
+		FailureReason:   r.FailureReason,
+		SucceededAt:     r.SucceededAt,
+		FailedAt:        r.FailedAt,
+		Metadata:        r.Metadata,
+		EnvironmentID:   r.EnvironmentID,
+		BaseModel: types.BaseModel{
+			TenantID:  r.TenantID,
+			Status:    types.Status(r.Status),
+			CreatedAt: r.CreatedAt,
+			UpdatedAt: r.UpdatedAt,
+			CreatedBy: r.CreatedBy,
+			UpdatedBy: r.UpdatedBy,
+		},
+	}
+}
+
+// FromEntRefundList converts a list of Ent payment refunds to domain payment refunds
+func FromEntRefundList(refunds []*ent.PaymentRefund) []*PaymentRefund {
+	if refunds == nil {
+		return nil
+	}
+
+	result := make([]*PaymentRefund, len(refunds))
+	for i, r := range refunds {
+		result[i] = FromEntRefund(r)
+	}
+	return result
+}
@@ -12,6 +12,11 @@ type Repository interface {
 	Create(ctx context.Context, payment *Payment) error
 	Get(ctx context.Context, id string) (*Payment, error)
 	Update(ctx context.Context, payment *Payment) error
+	// UpdateIfStatus updates the payment only while it is in one of the from statuses, it returns
+	// false when the payment was not in any of them
+	UpdateIfStatus(ctx context.Context, payment *Payment, from ...types.PaymentStatus) (bool, error)
+	// LockByDestination locks the payments of a destination until the end of the transaction of ctx
+	LockByDestination(ctx context.Context, destinationType types.PaymentDestinationType, destinationID string) error
 	Delete(ctx context.Context, id string) error
 	List(ctx context.Context, filter *types.PaymentFilter) ([]*Payment, error)
 	Count(ctx context.Context, filter *types.PaymentFilter) (int, error)
@@ -23,4 +28,11 @@ type Repository interface {
 	UpdateAttempt(ctx context.Context, attempt *PaymentAttempt) error
 	ListAttempts(ctx context.Context, paymentID string) ([]*PaymentAttempt, error)
 	GetLatestAttempt(ctx context.Context, paymentID string) (*PaymentAttempt, error)
+
+	// Payment refund operations
+	CreateRefund(ctx context.Context, refund *PaymentRefund) error
+	GetRefund(ctx context.Context, id string) (*PaymentRefund, error)
+	UpdateRefund(ctx context.Context, refund *PaymentRefund) error
+	ListRefunds(ctx context.Context, paymentID string) ([]*PaymentRefund, error)
+	ListRefundsByCreditNote(ctx context.Context, creditNoteID string) ([]*PaymentRefund, error)
 }
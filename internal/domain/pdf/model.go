@@ -29,6 +29,10 @@ type InvoiceData struct {
 	// Line items
 	LineItems []LineItemData `json:"line_items"`
 
+	// Sections group the line items per customer on consolidated invoices of parent customers,
+	// empty when the invoice only bills the usage of a single customer
+	Sections []InvoiceSectionData `json:"sections,omitempty"`
+
 	// Applied taxes (detailed breakdown)
 	AppliedTaxes []AppliedTaxData `json:"applied_taxes"`
 
@@ -75,6 +79,14 @@ type LineItemData struct {
 	Type            string     `json:"type"` // "subscription", "addon", "discount", "tax"
 }
 
+// InvoiceSectionData represents the line items of a single customer on a consolidated invoice
+type InvoiceSectionData struct {
+	CustomerID   string         `json:"customer_id"`
+	CustomerName string         `json:"customer_name"`
+	Subtotal     float64        `json:"subtotal"`
+	LineItems    []LineItemData `json:"line_items"`
+}
+
 // AppliedTaxData represents a tax applied to the invoice
 type AppliedTaxData struct {
 	TaxName       string  `json:"tax_name"`
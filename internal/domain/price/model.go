@@ -100,6 +100,16 @@ type Price struct {
 
 	TransformQuantity JSONBTransformQuantity `db:"transform_quantity,jsonb" json:"transform_quantity"`
 
+	// Matrix is the rate table keyed on event property dimensions in case of MATRIX billing model
+	Matrix *types.PriceMatrix `db:"matrix,jsonb" json:"matrix,omitempty"`
+
+	// MinCharge is the minimum amount charged for the price in a billing period
+	// The difference is billed as a separate true-up line item
+	MinCharge *decimal.Decimal `db:"min_charge" json:"min_charge,omitempty"`
+
+	// MaxCharge caps the amount charged for the price in a billing period
+	MaxCharge *decimal.Decimal `db:"max_charge" json:"max_charge,omitempty"`
+
 	Metadata JSONBMetadata `db:"metadata,jsonb" json:"metadata"`
 
 	// EnvironmentID is the environment identifier for the price
@@ -186,12 +196,32 @@ func (p *Price) CalculateAmount(quantity decimal.Decimal) decimal.Decimal {
 	return result
 }
 
+// ApplyChargeLimits returns the amount after applying the minimum and maximum charge of the price
+func (p *Price) ApplyChargeLimits(amount decimal.Decimal) decimal.Decimal {
+	if p.MaxCharge != nil && amount.GreaterThan(*p.MaxCharge) {
+		amount = *p.MaxCharge
+	}
+	if p.MinCharge != nil && amount.LessThan(*p.MinCharge) {
+		amount = *p.MinCharge
+	}
+	return amount
+}
+
+// HasChargeLimits returns true if the price has a minimum or maximum charge
+func (p *Price) HasChargeLimits() bool {
+	return p.MinCharge != nil || p.MaxCharge != nil
+}
+
 // CalculateTierAmount performs calculation for tier price with flat and fixed ampunt
 func (pt *PriceTier) CalculateTierAmount(quantity decimal.Decimal, currency string) decimal.Decimal {
 	tierCost := pt.UnitAmount.Mul(quantity)
 	if pt.FlatAmount != nil {
 		tierCost = tierCost.Add(*pt.FlatAmount)
 	}
+	// The tier minimum only applies once some usage falls in the tier
+	if pt.MinAmount != nil && quantity.GreaterThan(decimal.Zero) && tierCost.LessThan(*pt.MinAmount) {
+		tierCost = *pt.MinAmount
+	}
 	return tierCost
 }
 
@@ -240,6 +270,10 @@ type PriceTier struct {
 	// flat_amount is the flat amount for the given tier (optional)
 	// Applied on top of unit_amount*quantity. Useful for cases like "2.7$ + 5c"
 	FlatAmount *decimal.Decimal `json:"flat_amount,omitempty"`
+
+	// min_amount is the minimum amount charged for the tier once any usage falls in it (optional)
+	// Applied after unit_amount*quantity + flat_amount, ex "$0.01 per request, at least $5"
+	MinAmount *decimal.Decimal `json:"min_amount,omitempty"`
 }
 
 // TODO : comeup with a better way to handle jsonb fields
@@ -350,6 +384,7 @@ func FromEnt(e *ent.Price) *Price {
 			tiers[i] = PriceTier{
 				UpTo:       tier.UpTo,
 				UnitAmount: tier.UnitAmount,
+				MinAmount:  tier.MinAmount,
 			}
 			if tier.FlatAmount != nil {
 				flatAmount := tier.FlatAmount
@@ -366,6 +401,7 @@ func FromEnt(e *ent.Price) *Price {
 			priceUnitTiers[i] = PriceTier{
 				UpTo:       tier.UpTo,
 				UnitAmount: tier.UnitAmount,
+				MinAmount:  tier.MinAmount,
 			}
 			if tier.FlatAmount != nil {
 				flatAmount := tier.FlatAmount
@@ -394,6 +430,9 @@ func FromEnt(e *ent.Price) *Price {
 		LookupKey:              e.LookupKey,
 		Description:            e.Description,
 		TransformQuantity:      JSONBTransformQuantity(e.TransformQuantity),
+		Matrix:                 e.Matrix,
+		MinCharge:              e.MinCharge,
+		MaxCharge:              e.MaxCharge,
 		Metadata:               JSONBMetadata(e.Metadata),
 		EnvironmentID:          e.EnvironmentID,
 		PriceUnitID:            e.PriceUnitID,
@@ -442,6 +481,7 @@ func (p *Price) ToEntTiers() []*types.PriceTier {
 			UpTo:       tier.UpTo,
 			UnitAmount: tier.UnitAmount,
 			FlatAmount: tier.FlatAmount,
+			MinAmount:  tier.MinAmount,
 		}
 	}
 	return tiers
@@ -459,6 +499,7 @@ func (p *Price) ToPriceUnitTiers() []*types.PriceTier {
 			UpTo:       tier.UpTo,
 			UnitAmount: tier.UnitAmount,
 			FlatAmount: tier.FlatAmount,
+			MinAmount:  tier.MinAmount,
 		}
 	}
 	return tiers
@@ -0,0 +1,71 @@
+package promotion_code
+
+import (
+	"time"
+
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// PromotionCode represents a customer facing code that redeems a coupon
+type PromotionCode struct {
+	ID               string            `json:"id" db:"id"`
+	CouponID         string            `json:"coupon_id" db:"coupon_id"`
+	Code             string            `json:"code" db:"code"`
+	CustomerID       *string           `json:"customer_id,omitempty" db:"customer_id"`
+	ExpiresAt        *time.Time        `json:"expires_at,omitempty" db:"expires_at"`
+	MaxRedemptions   *int              `json:"max_redemptions,omitempty" db:"max_redemptions"`
+	TotalRedemptions int               `json:"total_redemptions" db:"total_redemptions"`
+	Metadata         map[string]string `json:"metadata,omitempty" db:"metadata"`
+	EnvironmentID    string            `json:"environment_id" db:"environment_id"`
+	types.BaseModel
+}
+
+// IsExpired checks if the promotion code has expired
+func (p *PromotionCode) IsExpired() bool {
+	return p.ExpiresAt != nil && time.Now().After(*p.ExpiresAt)
+}
+
+// HasRedemptionsLeft checks if the promotion code can still be redeemed
+func (p *PromotionCode) HasRedemptionsLeft() bool {
+	return p.MaxRedemptions == nil || p.TotalRedemptions < *p.MaxRedemptions
+}
+
+// FromEnt converts an ent.PromotionCode to a domain PromotionCode
+func FromEnt(e *ent.PromotionCode) *PromotionCode {
+	if e == nil {
+		return nil
+	}
+
+	return &PromotionCode{
+		ID:               e.ID,
+		CouponID:         e.CouponID,
+		Code:             e.Code,
+		CustomerID:       e.CustomerID,
+		ExpiresAt:        e.ExpiresAt,
+		MaxRedemptions:   e.MaxRedemptions,
+		TotalRedemptions: e.TotalRedemptions,
+		Metadata:         e.Metadata,
+		EnvironmentID:    e.EnvironmentID,
+		BaseModel: types.BaseModel{
+			TenantID:  e.TenantID,
+			Status:    types.Status(e.Status),
+			CreatedBy: e.CreatedBy,
+			UpdatedBy: e.UpdatedBy,
+			CreatedAt: e.CreatedAt,
+			UpdatedAt: e.UpdatedAt,
+		},
+	}
+}
+
+// FromEntList converts a list of ent.PromotionCode to domain PromotionCodes
+func FromEntList(list []*ent.PromotionCode) []*PromotionCode {
+	if list == nil {
+		return nil
+	}
+	codes := make([]*PromotionCode, len(list))
+	for i, item := range list {
+		codes[i] = FromEnt(item)
+	}
+	return codes
+}
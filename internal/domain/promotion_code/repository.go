@@ -0,0 +1,22 @@
+package promotion_code
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// Repository defines the interface for promotion code data access
+type Repository interface {
+	Create(ctx context.Context, promotionCode *PromotionCode) error
+	CreateBulk(ctx context.Context, promotionCodes []*PromotionCode) error
+	Get(ctx context.Context, id string) (*PromotionCode, error)
+	// GetByCode returns the published promotion code with the given code
+	GetByCode(ctx context.Context, code string) (*PromotionCode, error)
+	Delete(ctx context.Context, id string) error
+	List(ctx context.Context, filter *types.PromotionCodeFilter) ([]*PromotionCode, error)
+	Count(ctx context.Context, filter *types.PromotionCodeFilter) (int, error)
+	// IncrementRedemptions counts a redemption of the promotion code, it returns a validation error
+	// when the promotion code has no redemptions left
+	IncrementRedemptions(ctx context.Context, id string) error
+}
@@ -57,6 +57,22 @@ func (li *SubscriptionLineItem) IsActive(t time.Time) bool {
 	return true
 }
 
+// IsActiveInPeriod returns true if the line item is active at any time of the period [periodStart, periodEnd)
+func (li *SubscriptionLineItem) IsActiveInPeriod(periodStart, periodEnd time.Time) bool {
+	if li.Status != types.StatusPublished || li.StartDate.IsZero() {
+		return false
+	}
+
+	if !li.StartDate.Before(periodEnd) {
+		return false
+	}
+
+	if !li.EndDate.IsZero() && !li.EndDate.After(periodStart) {
+		return false
+	}
+	return true
+}
+
 func (li *SubscriptionLineItem) IsUsage() bool {
 	return li.PriceType == types.PRICE_TYPE_USAGE && li.MeterID != ""
 }
@@ -98,6 +98,18 @@ type Subscription struct {
 	// OverageFactor is a multiplier applied to usage beyond the commitment amount
 	OverageFactor *decimal.Decimal `db:"overage_factor" json:"overage_factor,omitempty"`
 
+	// CommitmentType determines if the commitment applies to each billing period or to a commitment term
+	CommitmentType types.CommitmentType `db:"commitment_type" json:"commitment_type,omitempty"`
+
+	// CommitmentDuration is the length of the commitment term for prepaid and postpaid commitments
+	CommitmentDuration *types.BillingPeriod `db:"commitment_duration" json:"commitment_duration,omitempty"`
+
+	// CommitmentStartDate is the start of the current commitment term
+	CommitmentStartDate *time.Time `db:"commitment_start_date" json:"commitment_start_date,omitempty"`
+
+	// CommitmentEndDate is the end of the current commitment term
+	CommitmentEndDate *time.Time `db:"commitment_end_date" json:"commitment_end_date,omitempty"`
+
 	// PaymentBehavior determines how subscription payments are handled
 	PaymentBehavior string `db:"payment_behavior" json:"payment_behavior"`
 
@@ -117,6 +129,9 @@ type Subscription struct {
 
 	ProrationBehavior types.ProrationBehavior `json:"proration_behavior"`
 
+	// UsageAlerts are the spend and usage thresholds evaluated against the current billing period
+	UsageAlerts []types.UsageAlert `db:"usage_alerts" json:"usage_alerts,omitempty"`
+
 	types.BaseModel
 }
 
@@ -173,6 +188,10 @@ func GetSubscriptionFromEnt(sub *ent.Subscription) *Subscription {
 		ActivePauseID:          sub.ActivePauseID,
 		CommitmentAmount:       sub.CommitmentAmount,
 		OverageFactor:          sub.OverageFactor,
+		CommitmentType:         types.CommitmentType(sub.CommitmentType),
+		CommitmentDuration:     (*types.BillingPeriod)(sub.CommitmentDuration),
+		CommitmentStartDate:    sub.CommitmentStartDate,
+		CommitmentEndDate:      sub.CommitmentEndDate,
 		PaymentBehavior:        string(sub.PaymentBehavior),
 		CollectionMethod:       string(sub.CollectionMethod),
 		GatewayPaymentMethodID: lo.ToPtr(sub.GatewayPaymentMethodID),
@@ -182,6 +201,7 @@ func GetSubscriptionFromEnt(sub *ent.Subscription) *Subscription {
 		Pauses:             pauses,
 		CustomerTimezone:   sub.CustomerTimezone,
 		ProrationBehavior:  types.ProrationBehavior(sub.ProrationBehavior),
+		UsageAlerts:        sub.UsageAlerts,
 		BaseModel: types.BaseModel{
 			TenantID:  sub.TenantID,
 			Status:    types.Status(sub.Status),
@@ -192,3 +212,35 @@ func GetSubscriptionFromEnt(sub *ent.Subscription) *Subscription {
 		},
 	}
 }
+
+// GetCommitmentType returns the commitment type, defaulting to a commitment per billing period
+func (s *Subscription) GetCommitmentType() types.CommitmentType {
+	if s.CommitmentType == "" {
+		return types.CommitmentTypeBillingPeriod
+	}
+	return s.CommitmentType
+}
+
+// HasTermCommitment returns true if the subscription has a prepaid or postpaid commitment
+// drawn down across the billing periods of a commitment term
+func (s *Subscription) HasTermCommitment() bool {
+	return s.GetCommitmentType().IsTermCommitment() &&
+		s.CommitmentStartDate != nil &&
+		s.CommitmentEndDate != nil &&
+		lo.FromPtr(s.CommitmentAmount).IsPositive()
+}
+
+// GetOverageFactor returns the multiplier applied to usage beyond the commitment, defaulting to 1
+func (s *Subscription) GetOverageFactor() decimal.Decimal {
+	if s.OverageFactor == nil || s.OverageFactor.LessThan(decimal.NewFromInt(1)) {
+		return decimal.NewFromInt(1)
+	}
+	return *s.OverageFactor
+}
+
+// CalculateCommitmentEndDate returns the end of the commitment term starting at the given time,
+// capped at the subscription end date
+func (s *Subscription) CalculateCommitmentEndDate(termStart time.Time) (time.Time, error) {
+	duration := lo.FromPtrOr(s.CommitmentDuration, types.BILLING_PERIOD_ANNUAL)
+	return types.NextBillingDate(termStart, termStart, 1, duration, s.EndDate)
+}
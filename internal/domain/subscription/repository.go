@@ -28,6 +28,9 @@ type Repository interface {
 
 	// Renewal due alert methods
 	ListSubscriptionsDueForRenewal(ctx context.Context) ([]*Subscription, error)
+
+	// Usage alert methods
+	ListSubscriptionsWithUsageAlerts(ctx context.Context) ([]*Subscription, error)
 }
 
 // SubscriptionScheduleRepository provides access to the subscription schedule store
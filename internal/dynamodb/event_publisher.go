@@ -2,12 +2,13 @@ package dynamodb
 
 import (
 	"context"
-
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	dynamodbtypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/cenkalti/backoff/v4"
 	ierr "github.com/flexprice/flexprice/internal/errors"
 	"go.uber.org/zap"
 
@@ -30,6 +31,15 @@ func NewEventPublisher(client *Client, cfg *config.Configuration, logger *logger
 	}
 }
 
+const (
+	// batchWriteSize is the maximum number of items of a BatchWriteItem request
+	batchWriteSize = 25
+	// batchWriteRetries bounds the retries of the items left unprocessed by throttling
+	batchWriteRetries = 5
+	// batchWriteInitialInterval is the first backoff interval, doubled on every retry
+	batchWriteInitialInterval = 100 * time.Millisecond
+)
+
 type DynamoEvent struct {
 	PK                 string                 `dynamodbav:"pk"` // TenantID
 	SK                 string                 `dynamodbav:"sk"` // EventID
@@ -44,24 +54,9 @@ type DynamoEvent struct {
 }
 
 func (p *EventPublisher) Publish(ctx context.Context, event *events.Event) error {
-	dynamoEvent := &DynamoEvent{
-		PK:                 event.TenantID,
-		SK:                 event.ID,
-		EventName:          event.EventName,
-		Properties:         event.Properties,
-		Timestamp:          event.Timestamp,
-		Source:             event.Source,
-		EnvironmentID:      event.EnvironmentID,
-		IngestedAt:         time.Now(),
-		CustomerID:         event.CustomerID,
-		ExternalCustomerID: event.ExternalCustomerID,
-	}
-
-	item, err := attributevalue.MarshalMap(dynamoEvent)
+	item, err := toDynamoItem(event)
 	if err != nil {
-		return ierr.WithError(err).
-			WithHint("Failed to marshal event").
-			Mark(ierr.ErrValidation)
+		return err
 	}
 
 	input := &dynamodb.PutItemInput{
@@ -85,3 +80,102 @@ func (p *EventPublisher) Publish(ctx context.Context, event *events.Event) error
 
 	return nil
 }
+
+// PublishBatch writes the events with BatchWriteItem requests of up to 25 items, retrying the
+// items left unprocessed by throttling with an exponential backoff. BatchWriteItem rejects requests
+// holding the same key twice, an event repeated in the batch is written once with its last version,
+// like consecutive PutItem calls would.
+func (p *EventPublisher) PublishBatch(ctx context.Context, batch []*events.Event) error {
+	p.logger.With(
+		zap.Int("count", len(batch)),
+	).Debug("publishing event batch to dynamodb")
+
+	positions := make(map[[2]string]int, len(batch))
+	unique := make([]*events.Event, 0, len(batch))
+	for _, event := range batch {
+		key := [2]string{event.TenantID, event.ID}
+		if position, ok := positions[key]; ok {
+			unique[position] = event
+			continue
+		}
+		positions[key] = len(unique)
+		unique = append(unique, event)
+	}
+
+	for start := 0; start < len(unique); start += batchWriteSize {
+		end := min(start+batchWriteSize, len(unique))
+
+		requests := make([]dynamodbtypes.WriteRequest, 0, end-start)
+		for _, event := range unique[start:end] {
+			item, err := toDynamoItem(event)
+			if err != nil {
+				return err
+			}
+			requests = append(requests, dynamodbtypes.WriteRequest{
+				PutRequest: &dynamodbtypes.PutRequest{Item: item},
+			})
+		}
+
+		if err := p.batchWriteWithRetry(ctx, requests); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// batchWriteWithRetry writes the requests, errors of the whole request are already retried by the
+// SDK and are not retried again
+func (p *EventPublisher) batchWriteWithRetry(ctx context.Context, requests []dynamodbtypes.WriteRequest) error {
+	pending := map[string][]dynamodbtypes.WriteRequest{p.tableName: requests}
+	operation := func() error {
+		output, err := p.client.db.BatchWriteItem(ctx, &dynamodb.BatchWriteItemInput{
+			RequestItems: pending,
+		})
+		if err != nil {
+			return backoff.Permanent(ierr.WithError(err).
+				WithHint("Failed to batch write items in dynamodb").
+				Mark(ierr.ErrValidation))
+		}
+
+		pending = output.UnprocessedItems
+		if len(pending) == 0 {
+			return nil
+		}
+		p.logger.Warnw("dynamodb left items unprocessed, retrying",
+			"unprocessed", len(pending[p.tableName]))
+		return ierr.NewError("dynamodb left items unprocessed").
+			WithHint("Failed to write all items of the batch to dynamodb").
+			WithReportableDetails(map[string]interface{}{
+				"unprocessed": len(pending[p.tableName]),
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	policy := backoff.NewExponentialBackOff()
+	policy.InitialInterval = batchWriteInitialInterval
+	return backoff.Retry(operation, backoff.WithContext(backoff.WithMaxRetries(policy, batchWriteRetries), ctx))
+}
+
+func toDynamoItem(event *events.Event) (map[string]dynamodbtypes.AttributeValue, error) {
+	dynamoEvent := &DynamoEvent{
+		PK:                 event.TenantID,
+		SK:                 event.ID,
+		EventName:          event.EventName,
+		Properties:         event.Properties,
+		Timestamp:          event.Timestamp,
+		Source:             event.Source,
+		EnvironmentID:      event.EnvironmentID,
+		IngestedAt:         time.Now(),
+		CustomerID:         event.CustomerID,
+		ExternalCustomerID: event.ExternalCustomerID,
+	}
+
+	item, err := attributevalue.MarshalMap(dynamoEvent)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to marshal event").
+			Mark(ierr.ErrValidation)
+	}
+	return item, nil
+}
@@ -0,0 +1,64 @@
+package expression
+
+import (
+	"container/list"
+	"sync"
+)
+
+// cacheSize is the maximum number of parsed expressions kept in memory. The expressions of the meters
+// are parsed on every event and stay cached, expressions only parsed to validate a request are evicted.
+const cacheSize = 1024
+
+// lruCache is a fixed size cache of parsed expressions, evicting the least recently used one when full
+type lruCache struct {
+	mu      sync.Mutex
+	size    int
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+func newLRUCache(size int) *lruCache {
+	return &lruCache{
+		size:    size,
+		order:   list.New(),
+		entries: make(map[string]*list.Element, size),
+	}
+}
+
+// get returns the cached expression of the source and marks it as recently used
+func (c *lruCache) get(source string) (*Expression, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[source]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(elem)
+	return elem.Value.(*Expression), true
+}
+
+// add caches the expression, evicting the least recently used expression when the cache is full
+func (c *lruCache) add(expr *Expression) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[expr.source]; ok {
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	c.entries[expr.source] = c.order.PushFront(expr)
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*Expression).source)
+	}
+}
+
+// len returns the number of cached expressions
+func (c *lruCache) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
@@ -0,0 +1,237 @@
+// Package expression implements the arithmetic expressions used to compute meter values
+// from multiple event properties, e.g. "input_tokens + 2 * output_tokens".
+//
+// An expression is made of property keys from $event.properties, numeric literals,
+// the binary operators + - * /, unary minus and parentheses. The same parsed expression
+// can be evaluated in Go against an event's properties (used while tracking feature usage)
+// and translated to a ClickHouse SQL expression (used by the usage aggregators), so both
+// paths always compute the same value:
+//   - a missing or non-numeric property evaluates to 0, like JSONExtractFloat
+//   - a division by zero evaluates to 0, like ifNotFinite(a / b, 0)
+package expression
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/shopspring/decimal"
+)
+
+// MaxLength is the maximum allowed length of an expression
+const MaxLength = 512
+
+// Expression is a parsed arithmetic expression over event properties
+type Expression struct {
+	source string
+	root   node
+	fields []string
+}
+
+// cache holds parsed expressions keyed by their source to avoid re-parsing on every event
+var cache = newLRUCache(cacheSize)
+
+// Parse parses and validates an expression
+func Parse(source string) (*Expression, error) {
+	if cached, ok := cache.get(source); ok {
+		return cached, nil
+	}
+
+	if strings.TrimSpace(source) == "" {
+		return nil, ierr.NewError("expression cannot be empty").
+			WithHint("Please provide a valid arithmetic expression").
+			Mark(ierr.ErrValidation)
+	}
+
+	if len(source) > MaxLength {
+		return nil, ierr.NewError("expression is too long").
+			WithHint(fmt.Sprintf("Expression must be at most %d characters", MaxLength)).
+			WithReportableDetails(map[string]interface{}{
+				"length": len(source),
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	tokens, err := tokenize(source)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens, source: source}
+	root, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+	if !p.done() {
+		return nil, newSyntaxError(source, p.peek().pos, fmt.Sprintf("unexpected token %q", p.peek().text))
+	}
+
+	fieldSet := make(map[string]bool)
+	fields := make([]string, 0)
+	collectFields(root, fieldSet, &fields)
+	if len(fields) == 0 {
+		return nil, ierr.NewError("expression must reference at least one property").
+			WithHint("Use property keys from the event properties, e.g. input_tokens + output_tokens").
+			WithReportableDetails(map[string]interface{}{
+				"expression": source,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	expr := &Expression{
+		source: source,
+		root:   root,
+		fields: fields,
+	}
+	cache.add(expr)
+	return expr, nil
+}
+
+// String returns the source of the expression
+func (e *Expression) String() string {
+	return e.source
+}
+
+// Fields returns the distinct property keys referenced by the expression
+func (e *Expression) Fields() []string {
+	return e.fields
+}
+
+// Evaluate evaluates the expression against the given event properties
+func (e *Expression) Evaluate(properties map[string]interface{}) decimal.Decimal {
+	return e.root.eval(properties)
+}
+
+// ToClickHouseSQL translates the expression to a ClickHouse SQL expression, reading
+// property values from the given JSON column expression, e.g. "assumeNotNull(properties)"
+func (e *Expression) ToClickHouseSQL(column string) string {
+	return e.root.sql(column)
+}
+
+// node is a node of the expression tree
+type node interface {
+	eval(properties map[string]interface{}) decimal.Decimal
+	sql(column string) string
+}
+
+type numberNode struct {
+	value decimal.Decimal
+}
+
+func (n *numberNode) eval(map[string]interface{}) decimal.Decimal {
+	return n.value
+}
+
+func (n *numberNode) sql(string) string {
+	return n.value.String()
+}
+
+type fieldNode struct {
+	key string
+}
+
+func (n *fieldNode) eval(properties map[string]interface{}) decimal.Decimal {
+	val, ok := properties[n.key]
+	if !ok {
+		return decimal.Zero
+	}
+	return toDecimal(val)
+}
+
+func (n *fieldNode) sql(column string) string {
+	return fmt.Sprintf("JSONExtractFloat(%s, '%s')", column, n.key)
+}
+
+type unaryNode struct {
+	operand node
+}
+
+func (n *unaryNode) eval(properties map[string]interface{}) decimal.Decimal {
+	return n.operand.eval(properties).Neg()
+}
+
+func (n *unaryNode) sql(column string) string {
+	return fmt.Sprintf("(-%s)", n.operand.sql(column))
+}
+
+type binaryNode struct {
+	op    byte
+	left  node
+	right node
+}
+
+func (n *binaryNode) eval(properties map[string]interface{}) decimal.Decimal {
+	left := n.left.eval(properties)
+	right := n.right.eval(properties)
+	switch n.op {
+	case '+':
+		return left.Add(right)
+	case '-':
+		return left.Sub(right)
+	case '*':
+		return left.Mul(right)
+	case '/':
+		if right.IsZero() {
+			return decimal.Zero
+		}
+		return left.Div(right)
+	}
+	return decimal.Zero
+}
+
+func (n *binaryNode) sql(column string) string {
+	if n.op == '/' {
+		return fmt.Sprintf("ifNotFinite((%s / %s), 0)", n.left.sql(column), n.right.sql(column))
+	}
+	return fmt.Sprintf("(%s %c %s)", n.left.sql(column), n.op, n.right.sql(column))
+}
+
+func collectFields(n node, seen map[string]bool, fields *[]string) {
+	switch v := n.(type) {
+	case *fieldNode:
+		if !seen[v.key] {
+			seen[v.key] = true
+			*fields = append(*fields, v.key)
+		}
+	case *unaryNode:
+		collectFields(v.operand, seen, fields)
+	case *binaryNode:
+		collectFields(v.left, seen, fields)
+		collectFields(v.right, seen, fields)
+	}
+}
+
+// toDecimal converts a property value to a decimal, returning zero for non-numeric values
+func toDecimal(val interface{}) decimal.Decimal {
+	switch v := val.(type) {
+	case float64:
+		return decimal.NewFromFloat(v)
+	case float32:
+		return decimal.NewFromFloat32(v)
+	case int:
+		return decimal.NewFromInt(int64(v))
+	case int64:
+		return decimal.NewFromInt(v)
+	case int32:
+		return decimal.NewFromInt(int64(v))
+	case uint:
+		return decimal.NewFromUint64(uint64(v))
+	case uint64:
+		return decimal.NewFromUint64(v)
+	case string:
+		d, err := decimal.NewFromString(v)
+		if err != nil {
+			return decimal.Zero
+		}
+		return d
+	case json.Number:
+		d, err := decimal.NewFromString(string(v))
+		if err != nil {
+			return decimal.Zero
+		}
+		return d
+	default:
+		return decimal.Zero
+	}
+}
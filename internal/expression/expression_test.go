@@ -0,0 +1,148 @@
+package expression
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		wantFields []string
+		wantSQL    string
+		wantErr    bool
+	}{
+		{
+			name:       "sum of two properties",
+			expression: "input_tokens + output_tokens",
+			wantFields: []string{"input_tokens", "output_tokens"},
+			wantSQL:    "(JSONExtractFloat(properties, 'input_tokens') + JSONExtractFloat(properties, 'output_tokens'))",
+		},
+		{
+			name:       "operator precedence",
+			expression: "input_tokens + 2 * output_tokens",
+			wantFields: []string{"input_tokens", "output_tokens"},
+			wantSQL:    "(JSONExtractFloat(properties, 'input_tokens') + (2 * JSONExtractFloat(properties, 'output_tokens')))",
+		},
+		{
+			name:       "division guarded against zero",
+			expression: "(bytes_in + bytes_out) / 1024",
+			wantFields: []string{"bytes_in", "bytes_out"},
+			wantSQL:    "ifNotFinite(((JSONExtractFloat(properties, 'bytes_in') + JSONExtractFloat(properties, 'bytes_out')) / 1024), 0)",
+		},
+		{
+			name:       "repeated property is reported once",
+			expression: "-duration * duration",
+			wantFields: []string{"duration"},
+			wantSQL:    "((-JSONExtractFloat(properties, 'duration')) * JSONExtractFloat(properties, 'duration'))",
+		},
+		{
+			name:       "empty expression",
+			expression: " ",
+			wantErr:    true,
+		},
+		{
+			name:       "no properties referenced",
+			expression: "1 + 2",
+			wantErr:    true,
+		},
+		{
+			name:       "invalid character",
+			expression: "tokens; DROP TABLE events",
+			wantErr:    true,
+		},
+		{
+			name:       "missing closing parenthesis",
+			expression: "(input_tokens + output_tokens",
+			wantErr:    true,
+		},
+		{
+			name:       "dangling operator",
+			expression: "input_tokens +",
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expr, err := Parse(tt.expression)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantFields, expr.Fields())
+			assert.Equal(t, tt.wantSQL, expr.ToClickHouseSQL("properties"))
+		})
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		properties map[string]interface{}
+		want       decimal.Decimal
+	}{
+		{
+			name:       "weighted token usage",
+			expression: "input_tokens + 2 * output_tokens",
+			properties: map[string]interface{}{"input_tokens": float64(100), "output_tokens": "50"},
+			want:       decimal.NewFromInt(200),
+		},
+		{
+			name:       "missing property evaluates to zero",
+			expression: "input_tokens + output_tokens",
+			properties: map[string]interface{}{"input_tokens": float64(10)},
+			want:       decimal.NewFromInt(10),
+		},
+		{
+			name:       "non numeric property evaluates to zero",
+			expression: "input_tokens * 3",
+			properties: map[string]interface{}{"input_tokens": "abc"},
+			want:       decimal.Zero,
+		},
+		{
+			name:       "division by zero evaluates to zero",
+			expression: "bytes / interval",
+			properties: map[string]interface{}{"bytes": float64(10), "interval": float64(0)},
+			want:       decimal.Zero,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expr, err := Parse(tt.expression)
+			require.NoError(t, err)
+			assert.True(t, tt.want.Equal(expr.Evaluate(tt.properties)), "got %s, want %s", expr.Evaluate(tt.properties), tt.want)
+		})
+	}
+}
+
+func TestCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	c := newLRUCache(2)
+	parse := func(source string) *Expression {
+		expr, err := Parse(source)
+		require.NoError(t, err)
+		return expr
+	}
+
+	c.add(parse("a + 1"))
+	c.add(parse("b + 1"))
+	_, ok := c.get("a + 1")
+	require.True(t, ok)
+
+	// b is the least recently used expression once a was read
+	c.add(parse("c + 1"))
+	assert.Equal(t, 2, c.len())
+	_, ok = c.get("b + 1")
+	assert.False(t, ok)
+	_, ok = c.get("a + 1")
+	assert.True(t, ok)
+	_, ok = c.get("c + 1")
+	assert.True(t, ok)
+}
@@ -0,0 +1,182 @@
+package expression
+
+import (
+	"fmt"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/shopspring/decimal"
+)
+
+type tokenKind int
+
+const (
+	tokenNumber tokenKind = iota
+	tokenIdent
+	tokenOperator
+	tokenLParen
+	tokenRParen
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+// tokenize splits the source into tokens
+func tokenize(source string) ([]token, error) {
+	tokens := make([]token, 0)
+	for i := 0; i < len(source); {
+		c := source[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '+' || c == '-' || c == '*' || c == '/':
+			tokens = append(tokens, token{kind: tokenOperator, text: string(c), pos: i})
+			i++
+		case c == '(':
+			tokens = append(tokens, token{kind: tokenLParen, text: "(", pos: i})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{kind: tokenRParen, text: ")", pos: i})
+			i++
+		case isDigit(c) || c == '.':
+			start := i
+			for i < len(source) && (isDigit(source[i]) || source[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: source[start:i], pos: start})
+		case isIdentStart(c):
+			start := i
+			for i < len(source) && isIdentPart(source[i]) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenIdent, text: source[start:i], pos: start})
+		default:
+			return nil, newSyntaxError(source, i, fmt.Sprintf("unexpected character %q", c))
+		}
+	}
+	return tokens, nil
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isIdentPart(c byte) bool {
+	return isIdentStart(c) || isDigit(c)
+}
+
+// parser is a recursive descent parser for the grammar:
+//
+//	expression = term { ("+" | "-") term }
+//	term       = unary { ("*" | "/") unary }
+//	unary      = "-" unary | primary
+//	primary    = number | identifier | "(" expression ")"
+type parser struct {
+	tokens []token
+	pos    int
+	source string
+}
+
+func (p *parser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	p.pos++
+	return t
+}
+
+func (p *parser) parseExpression() (node, error) {
+	left, err := p.parseTerm()
+	if err != nil {
+		return nil, err
+	}
+	for !p.done() && p.peek().kind == tokenOperator && (p.peek().text == "+" || p.peek().text == "-") {
+		op := p.next().text[0]
+		right, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryNode{op: op, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseTerm() (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for !p.done() && p.peek().kind == tokenOperator && (p.peek().text == "*" || p.peek().text == "/") {
+		op := p.next().text[0]
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryNode{op: op, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseUnary() (node, error) {
+	if !p.done() && p.peek().kind == tokenOperator && p.peek().text == "-" {
+		p.next()
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &unaryNode{operand: operand}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	if p.done() {
+		return nil, newSyntaxError(p.source, len(p.source), "unexpected end of expression")
+	}
+
+	t := p.next()
+	switch t.kind {
+	case tokenNumber:
+		value, err := decimal.NewFromString(t.text)
+		if err != nil {
+			return nil, newSyntaxError(p.source, t.pos, fmt.Sprintf("invalid number %q", t.text))
+		}
+		return &numberNode{value: value}, nil
+	case tokenIdent:
+		return &fieldNode{key: t.text}, nil
+	case tokenLParen:
+		inner, err := p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		if p.done() || p.peek().kind != tokenRParen {
+			return nil, newSyntaxError(p.source, len(p.source), "missing closing parenthesis")
+		}
+		p.next()
+		return inner, nil
+	default:
+		return nil, newSyntaxError(p.source, t.pos, fmt.Sprintf("unexpected token %q", t.text))
+	}
+}
+
+func newSyntaxError(source string, pos int, msg string) error {
+	return ierr.NewErrorf("invalid expression: %s", msg).
+		WithHint("Expressions support property keys, numbers, + - * / and parentheses, e.g. input_tokens + 2 * output_tokens").
+		WithReportableDetails(map[string]interface{}{
+			"expression": source,
+			"position":   pos,
+		}).
+		Mark(ierr.ErrValidation)
+}
@@ -0,0 +1,169 @@
+package httpforwarder
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/config"
+	"github.com/flexprice/flexprice/internal/domain/events"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+const (
+	// SignatureHeader carries the hex encoded HMAC-SHA256 of the request timestamp and body
+	SignatureHeader = "X-Flexprice-Signature"
+	// TimestampHeader carries the unix time the request was signed at
+	TimestampHeader = "X-Flexprice-Timestamp"
+
+	defaultBatchSize = 100
+)
+
+// ForwardRequest is the body posted to the endpoint
+type ForwardRequest struct {
+	Events []*events.Event `json:"events"`
+}
+
+// EventPublisher forwards events to an HTTP endpoint in batches. Requests failing with a connection
+// error, a 429 or a 5xx are retried with an exponential backoff.
+type EventPublisher struct {
+	client *retryablehttp.Client
+	config *config.HTTPForwarderConfig
+	logger *logger.Logger
+}
+
+func NewEventPublisher(cfg *config.Configuration, logger *logger.Logger) (*EventPublisher, error) {
+	if cfg.HTTPForwarder.URL == "" {
+		return nil, fmt.Errorf("http_forwarder url is required to forward events over http")
+	}
+
+	timeout := time.Duration(cfg.HTTPForwarder.TimeoutSeconds) * time.Second
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+
+	client := retryablehttp.NewClient()
+	client.RetryMax = max(cfg.HTTPForwarder.MaxRetries, 0)
+	client.RetryWaitMin = 500 * time.Millisecond
+	client.RetryWaitMax = 10 * time.Second
+	client.HTTPClient.Timeout = timeout
+	client.Logger = logger.GetRetryableHTTPLogger()
+
+	return &EventPublisher{
+		client: client,
+		config: &cfg.HTTPForwarder,
+		logger: logger,
+	}, nil
+}
+
+func (p *EventPublisher) Publish(ctx context.Context, event *events.Event) error {
+	return p.PublishBatch(ctx, []*events.Event{event})
+}
+
+// PublishBatch posts the events in requests of batch_size events
+func (p *EventPublisher) PublishBatch(ctx context.Context, batch []*events.Event) error {
+	batchSize := p.config.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+
+	p.logger.With(
+		zap.Int("count", len(batch)),
+		zap.String("url", p.config.URL),
+	).Debug("forwarding events over http")
+
+	for _, chunk := range lo.Chunk(batch, batchSize) {
+		if err := p.forward(ctx, chunk); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *EventPublisher) forward(ctx context.Context, batch []*events.Event) error {
+	body, err := json.Marshal(&ForwardRequest{Events: batch})
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to marshal events").
+			Mark(ierr.ErrValidation)
+	}
+
+	req, err := retryablehttp.NewRequestWithContext(ctx, http.MethodPost, p.config.URL, body)
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to create the forward request").
+			Mark(ierr.ErrSystem)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range p.config.Headers {
+		req.Header.Set(key, value)
+	}
+	if p.config.Secret != "" {
+		// retries resend the same headers, the receiver tolerates a few minutes of age
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		req.Header.Set(TimestampHeader, timestamp)
+		req.Header.Set(SignatureHeader, Sign(p.config.Secret, timestamp, body))
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to forward events over http").
+			WithReportableDetails(map[string]interface{}{
+				"url":   p.config.URL,
+				"count": len(batch),
+			}).
+			Mark(ierr.ErrSystem)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return ierr.NewErrorf("http forwarder returned status %d", resp.StatusCode).
+			WithHint("Failed to forward events over http").
+			WithReportableDetails(map[string]interface{}{
+				"url":      p.config.URL,
+				"count":    len(batch),
+				"response": string(bytes.TrimSpace(respBody)),
+			}).
+			Mark(ierr.ErrSystem)
+	}
+	return nil
+}
+
+// Sign returns the signature of the timestamp and the body, receivers compute it with their copy of the secret
+func Sign(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify tells whether the signature was computed over the timestamp and the body with the secret, and
+// whether the timestamp is within tolerance of now so that a captured request cannot be replayed later
+func Verify(secret string, timestamp string, body []byte, signature string, tolerance time.Duration, now time.Time) bool {
+	signedAt, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return false
+	}
+
+	age := now.Sub(time.Unix(signedAt, 0))
+	if age > tolerance || age < -tolerance {
+		return false
+	}
+
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
@@ -0,0 +1,26 @@
+package httpforwarder
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerify(t *testing.T) {
+	body := []byte(`{"events":[{"id":"evt_1"}]}`)
+	now := time.Unix(1700000000, 0)
+	timestamp := "1700000000"
+	signature := Sign("secret", timestamp, body)
+
+	assert.True(t, Verify("secret", timestamp, body, signature, time.Minute, now))
+	assert.True(t, Verify("secret", timestamp, body, signature, time.Minute, now.Add(30*time.Second)))
+	assert.False(t, Verify("other", timestamp, body, signature, time.Minute, now))
+	assert.False(t, Verify("secret", timestamp, []byte(`{"events":[]}`), signature, time.Minute, now))
+	assert.False(t, Verify("secret", timestamp, body, "", time.Minute, now))
+
+	// the timestamp is signed and replays outside the tolerance are rejected
+	assert.False(t, Verify("secret", "1700000001", body, signature, time.Minute, now))
+	assert.False(t, Verify("secret", timestamp, body, signature, time.Minute, now.Add(2*time.Minute)))
+	assert.False(t, Verify("secret", "", body, signature, time.Minute, now))
+}
@@ -26,6 +26,9 @@ const (
 
 	// Tax Application
 	ScopeTaxApplication Scope = "tax_application"
+
+	// Wallet auto top-up
+	ScopeWalletAutoTopup Scope = "wallet_auto_topup"
 )
 
 // Generator generates idempotency keys
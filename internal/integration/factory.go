@@ -14,6 +14,7 @@ import (
 	ierr "github.com/flexprice/flexprice/internal/errors"
 	"github.com/flexprice/flexprice/internal/integration/hubspot"
 	hubspotwebhook "github.com/flexprice/flexprice/internal/integration/hubspot/webhook"
+	"github.com/flexprice/flexprice/internal/integration/razorpay"
 	"github.com/flexprice/flexprice/internal/integration/s3"
 	"github.com/flexprice/flexprice/internal/integration/stripe"
 	"github.com/flexprice/flexprice/internal/integration/stripe/webhook"
@@ -132,6 +133,22 @@ func (f *Factory) GetStripeIntegration(ctx context.Context) (*StripeIntegration,
 		PaymentSvc:     paymentSvc,
 		InvoiceSyncSvc: invoiceSyncSvc,
 		WebhookHandler: webhookHandler,
+		Gateway:        stripe.NewGateway(stripeClient, customerSvc, paymentSvc),
+	}, nil
+}
+
+// GetRazorpayIntegration returns a complete Razorpay integration setup
+func (f *Factory) GetRazorpayIntegration(ctx context.Context) (*RazorpayIntegration, error) {
+	razorpayClient := razorpay.NewClient(
+		f.connectionRepo,
+		f.encryptionService,
+		f.logger,
+	)
+
+	return &RazorpayIntegration{
+		Client:         razorpayClient,
+		Gateway:        razorpay.NewGateway(razorpayClient, f.entityIntegrationMappingRepo, f.logger),
+		WebhookHandler: razorpay.NewWebhookHandler(f.logger),
 	}, nil
 }
 
@@ -193,6 +210,8 @@ func (f *Factory) GetIntegrationByProvider(ctx context.Context, providerType typ
 		return f.GetStripeIntegration(ctx)
 	case types.SecretProviderHubSpot:
 		return f.GetHubSpotIntegration(ctx)
+	case types.SecretProviderRazorpay:
+		return f.GetRazorpayIntegration(ctx)
 	default:
 		return nil, ierr.NewError("unsupported integration provider").
 			WithHint("Provider type is not supported").
@@ -208,6 +227,7 @@ func (f *Factory) GetSupportedProviders() []types.SecretProvider {
 	return []types.SecretProvider{
 		types.SecretProviderStripe,
 		types.SecretProviderHubSpot,
+		types.SecretProviderRazorpay,
 	}
 }
 
@@ -229,6 +249,14 @@ type StripeIntegration struct {
 	PaymentSvc     *stripe.PaymentService
 	InvoiceSyncSvc *stripe.InvoiceSyncService
 	WebhookHandler *webhook.Handler
+	Gateway        *stripe.Gateway
+}
+
+// RazorpayIntegration contains all Razorpay integration services
+type RazorpayIntegration struct {
+	Client         *razorpay.Client
+	Gateway        *razorpay.Gateway
+	WebhookHandler *razorpay.WebhookHandler
 }
 
 // HubSpotIntegration contains all HubSpot integration services
@@ -276,6 +304,21 @@ func (p *HubSpotProvider) IsAvailable(ctx context.Context) bool {
 	return p.integration.Client.HasHubSpotConnection(ctx)
 }
 
+// RazorpayProvider implements IntegrationProvider for Razorpay
+type RazorpayProvider struct {
+	integration *RazorpayIntegration
+}
+
+// GetProviderType returns the provider type
+func (p *RazorpayProvider) GetProviderType() types.SecretProvider {
+	return types.SecretProviderRazorpay
+}
+
+// IsAvailable checks if Razorpay integration is available
+func (p *RazorpayProvider) IsAvailable(ctx context.Context) bool {
+	return p.integration.Client.HasRazorpayConnection(ctx)
+}
+
 // GetAvailableProviders returns all available providers for the current environment
 func (f *Factory) GetAvailableProviders(ctx context.Context) ([]IntegrationProvider, error) {
 	var providers []IntegrationProvider
@@ -298,6 +341,15 @@ func (f *Factory) GetAvailableProviders(ctx context.Context) ([]IntegrationProvi
 		}
 	}
 
+	// Check Razorpay
+	razorpayIntegration, err := f.GetRazorpayIntegration(ctx)
+	if err == nil {
+		razorpayProvider := &RazorpayProvider{integration: razorpayIntegration}
+		if razorpayProvider.IsAvailable(ctx) {
+			providers = append(providers, razorpayProvider)
+		}
+	}
+
 	return providers, nil
 }
 
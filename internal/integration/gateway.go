@@ -0,0 +1,114 @@
+package integration
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/connection"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/integration/razorpay"
+	"github.com/flexprice/flexprice/internal/integration/stripe"
+	"github.com/flexprice/flexprice/internal/interfaces"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// PaymentGateway defines the payment operations flexprice needs from a payment provider
+type PaymentGateway interface {
+	// GetGatewayType returns the type of the payment gateway
+	GetGatewayType() types.PaymentGatewayType
+
+	// CreateCustomer creates the customer in the gateway unless it is already linked to a gateway
+	// customer, and returns the id of the gateway customer
+	CreateCustomer(ctx context.Context, customerID string, customerService interfaces.CustomerService) (string, error)
+
+	// HasCustomer checks if the customer is linked to a gateway customer
+	HasCustomer(ctx context.Context, customerID string, customerService interfaces.CustomerService) bool
+
+	// CreateSetupIntent starts a session in which the customer saves a payment method for later charges
+	CreateSetupIntent(ctx context.Context, customerID string, req *dto.CreateSetupIntentRequest, customerService interfaces.CustomerService) (*dto.SetupIntentResponse, error)
+
+	// GetDefaultPaymentMethod returns the saved payment method charged when none is given
+	GetDefaultPaymentMethod(ctx context.Context, customerID string, customerService interfaces.CustomerService) (*dto.PaymentMethodResponse, error)
+
+	// ChargeSavedPaymentMethod charges a saved payment method off session
+	ChargeSavedPaymentMethod(ctx context.Context, req *dto.ChargeSavedPaymentMethodRequest, customerService interfaces.CustomerService, invoiceService interfaces.InvoiceService) (*dto.PaymentIntentResponse, error)
+
+	// CreatePaymentLink creates a hosted page on which the customer pays an invoice
+	CreatePaymentLink(ctx context.Context, req *dto.CreatePaymentLinkRequest, customerService interfaces.CustomerService, invoiceService interfaces.InvoiceService) (*dto.PaymentLinkResponse, error)
+
+	// CreateRefund refunds a payment, partially or in full
+	CreateRefund(ctx context.Context, req *dto.CreateGatewayRefundRequest) (*dto.GatewayRefundResponse, error)
+
+	// ParseWebhookEvent verifies the signature of a webhook payload sent by the gateway and parses it
+	ParseWebhookEvent(ctx context.Context, payload []byte, signature string) (*dto.GatewayWebhookEvent, error)
+}
+
+var (
+	_ PaymentGateway = (*stripe.Gateway)(nil)
+	_ PaymentGateway = (*razorpay.Gateway)(nil)
+)
+
+// paymentGatewayProviders are the connection providers that can collect payments, in order of preference
+var paymentGatewayProviders = []types.SecretProvider{
+	types.SecretProviderStripe,
+	types.SecretProviderRazorpay,
+}
+
+// GetPaymentGateway returns the payment gateway of the current environment. When the environment has
+// connections to more than one payment gateway, the connection marked with default_payment_gateway wins.
+func (f *Factory) GetPaymentGateway(ctx context.Context) (PaymentGateway, error) {
+	var selected *connection.Connection
+	for _, provider := range paymentGatewayProviders {
+		conn, err := f.connectionRepo.GetByProvider(ctx, provider)
+		if err != nil {
+			if ierr.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		if conn.IsDefaultPaymentGateway() {
+			selected = conn
+			break
+		}
+		if selected == nil {
+			selected = conn
+		}
+	}
+
+	if selected == nil {
+		return nil, ierr.NewError("payment gateway not configured").
+			WithHint("Connect a payment gateway to this environment to collect payments").
+			WithReportableDetails(map[string]interface{}{
+				"supported_providers": paymentGatewayProviders,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+
+	return f.GetPaymentGatewayByType(ctx, types.PaymentGatewayType(selected.ProviderType))
+}
+
+// GetPaymentGatewayByType returns the payment gateway of the given type
+func (f *Factory) GetPaymentGatewayByType(ctx context.Context, gatewayType types.PaymentGatewayType) (PaymentGateway, error) {
+	switch gatewayType {
+	case types.PaymentGatewayTypeStripe:
+		stripeIntegration, err := f.GetStripeIntegration(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return stripeIntegration.Gateway, nil
+	case types.PaymentGatewayTypeRazorpay:
+		razorpayIntegration, err := f.GetRazorpayIntegration(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return razorpayIntegration.Gateway, nil
+	default:
+		return nil, ierr.NewError("unsupported payment gateway").
+			WithHint("Payment gateway type is not supported").
+			WithReportableDetails(map[string]interface{}{
+				"gateway_type": gatewayType,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+}
@@ -0,0 +1,266 @@
+package razorpay
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/flexprice/flexprice/internal/domain/connection"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/httpclient"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/security"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+const (
+	RazorpayAPIBaseURL = "https://api.razorpay.com/v1"
+)
+
+// Client handles Razorpay API client setup and configuration
+type Client struct {
+	connectionRepo    connection.Repository
+	encryptionService security.EncryptionService
+	logger            *logger.Logger
+	httpClient        httpclient.Client
+	baseURL           string
+}
+
+// NewClient creates a new Razorpay client
+func NewClient(
+	connectionRepo connection.Repository,
+	encryptionService security.EncryptionService,
+	logger *logger.Logger,
+) *Client {
+	return &Client{
+		connectionRepo:    connectionRepo,
+		encryptionService: encryptionService,
+		logger:            logger,
+		httpClient:        httpclient.NewDefaultClient(),
+		baseURL:           RazorpayAPIBaseURL,
+	}
+}
+
+// RazorpayConfig holds decrypted Razorpay configuration
+type RazorpayConfig struct {
+	KeyID         string
+	KeySecret     string
+	WebhookSecret string
+}
+
+// GetRazorpayConfig retrieves and decrypts the Razorpay configuration for the current environment
+func (c *Client) GetRazorpayConfig(ctx context.Context) (*RazorpayConfig, error) {
+	conn, err := c.connectionRepo.GetByProvider(ctx, types.SecretProviderRazorpay)
+	if err != nil {
+		return nil, ierr.NewError("failed to get Razorpay connection").
+			WithHint("Razorpay connection not configured for this environment").
+			Mark(ierr.ErrNotFound)
+	}
+
+	razorpayConfig, err := c.GetDecryptedRazorpayConfig(conn)
+	if err != nil {
+		return nil, ierr.NewError("failed to get Razorpay configuration").
+			WithHint("Invalid Razorpay configuration").
+			Mark(ierr.ErrValidation)
+	}
+
+	if razorpayConfig.KeyID == "" || razorpayConfig.KeySecret == "" {
+		return nil, ierr.NewError("missing Razorpay API keys").
+			WithHint("Configure the Razorpay key ID and key secret in the connection settings").
+			Mark(ierr.ErrValidation)
+	}
+
+	return razorpayConfig, nil
+}
+
+// GetDecryptedRazorpayConfig decrypts and returns the Razorpay configuration of a connection
+func (c *Client) GetDecryptedRazorpayConfig(conn *connection.Connection) (*RazorpayConfig, error) {
+	if conn.ProviderType != types.SecretProviderRazorpay || conn.EncryptedSecretData.Razorpay == nil {
+		return &RazorpayConfig{}, nil
+	}
+
+	keyID, err := c.encryptionService.Decrypt(conn.EncryptedSecretData.Razorpay.KeyID)
+	if err != nil {
+		c.logger.Errorw("failed to decrypt key id", "connection_id", conn.ID, "error", err)
+		return nil, ierr.NewError("failed to decrypt key id").Mark(ierr.ErrInternal)
+	}
+
+	keySecret, err := c.encryptionService.Decrypt(conn.EncryptedSecretData.Razorpay.KeySecret)
+	if err != nil {
+		c.logger.Errorw("failed to decrypt key secret", "connection_id", conn.ID, "error", err)
+		return nil, ierr.NewError("failed to decrypt key secret").Mark(ierr.ErrInternal)
+	}
+
+	webhookSecret, err := c.encryptionService.Decrypt(conn.EncryptedSecretData.Razorpay.WebhookSecret)
+	if err != nil {
+		c.logger.Errorw("failed to decrypt webhook secret", "connection_id", conn.ID, "error", err)
+		return nil, ierr.NewError("failed to decrypt webhook secret").Mark(ierr.ErrInternal)
+	}
+
+	return &RazorpayConfig{
+		KeyID:         keyID,
+		KeySecret:     keySecret,
+		WebhookSecret: webhookSecret,
+	}, nil
+}
+
+// HasRazorpayConnection checks if the current environment has a Razorpay connection
+func (c *Client) HasRazorpayConnection(ctx context.Context) bool {
+	conn, err := c.connectionRepo.GetByProvider(ctx, types.SecretProviderRazorpay)
+	return err == nil && conn != nil
+}
+
+// VerifyWebhookSignature verifies the X-Razorpay-Signature header of a webhook,
+// the hex encoded HMAC-SHA256 of the raw body keyed with the webhook secret
+func (c *Client) VerifyWebhookSignature(payload []byte, signature string, webhookSecret string) bool {
+	if signature == "" || webhookSecret == "" {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(webhookSecret))
+	mac.Write(payload)
+	expected := hex.EncodeToString(mac.Sum(nil))
+
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
+
+// do sends an authenticated request to the Razorpay API and decodes the response into out
+func (c *Client) do(ctx context.Context, method string, path string, body interface{}, out interface{}) error {
+	config, err := c.GetRazorpayConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	req := &httpclient.Request{
+		Method: method,
+		URL:    c.baseURL + path,
+		Headers: map[string]string{
+			"Authorization": "Basic " + base64.StdEncoding.EncodeToString([]byte(config.KeyID+":"+config.KeySecret)),
+		},
+	}
+	if body != nil {
+		req.Body, err = json.Marshal(body)
+		if err != nil {
+			return ierr.WithError(err).
+				WithHint("Failed to encode the Razorpay request").
+				Mark(ierr.ErrInternal)
+		}
+	}
+
+	resp, err := c.httpClient.Send(ctx, req)
+	if err != nil {
+		if httpErr, ok := httpclient.IsHTTPError(err); ok {
+			var errResp ErrorResponse
+			_ = json.Unmarshal(httpErr.Response, &errResp)
+			c.logger.Errorw("razorpay api error",
+				"status", httpErr.StatusCode,
+				"code", errResp.Error.Code,
+				"description", errResp.Error.Description,
+				"path", path)
+			return ierr.NewError("razorpay api error").
+				WithHint(fmt.Sprintf("Razorpay API returned status %d: %s", httpErr.StatusCode, errResp.Error.Description)).
+				WithReportableDetails(map[string]interface{}{
+					"code":        errResp.Error.Code,
+					"description": errResp.Error.Description,
+					"field":       errResp.Error.Field,
+				}).
+				Mark(ierr.ErrHTTPClient)
+		}
+		return ierr.WithError(err).
+			WithHint("Razorpay API error").
+			Mark(ierr.ErrHTTPClient)
+	}
+
+	if out == nil {
+		return nil
+	}
+	if err := json.Unmarshal(resp.Body, out); err != nil {
+		return ierr.NewError("failed to decode razorpay response").Mark(ierr.ErrInternal)
+	}
+	return nil
+}
+
+// CreateCustomer creates a customer, or returns the customer with the same email and contact
+func (c *Client) CreateCustomer(ctx context.Context, req *CustomerRequest) (*Customer, error) {
+	var customer Customer
+	if err := c.do(ctx, "POST", "/customers", req, &customer); err != nil {
+		return nil, err
+	}
+	return &customer, nil
+}
+
+// CreatePaymentLink creates a payment link
+func (c *Client) CreatePaymentLink(ctx context.Context, req *PaymentLinkRequest) (*PaymentLink, error) {
+	var link PaymentLink
+	if err := c.do(ctx, "POST", "/payment_links", req, &link); err != nil {
+		return nil, err
+	}
+	return &link, nil
+}
+
+// CreateRegistrationLink creates a link on which the customer authorizes a recurring token
+func (c *Client) CreateRegistrationLink(ctx context.Context, req *RegistrationLinkRequest) (*RegistrationLink, error) {
+	var link RegistrationLink
+	if err := c.do(ctx, "POST", "/subscription_registration/auth_links", req, &link); err != nil {
+		return nil, err
+	}
+	return &link, nil
+}
+
+// ListTokens lists the saved payment methods of a customer
+func (c *Client) ListTokens(ctx context.Context, customerID string) (*TokenList, error) {
+	var tokens TokenList
+	if err := c.do(ctx, "GET", "/customers/"+customerID+"/tokens", nil, &tokens); err != nil {
+		return nil, err
+	}
+	return &tokens, nil
+}
+
+// CreateOrder creates an order, which every recurring payment belongs to
+func (c *Client) CreateOrder(ctx context.Context, req *OrderRequest) (*Order, error) {
+	var order Order
+	if err := c.do(ctx, "POST", "/orders", req, &order); err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+// CreateRecurringPayment charges a recurring token of a customer
+func (c *Client) CreateRecurringPayment(ctx context.Context, req *RecurringPaymentRequest) (*RecurringPaymentResponse, error) {
+	var resp RecurringPaymentResponse
+	if err := c.do(ctx, "POST", "/payments/create/recurring", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetPayment fetches a payment
+func (c *Client) GetPayment(ctx context.Context, paymentID string) (*Payment, error) {
+	var payment Payment
+	if err := c.do(ctx, "GET", "/payments/"+paymentID, nil, &payment); err != nil {
+		return nil, err
+	}
+	return &payment, nil
+}
+
+// CapturePayment captures an authorized payment
+func (c *Client) CapturePayment(ctx context.Context, paymentID string, req *CaptureRequest) (*Payment, error) {
+	var payment Payment
+	if err := c.do(ctx, "POST", "/payments/"+paymentID+"/capture", req, &payment); err != nil {
+		return nil, err
+	}
+	return &payment, nil
+}
+
+// CreateRefund refunds a captured payment
+func (c *Client) CreateRefund(ctx context.Context, paymentID string, req *RefundRequest) (*Refund, error) {
+	var refund Refund
+	if err := c.do(ctx, "POST", "/payments/"+paymentID+"/refund", req, &refund); err != nil {
+		return nil, err
+	}
+	return &refund, nil
+}
@@ -0,0 +1,142 @@
+package razorpay
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/config"
+	"github.com/flexprice/flexprice/internal/domain/connection"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/security"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+// stubConnectionRepo returns a single Razorpay connection
+type stubConnectionRepo struct {
+	connection.Repository
+	conn *connection.Connection
+}
+
+func (r *stubConnectionRepo) GetByProvider(_ context.Context, _ types.SecretProvider) (*connection.Connection, error) {
+	return r.conn, nil
+}
+
+func newTestGateway(t *testing.T, handler http.HandlerFunc) *Gateway {
+	cfg := &config.Configuration{
+		Logging: config.LoggingConfig{Level: types.LogLevelInfo},
+		Secrets: config.SecretsConfig{EncryptionKey: "test-encryption-key-for-unit-tests-only"},
+	}
+	log, err := logger.NewLogger(cfg)
+	require.NoError(t, err)
+	encryptionService, err := security.NewEncryptionService(cfg, log)
+	require.NoError(t, err)
+
+	encrypt := func(value string) string {
+		encrypted, err := encryptionService.Encrypt(value)
+		require.NoError(t, err)
+		return encrypted
+	}
+
+	repo := &stubConnectionRepo{conn: &connection.Connection{
+		ID:           "conn_razorpay",
+		ProviderType: types.SecretProviderRazorpay,
+		EncryptedSecretData: types.ConnectionMetadata{
+			Razorpay: &types.RazorpayConnectionMetadata{
+				KeyID:         encrypt("rzp_test_key"),
+				KeySecret:     encrypt("rzp_test_secret"),
+				WebhookSecret: encrypt("whsec_test"),
+			},
+		},
+	}}
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client := NewClient(repo, encryptionService, log)
+	client.baseURL = server.URL
+	return NewGateway(client, nil, log)
+}
+
+func TestGateway_CreateRefund(t *testing.T) {
+	ctx := types.SetEnvironmentID(types.SetTenantID(context.Background(), "tenant_1"), "env_1")
+
+	gateway := newTestGateway(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "/payments/pay_123/refund", r.URL.Path)
+		require.Equal(t, "Basic "+base64.StdEncoding.EncodeToString([]byte("rzp_test_key:rzp_test_secret")), r.Header.Get("Authorization"))
+
+		var req RefundRequest
+		body, _ := io.ReadAll(r.Body)
+		require.NoError(t, json.Unmarshal(body, &req))
+		require.Equal(t, int64(1050), req.Amount)
+		require.Equal(t, "ref_1", req.Notes["flexprice_refund_id"])
+
+		_, _ = w.Write([]byte(`{"id":"rfnd_1","payment_id":"pay_123","amount":1050,"status":"processed"}`))
+	})
+
+	resp, err := gateway.CreateRefund(ctx, &dto.CreateGatewayRefundRequest{
+		RefundID:         "ref_1",
+		PaymentID:        "pay_1",
+		GatewayPaymentID: "pay_123",
+		Amount:           decimal.NewFromFloat(10.50),
+		Currency:         "inr",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "rfnd_1", resp.ID)
+	require.Equal(t, types.PaymentStatusSucceeded, resp.Status)
+}
+
+func TestGateway_CreateRefundAPIError(t *testing.T) {
+	ctx := types.SetEnvironmentID(types.SetTenantID(context.Background(), "tenant_1"), "env_1")
+
+	gateway := newTestGateway(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":{"code":"BAD_REQUEST_ERROR","description":"The refund amount is invalid"}}`))
+	})
+
+	_, err := gateway.CreateRefund(ctx, &dto.CreateGatewayRefundRequest{
+		RefundID:         "ref_1",
+		GatewayPaymentID: "pay_123",
+		Amount:           decimal.NewFromInt(10),
+	})
+	require.Error(t, err)
+}
+
+func TestGateway_ParseWebhookEvent(t *testing.T) {
+	ctx := types.SetEnvironmentID(types.SetTenantID(context.Background(), "tenant_1"), "env_1")
+	gateway := newTestGateway(t, func(w http.ResponseWriter, r *http.Request) {})
+
+	payload := []byte(`{"event":"refund.processed","payload":{"refund":{"entity":{"id":"rfnd_1","status":"processed"}}}}`)
+	mac := hmac.New(sha256.New, []byte("whsec_test"))
+	mac.Write(payload)
+	signature := hex.EncodeToString(mac.Sum(nil))
+
+	event, err := gateway.ParseWebhookEvent(ctx, payload, signature)
+	require.NoError(t, err)
+	require.Equal(t, EventRefundProcessed, event.Type)
+	require.Equal(t, types.PaymentGatewayTypeRazorpay, event.Gateway)
+
+	_, err = gateway.ParseWebhookEvent(ctx, payload, "invalid")
+	require.Error(t, err)
+}
+
+func TestToSubunits(t *testing.T) {
+	require.Equal(t, int64(1050), toSubunits(decimal.NewFromFloat(10.50), "inr"))
+	require.Equal(t, int64(1050), toSubunits(decimal.NewFromInt(1050), "JPY"))
+	require.Equal(t, int64(10550), toSubunits(decimal.NewFromFloat(10.553), "KWD"))
+
+	require.True(t, decimal.NewFromFloat(10.50).Equal(fromSubunits(1050, "INR")))
+	require.True(t, decimal.NewFromInt(1050).Equal(fromSubunits(1050, "jpy")))
+	require.True(t, decimal.NewFromFloat(10.555).Equal(fromSubunits(10555, "KWD")))
+}
@@ -0,0 +1,72 @@
+package razorpay
+
+// Provider constants:
+// Use types.SecretProviderRazorpay for provider type (defined in internal/types/secret.go)
+// Use types.PaymentGatewayTypeRazorpay for payment gateway type (defined in internal/types/payment_gateway.go)
+
+// Razorpay webhook events
+const (
+	EventPaymentLinkPaid = "payment_link.paid"
+	EventPaymentCaptured = "payment.captured"
+	EventPaymentFailed   = "payment.failed"
+	EventRefundProcessed = "refund.processed"
+	EventRefundFailed    = "refund.failed"
+)
+
+// Razorpay payment statuses
+const (
+	PaymentStatusCreated    = "created"
+	PaymentStatusAuthorized = "authorized"
+	PaymentStatusCaptured   = "captured"
+	PaymentStatusFailed     = "failed"
+)
+
+// Razorpay refund statuses
+const (
+	RefundStatusPending   = "pending"
+	RefundStatusProcessed = "processed"
+	RefundStatusFailed    = "failed"
+)
+
+// Razorpay headers
+const (
+	HeaderSignature = "X-Razorpay-Signature"
+	HeaderEventID   = "X-Razorpay-Event-Id"
+)
+
+// MetadataWebhookEventID is the payment metadata key recording the webhook event that completed the payment
+const MetadataWebhookEventID = "razorpay_webhook_event_id"
+
+// Registration links authorize the card with a small charge. Razorpay only supports card mandates in INR.
+const (
+	registrationAmount   = 100
+	registrationCurrency = "INR"
+)
+
+// currencyExponents lists the currencies that do not have two decimals in Razorpay amounts
+var currencyExponents = map[string]int32{
+	// zero decimal currencies
+	"BIF": 0,
+	"CLP": 0,
+	"DJF": 0,
+	"GNF": 0,
+	"ISK": 0,
+	"JPY": 0,
+	"KMF": 0,
+	"KRW": 0,
+	"PYG": 0,
+	"RWF": 0,
+	"UGX": 0,
+	"VND": 0,
+	"VUV": 0,
+	"XAF": 0,
+	"XOF": 0,
+	"XPF": 0,
+	// three decimal currencies
+	"BHD": 3,
+	"IQD": 3,
+	"JOD": 3,
+	"KWD": 3,
+	"OMR": 3,
+	"TND": 3,
+}
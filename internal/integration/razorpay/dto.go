@@ -0,0 +1,215 @@
+package razorpay
+
+// ErrorResponse is the body of a failed Razorpay API call
+type ErrorResponse struct {
+	Error struct {
+		Code        string `json:"code"`
+		Description string `json:"description"`
+		Field       string `json:"field,omitempty"`
+	} `json:"error"`
+}
+
+// CustomerRequest is the body of a create customer call
+type CustomerRequest struct {
+	Name         string            `json:"name,omitempty"`
+	Email        string            `json:"email,omitempty"`
+	Contact      string            `json:"contact,omitempty"`
+	FailExisting string            `json:"fail_existing"`
+	Notes        map[string]string `json:"notes,omitempty"`
+}
+
+// Customer is a Razorpay customer
+type Customer struct {
+	ID        string            `json:"id"`
+	Name      string            `json:"name"`
+	Email     string            `json:"email"`
+	Contact   string            `json:"contact"`
+	Notes     map[string]string `json:"notes"`
+	CreatedAt int64             `json:"created_at"`
+}
+
+// LinkCustomer is the customer a payment or registration link is sent to
+type LinkCustomer struct {
+	Name    string `json:"name,omitempty"`
+	Email   string `json:"email,omitempty"`
+	Contact string `json:"contact,omitempty"`
+}
+
+// PaymentLinkRequest is the body of a create payment link call
+type PaymentLinkRequest struct {
+	Amount         int64             `json:"amount"`
+	Currency       string            `json:"currency"`
+	AcceptPartial  bool              `json:"accept_partial"`
+	ReferenceID    string            `json:"reference_id,omitempty"`
+	Description    string            `json:"description,omitempty"`
+	Customer       *LinkCustomer     `json:"customer,omitempty"`
+	CallbackURL    string            `json:"callback_url,omitempty"`
+	CallbackMethod string            `json:"callback_method,omitempty"`
+	Notes          map[string]string `json:"notes,omitempty"`
+}
+
+// PaymentLink is a Razorpay payment link
+type PaymentLink struct {
+	ID          string            `json:"id"`
+	ShortURL    string            `json:"short_url"`
+	Status      string            `json:"status"`
+	Amount      int64             `json:"amount"`
+	AmountPaid  int64             `json:"amount_paid"`
+	Currency    string            `json:"currency"`
+	ReferenceID string            `json:"reference_id"`
+	Notes       map[string]string `json:"notes"`
+	CreatedAt   int64             `json:"created_at"`
+	ExpireBy    int64             `json:"expire_by"`
+}
+
+// SubscriptionRegistration configures the recurring token a registration link creates
+type SubscriptionRegistration struct {
+	Method    string `json:"method"`
+	MaxAmount int64  `json:"max_amount,omitempty"`
+}
+
+// RegistrationLinkRequest is the body of a create registration (authorization) link call
+type RegistrationLinkRequest struct {
+	Customer                 *LinkCustomer             `json:"customer"`
+	Type                     string                    `json:"type"`
+	Amount                   int64                     `json:"amount"`
+	Currency                 string                    `json:"currency"`
+	Description              string                    `json:"description"`
+	SubscriptionRegistration *SubscriptionRegistration `json:"subscription_registration"`
+	EmailNotify              bool                      `json:"email_notify"`
+	SMSNotify                bool                      `json:"sms_notify"`
+	Notes                    map[string]string         `json:"notes,omitempty"`
+}
+
+// RegistrationLink is the invoice Razorpay creates for a registration link
+type RegistrationLink struct {
+	ID         string `json:"id"`
+	CustomerID string `json:"customer_id"`
+	OrderID    string `json:"order_id"`
+	ShortURL   string `json:"short_url"`
+	Status     string `json:"status"`
+	CreatedAt  int64  `json:"created_at"`
+	ExpireBy   int64  `json:"expire_by"`
+}
+
+// Card holds the card details of a token
+type Card struct {
+	Last4       string `json:"last4"`
+	Network     string `json:"network"`
+	ExpiryMonth int    `json:"expiry_month"`
+	ExpiryYear  int    `json:"expiry_year"`
+}
+
+// Token is a saved payment method of a Razorpay customer
+type Token struct {
+	ID        string `json:"id"`
+	Method    string `json:"method"`
+	Card      *Card  `json:"card,omitempty"`
+	Recurring bool   `json:"recurring"`
+	CreatedAt int64  `json:"created_at"`
+	ExpiredAt int64  `json:"expired_at"`
+}
+
+// TokenList is the collection returned when listing the tokens of a customer
+type TokenList struct {
+	Count int      `json:"count"`
+	Items []*Token `json:"items"`
+}
+
+// OrderRequest is the body of a create order call
+type OrderRequest struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+	Receipt  string `json:"receipt,omitempty"`
+	// PaymentCapture set to 1 captures the payments of the order once they are authorized
+	PaymentCapture int               `json:"payment_capture"`
+	Notes          map[string]string `json:"notes,omitempty"`
+}
+
+// Order is a Razorpay order
+type Order struct {
+	ID       string `json:"id"`
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+	Status   string `json:"status"`
+}
+
+// RecurringPaymentRequest is the body of a create recurring payment call
+type RecurringPaymentRequest struct {
+	Email       string            `json:"email,omitempty"`
+	Contact     string            `json:"contact,omitempty"`
+	Amount      int64             `json:"amount"`
+	Currency    string            `json:"currency"`
+	OrderID     string            `json:"order_id"`
+	CustomerID  string            `json:"customer_id"`
+	Token       string            `json:"token"`
+	Recurring   string            `json:"recurring"`
+	Description string            `json:"description,omitempty"`
+	Notes       map[string]string `json:"notes,omitempty"`
+}
+
+// RecurringPaymentResponse is returned when a recurring payment is created
+type RecurringPaymentResponse struct {
+	PaymentID string `json:"razorpay_payment_id"`
+	OrderID   string `json:"razorpay_order_id"`
+}
+
+// Payment is a Razorpay payment
+type Payment struct {
+	ID               string            `json:"id"`
+	Amount           int64             `json:"amount"`
+	Currency         string            `json:"currency"`
+	Status           string            `json:"status"`
+	OrderID          string            `json:"order_id"`
+	Method           string            `json:"method"`
+	CustomerID       string            `json:"customer_id"`
+	TokenID          string            `json:"token_id"`
+	ErrorCode        string            `json:"error_code"`
+	ErrorDescription string            `json:"error_description"`
+	Notes            map[string]string `json:"notes"`
+	CreatedAt        int64             `json:"created_at"`
+}
+
+// CaptureRequest is the body of a capture payment call
+type CaptureRequest struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+// RefundRequest is the body of a refund payment call
+type RefundRequest struct {
+	Amount  int64             `json:"amount"`
+	Speed   string            `json:"speed"`
+	Receipt string            `json:"receipt,omitempty"`
+	Notes   map[string]string `json:"notes,omitempty"`
+}
+
+// Refund is a Razorpay refund
+type Refund struct {
+	ID        string            `json:"id"`
+	PaymentID string            `json:"payment_id"`
+	Amount    int64             `json:"amount"`
+	Currency  string            `json:"currency"`
+	Status    string            `json:"status"`
+	Receipt   string            `json:"receipt"`
+	Notes     map[string]string `json:"notes"`
+	CreatedAt int64             `json:"created_at"`
+}
+
+// WebhookEvent is the body of a Razorpay webhook
+type WebhookEvent struct {
+	Event     string   `json:"event"`
+	Contains  []string `json:"contains"`
+	CreatedAt int64    `json:"created_at"`
+	Payload   struct {
+		Payment *struct {
+			Entity *Payment `json:"entity"`
+		} `json:"payment,omitempty"`
+		Refund *struct {
+			Entity *Refund `json:"entity"`
+		} `json:"refund,omitempty"`
+		PaymentLink *struct {
+			Entity *PaymentLink `json:"entity"`
+		} `json:"payment_link,omitempty"`
+	} `json:"payload"`
+}
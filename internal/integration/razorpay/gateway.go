@@ -0,0 +1,509 @@
+package razorpay
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/customer"
+	"github.com/flexprice/flexprice/internal/domain/entityintegrationmapping"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/interfaces"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// Gateway implements the payment gateway operations on top of the Razorpay API
+type Gateway struct {
+	client                       *Client
+	entityIntegrationMappingRepo entityintegrationmapping.Repository
+	logger                       *logger.Logger
+}
+
+// NewGateway creates a new Razorpay payment gateway
+func NewGateway(
+	client *Client,
+	entityIntegrationMappingRepo entityintegrationmapping.Repository,
+	logger *logger.Logger,
+) *Gateway {
+	return &Gateway{
+		client:                       client,
+		entityIntegrationMappingRepo: entityIntegrationMappingRepo,
+		logger:                       logger,
+	}
+}
+
+// GetGatewayType returns the type of the payment gateway
+func (g *Gateway) GetGatewayType() types.PaymentGatewayType {
+	return types.PaymentGatewayTypeRazorpay
+}
+
+// CreateCustomer creates the customer in Razorpay unless it is already linked to a Razorpay customer
+func (g *Gateway) CreateCustomer(ctx context.Context, customerID string, customerService interfaces.CustomerService) (string, error) {
+	customerResp, err := customerService.GetCustomer(ctx, customerID)
+	if err != nil {
+		return "", err
+	}
+	cust := customerResp.Customer
+
+	if razorpayCustomerID := g.getRazorpayCustomerID(ctx, cust); razorpayCustomerID != "" {
+		return razorpayCustomerID, nil
+	}
+
+	// fail_existing=0 returns the existing Razorpay customer with the same email instead of failing
+	razorpayCustomer, err := g.client.CreateCustomer(ctx, &CustomerRequest{
+		Name:         cust.Name,
+		Email:        cust.Email,
+		FailExisting: "0",
+		Notes: map[string]string{
+			"flexprice_customer_id": cust.ID,
+			"flexprice_environment": cust.EnvironmentID,
+			"external_id":           cust.ExternalID,
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	updateReq := dto.UpdateCustomerRequest{
+		Metadata: lo.Assign(cust.Metadata, map[string]string{
+			"razorpay_customer_id": razorpayCustomer.ID,
+		}),
+	}
+	if _, err := customerService.UpdateCustomer(ctx, cust.ID, updateReq); err != nil {
+		return "", err
+	}
+
+	if g.entityIntegrationMappingRepo != nil {
+		mapping := &entityintegrationmapping.EntityIntegrationMapping{
+			ID:               types.GenerateUUIDWithPrefix(types.UUID_PREFIX_ENTITY_INTEGRATION_MAPPING),
+			EntityID:         cust.ID,
+			EntityType:       types.IntegrationEntityTypeCustomer,
+			ProviderType:     string(types.SecretProviderRazorpay),
+			ProviderEntityID: razorpayCustomer.ID,
+			Metadata: map[string]interface{}{
+				"created_via": "flexprice_to_provider",
+				"synced_at":   time.Now().UTC().Format(time.RFC3339),
+			},
+			EnvironmentID: types.GetEnvironmentID(ctx),
+			BaseModel:     types.GetDefaultBaseModel(ctx),
+		}
+		if err := g.entityIntegrationMappingRepo.Create(ctx, mapping); err != nil {
+			// Don't fail the sync, the customer metadata already links the Razorpay customer
+			g.logger.Warnw("failed to create entity mapping for customer",
+				"error", err,
+				"customer_id", cust.ID,
+				"razorpay_customer_id", razorpayCustomer.ID)
+		}
+	}
+
+	g.logger.Infow("created customer in Razorpay",
+		"customer_id", cust.ID,
+		"razorpay_customer_id", razorpayCustomer.ID)
+
+	return razorpayCustomer.ID, nil
+}
+
+// HasCustomer checks if the customer is linked to a Razorpay customer
+func (g *Gateway) HasCustomer(ctx context.Context, customerID string, customerService interfaces.CustomerService) bool {
+	customerResp, err := customerService.GetCustomer(ctx, customerID)
+	if err != nil {
+		return false
+	}
+	return g.getRazorpayCustomerID(ctx, customerResp.Customer) != ""
+}
+
+// CreateSetupIntent creates a registration link on which the customer authorizes a recurring card token
+func (g *Gateway) CreateSetupIntent(ctx context.Context, customerID string, req *dto.CreateSetupIntentRequest, customerService interfaces.CustomerService) (*dto.SetupIntentResponse, error) {
+	razorpayCustomerID, err := g.CreateCustomer(ctx, customerID, customerService)
+	if err != nil {
+		return nil, err
+	}
+
+	customerResp, err := customerService.GetCustomer(ctx, customerID)
+	if err != nil {
+		return nil, err
+	}
+
+	notes := toNotes(req.Metadata)
+	notes["flexprice_customer_id"] = customerID
+	notes["environment_id"] = types.GetEnvironmentID(ctx)
+
+	link, err := g.client.CreateRegistrationLink(ctx, &RegistrationLinkRequest{
+		Customer: &LinkCustomer{
+			Name:  customerResp.Customer.Name,
+			Email: customerResp.Customer.Email,
+		},
+		Type:                     "link",
+		Amount:                   registrationAmount,
+		Currency:                 registrationCurrency,
+		Description:              "Save your card for future payments",
+		SubscriptionRegistration: &SubscriptionRegistration{Method: "card"},
+		Notes:                    notes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.SetupIntentResponse{
+		SetupIntentID:     link.ID,
+		CheckoutSessionID: link.OrderID,
+		CheckoutURL:       link.ShortURL,
+		Status:            link.Status,
+		Usage:             "off_session",
+		CustomerID:        razorpayCustomerID,
+		CreatedAt:         link.CreatedAt,
+		ExpiresAt:         link.ExpireBy,
+	}, nil
+}
+
+// GetDefaultPaymentMethod returns the most recent active recurring token of the Razorpay customer
+func (g *Gateway) GetDefaultPaymentMethod(ctx context.Context, customerID string, customerService interfaces.CustomerService) (*dto.PaymentMethodResponse, error) {
+	customerResp, err := customerService.GetCustomer(ctx, customerID)
+	if err != nil {
+		return nil, err
+	}
+
+	razorpayCustomerID := g.getRazorpayCustomerID(ctx, customerResp.Customer)
+	if razorpayCustomerID == "" {
+		return nil, ierr.NewError("customer not found in Razorpay").
+			WithHint("Customer must have a Razorpay account").
+			Mark(ierr.ErrNotFound)
+	}
+
+	tokens, err := g.client.ListTokens(ctx, razorpayCustomerID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().Unix()
+	var token *Token
+	for _, t := range tokens.Items {
+		if !t.Recurring || (t.ExpiredAt > 0 && t.ExpiredAt < now) {
+			continue
+		}
+		if token == nil || t.CreatedAt > token.CreatedAt {
+			token = t
+		}
+	}
+	if token == nil {
+		return nil, ierr.NewError("no default payment method").
+			WithHint("Customer does not have a recurring payment method saved in Razorpay").
+			WithReportableDetails(map[string]interface{}{
+				"customer_id": customerID,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+
+	response := &dto.PaymentMethodResponse{
+		ID:       token.ID,
+		Type:     token.Method,
+		Customer: razorpayCustomerID,
+		Created:  token.CreatedAt,
+		Metadata: make(map[string]interface{}),
+	}
+	if token.Card != nil {
+		response.Card = &dto.CardDetails{
+			Brand:    token.Card.Network,
+			Last4:    token.Card.Last4,
+			ExpMonth: token.Card.ExpiryMonth,
+			ExpYear:  token.Card.ExpiryYear,
+		}
+	}
+
+	return response, nil
+}
+
+// ChargeSavedPaymentMethod charges a recurring token through an order created for the payment
+func (g *Gateway) ChargeSavedPaymentMethod(ctx context.Context, req *dto.ChargeSavedPaymentMethodRequest, customerService interfaces.CustomerService, invoiceService interfaces.InvoiceService) (*dto.PaymentIntentResponse, error) {
+	customerResp, err := customerService.GetCustomer(ctx, req.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+
+	razorpayCustomerID := g.getRazorpayCustomerID(ctx, customerResp.Customer)
+	if razorpayCustomerID == "" {
+		return nil, ierr.NewError("customer not found in Razorpay").
+			WithHint("Customer must have a Razorpay account").
+			Mark(ierr.ErrNotFound)
+	}
+
+	currency := strings.ToUpper(req.Currency)
+	amount := toSubunits(req.Amount, currency)
+	notes := map[string]string{
+		"flexprice_payment_id": req.PaymentID,
+		"flexprice_invoice_id": req.InvoiceID,
+		"environment_id":       types.GetEnvironmentID(ctx),
+	}
+
+	order, err := g.client.CreateOrder(ctx, &OrderRequest{
+		Amount:         amount,
+		Currency:       currency,
+		Receipt:        req.PaymentID,
+		PaymentCapture: 1,
+		Notes:          notes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	recurring, err := g.client.CreateRecurringPayment(ctx, &RecurringPaymentRequest{
+		Email:       customerResp.Customer.Email,
+		Amount:      amount,
+		Currency:    currency,
+		OrderID:     order.ID,
+		CustomerID:  razorpayCustomerID,
+		Token:       req.PaymentMethodID,
+		Recurring:   "1",
+		Description: fmt.Sprintf("Payment for invoice %s", req.InvoiceID),
+		Notes:       notes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	payment, err := g.client.GetPayment(ctx, recurring.PaymentID)
+	if err != nil {
+		// the payment was created, its outcome is left to the payment webhooks instead of failing it
+		g.logger.Warnw("failed to get razorpay payment, waiting for the payment webhook",
+			"payment_id", req.PaymentID,
+			"razorpay_payment_id", recurring.PaymentID,
+			"error", err)
+		payment = &Payment{ID: recurring.PaymentID, Status: PaymentStatusCreated}
+	}
+	// the order captures the payment automatically, capture it explicitly when that did not happen yet
+	// so an authorization that is never captured and lapses is not recorded as paid
+	if payment.Status == PaymentStatusAuthorized {
+		captured, err := g.client.CapturePayment(ctx, payment.ID, &CaptureRequest{
+			Amount:   amount,
+			Currency: currency,
+		})
+		if err != nil {
+			g.logger.Warnw("failed to capture razorpay payment, waiting for the payment webhook",
+				"payment_id", req.PaymentID,
+				"razorpay_payment_id", payment.ID,
+				"error", err)
+		} else {
+			payment = captured
+		}
+	}
+
+	// Recurring payments are processed asynchronously by the bank, a payment which is created or
+	// authorized is still in flight and its outcome is delivered by the payment.captured and
+	// payment.failed webhooks
+	var paymentStatus types.PaymentStatus
+	switch payment.Status {
+	case PaymentStatusCaptured:
+		paymentStatus = types.PaymentStatusSucceeded
+	case PaymentStatusCreated, PaymentStatusAuthorized:
+		paymentStatus = types.PaymentStatusPending
+	default:
+		return nil, ierr.NewError("razorpay payment was not successful").
+			WithHintf("Razorpay payment status is %s: %s", payment.Status, payment.ErrorDescription).
+			WithReportableDetails(map[string]interface{}{
+				"razorpay_payment_id": payment.ID,
+				"status":              payment.Status,
+				"error_code":          payment.ErrorCode,
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	g.logger.Infow("charged saved payment method in Razorpay",
+		"customer_id", req.CustomerID,
+		"payment_id", req.PaymentID,
+		"razorpay_payment_id", payment.ID,
+		"status", payment.Status)
+
+	return &dto.PaymentIntentResponse{
+		ID:            payment.ID,
+		Status:        payment.Status,
+		PaymentStatus: paymentStatus,
+		Amount:        req.Amount,
+		Currency:      req.Currency,
+		CustomerID:    razorpayCustomerID,
+		PaymentMethod: req.PaymentMethodID,
+		CreatedAt:     payment.CreatedAt,
+	}, nil
+}
+
+// CreatePaymentLink creates a Razorpay payment link for the invoice
+func (g *Gateway) CreatePaymentLink(ctx context.Context, req *dto.CreatePaymentLinkRequest, customerService interfaces.CustomerService, invoiceService interfaces.InvoiceService) (*dto.PaymentLinkResponse, error) {
+	customerResp, err := customerService.GetCustomer(ctx, req.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+
+	notes := toNotes(req.Metadata)
+	notes["flexprice_payment_id"] = req.PaymentID
+	notes["flexprice_invoice_id"] = req.InvoiceID
+	notes["environment_id"] = types.GetEnvironmentID(ctx)
+
+	description := req.Description
+	if description == "" {
+		description = fmt.Sprintf("Payment for invoice %s", req.InvoiceID)
+	}
+
+	linkReq := &PaymentLinkRequest{
+		Amount:      toSubunits(req.Amount, req.Currency),
+		Currency:    strings.ToUpper(req.Currency),
+		ReferenceID: req.PaymentID,
+		Description: description,
+		Customer: &LinkCustomer{
+			Name:  customerResp.Customer.Name,
+			Email: customerResp.Customer.Email,
+		},
+		Notes: notes,
+	}
+	if req.SuccessURL != "" {
+		linkReq.CallbackURL = req.SuccessURL
+		linkReq.CallbackMethod = "get"
+	}
+
+	link, err := g.client.CreatePaymentLink(ctx, linkReq)
+	if err != nil {
+		return nil, err
+	}
+
+	g.logger.Infow("created razorpay payment link",
+		"payment_id", req.PaymentID,
+		"invoice_id", req.InvoiceID,
+		"payment_link_id", link.ID)
+
+	resp := &dto.PaymentLinkResponse{
+		ID:         link.ID,
+		PaymentURL: link.ShortURL,
+		Amount:     req.Amount,
+		Currency:   req.Currency,
+		Status:     link.Status,
+		CreatedAt:  link.CreatedAt,
+		PaymentID:  req.PaymentID,
+		Gateway:    string(types.PaymentGatewayTypeRazorpay),
+	}
+	if link.ExpireBy > 0 {
+		resp.ExpiresAt = lo.ToPtr(link.ExpireBy)
+	}
+	return resp, nil
+}
+
+// CreateRefund refunds a captured Razorpay payment
+func (g *Gateway) CreateRefund(ctx context.Context, req *dto.CreateGatewayRefundRequest) (*dto.GatewayRefundResponse, error) {
+	refund, err := g.client.CreateRefund(ctx, req.GatewayPaymentID, &RefundRequest{
+		Amount:  toSubunits(req.Amount, req.Currency),
+		Speed:   "normal",
+		Receipt: req.RefundID,
+		Notes: map[string]string{
+			"environment_id":       types.GetEnvironmentID(ctx),
+			"flexprice_refund_id":  req.RefundID,
+			"flexprice_payment_id": req.PaymentID,
+			"credit_note_id":       req.CreditNoteID,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	g.logger.Infow("created razorpay refund",
+		"refund_id", req.RefundID,
+		"razorpay_refund_id", refund.ID,
+		"status", refund.Status)
+
+	return &dto.GatewayRefundResponse{
+		ID:     refund.ID,
+		Status: ToRefundStatus(refund.Status),
+	}, nil
+}
+
+// ParseWebhookEvent verifies a Razorpay webhook payload with the webhook secret of the connection
+func (g *Gateway) ParseWebhookEvent(ctx context.Context, payload []byte, signature string) (*dto.GatewayWebhookEvent, error) {
+	config, err := g.client.GetRazorpayConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if config.WebhookSecret == "" {
+		return nil, ierr.NewError("webhook secret not configured").
+			WithHint("Configure the webhook secret of the Razorpay connection").
+			Mark(ierr.ErrValidation)
+	}
+
+	if !g.client.VerifyWebhookSignature(payload, signature, config.WebhookSecret) {
+		return nil, ierr.NewError("failed to verify webhook signature").
+			WithHint("Invalid webhook signature or payload").
+			Mark(ierr.ErrValidation)
+	}
+
+	event, err := parseWebhookPayload(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.GatewayWebhookEvent{
+		Type:    event.Event,
+		Gateway: types.PaymentGatewayTypeRazorpay,
+		Payload: payload,
+	}, nil
+}
+
+// getRazorpayCustomerID returns the Razorpay customer linked to the customer, or an empty string
+func (g *Gateway) getRazorpayCustomerID(ctx context.Context, cust *customer.Customer) string {
+	if id := cust.Metadata["razorpay_customer_id"]; id != "" {
+		return id
+	}
+	if g.entityIntegrationMappingRepo == nil {
+		return ""
+	}
+
+	mappings, err := g.entityIntegrationMappingRepo.List(ctx, &types.EntityIntegrationMappingFilter{
+		EntityID:      cust.ID,
+		EntityType:    types.IntegrationEntityTypeCustomer,
+		ProviderTypes: []string{string(types.SecretProviderRazorpay)},
+	})
+	if err != nil || len(mappings) == 0 {
+		return ""
+	}
+	return mappings[0].ProviderEntityID
+}
+
+// ToRefundStatus maps a Razorpay refund status to the status of the payment refund
+func ToRefundStatus(status string) types.PaymentStatus {
+	switch status {
+	case RefundStatusProcessed:
+		return types.PaymentStatusSucceeded
+	case RefundStatusFailed:
+		return types.PaymentStatusFailed
+	default:
+		return types.PaymentStatusPending
+	}
+}
+
+// toSubunits converts an amount to the smallest unit of the currency Razorpay expects. Razorpay only
+// accepts two decimals for the three decimal currencies, their last digit must be 0.
+func toSubunits(amount decimal.Decimal, currency string) int64 {
+	exponent := currencyExponent(currency)
+	return amount.Round(min(exponent, 2)).Shift(exponent).IntPart()
+}
+
+// fromSubunits converts an amount in the smallest unit of the currency back to the currency unit
+func fromSubunits(amount int64, currency string) decimal.Decimal {
+	return decimal.NewFromInt(amount).Shift(-currencyExponent(currency))
+}
+
+// currencyExponent returns the number of decimals of the currency in Razorpay amounts
+func currencyExponent(currency string) int32 {
+	if exponent, ok := currencyExponents[strings.ToUpper(currency)]; ok {
+		return exponent
+	}
+	return 2
+}
+
+// toNotes copies metadata into the notes of a Razorpay entity
+func toNotes(metadata types.Metadata) map[string]string {
+	notes := make(map[string]string, len(metadata))
+	for k, v := range metadata {
+		notes[k] = v
+	}
+	return notes
+}
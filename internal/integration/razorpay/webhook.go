@@ -0,0 +1,241 @@
+package razorpay
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/interfaces"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// WebhookHandler handles Razorpay webhook events
+type WebhookHandler struct {
+	logger *logger.Logger
+}
+
+// NewWebhookHandler creates a new Razorpay webhook handler
+func NewWebhookHandler(logger *logger.Logger) *WebhookHandler {
+	return &WebhookHandler{
+		logger: logger,
+	}
+}
+
+// HandleWebhookEvent processes a verified Razorpay webhook event
+func (h *WebhookHandler) HandleWebhookEvent(ctx context.Context, event *dto.GatewayWebhookEvent, paymentService interfaces.PaymentService, invoiceService interfaces.InvoiceService) error {
+	webhookEvent, err := parseWebhookPayload(event.Payload)
+	if err != nil {
+		return err
+	}
+
+	h.logger.Infow("processing razorpay webhook event",
+		"event_type", webhookEvent.Event,
+		"environment_id", types.GetEnvironmentID(ctx))
+
+	switch webhookEvent.Event {
+	case EventPaymentLinkPaid:
+		return h.handlePaymentLinkPaid(ctx, event.ID, webhookEvent, paymentService, invoiceService)
+	case EventPaymentCaptured:
+		return h.handlePaymentCaptured(ctx, event.ID, webhookEvent, paymentService, invoiceService)
+	case EventPaymentFailed:
+		return h.handlePaymentFailed(ctx, event.ID, webhookEvent, paymentService)
+	case EventRefundProcessed, EventRefundFailed:
+		return h.handleRefund(ctx, webhookEvent, paymentService)
+	default:
+		h.logger.Debugw("ignoring unhandled razorpay webhook event", "event_type", webhookEvent.Event)
+		return nil
+	}
+}
+
+// handlePaymentLinkPaid marks the payment of a paid payment link as succeeded and reconciles its invoice
+func (h *WebhookHandler) handlePaymentLinkPaid(ctx context.Context, eventID string, event *WebhookEvent, paymentService interfaces.PaymentService, invoiceService interfaces.InvoiceService) error {
+	if event.Payload.PaymentLink == nil || event.Payload.PaymentLink.Entity == nil ||
+		event.Payload.Payment == nil || event.Payload.Payment.Entity == nil {
+		return ierr.NewError("invalid payment_link.paid payload").
+			WithHint("The payment link and payment entities are required").
+			Mark(ierr.ErrValidation)
+	}
+	link := event.Payload.PaymentLink.Entity
+	razorpayPayment := event.Payload.Payment.Entity
+
+	paymentID := link.Notes["flexprice_payment_id"]
+	if paymentID == "" {
+		h.logger.Infow("payment link was not created by flexprice, skipping", "payment_link_id", link.ID)
+		return nil
+	}
+
+	return h.completePayment(ctx, eventID, paymentID, razorpayPayment, paymentService, invoiceService)
+}
+
+// handlePaymentCaptured completes a recurring payment charged on a saved payment method, which was
+// still in flight when the charge was requested. Payments of payment links are completed the same way
+// by whichever of the payment.captured and payment_link.paid webhooks is delivered first.
+func (h *WebhookHandler) handlePaymentCaptured(ctx context.Context, eventID string, event *WebhookEvent, paymentService interfaces.PaymentService, invoiceService interfaces.InvoiceService) error {
+	if event.Payload.Payment == nil || event.Payload.Payment.Entity == nil {
+		return ierr.NewError("invalid payment.captured payload").
+			WithHint("The payment entity is required").
+			Mark(ierr.ErrValidation)
+	}
+	razorpayPayment := event.Payload.Payment.Entity
+
+	paymentID := razorpayPayment.Notes["flexprice_payment_id"]
+	if paymentID == "" {
+		h.logger.Debugw("payment was not created by flexprice, skipping", "razorpay_payment_id", razorpayPayment.ID)
+		return nil
+	}
+
+	return h.completePayment(ctx, eventID, paymentID, razorpayPayment, paymentService, invoiceService)
+}
+
+// handlePaymentFailed fails a recurring payment charged on a saved payment method, which was still in
+// flight when the charge was requested. A failed attempt on a payment link is ignored since the
+// customer can pay the link again.
+func (h *WebhookHandler) handlePaymentFailed(ctx context.Context, eventID string, event *WebhookEvent, paymentService interfaces.PaymentService) error {
+	if event.Payload.Payment == nil || event.Payload.Payment.Entity == nil {
+		return ierr.NewError("invalid payment.failed payload").
+			WithHint("The payment entity is required").
+			Mark(ierr.ErrValidation)
+	}
+	razorpayPayment := event.Payload.Payment.Entity
+
+	paymentID := razorpayPayment.Notes["flexprice_payment_id"]
+	if paymentID == "" {
+		h.logger.Debugw("payment was not created by flexprice, skipping", "razorpay_payment_id", razorpayPayment.ID)
+		return nil
+	}
+
+	p, err := paymentService.GetPayment(ctx, paymentID)
+	if err != nil {
+		return err
+	}
+	if p.PaymentMethodType != types.PaymentMethodTypeCard {
+		h.logger.Infow("ignoring failed razorpay payment attempt",
+			"payment_id", paymentID,
+			"payment_method_type", p.PaymentMethodType,
+			"razorpay_payment_id", razorpayPayment.ID)
+		return nil
+	}
+
+	metadata := lo.Assign(p.Metadata, types.Metadata{
+		MetadataWebhookEventID: eventID,
+	})
+	updated, err := paymentService.UpdatePaymentIfStatus(ctx, paymentID, dto.UpdatePaymentRequest{
+		PaymentStatus:    lo.ToPtr(string(types.PaymentStatusFailed)),
+		GatewayPaymentID: lo.ToPtr(razorpayPayment.ID),
+		ErrorMessage:     lo.ToPtr(lo.CoalesceOrEmpty(razorpayPayment.ErrorDescription, "payment failed in Razorpay")),
+		FailedAt:         lo.ToPtr(time.Now().UTC()),
+		Metadata:         &metadata,
+	}, types.PaymentStatusPending, types.PaymentStatusProcessing)
+	if err != nil {
+		return err
+	}
+	if updated == nil {
+		h.logger.Infow("payment is no longer pending, skipping",
+			"payment_id", paymentID,
+			"event_id", eventID)
+	}
+	return nil
+}
+
+// completePayment marks the payment as succeeded and reconciles its invoice. Razorpay delivers webhooks
+// at least once, the event id is recorded on the payment and the payment only moves to succeeded from a
+// pending status so a redelivery does not reconcile the invoice twice.
+func (h *WebhookHandler) completePayment(ctx context.Context, eventID string, paymentID string, razorpayPayment *Payment, paymentService interfaces.PaymentService, invoiceService interfaces.InvoiceService) error {
+	p, err := paymentService.GetPayment(ctx, paymentID)
+	if err != nil {
+		return err
+	}
+	if eventID != "" && p.Metadata[MetadataWebhookEventID] == eventID {
+		h.logger.Infow("webhook event already processed, skipping",
+			"payment_id", paymentID,
+			"event_id", eventID)
+		return nil
+	}
+
+	metadata := lo.Assign(p.Metadata, types.Metadata{
+		MetadataWebhookEventID: eventID,
+	})
+	updated, err := paymentService.UpdatePaymentIfStatus(ctx, paymentID, dto.UpdatePaymentRequest{
+		PaymentStatus:    lo.ToPtr(string(types.PaymentStatusSucceeded)),
+		GatewayPaymentID: lo.ToPtr(razorpayPayment.ID),
+		PaymentGateway:   lo.ToPtr(string(types.PaymentGatewayTypeRazorpay)),
+		SucceededAt:      lo.ToPtr(time.Now().UTC()),
+		Metadata:         &metadata,
+	}, types.PaymentStatusInitiated, types.PaymentStatusPending, types.PaymentStatusProcessing, types.PaymentStatusFailed)
+	if err != nil {
+		return err
+	}
+	if updated == nil {
+		h.logger.Infow("payment is no longer pending, skipping",
+			"payment_id", paymentID,
+			"event_id", eventID)
+		return nil
+	}
+
+	if p.DestinationType != types.PaymentDestinationTypeInvoice {
+		return nil
+	}
+
+	invoiceResp, err := invoiceService.GetInvoice(ctx, p.DestinationID)
+	if err != nil {
+		return err
+	}
+
+	amount := fromSubunits(razorpayPayment.Amount, razorpayPayment.Currency)
+	status := types.PaymentStatusPending
+	remaining := invoiceResp.AmountDue.Sub(invoiceResp.AmountPaid.Add(amount))
+	if remaining.IsZero() {
+		status = types.PaymentStatusSucceeded
+	} else if remaining.LessThan(decimal.Zero) {
+		status = types.PaymentStatusOverpaid
+	}
+
+	return invoiceService.ReconcilePaymentStatus(ctx, p.DestinationID, status, &amount)
+}
+
+// handleRefund records the outcome of a refund created for a credit note
+func (h *WebhookHandler) handleRefund(ctx context.Context, event *WebhookEvent, paymentService interfaces.PaymentService) error {
+	if event.Payload.Refund == nil || event.Payload.Refund.Entity == nil {
+		return ierr.NewError("invalid refund payload").
+			WithHint("The refund entity is required").
+			Mark(ierr.ErrValidation)
+	}
+	refund := event.Payload.Refund.Entity
+
+	refundID := refund.Notes["flexprice_refund_id"]
+	if refundID == "" {
+		h.logger.Infow("refund was not created by flexprice, skipping", "razorpay_refund_id", refund.ID)
+		return nil
+	}
+
+	req := dto.UpdatePaymentRefundRequest{
+		RefundStatus:    ToRefundStatus(refund.Status),
+		GatewayRefundID: lo.ToPtr(refund.ID),
+	}
+	if req.RefundStatus == types.PaymentStatusFailed {
+		req.FailureReason = lo.ToPtr("refund failed in Razorpay")
+	}
+
+	return paymentService.UpdateRefund(ctx, refundID, req)
+}
+
+// parseWebhookPayload decodes the body of a Razorpay webhook
+func parseWebhookPayload(payload []byte) (*WebhookEvent, error) {
+	var event WebhookEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Invalid Razorpay webhook payload").
+			Mark(ierr.ErrValidation)
+	}
+	if event.Event == "" {
+		return nil, ierr.NewError("missing webhook event type").
+			WithHint("Invalid Razorpay webhook payload").
+			Mark(ierr.ErrValidation)
+	}
+	return &event, nil
+}
@@ -0,0 +1,121 @@
+package stripe
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/interfaces"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// Gateway exposes the Stripe customer and payment services as a payment gateway
+type Gateway struct {
+	client      *Client
+	customerSvc *CustomerService
+	paymentSvc  *PaymentService
+}
+
+// NewGateway creates a new Stripe payment gateway
+func NewGateway(client *Client, customerSvc *CustomerService, paymentSvc *PaymentService) *Gateway {
+	return &Gateway{
+		client:      client,
+		customerSvc: customerSvc,
+		paymentSvc:  paymentSvc,
+	}
+}
+
+// GetGatewayType returns the type of the payment gateway
+func (g *Gateway) GetGatewayType() types.PaymentGatewayType {
+	return types.PaymentGatewayTypeStripe
+}
+
+// CreateCustomer syncs the customer to Stripe and returns the Stripe customer id
+func (g *Gateway) CreateCustomer(ctx context.Context, customerID string, customerService interfaces.CustomerService) (string, error) {
+	customerResp, err := g.customerSvc.EnsureCustomerSyncedToStripe(ctx, customerID, customerService)
+	if err != nil {
+		return "", err
+	}
+	return customerResp.Customer.Metadata["stripe_customer_id"], nil
+}
+
+// HasCustomer checks if the customer is linked to a Stripe customer
+func (g *Gateway) HasCustomer(ctx context.Context, customerID string, customerService interfaces.CustomerService) bool {
+	return g.customerSvc.HasCustomerStripeMapping(ctx, customerID, customerService)
+}
+
+// CreateSetupIntent creates a Stripe checkout session in setup mode
+func (g *Gateway) CreateSetupIntent(ctx context.Context, customerID string, req *dto.CreateSetupIntentRequest, customerService interfaces.CustomerService) (*dto.SetupIntentResponse, error) {
+	return g.paymentSvc.SetupIntent(ctx, customerID, req, customerService)
+}
+
+// GetDefaultPaymentMethod returns the default payment method of the Stripe customer
+func (g *Gateway) GetDefaultPaymentMethod(ctx context.Context, customerID string, customerService interfaces.CustomerService) (*dto.PaymentMethodResponse, error) {
+	return g.customerSvc.GetDefaultPaymentMethod(ctx, customerID, customerService)
+}
+
+// ChargeSavedPaymentMethod charges a saved payment method through a Stripe payment intent
+func (g *Gateway) ChargeSavedPaymentMethod(ctx context.Context, req *dto.ChargeSavedPaymentMethodRequest, customerService interfaces.CustomerService, invoiceService interfaces.InvoiceService) (*dto.PaymentIntentResponse, error) {
+	return g.paymentSvc.ChargeSavedPaymentMethod(ctx, req, customerService, invoiceService)
+}
+
+// CreatePaymentLink creates a Stripe checkout session for the invoice
+func (g *Gateway) CreatePaymentLink(ctx context.Context, req *dto.CreatePaymentLinkRequest, customerService interfaces.CustomerService, invoiceService interfaces.InvoiceService) (*dto.PaymentLinkResponse, error) {
+	resp, err := g.paymentSvc.CreatePaymentLink(ctx, &dto.CreateStripePaymentLinkRequest{
+		InvoiceID:              req.InvoiceID,
+		CustomerID:             req.CustomerID,
+		Amount:                 req.Amount,
+		Currency:               req.Currency,
+		SuccessURL:             req.SuccessURL,
+		CancelURL:              req.CancelURL,
+		EnvironmentID:          types.GetEnvironmentID(ctx),
+		Metadata:               req.Metadata,
+		SaveCardAndMakeDefault: req.SaveCardAndMakeDefault,
+		PaymentID:              req.PaymentID,
+	}, customerService, invoiceService)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.PaymentLinkResponse{
+		ID:              resp.ID,
+		PaymentURL:      resp.PaymentURL,
+		PaymentIntentID: resp.PaymentIntentID,
+		Amount:          resp.Amount,
+		Currency:        resp.Currency,
+		Status:          resp.Status,
+		CreatedAt:       resp.CreatedAt,
+		PaymentID:       resp.PaymentID,
+		Gateway:         string(types.PaymentGatewayTypeStripe),
+	}, nil
+}
+
+// CreateRefund refunds the payment intent of a payment
+func (g *Gateway) CreateRefund(ctx context.Context, req *dto.CreateGatewayRefundRequest) (*dto.GatewayRefundResponse, error) {
+	return g.paymentSvc.CreateRefund(ctx, req)
+}
+
+// ParseWebhookEvent verifies a Stripe webhook payload with the webhook secret of the connection
+func (g *Gateway) ParseWebhookEvent(ctx context.Context, payload []byte, signature string) (*dto.GatewayWebhookEvent, error) {
+	_, stripeConfig, err := g.client.GetStripeClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if stripeConfig.WebhookSecret == "" {
+		return nil, ierr.NewError("webhook secret not configured").
+			WithHint("Configure the webhook secret of the Stripe connection").
+			Mark(ierr.ErrValidation)
+	}
+
+	event, err := g.paymentSvc.ParseWebhookEvent(payload, signature, stripeConfig.WebhookSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.GatewayWebhookEvent{
+		ID:      event.ID,
+		Type:    string(event.Type),
+		Gateway: types.PaymentGatewayTypeStripe,
+		Payload: payload,
+	}, nil
+}
@@ -499,6 +499,90 @@ func (s *PaymentService) ChargeSavedPaymentMethod(ctx context.Context, req *dto.
 	return response, nil
 }
 
+// CreateRefund refunds the amount of a payment intent charged through Stripe. The refund is
+// asynchronous for some payment methods, the returned status is pending until the refund webhook.
+func (s *PaymentService) CreateRefund(ctx context.Context, req *dto.CreateGatewayRefundRequest) (*dto.GatewayRefundResponse, error) {
+	stripeClient, _, err := s.client.GetStripeClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	amountInCents := req.Amount.Mul(decimal.NewFromInt(100)).IntPart()
+	params := &stripe.RefundCreateParams{
+		PaymentIntent: stripe.String(req.GatewayPaymentID),
+		Amount:        stripe.Int64(amountInCents),
+		Reason:        stripe.String(toStripeRefundReason(req.Reason)),
+		Metadata: map[string]string{
+			"environment_id":       types.GetEnvironmentID(ctx),
+			"payment_source":       "flexprice",
+			"flexprice_refund_id":  req.RefundID,
+			"flexprice_payment_id": req.PaymentID,
+		},
+	}
+	if req.CreditNoteID != "" {
+		params.Metadata["credit_note_id"] = req.CreditNoteID
+	}
+	// the refund id makes a retried request return the refund created the first time
+	params.SetIdempotencyKey(req.RefundID)
+
+	refund, err := stripeClient.V1Refunds.Create(ctx, params)
+	if err != nil {
+		s.logger.Errorw("failed to create Stripe refund",
+			"error", err,
+			"refund_id", req.RefundID,
+			"payment_id", req.PaymentID,
+			"payment_intent_id", req.GatewayPaymentID,
+			"amount", req.Amount.String(),
+		)
+		return nil, ierr.NewError("failed to create refund").
+			WithHint("Unable to refund the payment through Stripe").
+			WithReportableDetails(map[string]interface{}{
+				"payment_id":        req.PaymentID,
+				"payment_intent_id": req.GatewayPaymentID,
+				"error":             err.Error(),
+			}).
+			Mark(ierr.ErrSystem)
+	}
+
+	s.logger.Infow("created Stripe refund",
+		"refund_id", req.RefundID,
+		"stripe_refund_id", refund.ID,
+		"payment_id", req.PaymentID,
+		"amount", req.Amount.String(),
+		"status", refund.Status,
+	)
+
+	return &dto.GatewayRefundResponse{
+		ID:            refund.ID,
+		Status:        ToRefundStatus(refund.Status),
+		FailureReason: string(refund.FailureReason),
+	}, nil
+}
+
+// ToRefundStatus maps the status of a Stripe refund to the status of the refund record
+func ToRefundStatus(status stripe.RefundStatus) types.PaymentStatus {
+	switch status {
+	case stripe.RefundStatusSucceeded:
+		return types.PaymentStatusSucceeded
+	case stripe.RefundStatusFailed, stripe.RefundStatusCanceled:
+		return types.PaymentStatusFailed
+	default:
+		return types.PaymentStatusPending
+	}
+}
+
+// toStripeRefundReason maps the credit note reason to one of the refund reasons Stripe accepts
+func toStripeRefundReason(reason types.CreditNoteReason) string {
+	switch reason {
+	case types.CreditNoteReasonDuplicate:
+		return string(stripe.RefundReasonDuplicate)
+	case types.CreditNoteReasonFraudulent:
+		return string(stripe.RefundReasonFraudulent)
+	default:
+		return string(stripe.RefundReasonRequestedByCustomer)
+	}
+}
+
 // GetPaymentStatus gets the payment status from Stripe checkout session
 func (s *PaymentService) GetPaymentStatus(ctx context.Context, sessionID string, environmentID string) (*dto.PaymentStatusResponse, error) {
 	// Get Stripe client
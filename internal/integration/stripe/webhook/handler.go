@@ -88,6 +88,8 @@ func (h *Handler) HandleWebhookEvent(ctx context.Context, event *stripeapi.Event
 		return h.handleSubscriptionUpdated(ctx, event, environmentID, services)
 	case string(types.WebhookEventTypeSubscriptionDeleted):
 		return h.handleSubscriptionCancellation(ctx, event, environmentID, services)
+	case string(types.WebhookEventTypeRefundUpdated), string(types.WebhookEventTypeRefundFailed):
+		return h.handleRefundUpdated(ctx, event, environmentID, services)
 
 	default:
 		h.logger.Infow("unhandled Stripe webhook event type", "type", event.Type)
@@ -304,6 +306,55 @@ func (h *Handler) handlePaymentIntentPaymentFailed(ctx context.Context, event *s
 	return nil
 }
 
+// handleRefundUpdated handles refund.updated and refund.failed webhooks of refunds issued by FlexPrice
+func (h *Handler) handleRefundUpdated(ctx context.Context, event *stripeapi.Event, environmentID string, services *ServiceDependencies) error {
+	var refund stripeapi.Refund
+	err := json.Unmarshal(event.Data.Raw, &refund)
+	if err != nil {
+		h.logger.Errorw("failed to parse refund from webhook, skipping event", "error", err, "event_id", event.ID)
+		return nil
+	}
+
+	h.logger.Infow("received refund webhook",
+		"stripe_refund_id", refund.ID,
+		"status", refund.Status,
+		"environment_id", environmentID,
+		"event_id", event.ID)
+
+	// refunds created outside FlexPrice have no refund record
+	flexpriceRefundID := refund.Metadata["flexprice_refund_id"]
+	if flexpriceRefundID == "" {
+		h.logger.Debugw("no flexprice_refund_id found in refund metadata, skipping event",
+			"stripe_refund_id", refund.ID)
+		return nil
+	}
+
+	updateReq := dto.UpdatePaymentRefundRequest{
+		RefundStatus:    stripe.ToRefundStatus(refund.Status),
+		GatewayRefundID: &refund.ID,
+	}
+	if refund.FailureReason != "" {
+		failureReason := string(refund.FailureReason)
+		updateReq.FailureReason = &failureReason
+	}
+
+	if err := services.PaymentService.UpdateRefund(ctx, flexpriceRefundID, updateReq); err != nil {
+		h.logger.Errorw("failed to update refund record, skipping event",
+			"error", err,
+			"refund_id", flexpriceRefundID,
+			"stripe_refund_id", refund.ID,
+			"event_id", event.ID)
+		return nil
+	}
+
+	h.logger.Infow("successfully updated refund record",
+		"refund_id", flexpriceRefundID,
+		"stripe_refund_id", refund.ID,
+		"refund_status", updateReq.RefundStatus)
+
+	return nil
+}
+
 // handleSetupIntentSucceeded handles setup_intent.succeeded webhook
 func (h *Handler) handleSetupIntentSucceeded(ctx context.Context, event *stripeapi.Event, environmentID string, services *ServiceDependencies) error {
 	// Parse webhook to get setup intent data
@@ -27,7 +27,13 @@ type PaymentService interface {
 	GetPayment(ctx context.Context, id string) (*dto.PaymentResponse, error)
 	ListPayments(ctx context.Context, filter *types.PaymentFilter) (*dto.ListPaymentsResponse, error)
 	UpdatePayment(ctx context.Context, id string, req dto.UpdatePaymentRequest) (*dto.PaymentResponse, error)
+	// UpdatePaymentIfStatus applies the update only while the payment is in one of the from statuses, it
+	// returns a nil payment when the payment has moved on, e.g. on a redelivered gateway webhook
+	UpdatePaymentIfStatus(ctx context.Context, id string, req dto.UpdatePaymentRequest, from ...types.PaymentStatus) (*dto.PaymentResponse, error)
 	DeletePayment(ctx context.Context, id string) error
+	// UpdateRefund records the gateway outcome of a refund and moves its payment to
+	// REFUNDED or PARTIALLY_REFUNDED once the gateway confirmed the refund
+	UpdateRefund(ctx context.Context, id string, req dto.UpdatePaymentRefundRequest) error
 }
 
 // InvoiceService defines the interface for invoice operations
@@ -73,10 +79,12 @@ type SubscriptionService interface {
 	CreateSubscription(ctx context.Context, req dto.CreateSubscriptionRequest) (*dto.SubscriptionResponse, error)
 	GetSubscription(ctx context.Context, id string) (*dto.SubscriptionResponse, error)
 	UpdateSubscription(ctx context.Context, subscriptionID string, req dto.UpdateSubscriptionRequest) (*dto.SubscriptionResponse, error)
+	UpdateSubscriptionUsageAlerts(ctx context.Context, subscriptionID string, req *dto.UpdateSubscriptionUsageAlertsRequest) (*dto.SubscriptionResponse, error)
 	CancelSubscription(ctx context.Context, subscriptionID string, req *dto.CancelSubscriptionRequest) (*dto.CancelSubscriptionResponse, error)
 	ActivateIncompleteSubscription(ctx context.Context, subscriptionID string) error
 	ListSubscriptions(ctx context.Context, filter *types.SubscriptionFilter) (*dto.ListSubscriptionsResponse, error)
 	GetUsageBySubscription(ctx context.Context, req *dto.GetUsageBySubscriptionRequest) (*dto.GetUsageBySubscriptionResponse, error)
+	GetSubscriptionCommitment(ctx context.Context, subscriptionID string) (*dto.SubscriptionCommitmentResponse, error)
 	UpdateBillingPeriods(ctx context.Context) (*dto.SubscriptionUpdatePeriodResponse, error)
 
 	// Pause-related methods
@@ -113,6 +121,8 @@ type SubscriptionService interface {
 	ProcessAutoCancellationSubscriptions(ctx context.Context) error
 	// Renewal due alert methods
 	ProcessSubscriptionRenewalDueAlert(ctx context.Context) error
+	// Usage alert methods
+	ProcessSubscriptionUsageAlerts(ctx context.Context) error
 
 	// Feature usage tracking
 	GetFeatureUsageBySubscription(ctx context.Context, req *dto.GetUsageBySubscriptionRequest) (*dto.GetUsageBySubscriptionResponse, error)
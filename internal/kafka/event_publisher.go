@@ -30,23 +30,67 @@ func NewEventPublisher(producer *Producer, cfg *config.Configuration, logger *lo
 }
 
 func (p *EventPublisher) Publish(ctx context.Context, event *events.Event) error {
-	payload, err := json.Marshal(event)
+	p.logger.With(
+		zap.String("event_id", event.ID),
+		zap.String("event_name", event.EventName),
+		zap.String("tenant_id", event.TenantID),
+	).Debug("publishing event to kafka")
+
+	msg, err := p.newMessage(event)
 	if err != nil {
+		return err
+	}
+
+	/*
+		TODO: Once we support multiple event import integrations (e.g., S3, Postgres, etc.),
+		route those imported events to the lazy topic.
+	*/
+	if err := p.producer.Publish(p.determineTopic(event), msg); err != nil {
 		return ierr.WithError(err).
-			WithHint("Failed to marshal event").
+			WithHint("Failed to publish event").
 			Mark(ierr.ErrValidation)
 	}
+	return nil
+}
+
+// PublishBatch publishes the events with one producer call per topic
+func (p *EventPublisher) PublishBatch(ctx context.Context, batch []*events.Event) error {
+	messagesByTopic := make(map[string][]*message.Message)
+	for _, event := range batch {
+		msg, err := p.newMessage(event)
+		if err != nil {
+			return err
+		}
+		topic := p.determineTopic(event)
+		messagesByTopic[topic] = append(messagesByTopic[topic], msg)
+	}
 
 	p.logger.With(
-		zap.String("event_id", event.ID),
-		zap.String("event_name", event.EventName),
-		zap.String("tenant_id", event.TenantID),
-	).Debug("publishing event to kafka")
+		zap.Int("count", len(batch)),
+	).Debug("publishing event batch to kafka")
+
+	for topic, messages := range messagesByTopic {
+		if err := p.producer.Publish(topic, messages...); err != nil {
+			return ierr.WithError(err).
+				WithHint("Failed to publish event batch").
+				Mark(ierr.ErrValidation)
+		}
+	}
+	return nil
+}
 
+func (p *EventPublisher) newMessage(event *events.Event) (*message.Message, error) {
 	if event.ID == "" {
 		event.ID = watermill.NewUUID()
 	}
 
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to marshal event").
+			Mark(ierr.ErrValidation)
+	}
+
 	msg := message.NewMessage(event.ID, payload)
 
 	// Create a deterministic partition key based on tenant_id and external_customer_id
@@ -58,16 +102,7 @@ func (p *EventPublisher) Publish(ctx context.Context, event *events.Event) error
 	msg.Metadata.Set("tenant_id", event.TenantID)
 	msg.Metadata.Set("environment_id", event.EnvironmentID)
 	msg.Metadata.Set("partition_key", partitionKey)
-	/*
-		TODO: Once we support multiple event import integrations (e.g., S3, Postgres, etc.),
-		route those imported events to the lazy topic.
-	*/
-	if err := p.producer.Publish(p.determineTopic(event), msg); err != nil {
-		return ierr.WithError(err).
-			WithHint("Failed to publish event").
-			Mark(ierr.ErrValidation)
-	}
-	return nil
+	return msg, nil
 }
 
 func (p *EventPublisher) determineTopic(event *events.Event) string {
@@ -0,0 +1,165 @@
+package nats
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/config"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	natsgo "github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// Message is a message published to JetStream
+type Message struct {
+	// ID is used as the JetStream deduplication id
+	ID   string
+	Data []byte
+	// Header is sent as the NATS headers of the message, consumers read the tenant and the environment from it
+	Header map[string]string
+}
+
+// Client is a NATS connection publishing to and consuming from JetStream. The connection is opened on
+// first use and re-established by the NATS client after a failure.
+type Client struct {
+	cfg    *config.NATSConfig
+	logger *logger.Logger
+
+	mu   sync.Mutex
+	conn *natsgo.Conn
+	js   jetstream.JetStream
+}
+
+func NewClient(cfg *config.Configuration, logger *logger.Logger) *Client {
+	return &Client{
+		cfg:    &cfg.NATS,
+		logger: logger,
+	}
+}
+
+func (c *Client) timeout() time.Duration {
+	if c.cfg.TimeoutSeconds <= 0 {
+		return 5 * time.Second
+	}
+	return time.Duration(c.cfg.TimeoutSeconds) * time.Second
+}
+
+// JetStream returns the JetStream context of the connection, connecting to the server on first use
+func (c *Client) JetStream() (jetstream.JetStream, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.js != nil {
+		return c.js, nil
+	}
+
+	options := []natsgo.Option{
+		natsgo.Name("flexprice"),
+		natsgo.Timeout(c.timeout()),
+		natsgo.MaxReconnects(-1),
+		natsgo.DisconnectErrHandler(func(_ *natsgo.Conn, err error) {
+			if err != nil {
+				c.logger.Warnw("disconnected from nats", "error", err)
+			}
+		}),
+	}
+	if c.cfg.Username != "" {
+		options = append(options, natsgo.UserInfo(c.cfg.Username, c.cfg.Password))
+	}
+	if c.cfg.Token != "" {
+		options = append(options, natsgo.Token(c.cfg.Token))
+	}
+
+	conn, err := natsgo.Connect(c.cfg.URL, options...)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to connect to the NATS server").
+			WithReportableDetails(map[string]interface{}{
+				"url": c.cfg.URL,
+			}).
+			Mark(ierr.ErrSystem)
+	}
+
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return nil, ierr.WithError(err).
+			WithHint("Failed to create the JetStream context").
+			Mark(ierr.ErrSystem)
+	}
+
+	c.conn = conn
+	c.js = js
+	return js, nil
+}
+
+// PublishBatch publishes the messages on the subject and waits for their JetStream acknowledgements.
+// It returns the messages that were not acknowledged within the timeout, or an error when the client
+// could not connect, in which case none of the messages were published.
+func (c *Client) PublishBatch(ctx context.Context, subject string, messages []*Message) ([]*Message, error) {
+	js, err := c.JetStream()
+	if err != nil {
+		return nil, err
+	}
+
+	failed := make([]*Message, 0)
+	futures := make([]jetstream.PubAckFuture, 0, len(messages))
+	published := make([]*Message, 0, len(messages))
+	for _, msg := range messages {
+		natsMsg := natsgo.NewMsg(subject)
+		natsMsg.Data = msg.Data
+		for key, value := range msg.Header {
+			natsMsg.Header.Set(key, value)
+		}
+
+		future, err := js.PublishMsgAsync(natsMsg, jetstream.WithMsgID(msg.ID))
+		if err != nil {
+			c.logger.Warnw("failed to publish message to jetstream",
+				"subject", subject,
+				"message_id", msg.ID,
+				"error", err)
+			failed = append(failed, msg)
+			continue
+		}
+		futures = append(futures, future)
+		published = append(published, msg)
+	}
+
+	timer := time.NewTimer(c.timeout())
+	defer timer.Stop()
+
+	for i, future := range futures {
+		select {
+		case <-future.Ok():
+		case err := <-future.Err():
+			c.logger.Warnw("jetstream did not store message",
+				"subject", subject,
+				"message_id", published[i].ID,
+				"error", err)
+			failed = append(failed, published[i])
+		case <-timer.C:
+			// the messages without an acknowledgement are retried, JetStream drops the duplicates
+			// of the messages that were stored in the meantime by their message id
+			return append(failed, published[i:]...), nil
+		case <-ctx.Done():
+			return append(failed, published[i:]...), nil
+		}
+	}
+	return failed, nil
+}
+
+// Close drains and closes the connection
+func (c *Client) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.conn == nil {
+		return nil
+	}
+	err := c.conn.Drain()
+	c.conn = nil
+	c.js = nil
+	return err
+}
@@ -0,0 +1,95 @@
+package nats
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/flexprice/flexprice/internal/config"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeJetStream accepts a single connection and acknowledges the published messages, except the
+// messages ids listed in reject which get an error ack the first time they are published
+func fakeJetStream(t *testing.T, reject map[string]bool) (string, <-chan string) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	stored := make(chan string, 100)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		reader := bufio.NewReader(conn)
+		fmt.Fprint(conn, "INFO {\"headers\":true,\"max_payload\":1048576}\r\n")
+
+		seq := 0
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+			fields := strings.Fields(line)
+			switch fields[0] {
+			case "PING":
+				fmt.Fprint(conn, "PONG\r\n")
+			case "HPUB":
+				// HPUB <subject> <reply> <#header bytes> <#total bytes>
+				total, _ := strconv.Atoi(fields[4])
+				data := make([]byte, total+2)
+				if _, err := io.ReadFull(reader, data); err != nil {
+					return
+				}
+				_, id, _ := strings.Cut(strings.Split(string(data), "\r\n")[1], "Nats-Msg-Id: ")
+
+				ack := fmt.Sprintf(`{"stream":"EVENTS","seq":%d}`, seq+1)
+				if reject[id] {
+					delete(reject, id)
+					ack = `{"error":{"code":503,"description":"stream unavailable"}}`
+				} else {
+					seq++
+					stored <- id
+				}
+				fmt.Fprintf(conn, "MSG %s 1 %d\r\n%s\r\n", fields[2], len(ack), ack)
+			}
+		}
+	}()
+
+	return "nats://" + listener.Addr().String(), stored
+}
+
+func TestClientPublishBatch(t *testing.T) {
+	url, stored := fakeJetStream(t, map[string]bool{"event-2": true})
+
+	cfg := config.GetDefaultConfig()
+	cfg.NATS = config.NATSConfig{URL: url, Subject: "flexprice.events", BatchSize: 2, MaxRetries: 2, TimeoutSeconds: 2}
+	client := NewClient(cfg, logger.GetLogger())
+	defer client.Close()
+
+	failed, err := client.PublishBatch(context.Background(), cfg.NATS.Subject, []*Message{
+		{ID: "event-1", Data: []byte(`{"id":"event-1"}`)},
+		{ID: "event-2", Data: []byte(`{"id":"event-2"}`)},
+	})
+	require.NoError(t, err)
+	require.Len(t, failed, 1)
+	assert.Equal(t, "event-2", failed[0].ID)
+
+	// the rejected message is stored on retry over the same connection
+	failed, err = client.PublishBatch(context.Background(), cfg.NATS.Subject, failed)
+	require.NoError(t, err)
+	assert.Empty(t, failed)
+
+	assert.Equal(t, "event-1", <-stored)
+	assert.Equal(t, "event-2", <-stored)
+}
@@ -0,0 +1,118 @@
+package nats
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/flexprice/flexprice/internal/config"
+	"github.com/flexprice/flexprice/internal/domain/events"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+type EventPublisher struct {
+	client *Client
+	config *config.NATSConfig
+	logger *logger.Logger
+}
+
+func NewEventPublisher(client *Client, cfg *config.Configuration, logger *logger.Logger) *EventPublisher {
+	return &EventPublisher{
+		client: client,
+		config: &cfg.NATS,
+		logger: logger,
+	}
+}
+
+func (p *EventPublisher) Publish(ctx context.Context, event *events.Event) error {
+	return p.PublishBatch(ctx, []*events.Event{event})
+}
+
+// PublishBatch publishes the events to JetStream in batches of batch_size, retrying the messages
+// that were not acknowledged with an exponential backoff
+func (p *EventPublisher) PublishBatch(ctx context.Context, batch []*events.Event) error {
+	messages := make([]*Message, 0, len(batch))
+	for _, event := range batch {
+		payload, err := json.Marshal(event)
+		if err != nil {
+			return ierr.WithError(err).
+				WithHint("Failed to marshal event").
+				Mark(ierr.ErrValidation)
+		}
+		messages = append(messages, &Message{
+			ID:     event.ID,
+			Data:   payload,
+			Header: EventHeader(event),
+		})
+	}
+
+	batchSize := p.config.BatchSize
+	if batchSize <= 0 {
+		batchSize = 100
+	}
+
+	p.logger.With(
+		zap.Int("count", len(batch)),
+		zap.String("subject", p.config.Subject),
+	).Debug("publishing events to nats")
+
+	for _, chunk := range lo.Chunk(messages, batchSize) {
+		if err := p.publishWithRetry(ctx, chunk); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *EventPublisher) publishWithRetry(ctx context.Context, messages []*Message) error {
+	pending := messages
+	operation := func() error {
+		failed, err := p.client.PublishBatch(ctx, p.config.Subject, pending)
+		if err != nil {
+			p.logger.Warnw("failed to publish events to nats, retrying",
+				"count", len(pending),
+				"error", err)
+			return err
+		}
+		if len(failed) > 0 {
+			pending = failed
+			return ierr.NewErrorf("%d events were not acknowledged by jetstream", len(failed)).
+				WithHint("Failed to publish events to NATS JetStream").
+				Mark(ierr.ErrSystem)
+		}
+		return nil
+	}
+
+	policy := backoff.WithContext(backoff.WithMaxRetries(backoff.NewExponentialBackOff(), uint64(max(p.config.MaxRetries, 0))), ctx)
+	if err := backoff.Retry(operation, policy); err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to publish events to NATS JetStream").
+			WithReportableDetails(map[string]interface{}{
+				"subject": p.config.Subject,
+				"pending": len(pending),
+			}).
+			Mark(ierr.ErrSystem)
+	}
+	return nil
+}
+
+// EventHeader returns the headers of the message of the event, they become the metadata of the
+// consumed message
+func EventHeader(event *events.Event) map[string]string {
+	header := map[string]string{
+		"tenant_id":     event.TenantID,
+		"event_name":    event.EventName,
+		"partition_key": event.TenantID,
+	}
+	if event.EnvironmentID != "" {
+		header["environment_id"] = event.EnvironmentID
+	}
+	if event.ExternalCustomerID != "" {
+		header["partition_key"] = fmt.Sprintf("%s:%s", event.TenantID, event.ExternalCustomerID)
+	}
+	return header
+}
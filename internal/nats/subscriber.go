@@ -0,0 +1,153 @@
+package nats
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill/message"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// Subscriber consumes a subject of a JetStream stream with a durable consumer, it implements the
+// watermill subscriber so that the messages go through the handlers of the pubsub router. Messages
+// are acknowledged once the handler acked them and redelivered when it nacked them.
+type Subscriber struct {
+	client  *Client
+	durable string
+	logger  *logger.Logger
+
+	closing   chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+// NewSubscriber creates a subscriber consuming with the durable consumer, every durable consumer
+// receives all the messages of the subject like a kafka consumer group
+func NewSubscriber(client *Client, durable string, logger *logger.Logger) *Subscriber {
+	return &Subscriber{
+		client:  client,
+		durable: durable,
+		logger:  logger,
+		closing: make(chan struct{}),
+	}
+}
+
+// Subscribe consumes the subject, the stream capturing it must exist
+func (s *Subscriber) Subscribe(ctx context.Context, subject string) (<-chan *message.Message, error) {
+	js, err := s.client.JetStream()
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := js.StreamNameBySubject(ctx, subject)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHintf("No JetStream stream captures the subject %s", subject).
+			Mark(ierr.ErrSystem)
+	}
+
+	consumer, err := js.CreateOrUpdateConsumer(ctx, stream, jetstream.ConsumerConfig{
+		Durable:       s.durable,
+		FilterSubject: subject,
+		AckPolicy:     jetstream.AckExplicitPolicy,
+	})
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to create the JetStream consumer").
+			WithReportableDetails(map[string]interface{}{
+				"stream":  stream,
+				"durable": s.durable,
+			}).
+			Mark(ierr.ErrSystem)
+	}
+
+	output := make(chan *message.Message)
+	consumeCtx, err := consumer.Consume(func(jsMsg jetstream.Msg) {
+		s.handle(ctx, jsMsg, output)
+	})
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to consume the JetStream consumer").
+			Mark(ierr.ErrSystem)
+	}
+
+	s.logger.Infow("subscribed to jetstream",
+		"stream", stream,
+		"subject", subject,
+		"durable", s.durable)
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		select {
+		case <-ctx.Done():
+		case <-s.closing:
+		}
+		consumeCtx.Stop()
+		<-consumeCtx.Closed()
+		close(output)
+	}()
+
+	return output, nil
+}
+
+// handle hands the message to the router and waits for the handler, the messages of a consumer are
+// handled one at a time
+func (s *Subscriber) handle(ctx context.Context, jsMsg jetstream.Msg, output chan<- *message.Message) {
+	uuid := jsMsg.Headers().Get(jetstream.MsgIDHeader)
+	if uuid == "" {
+		uuid = watermill.NewUUID()
+	}
+
+	msg := message.NewMessage(uuid, jsMsg.Data())
+	for key := range jsMsg.Headers() {
+		msg.Metadata.Set(key, jsMsg.Headers().Get(key))
+	}
+
+	msgCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	msg.SetContext(msgCtx)
+
+	select {
+	case output <- msg:
+	case <-ctx.Done():
+		s.nak(jsMsg)
+		return
+	case <-s.closing:
+		s.nak(jsMsg)
+		return
+	}
+
+	select {
+	case <-msg.Acked():
+		if err := jsMsg.Ack(); err != nil {
+			s.logger.Warnw("failed to ack jetstream message",
+				"message_uuid", uuid,
+				"error", err)
+		}
+	case <-msg.Nacked():
+		s.nak(jsMsg)
+	case <-ctx.Done():
+		s.nak(jsMsg)
+	case <-s.closing:
+		s.nak(jsMsg)
+	}
+}
+
+func (s *Subscriber) nak(jsMsg jetstream.Msg) {
+	if err := jsMsg.Nak(); err != nil {
+		s.logger.Warnw("failed to nak jetstream message", "error", err)
+	}
+}
+
+// Close stops the subscriptions, the connection is closed with the client
+func (s *Subscriber) Close() error {
+	s.closeOnce.Do(func() {
+		close(s.closing)
+	})
+	s.wg.Wait()
+	return nil
+}
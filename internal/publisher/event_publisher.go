@@ -2,14 +2,17 @@ package publisher
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"sync"
 
 	"github.com/flexprice/flexprice/internal/config"
 	"github.com/flexprice/flexprice/internal/domain/events"
 	"github.com/flexprice/flexprice/internal/dynamodb"
+	"github.com/flexprice/flexprice/internal/httpforwarder"
 	"github.com/flexprice/flexprice/internal/kafka"
 	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/nats"
+	"github.com/flexprice/flexprice/internal/sqs"
 	"github.com/flexprice/flexprice/internal/types"
 	"go.uber.org/zap"
 )
@@ -17,17 +20,23 @@ import (
 // EventPublisher handles event publishing across multiple destinations
 type EventPublisher interface {
 	Publish(ctx context.Context, event *events.Event) error
+	// PublishBatch publishes the events with a single call per destination
+	PublishBatch(ctx context.Context, events []*events.Event) error
+}
+
+// destination is an EventPublisher for a single publish destination
+type destination struct {
+	name      types.PublishDestination
+	publisher EventPublisher
 }
 
 type eventPublisher struct {
-	kafkaPublisher  *kafka.EventPublisher
-	dynamoPublisher *dynamodb.EventPublisher
-	logger          *logger.Logger
-	config          *config.EventConfig
-	mu              sync.RWMutex
+	destinations []destination
+	logger       *logger.Logger
 }
 
-// NewEventPublisher creates a new publisher
+// NewEventPublisher creates a publisher for the destinations of the event config. The kafka producer and
+// the dynamodb client are only required when their destination is configured.
 func NewEventPublisher(
 	cfg *config.Configuration,
 	logger *logger.Logger,
@@ -36,67 +45,96 @@ func NewEventPublisher(
 ) (EventPublisher, error) {
 	publisher := &eventPublisher{
 		logger: logger,
-		config: &cfg.Event,
 	}
 
-	// Initialize publishers based on configuration
-	if cfg.Event.PublishDestination == types.PublishToKafka || cfg.Event.PublishDestination == types.PublishToAll {
-		if kafkaProducer == nil {
-			return nil, fmt.Errorf("kafka producer is not initialized but it is one of the publish destinations")
+	for _, name := range cfg.Event.GetDestinations() {
+		if err := name.Validate(); err != nil {
+			return nil, err
 		}
-		publisher.kafkaPublisher = kafka.NewEventPublisher(kafkaProducer, cfg, logger)
-	}
 
-	if cfg.Event.PublishDestination == types.PublishToDynamoDB || cfg.Event.PublishDestination == types.PublishToAll {
-		if dynamoClient == nil {
-			return nil, fmt.Errorf("dynamodb client is not initialized but it is one of the publish destinations")
+		destinationPublisher, err := newDestinationPublisher(name, cfg, logger, kafkaProducer, dynamoClient)
+		if err != nil {
+			return nil, err
 		}
-		publisher.dynamoPublisher = dynamodb.NewEventPublisher(dynamoClient, cfg, logger)
+		publisher.destinations = append(publisher.destinations, destination{
+			name:      name,
+			publisher: destinationPublisher,
+		})
 	}
 
-	if publisher.kafkaPublisher == nil && publisher.dynamoPublisher == nil {
+	if len(publisher.destinations) == 0 {
 		return nil, fmt.Errorf("no publishers configured for destination: %s", cfg.Event.PublishDestination)
 	}
 
 	return publisher, nil
 }
 
-func (s *eventPublisher) Publish(ctx context.Context, event *events.Event) error {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
-
-	s.logger.With(
-		zap.String("event_id", event.ID),
-		zap.String("event_name", event.EventName),
-		zap.String("destination", string(s.config.PublishDestination)),
-	).Debug("publishing event")
-
-	switch s.config.PublishDestination {
+func newDestinationPublisher(
+	name types.PublishDestination,
+	cfg *config.Configuration,
+	logger *logger.Logger,
+	kafkaProducer *kafka.Producer,
+	dynamoClient *dynamodb.Client,
+) (EventPublisher, error) {
+	switch name {
 	case types.PublishToKafka:
-		return s.kafkaPublisher.Publish(ctx, event)
+		if kafkaProducer == nil {
+			return nil, fmt.Errorf("kafka producer is not initialized but it is one of the publish destinations")
+		}
+		return kafka.NewEventPublisher(kafkaProducer, cfg, logger), nil
 	case types.PublishToDynamoDB:
-		return s.dynamoPublisher.Publish(ctx, event)
-	case types.PublishToAll:
-		// Publish to both and fail if either fails
-		var kafkaErr, dynamoErr error
-		if err := s.kafkaPublisher.Publish(ctx, event); err != nil {
-			kafkaErr = fmt.Errorf("failed to publish to kafka: %w", err)
+		if dynamoClient == nil {
+			return nil, fmt.Errorf("dynamodb client is not initialized but it is one of the publish destinations")
 		}
-
-		if err := s.dynamoPublisher.Publish(ctx, event); err != nil {
-			dynamoErr = fmt.Errorf("failed to publish to dynamodb: %w", err)
+		return dynamodb.NewEventPublisher(dynamoClient, cfg, logger), nil
+	case types.PublishToNATS:
+		return nats.NewEventPublisher(nats.NewClient(cfg, logger), cfg, logger), nil
+	case types.PublishToSQS:
+		client, err := sqs.NewClient(cfg)
+		if err != nil {
+			return nil, err
 		}
+		return sqs.NewEventPublisher(client, cfg, logger), nil
+	case types.PublishToHTTP:
+		return httpforwarder.NewEventPublisher(cfg, logger)
+	default:
+		return nil, fmt.Errorf("unknown publish destination: %s", name)
+	}
+}
+
+// Publish publishes the event to every destination and fails if any of them fails
+func (s *eventPublisher) Publish(ctx context.Context, event *events.Event) error {
+	var errs []error
+	for _, destination := range s.destinations {
+		s.logger.With(
+			zap.String("event_id", event.ID),
+			zap.String("event_name", event.EventName),
+			zap.String("destination", string(destination.name)),
+		).Debug("publishing event")
 
-		if kafkaErr != nil && dynamoErr != nil {
-			return fmt.Errorf("failed to publish to both kafka and dynamodb: %v, %v", kafkaErr, dynamoErr)
-		} else if kafkaErr != nil {
-			return kafkaErr
-		} else if dynamoErr != nil {
-			return dynamoErr
+		if err := destination.publisher.Publish(ctx, event); err != nil {
+			errs = append(errs, fmt.Errorf("failed to publish to %s: %w", destination.name, err))
 		}
+	}
+	return errors.Join(errs...)
+}
 
+// PublishBatch publishes the events to every destination and fails if any of them fails
+func (s *eventPublisher) PublishBatch(ctx context.Context, batch []*events.Event) error {
+	if len(batch) == 0 {
 		return nil
-	default:
-		return fmt.Errorf("unknown publish destination: %s", s.config.PublishDestination)
 	}
+
+	var errs []error
+	for _, destination := range s.destinations {
+		s.logger.With(
+			zap.Int("count", len(batch)),
+			zap.String("destination", string(destination.name)),
+		).Debug("publishing event batch")
+
+		if err := destination.publisher.PublishBatch(ctx, batch); err != nil {
+			errs = append(errs, fmt.Errorf("failed to publish batch to %s: %w", destination.name, err))
+		}
+	}
+	return errors.Join(errs...)
 }
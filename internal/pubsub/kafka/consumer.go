@@ -2,8 +2,11 @@ package kafka
 
 import (
 	"context"
+	"fmt"
+	"os"
 	"time"
 
+	"github.com/Shopify/sarama"
 	"github.com/ThreeDotsLabs/watermill"
 	"github.com/ThreeDotsLabs/watermill-kafka/v2/pkg/kafka"
 	"github.com/ThreeDotsLabs/watermill/message"
@@ -22,9 +25,28 @@ type Consumer struct {
 }
 
 func NewConsumer(cfg *config.Configuration, consumerGroupID string) (*Consumer, error) {
-	enableDebugLogs := cfg.Logging.Level == types.LogLevelDebug
+	return newConsumer(cfg, consumerGroupID, GetSaramaConfig(cfg))
+}
 
+// NewBroadcastConsumer creates a consumer in a consumer group of its own instance that starts at the newest
+// offset, so that every instance receives all the messages published after it first subscribed. The group
+// is named after the host so that restarts of the instance reuse it instead of leaving groups behind.
+func NewBroadcastConsumer(cfg *config.Configuration, consumerGroupPrefix string) (*Consumer, error) {
 	saramaConfig := GetSaramaConfig(cfg)
+	if saramaConfig != nil {
+		saramaConfig.Consumer.Offsets.Initial = sarama.OffsetNewest
+	}
+
+	instanceID, err := os.Hostname()
+	if err != nil || instanceID == "" {
+		instanceID = watermill.NewShortUUID()
+	}
+	return newConsumer(cfg, fmt.Sprintf("%s_%s", consumerGroupPrefix, instanceID), saramaConfig)
+}
+
+func newConsumer(cfg *config.Configuration, consumerGroupID string, saramaConfig *sarama.Config) (*Consumer, error) {
+	enableDebugLogs := cfg.Logging.Level == types.LogLevelDebug
+
 	if saramaConfig != nil {
 		// Optimize consumer configs for throughput
 		// TODO: move this to config
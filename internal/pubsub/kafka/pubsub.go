@@ -51,6 +51,26 @@ func NewPubSubFromConfig(
 	return NewPubSub(config, logger, producer, consumer), nil
 }
 
+// NewBroadcastPubSubFromConfig creates a pubsub whose subscriptions receive every message published
+// after they started, regardless of the other instances consuming the same topic
+func NewBroadcastPubSubFromConfig(
+	config *config.Configuration,
+	logger *logger.Logger,
+	consumerGroupPrefix string,
+) (pubsub.PubSub, error) {
+	producer, err := NewProducer(config)
+	if err != nil {
+		return nil, err
+	}
+
+	consumer, err := NewBroadcastConsumer(config, consumerGroupPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPubSub(config, logger, producer, consumer), nil
+}
+
 // Publish publishes a webhook event
 func (p *PubSub) Publish(ctx context.Context, topic string, msg *message.Message) error {
 	return p.producer.Publish(topic, msg)
@@ -42,6 +42,8 @@ import (
 	"time"
 
 	"github.com/flexprice/flexprice/internal/domain/events"
+	"github.com/flexprice/flexprice/internal/expression"
+	"github.com/flexprice/flexprice/internal/repository/clickhouse/builder"
 	"github.com/flexprice/flexprice/internal/types"
 	"github.com/shopspring/decimal"
 )
@@ -62,8 +64,14 @@ func GetAggregator(aggregationType types.AggregationType) events.Aggregator {
 		return &SumWithMultiAggregator{}
 	case types.AggregationMax:
 		return &MaxAggregator{}
+	case types.AggregationMin:
+		return &MinAggregator{}
+	case types.AggregationFirst:
+		return &FirstAggregator{}
 	case types.AggregationWeightedSum:
 		return &WeightedSumAggregator{}
+	case types.AggregationPercentile:
+		return &PercentileAggregator{}
 	}
 	return nil
 }
@@ -143,34 +151,25 @@ func formatWindowSizeWithBillingAnchor(windowSize types.WindowSize, billingAncho
 	return formatWindowSize(windowSize)
 }
 
-func buildFilterConditions(filters map[string][]string) string {
-	if len(filters) == 0 {
-		return ""
-	}
-
-	var conditions []string
-	for key, values := range filters {
-		if len(values) == 0 {
-			continue
-		}
-
-		quotedValues := make([]string, len(values))
-		for i, v := range values {
-			quotedValues[i] = fmt.Sprintf("'%s'", v)
+// getValueExpression returns the SQL expression for the value to aggregate, either the
+// configured property or the meter expression computed from multiple properties
+func getValueExpression(params *events.UsageParams) string {
+	if params.Expression != "" {
+		if expr, err := expression.Parse(params.Expression); err == nil {
+			return expr.ToClickHouseSQL("assumeNotNull(properties)")
 		}
-
-		conditions = append(conditions, fmt.Sprintf(
-			"JSONExtractString(properties, '%s') IN (%s)",
-			key,
-			strings.Join(quotedValues, ","),
-		))
 	}
+	return fmt.Sprintf("JSONExtractFloat(assumeNotNull(properties), '%s')", params.PropertyName)
+}
 
+// buildFilterConditions returns the meter filter conditions, excluding amended and retracted events
+func buildFilterConditions(ctx context.Context, params *events.UsageParams) string {
+	conditions := builder.FilterConditionsSQL(params.Filters, params.FilterConditions)
 	if len(conditions) == 0 {
-		return ""
+		return correctedEventsFilter(ctx)
 	}
 
-	return "AND " + strings.Join(conditions, " AND ")
+	return "AND " + strings.Join(conditions, " AND ") + " " + correctedEventsFilter(ctx)
 }
 
 func buildTimeConditions(params *events.UsageParams) string {
@@ -228,7 +227,7 @@ func (a *SumAggregator) GetQuery(ctx context.Context, params *events.UsageParams
 		customerFilter = fmt.Sprintf("AND customer_id = '%s'", params.CustomerID)
 	}
 
-	filterConditions := buildFilterConditions(params.Filters)
+	filterConditions := buildFilterConditions(ctx, params)
 	timeConditions := buildTimeConditions(params)
 
 	return fmt.Sprintf(`
@@ -236,7 +235,7 @@ func (a *SumAggregator) GetQuery(ctx context.Context, params *events.UsageParams
             %s sum(value) as total
         FROM (
             SELECT
-                %s anyLast(JSONExtractFloat(assumeNotNull(properties), '%s')) as value
+                %s anyLast(%s) as value
             FROM events
             PREWHERE tenant_id = '%s'
 				AND environment_id = '%s'
@@ -251,7 +250,7 @@ func (a *SumAggregator) GetQuery(ctx context.Context, params *events.UsageParams
     `,
 		selectClause,
 		windowClause,
-		params.PropertyName,
+		getValueExpression(params),
 		types.GetTenantID(ctx),
 		types.GetEnvironmentID(ctx),
 		params.EventName,
@@ -291,7 +290,7 @@ func (a *CountAggregator) GetQuery(ctx context.Context, params *events.UsagePara
 		customerFilter = fmt.Sprintf("AND customer_id = '%s'", params.CustomerID)
 	}
 
-	filterConditions := buildFilterConditions(params.Filters)
+	filterConditions := buildFilterConditions(ctx, params)
 	timeConditions := buildTimeConditions(params)
 
 	return fmt.Sprintf(`
@@ -350,7 +349,7 @@ func (a *CountUniqueAggregator) GetQuery(ctx context.Context, params *events.Usa
 		customerFilter = fmt.Sprintf("AND customer_id = '%s'", params.CustomerID)
 	}
 
-	filterConditions := buildFilterConditions(params.Filters)
+	filterConditions := buildFilterConditions(ctx, params)
 	timeConditions := buildTimeConditions(params)
 
 	return fmt.Sprintf(`
@@ -417,7 +416,7 @@ func (a *AvgAggregator) GetQuery(ctx context.Context, params *events.UsageParams
 		customerFilter = fmt.Sprintf("AND customer_id = '%s'", params.CustomerID)
 	}
 
-	filterConditions := buildFilterConditions(params.Filters)
+	filterConditions := buildFilterConditions(ctx, params)
 	timeConditions := buildTimeConditions(params)
 
 	return fmt.Sprintf(`
@@ -425,7 +424,7 @@ func (a *AvgAggregator) GetQuery(ctx context.Context, params *events.UsageParams
             %s avg(value) as total
         FROM (
             SELECT
-                %s anyLast(JSONExtractFloat(assumeNotNull(properties), '%s')) as value
+                %s anyLast(%s) as value
             FROM events
             PREWHERE tenant_id = '%s'
 				AND environment_id = '%s'
@@ -440,7 +439,7 @@ func (a *AvgAggregator) GetQuery(ctx context.Context, params *events.UsageParams
     `,
 		selectClause,
 		windowClause,
-		params.PropertyName,
+		getValueExpression(params),
 		types.GetTenantID(ctx),
 		types.GetEnvironmentID(ctx),
 		params.EventName,
@@ -480,12 +479,12 @@ func (a *LatestAggregator) GetQuery(ctx context.Context, params *events.UsagePar
 		customerFilter = fmt.Sprintf("AND customer_id = '%s'", params.CustomerID)
 	}
 
-	filterConditions := buildFilterConditions(params.Filters)
+	filterConditions := buildFilterConditions(ctx, params)
 	timeConditions := buildTimeConditions(params)
 
 	return fmt.Sprintf(`
         SELECT 
-            %s argMax(JSONExtractFloat(assumeNotNull(properties), '%s'), timestamp) as total
+            %s argMax(%s, timestamp) as total
         FROM 
 			events	PREWHERE tenant_id = '%s'
                 AND environment_id = '%s'
@@ -497,7 +496,7 @@ func (a *LatestAggregator) GetQuery(ctx context.Context, params *events.UsagePar
         %s
     `,
 		windowClause,
-		params.PropertyName,
+		getValueExpression(params),
 		types.GetTenantID(ctx),
 		types.GetEnvironmentID(ctx),
 		params.EventName,
@@ -539,7 +538,7 @@ func (a *SumWithMultiAggregator) GetQuery(ctx context.Context, params *events.Us
 		customerFilter = fmt.Sprintf("AND customer_id = '%s'", params.CustomerID)
 	}
 
-	filterConditions := buildFilterConditions(params.Filters)
+	filterConditions := buildFilterConditions(ctx, params)
 	timeConditions := buildTimeConditions(params)
 
 	multiplier := decimal.NewFromInt(1)
@@ -552,7 +551,7 @@ func (a *SumWithMultiAggregator) GetQuery(ctx context.Context, params *events.Us
             %s (sum(value) * %f) as total
         FROM (
             SELECT
-                %s anyLast(JSONExtractFloat(assumeNotNull(properties), '%s')) as value
+                %s anyLast(%s) as value
             FROM events
             PREWHERE tenant_id = '%s'
 				AND environment_id = '%s'
@@ -568,7 +567,7 @@ func (a *SumWithMultiAggregator) GetQuery(ctx context.Context, params *events.Us
 		selectClause,
 		multiplier.InexactFloat64(),
 		windowClause,
-		params.PropertyName,
+		getValueExpression(params),
 		types.GetTenantID(ctx),
 		types.GetEnvironmentID(ctx),
 		params.EventName,
@@ -621,7 +620,7 @@ func (a *MaxAggregator) getNonWindowedQuery(ctx context.Context, params *events.
 		customerFilter = fmt.Sprintf("AND customer_id = '%s'", params.CustomerID)
 	}
 
-	filterConditions := buildFilterConditions(params.Filters)
+	filterConditions := buildFilterConditions(ctx, params)
 	timeConditions := buildTimeConditions(params)
 
 	return fmt.Sprintf(`
@@ -629,7 +628,7 @@ func (a *MaxAggregator) getNonWindowedQuery(ctx context.Context, params *events.
 			%s max(value) as total
 		FROM (
 			SELECT
-				%s anyLast(JSONExtractFloat(assumeNotNull(properties), '%s')) as value
+				%s anyLast(%s) as value
 			FROM events
 			PREWHERE tenant_id = '%s'
 				AND environment_id = '%s'
@@ -644,7 +643,7 @@ func (a *MaxAggregator) getNonWindowedQuery(ctx context.Context, params *events.
 	`,
 		selectClause,
 		windowClause,
-		params.PropertyName,
+		getValueExpression(params),
 		types.GetTenantID(ctx),
 		types.GetEnvironmentID(ctx),
 		params.EventName,
@@ -670,7 +669,7 @@ func (a *MaxAggregator) getWindowedQuery(ctx context.Context, params *events.Usa
 		customerFilter = fmt.Sprintf("AND customer_id = '%s'", params.CustomerID)
 	}
 
-	filterConditions := buildFilterConditions(params.Filters)
+	filterConditions := buildFilterConditions(ctx, params)
 	timeConditions := buildTimeConditions(params)
 
 	// First get max values per bucket, then get the max across all buckets
@@ -678,7 +677,7 @@ func (a *MaxAggregator) getWindowedQuery(ctx context.Context, params *events.Usa
 		WITH bucket_maxes AS (
 			SELECT
 				%s as bucket_start,
-				max(JSONExtractFloat(assumeNotNull(properties), '%s')) as bucket_max
+				max(%s) as bucket_max
 			FROM events
 			PREWHERE tenant_id = '%s'
 				AND environment_id = '%s'
@@ -698,7 +697,7 @@ func (a *MaxAggregator) getWindowedQuery(ctx context.Context, params *events.Usa
 		ORDER BY bucket_start
 	`,
 		bucketWindow,
-		params.PropertyName,
+		getValueExpression(params),
 		types.GetTenantID(ctx),
 		types.GetEnvironmentID(ctx),
 		params.EventName,
@@ -712,6 +711,247 @@ func (a *MaxAggregator) GetType() types.AggregationType {
 	return types.AggregationMax
 }
 
+// MinAggregator implements min aggregation
+type MinAggregator struct{}
+
+func (a *MinAggregator) GetQuery(ctx context.Context, params *events.UsageParams) string {
+	// If bucket_size is specified, use windowed aggregation
+	if params.BucketSize != "" {
+		return a.getWindowedQuery(ctx, params)
+	}
+	// Otherwise use simple MIN aggregation
+	return a.getNonWindowedQuery(ctx, params)
+}
+
+func (a *MinAggregator) getNonWindowedQuery(ctx context.Context, params *events.UsageParams) string {
+	windowSize := formatWindowSizeWithBillingAnchor(params.WindowSize, params.BillingAnchor)
+	selectClause := ""
+	windowClause := ""
+	groupByClause := ""
+	windowGroupBy := ""
+
+	if windowSize != "" {
+		selectClause = "window_size,"
+		windowClause = fmt.Sprintf("%s AS window_size,", windowSize)
+		groupByClause = "GROUP BY window_size ORDER BY window_size"
+		windowGroupBy = ", window_size"
+	}
+
+	externalCustomerFilter := ""
+	if params.ExternalCustomerID != "" {
+		externalCustomerFilter = fmt.Sprintf("AND external_customer_id = '%s'", params.ExternalCustomerID)
+	}
+
+	customerFilter := ""
+	if params.CustomerID != "" {
+		customerFilter = fmt.Sprintf("AND customer_id = '%s'", params.CustomerID)
+	}
+
+	filterConditions := buildFilterConditions(ctx, params)
+	timeConditions := buildTimeConditions(params)
+
+	return fmt.Sprintf(`
+		SELECT 
+			%s min(value) as total
+		FROM (
+			SELECT
+				%s anyLast(%s) as value
+			FROM events
+			PREWHERE tenant_id = '%s'
+				AND environment_id = '%s'
+				AND event_name = '%s'
+				%s
+				%s
+				%s
+				%s
+			GROUP BY %s %s
+		)
+		%s
+	`,
+		selectClause,
+		windowClause,
+		getValueExpression(params),
+		types.GetTenantID(ctx),
+		types.GetEnvironmentID(ctx),
+		params.EventName,
+		externalCustomerFilter,
+		customerFilter,
+		filterConditions,
+		timeConditions,
+		getDeduplicationKey(),
+		windowGroupBy,
+		groupByClause)
+}
+
+func (a *MinAggregator) getWindowedQuery(ctx context.Context, params *events.UsageParams) string {
+	bucketWindow := formatWindowSizeWithBillingAnchor(params.BucketSize, params.BillingAnchor)
+
+	externalCustomerFilter := ""
+	if params.ExternalCustomerID != "" {
+		externalCustomerFilter = fmt.Sprintf("AND external_customer_id = '%s'", params.ExternalCustomerID)
+	}
+
+	customerFilter := ""
+	if params.CustomerID != "" {
+		customerFilter = fmt.Sprintf("AND customer_id = '%s'", params.CustomerID)
+	}
+
+	filterConditions := buildFilterConditions(ctx, params)
+	timeConditions := buildTimeConditions(params)
+
+	// First get min values per bucket, then sum the bucket mins for the total
+	return fmt.Sprintf(`
+		WITH bucket_mins AS (
+			SELECT
+				%s as bucket_start,
+				min(%s) as bucket_min
+			FROM events
+			PREWHERE tenant_id = '%s'
+				AND environment_id = '%s'
+				AND event_name = '%s'
+				%s
+				%s
+				%s
+				%s
+			GROUP BY bucket_start
+			ORDER BY bucket_start
+		)
+		SELECT
+			(SELECT sum(bucket_min) FROM bucket_mins) as total,
+			bucket_start as timestamp,
+			bucket_min as value
+		FROM bucket_mins
+		ORDER BY bucket_start
+	`,
+		bucketWindow,
+		getValueExpression(params),
+		types.GetTenantID(ctx),
+		types.GetEnvironmentID(ctx),
+		params.EventName,
+		externalCustomerFilter,
+		customerFilter,
+		filterConditions,
+		timeConditions)
+}
+
+func (a *MinAggregator) GetType() types.AggregationType {
+	return types.AggregationMin
+}
+
+// FirstAggregator implements first value aggregation, the counterpart of LatestAggregator
+type FirstAggregator struct{}
+
+func (a *FirstAggregator) GetQuery(ctx context.Context, params *events.UsageParams) string {
+	// If bucket_size is specified, use windowed aggregation
+	if params.BucketSize != "" {
+		return a.getWindowedQuery(ctx, params)
+	}
+	return a.getNonWindowedQuery(ctx, params)
+}
+
+func (a *FirstAggregator) getNonWindowedQuery(ctx context.Context, params *events.UsageParams) string {
+	windowSize := formatWindowSizeWithBillingAnchor(params.WindowSize, params.BillingAnchor)
+	windowClause := ""
+	groupByClause := ""
+
+	if windowSize != "" {
+		windowClause = fmt.Sprintf("%s AS window_size,", windowSize)
+		groupByClause = "GROUP BY window_size ORDER BY window_size"
+	}
+
+	externalCustomerFilter := ""
+	if params.ExternalCustomerID != "" {
+		externalCustomerFilter = fmt.Sprintf("AND external_customer_id = '%s'", params.ExternalCustomerID)
+	}
+
+	customerFilter := ""
+	if params.CustomerID != "" {
+		customerFilter = fmt.Sprintf("AND customer_id = '%s'", params.CustomerID)
+	}
+
+	filterConditions := buildFilterConditions(ctx, params)
+	timeConditions := buildTimeConditions(params)
+
+	return fmt.Sprintf(`
+        SELECT 
+            %s argMin(%s, timestamp) as total
+        FROM 
+			events	PREWHERE tenant_id = '%s'
+                AND environment_id = '%s'
+                AND event_name = '%s'
+                %s
+                %s
+                %s
+                %s
+        %s
+    `,
+		windowClause,
+		getValueExpression(params),
+		types.GetTenantID(ctx),
+		types.GetEnvironmentID(ctx),
+		params.EventName,
+		externalCustomerFilter,
+		customerFilter,
+		filterConditions,
+		timeConditions,
+		groupByClause)
+}
+
+func (a *FirstAggregator) getWindowedQuery(ctx context.Context, params *events.UsageParams) string {
+	bucketWindow := formatWindowSizeWithBillingAnchor(params.BucketSize, params.BillingAnchor)
+
+	externalCustomerFilter := ""
+	if params.ExternalCustomerID != "" {
+		externalCustomerFilter = fmt.Sprintf("AND external_customer_id = '%s'", params.ExternalCustomerID)
+	}
+
+	customerFilter := ""
+	if params.CustomerID != "" {
+		customerFilter = fmt.Sprintf("AND customer_id = '%s'", params.CustomerID)
+	}
+
+	filterConditions := buildFilterConditions(ctx, params)
+	timeConditions := buildTimeConditions(params)
+
+	// First get the earliest value per bucket, then sum the bucket values for the total
+	return fmt.Sprintf(`
+		WITH bucket_firsts AS (
+			SELECT
+				%s as bucket_start,
+				argMin(%s, timestamp) as bucket_first
+			FROM events
+			PREWHERE tenant_id = '%s'
+				AND environment_id = '%s'
+				AND event_name = '%s'
+				%s
+				%s
+				%s
+				%s
+			GROUP BY bucket_start
+			ORDER BY bucket_start
+		)
+		SELECT
+			(SELECT sum(bucket_first) FROM bucket_firsts) as total,
+			bucket_start as timestamp,
+			bucket_first as value
+		FROM bucket_firsts
+		ORDER BY bucket_start
+	`,
+		bucketWindow,
+		getValueExpression(params),
+		types.GetTenantID(ctx),
+		types.GetEnvironmentID(ctx),
+		params.EventName,
+		externalCustomerFilter,
+		customerFilter,
+		filterConditions,
+		timeConditions)
+}
+
+func (a *FirstAggregator) GetType() types.AggregationType {
+	return types.AggregationFirst
+}
+
 // WeightedSumAggregator implements weighted sum aggregation
 type WeightedSumAggregator struct{}
 
@@ -737,7 +977,7 @@ func (a *WeightedSumAggregator) GetQuery(ctx context.Context, params *events.Usa
 		customerFilter = fmt.Sprintf("AND customer_id = '%s'", params.CustomerID)
 	}
 
-	filterConditions := buildFilterConditions(params.Filters)
+	filterConditions := buildFilterConditions(ctx, params)
 	timeConditions := buildTimeConditions(params)
 
 	return fmt.Sprintf(`
@@ -747,7 +987,7 @@ func (a *WeightedSumAggregator) GetQuery(ctx context.Context, params *events.Usa
             dateDiff('second', period_start, period_end) AS total_seconds
         SELECT 
             %s sum(
-                (JSONExtractFloat(assumeNotNull(properties), '%s') / nullIf(total_seconds, 0)) *
+                (%s / nullIf(total_seconds, 0)) *
                 dateDiff('second', timestamp, period_end)
             ) AS total
         FROM (
@@ -768,7 +1008,7 @@ func (a *WeightedSumAggregator) GetQuery(ctx context.Context, params *events.Usa
 		formatClickHouseDateTime(params.StartTime),
 		formatClickHouseDateTime(params.EndTime),
 		selectClause,
-		params.PropertyName,
+		getValueExpression(params),
 		windowClause,
 		types.GetTenantID(ctx),
 		types.GetEnvironmentID(ctx),
@@ -785,6 +1025,143 @@ func (a *WeightedSumAggregator) GetType() types.AggregationType {
 	return types.AggregationWeightedSum
 }
 
+// PercentileAggregator implements percentile aggregation, e.g. P95 of the values in the period
+type PercentileAggregator struct{}
+
+func (a *PercentileAggregator) GetQuery(ctx context.Context, params *events.UsageParams) string {
+	// If bucket_size is specified, compute the percentile within each bucket
+	if params.BucketSize != "" {
+		return a.getWindowedQuery(ctx, params)
+	}
+	return a.getNonWindowedQuery(ctx, params)
+}
+
+func (a *PercentileAggregator) getNonWindowedQuery(ctx context.Context, params *events.UsageParams) string {
+	windowSize := formatWindowSizeWithBillingAnchor(params.WindowSize, params.BillingAnchor)
+	selectClause := ""
+	windowClause := ""
+	groupByClause := ""
+	windowGroupBy := ""
+
+	if windowSize != "" {
+		selectClause = "window_size,"
+		windowClause = fmt.Sprintf("%s AS window_size,", windowSize)
+		groupByClause = "GROUP BY window_size ORDER BY window_size"
+		windowGroupBy = ", window_size"
+	}
+
+	externalCustomerFilter := ""
+	if params.ExternalCustomerID != "" {
+		externalCustomerFilter = fmt.Sprintf("AND external_customer_id = '%s'", params.ExternalCustomerID)
+	}
+
+	customerFilter := ""
+	if params.CustomerID != "" {
+		customerFilter = fmt.Sprintf("AND customer_id = '%s'", params.CustomerID)
+	}
+
+	filterConditions := buildFilterConditions(ctx, params)
+	timeConditions := buildTimeConditions(params)
+
+	return fmt.Sprintf(`
+		SELECT 
+			%s quantileExact(%f)(value) as total
+		FROM (
+			SELECT
+				%s anyLast(%s) as value
+			FROM events
+			PREWHERE tenant_id = '%s'
+				AND environment_id = '%s'
+				AND event_name = '%s'
+				%s
+				%s
+				%s
+				%s
+			GROUP BY %s %s
+		)
+		%s
+	`,
+		selectClause,
+		formatPercentileLevel(params.Percentile),
+		windowClause,
+		getValueExpression(params),
+		types.GetTenantID(ctx),
+		types.GetEnvironmentID(ctx),
+		params.EventName,
+		externalCustomerFilter,
+		customerFilter,
+		filterConditions,
+		timeConditions,
+		getDeduplicationKey(),
+		windowGroupBy,
+		groupByClause)
+}
+
+func (a *PercentileAggregator) getWindowedQuery(ctx context.Context, params *events.UsageParams) string {
+	bucketWindow := formatWindowSizeWithBillingAnchor(params.BucketSize, params.BillingAnchor)
+
+	externalCustomerFilter := ""
+	if params.ExternalCustomerID != "" {
+		externalCustomerFilter = fmt.Sprintf("AND external_customer_id = '%s'", params.ExternalCustomerID)
+	}
+
+	customerFilter := ""
+	if params.CustomerID != "" {
+		customerFilter = fmt.Sprintf("AND customer_id = '%s'", params.CustomerID)
+	}
+
+	filterConditions := buildFilterConditions(ctx, params)
+	timeConditions := buildTimeConditions(params)
+
+	// First get the percentile per bucket, then sum the bucket values for the total
+	return fmt.Sprintf(`
+		WITH bucket_values AS (
+			SELECT
+				%s as bucket_start,
+				quantileExact(%f)(%s) as bucket_value
+			FROM events
+			PREWHERE tenant_id = '%s'
+				AND environment_id = '%s'
+				AND event_name = '%s'
+				%s
+				%s
+				%s
+				%s
+			GROUP BY bucket_start
+			ORDER BY bucket_start
+		)
+		SELECT
+			(SELECT sum(bucket_value) FROM bucket_values) as total,
+			bucket_start as timestamp,
+			bucket_value as value
+		FROM bucket_values
+		ORDER BY bucket_start
+	`,
+		bucketWindow,
+		formatPercentileLevel(params.Percentile),
+		getValueExpression(params),
+		types.GetTenantID(ctx),
+		types.GetEnvironmentID(ctx),
+		params.EventName,
+		externalCustomerFilter,
+		customerFilter,
+		filterConditions,
+		timeConditions)
+}
+
+func (a *PercentileAggregator) GetType() types.AggregationType {
+	return types.AggregationPercentile
+}
+
+// formatPercentileLevel converts a percentile in the range (0, 100] to the
+// quantile level in the range (0, 1] expected by ClickHouse quantile functions
+func formatPercentileLevel(percentile *decimal.Decimal) float64 {
+	if percentile == nil {
+		return 1
+	}
+	return percentile.Div(decimal.NewFromInt(100)).InexactFloat64()
+}
+
 // weighted sum final query without window size
 // WITH
 //             toDateTime64('2025-07-31 18:30:00.000', 3) AS period_start,
@@ -0,0 +1,120 @@
+package builder
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/flexprice/flexprice/internal/domain/meter"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// PropertyExtractSQL returns the SQL extracting the property identified by the key from the
+// properties column using the given JSONExtract* function. Nested keys like "request.region"
+// are extracted by path, unless a first level key containing the dots is present, which
+// mirrors meter.LookupProperty.
+func PropertyExtractSQL(fn string, key string) string {
+	path := meter.PropertyPath(key)
+	if len(path) == 1 && path[0] == key {
+		return fmt.Sprintf("%s(properties, %s)", fn, quoteString(key))
+	}
+	return fmt.Sprintf("if(JSONHas(properties, %s), %s(properties, %s), %s(properties, %s))",
+		quoteString(key), fn, quoteString(key), fn, quoteValues(path))
+}
+
+// PropertyStringSQL returns the SQL reading the property identified by the key as a string whatever its
+// JSON type. Numbers and booleans are read as written in the event, ex 4 or true, since JSONExtractString
+// returns an empty string for them.
+func PropertyStringSQL(key string) string {
+	raw := PropertyExtractSQL("JSONExtractRaw", key)
+	return fmt.Sprintf("if(startsWith(%s, '\"'), %s, %s)", raw, PropertyExtractSQL("JSONExtractString", key), raw)
+}
+
+// PropertyExistsSQL returns the SQL checking if the property identified by the key is present
+func PropertyExistsSQL(key string) string {
+	path := meter.PropertyPath(key)
+	if len(path) == 1 && path[0] == key {
+		return fmt.Sprintf("JSONHas(properties, %s)", quoteString(key))
+	}
+	return fmt.Sprintf("(JSONHas(properties, %s) OR JSONHas(properties, %s))", quoteString(key), quoteValues(path))
+}
+
+// InConditionSQL returns the SQL matching the property value against a list of exact values
+func InConditionSQL(key string, values []string) string {
+	property := PropertyExtractSQL("JSONExtractString", key)
+	if len(values) == 1 {
+		return fmt.Sprintf("%s = %s", property, quoteString(values[0]))
+	}
+	return fmt.Sprintf("%s IN (%s)", property, quoteValues(values))
+}
+
+// FilterConditionSQL returns the SQL condition for a meter filter, following the same
+// semantics as meter.Filter.Matches. Numbers and booleans are compared as written in the event
+// like the %v formatting used by Matches.
+func FilterConditionSQL(filter meter.Filter) string {
+	switch filter.GetOperator() {
+	case types.MeterFilterOperatorExists:
+		return PropertyExistsSQL(filter.Key)
+	case types.MeterFilterOperatorNotIn:
+		return fmt.Sprintf("(%s AND %s NOT IN (%s))",
+			PropertyExistsSQL(filter.Key),
+			PropertyStringSQL(filter.Key),
+			quoteValues(filter.Values))
+	case types.MeterFilterOperatorPrefix:
+		property := PropertyStringSQL(filter.Key)
+		prefixes := make([]string, len(filter.Values))
+		for i, prefix := range filter.Values {
+			prefixes[i] = fmt.Sprintf("startsWith(%s, %s)", property, quoteString(prefix))
+		}
+		return fmt.Sprintf("(%s)", strings.Join(prefixes, " OR "))
+	case types.MeterFilterOperatorRange:
+		// Non numeric values are not in any range, JSONExtractFloat would read them as 0
+		property := fmt.Sprintf("toFloat64OrNull(%s)", PropertyStringSQL(filter.Key))
+		conditions := []string{PropertyExistsSQL(filter.Key), fmt.Sprintf("isNotNull(%s)", property)}
+		if filter.Min != nil {
+			conditions = append(conditions, fmt.Sprintf("%s >= %s", property, filter.Min.String()))
+		}
+		if filter.Max != nil {
+			conditions = append(conditions, fmt.Sprintf("%s < %s", property, filter.Max.String()))
+		}
+		return fmt.Sprintf("(%s)", strings.Join(conditions, " AND "))
+	default:
+		return InConditionSQL(filter.Key, filter.Values)
+	}
+}
+
+// FilterConditionsSQL returns the SQL conditions for the exact match filters
+// and the operator based meter filters of the usage params
+func FilterConditionsSQL(filters map[string][]string, conditions []meter.Filter) []string {
+	var result []string
+	for key, values := range filters {
+		if len(values) == 0 {
+			continue
+		}
+		result = append(result, InConditionSQL(key, values))
+	}
+	for _, condition := range conditions {
+		result = append(result, FilterConditionSQL(condition))
+	}
+	return result
+}
+
+// CorrectedEventsConditionSQL returns the SQL excluding the events of the tenant environment that
+// were amended or retracted, amendments are counted through their replacement event instead
+func CorrectedEventsConditionSQL(tenantID, environmentID string) string {
+	return fmt.Sprintf("id NOT IN (SELECT event_id FROM event_corrections WHERE tenant_id = %s AND environment_id = %s)",
+		quoteString(tenantID), quoteString(environmentID))
+}
+
+func quoteString(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, `'`, `\'`)
+	return fmt.Sprintf("'%s'", value)
+}
+
+func quoteValues(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = quoteString(v)
+	}
+	return strings.Join(quoted, ",")
+}
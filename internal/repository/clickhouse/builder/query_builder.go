@@ -56,27 +56,8 @@ func (qb *QueryBuilder) WithBaseFilters(ctx context.Context, params *events.Usag
 		conditions = append(conditions, fmt.Sprintf("customer_id = '%s'", params.CustomerID))
 	}
 
-	if params.Filters != nil {
-		for property, values := range params.Filters {
-			if len(values) > 0 {
-				var condition string
-				if len(values) == 1 {
-					condition = fmt.Sprintf("JSONExtractString(properties, '%s') = '%s'", property, values[0])
-				} else {
-					quotedValues := make([]string, len(values))
-					for i, v := range values {
-						quotedValues[i] = fmt.Sprintf("'%s'", v)
-					}
-					condition = fmt.Sprintf(
-						"JSONExtractString(properties, '%s') IN (%s)",
-						property,
-						strings.Join(quotedValues, ","),
-					)
-				}
-				conditions = append(conditions, condition)
-			}
-		}
-	}
+	conditions = append(conditions, FilterConditionsSQL(params.Filters, params.FilterConditions)...)
+	conditions = append(conditions, CorrectedEventsConditionSQL(tenantID, environmentID))
 
 	qb.params = params
 	qb.baseQuery = fmt.Sprintf(`base_events AS (
@@ -104,21 +85,7 @@ func (qb *QueryBuilder) WithFilterGroups(ctx context.Context, groups []events.Fi
 			if len(values) == 0 {
 				continue
 			}
-			var condition string
-			if len(values) == 1 {
-				condition = fmt.Sprintf("JSONExtractString(properties, '%s') = '%s'", property, values[0])
-			} else {
-				quotedValues := make([]string, len(values))
-				for i, v := range values {
-					quotedValues[i] = fmt.Sprintf("'%s'", v)
-				}
-				condition = fmt.Sprintf(
-					"JSONExtractString(properties, '%s') IN (%s)",
-					property,
-					strings.Join(quotedValues, ","),
-				)
-			}
-			conditions = append(conditions, condition)
+			conditions = append(conditions, InConditionSQL(property, values))
 		}
 
 		// Only add the filter group if it has conditions
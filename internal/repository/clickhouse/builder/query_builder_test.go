@@ -2,6 +2,8 @@ package builder
 
 import (
 	"context"
+	"encoding/json"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -9,6 +11,8 @@ import (
 	"github.com/flexprice/flexprice/internal/domain/events"
 	"github.com/flexprice/flexprice/internal/domain/meter"
 	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -31,7 +35,7 @@ func TestQueryBuilder_WithBaseFilters(t *testing.T) {
 				CustomerID:         "cust_123",
 				ExternalCustomerID: "ext_123",
 			},
-			wantSQL: "WITH base_events AS (SELECT * FROM (SELECT DISTINCT ON (tenant_id, environment_id, timestamp, id) * FROM events WHERE event_name = 'audio_transcription' AND tenant_id = '00000000-0000-0000-0000-000000000000' AND timestamp >= toDateTime64('2024-01-01 00:00:00.000', 3) AND timestamp < toDateTime64('2024-01-02 00:00:00.000', 3) AND external_customer_id = 'ext_123' AND customer_id = 'cust_123' ORDER BY tenant_id, environment_id, timestamp, id DESC))",
+			wantSQL: "WITH base_events AS (SELECT * FROM (SELECT DISTINCT ON (tenant_id, environment_id, timestamp, id) * FROM events WHERE event_name = 'audio_transcription' AND tenant_id = '00000000-0000-0000-0000-000000000000' AND timestamp >= toDateTime64('2024-01-01 00:00:00.000', 3) AND timestamp < toDateTime64('2024-01-02 00:00:00.000', 3) AND external_customer_id = 'ext_123' AND customer_id = 'cust_123' AND id NOT IN (SELECT event_id FROM event_corrections WHERE tenant_id = '00000000-0000-0000-0000-000000000000' AND environment_id = '') ORDER BY tenant_id, environment_id, timestamp, id DESC))",
 		},
 		{
 			name: "base filters without customer ID",
@@ -40,7 +44,28 @@ func TestQueryBuilder_WithBaseFilters(t *testing.T) {
 				StartTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 				EndTime:   time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
 			},
-			wantSQL: "WITH base_events AS (SELECT * FROM (SELECT DISTINCT ON (tenant_id, environment_id, timestamp, id) * FROM events WHERE event_name = 'api_calls' AND tenant_id = '00000000-0000-0000-0000-000000000000' AND timestamp >= toDateTime64('2024-01-01 00:00:00.000', 3) AND timestamp < toDateTime64('2024-01-02 00:00:00.000', 3) ORDER BY tenant_id, environment_id, timestamp, id DESC))",
+			wantSQL: "WITH base_events AS (SELECT * FROM (SELECT DISTINCT ON (tenant_id, environment_id, timestamp, id) * FROM events WHERE event_name = 'api_calls' AND tenant_id = '00000000-0000-0000-0000-000000000000' AND timestamp >= toDateTime64('2024-01-01 00:00:00.000', 3) AND timestamp < toDateTime64('2024-01-02 00:00:00.000', 3) AND id NOT IN (SELECT event_id FROM event_corrections WHERE tenant_id = '00000000-0000-0000-0000-000000000000' AND environment_id = '') ORDER BY tenant_id, environment_id, timestamp, id DESC))",
+		},
+		{
+			name: "base filters with nested key and operator conditions",
+			params: &events.UsageParams{
+				EventName: "api_calls",
+				StartTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				EndTime:   time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+				FilterConditions: []meter.Filter{
+					{Key: "request.region", Operator: types.MeterFilterOperatorNotIn, Values: []string{"eu-west-1"}},
+					{Key: "latency_ms", Operator: types.MeterFilterOperatorRange, Min: lo.ToPtr(decimal.NewFromInt(100)), Max: lo.ToPtr(decimal.NewFromInt(500))},
+					{Key: "model", Operator: types.MeterFilterOperatorPrefix, Values: []string{"gpt-4", "o1"}},
+					{Key: "$.user.id", Operator: types.MeterFilterOperatorExists},
+				},
+			},
+			wantSQL: "WITH base_events AS (SELECT * FROM (SELECT DISTINCT ON (tenant_id, environment_id, timestamp, id) * FROM events WHERE event_name = 'api_calls' AND tenant_id = '00000000-0000-0000-0000-000000000000' AND timestamp >= toDateTime64('2024-01-01 00:00:00.000', 3) AND timestamp < toDateTime64('2024-01-02 00:00:00.000', 3) AND " +
+				"((JSONHas(properties, 'request.region') OR JSONHas(properties, 'request','region')) AND if(startsWith(if(JSONHas(properties, 'request.region'), JSONExtractRaw(properties, 'request.region'), JSONExtractRaw(properties, 'request','region')), '\"'), if(JSONHas(properties, 'request.region'), JSONExtractString(properties, 'request.region'), JSONExtractString(properties, 'request','region')), if(JSONHas(properties, 'request.region'), JSONExtractRaw(properties, 'request.region'), JSONExtractRaw(properties, 'request','region'))) NOT IN ('eu-west-1')) AND " +
+				"(JSONHas(properties, 'latency_ms') AND isNotNull(toFloat64OrNull(if(startsWith(JSONExtractRaw(properties, 'latency_ms'), '\"'), JSONExtractString(properties, 'latency_ms'), JSONExtractRaw(properties, 'latency_ms')))) AND toFloat64OrNull(if(startsWith(JSONExtractRaw(properties, 'latency_ms'), '\"'), JSONExtractString(properties, 'latency_ms'), JSONExtractRaw(properties, 'latency_ms'))) >= 100 AND toFloat64OrNull(if(startsWith(JSONExtractRaw(properties, 'latency_ms'), '\"'), JSONExtractString(properties, 'latency_ms'), JSONExtractRaw(properties, 'latency_ms'))) < 500) AND " +
+				"(startsWith(if(startsWith(JSONExtractRaw(properties, 'model'), '\"'), JSONExtractString(properties, 'model'), JSONExtractRaw(properties, 'model')), 'gpt-4') OR startsWith(if(startsWith(JSONExtractRaw(properties, 'model'), '\"'), JSONExtractString(properties, 'model'), JSONExtractRaw(properties, 'model')), 'o1')) AND " +
+				"(JSONHas(properties, '$.user.id') OR JSONHas(properties, 'user','id')) " +
+				"AND id NOT IN (SELECT event_id FROM event_corrections WHERE tenant_id = '00000000-0000-0000-0000-000000000000' AND environment_id = '') " +
+				"ORDER BY tenant_id, environment_id, timestamp, id DESC))",
 		},
 	}
 
@@ -253,3 +278,76 @@ func TestQueryBuilder_CompleteFlow(t *testing.T) {
 		})
 	}
 }
+
+func TestPropertyStringSQL(t *testing.T) {
+	// Numeric dimension values are read from the raw JSON instead of JSONExtractString
+	assert.Equal(t,
+		`if(startsWith(JSONExtractRaw(properties, 'tier'), '"'), JSONExtractString(properties, 'tier'), JSONExtractRaw(properties, 'tier'))`,
+		PropertyStringSQL("tier"))
+}
+
+// TestFilterConditionSQLMatchesParity checks the operator filters on numeric, boolean and string
+// properties read the value like meter.Filter.Matches. The ClickHouse functions of the generated SQL
+// are evaluated in Go: PropertyStringSQL keeps the raw JSON of non string values and toFloat64OrNull
+// returns NULL for values which are not numbers.
+func TestFilterConditionSQLMatchesParity(t *testing.T) {
+	propertyString := func(raw string) string {
+		var value string
+		if strings.HasPrefix(raw, `"`) && json.Unmarshal([]byte(raw), &value) == nil {
+			return value
+		}
+		return raw
+	}
+	evaluate := func(filter meter.Filter, raw string) bool {
+		value := propertyString(raw)
+		switch filter.GetOperator() {
+		case types.MeterFilterOperatorNotIn:
+			return !lo.Contains(filter.Values, value)
+		case types.MeterFilterOperatorPrefix:
+			return lo.SomeBy(filter.Values, func(prefix string) bool { return strings.HasPrefix(value, prefix) })
+		case types.MeterFilterOperatorRange:
+			number, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return false
+			}
+			return (filter.Min == nil || number >= filter.Min.InexactFloat64()) &&
+				(filter.Max == nil || number < filter.Max.InexactFloat64())
+		}
+		return false
+	}
+
+	notIn := meter.Filter{Key: "tier", Operator: types.MeterFilterOperatorNotIn, Values: []string{"4", "true"}}
+	prefix := meter.Filter{Key: "tier", Operator: types.MeterFilterOperatorPrefix, Values: []string{"4"}}
+	between := meter.Filter{Key: "tier", Operator: types.MeterFilterOperatorRange,
+		Min: lo.ToPtr(decimal.NewFromInt(1)), Max: lo.ToPtr(decimal.NewFromInt(10))}
+
+	tests := []struct {
+		name   string
+		filter meter.Filter
+		raw    string
+		want   bool
+	}{
+		{name: "not in numeric value", filter: notIn, raw: `4`, want: false},
+		{name: "not in numeric string", filter: notIn, raw: `"4"`, want: false},
+		{name: "not in boolean value", filter: notIn, raw: `true`, want: false},
+		{name: "not in other numeric value", filter: notIn, raw: `5`, want: true},
+		{name: "prefix numeric value", filter: prefix, raw: `42`, want: true},
+		{name: "prefix other numeric value", filter: prefix, raw: `24`, want: false},
+		{name: "range numeric value", filter: between, raw: `4.5`, want: true},
+		{name: "range numeric string", filter: between, raw: `"4.5"`, want: true},
+		{name: "range numeric value above max", filter: between, raw: `10`, want: false},
+		{name: "range non numeric string", filter: between, raw: `"abc"`, want: false},
+		{name: "range boolean value", filter: between, raw: `true`, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var properties map[string]interface{}
+			assert.NoError(t, json.Unmarshal([]byte(`{"tier":`+tt.raw+`}`), &properties))
+
+			assert.Equal(t, tt.want, tt.filter.Matches(properties))
+			assert.Contains(t, FilterConditionSQL(tt.filter), PropertyStringSQL(tt.filter.Key))
+			assert.Equal(t, tt.want, evaluate(tt.filter, tt.raw))
+		})
+	}
+}
@@ -0,0 +1,213 @@
+package clickhouse
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/flexprice/flexprice/internal/clickhouse"
+	"github.com/flexprice/flexprice/internal/domain/events"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+type DeadLetterEventRepository struct {
+	store  *clickhouse.ClickHouseStore
+	logger *logger.Logger
+}
+
+func NewDeadLetterEventRepository(store *clickhouse.ClickHouseStore, logger *logger.Logger) events.DeadLetterEventRepository {
+	return &DeadLetterEventRepository{store: store, logger: logger}
+}
+
+func (r *DeadLetterEventRepository) InsertDeadLetterEvents(ctx context.Context, deadLetterEvents []*events.DeadLetterEvent) error {
+	if len(deadLetterEvents) == 0 {
+		return nil
+	}
+
+	span := StartRepositorySpan(ctx, "dead_letter_event", "insert", map[string]interface{}{
+		"event_count": len(deadLetterEvents),
+	})
+	defer FinishSpan(span)
+
+	batch, err := r.store.GetConn().PrepareBatch(ctx, `
+		INSERT INTO dead_letter_events (
+			id, tenant_id, environment_id, external_customer_id, customer_id, event_name, source,
+			timestamp, ingested_at, properties, reason, error, status, replay_count, failed_at, replayed_at, version
+		)
+	`)
+	if err != nil {
+		SetSpanError(span, err)
+		return ierr.WithError(err).
+			WithHint("Failed to prepare batch for dead letter events").
+			Mark(ierr.ErrDatabase)
+	}
+
+	for _, event := range deadLetterEvents {
+		propertiesJSON, err := json.Marshal(event.Properties)
+		if err != nil {
+			SetSpanError(span, err)
+			return ierr.WithError(err).
+				WithHint("Failed to marshal event properties").
+				WithReportableDetails(map[string]interface{}{
+					"event_id": event.ID,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+
+		if err := batch.Append(
+			event.ID,
+			event.TenantID,
+			event.EnvironmentID,
+			event.ExternalCustomerID,
+			event.CustomerID,
+			event.EventName,
+			event.Source,
+			event.Timestamp,
+			event.IngestedAt,
+			string(propertiesJSON),
+			string(event.Reason),
+			event.Error,
+			string(event.Status),
+			event.ReplayCount,
+			event.FailedAt,
+			event.ReplayedAt,
+			event.Version,
+		); err != nil {
+			SetSpanError(span, err)
+			return ierr.WithError(err).
+				WithHint("Failed to append dead letter event to batch").
+				WithReportableDetails(map[string]interface{}{
+					"event_id": event.ID,
+				}).
+				Mark(ierr.ErrDatabase)
+		}
+	}
+
+	if err := batch.Send(); err != nil {
+		SetSpanError(span, err)
+		return ierr.WithError(err).
+			WithHint("Failed to insert dead letter events").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return nil
+}
+
+func (r *DeadLetterEventRepository) GetDeadLetterEvents(ctx context.Context, params *events.GetDeadLetterEventsParams) ([]*events.DeadLetterEvent, uint64, error) {
+	span := StartRepositorySpan(ctx, "dead_letter_event", "get_dead_letter_events", map[string]interface{}{
+		"event_name": params.EventName,
+		"reason":     params.Reason,
+		"page_size":  params.PageSize,
+	})
+	defer FinishSpan(span)
+
+	whereClause := " WHERE tenant_id = ? AND environment_id = ?"
+	args := []interface{}{types.GetTenantID(ctx), types.GetEnvironmentID(ctx)}
+
+	if len(params.EventIDs) > 0 {
+		placeholders := make([]string, len(params.EventIDs))
+		for i, id := range params.EventIDs {
+			placeholders[i] = "?"
+			args = append(args, id)
+		}
+		whereClause += " AND id IN (" + strings.Join(placeholders, ",") + ")"
+	}
+	if params.EventName != "" {
+		whereClause += " AND event_name = ?"
+		args = append(args, params.EventName)
+	}
+	if params.ExternalCustomerID != "" {
+		whereClause += " AND external_customer_id = ?"
+		args = append(args, params.ExternalCustomerID)
+	}
+	if params.Reason != "" {
+		whereClause += " AND reason = ?"
+		args = append(args, string(params.Reason))
+	}
+	if params.Status != "" {
+		whereClause += " AND status = ?"
+		args = append(args, string(params.Status))
+	}
+	if !params.StartTime.IsZero() {
+		whereClause += " AND failed_at >= ?"
+		args = append(args, params.StartTime)
+	}
+	if !params.EndTime.IsZero() {
+		whereClause += " AND failed_at <= ?"
+		args = append(args, params.EndTime)
+	}
+
+	// FINAL collapses the rows of replayed events to their latest version
+	var totalCount uint64
+	if err := r.store.GetConn().QueryRow(ctx, "SELECT COUNT(*) FROM dead_letter_events FINAL"+whereClause, args...).Scan(&totalCount); err != nil {
+		SetSpanError(span, err)
+		return nil, 0, ierr.WithError(err).
+			WithHint("Failed to count dead letter events").
+			Mark(ierr.ErrDatabase)
+	}
+
+	query := `
+		SELECT
+			id, tenant_id, environment_id, external_customer_id, customer_id, event_name, source,
+			timestamp, ingested_at, properties, reason, error, status, replay_count, failed_at, replayed_at, version
+		FROM dead_letter_events FINAL` + whereClause + `
+		ORDER BY failed_at DESC, id DESC`
+	if params.PageSize > 0 {
+		query += " LIMIT ? OFFSET ?"
+		args = append(args, params.PageSize, params.Offset)
+	}
+
+	rows, err := r.store.GetConn().Query(ctx, query, args...)
+	if err != nil {
+		SetSpanError(span, err)
+		return nil, 0, ierr.WithError(err).
+			WithHint("Failed to query dead letter events").
+			Mark(ierr.ErrDatabase)
+	}
+	defer rows.Close()
+
+	result := make([]*events.DeadLetterEvent, 0)
+	for rows.Next() {
+		var event events.DeadLetterEvent
+		var propertiesJSON, reason, status string
+
+		if err := rows.Scan(
+			&event.ID,
+			&event.TenantID,
+			&event.EnvironmentID,
+			&event.ExternalCustomerID,
+			&event.CustomerID,
+			&event.EventName,
+			&event.Source,
+			&event.Timestamp,
+			&event.IngestedAt,
+			&propertiesJSON,
+			&reason,
+			&event.Error,
+			&status,
+			&event.ReplayCount,
+			&event.FailedAt,
+			&event.ReplayedAt,
+			&event.Version,
+		); err != nil {
+			SetSpanError(span, err)
+			return nil, 0, ierr.WithError(err).
+				WithHint("Failed to scan dead letter event").
+				Mark(ierr.ErrDatabase)
+		}
+
+		event.Reason = types.DeadLetterReason(reason)
+		event.Status = types.DeadLetterEventStatus(status)
+		if err := json.Unmarshal([]byte(propertiesJSON), &event.Properties); err != nil {
+			r.logger.Warnw("failed to unmarshal dead letter event properties", "event_id", event.ID, "error", err)
+		}
+
+		result = append(result, &event)
+	}
+
+	SetSpanSuccess(span)
+	return result, totalCount, nil
+}
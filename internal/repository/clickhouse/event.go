@@ -10,6 +10,7 @@ import (
 	"github.com/flexprice/flexprice/internal/clickhouse"
 	"github.com/flexprice/flexprice/internal/domain/events"
 	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/expression"
 	"github.com/flexprice/flexprice/internal/logger"
 	"github.com/flexprice/flexprice/internal/repository/clickhouse/builder"
 	"github.com/flexprice/flexprice/internal/types"
@@ -212,6 +213,40 @@ func (r *EventRepository) GetUsage(ctx context.Context, params *events.UsagePara
 		}
 	}
 
+	// Validate percentile for PERCENTILE aggregations
+	if params.AggregationType == types.AggregationPercentile {
+		if params.Percentile == nil ||
+			params.Percentile.LessThanOrEqual(decimal.Zero) ||
+			params.Percentile.GreaterThan(decimal.NewFromInt(100)) {
+			err := ierr.NewError("invalid percentile value").
+				WithHint("Percentile must be greater than 0 and at most 100").
+				WithReportableDetails(map[string]interface{}{
+					"percentile": params.Percentile,
+				}).
+				Mark(ierr.ErrValidation)
+			SetSpanError(span, err)
+			return nil, err
+		}
+	}
+
+	// Validate expression if provided, aggregators fall back to the property otherwise
+	if params.Expression != "" {
+		if !params.AggregationType.SupportsExpression() {
+			err := ierr.NewError("expression not supported for this aggregation type").
+				WithHint("Expressions can only be used with aggregations on numeric values").
+				WithReportableDetails(map[string]interface{}{
+					"aggregation_type": params.AggregationType,
+				}).
+				Mark(ierr.ErrValidation)
+			SetSpanError(span, err)
+			return nil, err
+		}
+		if _, err := expression.Parse(params.Expression); err != nil {
+			SetSpanError(span, err)
+			return nil, err
+		}
+	}
+
 	aggregator := GetAggregator(params.AggregationType)
 	if aggregator == nil {
 		err := ierr.NewError("unsupported aggregation type").
@@ -245,7 +280,8 @@ func (r *EventRepository) GetUsage(ctx context.Context, params *events.UsagePara
 	result.EventName = params.EventName
 
 	// For windowed queries, we need to process all rows
-	if params.WindowSize != "" || (params.AggregationType == types.AggregationMax && params.BucketSize != "") {
+	isBucketed := params.AggregationType.SupportsBucketSize() && params.BucketSize != ""
+	if params.WindowSize != "" || isBucketed {
 		for rows.Next() {
 			var windowSize time.Time
 			var value decimal.Decimal
@@ -265,8 +301,8 @@ func (r *EventRepository) GetUsage(ctx context.Context, params *events.UsagePara
 						Mark(ierr.ErrDatabase)
 				}
 				value = decimal.NewFromUint64(countValue)
-			case types.AggregationMax:
-				if params.BucketSize != "" {
+			case types.AggregationMax, types.AggregationMin, types.AggregationFirst, types.AggregationPercentile:
+				if isBucketed {
 					var totalFloat, valueFloat float64
 					if err := rows.Scan(&totalFloat, &windowSize, &valueFloat); err != nil {
 						SetSpanError(span, err)
@@ -281,7 +317,7 @@ func (r *EventRepository) GetUsage(ctx context.Context, params *events.UsagePara
 					}
 					total = decimal.NewFromFloat(totalFloat)
 					value = decimal.NewFromFloat(valueFloat)
-					// Set the overall maximum as the result value
+					// Set the sum of the bucket values as the result value
 					result.Value = total
 				} else {
 					var floatValue float64
@@ -346,7 +382,7 @@ func (r *EventRepository) GetUsage(ctx context.Context, params *events.UsagePara
 						Mark(ierr.ErrDatabase)
 				}
 				result.Value = decimal.NewFromUint64(value)
-			case types.AggregationSum, types.AggregationAvg, types.AggregationLatest, types.AggregationSumWithMultiplier, types.AggregationMax, types.AggregationWeightedSum:
+			case types.AggregationSum, types.AggregationAvg, types.AggregationLatest, types.AggregationSumWithMultiplier, types.AggregationMax, types.AggregationMin, types.AggregationFirst, types.AggregationWeightedSum, types.AggregationPercentile:
 				var value float64
 				if err := rows.Scan(&value); err != nil {
 					SetSpanError(span, err)
@@ -452,7 +488,7 @@ func (r *EventRepository) GetUsageWithFilters(ctx context.Context, params *event
 					Mark(ierr.ErrDatabase)
 			}
 			result.Value = decimal.NewFromUint64(value)
-		case types.AggregationSum, types.AggregationAvg, types.AggregationLatest, types.AggregationSumWithMultiplier, types.AggregationMax:
+		case types.AggregationSum, types.AggregationAvg, types.AggregationLatest, types.AggregationSumWithMultiplier, types.AggregationMax, types.AggregationMin, types.AggregationFirst:
 			var value float64
 			if err := rows.Scan(&filterGroupID, &value); err != nil {
 				SetSpanError(span, err)
@@ -721,6 +757,9 @@ func (r *EventRepository) FindUnprocessedEvents(ctx context.Context, params *eve
 		types.GetEnvironmentID(ctx),
 	}
 
+	// Amended and retracted events must not be processed again
+	query += " AND e." + builder.CorrectedEventsConditionSQL(types.GetTenantID(ctx), types.GetEnvironmentID(ctx))
+
 	// Add the last seen ID and timestamp for keyset pagination if provided
 	if params.LastID != "" && !params.LastTimestamp.IsZero() {
 		// Use keyset pagination for better performance
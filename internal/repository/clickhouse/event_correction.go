@@ -0,0 +1,155 @@
+package clickhouse
+
+import (
+	"context"
+	"strings"
+
+	"github.com/flexprice/flexprice/internal/clickhouse"
+	"github.com/flexprice/flexprice/internal/domain/events"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/repository/clickhouse/builder"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+type EventCorrectionRepository struct {
+	store  *clickhouse.ClickHouseStore
+	logger *logger.Logger
+}
+
+func NewEventCorrectionRepository(store *clickhouse.ClickHouseStore, logger *logger.Logger) events.EventCorrectionRepository {
+	return &EventCorrectionRepository{store: store, logger: logger}
+}
+
+// correctedEventsFilter returns the condition excluding the amended and retracted events of the
+// tenant environment from queries on the events and feature_usage tables
+func correctedEventsFilter(ctx context.Context) string {
+	return "AND " + builder.CorrectedEventsConditionSQL(types.GetTenantID(ctx), types.GetEnvironmentID(ctx))
+}
+
+func (r *EventCorrectionRepository) InsertEventCorrection(ctx context.Context, correction *events.EventCorrection) error {
+	span := StartRepositorySpan(ctx, "event_correction", "insert", map[string]interface{}{
+		"event_id": correction.EventID,
+		"action":   correction.Action,
+	})
+	defer FinishSpan(span)
+
+	query := `
+		INSERT INTO event_corrections (
+			id, tenant_id, environment_id, event_id, event_name, external_customer_id, event_timestamp,
+			action, replacement_event_id, reason, created_at, created_by
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	if err := r.store.GetConn().Exec(ctx, query,
+		correction.ID,
+		correction.TenantID,
+		correction.EnvironmentID,
+		correction.EventID,
+		correction.EventName,
+		correction.ExternalCustomerID,
+		correction.EventTimestamp,
+		string(correction.Action),
+		correction.ReplacementEventID,
+		correction.Reason,
+		correction.CreatedAt,
+		correction.CreatedBy,
+	); err != nil {
+		SetSpanError(span, err)
+		return ierr.WithError(err).
+			WithHint("Failed to insert event correction").
+			WithReportableDetails(map[string]interface{}{
+				"event_id": correction.EventID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return nil
+}
+
+func (r *EventCorrectionRepository) GetEventCorrections(ctx context.Context, params *events.GetEventCorrectionsParams) ([]*events.EventCorrection, uint64, error) {
+	span := StartRepositorySpan(ctx, "event_correction", "get_event_corrections", map[string]interface{}{
+		"event_count": len(params.EventIDs),
+		"page_size":   params.PageSize,
+	})
+	defer FinishSpan(span)
+
+	whereClause := " WHERE tenant_id = ? AND environment_id = ?"
+	args := []interface{}{types.GetTenantID(ctx), types.GetEnvironmentID(ctx)}
+
+	if len(params.EventIDs) > 0 {
+		placeholders := make([]string, len(params.EventIDs))
+		for i, id := range params.EventIDs {
+			placeholders[i] = "?"
+			args = append(args, id)
+		}
+		whereClause += " AND event_id IN (" + strings.Join(placeholders, ",") + ")"
+	}
+	if params.ExternalCustomerID != "" {
+		whereClause += " AND external_customer_id = ?"
+		args = append(args, params.ExternalCustomerID)
+	}
+	if params.Action != "" {
+		whereClause += " AND action = ?"
+		args = append(args, string(params.Action))
+	}
+
+	var totalCount uint64
+	if err := r.store.GetConn().QueryRow(ctx, "SELECT COUNT(*) FROM event_corrections FINAL"+whereClause, args...).Scan(&totalCount); err != nil {
+		SetSpanError(span, err)
+		return nil, 0, ierr.WithError(err).
+			WithHint("Failed to count event corrections").
+			Mark(ierr.ErrDatabase)
+	}
+
+	query := `
+		SELECT
+			id, tenant_id, environment_id, event_id, event_name, external_customer_id, event_timestamp,
+			action, replacement_event_id, reason, created_at, created_by
+		FROM event_corrections FINAL` + whereClause + `
+		ORDER BY created_at DESC, id DESC
+		LIMIT ? OFFSET ?`
+	args = append(args, params.PageSize, params.Offset)
+
+	rows, err := r.store.GetConn().Query(ctx, query, args...)
+	if err != nil {
+		SetSpanError(span, err)
+		return nil, 0, ierr.WithError(err).
+			WithHint("Failed to query event corrections").
+			Mark(ierr.ErrDatabase)
+	}
+	defer rows.Close()
+
+	result := make([]*events.EventCorrection, 0)
+	for rows.Next() {
+		var correction events.EventCorrection
+		var action string
+
+		if err := rows.Scan(
+			&correction.ID,
+			&correction.TenantID,
+			&correction.EnvironmentID,
+			&correction.EventID,
+			&correction.EventName,
+			&correction.ExternalCustomerID,
+			&correction.EventTimestamp,
+			&action,
+			&correction.ReplacementEventID,
+			&correction.Reason,
+			&correction.CreatedAt,
+			&correction.CreatedBy,
+		); err != nil {
+			SetSpanError(span, err)
+			return nil, 0, ierr.WithError(err).
+				WithHint("Failed to scan event correction").
+				Mark(ierr.ErrDatabase)
+		}
+
+		correction.Action = types.EventCorrectionAction(action)
+		result = append(result, &correction)
+	}
+
+	SetSpanSuccess(span)
+	return result, totalCount, nil
+}
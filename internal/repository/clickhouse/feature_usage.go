@@ -12,6 +12,7 @@ import (
 	"github.com/flexprice/flexprice/internal/domain/events"
 	ierr "github.com/flexprice/flexprice/internal/errors"
 	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/repository/clickhouse/builder"
 	"github.com/flexprice/flexprice/internal/types"
 	"github.com/samber/lo"
 	"github.com/shopspring/decimal"
@@ -527,7 +528,8 @@ func (r *FeatureUsageRepository) getStandardAnalytics(ctx context.Context, param
 		AND timestamp >= ?
 		AND timestamp < ?
 		AND sign != 0
-	`, strings.Join(selectColumns, ",\n\t\t\t"))
+		AND %s
+	`, strings.Join(selectColumns, ",\n\t\t\t"), builder.CorrectedEventsConditionSQL(params.TenantID, params.EnvironmentID))
 
 	// Add filters for feature_ids
 	filterParams := []interface{}{}
@@ -715,7 +717,7 @@ func (r *FeatureUsageRepository) getStandardAnalytics(ctx context.Context, param
 	return results, nil
 }
 
-// getMaxBucketAnalytics handles analytics for MAX with bucket features
+// getMaxBucketAnalytics handles analytics for bucketed features and for MIN, FIRST and PERCENTILE features
 func (r *FeatureUsageRepository) getMaxBucketAnalytics(ctx context.Context, params *events.UsageAnalyticsParams, maxBucketFeatures map[string]*events.MaxBucketFeatureInfo) ([]*events.DetailedUsageAnalytic, error) {
 	// For MAX with bucket features, we need to:
 	// 1. Calculate totals using bucket-based aggregation (meter's bucket size)
@@ -735,8 +737,9 @@ func (r *FeatureUsageRepository) getMaxBucketAnalytics(ctx context.Context, para
 			return nil, err
 		}
 
-		// Get window-based time series points for each group
-		if featureInfo.BucketSize != "" {
+		// Get window-based time series points for each group. Non-bucketed features
+		// (e.g. plain PERCENTILE) only have points when a window size is requested
+		if featureInfo.BucketSize != "" || params.WindowSize != "" {
 			// Need to get points per group to match totals
 			for _, total := range totals {
 				points, err := r.getMaxBucketPointsForGroup(ctx, &featureParams, featureInfo, total)
@@ -757,7 +760,7 @@ func (r *FeatureUsageRepository) getMaxBucketAnalytics(ctx context.Context, para
 // getMaxBucketTotals calculates totals using bucket-based aggregation for MAX features
 func (r *FeatureUsageRepository) getMaxBucketTotals(ctx context.Context, params *events.UsageAnalyticsParams, featureInfo *events.MaxBucketFeatureInfo) ([]*events.DetailedUsageAnalytic, error) {
 	// Build bucket window expression based on meter's bucket size
-	bucketWindowExpr := r.formatWindowSize(featureInfo.BucketSize, nil)
+	bucketWindowExpr := r.formatBucketWindow(featureInfo.BucketSize, nil)
 
 	// Build group by columns based on request parameters
 	groupByColumns := []string{"bucket_start", "feature_id", "price_id", "meter_id", "sub_line_item_id"}
@@ -793,7 +796,7 @@ func (r *FeatureUsageRepository) getMaxBucketTotals(ctx context.Context, params
 		SELECT
 			%s as bucket_start,
 			%s,
-			max(qty_total * sign) as bucket_max,
+			%s as bucket_max,
 			argMax(qty_total, timestamp) as bucket_latest,
 			count(DISTINCT unique_hash) as bucket_count_unique,
 			count(DISTINCT id) as event_count
@@ -804,7 +807,8 @@ func (r *FeatureUsageRepository) getMaxBucketTotals(ctx context.Context, params
 		AND feature_id = ?
 		AND timestamp >= ?
 		AND timestamp < ?
-		AND sign != 0`, bucketWindowExpr, strings.Join(innerSelectColumns, ", "))
+		AND sign != 0
+		AND %s`, bucketWindowExpr, strings.Join(innerSelectColumns, ", "), r.bucketAggregationExpr(featureInfo), builder.CorrectedEventsConditionSQL(params.TenantID, params.EnvironmentID))
 
 	queryParams := []interface{}{
 		params.TenantID,
@@ -889,7 +893,7 @@ func (r *FeatureUsageRepository) getMaxBucketTotals(ctx context.Context, params
 			FeatureID:       featureInfo.FeatureID,
 			MeterID:         featureInfo.MeterID,
 			EventName:       featureInfo.EventName,
-			AggregationType: types.AggregationMax,
+			AggregationType: lo.Ternary(featureInfo.AggregationType != "", featureInfo.AggregationType, types.AggregationMax),
 			Points:          []events.UsageAnalyticPoint{},
 			Properties:      make(map[string]string),
 		}
@@ -949,8 +953,13 @@ func (r *FeatureUsageRepository) getMaxBucketTotals(ctx context.Context, params
 
 // getMaxBucketPointsForGroup calculates time series points for a specific group
 func (r *FeatureUsageRepository) getMaxBucketPointsForGroup(ctx context.Context, params *events.UsageAnalyticsParams, featureInfo *events.MaxBucketFeatureInfo, group *events.DetailedUsageAnalytic) ([]events.UsageAnalyticPoint, error) {
-	// Build window expression based on request window size
-	windowExpr := r.formatWindowSize(featureInfo.BucketSize, params.BillingAnchor)
+	// Build window expression based on the meter's bucket size, falling back to the
+	// request window size for features without a bucket size
+	bucketSize := featureInfo.BucketSize
+	if bucketSize == "" {
+		bucketSize = params.WindowSize
+	}
+	windowExpr := r.formatWindowSize(bucketSize, params.BillingAnchor)
 
 	// For MAX with bucket features, we need to first get max within each bucket,
 	// then aggregate those maxes within the request window
@@ -961,7 +970,7 @@ func (r *FeatureUsageRepository) getMaxBucketPointsForGroup(ctx context.Context,
 		SELECT
 			%s as bucket_start,
 			%s as window_start,
-			max(qty_total * sign) as bucket_max,
+			%s as bucket_max,
 			argMax(qty_total, timestamp) as bucket_latest,
 			count(DISTINCT unique_hash) as bucket_count_unique,
 			count(DISTINCT id) as event_count
@@ -972,7 +981,8 @@ func (r *FeatureUsageRepository) getMaxBucketPointsForGroup(ctx context.Context,
 		AND feature_id = ?
 		AND timestamp >= ?
 		AND timestamp < ?
-		AND sign != 0`, r.formatWindowSize(featureInfo.BucketSize, nil), windowExpr)
+		AND sign != 0
+		AND %s`, r.formatWindowSize(bucketSize, nil), windowExpr, r.bucketAggregationExpr(featureInfo), builder.CorrectedEventsConditionSQL(params.TenantID, params.EnvironmentID))
 
 	queryParams := []interface{}{
 		params.TenantID,
@@ -1101,6 +1111,30 @@ func (r *FeatureUsageRepository) getMaxBucketPointsForGroup(ctx context.Context,
 	return points, nil
 }
 
+// formatBucketWindow formats the bucket expression for bucketed analytics. Features without
+// a bucket size are aggregated as a single bucket spanning the whole requested period
+func (r *FeatureUsageRepository) formatBucketWindow(bucketSize types.WindowSize, billingAnchor *time.Time) string {
+	if bucketSize == "" {
+		return "toDateTime64(0, 3)"
+	}
+	return r.formatWindowSize(bucketSize, billingAnchor)
+}
+
+// bucketAggregationExpr returns the expression used to aggregate qty_total within a bucket
+func (r *FeatureUsageRepository) bucketAggregationExpr(featureInfo *events.MaxBucketFeatureInfo) string {
+	switch featureInfo.AggregationType {
+	case types.AggregationMin:
+		return "min(qty_total * sign)"
+	case types.AggregationFirst:
+		return "argMin(qty_total * sign, timestamp)"
+	case types.AggregationPercentile:
+		if featureInfo.Percentile != nil {
+			return fmt.Sprintf("quantileExact(%f)(qty_total * sign)", formatPercentileLevel(featureInfo.Percentile))
+		}
+	}
+	return "max(qty_total * sign)"
+}
+
 // formatWindowSize formats window size for ClickHouse queries
 func (r *FeatureUsageRepository) formatWindowSize(windowSize types.WindowSize, billingAnchor *time.Time) string {
 	switch windowSize {
@@ -1209,7 +1243,8 @@ func (r *FeatureUsageRepository) getAnalyticsPoints(
 		AND timestamp >= ?
 		AND timestamp < ?
 		AND sign != 0
-	`, strings.Join(selectColumns, ",\n\t\t\t"))
+		AND %s
+	`, strings.Join(selectColumns, ",\n\t\t\t"), builder.CorrectedEventsConditionSQL(params.TenantID, params.EnvironmentID))
 
 	// Add filters for the specific analytics item
 	queryParams := []interface{}{
@@ -1328,54 +1363,61 @@ func (r *FeatureUsageRepository) getAnalyticsPoints(
 }
 
 // GetFeatureUsageBySubscription gets usage data for a subscription using a single optimized query
-func (r *FeatureUsageRepository) GetFeatureUsageBySubscription(ctx context.Context, subscriptionID, externalCustomerID string, startTime, endTime time.Time) (map[string]*events.UsageByFeatureResult, error) {
+func (r *FeatureUsageRepository) GetFeatureUsageBySubscription(ctx context.Context, subscriptionID string, externalCustomerIDs []string, startTime, endTime time.Time) (map[string]*events.UsageByFeatureResult, error) {
 	// Extract tenantID and environmentID from context
 	tenantID := types.GetTenantID(ctx)
 	environmentID := types.GetEnvironmentID(ctx)
 
 	// Start a span for this repository operation
 	span := StartRepositorySpan(ctx, "feature_usage", "get_usage_by_subscription_v2", map[string]interface{}{
-		"subscription_id":      subscriptionID,
-		"external_customer_id": externalCustomerID,
-		"environment_id":       environmentID,
-		"tenant_id":            tenantID,
-		"start_time":           startTime,
-		"end_time":             endTime,
+		"subscription_id":       subscriptionID,
+		"external_customer_ids": externalCustomerIDs,
+		"environment_id":        environmentID,
+		"tenant_id":             tenantID,
+		"start_time":            startTime,
+		"end_time":              endTime,
 	})
 	defer FinishSpan(span)
 
-	query := `
+	query := fmt.Sprintf(`
 		SELECT 
 			sub_line_item_id,
 			feature_id,
 			meter_id,
 			sum(qty_total)                     AS sum_total,
 			max(qty_total)                     AS max_total,
+			min(qty_total)                     AS min_total,
 			count(DISTINCT id)                 AS count_distinct_ids,
 			count(DISTINCT unique_hash)        AS count_unique_qty,
-			argMax(qty_total, "timestamp")     AS latest_qty
+			argMax(qty_total, "timestamp")     AS latest_qty,
+			argMin(qty_total, "timestamp")     AS first_qty
 		FROM feature_usage
 		WHERE 
 			subscription_id = ?
-			AND external_customer_id = ?
+			AND external_customer_id IN (%s)
 			AND environment_id = ?
 			AND tenant_id = ?
 			AND "timestamp" >= ?
 			AND "timestamp" < ?
+			AND %s
 		GROUP BY sub_line_item_id, feature_id, meter_id
-	`
+	`, inPlaceholders(len(externalCustomerIDs)), builder.CorrectedEventsConditionSQL(tenantID, environmentID))
+
+	params := []interface{}{subscriptionID}
+	params = append(params, lo.ToAnySlice(externalCustomerIDs)...)
+	params = append(params, environmentID, tenantID, startTime, endTime)
 
 	log.Printf("Executing query: %s", query)
-	log.Printf("Params: %v", []interface{}{subscriptionID, externalCustomerID, environmentID, tenantID, startTime, endTime})
+	log.Printf("Params: %v", params)
 
-	rows, err := r.store.GetConn().Query(ctx, query, subscriptionID, externalCustomerID, environmentID, tenantID, startTime, endTime)
+	rows, err := r.store.GetConn().Query(ctx, query, params...)
 	if err != nil {
 		SetSpanError(span, err)
 		return nil, ierr.WithError(err).
 			WithHint("Failed to execute optimized subscription usage query").
 			WithReportableDetails(map[string]interface{}{
-				"subscription_id":      subscriptionID,
-				"external_customer_id": externalCustomerID,
+				"subscription_id":       subscriptionID,
+				"external_customer_ids": externalCustomerIDs,
 			}).
 			Mark(ierr.ErrDatabase)
 	}
@@ -1384,10 +1426,10 @@ func (r *FeatureUsageRepository) GetFeatureUsageBySubscription(ctx context.Conte
 	results := make(map[string]*events.UsageByFeatureResult)
 	for rows.Next() {
 		var subLineItemID, featureID, meterID string
-		var sumTotal, maxTotal, latestQty decimal.Decimal
+		var sumTotal, maxTotal, minTotal, latestQty, firstQty decimal.Decimal
 		var countDistinctIDs, countUniqueQty uint64
 
-		err := rows.Scan(&subLineItemID, &featureID, &meterID, &sumTotal, &maxTotal, &countDistinctIDs, &countUniqueQty, &latestQty)
+		err := rows.Scan(&subLineItemID, &featureID, &meterID, &sumTotal, &maxTotal, &minTotal, &countDistinctIDs, &countUniqueQty, &latestQty, &firstQty)
 		if err != nil {
 			SetSpanError(span, err)
 			return nil, ierr.WithError(err).
@@ -1401,9 +1443,11 @@ func (r *FeatureUsageRepository) GetFeatureUsageBySubscription(ctx context.Conte
 			MeterID:          meterID,
 			SumTotal:         sumTotal,
 			MaxTotal:         maxTotal,
+			MinTotal:         minTotal,
 			CountDistinctIDs: countDistinctIDs,
 			CountUniqueQty:   countUniqueQty,
 			LatestQty:        latestQty,
+			FirstQty:         firstQty,
 		}
 	}
 
@@ -1422,6 +1466,228 @@ func (r *FeatureUsageRepository) GetFeatureUsageBySubscription(ctx context.Conte
 	return results, nil
 }
 
+// GetFeatureUsageByDimensions gets the usage of a subscription line item grouped by the values of the
+// given property dimensions, used to break down the usage of matrix prices per cell. The percentile
+// is only used by PERCENTILE meters and may be nil otherwise.
+func (r *FeatureUsageRepository) GetFeatureUsageByDimensions(ctx context.Context, subscriptionID, subLineItemID string, externalCustomerIDs []string, dimensions []string, percentile *decimal.Decimal, startTime, endTime time.Time) ([]*events.UsageByFeatureResult, error) {
+	tenantID := types.GetTenantID(ctx)
+	environmentID := types.GetEnvironmentID(ctx)
+
+	span := StartRepositorySpan(ctx, "feature_usage", "get_usage_by_dimensions", map[string]interface{}{
+		"subscription_id":       subscriptionID,
+		"sub_line_item_id":      subLineItemID,
+		"external_customer_ids": externalCustomerIDs,
+		"dimensions":            dimensions,
+		"start_time":            startTime,
+		"end_time":              endTime,
+	})
+	defer FinishSpan(span)
+
+	if len(dimensions) == 0 {
+		return nil, ierr.NewError("dimensions are required").
+			WithHint("At least one dimension is required to group the usage").
+			Mark(ierr.ErrValidation)
+	}
+
+	dimensionColumns := make([]string, len(dimensions))
+	dimensionAliases := make([]string, len(dimensions))
+	for i, dimension := range dimensions {
+		dimensionAliases[i] = fmt.Sprintf("dim_%d", i)
+		dimensionColumns[i] = fmt.Sprintf("%s AS %s", builder.PropertyStringSQL(dimension), dimensionAliases[i])
+	}
+
+	query := fmt.Sprintf(`
+		SELECT
+			%s,
+			feature_id,
+			meter_id,
+			sum(qty_total)                     AS sum_total,
+			max(qty_total)                     AS max_total,
+			min(qty_total)                     AS min_total,
+			count(DISTINCT id)                 AS count_distinct_ids,
+			count(DISTINCT unique_hash)        AS count_unique_qty,
+			argMax(qty_total, "timestamp")     AS latest_qty,
+			argMin(qty_total, "timestamp")     AS first_qty,
+			quantileExact(%f)(qty_total)       AS percentile_qty
+		FROM feature_usage
+		WHERE
+			subscription_id = ?
+			AND sub_line_item_id = ?
+			AND external_customer_id IN (%s)
+			AND environment_id = ?
+			AND tenant_id = ?
+			AND "timestamp" >= ?
+			AND "timestamp" < ?
+			AND %s
+		GROUP BY %s, feature_id, meter_id
+	`, strings.Join(dimensionColumns, ",\n\t\t\t"), formatPercentileLevel(percentile), inPlaceholders(len(externalCustomerIDs)), builder.CorrectedEventsConditionSQL(tenantID, environmentID), strings.Join(dimensionAliases, ", "))
+
+	params := []interface{}{subscriptionID, subLineItemID}
+	params = append(params, lo.ToAnySlice(externalCustomerIDs)...)
+	params = append(params, environmentID, tenantID, startTime, endTime)
+
+	rows, err := r.store.GetConn().Query(ctx, query, params...)
+	if err != nil {
+		SetSpanError(span, err)
+		return nil, ierr.WithError(err).
+			WithHint("Failed to execute usage by dimensions query").
+			WithReportableDetails(map[string]interface{}{
+				"subscription_id":  subscriptionID,
+				"sub_line_item_id": subLineItemID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+	defer rows.Close()
+
+	var results []*events.UsageByFeatureResult
+	for rows.Next() {
+		dimensionValues := make([]string, len(dimensions))
+		result := &events.UsageByFeatureResult{
+			SubLineItemID:   subLineItemID,
+			DimensionValues: make(map[string]string, len(dimensions)),
+		}
+
+		dest := make([]interface{}, 0, len(dimensions)+10)
+		for i := range dimensionValues {
+			dest = append(dest, &dimensionValues[i])
+		}
+		dest = append(dest,
+			&result.FeatureID,
+			&result.MeterID,
+			&result.SumTotal,
+			&result.MaxTotal,
+			&result.MinTotal,
+			&result.CountDistinctIDs,
+			&result.CountUniqueQty,
+			&result.LatestQty,
+			&result.FirstQty,
+			&result.PercentileQty,
+		)
+
+		if err := rows.Scan(dest...); err != nil {
+			SetSpanError(span, err)
+			return nil, ierr.WithError(err).
+				WithHint("Failed to scan usage by dimensions result").
+				Mark(ierr.ErrDatabase)
+		}
+
+		for i, dimension := range dimensions {
+			result.DimensionValues[dimension] = dimensionValues[i]
+		}
+		results = append(results, result)
+	}
+
+	if err := rows.Err(); err != nil {
+		SetSpanError(span, err)
+		return nil, ierr.WithError(err).
+			WithHint("Error iterating usage by dimensions results").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return results, nil
+}
+
+// inPlaceholders returns a comma separated list of n query placeholders for an IN clause
+func inPlaceholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
+
+// GetFeatureUsagePercentiles gets the percentile usage of the line items of a subscription that are billed by
+// PERCENTILE meters. percentiles maps the meter ID to the percentile of the meter, the result is keyed by sub line item ID
+func (r *FeatureUsageRepository) GetFeatureUsagePercentiles(ctx context.Context, subscriptionID string, externalCustomerIDs []string, percentiles map[string]decimal.Decimal, startTime, endTime time.Time) (map[string]decimal.Decimal, error) {
+	results := make(map[string]decimal.Decimal)
+	if len(percentiles) == 0 {
+		return results, nil
+	}
+
+	tenantID := types.GetTenantID(ctx)
+	environmentID := types.GetEnvironmentID(ctx)
+
+	span := StartRepositorySpan(ctx, "feature_usage", "get_usage_percentiles", map[string]interface{}{
+		"subscription_id":       subscriptionID,
+		"external_customer_ids": externalCustomerIDs,
+		"meter_count":           len(percentiles),
+		"start_time":            startTime,
+		"end_time":              endTime,
+	})
+	defer FinishSpan(span)
+
+	// All requested levels are computed in one pass, each meter then picks the level of its own percentile
+	levels := make([]string, 0, len(percentiles))
+	levelIndex := make(map[string]int, len(percentiles))
+	meterPlaceholders := make([]string, 0, len(percentiles))
+	params := []interface{}{subscriptionID}
+	params = append(params, lo.ToAnySlice(externalCustomerIDs)...)
+	params = append(params, environmentID, tenantID, startTime, endTime)
+	for meterID, percentile := range percentiles {
+		level := fmt.Sprintf("%f", formatPercentileLevel(&percentile))
+		if _, ok := levelIndex[level]; !ok {
+			levelIndex[level] = len(levels)
+			levels = append(levels, level)
+		}
+		meterPlaceholders = append(meterPlaceholders, "?")
+		params = append(params, meterID)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT
+			sub_line_item_id,
+			meter_id,
+			quantilesExact(%s)(qty_total) AS percentile_qtys
+		FROM feature_usage
+		WHERE
+			subscription_id = ?
+			AND external_customer_id IN (%s)
+			AND environment_id = ?
+			AND tenant_id = ?
+			AND "timestamp" >= ?
+			AND "timestamp" < ?
+			AND meter_id IN (%s)
+			AND %s
+		GROUP BY sub_line_item_id, meter_id
+	`, strings.Join(levels, ", "), inPlaceholders(len(externalCustomerIDs)), strings.Join(meterPlaceholders, ", "), builder.CorrectedEventsConditionSQL(tenantID, environmentID))
+
+	rows, err := r.store.GetConn().Query(ctx, query, params...)
+	if err != nil {
+		SetSpanError(span, err)
+		return nil, ierr.WithError(err).
+			WithHint("Failed to execute usage percentiles query").
+			WithReportableDetails(map[string]interface{}{
+				"subscription_id": subscriptionID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var subLineItemID, meterID string
+		var percentileQtys []decimal.Decimal
+		if err := rows.Scan(&subLineItemID, &meterID, &percentileQtys); err != nil {
+			SetSpanError(span, err)
+			return nil, ierr.WithError(err).
+				WithHint("Failed to scan usage percentiles result").
+				Mark(ierr.ErrDatabase)
+		}
+
+		percentile := percentiles[meterID]
+		index := levelIndex[fmt.Sprintf("%f", formatPercentileLevel(&percentile))]
+		if index < len(percentileQtys) {
+			results[subLineItemID] = percentileQtys[index]
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		SetSpanError(span, err)
+		return nil, ierr.WithError(err).
+			WithHint("Error iterating usage percentiles results").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return results, nil
+}
+
 // GetFeatureUsageForExport retrieves feature usage data for export in batches
 func (r *FeatureUsageRepository) GetFeatureUsageForExport(ctx context.Context, startTime, endTime time.Time, batchSize int, offset int) ([]*events.FeatureUsage, error) {
 	// Extract tenantID and environmentID from context
@@ -1545,3 +1811,59 @@ func (r *FeatureUsageRepository) GetFeatureUsageForExport(ctx context.Context, s
 
 	return results, nil
 }
+
+// GetTrackedEventIDs returns the IDs among eventIDs which already have feature usage
+func (r *FeatureUsageRepository) GetTrackedEventIDs(ctx context.Context, eventIDs []string, startTime, endTime time.Time) ([]string, error) {
+	if len(eventIDs) == 0 {
+		return nil, nil
+	}
+
+	span := StartRepositorySpan(ctx, "feature_usage", "get_tracked_event_ids", map[string]interface{}{
+		"event_count": len(eventIDs),
+		"start_time":  startTime,
+		"end_time":    endTime,
+	})
+	defer FinishSpan(span)
+
+	query := `
+		SELECT DISTINCT id
+		FROM feature_usage
+		WHERE tenant_id = ?
+		  AND environment_id = ?
+		  AND timestamp >= ?
+		  AND timestamp <= ?
+		  AND id IN ?
+		  AND sign = 1
+	`
+
+	rows, err := r.store.GetConn().Query(ctx, query, types.GetTenantID(ctx), types.GetEnvironmentID(ctx), startTime, endTime, eventIDs)
+	if err != nil {
+		SetSpanError(span, err)
+		return nil, ierr.WithError(err).
+			WithHint("Failed to query tracked events").
+			Mark(ierr.ErrDatabase)
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			SetSpanError(span, err)
+			return nil, ierr.WithError(err).
+				WithHint("Failed to scan tracked event id").
+				Mark(ierr.ErrDatabase)
+		}
+		ids = append(ids, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		SetSpanError(span, err)
+		return nil, ierr.WithError(err).
+			WithHint("Error iterating tracked event ids").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return ids, nil
+}
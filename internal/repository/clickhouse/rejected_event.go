@@ -0,0 +1,201 @@
+package clickhouse
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/flexprice/flexprice/internal/clickhouse"
+	"github.com/flexprice/flexprice/internal/domain/events"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+type RejectedEventRepository struct {
+	store  *clickhouse.ClickHouseStore
+	logger *logger.Logger
+}
+
+func NewRejectedEventRepository(store *clickhouse.ClickHouseStore, logger *logger.Logger) events.RejectedEventRepository {
+	return &RejectedEventRepository{store: store, logger: logger}
+}
+
+func (r *RejectedEventRepository) BulkInsertRejectedEvents(ctx context.Context, rejectedEvents []*events.RejectedEvent) error {
+	if len(rejectedEvents) == 0 {
+		return nil
+	}
+
+	span := StartRepositorySpan(ctx, "rejected_event", "bulk_insert", map[string]interface{}{
+		"event_count": len(rejectedEvents),
+	})
+	defer FinishSpan(span)
+
+	batch, err := r.store.GetConn().PrepareBatch(ctx, `
+		INSERT INTO rejected_events (
+			id, tenant_id, environment_id, external_customer_id, customer_id, event_name, source,
+			timestamp, properties, schema_id, action, violations, rejected_at
+		)
+	`)
+	if err != nil {
+		SetSpanError(span, err)
+		return ierr.WithError(err).
+			WithHint("Failed to prepare batch for rejected events").
+			Mark(ierr.ErrDatabase)
+	}
+
+	for _, event := range rejectedEvents {
+		propertiesJSON, err := json.Marshal(event.Properties)
+		if err != nil {
+			SetSpanError(span, err)
+			return ierr.WithError(err).
+				WithHint("Failed to marshal event properties").
+				WithReportableDetails(map[string]interface{}{
+					"event_id": event.ID,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+
+		violationsJSON, err := json.Marshal(event.Violations)
+		if err != nil {
+			SetSpanError(span, err)
+			return ierr.WithError(err).
+				WithHint("Failed to marshal schema violations").
+				WithReportableDetails(map[string]interface{}{
+					"event_id": event.ID,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+
+		if err := batch.Append(
+			event.ID,
+			event.TenantID,
+			event.EnvironmentID,
+			event.ExternalCustomerID,
+			event.CustomerID,
+			event.EventName,
+			event.Source,
+			event.Timestamp,
+			string(propertiesJSON),
+			event.SchemaID,
+			string(event.Action),
+			string(violationsJSON),
+			event.RejectedAt,
+		); err != nil {
+			SetSpanError(span, err)
+			return ierr.WithError(err).
+				WithHint("Failed to append rejected event to batch").
+				WithReportableDetails(map[string]interface{}{
+					"event_id": event.ID,
+				}).
+				Mark(ierr.ErrDatabase)
+		}
+	}
+
+	if err := batch.Send(); err != nil {
+		SetSpanError(span, err)
+		return ierr.WithError(err).
+			WithHint("Failed to insert rejected events").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return nil
+}
+
+func (r *RejectedEventRepository) GetRejectedEvents(ctx context.Context, params *events.GetRejectedEventsParams) ([]*events.RejectedEvent, uint64, error) {
+	span := StartRepositorySpan(ctx, "rejected_event", "get_rejected_events", map[string]interface{}{
+		"event_name": params.EventName,
+		"page_size":  params.PageSize,
+	})
+	defer FinishSpan(span)
+
+	whereClause := " WHERE tenant_id = ? AND environment_id = ?"
+	args := []interface{}{types.GetTenantID(ctx), types.GetEnvironmentID(ctx)}
+
+	if params.EventName != "" {
+		whereClause += " AND event_name = ?"
+		args = append(args, params.EventName)
+	}
+	if params.ExternalCustomerID != "" {
+		whereClause += " AND external_customer_id = ?"
+		args = append(args, params.ExternalCustomerID)
+	}
+	if params.Action != "" {
+		whereClause += " AND action = ?"
+		args = append(args, string(params.Action))
+	}
+	if !params.StartTime.IsZero() {
+		whereClause += " AND rejected_at >= ?"
+		args = append(args, params.StartTime)
+	}
+	if !params.EndTime.IsZero() {
+		whereClause += " AND rejected_at <= ?"
+		args = append(args, params.EndTime)
+	}
+
+	var totalCount uint64
+	if err := r.store.GetConn().QueryRow(ctx, "SELECT COUNT(*) FROM rejected_events"+whereClause, args...).Scan(&totalCount); err != nil {
+		SetSpanError(span, err)
+		return nil, 0, ierr.WithError(err).
+			WithHint("Failed to count rejected events").
+			Mark(ierr.ErrDatabase)
+	}
+
+	query := `
+		SELECT
+			id, tenant_id, environment_id, external_customer_id, customer_id, event_name, source,
+			timestamp, properties, schema_id, action, violations, rejected_at
+		FROM rejected_events` + whereClause + `
+		ORDER BY rejected_at DESC, id DESC
+		LIMIT ? OFFSET ?`
+	args = append(args, params.PageSize, params.Offset)
+
+	rows, err := r.store.GetConn().Query(ctx, query, args...)
+	if err != nil {
+		SetSpanError(span, err)
+		return nil, 0, ierr.WithError(err).
+			WithHint("Failed to query rejected events").
+			Mark(ierr.ErrDatabase)
+	}
+	defer rows.Close()
+
+	result := make([]*events.RejectedEvent, 0)
+	for rows.Next() {
+		var event events.RejectedEvent
+		var propertiesJSON, violationsJSON, action string
+
+		if err := rows.Scan(
+			&event.ID,
+			&event.TenantID,
+			&event.EnvironmentID,
+			&event.ExternalCustomerID,
+			&event.CustomerID,
+			&event.EventName,
+			&event.Source,
+			&event.Timestamp,
+			&propertiesJSON,
+			&event.SchemaID,
+			&action,
+			&violationsJSON,
+			&event.RejectedAt,
+		); err != nil {
+			SetSpanError(span, err)
+			return nil, 0, ierr.WithError(err).
+				WithHint("Failed to scan rejected event").
+				Mark(ierr.ErrDatabase)
+		}
+
+		event.Action = types.RejectedEventAction(action)
+		if err := json.Unmarshal([]byte(propertiesJSON), &event.Properties); err != nil {
+			r.logger.Warnw("failed to unmarshal rejected event properties", "event_id", event.ID, "error", err)
+		}
+		if err := json.Unmarshal([]byte(violationsJSON), &event.Violations); err != nil {
+			r.logger.Warnw("failed to unmarshal rejected event violations", "event_id", event.ID, "error", err)
+		}
+
+		result = append(result, &event)
+	}
+
+	SetSpanSuccess(span)
+	return result, totalCount, nil
+}
@@ -243,6 +243,14 @@ func convertConnectionMetadataToMap(encryptedSecretData types.ConnectionMetadata
 			}
 			return result
 		}
+	case types.SecretProviderRazorpay:
+		if encryptedSecretData.Razorpay != nil {
+			return map[string]interface{}{
+				"key_id":         encryptedSecretData.Razorpay.KeyID,
+				"key_secret":     encryptedSecretData.Razorpay.KeySecret,
+				"webhook_secret": encryptedSecretData.Razorpay.WebhookSecret,
+			}
+		}
 	default:
 		// For other providers or unknown types, use generic format
 		if encryptedSecretData.Generic != nil {
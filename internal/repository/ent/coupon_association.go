@@ -260,6 +260,52 @@ func (r *couponAssociationRepository) GetBySubscription(ctx context.Context, sub
 	return domainAssociations, nil
 }
 
+// GetBySubscriptionsAndCoupon retrieves the associations of a coupon, at both the subscription and the
+// line item level, with any of the given subscriptions
+func (r *couponAssociationRepository) GetBySubscriptionsAndCoupon(ctx context.Context, subscriptionIDs []string, couponID string) ([]*domainCouponAssociation.CouponAssociation, error) {
+	if len(subscriptionIDs) == 0 {
+		return []*domainCouponAssociation.CouponAssociation{}, nil
+	}
+
+	client := r.client.Reader(ctx)
+
+	r.log.Debugw("getting coupon associations by subscriptions and coupon",
+		"subscription_count", len(subscriptionIDs),
+		"coupon_id", couponID)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "coupon_association", "get_by_subscriptions_and_coupon", map[string]interface{}{
+		"subscription_count": len(subscriptionIDs),
+		"coupon_id":          couponID,
+	})
+	defer FinishSpan(span)
+
+	associations, err := client.CouponAssociation.Query().
+		Where(
+			couponassociation.SubscriptionIDIn(subscriptionIDs...),
+			couponassociation.CouponID(couponID),
+			couponassociation.TenantID(types.GetTenantID(ctx)),
+			couponassociation.EnvironmentID(types.GetEnvironmentID(ctx)),
+		).
+		All(ctx)
+
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get coupon associations from database").
+			WithReportableDetails(map[string]interface{}{
+				"coupon_id": couponID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	domainAssociations := make([]*domainCouponAssociation.CouponAssociation, len(associations))
+	for i, ca := range associations {
+		domainAssociations[i] = r.toDomainCouponAssociation(ca)
+	}
+
+	return domainAssociations, nil
+}
+
 func (r *couponAssociationRepository) GetBySubscriptionForLineItems(ctx context.Context, subscriptionID string) ([]*domainCouponAssociation.CouponAssociation, error) {
 	client := r.client.Reader(ctx)
 
@@ -60,6 +60,7 @@ func (r *creditnoteRepository) Create(ctx context.Context, cn *domainCreditNote.
 		SetCreditNoteStatus(cn.CreditNoteStatus).
 		SetCreditNoteType(cn.CreditNoteType).
 		SetNillableRefundStatus(cn.RefundStatus).
+		SetRefundMethod(cn.RefundMethod).
 		SetReason(cn.Reason).
 		SetMemo(cn.Memo).
 		SetCurrency(cn.Currency).
@@ -143,6 +144,7 @@ func (r *creditnoteRepository) CreateWithLineItems(ctx context.Context, cn *doma
 			SetCreditNoteStatus(cn.CreditNoteStatus).
 			SetCreditNoteType(cn.CreditNoteType).
 			SetNillableRefundStatus(cn.RefundStatus).
+			SetRefundMethod(cn.RefundMethod).
 			SetReason(cn.Reason).
 			SetMemo(cn.Memo).
 			SetCurrency(cn.Currency).
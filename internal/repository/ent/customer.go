@@ -68,6 +68,7 @@ func (r *customerRepository) Create(ctx context.Context, c *domainCustomer.Custo
 		SetAddressState(c.AddressState).
 		SetAddressPostalCode(c.AddressPostalCode).
 		SetAddressCountry(c.AddressCountry).
+		SetNillableParentCustomerID(c.ParentCustomerID).
 		SetMetadata(c.Metadata).
 		SetStatus(string(c.Status)).
 		SetCreatedAt(c.CreatedAt).
@@ -318,7 +319,7 @@ func (r *customerRepository) Update(ctx context.Context, c *domainCustomer.Custo
 	})
 	defer FinishSpan(span)
 
-	_, err := client.Customer.Update().
+	update := client.Customer.Update().
 		Where(
 			customer.ID(c.ID),
 			customer.TenantID(c.TenantID),
@@ -335,8 +336,15 @@ func (r *customerRepository) Update(ctx context.Context, c *domainCustomer.Custo
 		SetAddressCountry(c.AddressCountry).
 		SetMetadata(c.Metadata).
 		SetUpdatedAt(time.Now().UTC()).
-		SetUpdatedBy(types.GetUserID(ctx)).
-		Save(ctx)
+		SetUpdatedBy(types.GetUserID(ctx))
+
+	if c.ParentCustomerID != nil {
+		update.SetParentCustomerID(*c.ParentCustomerID)
+	} else {
+		update.ClearParentCustomerID()
+	}
+
+	_, err := update.Save(ctx)
 
 	if err != nil {
 		SetSpanError(span, err)
@@ -508,6 +516,10 @@ func (o CustomerQueryOptions) applyEntityQueryOptions(_ context.Context, f *type
 		query = query.Where(customer.IDIn(f.CustomerIDs...))
 	}
 
+	if len(f.ParentCustomerIDs) > 0 {
+		query = query.Where(customer.ParentCustomerIDIn(f.ParentCustomerIDs...))
+	}
+
 	if len(f.ExternalIDs) > 0 {
 		query = query.Where(customer.ExternalIDIn(f.ExternalIDs...))
 	}
@@ -0,0 +1,138 @@
+package ent
+
+import (
+	"context"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/domain/entitlement"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/postgres"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
+)
+
+type entitlementUsageCounterRepository struct {
+	client postgres.IClient
+	log    *logger.Logger
+}
+
+func NewEntitlementUsageCounterRepository(client postgres.IClient, log *logger.Logger) entitlement.UsageCounterRepository {
+	return &entitlementUsageCounterRepository{
+		client: client,
+		log:    log,
+	}
+}
+
+func (r *entitlementUsageCounterRepository) GetForUpdate(ctx context.Context, customerID, featureID string, periodStart time.Time) (*entitlement.UsageCounter, error) {
+	span := StartRepositorySpan(ctx, "entitlement_usage_counter", "get_for_update", map[string]interface{}{
+		"customer_id": customerID,
+		"feature_id":  featureID,
+	})
+	defer FinishSpan(span)
+
+	client := r.client.Writer(ctx)
+	tenantID, environmentID := types.GetTenantID(ctx), types.GetEnvironmentID(ctx)
+
+	// the row is created first so that there is always a row to lock, even for the first check
+	insert := `
+		INSERT INTO entitlement_usage_counters (tenant_id, environment_id, customer_id, feature_id, period_start, baseline, reserved, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, 0, 0, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+		ON CONFLICT (tenant_id, environment_id, customer_id, feature_id, period_start) DO NOTHING`
+	if _, err := client.ExecContext(ctx, insert, tenantID, environmentID, customerID, featureID, periodStart); err != nil {
+		SetSpanError(span, err)
+		return nil, ierr.WithError(err).WithHint("Failed to get entitlement usage counter").Mark(ierr.ErrDatabase)
+	}
+
+	query := `
+		SELECT baseline, reserved
+		FROM entitlement_usage_counters
+		WHERE tenant_id = $1 AND environment_id = $2 AND customer_id = $3 AND feature_id = $4 AND period_start = $5
+		FOR UPDATE`
+	rows, err := client.QueryContext(ctx, query, tenantID, environmentID, customerID, featureID, periodStart)
+	if err != nil {
+		SetSpanError(span, err)
+		return nil, ierr.WithError(err).WithHint("Failed to get entitlement usage counter").Mark(ierr.ErrDatabase)
+	}
+	defer rows.Close()
+
+	counter := &entitlement.UsageCounter{
+		CustomerID:  customerID,
+		FeatureID:   featureID,
+		PeriodStart: periodStart,
+		Baseline:    decimal.Zero,
+		Reserved:    decimal.Zero,
+	}
+	if rows.Next() {
+		if err := rows.Scan(&counter.Baseline, &counter.Reserved); err != nil {
+			SetSpanError(span, err)
+			return nil, ierr.WithError(err).WithHint("Failed to get entitlement usage counter").Mark(ierr.ErrDatabase)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		SetSpanError(span, err)
+		return nil, ierr.WithError(err).WithHint("Failed to get entitlement usage counter").Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return counter, nil
+}
+
+func (r *entitlementUsageCounterRepository) Save(ctx context.Context, counter *entitlement.UsageCounter) error {
+	span := StartRepositorySpan(ctx, "entitlement_usage_counter", "save", map[string]interface{}{
+		"customer_id": counter.CustomerID,
+		"feature_id":  counter.FeatureID,
+	})
+	defer FinishSpan(span)
+
+	query := `
+		INSERT INTO entitlement_usage_counters (tenant_id, environment_id, customer_id, feature_id, period_start, baseline, reserved, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+		ON CONFLICT (tenant_id, environment_id, customer_id, feature_id, period_start) DO UPDATE
+		SET baseline = EXCLUDED.baseline,
+			reserved = EXCLUDED.reserved,
+			updated_at = CURRENT_TIMESTAMP`
+	if _, err := r.client.Writer(ctx).ExecContext(ctx, query,
+		types.GetTenantID(ctx), types.GetEnvironmentID(ctx), counter.CustomerID, counter.FeatureID, counter.PeriodStart,
+		counter.Baseline, counter.Reserved); err != nil {
+		SetSpanError(span, err)
+		return ierr.WithError(err).WithHint("Failed to save entitlement usage counter").Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return nil
+}
+
+func (r *entitlementUsageCounterRepository) MarkLimitReached(ctx context.Context, customerID, featureID string, periodStart time.Time) (bool, error) {
+	span := StartRepositorySpan(ctx, "entitlement_usage_counter", "mark_limit_reached", map[string]interface{}{
+		"customer_id": customerID,
+		"feature_id":  featureID,
+	})
+	defer FinishSpan(span)
+
+	// only the statement setting limit_reached_at returns a row, so a single caller wins the race
+	query := `
+		INSERT INTO entitlement_usage_counters AS c (tenant_id, environment_id, customer_id, feature_id, period_start, baseline, reserved, limit_reached_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, 0, 0, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+		ON CONFLICT (tenant_id, environment_id, customer_id, feature_id, period_start) DO UPDATE
+		SET limit_reached_at = CURRENT_TIMESTAMP,
+			updated_at = CURRENT_TIMESTAMP
+		WHERE c.limit_reached_at IS NULL
+		RETURNING c.id`
+	rows, err := r.client.Writer(ctx).QueryContext(ctx, query,
+		types.GetTenantID(ctx), types.GetEnvironmentID(ctx), customerID, featureID, periodStart)
+	if err != nil {
+		SetSpanError(span, err)
+		return false, ierr.WithError(err).WithHint("Failed to mark entitlement limit reached").Mark(ierr.ErrDatabase)
+	}
+	defer rows.Close()
+
+	marked := rows.Next()
+	if err := rows.Err(); err != nil {
+		SetSpanError(span, err)
+		return false, ierr.WithError(err).WithHint("Failed to mark entitlement limit reached").Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return marked, nil
+}
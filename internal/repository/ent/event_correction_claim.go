@@ -0,0 +1,90 @@
+package ent
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/ent/eventcorrectionclaim"
+	"github.com/flexprice/flexprice/internal/domain/events"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/postgres"
+)
+
+type eventCorrectionClaimRepository struct {
+	client postgres.IClient
+	log    *logger.Logger
+}
+
+func NewEventCorrectionClaimRepository(client postgres.IClient, log *logger.Logger) events.EventCorrectionClaimRepository {
+	return &eventCorrectionClaimRepository{
+		client: client,
+		log:    log,
+	}
+}
+
+func (r *eventCorrectionClaimRepository) ClaimEvent(ctx context.Context, correction *events.EventCorrection) error {
+	client := r.client.Writer(ctx)
+
+	span := StartRepositorySpan(ctx, "event_correction_claim", "claim", map[string]interface{}{
+		"correction_id": correction.ID,
+		"event_id":      correction.EventID,
+	})
+	defer FinishSpan(span)
+
+	_, err := client.EventCorrectionClaim.Create().
+		SetID(correction.ID).
+		SetTenantID(correction.TenantID).
+		SetEnvironmentID(correction.EnvironmentID).
+		SetEventID(correction.EventID).
+		SetAction(string(correction.Action)).
+		SetCreatedAt(correction.CreatedAt).
+		SetCreatedBy(correction.CreatedBy).
+		Save(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+		if ent.IsConstraintError(err) {
+			return ierr.WithError(err).
+				WithHintf("Event %s is already being corrected", correction.EventID).
+				WithReportableDetails(map[string]interface{}{
+					"event_id": correction.EventID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to claim event for correction").
+			WithReportableDetails(map[string]interface{}{
+				"event_id": correction.EventID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return nil
+}
+
+func (r *eventCorrectionClaimRepository) ReleaseEvent(ctx context.Context, correction *events.EventCorrection) error {
+	client := r.client.Writer(ctx)
+
+	span := StartRepositorySpan(ctx, "event_correction_claim", "release", map[string]interface{}{
+		"correction_id": correction.ID,
+		"event_id":      correction.EventID,
+	})
+	defer FinishSpan(span)
+
+	_, err := client.EventCorrectionClaim.Delete().
+		Where(eventcorrectionclaim.ID(correction.ID)).
+		Exec(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+		return ierr.WithError(err).
+			WithHint("Failed to release event claimed for correction").
+			WithReportableDetails(map[string]interface{}{
+				"event_id": correction.EventID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return nil
+}
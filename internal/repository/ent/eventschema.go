@@ -0,0 +1,371 @@
+package ent
+
+import (
+	"context"
+	"errors"
+
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/ent/eventschema"
+	"github.com/flexprice/flexprice/ent/schema"
+	"github.com/flexprice/flexprice/internal/cache"
+	domainEventSchema "github.com/flexprice/flexprice/internal/domain/eventschema"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/postgres"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/lib/pq"
+)
+
+type eventSchemaRepository struct {
+	client    postgres.IClient
+	log       *logger.Logger
+	cache     cache.Cache
+	queryOpts EventSchemaQueryOptions
+}
+
+func NewEventSchemaRepository(client postgres.IClient, log *logger.Logger, cache cache.Cache) domainEventSchema.Repository {
+	return &eventSchemaRepository{
+		client:    client,
+		log:       log,
+		cache:     cache,
+		queryOpts: EventSchemaQueryOptions{},
+	}
+}
+
+func (r *eventSchemaRepository) Create(ctx context.Context, s *domainEventSchema.EventSchema) error {
+	client := r.client.Writer(ctx)
+
+	span := StartRepositorySpan(ctx, "event_schema", "create", map[string]interface{}{
+		"event_schema_id": s.ID,
+		"event_name":      s.EventName,
+	})
+	defer FinishSpan(span)
+
+	_, err := client.EventSchema.Create().
+		SetID(s.ID).
+		SetEventName(s.EventName).
+		SetDescription(s.Description).
+		SetValidationMode(string(s.ValidationMode)).
+		SetProperties(s.Properties).
+		SetTenantID(s.TenantID).
+		SetEnvironmentID(s.EnvironmentID).
+		SetStatus(string(s.Status)).
+		SetCreatedAt(s.CreatedAt).
+		SetUpdatedAt(s.UpdatedAt).
+		SetCreatedBy(types.GetUserID(ctx)).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		SetSpanError(span, err)
+		if ent.IsConstraintError(err) {
+			var pqErr *pq.Error
+			if errors.As(err, &pqErr) && pqErr.Constraint == schema.Idx_event_schema_tenant_environment_event_name {
+				return ierr.WithError(err).
+					WithHint("An event schema already exists for this event name").
+					WithReportableDetails(map[string]any{
+						"event_name": s.EventName,
+					}).
+					Mark(ierr.ErrAlreadyExists)
+			}
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create event schema").
+			Mark(ierr.ErrDatabase)
+	}
+
+	r.DeleteCache(ctx)
+	SetSpanSuccess(span)
+	return nil
+}
+
+func (r *eventSchemaRepository) Get(ctx context.Context, id string) (*domainEventSchema.EventSchema, error) {
+	client := r.client.Reader(ctx)
+
+	span := StartRepositorySpan(ctx, "event_schema", "get", map[string]interface{}{
+		"event_schema_id": id,
+	})
+	defer FinishSpan(span)
+
+	s, err := client.EventSchema.Query().
+		Where(
+			eventschema.ID(id),
+			eventschema.TenantID(types.GetTenantID(ctx)),
+			eventschema.EnvironmentID(types.GetEnvironmentID(ctx)),
+			eventschema.StatusEQ(string(types.StatusPublished)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		SetSpanError(span, err)
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Event schema with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"event_schema_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get event schema").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return domainEventSchema.FromEnt(s), nil
+}
+
+// GetByEventName looks up the schema in the cached schemas of the environment so that event ingestion
+// does not query the database for every event
+func (r *eventSchemaRepository) GetByEventName(ctx context.Context, eventName string) (*domainEventSchema.EventSchema, error) {
+	schemas := r.GetCache(ctx)
+	if schemas == nil {
+		span := StartRepositorySpan(ctx, "event_schema", "get_by_event_name", map[string]interface{}{
+			"event_name": eventName,
+		})
+		defer FinishSpan(span)
+
+		list, err := r.client.Reader(ctx).EventSchema.Query().
+			Where(
+				eventschema.TenantID(types.GetTenantID(ctx)),
+				eventschema.EnvironmentID(types.GetEnvironmentID(ctx)),
+				eventschema.StatusEQ(string(types.StatusPublished)),
+			).
+			All(ctx)
+		if err != nil {
+			SetSpanError(span, err)
+			return nil, ierr.WithError(err).
+				WithHint("Failed to get event schemas").
+				Mark(ierr.ErrDatabase)
+		}
+
+		schemas = make(map[string]*domainEventSchema.EventSchema, len(list))
+		for _, s := range domainEventSchema.FromEntList(list) {
+			schemas[s.EventName] = s
+		}
+		r.SetCache(ctx, schemas)
+		SetSpanSuccess(span)
+	}
+
+	return schemas[eventName], nil
+}
+
+func (r *eventSchemaRepository) Update(ctx context.Context, s *domainEventSchema.EventSchema) error {
+	client := r.client.Writer(ctx)
+
+	span := StartRepositorySpan(ctx, "event_schema", "update", map[string]interface{}{
+		"event_schema_id": s.ID,
+	})
+	defer FinishSpan(span)
+
+	_, err := client.EventSchema.Update().
+		Where(
+			eventschema.ID(s.ID),
+			eventschema.TenantID(types.GetTenantID(ctx)),
+			eventschema.EnvironmentID(types.GetEnvironmentID(ctx)),
+		).
+		SetDescription(s.Description).
+		SetValidationMode(string(s.ValidationMode)).
+		SetProperties(s.Properties).
+		SetUpdatedAt(s.UpdatedAt).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		SetSpanError(span, err)
+		return ierr.WithError(err).
+			WithHint("Failed to update event schema").
+			Mark(ierr.ErrDatabase)
+	}
+
+	r.DeleteCache(ctx)
+	SetSpanSuccess(span)
+	return nil
+}
+
+func (r *eventSchemaRepository) Delete(ctx context.Context, id string) error {
+	client := r.client.Writer(ctx)
+
+	span := StartRepositorySpan(ctx, "event_schema", "delete", map[string]interface{}{
+		"event_schema_id": id,
+	})
+	defer FinishSpan(span)
+
+	_, err := client.EventSchema.Update().
+		Where(
+			eventschema.ID(id),
+			eventschema.TenantID(types.GetTenantID(ctx)),
+			eventschema.EnvironmentID(types.GetEnvironmentID(ctx)),
+		).
+		SetStatus(string(types.StatusArchived)).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		SetSpanError(span, err)
+		return ierr.WithError(err).
+			WithHint("Failed to delete event schema").
+			Mark(ierr.ErrDatabase)
+	}
+
+	r.DeleteCache(ctx)
+	SetSpanSuccess(span)
+	return nil
+}
+
+func (r *eventSchemaRepository) List(ctx context.Context, filter *types.EventSchemaFilter) ([]*domainEventSchema.EventSchema, error) {
+	client := r.client.Reader(ctx)
+
+	span := StartRepositorySpan(ctx, "event_schema", "list", map[string]interface{}{
+		"filter": filter,
+	})
+	defer FinishSpan(span)
+
+	query := client.EventSchema.Query()
+	query = r.queryOpts.applyEntityQueryOptions(filter, query)
+	query = ApplyQueryOptions(ctx, query, filter, r.queryOpts)
+
+	schemas, err := query.All(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list event schemas").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return domainEventSchema.FromEntList(schemas), nil
+}
+
+func (r *eventSchemaRepository) Count(ctx context.Context, filter *types.EventSchemaFilter) (int, error) {
+	client := r.client.Reader(ctx)
+
+	span := StartRepositorySpan(ctx, "event_schema", "count", map[string]interface{}{
+		"filter": filter,
+	})
+	defer FinishSpan(span)
+
+	query := client.EventSchema.Query()
+	query = ApplyBaseFilters(ctx, query, filter, r.queryOpts)
+	query = r.queryOpts.applyEntityQueryOptions(filter, query)
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count event schemas").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return count, nil
+}
+
+func (r *eventSchemaRepository) SetCache(ctx context.Context, schemas map[string]*domainEventSchema.EventSchema) {
+	span := cache.StartCacheSpan(ctx, "event_schema", "set", nil)
+	defer cache.FinishSpan(span)
+
+	cacheKey := cache.GenerateKey(cache.PrefixEventSchema, types.GetTenantID(ctx), types.GetEnvironmentID(ctx))
+	r.cache.Set(ctx, cacheKey, schemas, cache.ExpiryDefaultInMemory)
+}
+
+func (r *eventSchemaRepository) GetCache(ctx context.Context) map[string]*domainEventSchema.EventSchema {
+	span := cache.StartCacheSpan(ctx, "event_schema", "get", nil)
+	defer cache.FinishSpan(span)
+
+	cacheKey := cache.GenerateKey(cache.PrefixEventSchema, types.GetTenantID(ctx), types.GetEnvironmentID(ctx))
+	if value, found := r.cache.Get(ctx, cacheKey); found {
+		return value.(map[string]*domainEventSchema.EventSchema)
+	}
+	return nil
+}
+
+func (r *eventSchemaRepository) DeleteCache(ctx context.Context) {
+	span := cache.StartCacheSpan(ctx, "event_schema", "delete", nil)
+	defer cache.FinishSpan(span)
+
+	cacheKey := cache.GenerateKey(cache.PrefixEventSchema, types.GetTenantID(ctx), types.GetEnvironmentID(ctx))
+	r.cache.Delete(ctx, cacheKey)
+}
+
+// EventSchemaQuery type alias for better readability
+type EventSchemaQuery = *ent.EventSchemaQuery
+
+// EventSchemaQueryOptions implements BaseQueryOptions for event schema queries
+type EventSchemaQueryOptions struct{}
+
+func (o EventSchemaQueryOptions) ApplyTenantFilter(ctx context.Context, query EventSchemaQuery) EventSchemaQuery {
+	return query.Where(eventschema.TenantID(types.GetTenantID(ctx)))
+}
+
+func (o EventSchemaQueryOptions) ApplyEnvironmentFilter(ctx context.Context, query EventSchemaQuery) EventSchemaQuery {
+	environmentID := types.GetEnvironmentID(ctx)
+	if environmentID != "" {
+		return query.Where(eventschema.EnvironmentID(environmentID))
+	}
+	return query
+}
+
+func (o EventSchemaQueryOptions) ApplyStatusFilter(query EventSchemaQuery, status string) EventSchemaQuery {
+	if status == "" {
+		return query.Where(eventschema.StatusNotIn(string(types.StatusDeleted)))
+	}
+	return query.Where(eventschema.Status(status))
+}
+
+func (o EventSchemaQueryOptions) ApplySortFilter(query EventSchemaQuery, field string, order string) EventSchemaQuery {
+	if field != "" {
+		if order == types.OrderDesc {
+			query = query.Order(ent.Desc(o.GetFieldName(field)))
+		} else {
+			query = query.Order(ent.Asc(o.GetFieldName(field)))
+		}
+	}
+	return query
+}
+
+func (o EventSchemaQueryOptions) ApplyPaginationFilter(query EventSchemaQuery, limit int, offset int) EventSchemaQuery {
+	query = query.Limit(limit)
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+	return query
+}
+
+func (o EventSchemaQueryOptions) GetFieldName(field string) string {
+	switch field {
+	case "created_at":
+		return eventschema.FieldCreatedAt
+	case "updated_at":
+		return eventschema.FieldUpdatedAt
+	case "event_name":
+		return eventschema.FieldEventName
+	case "validation_mode":
+		return eventschema.FieldValidationMode
+	default:
+		return field
+	}
+}
+
+func (o EventSchemaQueryOptions) applyEntityQueryOptions(f *types.EventSchemaFilter, query EventSchemaQuery) EventSchemaQuery {
+	if f == nil {
+		return query
+	}
+
+	if len(f.EventSchemaIDs) > 0 {
+		query = query.Where(eventschema.IDIn(f.EventSchemaIDs...))
+	}
+	if len(f.EventNames) > 0 {
+		query = query.Where(eventschema.EventNameIn(f.EventNames...))
+	}
+	if f.TimeRangeFilter != nil {
+		if f.StartTime != nil {
+			query = query.Where(eventschema.CreatedAtGTE(*f.StartTime))
+		}
+		if f.EndTime != nil {
+			query = query.Where(eventschema.CreatedAtLTE(*f.EndTime))
+		}
+	}
+
+	return query
+}
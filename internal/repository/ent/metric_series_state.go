@@ -0,0 +1,143 @@
+package ent
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/flexprice/flexprice/internal/domain/events"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/postgres"
+)
+
+type metricSeriesStateRepository struct {
+	client postgres.IClient
+	log    *logger.Logger
+}
+
+func NewMetricSeriesStateRepository(client postgres.IClient, log *logger.Logger) events.MetricSeriesStateRepository {
+	return &metricSeriesStateRepository{
+		client: client,
+		log:    log,
+	}
+}
+
+func (r *metricSeriesStateRepository) Advance(ctx context.Context, state *events.MetricSeriesState) (*events.MetricSeriesState, bool, error) {
+	span := StartRepositorySpan(ctx, "metric_series_state", "advance", map[string]interface{}{
+		"series_id": state.SeriesID,
+	})
+	defer FinishSpan(span)
+
+	// Use raw SQL since ent doesn't support RETURNING with OnConflict. The update keeps the replaced point
+	// in the previous_* columns so that it is returned by the same atomic statement, and does not touch
+	// the row when the point is not newer, in which case no row is returned.
+	query := `
+		INSERT INTO metric_series_states AS s (tenant_id, environment_id, series_id, value, count, start_time, value_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+		ON CONFLICT (tenant_id, environment_id, series_id) DO UPDATE
+		SET previous_value = s.value,
+			previous_count = s.count,
+			previous_start_time = s.start_time,
+			previous_value_at = s.value_at,
+			value = EXCLUDED.value,
+			count = EXCLUDED.count,
+			start_time = EXCLUDED.start_time,
+			value_at = EXCLUDED.value_at,
+			updated_at = CURRENT_TIMESTAMP
+		WHERE s.value_at < EXCLUDED.value_at
+		RETURNING (xmax = 0) AS inserted, previous_value, previous_count, previous_start_time, previous_value_at`
+
+	startTime := sql.NullTime{Time: state.StartTime, Valid: !state.StartTime.IsZero()}
+	rows, err := r.client.Writer(ctx).QueryContext(ctx, query,
+		state.TenantID, state.EnvironmentID, state.SeriesID, state.Value, int64(state.Count), startTime, state.ValueAt)
+	if err != nil {
+		SetSpanError(span, err)
+		return nil, false, ierr.WithError(err).WithHint("Failed to advance metric series").Mark(ierr.ErrDatabase)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			SetSpanError(span, err)
+			return nil, false, ierr.WithError(err).WithHint("Failed to advance metric series").Mark(ierr.ErrDatabase)
+		}
+		SetSpanSuccess(span)
+		return r.getRetriedPrevious(ctx, state)
+	}
+
+	var (
+		inserted          bool
+		previousValue     sql.NullFloat64
+		previousCount     sql.NullInt64
+		previousStartTime sql.NullTime
+		previousValueAt   sql.NullTime
+	)
+	if err := rows.Scan(&inserted, &previousValue, &previousCount, &previousStartTime, &previousValueAt); err != nil {
+		SetSpanError(span, err)
+		return nil, false, ierr.WithError(err).WithHint("Failed to advance metric series").Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	if inserted {
+		return nil, true, nil
+	}
+
+	return &events.MetricSeriesState{
+		TenantID:      state.TenantID,
+		EnvironmentID: state.EnvironmentID,
+		SeriesID:      state.SeriesID,
+		Value:         previousValue.Float64,
+		Count:         uint64(previousCount.Int64),
+		StartTime:     previousStartTime.Time,
+		ValueAt:       previousValueAt.Time,
+	}, true, nil
+}
+
+// getRetriedPrevious returns the point replaced by the last point of the series when the given point is
+// the last point, i.e. a retried point whose events may not have been published
+func (r *metricSeriesStateRepository) getRetriedPrevious(ctx context.Context, state *events.MetricSeriesState) (*events.MetricSeriesState, bool, error) {
+	query := `
+		SELECT previous_value, previous_count, previous_start_time, previous_value_at
+		FROM metric_series_states
+		WHERE tenant_id = $1 AND environment_id = $2 AND series_id = $3
+		AND value_at = $4 AND value = $5 AND count = $6`
+
+	rows, err := r.client.Writer(ctx).QueryContext(ctx, query,
+		state.TenantID, state.EnvironmentID, state.SeriesID, state.ValueAt, state.Value, int64(state.Count))
+	if err != nil {
+		return nil, false, ierr.WithError(err).WithHint("Failed to get metric series").Mark(ierr.ErrDatabase)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return nil, false, ierr.WithError(err).WithHint("Failed to get metric series").Mark(ierr.ErrDatabase)
+		}
+		return nil, false, nil
+	}
+
+	var (
+		previousValue     sql.NullFloat64
+		previousCount     sql.NullInt64
+		previousStartTime sql.NullTime
+		previousValueAt   sql.NullTime
+	)
+	if err := rows.Scan(&previousValue, &previousCount, &previousStartTime, &previousValueAt); err != nil {
+		return nil, false, ierr.WithError(err).WithHint("Failed to get metric series").Mark(ierr.ErrDatabase)
+	}
+
+	// the retried point set the baseline of the series
+	if !previousValueAt.Valid {
+		return nil, true, nil
+	}
+
+	return &events.MetricSeriesState{
+		TenantID:      state.TenantID,
+		EnvironmentID: state.EnvironmentID,
+		SeriesID:      state.SeriesID,
+		Value:         previousValue.Float64,
+		Count:         uint64(previousCount.Int64),
+		StartTime:     previousStartTime.Time,
+		ValueAt:       previousValueAt.Time,
+	}, true, nil
+}
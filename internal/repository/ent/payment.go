@@ -7,12 +7,14 @@ import (
 	"github.com/flexprice/flexprice/ent"
 	"github.com/flexprice/flexprice/ent/payment"
 	"github.com/flexprice/flexprice/ent/paymentattempt"
+	"github.com/flexprice/flexprice/ent/paymentrefund"
 	"github.com/flexprice/flexprice/internal/cache"
 	domainPayment "github.com/flexprice/flexprice/internal/domain/payment"
 	ierr "github.com/flexprice/flexprice/internal/errors"
 	"github.com/flexprice/flexprice/internal/logger"
 	"github.com/flexprice/flexprice/internal/postgres"
 	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
 )
 
 type paymentRepository struct {
@@ -128,6 +130,7 @@ func (r *paymentRepository) Get(ctx context.Context, id string) (*domainPayment.
 			payment.TenantID(types.GetTenantID(ctx)),
 		).
 		WithAttempts().
+		WithRefunds().
 		Only(ctx)
 
 	if err != nil {
@@ -235,12 +238,80 @@ func (r *paymentRepository) Update(ctx context.Context, p *domainPayment.Payment
 	})
 	defer FinishSpan(span)
 
-	_, err := client.Payment.Update().
+	_, err := setPaymentUpdateFields(client.Payment.Update().
 		Where(
 			payment.EnvironmentID(types.GetEnvironmentID(ctx)),
 			payment.ID(p.ID),
 			payment.TenantID(p.TenantID),
-		).
+		), p).
+		Save(ctx)
+
+	if err != nil {
+		SetSpanError(span, err)
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHint("Payment not found").
+				WithReportableDetails(map[string]interface{}{
+					"payment_id": p.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to update payment").
+			WithReportableDetails(map[string]interface{}{
+				"payment_id": p.ID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	r.DeleteCache(ctx, p.ID)
+	return nil
+}
+
+func (r *paymentRepository) UpdateIfStatus(ctx context.Context, p *domainPayment.Payment, from ...types.PaymentStatus) (bool, error) {
+	client := r.client.Writer(ctx)
+
+	r.log.Debugw("updating payment if status",
+		"payment_id", p.ID,
+		"tenant_id", p.TenantID,
+		"from", from,
+	)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "payment", "update_if_status", map[string]interface{}{
+		"payment_id": p.ID,
+		"tenant_id":  p.TenantID,
+	})
+	defer FinishSpan(span)
+
+	// the status condition is part of the update so that concurrent updates cannot both apply
+	n, err := setPaymentUpdateFields(client.Payment.Update().
+		Where(
+			payment.EnvironmentID(types.GetEnvironmentID(ctx)),
+			payment.ID(p.ID),
+			payment.TenantID(p.TenantID),
+			payment.PaymentStatusIn(lo.Map(from, func(status types.PaymentStatus, _ int) string {
+				return string(status)
+			})...),
+		), p).
+		Save(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+		return false, ierr.WithError(err).
+			WithHint("Failed to update payment").
+			WithReportableDetails(map[string]interface{}{
+				"payment_id": p.ID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	r.DeleteCache(ctx, p.ID)
+	return n > 0, nil
+}
+
+// setPaymentUpdateFields sets the mutable fields of the payment on the update
+func setPaymentUpdateFields(update *ent.PaymentUpdate, p *domainPayment.Payment) *ent.PaymentUpdate {
+	return update.
 		SetPaymentStatus(string(p.PaymentStatus)).
 		SetPaymentMethodID(p.PaymentMethodID).
 		SetNillablePaymentGateway(p.PaymentGateway).
@@ -254,28 +325,48 @@ func (r *paymentRepository) Update(ctx context.Context, p *domainPayment.Payment
 		SetNillableSucceededAt(p.SucceededAt).
 		SetNillableFailedAt(p.FailedAt).
 		SetNillableRefundedAt(p.RefundedAt).
-		SetNillableErrorMessage(p.ErrorMessage).
-		Save(ctx)
+		SetNillableErrorMessage(p.ErrorMessage)
+}
 
+func (r *paymentRepository) LockByDestination(ctx context.Context, destinationType types.PaymentDestinationType, destinationID string) error {
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "payment", "lock_by_destination", map[string]interface{}{
+		"destination_type": destinationType,
+		"destination_id":   destinationID,
+	})
+	defer FinishSpan(span)
+
+	query := `
+		SELECT id FROM payments
+		WHERE tenant_id = $1
+		AND environment_id = $2
+		AND destination_type = $3
+		AND destination_id = $4
+		FOR UPDATE`
+
+	rows, err := r.client.Writer(ctx).QueryContext(ctx, query,
+		types.GetTenantID(ctx), types.GetEnvironmentID(ctx), string(destinationType), destinationID)
 	if err != nil {
 		SetSpanError(span, err)
-		if ent.IsNotFound(err) {
-			return ierr.WithError(err).
-				WithHint("Payment not found").
-				WithReportableDetails(map[string]interface{}{
-					"payment_id": p.ID,
-				}).
-				Mark(ierr.ErrNotFound)
-		}
 		return ierr.WithError(err).
-			WithHint("Failed to update payment").
+			WithHint("Failed to lock payments").
 			WithReportableDetails(map[string]interface{}{
-				"payment_id": p.ID,
+				"destination_type": destinationType,
+				"destination_id":   destinationID,
 			}).
 			Mark(ierr.ErrDatabase)
 	}
+	defer rows.Close()
 
-	r.DeleteCache(ctx, p.ID)
+	// the rows are only read to hold their locks
+	for rows.Next() {
+	}
+	if err := rows.Err(); err != nil {
+		SetSpanError(span, err)
+		return ierr.WithError(err).
+			WithHint("Failed to lock payments").
+			Mark(ierr.ErrDatabase)
+	}
 	return nil
 }
 
@@ -599,6 +690,228 @@ func (r *paymentRepository) GetLatestAttempt(ctx context.Context, paymentID stri
 	return domainPayment.FromEntAttempt(a), nil
 }
 
+// Payment refund operations
+
+func (r *paymentRepository) CreateRefund(ctx context.Context, pr *domainPayment.PaymentRefund) error {
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "payment", "create_refund", map[string]interface{}{
+		"refund_id":  pr.ID,
+		"payment_id": pr.PaymentID,
+	})
+	defer FinishSpan(span)
+
+	client := r.client.Writer(ctx)
+
+	r.log.Debugw("creating payment refund",
+		"refund_id", pr.ID,
+		"payment_id", pr.PaymentID,
+		"amount", pr.Amount,
+		"refund_status", pr.RefundStatus,
+	)
+
+	// Set environment ID from context if not already set
+	if pr.EnvironmentID == "" {
+		pr.EnvironmentID = types.GetEnvironmentID(ctx)
+	}
+
+	refund, err := client.PaymentRefund.Create().
+		SetID(pr.ID).
+		SetPaymentID(pr.PaymentID).
+		SetNillableCreditNoteID(pr.CreditNoteID).
+		SetAmount(pr.Amount).
+		SetCurrency(pr.Currency).
+		SetRefundStatus(string(pr.RefundStatus)).
+		SetNillablePaymentGateway(pr.PaymentGateway).
+		SetNillableGatewayRefundID(pr.GatewayRefundID).
+		SetReason(pr.Reason).
+		SetNillableFailureReason(pr.FailureReason).
+		SetNillableSucceededAt(pr.SucceededAt).
+		SetNillableFailedAt(pr.FailedAt).
+		SetMetadata(pr.Metadata).
+		SetTenantID(pr.TenantID).
+		SetStatus(string(pr.Status)).
+		SetCreatedAt(pr.CreatedAt).
+		SetUpdatedAt(pr.UpdatedAt).
+		SetCreatedBy(pr.CreatedBy).
+		SetUpdatedBy(pr.UpdatedBy).
+		SetEnvironmentID(pr.EnvironmentID).
+		Save(ctx)
+
+	if err != nil {
+		SetSpanError(span, err)
+		return ierr.WithError(err).
+			WithHint("Failed to create payment refund").
+			WithReportableDetails(map[string]interface{}{
+				"refund_id":  pr.ID,
+				"payment_id": pr.PaymentID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	*pr = *domainPayment.FromEntRefund(refund)
+	return nil
+}
+
+func (r *paymentRepository) GetRefund(ctx context.Context, id string) (*domainPayment.PaymentRefund, error) {
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "payment", "get_refund", map[string]interface{}{
+		"refund_id": id,
+	})
+	defer FinishSpan(span)
+
+	client := r.client.Reader(ctx)
+
+	r.log.Debugw("getting payment refund",
+		"refund_id", id,
+	)
+
+	refund, err := client.PaymentRefund.Query().
+		Where(
+			paymentrefund.ID(id),
+			paymentrefund.TenantID(types.GetTenantID(ctx)),
+			paymentrefund.EnvironmentID(types.GetEnvironmentID(ctx)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		SetSpanError(span, err)
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHint("Payment refund not found").
+				WithReportableDetails(map[string]interface{}{
+					"refund_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get payment refund").
+			WithReportableDetails(map[string]interface{}{
+				"refund_id": id,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return domainPayment.FromEntRefund(refund), nil
+}
+
+func (r *paymentRepository) UpdateRefund(ctx context.Context, pr *domainPayment.PaymentRefund) error {
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "payment", "update_refund", map[string]interface{}{
+		"refund_id":  pr.ID,
+		"payment_id": pr.PaymentID,
+	})
+	defer FinishSpan(span)
+
+	client := r.client.Writer(ctx)
+
+	r.log.Debugw("updating payment refund",
+		"refund_id", pr.ID,
+		"payment_id", pr.PaymentID,
+		"refund_status", pr.RefundStatus,
+	)
+
+	_, err := client.PaymentRefund.Update().
+		Where(
+			paymentrefund.EnvironmentID(types.GetEnvironmentID(ctx)),
+			paymentrefund.ID(pr.ID),
+			paymentrefund.TenantID(pr.TenantID),
+		).
+		SetRefundStatus(string(pr.RefundStatus)).
+		SetStatus(string(pr.Status)).
+		SetNillablePaymentGateway(pr.PaymentGateway).
+		SetNillableGatewayRefundID(pr.GatewayRefundID).
+		SetNillableFailureReason(pr.FailureReason).
+		SetNillableSucceededAt(pr.SucceededAt).
+		SetNillableFailedAt(pr.FailedAt).
+		SetMetadata(pr.Metadata).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		SetSpanError(span, err)
+		return ierr.WithError(err).
+			WithHint("Failed to update payment refund").
+			WithReportableDetails(map[string]interface{}{
+				"refund_id":  pr.ID,
+				"payment_id": pr.PaymentID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *paymentRepository) ListRefunds(ctx context.Context, paymentID string) ([]*domainPayment.PaymentRefund, error) {
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "payment", "list_refunds", map[string]interface{}{
+		"payment_id": paymentID,
+	})
+	defer FinishSpan(span)
+
+	client := r.client.Reader(ctx)
+
+	r.log.Debugw("listing payment refunds",
+		"payment_id", paymentID,
+	)
+
+	refunds, err := client.PaymentRefund.Query().
+		Where(
+			paymentrefund.PaymentID(paymentID),
+			paymentrefund.TenantID(types.GetTenantID(ctx)),
+			paymentrefund.EnvironmentID(types.GetEnvironmentID(ctx)),
+		).
+		Order(ent.Asc(paymentrefund.FieldCreatedAt)).
+		All(ctx)
+
+	if err != nil {
+		SetSpanError(span, err)
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list payment refunds").
+			WithReportableDetails(map[string]interface{}{
+				"payment_id": paymentID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return domainPayment.FromEntRefundList(refunds), nil
+}
+
+func (r *paymentRepository) ListRefundsByCreditNote(ctx context.Context, creditNoteID string) ([]*domainPayment.PaymentRefund, error) {
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "payment", "list_refunds_by_credit_note", map[string]interface{}{
+		"credit_note_id": creditNoteID,
+	})
+	defer FinishSpan(span)
+
+	client := r.client.Reader(ctx)
+
+	r.log.Debugw("listing payment refunds of credit note",
+		"credit_note_id", creditNoteID,
+	)
+
+	refunds, err := client.PaymentRefund.Query().
+		Where(
+			paymentrefund.CreditNoteID(creditNoteID),
+			paymentrefund.TenantID(types.GetTenantID(ctx)),
+			paymentrefund.EnvironmentID(types.GetEnvironmentID(ctx)),
+		).
+		Order(ent.Asc(paymentrefund.FieldCreatedAt)).
+		All(ctx)
+
+	if err != nil {
+		SetSpanError(span, err)
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list payment refunds").
+			WithReportableDetails(map[string]interface{}{
+				"credit_note_id": creditNoteID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return domainPayment.FromEntRefundList(refunds), nil
+}
+
 // PaymentQuery type alias for better readability
 type PaymentQuery = *ent.PaymentQuery
 
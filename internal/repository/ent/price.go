@@ -76,6 +76,9 @@ func (r *priceRepository) Create(ctx context.Context, p *domainPrice.Price) erro
 		SetTiers(p.ToEntTiers()).
 		SetPriceUnitTiers(p.ToPriceUnitTiers()).
 		SetTransformQuantity(types.TransformQuantity(p.TransformQuantity)).
+		SetMatrix(p.Matrix).
+		SetNillableMinCharge(p.MinCharge).
+		SetNillableMaxCharge(p.MaxCharge).
 		SetLookupKey(p.LookupKey).
 		SetDescription(p.Description).
 		SetMetadata(map[string]string(p.Metadata)).
@@ -291,6 +294,9 @@ func (r *priceRepository) Update(ctx context.Context, p *domainPrice.Price) erro
 		SetTiers(p.ToEntTiers()).
 		SetPriceUnitTiers(p.ToPriceUnitTiers()).
 		SetTransformQuantity(types.TransformQuantity(p.TransformQuantity)).
+		SetMatrix(p.Matrix).
+		SetNillableMinCharge(p.MinCharge).
+		SetNillableMaxCharge(p.MaxCharge).
 		SetLookupKey(p.LookupKey).
 		SetNillableEndDate(p.EndDate).
 		SetDescription(p.Description).
@@ -413,6 +419,9 @@ func (r *priceRepository) CreateBulk(ctx context.Context, prices []*domainPrice.
 			SetTiers(p.ToEntTiers()).
 			SetPriceUnitTiers(p.ToPriceUnitTiers()).
 			SetTransformQuantity(types.TransformQuantity(p.TransformQuantity)).
+			SetMatrix(p.Matrix).
+			SetNillableMinCharge(p.MinCharge).
+			SetNillableMaxCharge(p.MaxCharge).
 			SetLookupKey(p.LookupKey).
 			SetDescription(p.Description).
 			SetMetadata(map[string]string(p.Metadata)).
@@ -0,0 +1,503 @@
+package ent
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/ent/promotioncode"
+	domainPromotionCode "github.com/flexprice/flexprice/internal/domain/promotion_code"
+	"github.com/flexprice/flexprice/internal/dsl"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/postgres"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+type promotionCodeRepository struct {
+	client    postgres.IClient
+	log       *logger.Logger
+	queryOpts PromotionCodeQueryOptions
+}
+
+func NewPromotionCodeRepository(client postgres.IClient, log *logger.Logger) domainPromotionCode.Repository {
+	return &promotionCodeRepository{
+		client:    client,
+		log:       log,
+		queryOpts: PromotionCodeQueryOptions{},
+	}
+}
+
+func (r *promotionCodeRepository) Create(ctx context.Context, p *domainPromotionCode.PromotionCode) error {
+	client := r.client.Writer(ctx)
+
+	r.log.Debugw("creating promotion code",
+		"promotion_code_id", p.ID,
+		"coupon_id", p.CouponID,
+		"code", p.Code,
+	)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "promotion_code", "create", map[string]interface{}{
+		"promotion_code_id": p.ID,
+		"coupon_id":         p.CouponID,
+	})
+	defer FinishSpan(span)
+
+	// Set environment ID from context if not already set
+	if p.EnvironmentID == "" {
+		p.EnvironmentID = types.GetEnvironmentID(ctx)
+	}
+
+	promotionCode, err := r.createQuery(client, p).Save(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+
+		if ent.IsConstraintError(err) {
+			return ierr.WithError(err).
+				WithHint("A promotion code with this code already exists").
+				WithReportableDetails(map[string]any{
+					"code": p.Code,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create promotion code").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	*p = *domainPromotionCode.FromEnt(promotionCode)
+	return nil
+}
+
+func (r *promotionCodeRepository) CreateBulk(ctx context.Context, promotionCodes []*domainPromotionCode.PromotionCode) error {
+	client := r.client.Writer(ctx)
+
+	r.log.Debugw("bulk creating promotion codes",
+		"count", len(promotionCodes),
+		"tenant_id", types.GetTenantID(ctx),
+	)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "promotion_code", "create_bulk", map[string]interface{}{
+		"count": len(promotionCodes),
+	})
+	defer FinishSpan(span)
+
+	if len(promotionCodes) == 0 {
+		return nil
+	}
+
+	builders := make([]*ent.PromotionCodeCreate, len(promotionCodes))
+	for i, p := range promotionCodes {
+		// Set environment ID from context if not already set
+		if p.EnvironmentID == "" {
+			p.EnvironmentID = types.GetEnvironmentID(ctx)
+		}
+		builders[i] = r.createQuery(client, p)
+	}
+
+	if _, err := client.PromotionCode.CreateBulk(builders...).Save(ctx); err != nil {
+		SetSpanError(span, err)
+
+		if ent.IsConstraintError(err) {
+			return ierr.WithError(err).
+				WithHint("One of the generated promotion codes already exists").
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create promotion codes").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return nil
+}
+
+func (r *promotionCodeRepository) createQuery(client *ent.Client, p *domainPromotionCode.PromotionCode) *ent.PromotionCodeCreate {
+	createQuery := client.PromotionCode.Create().
+		SetID(p.ID).
+		SetTenantID(p.TenantID).
+		SetCouponID(p.CouponID).
+		SetCode(p.Code).
+		SetNillableCustomerID(p.CustomerID).
+		SetNillableExpiresAt(p.ExpiresAt).
+		SetNillableMaxRedemptions(p.MaxRedemptions).
+		SetTotalRedemptions(p.TotalRedemptions).
+		SetStatus(string(p.Status)).
+		SetCreatedAt(p.CreatedAt).
+		SetUpdatedAt(p.UpdatedAt).
+		SetCreatedBy(p.CreatedBy).
+		SetUpdatedBy(p.UpdatedBy).
+		SetEnvironmentID(p.EnvironmentID)
+
+	if p.Metadata != nil {
+		createQuery = createQuery.SetMetadata(p.Metadata)
+	}
+	return createQuery
+}
+
+func (r *promotionCodeRepository) Get(ctx context.Context, id string) (*domainPromotionCode.PromotionCode, error) {
+	client := r.client.Reader(ctx)
+
+	r.log.Debugw("getting promotion code", "promotion_code_id", id)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "promotion_code", "get", map[string]interface{}{
+		"promotion_code_id": id,
+	})
+	defer FinishSpan(span)
+
+	p, err := client.PromotionCode.Query().
+		Where(
+			promotioncode.ID(id),
+			promotioncode.TenantID(types.GetTenantID(ctx)),
+			promotioncode.EnvironmentID(types.GetEnvironmentID(ctx)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		SetSpanError(span, err)
+
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Promotion code with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"promotion_code_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get promotion code").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return domainPromotionCode.FromEnt(p), nil
+}
+
+func (r *promotionCodeRepository) GetByCode(ctx context.Context, code string) (*domainPromotionCode.PromotionCode, error) {
+	client := r.client.Reader(ctx)
+
+	r.log.Debugw("getting promotion code by code", "code", code)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "promotion_code", "get_by_code", map[string]interface{}{
+		"code": code,
+	})
+	defer FinishSpan(span)
+
+	p, err := client.PromotionCode.Query().
+		Where(
+			promotioncode.Code(code),
+			promotioncode.Status(string(types.StatusPublished)),
+			promotioncode.TenantID(types.GetTenantID(ctx)),
+			promotioncode.EnvironmentID(types.GetEnvironmentID(ctx)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		SetSpanError(span, err)
+
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Promotion code %s was not found", code).
+				WithReportableDetails(map[string]any{
+					"code": code,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get promotion code").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return domainPromotionCode.FromEnt(p), nil
+}
+
+func (r *promotionCodeRepository) Delete(ctx context.Context, id string) error {
+	client := r.client.Writer(ctx)
+
+	r.log.Debugw("deleting promotion code",
+		"promotion_code_id", id,
+		"tenant_id", types.GetTenantID(ctx),
+		"environment_id", types.GetEnvironmentID(ctx),
+	)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "promotion_code", "delete", map[string]interface{}{
+		"promotion_code_id": id,
+	})
+	defer FinishSpan(span)
+
+	_, err := client.PromotionCode.Update().
+		Where(
+			promotioncode.ID(id),
+			promotioncode.TenantID(types.GetTenantID(ctx)),
+			promotioncode.EnvironmentID(types.GetEnvironmentID(ctx)),
+		).
+		SetStatus(string(types.StatusArchived)).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		SetSpanError(span, err)
+		return ierr.WithError(err).
+			WithHint("Failed to delete promotion code").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return nil
+}
+
+func (r *promotionCodeRepository) IncrementRedemptions(ctx context.Context, id string) error {
+	client := r.client.Writer(ctx)
+
+	r.log.Debugw("incrementing promotion code redemptions",
+		"promotion_code_id", id,
+		"tenant_id", types.GetTenantID(ctx),
+		"environment_id", types.GetEnvironmentID(ctx),
+	)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "promotion_code", "increment_redemptions", map[string]interface{}{
+		"promotion_code_id": id,
+	})
+	defer FinishSpan(span)
+
+	// the limit is checked in the update so that concurrent redemptions cannot exceed max_redemptions
+	n, err := client.PromotionCode.Update().
+		Where(
+			promotioncode.ID(id),
+			promotioncode.TenantID(types.GetTenantID(ctx)),
+			promotioncode.EnvironmentID(types.GetEnvironmentID(ctx)),
+			promotioncode.Or(
+				promotioncode.MaxRedemptionsIsNil(),
+				predicate.PromotionCode(func(s *sql.Selector) {
+					s.Where(sql.ColumnsLT(s.C(promotioncode.FieldTotalRedemptions), s.C(promotioncode.FieldMaxRedemptions)))
+				}),
+			),
+		).
+		AddTotalRedemptions(1).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		SetSpanError(span, err)
+		return ierr.WithError(err).
+			WithHint("Failed to increment promotion code redemptions").
+			Mark(ierr.ErrDatabase)
+	}
+
+	if n == 0 {
+		err := ierr.NewError("promotion code redemption limit reached").
+			WithHint("The promotion code has reached its maximum number of redemptions").
+			WithReportableDetails(map[string]interface{}{
+				"promotion_code_id": id,
+			}).
+			Mark(ierr.ErrValidation)
+		SetSpanError(span, err)
+		return err
+	}
+
+	SetSpanSuccess(span)
+	return nil
+}
+
+func (r *promotionCodeRepository) List(ctx context.Context, filter *types.PromotionCodeFilter) ([]*domainPromotionCode.PromotionCode, error) {
+	client := r.client.Reader(ctx)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "promotion_code", "list", map[string]interface{}{
+		"filter": filter,
+	})
+	defer FinishSpan(span)
+
+	query := client.PromotionCode.Query()
+	query = ApplyBaseFilters(ctx, query, filter, r.queryOpts)
+	query, err := r.queryOpts.applyEntityQueryOptions(ctx, filter, query)
+	if err != nil {
+		SetSpanError(span, err)
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list promotion codes").
+			Mark(ierr.ErrDatabase)
+	}
+	query = ApplyQueryOptions(ctx, query, filter, r.queryOpts)
+
+	promotionCodes, err := query.All(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list promotion codes").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return domainPromotionCode.FromEntList(promotionCodes), nil
+}
+
+func (r *promotionCodeRepository) Count(ctx context.Context, filter *types.PromotionCodeFilter) (int, error) {
+	client := r.client.Reader(ctx)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "promotion_code", "count", map[string]interface{}{
+		"filter": filter,
+	})
+	defer FinishSpan(span)
+
+	query := client.PromotionCode.Query()
+	query = ApplyBaseFilters(ctx, query, filter, r.queryOpts)
+
+	var err error
+	query, err = r.queryOpts.applyEntityQueryOptions(ctx, filter, query)
+	if err != nil {
+		SetSpanError(span, err)
+		return 0, ierr.WithError(err).
+			WithHint("Failed to apply query options").
+			Mark(ierr.ErrDatabase)
+	}
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count promotion codes").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return count, nil
+}
+
+// PromotionCodeQuery type alias for better readability
+type PromotionCodeQuery = *ent.PromotionCodeQuery
+
+// PromotionCodeQueryOptions implements BaseQueryOptions for promotion code queries
+type PromotionCodeQueryOptions struct{}
+
+func (o PromotionCodeQueryOptions) ApplyTenantFilter(ctx context.Context, query PromotionCodeQuery) PromotionCodeQuery {
+	return query.Where(promotioncode.TenantIDEQ(types.GetTenantID(ctx)))
+}
+
+func (o PromotionCodeQueryOptions) ApplyEnvironmentFilter(ctx context.Context, query PromotionCodeQuery) PromotionCodeQuery {
+	environmentID := types.GetEnvironmentID(ctx)
+	if environmentID != "" {
+		return query.Where(promotioncode.EnvironmentIDEQ(environmentID))
+	}
+	return query
+}
+
+func (o PromotionCodeQueryOptions) ApplyStatusFilter(query PromotionCodeQuery, status string) PromotionCodeQuery {
+	if status == "" {
+		return query.Where(promotioncode.StatusNotIn(string(types.StatusDeleted)))
+	}
+	return query.Where(promotioncode.Status(status))
+}
+
+func (o PromotionCodeQueryOptions) ApplySortFilter(query PromotionCodeQuery, field string, order string) PromotionCodeQuery {
+	if field != "" {
+		if order == types.OrderDesc {
+			query = query.Order(ent.Desc(o.GetFieldName(field)))
+		} else {
+			query = query.Order(ent.Asc(o.GetFieldName(field)))
+		}
+	}
+	return query
+}
+
+func (o PromotionCodeQueryOptions) ApplyPaginationFilter(query PromotionCodeQuery, limit int, offset int) PromotionCodeQuery {
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+	return query
+}
+
+func (o PromotionCodeQueryOptions) GetFieldName(field string) string {
+	switch field {
+	case "created_at":
+		return promotioncode.FieldCreatedAt
+	case "updated_at":
+		return promotioncode.FieldUpdatedAt
+	case "code":
+		return promotioncode.FieldCode
+	case "coupon_id":
+		return promotioncode.FieldCouponID
+	case "customer_id":
+		return promotioncode.FieldCustomerID
+	case "expires_at":
+		return promotioncode.FieldExpiresAt
+	case "status":
+		return promotioncode.FieldStatus
+	case "total_redemptions":
+		return promotioncode.FieldTotalRedemptions
+	default:
+		//unknown field
+		return ""
+	}
+}
+
+func (o PromotionCodeQueryOptions) GetFieldResolver(field string) (string, error) {
+	fieldName := o.GetFieldName(field)
+	if fieldName == "" {
+		return "", ierr.NewErrorf("unknown field name '%s' in promotion code query", field).
+			Mark(ierr.ErrValidation)
+	}
+	return fieldName, nil
+}
+
+func (o PromotionCodeQueryOptions) applyEntityQueryOptions(_ context.Context, f *types.PromotionCodeFilter, query PromotionCodeQuery) (PromotionCodeQuery, error) {
+	var err error
+	if f == nil {
+		return query, nil
+	}
+
+	if len(f.PromotionCodeIDs) > 0 {
+		query = query.Where(promotioncode.IDIn(f.PromotionCodeIDs...))
+	}
+	if f.CouponID != "" {
+		query = query.Where(promotioncode.CouponID(f.CouponID))
+	}
+	if f.CustomerID != "" {
+		query = query.Where(promotioncode.CustomerID(f.CustomerID))
+	}
+	if len(f.Codes) > 0 {
+		query = query.Where(promotioncode.CodeIn(f.Codes...))
+	}
+
+	if f.Filters != nil {
+		query, err = dsl.ApplyFilters[PromotionCodeQuery, predicate.PromotionCode](
+			query,
+			f.Filters,
+			o.GetFieldResolver,
+			func(p dsl.Predicate) predicate.PromotionCode { return predicate.PromotionCode(p) },
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Apply sorts using the generic function
+	if f.Sort != nil {
+		query, err = dsl.ApplySorts[PromotionCodeQuery, promotioncode.OrderOption](
+			query,
+			f.Sort,
+			o.GetFieldResolver,
+			func(o dsl.OrderFunc) promotioncode.OrderOption { return promotioncode.OrderOption(o) },
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return query, nil
+}
@@ -77,6 +77,10 @@ func (r *subscriptionRepository) Create(ctx context.Context, sub *domainSub.Subs
 		SetBillingCycle(string(sub.BillingCycle)).
 		SetNillableCommitmentAmount(sub.CommitmentAmount).
 		SetNillableOverageFactor(sub.OverageFactor).
+		SetCommitmentType(string(sub.GetCommitmentType())).
+		SetNillableCommitmentDuration((*string)(sub.CommitmentDuration)).
+		SetNillableCommitmentStartDate(sub.CommitmentStartDate).
+		SetNillableCommitmentEndDate(sub.CommitmentEndDate).
 		SetStatus(string(sub.Status)).
 		SetCreatedBy(sub.CreatedBy).
 		SetUpdatedBy(sub.UpdatedBy).
@@ -87,6 +91,7 @@ func (r *subscriptionRepository) Create(ctx context.Context, sub *domainSub.Subs
 		SetPaymentBehavior(subscription.PaymentBehavior(sub.PaymentBehavior)).
 		SetCollectionMethod(subscription.CollectionMethod(sub.CollectionMethod)).
 		SetNillableGatewayPaymentMethodID(sub.GatewayPaymentMethodID).
+		SetUsageAlerts(sub.UsageAlerts).
 		Save(ctx)
 
 	if err != nil {
@@ -176,6 +181,9 @@ func (r *subscriptionRepository) Update(ctx context.Context, sub *domainSub.Subs
 		SetPaymentBehavior(subscription.PaymentBehavior(sub.PaymentBehavior)).
 		SetCollectionMethod(subscription.CollectionMethod(sub.CollectionMethod)).
 		SetNillableGatewayPaymentMethodID(sub.GatewayPaymentMethodID).
+		SetNillableCommitmentStartDate(sub.CommitmentStartDate).
+		SetNillableCommitmentEndDate(sub.CommitmentEndDate).
+		SetUsageAlerts(sub.UsageAlerts).
 		SetUpdatedAt(now).
 		SetUpdatedBy(types.GetUserID(ctx)).
 		AddVersion(1) // Increment version atomically
@@ -371,6 +379,36 @@ func (r *subscriptionRepository) ListSubscriptionsDueForRenewal(ctx context.Cont
 	return result, nil
 }
 
+// ListSubscriptionsWithUsageAlerts retrieves all active subscriptions across tenants that have usage alerts configured
+// NOTE: This is a potentially expensive operation and to be used only for CRONs
+func (r *subscriptionRepository) ListSubscriptionsWithUsageAlerts(ctx context.Context) ([]*domainSub.Subscription, error) {
+	subs, err := r.client.Reader(ctx).Subscription.Query().
+		Where(
+			subscription.SubscriptionStatusIn(
+				string(types.SubscriptionStatusActive),
+				string(types.SubscriptionStatusTrialing),
+			),
+			subscription.StatusEQ(string(types.StatusPublished)),
+			subscription.UsageAlertsNotNil(),
+		).All(ctx)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list subscriptions with usage alerts").
+			Mark(ierr.ErrDatabase)
+	}
+
+	// Subscriptions without alerts may still hold a JSON null or empty array, skip them
+	result := make([]*domainSub.Subscription, 0, len(subs))
+	for _, sub := range subs {
+		if len(sub.UsageAlerts) == 0 {
+			continue
+		}
+		result = append(result, domainSub.GetSubscriptionFromEnt(sub))
+	}
+
+	return result, nil
+}
+
 // ListAll retrieves all subscriptions without pagination
 func (r *subscriptionRepository) ListAll(ctx context.Context, filter *types.SubscriptionFilter) ([]*domainSub.Subscription, error) {
 	if filter == nil {
@@ -20,6 +20,7 @@ import (
 	"github.com/flexprice/flexprice/internal/domain/entityintegrationmapping"
 	"github.com/flexprice/flexprice/internal/domain/environment"
 	"github.com/flexprice/flexprice/internal/domain/events"
+	"github.com/flexprice/flexprice/internal/domain/eventschema"
 	"github.com/flexprice/flexprice/internal/domain/feature"
 	"github.com/flexprice/flexprice/internal/domain/group"
 	"github.com/flexprice/flexprice/internal/domain/invoice"
@@ -28,6 +29,7 @@ import (
 	"github.com/flexprice/flexprice/internal/domain/plan"
 	"github.com/flexprice/flexprice/internal/domain/price"
 	"github.com/flexprice/flexprice/internal/domain/priceunit"
+	"github.com/flexprice/flexprice/internal/domain/promotion_code"
 	"github.com/flexprice/flexprice/internal/domain/scheduledtask"
 	"github.com/flexprice/flexprice/internal/domain/secret"
 	"github.com/flexprice/flexprice/internal/domain/settings"
@@ -64,6 +66,26 @@ func NewProcessedEventRepository(p RepositoryParams) events.ProcessedEventReposi
 	return clickhouseRepo.NewProcessedEventRepository(p.ClickHouseDB, p.Logger)
 }
 
+func NewRejectedEventRepository(p RepositoryParams) events.RejectedEventRepository {
+	return clickhouseRepo.NewRejectedEventRepository(p.ClickHouseDB, p.Logger)
+}
+
+func NewDeadLetterEventRepository(p RepositoryParams) events.DeadLetterEventRepository {
+	return clickhouseRepo.NewDeadLetterEventRepository(p.ClickHouseDB, p.Logger)
+}
+
+func NewEventCorrectionRepository(p RepositoryParams) events.EventCorrectionRepository {
+	return clickhouseRepo.NewEventCorrectionRepository(p.ClickHouseDB, p.Logger)
+}
+
+func NewEventCorrectionClaimRepository(p RepositoryParams) events.EventCorrectionClaimRepository {
+	return entRepo.NewEventCorrectionClaimRepository(p.EntClient, p.Logger)
+}
+
+func NewMetricSeriesStateRepository(p RepositoryParams) events.MetricSeriesStateRepository {
+	return entRepo.NewMetricSeriesStateRepository(p.EntClient, p.Logger)
+}
+
 func NewFeatureUsageRepository(p RepositoryParams) events.FeatureUsageRepository {
 	return clickhouseRepo.NewFeatureUsageRepository(p.ClickHouseDB, p.Logger)
 }
@@ -128,6 +150,10 @@ func NewEntitlementRepository(p RepositoryParams) entitlement.Repository {
 	return entRepo.NewEntitlementRepository(p.EntClient, p.Logger, p.Cache)
 }
 
+func NewEntitlementUsageCounterRepository(p RepositoryParams) entitlement.UsageCounterRepository {
+	return entRepo.NewEntitlementUsageCounterRepository(p.EntClient, p.Logger)
+}
+
 func NewPaymentRepository(p RepositoryParams) payment.Repository {
 	return entRepo.NewPaymentRepository(p.EntClient, p.Logger, p.Cache)
 }
@@ -164,6 +190,10 @@ func NewCouponApplicationRepository(p RepositoryParams) coupon_application.Repos
 	return entRepo.NewCouponApplicationRepository(p.EntClient, p.Logger, p.Cache)
 }
 
+func NewPromotionCodeRepository(p RepositoryParams) promotion_code.Repository {
+	return entRepo.NewPromotionCodeRepository(p.EntClient, p.Logger)
+}
+
 func NewCreditNoteRepository(p RepositoryParams) creditnote.Repository {
 	return entRepo.NewCreditNoteRepository(p.EntClient, p.Logger, p.Cache)
 }
@@ -216,6 +246,10 @@ func NewGroupRepository(p RepositoryParams) group.Repository {
 	return entRepo.NewGroupRepository(p.EntClient, p.Logger, p.Cache)
 }
 
+func NewEventSchemaRepository(p RepositoryParams) eventschema.Repository {
+	return entRepo.NewEventSchemaRepository(p.EntClient, p.Logger, p.Cache)
+}
+
 func NewScheduledTaskRepository(p RepositoryParams) scheduledtask.Repository {
 	return entRepo.NewScheduledTaskRepository(p.EntClient, p.Logger)
 }
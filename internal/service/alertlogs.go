@@ -6,10 +6,14 @@ import (
 	"time"
 
 	"github.com/flexprice/flexprice/internal/domain/alertlogs"
+	"github.com/flexprice/flexprice/internal/domain/entitlement"
+	"github.com/flexprice/flexprice/internal/domain/events"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
 	ierr "github.com/flexprice/flexprice/internal/errors"
 	"github.com/flexprice/flexprice/internal/types"
 	webhookDto "github.com/flexprice/flexprice/internal/webhook/dto"
 	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
 )
 
 // AlertLogsService defines the interface for alert logs operations
@@ -23,6 +27,9 @@ type AlertLogsService interface {
 
 	// ListAlertsByEntity retrieves alert logs for a specific entity
 	ListAlertsByEntity(ctx context.Context, entityType types.AlertEntityType, entityID string, limit int) ([]*alertlogs.AlertLog, error)
+
+	// CheckSubscriptionUsageAlerts evaluates the spend and usage alerts of a subscription against its current period
+	CheckSubscriptionUsageAlerts(ctx context.Context, sub *subscription.Subscription) error
 }
 
 // LogAlertRequest represents the request to log an alert
@@ -249,9 +256,9 @@ func (s *alertLogsService) LogAlert(ctx context.Context, req *LogAlertRequest) e
 				"webhook_event", webhookEventName,
 			)
 		}
-	case types.AlertTypeFeatureWalletBalance:
+	case types.AlertTypeFeatureWalletBalance, types.AlertTypeSubscriptionSpend, types.AlertTypeFeatureSpend, types.AlertTypeFeatureUsage:
 		// Publish webhook event using the publishWebhookEvent helper
-		// This will pass the alert log with parent entity fields (feature_id, wallet_id) to AlertPayloadBuilder
+		// This will pass the alert log with parent entity fields (wallet_id or subscription_id) to AlertPayloadBuilder
 		if webhookEventName != "" {
 			if err := s.publishWebhookEvent(ctx, webhookEventName, alertLog, req.AlertType); err != nil {
 				s.Logger.Errorw("failed to publish webhook event",
@@ -337,6 +344,19 @@ var alertWebhookMapping = map[types.AlertType]map[types.AlertState]WebhookEventM
 			WebhookEvent: types.WebhookEventFeatureWalletBalanceAlert, // "feature.balance.threshold.alert"
 		},
 	},
+	types.AlertTypeSubscriptionSpend: allAlertStatesWebhookMapping(types.WebhookEventSubscriptionSpendAlert),
+	types.AlertTypeFeatureSpend:      allAlertStatesWebhookMapping(types.WebhookEventFeatureSpendAlert),
+	types.AlertTypeFeatureUsage:      allAlertStatesWebhookMapping(types.WebhookEventFeatureUsageAlert),
+}
+
+// allAlertStatesWebhookMapping maps every alert state to the same webhook event
+func allAlertStatesWebhookMapping(webhookEvent string) map[types.AlertState]WebhookEventMapping {
+	return map[types.AlertState]WebhookEventMapping{
+		types.AlertStateInAlarm: {WebhookEvent: webhookEvent},
+		types.AlertStateWarning: {WebhookEvent: webhookEvent},
+		types.AlertStateInfo:    {WebhookEvent: webhookEvent},
+		types.AlertStateOk:      {WebhookEvent: webhookEvent},
+	}
 }
 
 // getWebhookEventName determines the appropriate webhook event name based on alert type and status
@@ -374,6 +394,30 @@ func (s *alertLogsService) publishWebhookEvent(ctx context.Context, eventName st
 			s.Logger.Errorw("failed to marshal webhook payload", "error", err)
 			return err
 		}
+	case types.AlertTypeSubscriptionSpend:
+		webhookPayload, err = json.Marshal(webhookDto.InternalAlertEvent{
+			SubscriptionID: alertLog.EntityID,
+			AlertType:      string(alertLog.AlertType),
+			AlertStatus:    string(alertLog.AlertStatus),
+			AlertInfo:      &alertLog.AlertInfo,
+		})
+		if err != nil {
+			s.Logger.Errorw("failed to marshal webhook payload", "error", err)
+			return err
+		}
+	case types.AlertTypeFeatureSpend, types.AlertTypeFeatureUsage:
+		// For feature usage alerts, the subscription is the parent entity
+		webhookPayload, err = json.Marshal(webhookDto.InternalAlertEvent{
+			FeatureID:      alertLog.EntityID,
+			SubscriptionID: lo.FromPtr(alertLog.ParentEntityID),
+			AlertType:      string(alertLog.AlertType),
+			AlertStatus:    string(alertLog.AlertStatus),
+			AlertInfo:      &alertLog.AlertInfo,
+		})
+		if err != nil {
+			s.Logger.Errorw("failed to marshal webhook payload", "error", err)
+			return err
+		}
 	default:
 		return ierr.NewError("invalid alert type").
 			WithHint("Invalid alert type").
@@ -397,3 +441,228 @@ func (s *alertLogsService) publishWebhookEvent(ctx context.Context, eventName st
 
 	return nil
 }
+
+// CheckSubscriptionUsageAlerts evaluates the usage alerts of a subscription against the processed events of its
+// current billing period and logs the resulting alert states. Alert logs and webhooks are only created on state changes.
+func (s *alertLogsService) CheckSubscriptionUsageAlerts(ctx context.Context, sub *subscription.Subscription) error {
+	alerts := lo.Filter(sub.UsageAlerts, func(alert types.UsageAlert, _ int) bool {
+		return alert.AlertSettings != nil && alert.AlertSettings.IsAlertEnabled()
+	})
+	if len(alerts) == 0 {
+		return nil
+	}
+
+	periodID, err := types.CalculatePeriodID(
+		sub.CurrentPeriodStart,
+		sub.StartDate,
+		sub.CurrentPeriodStart,
+		sub.CurrentPeriodEnd,
+		sub.BillingAnchor,
+		sub.BillingPeriodCount,
+		sub.BillingPeriod,
+	)
+	if err != nil {
+		return err
+	}
+
+	tenantID := types.GetTenantID(ctx)
+	environmentID := types.GetEnvironmentID(ctx)
+	values := usageAlertValues{
+		featureTotals: make(map[string]*events.PeriodFeatureTotal),
+		usageLimits:   make(map[string]*int64),
+	}
+
+	if lo.ContainsBy(alerts, func(alert types.UsageAlert) bool { return alert.AlertType == types.AlertTypeSubscriptionSpend }) {
+		values.periodCost, err = s.ProcessedEventRepo.GetPeriodCost(ctx, tenantID, environmentID, sub.CustomerID, sub.ID, periodID)
+		if err != nil {
+			return err
+		}
+	}
+
+	if lo.ContainsBy(alerts, func(alert types.UsageAlert) bool { return alert.FeatureID != "" }) {
+		totals, err := s.ProcessedEventRepo.GetPeriodFeatureTotals(ctx, tenantID, environmentID, sub.CustomerID, sub.ID, periodID)
+		if err != nil {
+			return err
+		}
+		for _, total := range totals {
+			values.featureTotals[total.FeatureID] = total
+		}
+	}
+
+	if lo.ContainsBy(alerts, func(alert types.UsageAlert) bool {
+		return alert.GetThresholdType() == types.AlertThresholdTypePercentage
+	}) {
+		values.usageLimits, err = s.getSubscriptionUsageLimits(ctx, sub)
+		if err != nil {
+			return err
+		}
+	}
+
+	now := time.Now().UTC()
+	for _, alert := range alerts {
+		value, ok := values.valueOf(alert)
+		if !ok {
+			s.Logger.Debugw("skipping usage alert without a value to compare",
+				"subscription_id", sub.ID,
+				"alert_type", alert.AlertType,
+				"feature_id", alert.FeatureID,
+			)
+			continue
+		}
+
+		alertStatus, err := alert.AlertSettings.AlertState(value)
+		if err != nil {
+			s.Logger.Errorw("failed to determine usage alert status",
+				"subscription_id", sub.ID,
+				"alert_type", alert.AlertType,
+				"feature_id", alert.FeatureID,
+				"error", err,
+			)
+			continue
+		}
+
+		req := &LogAlertRequest{
+			EntityType:  types.AlertEntityTypeSubscription,
+			EntityID:    sub.ID,
+			AlertType:   alert.AlertType,
+			AlertStatus: alertStatus,
+			AlertInfo: types.AlertInfo{
+				AlertSettings: alert.AlertSettings,
+				ThresholdType: alert.GetThresholdType(),
+				ValueAtTime:   value,
+				Timestamp:     now,
+			},
+		}
+		if alert.FeatureID != "" {
+			req.EntityType = types.AlertEntityTypeFeature
+			req.EntityID = alert.FeatureID
+			req.ParentEntityType = lo.ToPtr(string(types.AlertEntityTypeSubscription))
+			req.ParentEntityID = lo.ToPtr(sub.ID)
+		}
+
+		if err := s.LogAlert(ctx, req); err != nil {
+			s.Logger.Errorw("failed to log usage alert",
+				"subscription_id", sub.ID,
+				"alert_type", alert.AlertType,
+				"feature_id", alert.FeatureID,
+				"alert_status", alertStatus,
+				"error", err,
+			)
+		}
+	}
+
+	return nil
+}
+
+// getSubscriptionUsageLimits returns the usage limits of the metered features of the subscription. The entitlements
+// of the active plan and addon line items are aggregated per quantity like the customer entitlements, and the
+// entitlements of the subscription itself override them. Features with unlimited usage have no limit.
+func (s *alertLogsService) getSubscriptionUsageLimits(ctx context.Context, sub *subscription.Subscription) (map[string]*int64, error) {
+	_, lineItems, err := s.SubRepo.GetWithLineItems(ctx, sub.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the quantity of a plan or an addon is the quantity of its first active line item
+	now := time.Now().UTC()
+	quantities := make(map[string]int64)
+	planIDs := []string{sub.PlanID}
+	addonIDs := make([]string, 0)
+	for _, li := range lineItems {
+		if _, ok := quantities[li.EntityID]; ok || !li.IsActive(now) {
+			continue
+		}
+		switch li.EntityType {
+		case types.SubscriptionLineItemEntityTypePlan:
+			planIDs = append(planIDs, li.EntityID)
+		case types.SubscriptionLineItemEntityTypeAddon:
+			addonIDs = append(addonIDs, li.EntityID)
+		default:
+			continue
+		}
+		quantities[li.EntityID] = max(li.Quantity.IntPart(), 1)
+	}
+	if _, ok := quantities[sub.PlanID]; !ok {
+		quantities[sub.PlanID] = 1
+	}
+
+	planEntitlements, err := s.EntitlementRepo.ListByPlanIDs(ctx, lo.Uniq(planIDs))
+	if err != nil {
+		return nil, err
+	}
+	addonEntitlements, err := s.EntitlementRepo.ListByAddonIDs(ctx, addonIDs)
+	if err != nil {
+		return nil, err
+	}
+	overrides, err := s.EntitlementRepo.List(ctx, types.NewNoLimitEntitlementFilter().
+		WithEntityType(types.ENTITLEMENT_ENTITY_TYPE_SUBSCRIPTION).
+		WithEntityIDs([]string{sub.ID}))
+	if err != nil {
+		return nil, err
+	}
+
+	entitlementsByFeature := make(map[string][]*entitlement.Entitlement)
+	for _, e := range append(planEntitlements, addonEntitlements...) {
+		if e.Status != types.StatusPublished || e.FeatureType != types.FeatureTypeMetered {
+			continue
+		}
+		for range quantities[e.EntityID] {
+			entitlementsByFeature[e.FeatureID] = append(entitlementsByFeature[e.FeatureID], e)
+		}
+	}
+
+	overridesByFeature := make(map[string][]*entitlement.Entitlement)
+	for _, e := range overrides {
+		if e.Status != types.StatusPublished || e.FeatureType != types.FeatureTypeMetered {
+			continue
+		}
+		overridesByFeature[e.FeatureID] = append(overridesByFeature[e.FeatureID], e)
+	}
+	for featureID, featureOverrides := range overridesByFeature {
+		entitlementsByFeature[featureID] = featureOverrides
+	}
+
+	usageLimits := make(map[string]*int64, len(entitlementsByFeature))
+	for featureID, featureEntitlements := range entitlementsByFeature {
+		if limit := aggregateMeteredEntitlementsForBilling(featureEntitlements).UsageLimit; limit != nil {
+			usageLimits[featureID] = limit
+		}
+	}
+	return usageLimits, nil
+}
+
+// usageAlertValues holds the period values usage alerts are evaluated against
+type usageAlertValues struct {
+	periodCost    decimal.Decimal
+	featureTotals map[string]*events.PeriodFeatureTotal
+	usageLimits   map[string]*int64
+}
+
+// valueOf returns the value the thresholds of the alert are compared with. Percentage alerts of features
+// without an entitlement usage limit have no value.
+func (v usageAlertValues) valueOf(alert types.UsageAlert) (decimal.Decimal, bool) {
+	if alert.AlertType == types.AlertTypeSubscriptionSpend {
+		return v.periodCost, true
+	}
+
+	total := &events.PeriodFeatureTotal{Quantity: decimal.Zero, Cost: decimal.Zero}
+	if t, ok := v.featureTotals[alert.FeatureID]; ok {
+		total = t
+	}
+
+	switch alert.AlertType {
+	case types.AlertTypeFeatureSpend:
+		return total.Cost, true
+	case types.AlertTypeFeatureUsage:
+		if alert.GetThresholdType() != types.AlertThresholdTypePercentage {
+			return total.Quantity, true
+		}
+		limit, ok := v.usageLimits[alert.FeatureID]
+		if !ok || *limit <= 0 {
+			return decimal.Zero, false
+		}
+		return total.Quantity.Mul(decimal.NewFromInt(100)).Div(decimal.NewFromInt(*limit)), true
+	}
+
+	return decimal.Zero, false
+}
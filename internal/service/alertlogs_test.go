@@ -0,0 +1,201 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/domain/entitlement"
+	"github.com/flexprice/flexprice/internal/domain/events"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	"github.com/flexprice/flexprice/internal/testutil"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestUsageAlertValues(t *testing.T) {
+	values := usageAlertValues{
+		periodCost: decimal.NewFromInt(750),
+		featureTotals: map[string]*events.PeriodFeatureTotal{
+			"feat_api_calls": {FeatureID: "feat_api_calls", Quantity: decimal.NewFromInt(800), Cost: decimal.NewFromInt(520)},
+		},
+		usageLimits: map[string]*int64{
+			"feat_api_calls": lo.ToPtr(int64(1000)),
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		alert    types.UsageAlert
+		expected decimal.Decimal
+		ok       bool
+	}{
+		{
+			name:     "subscription spend",
+			alert:    types.UsageAlert{AlertType: types.AlertTypeSubscriptionSpend},
+			expected: decimal.NewFromInt(750),
+			ok:       true,
+		},
+		{
+			name:     "feature spend",
+			alert:    types.UsageAlert{AlertType: types.AlertTypeFeatureSpend, FeatureID: "feat_api_calls"},
+			expected: decimal.NewFromInt(520),
+			ok:       true,
+		},
+		{
+			name:     "feature usage amount",
+			alert:    types.UsageAlert{AlertType: types.AlertTypeFeatureUsage, FeatureID: "feat_api_calls"},
+			expected: decimal.NewFromInt(800),
+			ok:       true,
+		},
+		{
+			name:     "feature usage percentage of entitlement",
+			alert:    types.UsageAlert{AlertType: types.AlertTypeFeatureUsage, FeatureID: "feat_api_calls", ThresholdType: types.AlertThresholdTypePercentage},
+			expected: decimal.NewFromInt(80),
+			ok:       true,
+		},
+		{
+			name:     "feature without usage in the period",
+			alert:    types.UsageAlert{AlertType: types.AlertTypeFeatureSpend, FeatureID: "feat_storage"},
+			expected: decimal.Zero,
+			ok:       true,
+		},
+		{
+			name:  "percentage without entitlement usage limit",
+			alert: types.UsageAlert{AlertType: types.AlertTypeFeatureUsage, FeatureID: "feat_storage", ThresholdType: types.AlertThresholdTypePercentage},
+			ok:    false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			value, ok := values.valueOf(tc.alert)
+			assert.Equal(t, tc.ok, ok)
+			if tc.ok {
+				assert.True(t, tc.expected.Equal(value), "got %s", value)
+			}
+		})
+	}
+}
+
+func TestValidateUsageAlerts(t *testing.T) {
+	settings := &types.AlertSettings{
+		Critical:     &types.AlertThreshold{Threshold: decimal.NewFromInt(500), Condition: types.AlertConditionAbove},
+		AlertEnabled: lo.ToPtr(true),
+	}
+
+	assert.NoError(t, types.ValidateUsageAlerts([]types.UsageAlert{
+		{AlertType: types.AlertTypeSubscriptionSpend, AlertSettings: settings},
+		{AlertType: types.AlertTypeFeatureSpend, FeatureID: "feat_api_calls", AlertSettings: settings},
+		{AlertType: types.AlertTypeFeatureUsage, FeatureID: "feat_api_calls", ThresholdType: types.AlertThresholdTypePercentage, AlertSettings: settings},
+	}))
+
+	// feature alerts need a feature
+	assert.Error(t, types.ValidateUsageAlerts([]types.UsageAlert{
+		{AlertType: types.AlertTypeFeatureSpend, AlertSettings: settings},
+	}))
+
+	// percentage thresholds are only supported for usage alerts
+	assert.Error(t, types.ValidateUsageAlerts([]types.UsageAlert{
+		{AlertType: types.AlertTypeSubscriptionSpend, ThresholdType: types.AlertThresholdTypePercentage, AlertSettings: settings},
+	}))
+
+	// wallet alert types are not usage alerts
+	assert.Error(t, types.ValidateUsageAlerts([]types.UsageAlert{
+		{AlertType: types.AlertTypeLowCreditBalance, AlertSettings: settings},
+	}))
+
+	// each alert type can only be configured once per feature
+	assert.Error(t, types.ValidateUsageAlerts([]types.UsageAlert{
+		{AlertType: types.AlertTypeFeatureUsage, FeatureID: "feat_api_calls", AlertSettings: settings},
+		{AlertType: types.AlertTypeFeatureUsage, FeatureID: "feat_api_calls", ThresholdType: types.AlertThresholdTypePercentage, AlertSettings: settings},
+	}))
+}
+
+type AlertLogsServiceSuite struct {
+	testutil.BaseServiceTestSuite
+	service *alertLogsService
+}
+
+func TestAlertLogsService(t *testing.T) {
+	suite.Run(t, new(AlertLogsServiceSuite))
+}
+
+func (s *AlertLogsServiceSuite) SetupTest() {
+	s.BaseServiceTestSuite.SetupTest()
+	stores := s.GetStores()
+	s.service = &alertLogsService{
+		ServiceParams: ServiceParams{
+			Logger:          s.GetLogger(),
+			Config:          s.GetConfig(),
+			DB:              s.GetDB(),
+			SubRepo:         stores.SubscriptionRepo,
+			EntitlementRepo: stores.EntitlementRepo,
+			AlertLogsRepo:   stores.AlertLogsRepo,
+		},
+	}
+}
+
+func (s *AlertLogsServiceSuite) TearDownTest() {
+	s.BaseServiceTestSuite.TearDownTest()
+	s.BaseServiceTestSuite.ClearStores()
+}
+
+func (s *AlertLogsServiceSuite) TestGetSubscriptionUsageLimits() {
+	ctx := s.GetContext()
+	now := time.Now().UTC()
+
+	sub := &subscription.Subscription{
+		ID:         "sub_usage_limits",
+		CustomerID: "cust_usage_limits",
+		PlanID:     "plan_usage_limits",
+		BaseModel:  types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().SubscriptionRepo.CreateWithLineItems(ctx, sub, []*subscription.SubscriptionLineItem{
+		{
+			ID:             "li_plan",
+			SubscriptionID: sub.ID,
+			EntityID:       sub.PlanID,
+			EntityType:     types.SubscriptionLineItemEntityTypePlan,
+			Quantity:       decimal.NewFromInt(1),
+			StartDate:      now.AddDate(0, -1, 0),
+			BaseModel:      types.GetDefaultBaseModel(ctx),
+		},
+		{
+			ID:             "li_addon",
+			SubscriptionID: sub.ID,
+			EntityID:       "addon_usage_limits",
+			EntityType:     types.SubscriptionLineItemEntityTypeAddon,
+			Quantity:       decimal.NewFromInt(2),
+			StartDate:      now.AddDate(0, -1, 0),
+			BaseModel:      types.GetDefaultBaseModel(ctx),
+		},
+	}))
+
+	entitlements := []*entitlement.Entitlement{
+		{ID: "ent_plan_api_calls", EntityType: types.ENTITLEMENT_ENTITY_TYPE_PLAN, EntityID: sub.PlanID, FeatureID: "feat_api_calls", UsageLimit: lo.ToPtr(int64(100))},
+		{ID: "ent_plan_storage", EntityType: types.ENTITLEMENT_ENTITY_TYPE_PLAN, EntityID: sub.PlanID, FeatureID: "feat_storage", UsageLimit: lo.ToPtr(int64(50))},
+		{ID: "ent_plan_seats", EntityType: types.ENTITLEMENT_ENTITY_TYPE_PLAN, EntityID: sub.PlanID, FeatureID: "feat_seats"},
+		{ID: "ent_addon_api_calls", EntityType: types.ENTITLEMENT_ENTITY_TYPE_ADDON, EntityID: "addon_usage_limits", FeatureID: "feat_api_calls", UsageLimit: lo.ToPtr(int64(20))},
+		{ID: "ent_sub_storage", EntityType: types.ENTITLEMENT_ENTITY_TYPE_SUBSCRIPTION, EntityID: sub.ID, FeatureID: "feat_storage", UsageLimit: lo.ToPtr(int64(500))},
+	}
+	for _, e := range entitlements {
+		e.FeatureType = types.FeatureTypeMetered
+		e.IsEnabled = true
+		e.BaseModel = types.GetDefaultBaseModel(ctx)
+		_, err := s.GetStores().EntitlementRepo.Create(ctx, e)
+		s.NoError(err)
+	}
+
+	usageLimits, err := s.service.getSubscriptionUsageLimits(ctx, sub)
+	s.NoError(err)
+
+	// the plan limit is raised by both units of the addon and the subscription entitlement overrides the plan
+	s.Require().Contains(usageLimits, "feat_api_calls")
+	s.Equal(int64(140), *usageLimits["feat_api_calls"])
+	s.Require().Contains(usageLimits, "feat_storage")
+	s.Equal(int64(500), *usageLimits["feat_storage"])
+	s.NotContains(usageLimits, "feat_seats")
+}
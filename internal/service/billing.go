@@ -9,6 +9,7 @@ import (
 	"github.com/flexprice/flexprice/internal/api/dto"
 	"github.com/flexprice/flexprice/internal/domain/customer"
 	"github.com/flexprice/flexprice/internal/domain/entitlement"
+	"github.com/flexprice/flexprice/internal/domain/events"
 	"github.com/flexprice/flexprice/internal/domain/feature"
 	"github.com/flexprice/flexprice/internal/domain/invoice"
 	"github.com/flexprice/flexprice/internal/domain/meter"
@@ -64,11 +65,23 @@ type BillingService interface {
 	// CreateInvoiceRequestForCharges creates an invoice creation request for the given charges
 	CreateInvoiceRequestForCharges(ctx context.Context, sub *subscription.Subscription, result *BillingCalculationResult, periodStart, periodEnd time.Time, description string, metadata types.Metadata) (*dto.CreateInvoiceRequest, error)
 
+	// GetCommitmentUtilized returns the commitment drawn down by the usage invoiced for the billing
+	// periods of the commitment term starting before the given time
+	GetCommitmentUtilized(ctx context.Context, sub *subscription.Subscription, before time.Time) (decimal.Decimal, error)
+
+	// PrepareCommitmentInvoiceRequest prepares the invoice request for the current commitment term of the
+	// subscription, i.e. the upfront invoice of a prepaid commitment or the true-up invoice of a postpaid
+	// commitment. Returns nil if there is nothing to invoice.
+	PrepareCommitmentInvoiceRequest(ctx context.Context, sub *subscription.Subscription) (*dto.CreateInvoiceRequest, error)
+
 	// GetCustomerEntitlements returns aggregated entitlements for a customer across all subscriptions
 	GetCustomerEntitlements(ctx context.Context, customerID string, req *dto.GetCustomerEntitlementsRequest) (*dto.CustomerEntitlementsResponse, error)
 
 	// GetCustomerUsageSummary returns usage summaries for a customer's features
 	GetCustomerUsageSummary(ctx context.Context, customerID string, req *dto.GetCustomerUsageSummaryRequest) (*dto.CustomerUsageSummaryResponse, error)
+
+	// CheckEntitlement checks if a customer can use the requested quantity of a feature
+	CheckEntitlement(ctx context.Context, req *dto.CheckEntitlementRequest) (*dto.CheckEntitlementResponse, error)
 }
 
 type billingService struct {
@@ -223,6 +236,41 @@ func (s *billingService) CalculateUsageCharges(
 		meterMap[m.ID] = m
 	}
 
+	// Fetch the usage prices to enforce their minimum and maximum charges
+	priceIDs := make([]string, 0)
+	for _, item := range sub.LineItems {
+		if item.PriceType == types.PRICE_TYPE_USAGE && item.PriceID != "" {
+			priceIDs = append(priceIDs, item.PriceID)
+		}
+	}
+	priceMap := make(map[string]*price.Price)
+	if len(priceIDs) > 0 {
+		priceFilter := types.NewNoLimitPriceFilter()
+		priceFilter.PriceIDs = lo.Uniq(priceIDs)
+		priceFilter.AllowExpiredPrices = true
+		prices, err := s.PriceRepo.List(ctx, priceFilter)
+		if err != nil {
+			return nil, decimal.Zero, err
+		}
+		for _, p := range prices {
+			priceMap[p.ID] = p
+		}
+	}
+
+	// Customers of the usage, child customers are billed through the subscription of their parent
+	usageCustomers := make(map[string]*customer.Customer)
+	getUsageCustomer := func(customerID string) (*customer.Customer, error) {
+		if c, ok := usageCustomers[customerID]; ok {
+			return c, nil
+		}
+		c, err := s.CustomerRepo.Get(ctx, customerID)
+		if err != nil {
+			return nil, err
+		}
+		usageCustomers[customerID] = c
+		return c, nil
+	}
+
 	// filter out line items that are not active
 	for _, item := range sub.LineItems {
 		if item.PriceType != types.PRICE_TYPE_USAGE {
@@ -242,26 +290,57 @@ func (s *billingService) CalculateUsageCharges(
 				"subscription_id", sub.ID,
 				"line_item_id", item.ID,
 				"price_id", item.PriceID)
+
+			// The minimum charge of the price is due even without any usage, as long as the line item
+			// is active in the period
+			if !item.IsActiveInPeriod(periodStart, periodEnd) {
+				continue
+			}
+			if trueUp, _ := s.applyPriceChargeLimits(item, priceMap[item.PriceID], nil, periodStart, periodEnd); trueUp != nil {
+				usageCharges = append(usageCharges, *trueUp)
+				totalUsageCost = totalUsageCost.Add(trueUp.Amount)
+			}
 			continue
 		}
 
-		// Get customer for usage request
-		customer, err := s.CustomerRepo.Get(ctx, sub.CustomerID)
-		if err != nil {
-			return nil, decimal.Zero, err
-		}
-		eventService := NewEventService(s.EventRepo, s.MeterRepo, s.EventPublisher, s.Logger, s.Config)
+		// Track the line items of this subscription line item to enforce the price charge limits
+		itemChargesStart := len(usageCharges)
+
+		eventService := NewEventService(s.EventRepo, s.MeterRepo, s.EventSchemaRepo, s.RejectedEventRepo, s.EventPublisher, s.Logger, s.Config)
+
+		// Usage of the child customers shares the entitlement and the tiers of the price with the parent
+		// customer, so their charges are rated as one pooled charge and split per customer afterwards
+		matchingCharges, pooledCharge, customerCharges := poolCustomerCharges(matchingCharges)
 
 		// Process each matching charge individually (normal and overage charges)
 		for _, matchingCharge := range matchingCharges {
+			// Get the customers of the usage of the charge
+			chargeCharges := []*dto.SubscriptionUsageByMetersResponse{matchingCharge}
+			if matchingCharge == pooledCharge {
+				chargeCharges = customerCharges
+			}
+			chargeCustomers := make([]*customer.Customer, 0, len(chargeCharges))
+			for _, charge := range chargeCharges {
+				c, err := getUsageCustomer(lo.Ternary(charge.CustomerID != "", charge.CustomerID, sub.CustomerID))
+				if err != nil {
+					return nil, decimal.Zero, err
+				}
+				chargeCustomers = append(chargeCustomers, c)
+			}
+
 			quantityForCalculation := decimal.NewFromFloat(matchingCharge.Quantity)
 			matchingEntitlement, ok := entitlementsByPlanMeterID[item.EntityID][item.MeterID]
 
+			// Matrix charges are already broken down per cell of the rate table and can't be
+			// adjusted against a meter level entitlement
+			isMatrixCharge := len(matchingCharge.DimensionValues) > 0
+
 			// Only apply entitlement adjustments if:
 			// 1. This is not an overage charge
-			// 2. There is a matching entitlement
-			// 3. The entitlement is enabled
-			if !matchingCharge.IsOverage && ok && matchingEntitlement.IsEnabled {
+			// 2. This is not a matrix cell charge
+			// 3. There is a matching entitlement
+			// 4. The entitlement is enabled
+			if !matchingCharge.IsOverage && !isMatrixCharge && ok && matchingEntitlement.IsEnabled {
 				if matchingEntitlement.UsageLimit != nil {
 
 					// consider the usage reset period
@@ -283,16 +362,15 @@ func (s *billingService) CalculateUsageCharges(
 
 						// Create usage request with daily window size
 						usageRequest := &dto.GetUsageByMeterRequest{
-							MeterID:            item.MeterID,
-							PriceID:            item.PriceID,
-							ExternalCustomerID: customer.ExternalID,
-							StartTime:          item.GetPeriodStart(periodStart),
-							EndTime:            item.GetPeriodEnd(periodEnd),
-							WindowSize:         types.WindowSizeDay, // Use daily window size
+							MeterID:    item.MeterID,
+							PriceID:    item.PriceID,
+							StartTime:  item.GetPeriodStart(periodStart),
+							EndTime:    item.GetPeriodEnd(periodEnd),
+							WindowSize: types.WindowSizeDay, // Use daily window size
 						}
 
 						// Get usage data with daily windows
-						usageResult, err := eventService.GetUsageByMeter(ctx, usageRequest)
+						usageResult, err := getUsageByMeterForCustomers(ctx, eventService, usageRequest, chargeCustomers)
 						if err != nil {
 							return nil, decimal.Zero, err
 						}
@@ -339,17 +417,16 @@ func (s *billingService) CalculateUsageCharges(
 
 						// Create usage request with monthly window size
 						usageRequest := &dto.GetUsageByMeterRequest{
-							MeterID:            item.MeterID,
-							PriceID:            item.PriceID,
-							ExternalCustomerID: customer.ExternalID,
-							StartTime:          item.GetPeriodStart(periodStart),
-							EndTime:            item.GetPeriodEnd(periodEnd),
-							BillingAnchor:      &sub.BillingAnchor,
-							WindowSize:         types.WindowSizeMonth, // Use monthly window size
+							MeterID:       item.MeterID,
+							PriceID:       item.PriceID,
+							StartTime:     item.GetPeriodStart(periodStart),
+							EndTime:       item.GetPeriodEnd(periodEnd),
+							BillingAnchor: &sub.BillingAnchor,
+							WindowSize:    types.WindowSizeMonth, // Use monthly window size
 						}
 
 						// Get usage data with monthly windows
-						usageResult, err := eventService.GetUsageByMeter(ctx, usageRequest)
+						usageResult, err := getUsageByMeterForCustomers(ctx, eventService, usageRequest, chargeCustomers)
 						if err != nil {
 							return nil, decimal.Zero, err
 						}
@@ -391,7 +468,7 @@ func (s *billingService) CalculateUsageCharges(
 					} else if matchingEntitlement.UsageResetPeriod == types.ENTITLEMENT_USAGE_RESET_PERIOD_NEVER {
 						// Calculate usage for never reset entitlements using helper function
 						usageAllowed := decimal.NewFromFloat(float64(*matchingEntitlement.UsageLimit))
-						quantityForCalculation, err = s.calculateNeverResetUsage(ctx, sub, item, customer, eventService, periodStart, periodEnd, usageAllowed)
+						quantityForCalculation, err = s.calculateNeverResetUsage(ctx, sub, item, chargeCustomers, eventService, periodStart, periodEnd, usageAllowed)
 						if err != nil {
 							return nil, decimal.Zero, err
 						}
@@ -415,20 +492,19 @@ func (s *billingService) CalculateUsageCharges(
 						}
 
 						// For bucketed max, we need to process each bucket's max value
-						if meter.IsBucketedMaxMeter() {
+						if meter.IsBucketedMeter() {
 							// Get usage with bucketed values
 							usageRequest := &dto.GetUsageByMeterRequest{
-								MeterID:            item.MeterID,
-								PriceID:            item.PriceID,
-								ExternalCustomerID: customer.ExternalID,
-								StartTime:          item.GetPeriodStart(periodStart),
-								EndTime:            item.GetPeriodEnd(periodEnd),
-								WindowSize:         types.WindowSizeMonth, // Set monthly window size for custom billing periods
-								BillingAnchor:      &sub.BillingAnchor,
+								MeterID:       item.MeterID,
+								PriceID:       item.PriceID,
+								StartTime:     item.GetPeriodStart(periodStart),
+								EndTime:       item.GetPeriodEnd(periodEnd),
+								WindowSize:    types.WindowSizeMonth, // Set monthly window size for custom billing periods
+								BillingAnchor: &sub.BillingAnchor,
 							}
 
 							// Get usage data with buckets
-							usageResult, err := eventService.GetUsageByMeter(ctx, usageRequest)
+							usageResult, err := getUsageByMeterForCustomers(ctx, eventService, usageRequest, chargeCustomers)
 							if err != nil {
 								return nil, decimal.Zero, err
 							}
@@ -484,8 +560,22 @@ func (s *billingService) CalculateUsageCharges(
 				displayName = lo.ToPtr(fmt.Sprintf("%s (Overage)", item.DisplayName))
 			}
 
+			// Add the matrix cell to tell apart the line items of the same price
+			if isMatrixCharge && matchingCharge.Price != nil {
+				dimensions := matchingCharge.Price.Matrix.FormatDimensionValues(matchingCharge.DimensionValues)
+				metadata["dimensions"] = dimensions
+				displayName = lo.ToPtr(fmt.Sprintf("%s (%s)", lo.FromPtr(displayName), dimensions))
+			}
+
+			// Prefix the line items of the child customers to group them per customer on the invoice
+			if matchingCharge.CustomerID != "" {
+				metadata["customer_id"] = matchingCharge.CustomerID
+				metadata["customer_name"] = matchingCharge.CustomerName
+				displayName = lo.ToPtr(fmt.Sprintf("%s - %s", lo.CoalesceOrEmpty(matchingCharge.CustomerName, matchingCharge.CustomerID), lo.FromPtr(displayName)))
+			}
+
 			// Add usage reset period metadata if entitlement has daily, monthly, or never reset
-			if !matchingCharge.IsOverage && ok && matchingEntitlement.IsEnabled {
+			if !matchingCharge.IsOverage && !isMatrixCharge && ok && matchingEntitlement.IsEnabled {
 				switch matchingEntitlement.UsageResetPeriod {
 				case types.ENTITLEMENT_USAGE_RESET_PERIOD_DAILY:
 					metadata["usage_reset_period"] = "daily"
@@ -518,7 +608,7 @@ func (s *billingService) CalculateUsageCharges(
 				}
 			}
 
-			usageCharges = append(usageCharges, dto.CreateInvoiceLineItemRequest{
+			lineItem := dto.CreateInvoiceLineItemRequest{
 				EntityID:         lo.ToPtr(item.EntityID),
 				EntityType:       lo.ToPtr(string(item.EntityType)),
 				PlanDisplayName:  lo.ToPtr(item.PlanDisplayName),
@@ -534,13 +624,246 @@ func (s *billingService) CalculateUsageCharges(
 				PeriodStart:      lo.ToPtr(item.GetPeriodStart(periodStart)),
 				PeriodEnd:        lo.ToPtr(item.GetPeriodEnd(periodEnd)),
 				Metadata:         metadata,
-			})
+			}
+
+			if matchingCharge == pooledCharge {
+				usageCharges = append(usageCharges, splitLineItemByCustomer(lineItem, customerCharges)...)
+			} else {
+				usageCharges = append(usageCharges, lineItem)
+			}
+		}
+
+		// Enforce the minimum and maximum charge of the price across all the line items of the
+		// subscription line item, the minimum is billed as a separate true-up line item
+		trueUp, reduction := s.applyPriceChargeLimits(item, priceMap[item.PriceID], usageCharges[itemChargesStart:], periodStart, periodEnd)
+		totalUsageCost = totalUsageCost.Sub(reduction)
+		if trueUp != nil {
+			usageCharges = append(usageCharges, *trueUp)
+			totalUsageCost = totalUsageCost.Add(trueUp.Amount)
 		}
 	}
 
 	return usageCharges, totalUsageCost, nil
 }
 
+// poolCustomerCharges merges the regular usage charges of the parent customer and the child customers
+// of a price into one pooled charge, so the price is rated on the usage of all the customers together.
+// Overage and matrix cell charges are kept as is. It returns the charges to rate, the pooled charge and
+// the per customer charges merged into it, or no pooled charge when there is a single customer.
+func poolCustomerCharges(charges []*dto.SubscriptionUsageByMetersResponse) ([]*dto.SubscriptionUsageByMetersResponse, *dto.SubscriptionUsageByMetersResponse, []*dto.SubscriptionUsageByMetersResponse) {
+	customerCharges := lo.Filter(charges, func(c *dto.SubscriptionUsageByMetersResponse, _ int) bool {
+		return !c.IsOverage && len(c.DimensionValues) == 0
+	})
+	if len(customerCharges) <= 1 {
+		return charges, nil, nil
+	}
+
+	pooledCharge := *customerCharges[0]
+	pooledCharge.CustomerID = ""
+	pooledCharge.CustomerName = ""
+	quantity := decimal.Zero
+	amount := decimal.Zero
+	for _, c := range customerCharges {
+		quantity = quantity.Add(decimal.NewFromFloat(c.Quantity))
+		amount = amount.Add(decimal.NewFromFloat(c.Amount))
+	}
+	pooledCharge.Quantity = quantity.InexactFloat64()
+	pooledCharge.Amount = amount.InexactFloat64()
+
+	pooled := []*dto.SubscriptionUsageByMetersResponse{&pooledCharge}
+	for _, c := range charges {
+		if c.IsOverage || len(c.DimensionValues) > 0 {
+			pooled = append(pooled, c)
+		}
+	}
+	return pooled, &pooledCharge, customerCharges
+}
+
+// splitLineItemByCustomer splits the line item of a pooled charge into a line item per customer charge,
+// proportionally to the usage of the customers. The line items of the child customers are prefixed with
+// the customer so they are grouped per customer on the invoice.
+func splitLineItemByCustomer(lineItem dto.CreateInvoiceLineItemRequest, customerCharges []*dto.SubscriptionUsageByMetersResponse) []dto.CreateInvoiceLineItemRequest {
+	usage := lo.Map(customerCharges, func(c *dto.SubscriptionUsageByMetersResponse, _ int) decimal.Decimal {
+		return decimal.NewFromFloat(c.Quantity)
+	})
+	amounts := allocateByQuantity(lineItem.Amount, usage)
+	quantities := allocateByQuantity(lineItem.Quantity, usage)
+	var priceUnitAmounts []decimal.Decimal
+	if lineItem.PriceUnitAmount != nil {
+		priceUnitAmounts = allocateByQuantity(*lineItem.PriceUnitAmount, usage)
+	}
+
+	lineItems := make([]dto.CreateInvoiceLineItemRequest, 0, len(customerCharges))
+	for i, charge := range customerCharges {
+		split := lineItem
+		split.Amount = amounts[i]
+		split.Quantity = quantities[i]
+		if priceUnitAmounts != nil {
+			split.PriceUnitAmount = lo.ToPtr(priceUnitAmounts[i])
+		}
+		split.Metadata = lo.Assign(lineItem.Metadata)
+		if charge.CustomerID != "" {
+			split.Metadata["customer_id"] = charge.CustomerID
+			split.Metadata["customer_name"] = charge.CustomerName
+			split.DisplayName = lo.ToPtr(fmt.Sprintf("%s - %s", lo.CoalesceOrEmpty(charge.CustomerName, charge.CustomerID), lo.FromPtr(lineItem.DisplayName)))
+		}
+		lineItems = append(lineItems, split)
+	}
+	return lineItems
+}
+
+// allocateByQuantity splits the total proportionally to the quantities. The last share takes the rounding
+// remainder so the shares add up to the total, and the first share takes the total without any quantity.
+func allocateByQuantity(total decimal.Decimal, quantities []decimal.Decimal) []decimal.Decimal {
+	shares := make([]decimal.Decimal, len(quantities))
+	if len(quantities) == 0 {
+		return shares
+	}
+
+	sum := decimal.Sum(decimal.Zero, quantities...)
+	if sum.IsZero() {
+		shares[0] = total
+		return shares
+	}
+
+	allocated := decimal.Zero
+	for i, quantity := range quantities {
+		if i == len(quantities)-1 {
+			shares[i] = total.Sub(allocated)
+			break
+		}
+		shares[i] = total.Mul(quantity).Div(sum)
+		allocated = allocated.Add(shares[i])
+	}
+	return shares
+}
+
+// getUsageByMeterForCustomers returns the usage of the meter pooled across the customers, the values
+// of the customers are summed per window
+func getUsageByMeterForCustomers(ctx context.Context, eventService EventService, req *dto.GetUsageByMeterRequest, customers []*customer.Customer) (*events.AggregationResult, error) {
+	var pooled *events.AggregationResult
+	windows := make(map[time.Time]int)
+	for _, c := range customers {
+		customerReq := *req
+		customerReq.ExternalCustomerID = c.ExternalID
+		result, err := eventService.GetUsageByMeter(ctx, &customerReq)
+		if err != nil {
+			return nil, err
+		}
+
+		if pooled == nil {
+			pooled = result
+			pooled.Results = append([]events.UsageResult(nil), result.Results...)
+			for i, r := range pooled.Results {
+				windows[r.WindowSize] = i
+			}
+			continue
+		}
+
+		pooled.Value = pooled.Value.Add(result.Value)
+		for _, r := range result.Results {
+			if i, ok := windows[r.WindowSize]; ok {
+				pooled.Results[i].Value = pooled.Results[i].Value.Add(r.Value)
+				continue
+			}
+			windows[r.WindowSize] = len(pooled.Results)
+			pooled.Results = append(pooled.Results, r)
+		}
+	}
+
+	if pooled == nil {
+		return &events.AggregationResult{MeterID: req.MeterID, PriceID: req.PriceID}, nil
+	}
+	sort.Slice(pooled.Results, func(i, j int) bool {
+		return pooled.Results[i].WindowSize.Before(pooled.Results[j].WindowSize)
+	})
+	return pooled, nil
+}
+
+// applyPriceChargeLimits enforces the minimum and maximum charge of a price on the usage line items
+// of a subscription line item for the period. The line items are reduced in place when their total
+// exceeds the maximum charge, and a true-up line item is returned when it is below the minimum charge.
+// It also returns the amount the line items were reduced by.
+func (s *billingService) applyPriceChargeLimits(
+	item *subscription.SubscriptionLineItem,
+	p *price.Price,
+	lineItems []dto.CreateInvoiceLineItemRequest,
+	periodStart,
+	periodEnd time.Time,
+) (*dto.CreateInvoiceLineItemRequest, decimal.Decimal) {
+	if p == nil || !p.HasChargeLimits() {
+		return nil, decimal.Zero
+	}
+
+	total := decimal.Zero
+	for _, lineItem := range lineItems {
+		total = total.Add(lineItem.Amount)
+	}
+
+	limited := p.ApplyChargeLimits(total)
+	if limited.LessThan(total) {
+		// Reduce the line items starting from the last one as line item amounts can't be negative
+		excess := total.Sub(limited)
+		for i := len(lineItems) - 1; i >= 0 && excess.IsPositive(); i-- {
+			reduction := decimal.Min(excess, lineItems[i].Amount)
+			if !reduction.IsPositive() {
+				continue
+			}
+
+			originalAmount := lineItems[i].Amount
+			lineItems[i].Amount = originalAmount.Sub(reduction)
+			if lineItems[i].PriceUnitAmount != nil && !originalAmount.IsZero() {
+				lineItems[i].PriceUnitAmount = lo.ToPtr(lineItems[i].PriceUnitAmount.Mul(lineItems[i].Amount).Div(originalAmount))
+			}
+			if lineItems[i].Metadata == nil {
+				lineItems[i].Metadata = types.Metadata{}
+			}
+			lineItems[i].Metadata["max_charge_applied"] = "true"
+			lineItems[i].Metadata["original_amount"] = originalAmount.String()
+			excess = excess.Sub(reduction)
+		}
+
+		s.Logger.Debugw("applied maximum charge to usage line item",
+			"line_item_id", item.ID,
+			"price_id", item.PriceID,
+			"usage_amount", total,
+			"max_charge", p.MaxCharge)
+		return nil, total.Sub(limited)
+	}
+
+	if !limited.GreaterThan(total) {
+		return nil, decimal.Zero
+	}
+
+	s.Logger.Debugw("applied minimum charge to usage line item",
+		"line_item_id", item.ID,
+		"price_id", item.PriceID,
+		"usage_amount", total,
+		"min_charge", p.MinCharge)
+
+	return &dto.CreateInvoiceLineItemRequest{
+		EntityID:         lo.ToPtr(item.EntityID),
+		EntityType:       lo.ToPtr(string(item.EntityType)),
+		PlanDisplayName:  lo.ToPtr(item.PlanDisplayName),
+		PriceType:        lo.ToPtr(string(item.PriceType)),
+		PriceID:          lo.ToPtr(item.PriceID),
+		MeterID:          lo.ToPtr(item.MeterID),
+		MeterDisplayName: lo.ToPtr(item.MeterDisplayName),
+		PriceUnit:        lo.ToPtr(item.PriceUnit),
+		DisplayName:      lo.ToPtr(fmt.Sprintf("%s (Minimum Charge)", item.DisplayName)),
+		Amount:           limited.Sub(total),
+		Quantity:         decimal.Zero,
+		PeriodStart:      lo.ToPtr(item.GetPeriodStart(periodStart)),
+		PeriodEnd:        lo.ToPtr(item.GetPeriodEnd(periodEnd)),
+		Metadata: types.Metadata{
+			"description":  fmt.Sprintf("%s (Minimum Charge True-up)", item.DisplayName),
+			"is_true_up":   "true",
+			"min_charge":   p.MinCharge.String(),
+			"usage_amount": total.String(),
+		},
+	}, decimal.Zero
+}
+
 func (s *billingService) CalculateAllCharges(
 	ctx context.Context,
 	sub *subscription.Subscription,
@@ -560,6 +883,14 @@ func (s *billingService) CalculateAllCharges(
 		return nil, err
 	}
 
+	// Draw down the usage charges from the commitment of prepaid and postpaid commitments
+	if sub.HasTermCommitment() && len(usageCharges) > 0 {
+		usageTotal, err = s.applyCommitmentDrawdown(ctx, sub, usageCharges, periodStart)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &BillingCalculationResult{
 		FixedCharges: fixedCharges,
 		UsageCharges: usageCharges,
@@ -568,6 +899,204 @@ func (s *billingService) CalculateAllCharges(
 	}, nil
 }
 
+// applyCommitmentDrawdown draws down the usage charges of a billing period from the remaining commitment
+// of the commitment term. The part of a charge covered by the commitment is billed at zero for prepaid
+// commitments, as the commitment is invoiced upfront, and as is for postpaid commitments. The part past
+// the commitment is billed at the overage factor. Returns the new total of the usage charges.
+func (s *billingService) applyCommitmentDrawdown(
+	ctx context.Context,
+	sub *subscription.Subscription,
+	usageCharges []dto.CreateInvoiceLineItemRequest,
+	periodStart time.Time,
+) (decimal.Decimal, error) {
+	total := decimal.Zero
+	for _, charge := range usageCharges {
+		total = total.Add(charge.Amount)
+	}
+
+	// Periods outside of the current commitment term are billed as is
+	if periodStart.Before(*sub.CommitmentStartDate) || !periodStart.Before(*sub.CommitmentEndDate) {
+		return total, nil
+	}
+
+	utilized, err := s.GetCommitmentUtilized(ctx, sub, periodStart)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	remaining := decimal.Max(lo.FromPtr(sub.CommitmentAmount).Sub(utilized), decimal.Zero)
+	overageFactor := sub.GetOverageFactor()
+
+	total = decimal.Zero
+	for i := range usageCharges {
+		charge := &usageCharges[i]
+		if !charge.Amount.IsPositive() {
+			continue
+		}
+
+		covered := decimal.Min(remaining, charge.Amount)
+		overage := charge.Amount.Sub(covered)
+		remaining = remaining.Sub(covered)
+
+		amount := overage.Mul(overageFactor)
+		if sub.CommitmentType == types.CommitmentTypePostpaid {
+			amount = amount.Add(covered)
+		}
+
+		if charge.Metadata == nil {
+			charge.Metadata = types.Metadata{}
+		}
+		if covered.IsPositive() {
+			charge.Metadata["commitment_drawdown"] = covered.String()
+		}
+		if overage.IsPositive() {
+			charge.Metadata["is_overage"] = "true"
+			charge.Metadata["overage_factor"] = overageFactor.String()
+		}
+		if charge.PriceUnitAmount != nil {
+			charge.PriceUnitAmount = lo.ToPtr(charge.PriceUnitAmount.Mul(amount).Div(charge.Amount))
+		}
+
+		charge.Amount = amount
+		total = total.Add(amount)
+	}
+
+	s.Logger.Debugw("applied commitment drawdown to usage charges",
+		"subscription_id", sub.ID,
+		"commitment_type", sub.CommitmentType,
+		"period_start", periodStart,
+		"commitment_utilized", utilized,
+		"commitment_remaining", remaining,
+		"usage_total", total)
+
+	return total, nil
+}
+
+// GetCommitmentUtilized returns the commitment drawn down by the usage invoiced for the billing
+// periods of the commitment term starting before the given time
+func (s *billingService) GetCommitmentUtilized(
+	ctx context.Context,
+	sub *subscription.Subscription,
+	before time.Time,
+) (decimal.Decimal, error) {
+	if !sub.HasTermCommitment() {
+		return decimal.Zero, nil
+	}
+
+	invoiceFilter := types.NewNoLimitInvoiceFilter()
+	invoiceFilter.SubscriptionID = sub.ID
+	invoiceFilter.InvoiceType = types.InvoiceTypeSubscription
+	invoiceFilter.InvoiceStatus = []types.InvoiceStatus{types.InvoiceStatusDraft, types.InvoiceStatusFinalized}
+
+	// The invoices are not filtered on their period, an invoice billing the arrear charges of the last
+	// period of the previous term with the advance charges of the first period straddles the term start.
+	// The line items are attributed to the term by their period start instead.
+	invoices, err := s.InvoiceRepo.List(ctx, invoiceFilter)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	utilized := decimal.Zero
+	for _, inv := range invoices {
+		for _, item := range inv.LineItems {
+			drawdown, ok := item.Metadata["commitment_drawdown"]
+			if !ok || item.PeriodStart == nil {
+				continue
+			}
+			if item.PeriodStart.Before(*sub.CommitmentStartDate) ||
+				!item.PeriodStart.Before(*sub.CommitmentEndDate) ||
+				!item.PeriodStart.Before(before) {
+				continue
+			}
+
+			amount, err := decimal.NewFromString(drawdown)
+			if err != nil {
+				s.Logger.Warnw("invalid commitment drawdown on invoice line item",
+					"invoice_id", inv.ID,
+					"line_item_id", item.ID,
+					"commitment_drawdown", drawdown)
+				continue
+			}
+			utilized = utilized.Add(amount)
+		}
+	}
+
+	return utilized, nil
+}
+
+// PrepareCommitmentInvoiceRequest prepares the invoice request for the current commitment term of the
+// subscription, i.e. the upfront invoice of a prepaid commitment or the true-up invoice of a postpaid
+// commitment. Returns nil if there is nothing to invoice.
+func (s *billingService) PrepareCommitmentInvoiceRequest(
+	ctx context.Context,
+	sub *subscription.Subscription,
+) (*dto.CreateInvoiceRequest, error) {
+	if !sub.HasTermCommitment() {
+		return nil, nil
+	}
+
+	termStart := *sub.CommitmentStartDate
+	termEnd := *sub.CommitmentEndDate
+	commitmentAmount := lo.FromPtr(sub.CommitmentAmount)
+
+	var amount decimal.Decimal
+	var displayName, description string
+	metadata := types.Metadata{
+		"commitment_type":       string(sub.CommitmentType),
+		"commitment_amount":     commitmentAmount.String(),
+		"commitment_start_date": termStart.Format(time.RFC3339),
+		"commitment_end_date":   termEnd.Format(time.RFC3339),
+	}
+
+	switch sub.CommitmentType {
+	case types.CommitmentTypePrepaid:
+		amount = commitmentAmount
+		displayName = "Prepaid Commitment"
+		description = fmt.Sprintf("Prepaid commitment invoice for subscription %s", sub.ID)
+	case types.CommitmentTypePostpaid:
+		utilized, err := s.GetCommitmentUtilized(ctx, sub, termEnd)
+		if err != nil {
+			return nil, err
+		}
+
+		amount = commitmentAmount.Sub(utilized)
+		displayName = "Commitment True-up"
+		description = fmt.Sprintf("Commitment true-up invoice for subscription %s", sub.ID)
+		metadata["commitment_utilized"] = utilized.String()
+	}
+
+	if !amount.IsPositive() {
+		return nil, nil
+	}
+
+	metadata["description"] = displayName
+	lineItem := dto.CreateInvoiceLineItemRequest{
+		DisplayName: lo.ToPtr(displayName),
+		Amount:      amount,
+		Quantity:    decimal.NewFromInt(1),
+		PeriodStart: lo.ToPtr(termStart),
+		PeriodEnd:   lo.ToPtr(termEnd),
+		Metadata:    metadata,
+	}
+
+	req, err := s.CreateInvoiceRequestForCharges(ctx, sub, &BillingCalculationResult{
+		FixedCharges: []dto.CreateInvoiceLineItemRequest{lineItem},
+		UsageCharges: []dto.CreateInvoiceLineItemRequest{},
+		TotalAmount:  amount,
+		Currency:     sub.Currency,
+	}, termStart, termEnd, description, types.Metadata{
+		"commitment_type": string(sub.CommitmentType),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The commitment isn't a charge of a subscription price, so it is billed on a one-off
+	// invoice linked to the subscription
+	req.InvoiceType = types.InvoiceTypeOneOff
+	return req, nil
+}
+
 func (s *billingService) PrepareSubscriptionInvoiceRequest(
 	ctx context.Context,
 	sub *subscription.Subscription,
@@ -1528,7 +2057,7 @@ func (s *billingService) GetCustomerEntitlements(ctx context.Context, customerID
 
 func (s *billingService) GetCustomerUsageSummary(ctx context.Context, customerID string, req *dto.GetCustomerUsageSummaryRequest) (*dto.CustomerUsageSummaryResponse, error) {
 	subscriptionService := NewSubscriptionService(s.ServiceParams)
-	eventService := NewEventService(s.EventRepo, s.MeterRepo, s.EventPublisher, s.Logger, s.Config)
+	eventService := NewEventService(s.EventRepo, s.MeterRepo, s.EventSchemaRepo, s.RejectedEventRepo, s.EventPublisher, s.Logger, s.Config)
 
 	// get customer
 	customer, err := s.CustomerRepo.Get(ctx, customerID)
@@ -1880,7 +2409,7 @@ func (s *billingService) calculateNeverResetUsage(
 	ctx context.Context,
 	sub *subscription.Subscription,
 	item *subscription.SubscriptionLineItem,
-	customer *customer.Customer,
+	customers []*customer.Customer,
 	eventService EventService,
 	periodStart,
 	periodEnd time.Time,
@@ -1896,15 +2425,14 @@ func (s *billingService) calculateNeverResetUsage(
 
 	// Get total cumulative usage from subscription start to line item period end
 	totalUsageRequest := &dto.GetUsageByMeterRequest{
-		MeterID:            item.MeterID,
-		PriceID:            item.PriceID,
-		ExternalCustomerID: customer.ExternalID,
-		StartTime:          sub.StartDate,
-		EndTime:            lineItemPeriodEnd,
-		BillingAnchor:      &sub.BillingAnchor,
+		MeterID:       item.MeterID,
+		PriceID:       item.PriceID,
+		StartTime:     sub.StartDate,
+		EndTime:       lineItemPeriodEnd,
+		BillingAnchor: &sub.BillingAnchor,
 	}
 
-	totalUsageResult, err := eventService.GetUsageByMeter(ctx, totalUsageRequest)
+	totalUsageResult, err := getUsageByMeterForCustomers(ctx, eventService, totalUsageRequest, customers)
 	if err != nil {
 		return decimal.Zero, err
 	}
@@ -1912,14 +2440,13 @@ func (s *billingService) calculateNeverResetUsage(
 	// Get cumulative usage from subscription start to line item period start
 	// This represents usage that was already billed in previous periods
 	previousPeriodUsageRequest := &dto.GetUsageByMeterRequest{
-		MeterID:            item.MeterID,
-		PriceID:            item.PriceID,
-		ExternalCustomerID: customer.ExternalID,
-		StartTime:          sub.StartDate,
-		EndTime:            lineItemPeriodStart,
+		MeterID:   item.MeterID,
+		PriceID:   item.PriceID,
+		StartTime: sub.StartDate,
+		EndTime:   lineItemPeriodStart,
 	}
 
-	previousPeriodUsageResult, err := eventService.GetUsageByMeter(ctx, previousPeriodUsageRequest)
+	previousPeriodUsageResult, err := getUsageByMeterForCustomers(ctx, eventService, previousPeriodUsageRequest, customers)
 	if err != nil {
 		return decimal.Zero, err
 	}
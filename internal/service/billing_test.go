@@ -1468,6 +1468,343 @@ func (s *BillingServiceSuite) TestCalculateUsageChargesWithDailyReset() {
 	s.Equal("daily", lineItems[0].Metadata["usage_reset_period"])
 }
 
+func (s *BillingServiceSuite) TestCalculateUsageChargesWithChargeLimits() {
+	ctx := s.GetContext()
+
+	tests := []struct {
+		name           string
+		minCharge      *decimal.Decimal
+		maxCharge      *decimal.Decimal
+		charges        []*dto.SubscriptionUsageByMetersResponse
+		expectedAmount decimal.Decimal
+		expectedItems  int
+		expectTrueUp   bool
+	}{
+		{
+			name:      "usage above maximum charge is capped",
+			maxCharge: lo.ToPtr(decimal.NewFromInt(15)),
+			charges: []*dto.SubscriptionUsageByMetersResponse{
+				{Price: s.testData.prices.apiCalls, Quantity: 1000, Amount: 20},
+			},
+			expectedAmount: decimal.NewFromInt(15),
+			expectedItems:  1,
+		},
+		{
+			name:      "usage below minimum charge is trued up",
+			minCharge: lo.ToPtr(decimal.NewFromInt(50)),
+			charges: []*dto.SubscriptionUsageByMetersResponse{
+				{Price: s.testData.prices.apiCalls, Quantity: 1000, Amount: 20},
+			},
+			expectedAmount: decimal.NewFromInt(50),
+			expectedItems:  2,
+			expectTrueUp:   true,
+		},
+		{
+			name:           "minimum charge is billed without usage",
+			minCharge:      lo.ToPtr(decimal.NewFromInt(50)),
+			expectedAmount: decimal.NewFromInt(50),
+			expectedItems:  1,
+			expectTrueUp:   true,
+		},
+		{
+			name:      "usage within limits is unchanged",
+			minCharge: lo.ToPtr(decimal.NewFromInt(10)),
+			maxCharge: lo.ToPtr(decimal.NewFromInt(30)),
+			charges: []*dto.SubscriptionUsageByMetersResponse{
+				{Price: s.testData.prices.apiCalls, Quantity: 1000, Amount: 20},
+			},
+			expectedAmount: decimal.NewFromInt(20),
+			expectedItems:  1,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.testData.prices.apiCalls.MinCharge = tt.minCharge
+			s.testData.prices.apiCalls.MaxCharge = tt.maxCharge
+			s.NoError(s.GetStores().PriceRepo.Update(ctx, s.testData.prices.apiCalls))
+
+			usage := &dto.GetUsageBySubscriptionResponse{
+				StartTime: s.testData.subscription.CurrentPeriodStart,
+				EndTime:   s.testData.subscription.CurrentPeriodEnd,
+				Currency:  s.testData.subscription.Currency,
+				Charges:   tt.charges,
+			}
+
+			lineItems, totalAmount, err := s.service.CalculateUsageCharges(
+				ctx,
+				s.testData.subscription,
+				usage,
+				s.testData.subscription.CurrentPeriodStart,
+				s.testData.subscription.CurrentPeriodEnd,
+			)
+			s.NoError(err)
+			s.Len(lineItems, tt.expectedItems)
+			s.True(tt.expectedAmount.Equal(totalAmount), "Expected total %s, got %s", tt.expectedAmount, totalAmount)
+
+			lineItemsTotal := decimal.Zero
+			for _, lineItem := range lineItems {
+				s.False(lineItem.Amount.IsNegative())
+				lineItemsTotal = lineItemsTotal.Add(lineItem.Amount)
+			}
+			s.True(totalAmount.Equal(lineItemsTotal))
+
+			if tt.expectTrueUp {
+				trueUp := lineItems[len(lineItems)-1]
+				s.Equal("true", trueUp.Metadata["is_true_up"])
+				s.Equal(s.testData.prices.apiCalls.ID, lo.FromPtr(trueUp.PriceID))
+			}
+		})
+	}
+}
+
+func (s *BillingServiceSuite) TestMinimumChargeSkipsInactiveLineItems() {
+	ctx := s.GetContext()
+	sub := s.testData.subscription
+
+	s.testData.prices.apiCalls.MinCharge = lo.ToPtr(decimal.NewFromInt(50))
+	s.NoError(s.GetStores().PriceRepo.Update(ctx, s.testData.prices.apiCalls))
+
+	// The line item only starts after the billing period
+	for _, item := range sub.LineItems {
+		if item.PriceID == s.testData.prices.apiCalls.ID {
+			item.StartDate = sub.CurrentPeriodEnd.Add(time.Hour)
+		}
+	}
+
+	usage := &dto.GetUsageBySubscriptionResponse{
+		StartTime: sub.CurrentPeriodStart,
+		EndTime:   sub.CurrentPeriodEnd,
+		Currency:  sub.Currency,
+	}
+
+	lineItems, totalAmount, err := s.service.CalculateUsageCharges(ctx, sub, usage, sub.CurrentPeriodStart, sub.CurrentPeriodEnd)
+	s.NoError(err)
+	s.Empty(lineItems)
+	s.True(totalAmount.IsZero())
+}
+
+func (s *BillingServiceSuite) TestCalculateUsageChargesPoolsChildCustomerUsage() {
+	ctx := s.GetContext()
+	sub := s.testData.subscription
+
+	child := &customer.Customer{
+		ID:               "cust_child",
+		ExternalID:       "ext_cust_child",
+		Name:             "Child Customer",
+		ParentCustomerID: lo.ToPtr(s.testData.customer.ID),
+		BaseModel:        types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().CustomerRepo.Create(ctx, child))
+
+	testFeature := &feature.Feature{
+		ID:        "feat_pooled",
+		Name:      "Pooled Feature",
+		Type:      types.FeatureTypeMetered,
+		MeterID:   s.testData.meters.apiCalls.ID,
+		BaseModel: types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().FeatureRepo.Create(ctx, testFeature))
+	_, err := s.GetStores().EntitlementRepo.Create(ctx, &entitlement.Entitlement{
+		ID:               "ent_pooled",
+		EntityType:       types.ENTITLEMENT_ENTITY_TYPE_PLAN,
+		EntityID:         s.testData.plan.ID,
+		FeatureID:        testFeature.ID,
+		FeatureType:      types.FeatureTypeMetered,
+		IsEnabled:        true,
+		UsageLimit:       lo.ToPtr(int64(1000)),
+		UsageResetPeriod: types.ENTITLEMENT_USAGE_RESET_PERIOD_MONTHLY,
+		BaseModel:        types.GetDefaultBaseModel(ctx),
+	})
+	s.NoError(err)
+
+	// Each customer stays within the entitlement on its own, the pooled usage does not
+	usage := &dto.GetUsageBySubscriptionResponse{
+		StartTime: sub.CurrentPeriodStart,
+		EndTime:   sub.CurrentPeriodEnd,
+		Currency:  sub.Currency,
+		Charges: []*dto.SubscriptionUsageByMetersResponse{
+			{
+				Price:    s.testData.prices.apiCalls,
+				Quantity: 700,
+				Amount:   14,
+				MeterID:  s.testData.meters.apiCalls.ID,
+			},
+			{
+				Price:        s.testData.prices.apiCalls,
+				Quantity:     700,
+				Amount:       14,
+				MeterID:      s.testData.meters.apiCalls.ID,
+				CustomerID:   child.ID,
+				CustomerName: child.Name,
+			},
+		},
+	}
+
+	lineItems, totalAmount, err := s.service.CalculateUsageCharges(ctx, sub, usage, sub.CurrentPeriodStart, sub.CurrentPeriodEnd)
+	s.NoError(err)
+
+	// 400 units over the entitlement at $0.02/unit, split evenly between the customers
+	s.True(decimal.NewFromInt(8).Equal(totalAmount), "expected total 8, got %s", totalAmount)
+	s.Require().Len(lineItems, 2)
+	s.True(decimal.NewFromInt(4).Equal(lineItems[0].Amount))
+	s.True(decimal.NewFromInt(4).Equal(lineItems[1].Amount))
+	s.Empty(lineItems[0].Metadata["customer_id"])
+	s.Equal(child.ID, lineItems[1].Metadata["customer_id"])
+}
+
+func (s *BillingServiceSuite) TestCalculateAllChargesWithTermCommitment() {
+	ctx := s.GetContext()
+	sub := s.testData.subscription
+	termStart := sub.CurrentPeriodStart.AddDate(0, -1, 0)
+	termEnd := termStart.AddDate(1, 0, 0)
+
+	// A previous billing period of the term drew down 10 of the commitment
+	s.NoError(s.GetStores().InvoiceRepo.Create(ctx, &invoice.Invoice{
+		ID:             "inv_previous_period",
+		CustomerID:     sub.CustomerID,
+		SubscriptionID: lo.ToPtr(sub.ID),
+		InvoiceType:    types.InvoiceTypeSubscription,
+		InvoiceStatus:  types.InvoiceStatusFinalized,
+		Currency:       sub.Currency,
+		PeriodStart:    lo.ToPtr(termStart),
+		PeriodEnd:      lo.ToPtr(sub.CurrentPeriodStart),
+		LineItems: []*invoice.InvoiceLineItem{
+			{
+				ID:          "inv_line_previous_period",
+				PriceID:     lo.ToPtr(s.testData.prices.apiCalls.ID),
+				Amount:      decimal.NewFromInt(10),
+				PeriodStart: lo.ToPtr(termStart),
+				PeriodEnd:   lo.ToPtr(sub.CurrentPeriodStart),
+				Metadata:    types.Metadata{"commitment_drawdown": "10"},
+			},
+		},
+		BaseModel: types.GetDefaultBaseModel(ctx),
+	}))
+
+	tests := []struct {
+		name           string
+		commitmentType types.CommitmentType
+		expectedAmount decimal.Decimal
+	}{
+		{
+			// 15 of usage is covered by the remaining commitment of 5 and 10 is billed at 1.5x
+			name:           "prepaid commitment bills only the overage",
+			commitmentType: types.CommitmentTypePrepaid,
+			expectedAmount: decimal.NewFromInt(15),
+		},
+		{
+			name:           "postpaid commitment bills the drawdown and the overage",
+			commitmentType: types.CommitmentTypePostpaid,
+			expectedAmount: decimal.NewFromInt(20),
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			termSub := *sub
+			termSub.CommitmentType = tt.commitmentType
+			termSub.CommitmentAmount = lo.ToPtr(decimal.NewFromInt(15))
+			termSub.OverageFactor = lo.ToPtr(decimal.NewFromFloat(1.5))
+			termSub.CommitmentStartDate = lo.ToPtr(termStart)
+			termSub.CommitmentEndDate = lo.ToPtr(termEnd)
+
+			usage := &dto.GetUsageBySubscriptionResponse{
+				StartTime: sub.CurrentPeriodStart,
+				EndTime:   sub.CurrentPeriodEnd,
+				Currency:  sub.Currency,
+				Charges: []*dto.SubscriptionUsageByMetersResponse{
+					{Price: s.testData.prices.apiCalls, Quantity: 750, Amount: 15},
+				},
+			}
+
+			result, err := s.service.CalculateAllCharges(ctx, &termSub, usage, sub.CurrentPeriodStart, sub.CurrentPeriodEnd)
+			s.NoError(err)
+			s.Require().Len(result.UsageCharges, 1)
+
+			lineItem := result.UsageCharges[0]
+			s.True(tt.expectedAmount.Equal(lineItem.Amount), "Expected amount %s, got %s", tt.expectedAmount, lineItem.Amount)
+			s.Equal("5", lineItem.Metadata["commitment_drawdown"])
+			s.Equal("true", lineItem.Metadata["is_overage"])
+
+			fixedTotal := decimal.Zero
+			for _, charge := range result.FixedCharges {
+				fixedTotal = fixedTotal.Add(charge.Amount)
+			}
+			s.True(result.TotalAmount.Equal(fixedTotal.Add(tt.expectedAmount)))
+		})
+	}
+
+	s.Run("postpaid commitment true-up bills the unused commitment", func() {
+		termSub := *sub
+		termSub.CommitmentType = types.CommitmentTypePostpaid
+		termSub.CommitmentAmount = lo.ToPtr(decimal.NewFromInt(15))
+		termSub.CommitmentStartDate = lo.ToPtr(termStart)
+		termSub.CommitmentEndDate = lo.ToPtr(termEnd)
+
+		req, err := s.service.PrepareCommitmentInvoiceRequest(ctx, &termSub)
+		s.NoError(err)
+		s.Require().NotNil(req)
+		s.Equal(types.InvoiceTypeOneOff, req.InvoiceType)
+		s.Require().Len(req.LineItems, 1)
+		s.True(decimal.NewFromInt(5).Equal(req.LineItems[0].Amount))
+	})
+
+	s.Run("postpaid commitment true-up ignores invoices of the previous term", func() {
+		termSub := *sub
+		termSub.CommitmentType = types.CommitmentTypePostpaid
+		termSub.CommitmentAmount = lo.ToPtr(decimal.NewFromInt(15))
+		termSub.CommitmentStartDate = lo.ToPtr(sub.CurrentPeriodStart)
+		termSub.CommitmentEndDate = lo.ToPtr(sub.CurrentPeriodStart.AddDate(1, 0, 0))
+
+		utilized, err := s.service.GetCommitmentUtilized(ctx, &termSub, *termSub.CommitmentEndDate)
+		s.NoError(err)
+		s.True(utilized.IsZero())
+	})
+
+	s.Run("commitment utilization counts the line items of an invoice straddling the term start", func() {
+		s.NoError(s.GetStores().InvoiceRepo.Create(ctx, &invoice.Invoice{
+			ID:             "inv_straddling_term_start",
+			CustomerID:     sub.CustomerID,
+			SubscriptionID: lo.ToPtr(sub.ID),
+			InvoiceType:    types.InvoiceTypeSubscription,
+			InvoiceStatus:  types.InvoiceStatusFinalized,
+			Currency:       sub.Currency,
+			PeriodStart:    lo.ToPtr(termStart.AddDate(0, -1, 0)),
+			PeriodEnd:      lo.ToPtr(termStart.AddDate(0, 1, 0)),
+			LineItems: []*invoice.InvoiceLineItem{
+				{
+					ID:          "inv_line_previous_term",
+					PriceID:     lo.ToPtr(s.testData.prices.apiCalls.ID),
+					Amount:      decimal.NewFromInt(7),
+					PeriodStart: lo.ToPtr(termStart.AddDate(0, -1, 0)),
+					PeriodEnd:   lo.ToPtr(termStart),
+					Metadata:    types.Metadata{"commitment_drawdown": "7"},
+				},
+				{
+					ID:          "inv_line_term_start",
+					PriceID:     lo.ToPtr(s.testData.prices.apiCalls.ID),
+					Amount:      decimal.NewFromInt(3),
+					PeriodStart: lo.ToPtr(termStart),
+					PeriodEnd:   lo.ToPtr(termStart.AddDate(0, 1, 0)),
+					Metadata:    types.Metadata{"commitment_drawdown": "3"},
+				},
+			},
+			BaseModel: types.GetDefaultBaseModel(ctx),
+		}))
+
+		termSub := *sub
+		termSub.CommitmentType = types.CommitmentTypePostpaid
+		termSub.CommitmentAmount = lo.ToPtr(decimal.NewFromInt(15))
+		termSub.CommitmentStartDate = lo.ToPtr(termStart)
+		termSub.CommitmentEndDate = lo.ToPtr(termEnd)
+
+		utilized, err := s.service.GetCommitmentUtilized(ctx, &termSub, termEnd)
+		s.NoError(err)
+		s.True(decimal.NewFromInt(13).Equal(utilized), "expected 13, got %s", utilized)
+	})
+}
+
 func (s *BillingServiceSuite) TestCalculateUsageChargesWithBucketedMaxAggregation() {
 	ctx := s.GetContext()
 
@@ -1889,7 +2226,7 @@ func (s *BillingServiceSuite) TestCalculateNeverResetUsage() {
 			}
 
 			// Call the function under test using the real event service
-			eventService := NewEventService(s.GetStores().EventRepo, s.GetStores().MeterRepo, s.GetPublisher(), s.GetLogger(), s.GetConfig())
+			eventService := NewEventService(s.GetStores().EventRepo, s.GetStores().MeterRepo, s.GetStores().EventSchemaRepo, s.GetStores().RejectedEventRepo, s.GetPublisher(), s.GetLogger(), s.GetConfig())
 
 			// Create mock events in the event store for our test data
 			for _, event := range tt.totalUsageEvents {
@@ -1949,7 +2286,7 @@ func (s *BillingServiceSuite) TestCalculateNeverResetUsage() {
 				ctx,
 				testSubscription,
 				lineItem,
-				s.testData.customer,
+				[]*customer.Customer{s.testData.customer},
 				eventService,
 				tt.periodStart,
 				tt.periodEnd,
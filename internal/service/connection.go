@@ -105,6 +105,28 @@ func (s *connectionService) encryptMetadata(encryptedSecretData types.Connection
 			}
 		}
 
+	case types.SecretProviderRazorpay:
+		if encryptedSecretData.Razorpay != nil {
+			encryptedKeyID, err := s.encryptionService.Encrypt(encryptedSecretData.Razorpay.KeyID)
+			if err != nil {
+				return types.ConnectionMetadata{}, err
+			}
+			encryptedKeySecret, err := s.encryptionService.Encrypt(encryptedSecretData.Razorpay.KeySecret)
+			if err != nil {
+				return types.ConnectionMetadata{}, err
+			}
+			encryptedWebhookSecret, err := s.encryptionService.Encrypt(encryptedSecretData.Razorpay.WebhookSecret)
+			if err != nil {
+				return types.ConnectionMetadata{}, err
+			}
+
+			encryptedMetadata.Razorpay = &types.RazorpayConnectionMetadata{
+				KeyID:         encryptedKeyID,
+				KeySecret:     encryptedKeySecret,
+				WebhookSecret: encryptedWebhookSecret,
+			}
+		}
+
 	default:
 		// For other providers or unknown types, use generic format
 		if encryptedSecretData.Generic != nil {
@@ -202,6 +224,28 @@ func (s *connectionService) decryptMetadata(encryptedSecretData types.Connection
 			}
 		}
 
+	case types.SecretProviderRazorpay:
+		if encryptedSecretData.Razorpay != nil {
+			decryptedKeyID, err := s.encryptionService.Decrypt(encryptedSecretData.Razorpay.KeyID)
+			if err != nil {
+				return types.ConnectionMetadata{}, err
+			}
+			decryptedKeySecret, err := s.encryptionService.Decrypt(encryptedSecretData.Razorpay.KeySecret)
+			if err != nil {
+				return types.ConnectionMetadata{}, err
+			}
+			decryptedWebhookSecret, err := s.encryptionService.Decrypt(encryptedSecretData.Razorpay.WebhookSecret)
+			if err != nil {
+				return types.ConnectionMetadata{}, err
+			}
+
+			decryptedMetadata.Razorpay = &types.RazorpayConnectionMetadata{
+				KeyID:         decryptedKeyID,
+				KeySecret:     decryptedKeySecret,
+				WebhookSecret: decryptedWebhookSecret,
+			}
+		}
+
 	default:
 		// For other providers or unknown types, use generic format
 		if encryptedSecretData.Generic != nil {
@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"time"
 
 	"github.com/flexprice/flexprice/internal/api/dto"
@@ -10,6 +12,7 @@ import (
 	"github.com/flexprice/flexprice/internal/domain/invoice"
 	ierr "github.com/flexprice/flexprice/internal/errors"
 	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
 	"github.com/shopspring/decimal"
 )
 
@@ -287,10 +290,20 @@ func (s *couponApplicationService) ApplyCouponsOnInvoiceWithLineItems(ctx contex
 		"line_item_coupon_count", len(lineItemCoupons),
 		"original_total", inv.Total)
 
+	// Drop the coupons whose eligibility rules this invoice does not satisfy
+	totalCoupons := len(invoiceCoupons) + len(lineItemCoupons)
+	invoiceCoupons, lineItemCoupons, skipped, err := s.filterEligibleCoupons(ctx, inv, invoiceCoupons, lineItemCoupons)
+	if err != nil {
+		return nil, err
+	}
+	if err := recordSkippedCoupons(inv, skipped); err != nil {
+		return nil, err
+	}
+
 	var result *CouponCalculationResult
 
 	// Use transaction for atomic operations
-	err := s.DB.WithTx(ctx, func(txCtx context.Context) error {
+	err = s.DB.WithTx(ctx, func(txCtx context.Context) error {
 		totalDiscount := decimal.Zero
 		applicationRequests := make([]dto.CreateCouponApplicationRequest, 0, len(invoiceCoupons)+len(lineItemCoupons))
 
@@ -430,12 +443,13 @@ func (s *couponApplicationService) ApplyCouponsOnInvoiceWithLineItems(ctx contex
 			AppliedCoupons:      appliedCoupons,
 			Currency:            inv.Currency,
 			Metadata: map[string]interface{}{
-				"total_coupons_processed":    len(invoiceCoupons) + len(lineItemCoupons),
+				"total_coupons_processed":    totalCoupons,
 				"successful_applications":    len(appliedCoupons),
-				"validation_failures":        (len(invoiceCoupons) + len(lineItemCoupons)) - len(appliedCoupons),
+				"validation_failures":        totalCoupons - len(appliedCoupons),
 				"invoice_level_coupons":      len(invoiceCoupons),
 				"line_item_level_coupons":    len(lineItemCoupons),
 				"line_item_discount_details": lineItemDiscounts,
+				"skipped_coupons":            skipped,
 			},
 		}
 
@@ -454,6 +468,141 @@ func (s *couponApplicationService) ApplyCouponsOnInvoiceWithLineItems(ctx contex
 	return result, nil
 }
 
+// skippedCoupon is a coupon that was not applied on an invoice because the invoice did not satisfy its
+// eligibility rules, the skipped coupons are recorded in the invoice metadata
+type skippedCoupon struct {
+	CouponID   string                          `json:"coupon_id"`
+	LineItemID string                          `json:"line_item_id,omitempty"`
+	Code       types.CouponValidationErrorCode `json:"code"`
+	Message    string                          `json:"message"`
+}
+
+// filterEligibleCoupons evaluates the eligibility rules of the coupons against the invoice, or against the
+// targeted line item for line item coupons, and returns the coupons that pass and the skipped ones. Errors
+// while evaluating the rules are returned so that the invoice is not created without its coupons.
+func (s *couponApplicationService) filterEligibleCoupons(ctx context.Context, inv *invoice.Invoice, invoiceCoupons []dto.InvoiceCoupon, lineItemCoupons []dto.InvoiceLineItemCoupon) ([]dto.InvoiceCoupon, []dto.InvoiceLineItemCoupon, []skippedCoupon, error) {
+	couponIDs := make([]string, 0, len(invoiceCoupons)+len(lineItemCoupons))
+	for _, c := range invoiceCoupons {
+		couponIDs = append(couponIDs, c.CouponID)
+	}
+	for _, c := range lineItemCoupons {
+		couponIDs = append(couponIDs, c.CouponID)
+	}
+
+	filter := types.NewNoLimitCouponFilter()
+	filter.CouponIDs = lo.Uniq(couponIDs)
+	coupons, err := s.CouponRepo.List(ctx, filter)
+	if err != nil {
+		return nil, nil, nil, ierr.WithError(err).
+			WithHint("Failed to get coupons for rule validation").
+			Mark(ierr.ErrDatabase)
+	}
+	couponsByID := lo.KeyBy(coupons, func(c *coupon.Coupon) string {
+		return c.ID
+	})
+
+	validationService := NewCouponValidationService(s.ServiceParams)
+	skipped := make([]skippedCoupon, 0)
+	isEligible := func(couponID string, couponAssociationID *string, lineItemID string, ruleCtx *CouponRuleContext) (bool, error) {
+		c, ok := couponsByID[couponID]
+		if !ok || c.Rules == nil || len(*c.Rules) == 0 {
+			return true, nil
+		}
+
+		// coupons of a subscription are evaluated as of their redemption
+		if couponAssociationID != nil {
+			association, err := s.CouponAssociationRepo.Get(ctx, *couponAssociationID)
+			if err != nil {
+				return false, err
+			}
+			redeemedCtx := *ruleCtx
+			redeemedCtx.RedeemedAt = lo.ToPtr(association.CreatedAt)
+			ruleCtx = &redeemedCtx
+		}
+
+		err := validationService.ValidateCouponRules(ctx, c, ruleCtx)
+		if err == nil {
+			return true, nil
+		}
+
+		var validationErr *CouponValidationError
+		if !errors.As(err, &validationErr) || validationErr.Code == types.CouponValidationErrorCodeDatabaseError {
+			return false, ierr.WithError(err).
+				WithHint("Failed to evaluate the coupon rules").
+				WithReportableDetails(map[string]interface{}{
+					"invoice_id": inv.ID,
+					"coupon_id":  couponID,
+				}).
+				Mark(ierr.ErrDatabase)
+		}
+
+		s.Logger.Infow("coupon rules not satisfied, skipping coupon",
+			"invoice_id", inv.ID,
+			"coupon_id", couponID,
+			"code", validationErr.Code)
+		skipped = append(skipped, skippedCoupon{
+			CouponID:   couponID,
+			LineItemID: lineItemID,
+			Code:       validationErr.Code,
+			Message:    validationErr.Message,
+		})
+		return false, nil
+	}
+
+	invoiceRuleCtx := NewInvoiceCouponRuleContext(inv, nil)
+	eligibleInvoiceCoupons := make([]dto.InvoiceCoupon, 0, len(invoiceCoupons))
+	for _, c := range invoiceCoupons {
+		eligible, err := isEligible(c.CouponID, c.CouponAssociationID, "", invoiceRuleCtx)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if eligible {
+			eligibleInvoiceCoupons = append(eligibleInvoiceCoupons, c)
+		}
+	}
+
+	eligibleLineItemCoupons := make([]dto.InvoiceLineItemCoupon, 0, len(lineItemCoupons))
+	for _, c := range lineItemCoupons {
+		// Line item coupons reference their line item by price_id, unmatched coupons are skipped when applied
+		lineItem, ok := lo.Find(inv.LineItems, func(li *invoice.InvoiceLineItem) bool {
+			return li.PriceID != nil && *li.PriceID == c.LineItemID
+		})
+		if !ok {
+			eligibleLineItemCoupons = append(eligibleLineItemCoupons, c)
+			continue
+		}
+
+		eligible, err := isEligible(c.CouponID, c.CouponAssociationID, lineItem.ID, NewInvoiceCouponRuleContext(inv, lineItem))
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if eligible {
+			eligibleLineItemCoupons = append(eligibleLineItemCoupons, c)
+		}
+	}
+
+	return eligibleInvoiceCoupons, eligibleLineItemCoupons, skipped, nil
+}
+
+// recordSkippedCoupons lists the skipped coupons in the invoice metadata, the invoice is saved by the caller
+func recordSkippedCoupons(inv *invoice.Invoice, skipped []skippedCoupon) error {
+	if len(skipped) == 0 {
+		return nil
+	}
+
+	value, err := json.Marshal(skipped)
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to record the skipped coupons").
+			Mark(ierr.ErrInternal)
+	}
+	if inv.Metadata == nil {
+		inv.Metadata = types.Metadata{}
+	}
+	inv.Metadata[types.InvoiceMetadataSkippedCoupons] = string(value)
+	return nil
+}
+
 // ApplyCouponsOnInvoice applies coupons to an invoice with optimized batch processing
 func (s *couponApplicationService) ApplyCouponsOnInvoice(ctx context.Context, inv *invoice.Invoice, invoiceCoupons []dto.InvoiceCoupon) (*CouponCalculationResult, error) {
 	if len(invoiceCoupons) == 0 {
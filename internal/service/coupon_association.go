@@ -5,8 +5,10 @@ import (
 
 	"github.com/flexprice/flexprice/internal/api/dto"
 	"github.com/flexprice/flexprice/internal/domain/coupon_association"
+	"github.com/flexprice/flexprice/internal/domain/promotion_code"
 	ierr "github.com/flexprice/flexprice/internal/errors"
 	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
 )
 
 type CouponAssociationService interface {
@@ -15,6 +17,7 @@ type CouponAssociationService interface {
 	DeleteCouponAssociation(ctx context.Context, id string) error
 	GetCouponAssociationsBySubscription(ctx context.Context, subscriptionID string) ([]*dto.CouponAssociationResponse, error)
 	GetBySubscriptionForLineItems(ctx context.Context, subscriptionID string) ([]*dto.CouponAssociationResponse, error)
+	// ApplyCouponToSubscription applies coupons to a subscription. Each entry is either a coupon ID or a promotion code.
 	ApplyCouponToSubscription(ctx context.Context, couponIDs []string, subscriptionID string) error
 
 	// Line item coupon association methods
@@ -45,12 +48,19 @@ func (s *couponAssociationService) CreateCouponAssociation(ctx context.Context,
 
 		// Create the coupon association object properly
 		baseModel := types.GetDefaultBaseModel(txCtx)
+		metadata := req.Metadata
+		if req.PromotionCodeID != nil {
+			// Record the promotion code the coupon was redeemed through
+			metadata = lo.Assign(map[string]string{}, req.Metadata, map[string]string{
+				"promotion_code_id": *req.PromotionCodeID,
+			})
+		}
 		ca := &coupon_association.CouponAssociation{
 			ID:                     types.GenerateUUIDWithPrefix(types.UUID_PREFIX_COUPON_ASSOCIATION),
 			CouponID:               req.CouponID,
 			SubscriptionID:         req.SubscriptionID,
 			SubscriptionLineItemID: req.SubscriptionLineItemID,
-			Metadata:               req.Metadata,
+			Metadata:               metadata,
 			BaseModel:              baseModel,
 			EnvironmentID:          types.GetEnvironmentID(txCtx),
 		}
@@ -70,9 +80,22 @@ func (s *couponAssociationService) CreateCouponAssociation(ctx context.Context,
 				Mark(ierr.ErrInternal)
 		}
 
+		if req.PromotionCodeID != nil {
+			if err := s.PromotionCodeRepo.IncrementRedemptions(txCtx, *req.PromotionCodeID); err != nil {
+				// a concurrent redemption took the last one since the promotion code was validated
+				if ierr.IsValidation(err) {
+					return err
+				}
+				return ierr.WithError(err).
+					WithHint("Failed to increment promotion code redemptions").
+					Mark(ierr.ErrInternal)
+			}
+		}
+
 		s.Logger.Infow("created coupon association",
 			"association_id", ca.ID,
 			"coupon_id", req.CouponID,
+			"promotion_code_id", req.PromotionCodeID,
 			"subscription_id", req.SubscriptionID,
 			"subscription_line_item_id", req.SubscriptionLineItemID,
 			"created_by", types.GetUserID(txCtx))
@@ -147,27 +170,33 @@ func (s *couponAssociationService) ApplyCouponToSubscription(ctx context.Context
 			Mark(ierr.ErrValidation)
 	}
 
+	redemptions, err := s.resolveCouponRedemptions(ctx, couponIDs, subscriptionID)
+	if err != nil {
+		return err
+	}
+
 	validationService := NewCouponValidationService(s.ServiceParams)
 
 	// Validate each coupon
-	for _, couponID := range couponIDs {
-		if err := validationService.ValidateCoupon(ctx, couponID, &subscriptionID); err != nil {
+	for _, redemption := range redemptions {
+		if err := validationService.ValidateCoupon(ctx, redemption.couponID, &subscriptionID); err != nil {
 			return ierr.WithError(err).
 				WithHint("Coupon validation failed").
 				WithReportableDetails(map[string]interface{}{
-					"coupon_id":       couponID,
+					"coupon_id":       redemption.couponID,
 					"subscription_id": subscriptionID,
 				}).
 				Mark(ierr.ErrValidation)
 		}
 	}
 
-	for _, couponID := range couponIDs {
+	for _, redemption := range redemptions {
 		// Create coupon association request
 		req := dto.CreateCouponAssociationRequest{
-			CouponID:       couponID,
-			SubscriptionID: subscriptionID,
-			Metadata:       map[string]string{},
+			CouponID:        redemption.couponID,
+			PromotionCodeID: redemption.promotionCodeID,
+			SubscriptionID:  subscriptionID,
+			Metadata:        map[string]string{},
 		}
 
 		// Create the coupon association
@@ -199,9 +228,15 @@ func (s *couponAssociationService) ApplyCouponToSubscriptionLineItem(ctx context
 			Mark(ierr.ErrValidation)
 	}
 
-	for _, couponID := range couponIDs {
+	redemptions, err := s.resolveCouponRedemptions(ctx, couponIDs, subscriptionID)
+	if err != nil {
+		return err
+	}
+
+	for _, redemption := range redemptions {
 		req := dto.CreateCouponAssociationRequest{
-			CouponID:               couponID,
+			CouponID:               redemption.couponID,
+			PromotionCodeID:        redemption.promotionCodeID,
 			SubscriptionID:         subscriptionID,
 			SubscriptionLineItemID: &lineItemID,
 			Metadata:               map[string]string{},
@@ -216,6 +251,77 @@ func (s *couponAssociationService) ApplyCouponToSubscriptionLineItem(ctx context
 	return nil
 }
 
+// couponRedemption is a coupon redeemed on a subscription, either directly or through a promotion code
+type couponRedemption struct {
+	couponID        string
+	promotionCodeID *string
+}
+
+// resolveCouponRedemptions resolves each entry to the coupon it redeems. Entries that are not coupon IDs
+// are looked up as promotion codes and validated for the customer of the subscription.
+func (s *couponAssociationService) resolveCouponRedemptions(ctx context.Context, couponIDsOrCodes []string, subscriptionID string) ([]couponRedemption, error) {
+	validationService := NewCouponValidationService(s.ServiceParams)
+	redemptions := make([]couponRedemption, 0, len(couponIDsOrCodes))
+
+	// Promotion codes already resolved in this request, so that repeating a code counts against its limit
+	promotionCodes := make(map[string]*promotion_code.PromotionCode)
+	var customerID string
+
+	for _, entry := range couponIDsOrCodes {
+		if _, err := s.CouponRepo.Get(ctx, entry); err == nil {
+			redemptions = append(redemptions, couponRedemption{couponID: entry})
+			continue
+		} else if !ierr.IsNotFound(err) {
+			return nil, err
+		}
+
+		code := types.NormalizePromotionCode(entry)
+		pc, ok := promotionCodes[code]
+		if !ok {
+			var err error
+			pc, err = s.PromotionCodeRepo.GetByCode(ctx, code)
+			if err != nil {
+				if ierr.IsNotFound(err) {
+					return nil, ierr.NewError("coupon or promotion code not found").
+						WithHintf("No coupon or promotion code matches %s", entry).
+						WithReportableDetails(map[string]interface{}{
+							"coupon": entry,
+						}).
+						Mark(ierr.ErrNotFound)
+				}
+				return nil, err
+			}
+			promotionCodes[code] = pc
+		}
+
+		if customerID == "" {
+			sub, err := s.SubRepo.Get(ctx, subscriptionID)
+			if err != nil {
+				return nil, err
+			}
+			customerID = sub.CustomerID
+		}
+
+		if err := validationService.ValidatePromotionCode(pc, customerID); err != nil {
+			return nil, ierr.WithError(err).
+				WithHint("Promotion code validation failed").
+				WithReportableDetails(map[string]interface{}{
+					"promotion_code":  code,
+					"subscription_id": subscriptionID,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		pc.TotalRedemptions++
+
+		redemptions = append(redemptions, couponRedemption{
+			couponID:        pc.CouponID,
+			promotionCodeID: lo.ToPtr(pc.ID),
+		})
+	}
+
+	return redemptions, nil
+}
+
 // Helper method to convert domain models to DTOs
 func (s *couponAssociationService) toCouponAssociationResponse(ca *coupon_association.CouponAssociation) *dto.CouponAssociationResponse {
 	return &dto.CouponAssociationResponse{
@@ -0,0 +1,337 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/domain/coupon"
+	"github.com/flexprice/flexprice/internal/domain/coupon_association"
+	"github.com/flexprice/flexprice/internal/domain/invoice"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// CouponRuleContext describes what a coupon is being redeemed on, for evaluating the coupon rules
+type CouponRuleContext struct {
+	CustomerID string
+	// SubscriptionID is empty for coupons applied on one-off invoices
+	SubscriptionID string
+	PlanIDs        []string
+	PriceIDs       []string
+	MeterIDs       []string
+	// InvoiceAmount is nil when the coupon is not applied on an invoice yet
+	InvoiceAmount *decimal.Decimal
+	// RedeemedAt is when the coupon was associated with the subscription, the rules about the history of
+	// the customer are evaluated as of that time. It is nil while the coupon is being redeemed.
+	RedeemedAt *time.Time
+}
+
+// NewSubscriptionCouponRuleContext builds the rule context of a coupon redeemed on a subscription
+func NewSubscriptionCouponRuleContext(sub *subscription.Subscription, lineItems []*subscription.SubscriptionLineItem) *CouponRuleContext {
+	ruleCtx := &CouponRuleContext{
+		CustomerID:     sub.CustomerID,
+		SubscriptionID: sub.ID,
+		PlanIDs:        []string{sub.PlanID},
+	}
+
+	for _, li := range lineItems {
+		ruleCtx.PriceIDs = append(ruleCtx.PriceIDs, li.PriceID)
+		if li.MeterID != "" {
+			ruleCtx.MeterIDs = append(ruleCtx.MeterIDs, li.MeterID)
+		}
+	}
+
+	return ruleCtx
+}
+
+// NewInvoiceCouponRuleContext builds the rule context of a coupon applied on an invoice, or on a
+// single line item of the invoice when lineItem is set
+func NewInvoiceCouponRuleContext(inv *invoice.Invoice, lineItem *invoice.InvoiceLineItem) *CouponRuleContext {
+	ruleCtx := &CouponRuleContext{
+		CustomerID:     inv.CustomerID,
+		SubscriptionID: lo.FromPtr(inv.SubscriptionID),
+		InvoiceAmount:  lo.ToPtr(inv.Subtotal),
+	}
+
+	lineItems := inv.LineItems
+	if lineItem != nil {
+		lineItems = []*invoice.InvoiceLineItem{lineItem}
+	}
+
+	for _, li := range lineItems {
+		if lo.FromPtr(li.EntityType) == string(types.SubscriptionLineItemEntityTypePlan) && li.EntityID != nil {
+			ruleCtx.PlanIDs = append(ruleCtx.PlanIDs, *li.EntityID)
+		}
+		if li.PriceID != nil {
+			ruleCtx.PriceIDs = append(ruleCtx.PriceIDs, *li.PriceID)
+		}
+		if li.MeterID != nil {
+			ruleCtx.MeterIDs = append(ruleCtx.MeterIDs, *li.MeterID)
+		}
+	}
+	ruleCtx.PlanIDs = lo.Uniq(ruleCtx.PlanIDs)
+
+	return ruleCtx
+}
+
+// ValidateCouponRules evaluates the eligibility rules of a coupon against what it is redeemed on
+func (s *couponValidationService) ValidateCouponRules(ctx context.Context, c *coupon.Coupon, ruleCtx *CouponRuleContext) error {
+	if c.Rules == nil || len(*c.Rules) == 0 || ruleCtx == nil {
+		return nil
+	}
+
+	rules, err := types.ParseCouponRules(*c.Rules)
+	if err != nil {
+		return &CouponValidationError{
+			Code:    types.CouponValidationErrorCodeInvalidRules,
+			Message: "Coupon rules are invalid",
+			Details: map[string]interface{}{
+				"coupon_id": c.ID,
+				"error":     err.Error(),
+			},
+		}
+	}
+
+	s.Logger.Debugw("validating coupon rules",
+		"coupon_id", c.ID,
+		"customer_id", ruleCtx.CustomerID,
+		"subscription_id", ruleCtx.SubscriptionID)
+
+	if len(rules.PlanIDs) > 0 && len(lo.Intersect(rules.PlanIDs, ruleCtx.PlanIDs)) == 0 {
+		return &CouponValidationError{
+			Code:    types.CouponValidationErrorCodePlanNotEligible,
+			Message: "Coupon is not valid for this plan",
+			Details: map[string]interface{}{
+				"coupon_id":         c.ID,
+				"eligible_plan_ids": rules.PlanIDs,
+				"plan_ids":          ruleCtx.PlanIDs,
+			},
+		}
+	}
+
+	if len(rules.PriceIDs) > 0 && len(lo.Intersect(rules.PriceIDs, ruleCtx.PriceIDs)) == 0 {
+		return &CouponValidationError{
+			Code:    types.CouponValidationErrorCodePriceNotEligible,
+			Message: "Coupon is not valid for these prices",
+			Details: map[string]interface{}{
+				"coupon_id":          c.ID,
+				"eligible_price_ids": rules.PriceIDs,
+				"price_ids":          ruleCtx.PriceIDs,
+			},
+		}
+	}
+
+	if len(rules.FeatureIDs) > 0 {
+		if err := s.validateFeatureRule(ctx, c, rules, ruleCtx); err != nil {
+			return err
+		}
+	}
+
+	if rules.MinInvoiceAmount != nil && ruleCtx.InvoiceAmount != nil &&
+		ruleCtx.InvoiceAmount.LessThan(*rules.MinInvoiceAmount) {
+		return &CouponValidationError{
+			Code:    types.CouponValidationErrorCodeMinInvoiceAmountNotMet,
+			Message: "Invoice amount is below the minimum amount required by the coupon",
+			Details: map[string]interface{}{
+				"coupon_id":          c.ID,
+				"min_invoice_amount": rules.MinInvoiceAmount,
+				"invoice_amount":     ruleCtx.InvoiceAmount,
+			},
+		}
+	}
+
+	if ruleCtx.CustomerID == "" {
+		return nil
+	}
+
+	if len(rules.CustomerMetadata) > 0 {
+		if err := s.validateCustomerMetadataRule(ctx, c, rules, ruleCtx); err != nil {
+			return err
+		}
+	}
+
+	if rules.FirstTimeCustomerOnly {
+		if err := s.validateFirstTimeCustomerRule(ctx, c, ruleCtx); err != nil {
+			return err
+		}
+	}
+
+	if rules.MaxRedemptionsPerCustomer != nil {
+		if err := s.validateCustomerRedemptionRule(ctx, c, rules, ruleCtx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateFeatureRule checks that the coupon is redeemed on a price charging one of the eligible features
+func (s *couponValidationService) validateFeatureRule(ctx context.Context, c *coupon.Coupon, rules *types.CouponRules, ruleCtx *CouponRuleContext) error {
+	featureIDs := make([]string, 0)
+	if len(ruleCtx.MeterIDs) > 0 {
+		filter := types.NewNoLimitFeatureFilter()
+		filter.MeterIDs = lo.Uniq(ruleCtx.MeterIDs)
+		features, err := s.FeatureRepo.List(ctx, filter)
+		if err != nil {
+			return &CouponValidationError{
+				Code:    types.CouponValidationErrorCodeDatabaseError,
+				Message: "Failed to get features for coupon rule validation",
+				Details: map[string]interface{}{
+					"coupon_id": c.ID,
+					"error":     err.Error(),
+				},
+			}
+		}
+		for _, f := range features {
+			featureIDs = append(featureIDs, f.ID)
+		}
+	}
+
+	if len(lo.Intersect(rules.FeatureIDs, featureIDs)) == 0 {
+		return &CouponValidationError{
+			Code:    types.CouponValidationErrorCodeFeatureNotEligible,
+			Message: "Coupon is not valid for these features",
+			Details: map[string]interface{}{
+				"coupon_id":            c.ID,
+				"eligible_feature_ids": rules.FeatureIDs,
+				"feature_ids":          featureIDs,
+			},
+		}
+	}
+
+	return nil
+}
+
+// validateCustomerMetadataRule checks that the customer metadata contains all the required key value pairs
+func (s *couponValidationService) validateCustomerMetadataRule(ctx context.Context, c *coupon.Coupon, rules *types.CouponRules, ruleCtx *CouponRuleContext) error {
+	cust, err := s.CustomerRepo.Get(ctx, ruleCtx.CustomerID)
+	if err != nil {
+		return &CouponValidationError{
+			Code:    types.CouponValidationErrorCodeDatabaseError,
+			Message: "Failed to get customer for coupon rule validation",
+			Details: map[string]interface{}{
+				"coupon_id":   c.ID,
+				"customer_id": ruleCtx.CustomerID,
+				"error":       err.Error(),
+			},
+		}
+	}
+
+	for key, value := range rules.CustomerMetadata {
+		if actual, ok := cust.Metadata[key]; !ok || actual != value {
+			return &CouponValidationError{
+				Code:    types.CouponValidationErrorCodeCustomerNotEligible,
+				Message: "Customer is not eligible for this coupon",
+				Details: map[string]interface{}{
+					"coupon_id":      c.ID,
+					"customer_id":    ruleCtx.CustomerID,
+					"metadata_key":   key,
+					"expected_value": value,
+				},
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateFirstTimeCustomerRule checks that the customer has no finalized invoices other than the ones of
+// the subscription the coupon is redeemed on. Once redeemed only the invoices finalized before the
+// redemption count, so the coupon keeps applying on the later invoices of the customer.
+func (s *couponValidationService) validateFirstTimeCustomerRule(ctx context.Context, c *coupon.Coupon, ruleCtx *CouponRuleContext) error {
+	filter := types.NewNoLimitInvoiceFilter()
+	filter.CustomerID = ruleCtx.CustomerID
+	filter.InvoiceStatus = []types.InvoiceStatus{types.InvoiceStatusFinalized}
+
+	invoices, err := s.InvoiceRepo.List(ctx, filter)
+	if err != nil {
+		return &CouponValidationError{
+			Code:    types.CouponValidationErrorCodeDatabaseError,
+			Message: "Failed to list invoices for coupon rule validation",
+			Details: map[string]interface{}{
+				"coupon_id":   c.ID,
+				"customer_id": ruleCtx.CustomerID,
+				"error":       err.Error(),
+			},
+		}
+	}
+
+	previousInvoices := lo.Filter(invoices, func(inv *invoice.Invoice, _ int) bool {
+		if ruleCtx.RedeemedAt != nil {
+			return inv.FinalizedAt != nil && inv.FinalizedAt.Before(*ruleCtx.RedeemedAt)
+		}
+		return ruleCtx.SubscriptionID == "" || lo.FromPtr(inv.SubscriptionID) != ruleCtx.SubscriptionID
+	})
+	if len(previousInvoices) > 0 {
+		return &CouponValidationError{
+			Code:    types.CouponValidationErrorCodeFirstTimeCustomerOnly,
+			Message: "Coupon is only valid for first-time customers",
+			Details: map[string]interface{}{
+				"coupon_id":         c.ID,
+				"customer_id":       ruleCtx.CustomerID,
+				"previous_invoices": len(previousInvoices),
+			},
+		}
+	}
+
+	return nil
+}
+
+// validateCustomerRedemptionRule checks that the customer has not redeemed the coupon more often than allowed.
+// A subscription that already redeemed the coupon stays eligible, as its redemption is already counted.
+func (s *couponValidationService) validateCustomerRedemptionRule(ctx context.Context, c *coupon.Coupon, rules *types.CouponRules, ruleCtx *CouponRuleContext) error {
+	filter := types.NewNoLimitSubscriptionFilter()
+	filter.CustomerID = ruleCtx.CustomerID
+
+	subs, err := s.SubRepo.List(ctx, filter)
+	if err != nil {
+		return &CouponValidationError{
+			Code:    types.CouponValidationErrorCodeDatabaseError,
+			Message: "Failed to list subscriptions for coupon rule validation",
+			Details: map[string]interface{}{
+				"coupon_id":   c.ID,
+				"customer_id": ruleCtx.CustomerID,
+				"error":       err.Error(),
+			},
+		}
+	}
+
+	subscriptionIDs := lo.Map(subs, func(sub *subscription.Subscription, _ int) string {
+		return sub.ID
+	})
+	associations, err := s.CouponAssociationRepo.GetBySubscriptionsAndCoupon(ctx, subscriptionIDs, c.ID)
+	if err != nil {
+		return &CouponValidationError{
+			Code:    types.CouponValidationErrorCodeDatabaseError,
+			Message: "Failed to count customer redemptions for coupon rule validation",
+			Details: map[string]interface{}{
+				"coupon_id":   c.ID,
+				"customer_id": ruleCtx.CustomerID,
+				"error":       err.Error(),
+			},
+		}
+	}
+
+	if ruleCtx.SubscriptionID != "" && lo.ContainsBy(associations, func(ca *coupon_association.CouponAssociation) bool {
+		return ca.SubscriptionID == ruleCtx.SubscriptionID
+	}) {
+		return nil
+	}
+
+	if len(associations) >= *rules.MaxRedemptionsPerCustomer {
+		return &CouponValidationError{
+			Code:    types.CouponValidationErrorCodeCustomerRedemptionLimitReached,
+			Message: "Customer has reached the maximum redemptions of this coupon",
+			Details: map[string]interface{}{
+				"coupon_id":                    c.ID,
+				"customer_id":                  ruleCtx.CustomerID,
+				"max_redemptions_per_customer": *rules.MaxRedemptionsPerCustomer,
+				"customer_redemptions":         len(associations),
+			},
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,215 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/domain/coupon"
+	"github.com/flexprice/flexprice/internal/domain/coupon_association"
+	"github.com/flexprice/flexprice/internal/domain/customer"
+	"github.com/flexprice/flexprice/internal/domain/invoice"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	"github.com/flexprice/flexprice/internal/testutil"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type CouponRulesSuite struct {
+	testutil.BaseServiceTestSuite
+	service  CouponValidationService
+	customer *customer.Customer
+	sub      *subscription.Subscription
+}
+
+func TestCouponRules(t *testing.T) {
+	suite.Run(t, new(CouponRulesSuite))
+}
+
+func (s *CouponRulesSuite) SetupTest() {
+	s.BaseServiceTestSuite.SetupTest()
+	s.service = NewCouponValidationService(ServiceParams{
+		Logger:                s.GetLogger(),
+		Config:                s.GetConfig(),
+		DB:                    s.GetDB(),
+		CustomerRepo:          s.GetStores().CustomerRepo,
+		SubRepo:               s.GetStores().SubscriptionRepo,
+		InvoiceRepo:           s.GetStores().InvoiceRepo,
+		FeatureRepo:           s.GetStores().FeatureRepo,
+		CouponRepo:            s.GetStores().CouponRepo,
+		CouponAssociationRepo: s.GetStores().CouponAssociationRepo,
+		CouponApplicationRepo: s.GetStores().CouponApplicationRepo,
+	})
+
+	ctx := s.GetContext()
+	s.customer = &customer.Customer{
+		ID:         "cust_rules",
+		ExternalID: "ext_cust_rules",
+		Name:       "Rules Customer",
+		Metadata:   map[string]string{"segment": "startup"},
+		BaseModel:  types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().CustomerRepo.Create(ctx, s.customer))
+
+	s.sub = &subscription.Subscription{
+		ID:                 "sub_rules",
+		CustomerID:         s.customer.ID,
+		PlanID:             "plan_pro",
+		Currency:           "usd",
+		SubscriptionStatus: types.SubscriptionStatusActive,
+		BaseModel:          types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().SubscriptionRepo.Create(ctx, s.sub))
+}
+
+func (s *CouponRulesSuite) newCoupon(rules map[string]interface{}) *coupon.Coupon {
+	return &coupon.Coupon{
+		ID:        "coupon_rules",
+		Type:      types.CouponTypePercentage,
+		Cadence:   types.CouponCadenceForever,
+		Rules:     &rules,
+		BaseModel: types.GetDefaultBaseModel(s.GetContext()),
+	}
+}
+
+func (s *CouponRulesSuite) TestPlanAndCustomerMetadataRules() {
+	ruleCtx := NewSubscriptionCouponRuleContext(s.sub, nil)
+
+	s.NoError(s.service.ValidateCouponRules(s.GetContext(), s.newCoupon(map[string]interface{}{
+		"plan_ids":          []string{"plan_pro"},
+		"customer_metadata": map[string]string{"segment": "startup"},
+	}), ruleCtx))
+
+	err := s.service.ValidateCouponRules(s.GetContext(), s.newCoupon(map[string]interface{}{
+		"plan_ids": []string{"plan_enterprise"},
+	}), ruleCtx)
+	s.Require().Error(err)
+	s.Equal(types.CouponValidationErrorCodePlanNotEligible, err.(*CouponValidationError).Code)
+
+	err = s.service.ValidateCouponRules(s.GetContext(), s.newCoupon(map[string]interface{}{
+		"customer_metadata": map[string]string{"segment": "enterprise"},
+	}), ruleCtx)
+	s.Require().Error(err)
+	s.Equal(types.CouponValidationErrorCodeCustomerNotEligible, err.(*CouponValidationError).Code)
+}
+
+func (s *CouponRulesSuite) TestMinInvoiceAmountRule() {
+	c := s.newCoupon(map[string]interface{}{"min_invoice_amount": "50"})
+	inv := &invoice.Invoice{
+		ID:             "inv_rules",
+		CustomerID:     s.customer.ID,
+		SubscriptionID: lo.ToPtr(s.sub.ID),
+		Subtotal:       decimal.NewFromInt(20),
+	}
+
+	err := s.service.ValidateCouponRules(s.GetContext(), c, NewInvoiceCouponRuleContext(inv, nil))
+	s.Require().Error(err)
+	s.Equal(types.CouponValidationErrorCodeMinInvoiceAmountNotMet, err.(*CouponValidationError).Code)
+
+	inv.Subtotal = decimal.NewFromInt(50)
+	s.NoError(s.service.ValidateCouponRules(s.GetContext(), c, NewInvoiceCouponRuleContext(inv, nil)))
+
+	// Subscriptions have no invoice amount yet, so the rule is only enforced on invoices
+	s.NoError(s.service.ValidateCouponRules(s.GetContext(), c, NewSubscriptionCouponRuleContext(s.sub, nil)))
+}
+
+func (s *CouponRulesSuite) TestMaxRedemptionsPerCustomerRule() {
+	ctx := s.GetContext()
+	c := s.newCoupon(map[string]interface{}{"max_redemptions_per_customer": 1})
+
+	s.NoError(s.service.ValidateCouponRules(ctx, c, NewSubscriptionCouponRuleContext(s.sub, nil)))
+
+	s.NoError(s.GetStores().CouponAssociationRepo.Create(ctx, &coupon_association.CouponAssociation{
+		ID:             "ca_rules",
+		CouponID:       c.ID,
+		SubscriptionID: s.sub.ID,
+		BaseModel:      types.GetDefaultBaseModel(ctx),
+	}))
+
+	// The subscription that redeemed the coupon stays eligible
+	s.NoError(s.service.ValidateCouponRules(ctx, c, NewSubscriptionCouponRuleContext(s.sub, nil)))
+
+	otherSub := &subscription.Subscription{
+		ID:                 "sub_rules_other",
+		CustomerID:         s.customer.ID,
+		PlanID:             "plan_pro",
+		Currency:           "usd",
+		SubscriptionStatus: types.SubscriptionStatusActive,
+		BaseModel:          types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().SubscriptionRepo.Create(ctx, otherSub))
+
+	err := s.service.ValidateCouponRules(ctx, c, NewSubscriptionCouponRuleContext(otherSub, nil))
+	s.Require().Error(err)
+	s.Equal(types.CouponValidationErrorCodeCustomerRedemptionLimitReached, err.(*CouponValidationError).Code)
+}
+
+func (s *CouponRulesSuite) TestFirstTimeCustomerOnlyRule() {
+	ctx := s.GetContext()
+	c := s.newCoupon(map[string]interface{}{"first_time_customer_only": true})
+
+	s.NoError(s.GetStores().InvoiceRepo.Create(ctx, &invoice.Invoice{
+		ID:            "inv_rules_previous",
+		CustomerID:    s.customer.ID,
+		InvoiceType:   types.InvoiceTypeOneOff,
+		InvoiceStatus: types.InvoiceStatusFinalized,
+		PaymentStatus: types.PaymentStatusPending,
+		Currency:      "usd",
+		BaseModel:     types.GetDefaultBaseModel(ctx),
+	}))
+
+	err := s.service.ValidateCouponRules(ctx, c, NewSubscriptionCouponRuleContext(s.sub, nil))
+	s.Require().Error(err)
+	s.Equal(types.CouponValidationErrorCodeFirstTimeCustomerOnly, err.(*CouponValidationError).Code)
+}
+
+func (s *CouponRulesSuite) TestFirstTimeCustomerOnlyRuleAfterRedemption() {
+	ctx := s.GetContext()
+	c := s.newCoupon(map[string]interface{}{"first_time_customer_only": true})
+	redeemedAt := time.Now().UTC().Add(-time.Hour)
+
+	// an invoice of another subscription finalized after the redemption does not make the coupon ineligible
+	s.NoError(s.GetStores().InvoiceRepo.Create(ctx, &invoice.Invoice{
+		ID:            "inv_rules_later",
+		CustomerID:    s.customer.ID,
+		InvoiceType:   types.InvoiceTypeOneOff,
+		InvoiceStatus: types.InvoiceStatusFinalized,
+		PaymentStatus: types.PaymentStatusPending,
+		Currency:      "usd",
+		FinalizedAt:   lo.ToPtr(time.Now().UTC()),
+		BaseModel:     types.GetDefaultBaseModel(ctx),
+	}))
+
+	ruleCtx := NewSubscriptionCouponRuleContext(s.sub, nil)
+	ruleCtx.RedeemedAt = &redeemedAt
+	s.NoError(s.service.ValidateCouponRules(ctx, c, ruleCtx))
+
+	s.NoError(s.GetStores().InvoiceRepo.Create(ctx, &invoice.Invoice{
+		ID:            "inv_rules_earlier",
+		CustomerID:    s.customer.ID,
+		InvoiceType:   types.InvoiceTypeOneOff,
+		InvoiceStatus: types.InvoiceStatusFinalized,
+		PaymentStatus: types.PaymentStatusPending,
+		Currency:      "usd",
+		FinalizedAt:   lo.ToPtr(redeemedAt.Add(-time.Hour)),
+		BaseModel:     types.GetDefaultBaseModel(ctx),
+	}))
+
+	err := s.service.ValidateCouponRules(ctx, c, ruleCtx)
+	s.Require().Error(err)
+	s.Equal(types.CouponValidationErrorCodeFirstTimeCustomerOnly, err.(*CouponValidationError).Code)
+}
+
+func (s *CouponRulesSuite) TestInvalidRules() {
+	// unknown rules are ignored
+	rules, err := types.ParseCouponRules(map[string]interface{}{"unknown_rule": true, "plan_ids": []string{"plan_1"}})
+	s.NoError(err)
+	s.Equal([]string{"plan_1"}, rules.PlanIDs)
+
+	_, err = types.ParseCouponRules(map[string]interface{}{"max_redemptions_per_customer": 0})
+	s.Error(err)
+
+	_, err = types.ParseCouponRules(map[string]interface{}{"plan_ids": "plan_1"})
+	s.Error(err)
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/flexprice/flexprice/internal/domain/coupon"
+	"github.com/flexprice/flexprice/internal/domain/promotion_code"
 	"github.com/flexprice/flexprice/internal/domain/subscription"
 	ierr "github.com/flexprice/flexprice/internal/errors"
 	"github.com/flexprice/flexprice/internal/types"
@@ -27,6 +28,10 @@ type CouponValidationService interface {
 	ValidateCoupon(ctx context.Context, couponID string, subscriptionID *string) error
 	// Basic coupon validation (status, validity, etc.)
 	ValidateCouponBasic(coupon *coupon.Coupon) error
+	// Eligibility rule validation against the subscription or invoice the coupon is redeemed on
+	ValidateCouponRules(ctx context.Context, coupon *coupon.Coupon, ruleCtx *CouponRuleContext) error
+	// Promotion code validation for a redemption by the given customer
+	ValidatePromotionCode(promotionCode *promotion_code.PromotionCode, customerID string) error
 }
 
 // couponValidationService implements CouponValidationService
@@ -57,14 +62,16 @@ func (s *couponValidationService) ValidateCoupon(ctx context.Context, couponID s
 
 	// subscription is nil by default if subscriptionID is nil
 	var subscription *subscription.Subscription
+	var ruleCtx *CouponRuleContext
 	if subscriptionID != nil {
-		sub, err := s.SubRepo.Get(ctx, *subscriptionID)
+		sub, lineItems, err := s.SubRepo.GetWithLineItems(ctx, *subscriptionID)
 		if err != nil {
 			return ierr.WithError(err).
 				WithHint("Failed to get subscription details").
 				Mark(ierr.ErrNotFound)
 		}
 		subscription = sub
+		ruleCtx = NewSubscriptionCouponRuleContext(sub, lineItems)
 	}
 
 	// Priority 1: Basic coupon validation
@@ -82,7 +89,12 @@ func (s *couponValidationService) ValidateCoupon(ctx context.Context, couponID s
 		return err
 	}
 
-	// Priority 4: Subscription-specific validation
+	// Priority 4: Eligibility rule validation
+	if err := s.ValidateCouponRules(ctx, coupon, ruleCtx); err != nil {
+		return err
+	}
+
+	// Priority 5: Subscription-specific validation
 	if subscription != nil {
 		if err := s.validateCouponCadence(ctx, coupon, subscription); err != nil {
 			return err
@@ -356,3 +368,54 @@ func (s *couponValidationService) validateRepeatedCadence(ctx context.Context, c
 
 	return nil
 }
+
+// ValidatePromotionCode validates that a promotion code can be redeemed by the given customer.
+// The coupon behind the code is validated separately.
+func (s *couponValidationService) ValidatePromotionCode(promotionCode *promotion_code.PromotionCode, customerID string) error {
+	if promotionCode.Status != types.StatusPublished {
+		return &CouponValidationError{
+			Code:    types.CouponValidationErrorCodePromotionCodeInactive,
+			Message: "Promotion code is not active",
+			Details: map[string]interface{}{
+				"promotion_code_id": promotionCode.ID,
+				"status":            promotionCode.Status,
+			},
+		}
+	}
+
+	if promotionCode.IsExpired() {
+		return &CouponValidationError{
+			Code:    types.CouponValidationErrorCodePromotionCodeExpired,
+			Message: "Promotion code has expired",
+			Details: map[string]interface{}{
+				"promotion_code_id": promotionCode.ID,
+				"expires_at":        promotionCode.ExpiresAt,
+			},
+		}
+	}
+
+	if !promotionCode.HasRedemptionsLeft() {
+		return &CouponValidationError{
+			Code:    types.CouponValidationErrorCodePromotionCodeRedemptionLimitReached,
+			Message: "Promotion code has reached its maximum redemptions",
+			Details: map[string]interface{}{
+				"promotion_code_id": promotionCode.ID,
+				"max_redemptions":   promotionCode.MaxRedemptions,
+				"total_redemptions": promotionCode.TotalRedemptions,
+			},
+		}
+	}
+
+	if promotionCode.CustomerID != nil && *promotionCode.CustomerID != customerID {
+		return &CouponValidationError{
+			Code:    types.CouponValidationErrorCodePromotionCodeCustomerMismatch,
+			Message: "Promotion code is restricted to another customer",
+			Details: map[string]interface{}{
+				"promotion_code_id": promotionCode.ID,
+				"customer_id":       customerID,
+			},
+		}
+	}
+
+	return nil
+}
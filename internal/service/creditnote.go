@@ -13,6 +13,7 @@ import (
 	"github.com/flexprice/flexprice/internal/domain/creditnote"
 	"github.com/flexprice/flexprice/internal/domain/customer"
 	"github.com/flexprice/flexprice/internal/domain/invoice"
+	"github.com/flexprice/flexprice/internal/domain/payment"
 	ierr "github.com/flexprice/flexprice/internal/errors"
 	"github.com/flexprice/flexprice/internal/idempotency"
 	"github.com/flexprice/flexprice/internal/types"
@@ -31,6 +32,14 @@ type CreditNoteService interface {
 	// This method is used to finalize a credit note
 	// this can be done when credit note is a adjustment and not a refund so we can cancel the adjustment
 	FinalizeCreditNote(ctx context.Context, id string) error
+
+	// SyncRefundStatus sets the refund status of a credit note refunded to the original payment
+	// method from the status of its payment refunds
+	SyncRefundStatus(ctx context.Context, id string) error
+
+	// CreditFailedRefund credits the amount of a failed payment refund of a credit note to the wallet
+	// of the customer, so the customer keeps the refunded amount as credits
+	CreditFailedRefund(ctx context.Context, refund *payment.PaymentRefund) error
 }
 
 type creditNoteService struct {
@@ -81,6 +90,16 @@ func (s *creditNoteService) CreateCreditNote(ctx context.Context, req *dto.Creat
 			return err
 		}
 
+		if req.RefundMethod == types.CreditNoteRefundMethodOriginalPaymentMethod && creditNoteType != types.CreditNoteTypeRefund {
+			return ierr.NewError("refund method not allowed for adjustment credit notes").
+				WithHint("Only refund credit notes of paid invoices can be refunded to the original payment method").
+				WithReportableDetails(map[string]any{
+					"invoice_id":       req.InvoiceID,
+					"credit_note_type": creditNoteType,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+
 		// Generate credit note number if not provided
 		if req.CreditNoteNumber == "" {
 			req.CreditNoteNumber = types.GenerateShortIDWithPrefix(types.SHORT_ID_PREFIX_CREDIT_NOTE)
@@ -470,64 +489,48 @@ func (s *creditNoteService) FinalizeCreditNote(ctx context.Context, id string) e
 			Mark(ierr.ErrValidation)
 	}
 
-	walletService := NewWalletService(s.ServiceParams)
+	// Refunds to the original payment method are split over the gateway payments of the invoice
+	var refunds []*payment.PaymentRefund
+	refundToPaymentMethod := cn.CreditNoteType == types.CreditNoteTypeRefund &&
+		cn.RefundMethod == types.CreditNoteRefundMethodOriginalPaymentMethod
 
 	// Process the credit note in transaction
 	err = s.DB.WithTx(ctx, func(tx context.Context) error {
+		if refundToPaymentMethod {
+			// the payments stay locked until the refunds are recorded so that concurrent credit notes
+			// of the invoice cannot refund the same amount
+			if err := s.PaymentRepo.LockByDestination(tx, types.PaymentDestinationTypeInvoice, cn.InvoiceID); err != nil {
+				return err
+			}
+			refunds, err = s.allocatePaymentRefunds(tx, cn)
+			if err != nil {
+				return err
+			}
+			cn.RefundStatus = lo.ToPtr(types.PaymentStatusPending)
+		}
+
 		// Update credit note status first
 		cn.CreditNoteStatus = types.CreditNoteStatusFinalized
 		if err := s.CreditNoteRepo.Update(tx, cn); err != nil {
 			return err
 		}
 
-		// Handle refund credit notes (wallet top-up logic)
-		if cn.CreditNoteType == types.CreditNoteTypeRefund {
-			// Get invoice using transaction context
-			inv, err := s.InvoiceRepo.Get(tx, cn.InvoiceID)
-			if err != nil {
+		// Record the refunds before calling the gateway so every gateway refund has a record
+		for _, refund := range refunds {
+			if err := s.PaymentRepo.CreateRefund(tx, refund); err != nil {
 				return err
 			}
+		}
 
-			// Find or create wallet using transaction context
-			wallets, err := walletService.GetWalletsByCustomerID(tx, inv.CustomerID)
-			if err != nil {
-				return err
-			}
-
-			var selectedWallet *dto.WalletResponse
-			for _, w := range wallets {
-				if types.IsMatchingCurrency(w.Currency, inv.Currency) {
-					selectedWallet = w
-					break
-				}
-			}
-			if selectedWallet == nil {
-				// Create new wallet using transaction context
-				walletReq := &dto.CreateWalletRequest{
-					Name:           "Subscription Wallet",
-					CustomerID:     inv.CustomerID,
-					Currency:       inv.Currency,
-					ConversionRate: decimal.NewFromInt(1), // Set default conversion rate to avoid division by zero
-					WalletType:     types.WalletTypePrePaid,
-				}
-
-				selectedWallet, err = walletService.CreateWallet(tx, walletReq)
-				if err != nil {
-					return err
-				}
-			}
-
-			// Top up wallet using transaction context
-			walletTxnReq := &dto.TopUpWalletRequest{
+		// Handle refund credit notes (wallet top-up logic)
+		if cn.CreditNoteType == types.CreditNoteTypeRefund && !refundToPaymentMethod {
+			if err := s.topUpCustomerWallet(tx, cn, &dto.TopUpWalletRequest{
 				Amount:            cn.TotalAmount,
 				TransactionReason: types.TransactionReasonCreditNote,
 				Metadata:          types.Metadata{"credit_note_id": cn.ID},
 				IdempotencyKey:    &cn.ID, // Use credit note ID as idempotency key
 				Description:       fmt.Sprintf("Credit note refund: %s", cn.CreditNoteNumber),
-			}
-
-			_, err = walletService.TopUpWallet(tx, selectedWallet.ID, walletTxnReq)
-			if err != nil {
+			}); err != nil {
 				return err
 			}
 		}
@@ -556,6 +559,10 @@ func (s *creditNoteService) FinalizeCreditNote(ctx context.Context, id string) e
 	// Publish webhook event after successful transaction
 	s.publishInternalWebhookEvent(ctx, types.WebhookEventCreditNoteUpdated, cn.ID)
 
+	if len(refunds) > 0 {
+		s.issuePaymentRefunds(ctx, cn, refunds)
+	}
+
 	s.Logger.Infow("credit note processed successfully",
 		"credit_note_id", id,
 		"total_amount", cn.TotalAmount)
@@ -563,6 +570,256 @@ func (s *creditNoteService) FinalizeCreditNote(ctx context.Context, id string) e
 	return nil
 }
 
+func (s *creditNoteService) SyncRefundStatus(ctx context.Context, id string) error {
+	cn, err := s.CreditNoteRepo.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	refunds, err := s.PaymentRepo.ListRefundsByCreditNote(ctx, id)
+	if err != nil {
+		return err
+	}
+	if len(refunds) == 0 {
+		return nil
+	}
+
+	// the credit note is refunded once all of its payment refunds succeeded, and failed if any of them failed
+	status := types.PaymentStatusSucceeded
+	for _, refund := range refunds {
+		if refund.RefundStatus == types.PaymentStatusFailed {
+			status = types.PaymentStatusFailed
+			break
+		}
+		if refund.RefundStatus != types.PaymentStatusSucceeded {
+			status = types.PaymentStatusPending
+		}
+	}
+
+	if lo.FromPtr(cn.RefundStatus) == status {
+		return nil
+	}
+
+	cn.RefundStatus = lo.ToPtr(status)
+	if err := s.CreditNoteRepo.Update(ctx, cn); err != nil {
+		return err
+	}
+
+	s.Logger.Infow("updated credit note refund status",
+		"credit_note_id", cn.ID,
+		"refund_status", status)
+
+	s.publishInternalWebhookEvent(ctx, types.WebhookEventCreditNoteUpdated, cn.ID)
+	return nil
+}
+
+// CreditFailedRefund tops up the wallet of the customer with the amount of the failed refund, the refund
+// ID is the idempotency key of the wallet transaction
+func (s *creditNoteService) CreditFailedRefund(ctx context.Context, refund *payment.PaymentRefund) error {
+	if refund.CreditNoteID == nil || refund.RefundStatus != types.PaymentStatusFailed {
+		return nil
+	}
+
+	cn, err := s.CreditNoteRepo.Get(ctx, *refund.CreditNoteID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.topUpCustomerWallet(ctx, cn, &dto.TopUpWalletRequest{
+		Amount:            refund.Amount,
+		TransactionReason: types.TransactionReasonCreditNote,
+		Metadata: types.Metadata{
+			"credit_note_id":    cn.ID,
+			"payment_refund_id": refund.ID,
+		},
+		IdempotencyKey: lo.ToPtr(refund.ID),
+		Description:    fmt.Sprintf("Failed refund of credit note: %s", cn.CreditNoteNumber),
+	}); err != nil {
+		return err
+	}
+
+	s.Logger.Infow("credited the failed payment refund to the customer wallet",
+		"refund_id", refund.ID,
+		"credit_note_id", cn.ID,
+		"amount", refund.Amount)
+	return nil
+}
+
+// topUpCustomerWallet tops up the wallet of the customer of the credit note invoice in the invoice
+// currency, the wallet is created when the customer has none in that currency
+func (s *creditNoteService) topUpCustomerWallet(ctx context.Context, cn *creditnote.CreditNote, req *dto.TopUpWalletRequest) error {
+	walletService := NewWalletService(s.ServiceParams)
+
+	inv, err := s.InvoiceRepo.Get(ctx, cn.InvoiceID)
+	if err != nil {
+		return err
+	}
+
+	wallets, err := walletService.GetWalletsByCustomerID(ctx, inv.CustomerID)
+	if err != nil {
+		return err
+	}
+
+	var selectedWallet *dto.WalletResponse
+	for _, w := range wallets {
+		if types.IsMatchingCurrency(w.Currency, inv.Currency) {
+			selectedWallet = w
+			break
+		}
+	}
+	if selectedWallet == nil {
+		selectedWallet, err = walletService.CreateWallet(ctx, &dto.CreateWalletRequest{
+			Name:           "Subscription Wallet",
+			CustomerID:     inv.CustomerID,
+			Currency:       inv.Currency,
+			ConversionRate: decimal.NewFromInt(1), // Set default conversion rate to avoid division by zero
+			WalletType:     types.WalletTypePrePaid,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = walletService.TopUpWallet(ctx, selectedWallet.ID, req)
+	return err
+}
+
+// allocatePaymentRefunds splits the credit note amount over the gateway payments of the invoice that
+// still have an amount left to refund, and fails when these payments cannot cover the credit note
+func (s *creditNoteService) allocatePaymentRefunds(ctx context.Context, cn *creditnote.CreditNote) ([]*payment.PaymentRefund, error) {
+	filter := types.NewNoLimitPaymentFilter()
+	filter.DestinationType = lo.ToPtr(string(types.PaymentDestinationTypeInvoice))
+	filter.DestinationID = lo.ToPtr(cn.InvoiceID)
+
+	payments, err := s.PaymentRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	remaining := cn.TotalAmount
+	refunds := make([]*payment.PaymentRefund, 0)
+	for _, p := range payments {
+		if remaining.IsZero() {
+			break
+		}
+		if lo.FromPtr(p.GatewayPaymentID) == "" || types.PaymentGatewayType(lo.FromPtr(p.PaymentGateway)).Validate() != nil {
+			continue
+		}
+		if p.PaymentStatus != types.PaymentStatusSucceeded && p.PaymentStatus != types.PaymentStatusPartiallyRefunded {
+			continue
+		}
+
+		existing, err := s.PaymentRepo.ListRefunds(ctx, p.ID)
+		if err != nil {
+			return nil, err
+		}
+		refundable := p.Amount
+		for _, refund := range existing {
+			if refund.RefundStatus != types.PaymentStatusFailed {
+				refundable = refundable.Sub(refund.Amount)
+			}
+		}
+		if !refundable.IsPositive() {
+			continue
+		}
+
+		amount := decimal.Min(refundable, remaining)
+		remaining = remaining.Sub(amount)
+		refunds = append(refunds, &payment.PaymentRefund{
+			ID:             types.GenerateUUIDWithPrefix(types.UUID_PREFIX_PAYMENT_REFUND),
+			PaymentID:      p.ID,
+			CreditNoteID:   lo.ToPtr(cn.ID),
+			Amount:         amount,
+			Currency:       p.Currency,
+			RefundStatus:   types.PaymentStatusPending,
+			PaymentGateway: p.PaymentGateway,
+			Reason:         string(cn.Reason),
+			Metadata:       types.Metadata{"credit_note_number": cn.CreditNoteNumber},
+			EnvironmentID:  types.GetEnvironmentID(ctx),
+			BaseModel:      types.GetDefaultBaseModel(ctx),
+		})
+	}
+
+	if remaining.IsPositive() {
+		return nil, ierr.NewError("credit note exceeds the refundable payments").
+			WithHint("The gateway payments of the invoice cannot cover this refund, use the WALLET refund method instead").
+			WithReportableDetails(map[string]any{
+				"invoice_id":   cn.InvoiceID,
+				"total_amount": cn.TotalAmount,
+				"unallocated":  remaining,
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	return refunds, nil
+}
+
+// issuePaymentRefunds sends the refunds to the gateway. Refunds the gateway did not settle right away
+// stay pending and are confirmed by the gateway webhook, failed refunds are credited to the customer
+// wallet by UpdateRefund.
+func (s *creditNoteService) issuePaymentRefunds(ctx context.Context, cn *creditnote.CreditNote, refunds []*payment.PaymentRefund) {
+	paymentService := NewPaymentService(s.ServiceParams)
+
+	fail := func(refund *payment.PaymentRefund, reason string) {
+		if err := paymentService.UpdateRefund(ctx, refund.ID, dto.UpdatePaymentRefundRequest{
+			RefundStatus:  types.PaymentStatusFailed,
+			FailureReason: lo.ToPtr(reason),
+		}); err != nil {
+			s.Logger.Errorw("failed to record the failed payment refund",
+				"error", err,
+				"refund_id", refund.ID,
+				"credit_note_id", cn.ID)
+		}
+	}
+
+	if s.IntegrationFactory == nil {
+		for _, refund := range refunds {
+			fail(refund, "payment gateway integration is not available")
+		}
+		return
+	}
+
+	for _, refund := range refunds {
+		p, err := s.PaymentRepo.Get(ctx, refund.PaymentID)
+		if err != nil {
+			fail(refund, err.Error())
+			continue
+		}
+
+		gateway, err := s.IntegrationFactory.GetPaymentGatewayByType(ctx, types.PaymentGatewayType(lo.FromPtr(p.PaymentGateway)))
+		if err != nil {
+			fail(refund, err.Error())
+			continue
+		}
+
+		resp, err := gateway.CreateRefund(ctx, &dto.CreateGatewayRefundRequest{
+			RefundID:         refund.ID,
+			PaymentID:        p.ID,
+			GatewayPaymentID: lo.FromPtr(p.GatewayPaymentID),
+			CreditNoteID:     cn.ID,
+			Amount:           refund.Amount,
+			Currency:         refund.Currency,
+			Reason:           cn.Reason,
+		})
+		if err != nil {
+			fail(refund, err.Error())
+			continue
+		}
+
+		if err := paymentService.UpdateRefund(ctx, refund.ID, dto.UpdatePaymentRefundRequest{
+			RefundStatus:    resp.Status,
+			GatewayRefundID: lo.ToPtr(resp.ID),
+			FailureReason:   lo.EmptyableToPtr(resp.FailureReason),
+		}); err != nil {
+			s.Logger.Errorw("failed to update the payment refund",
+				"error", err,
+				"refund_id", refund.ID,
+				"gateway_refund_id", resp.ID,
+				"credit_note_id", cn.ID)
+		}
+	}
+}
+
 func (s *creditNoteService) ValidateCreditNoteCreation(ctx context.Context, req *dto.CreateCreditNoteRequest) error {
 	// Validate invoice status and payment status
 	inv, err := s.validateInvoiceEligibility(ctx, req.InvoiceID)
@@ -8,9 +8,11 @@ import (
 	"github.com/flexprice/flexprice/internal/domain/creditnote"
 	"github.com/flexprice/flexprice/internal/domain/customer"
 	"github.com/flexprice/flexprice/internal/domain/invoice"
+	"github.com/flexprice/flexprice/internal/domain/payment"
 	"github.com/flexprice/flexprice/internal/domain/plan"
 	"github.com/flexprice/flexprice/internal/domain/subscription"
 	"github.com/flexprice/flexprice/internal/domain/wallet"
+	ierr "github.com/flexprice/flexprice/internal/errors"
 	"github.com/flexprice/flexprice/internal/testutil"
 	"github.com/flexprice/flexprice/internal/types"
 	"github.com/samber/lo"
@@ -70,6 +72,7 @@ func (s *CreditNoteServiceSuite) setupService() {
 		EntitlementRepo:            s.GetStores().EntitlementRepo,
 		FeatureRepo:                s.GetStores().FeatureRepo,
 		WalletRepo:                 s.GetStores().WalletRepo,
+		PaymentRepo:                s.GetStores().PaymentRepo,
 		EventPublisher:             s.GetPublisher(),
 		CreditGrantRepo:            s.GetStores().CreditGrantRepo,
 		TaxAssociationRepo:         s.GetStores().TaxAssociationRepo,
@@ -1276,6 +1279,69 @@ func (s *CreditNoteServiceSuite) TestWalletRefundIntegration() {
 	s.True(wallet.Balance.GreaterThan(decimal.NewFromFloat(100.00))) // Initial was 100.00
 }
 
+func (s *CreditNoteServiceSuite) TestOriginalPaymentMethodRefund() {
+	req := &dto.CreateCreditNoteRequest{
+		InvoiceID:    s.testData.invoices.finalized.ID,
+		Reason:       types.CreditNoteReasonUnsatisfactory,
+		RefundMethod: types.CreditNoteRefundMethodOriginalPaymentMethod,
+		LineItems: []dto.CreateCreditNoteLineItemRequest{
+			{
+				InvoiceLineItemID: "line_1",
+				Amount:            decimal.NewFromFloat(25.00),
+			},
+		},
+	}
+
+	resp, err := s.service.CreateCreditNote(s.GetContext(), req)
+	s.NoError(err)
+	s.Equal(types.CreditNoteRefundMethodOriginalPaymentMethod, resp.RefundMethod)
+
+	// without a gateway payment on the invoice there is nothing to refund
+	err = s.service.FinalizeCreditNote(s.GetContext(), resp.ID)
+	s.Error(err)
+	s.True(ierr.IsInvalidOperation(err))
+
+	p := &payment.Payment{
+		ID:                types.GenerateUUIDWithPrefix(types.UUID_PREFIX_PAYMENT),
+		IdempotencyKey:    "idem_original_payment_method_refund",
+		DestinationType:   types.PaymentDestinationTypeInvoice,
+		DestinationID:     s.testData.invoices.finalized.ID,
+		PaymentMethodType: types.PaymentMethodTypeCard,
+		PaymentGateway:    lo.ToPtr(string(types.PaymentGatewayTypeStripe)),
+		GatewayPaymentID:  lo.ToPtr("pi_test"),
+		Amount:            s.testData.invoices.finalized.AmountPaid,
+		Currency:          s.testData.invoices.finalized.Currency,
+		PaymentStatus:     types.PaymentStatusSucceeded,
+		BaseModel:         types.GetDefaultBaseModel(s.GetContext()),
+	}
+	s.NoError(s.GetStores().PaymentRepo.Create(s.GetContext(), p))
+
+	walletBefore, err := s.GetStores().WalletRepo.GetWalletByID(s.GetContext(), s.testData.wallets.usd.ID)
+	s.NoError(err)
+	balanceBefore := walletBefore.Balance
+
+	// the test services have no gateway integration so the refund is recorded as failed
+	s.NoError(s.service.FinalizeCreditNote(s.GetContext(), resp.ID))
+
+	refunds, err := s.GetStores().PaymentRepo.ListRefunds(s.GetContext(), p.ID)
+	s.NoError(err)
+	s.Len(refunds, 1)
+	s.True(decimal.NewFromFloat(25.00).Equal(refunds[0].Amount))
+	s.Equal(resp.ID, lo.FromPtr(refunds[0].CreditNoteID))
+	s.Equal(types.PaymentStatusFailed, refunds[0].RefundStatus)
+
+	cn, err := s.GetStores().CreditNoteRepo.Get(s.GetContext(), resp.ID)
+	s.NoError(err)
+	s.Equal(types.CreditNoteStatusFinalized, cn.CreditNoteStatus)
+	s.Equal(types.PaymentStatusFailed, lo.FromPtr(cn.RefundStatus))
+
+	// the amount of the failed refund is credited to the wallet instead
+	walletAfter, err := s.GetStores().WalletRepo.GetWalletByID(s.GetContext(), s.testData.wallets.usd.ID)
+	s.NoError(err)
+	s.True(balanceBefore.Add(decimal.NewFromFloat(25.00)).Equal(walletAfter.Balance),
+		"got %s, want %s + 25", walletAfter.Balance, balanceBefore)
+}
+
 func (s *CreditNoteServiceSuite) TestCurrencyMismatchHandling() {
 	// Test credit note with EUR invoice and wallet
 	req := &dto.CreateCreditNoteRequest{
@@ -57,6 +57,12 @@ func (s *customerService) CreateCustomer(ctx context.Context, req dto.CreateCust
 		}
 	}
 
+	if cust.IsChild() {
+		if err := s.validateParentCustomer(ctx, cust, *cust.ParentCustomerID, true); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := s.DB.WithTx(ctx, func(txCtx context.Context) error {
 		if err := s.CustomerRepo.Create(txCtx, cust); err != nil {
 			// No need to wrap the error as the repository already returns properly formatted errors
@@ -297,6 +303,18 @@ func (s *customerService) UpdateCustomer(ctx context.Context, id string, req dto
 		cust.Metadata = req.Metadata
 	}
 
+	// Attach the customer to a parent or detach it when an empty parent is provided
+	if req.ParentCustomerID != nil && *req.ParentCustomerID != lo.FromPtr(cust.ParentCustomerID) {
+		if *req.ParentCustomerID == "" {
+			cust.ParentCustomerID = nil
+		} else {
+			if err := s.validateParentCustomer(ctx, cust, *req.ParentCustomerID, false); err != nil {
+				return nil, err
+			}
+			cust.ParentCustomerID = req.ParentCustomerID
+		}
+	}
+
 	// Handle integration entity mappings if provided
 	if len(req.IntegrationEntityMapping) > 0 {
 		entityMappingService := NewEntityIntegrationMappingService(s.ServiceParams)
@@ -433,6 +451,17 @@ func (s *customerService) DeleteCustomer(ctx context.Context, id string) error {
 			Mark(ierr.ErrInvalidOperation)
 	}
 
+	children, err := s.getChildCustomers(ctx, id, 1)
+	if err != nil {
+		return err
+	}
+
+	if len(children) > 0 {
+		return ierr.NewError("customer cannot be deleted due to child customers").
+			WithHint("Please detach all child customers before deleting the customer").
+			Mark(ierr.ErrInvalidOperation)
+	}
+
 	wallets, err := s.WalletRepo.GetWalletsByCustomerID(ctx, id)
 	if err != nil {
 		return err
@@ -467,6 +496,96 @@ func (s *customerService) GetCustomerByLookupKey(ctx context.Context, lookupKey
 	return &dto.CustomerResponse{Customer: customer}, nil
 }
 
+// validateParentCustomer checks that the customer can be billed through the given parent.
+// Hierarchies are a single level deep: a parent cannot have a parent of its own and
+// a customer with children cannot become a child.
+func (s *customerService) validateParentCustomer(ctx context.Context, cust *customer.Customer, parentID string, isNew bool) error {
+	if parentID == cust.ID {
+		return ierr.NewError("customer cannot be its own parent").
+			WithHint("Please provide a different parent customer").
+			Mark(ierr.ErrValidation)
+	}
+
+	parent, err := s.CustomerRepo.Get(ctx, parentID)
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Parent customer not found").
+			WithReportableDetails(map[string]interface{}{
+				"parent_customer_id": parentID,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if parent.Status != types.StatusPublished {
+		return ierr.NewError("parent customer is not published").
+			WithHint("Parent customer must be active").
+			WithReportableDetails(map[string]interface{}{
+				"parent_customer_id": parentID,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if parent.IsChild() {
+		return ierr.NewError("parent customer is a child customer").
+			WithHint("Customer hierarchies can only be one level deep").
+			WithReportableDetails(map[string]interface{}{
+				"parent_customer_id": parentID,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	// Nothing else to check for customers which are being created
+	if isNew {
+		return nil
+	}
+
+	children, err := s.getChildCustomers(ctx, cust.ID, 1)
+	if err != nil {
+		return err
+	}
+
+	if len(children) > 0 {
+		return ierr.NewError("customer has child customers").
+			WithHint("A customer with child customers cannot be attached to a parent").
+			WithReportableDetails(map[string]interface{}{
+				"customer_id": cust.ID,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	// Usage of a child customer is billed through the parent subscription
+	subscriptionFilter := types.NewSubscriptionFilter()
+	subscriptionFilter.CustomerID = cust.ID
+	subscriptionFilter.SubscriptionStatusNotIn = []types.SubscriptionStatus{types.SubscriptionStatusCancelled}
+	subscriptionFilter.Limit = lo.ToPtr(1)
+	subscriptions, err := s.SubRepo.List(ctx, subscriptionFilter)
+	if err != nil {
+		return err
+	}
+
+	if len(subscriptions) > 0 {
+		return ierr.NewError("customer has active subscriptions").
+			WithHint("Please cancel all subscriptions before attaching the customer to a parent").
+			WithReportableDetails(map[string]interface{}{
+				"customer_id": cust.ID,
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	return nil
+}
+
+// getChildCustomers returns the published child customers of the parent customer,
+// a limit of 0 returns all of them
+func (s *customerService) getChildCustomers(ctx context.Context, parentID string, limit int) ([]*customer.Customer, error) {
+	filter := types.NewNoLimitCustomerFilter()
+	filter.ParentCustomerIDs = []string{parentID}
+	if limit > 0 {
+		filter.QueryFilter.Limit = lo.ToPtr(limit)
+	}
+	return s.CustomerRepo.List(ctx, filter)
+}
+
 func (s *customerService) publishWebhookEvent(ctx context.Context, eventName string, customerID string) {
 	webhookPayload, err := json.Marshal(webhookDto.InternalCustomerEvent{
 		CustomerID: customerID,
@@ -537,3 +537,55 @@ func (s *CustomerServiceSuite) TestGetCustomerByLookupKey() {
 		})
 	}
 }
+
+func (s *CustomerServiceSuite) TestCustomerHierarchy() {
+	parent := &domainCustomer.Customer{
+		ID:         "cust_parent",
+		ExternalID: "ext_parent",
+		Name:       "Parent",
+		BaseModel:  types.GetDefaultBaseModel(s.ctx),
+	}
+	s.NoError(s.GetStores().CustomerRepo.Create(s.ctx, parent))
+
+	child, err := s.service.CreateCustomer(s.ctx, dto.CreateCustomerRequest{
+		ExternalID:       "ext_child",
+		Name:             "Child",
+		ParentCustomerID: lo.ToPtr(parent.ID),
+	})
+	s.NoError(err)
+	s.Equal(parent.ID, lo.FromPtr(child.ParentCustomerID))
+
+	s.Run("parent_cannot_be_a_child", func() {
+		_, err := s.service.CreateCustomer(s.ctx, dto.CreateCustomerRequest{
+			ExternalID:       "ext_grandchild",
+			ParentCustomerID: lo.ToPtr(child.ID),
+		})
+		s.Error(err)
+		s.True(ierr.IsValidation(err))
+	})
+
+	s.Run("customer_with_children_cannot_become_a_child", func() {
+		other, err := s.service.CreateCustomer(s.ctx, dto.CreateCustomerRequest{ExternalID: "ext_other"})
+		s.NoError(err)
+
+		_, err = s.service.UpdateCustomer(s.ctx, parent.ID, dto.UpdateCustomerRequest{
+			ParentCustomerID: lo.ToPtr(other.ID),
+		})
+		s.Error(err)
+		s.True(ierr.IsValidation(err))
+	})
+
+	s.Run("parent_with_children_cannot_be_deleted", func() {
+		err := s.service.DeleteCustomer(s.ctx, parent.ID)
+		s.Error(err)
+		s.True(ierr.IsInvalidOperation(err))
+	})
+
+	s.Run("detach_child", func() {
+		resp, err := s.service.UpdateCustomer(s.ctx, child.ID, dto.UpdateCustomerRequest{
+			ParentCustomerID: lo.ToPtr(""),
+		})
+		s.NoError(err)
+		s.Nil(resp.ParentCustomerID)
+	})
+}
@@ -0,0 +1,421 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/invoice"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/idempotency"
+	"github.com/flexprice/flexprice/internal/temporal/models"
+	temporalservice "github.com/flexprice/flexprice/internal/temporal/service"
+	"github.com/flexprice/flexprice/internal/types"
+	webhookDto "github.com/flexprice/flexprice/internal/webhook/dto"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// DunningService retries the payment of invoices whose card payment failed, following the retry
+// schedule of the dunning_config setting. The schedule is driven by the DunningWorkflow, the steps
+// of the workflow are the Begin, Retry and End methods below.
+type DunningService interface {
+	// StartDunning starts the dunning workflow of the invoice when dunning is enabled
+	StartDunning(ctx context.Context, invoiceID string) error
+	// StopDunning signals the dunning workflow of a paid invoice so it ends before the next retry
+	StopDunning(ctx context.Context, inv *invoice.Invoice)
+
+	// BeginDunningCycle moves the subscription of the invoice to past_due and returns the retry schedule
+	BeginDunningCycle(ctx context.Context, invoiceID string) (*models.DunningSchedule, error)
+	// RetryDunningPayment charges the default payment method of the customer for the remaining amount
+	RetryDunningPayment(ctx context.Context, input *models.DunningAttemptInput) (*models.DunningAttemptResult, error)
+	// EndDunningCycle reactivates the subscription of a recovered invoice or applies the final action
+	EndDunningCycle(ctx context.Context, input *models.DunningOutcomeInput) error
+}
+
+type dunningService struct {
+	ServiceParams
+}
+
+func NewDunningService(params ServiceParams) DunningService {
+	return &dunningService{
+		ServiceParams: params,
+	}
+}
+
+func (s *dunningService) StartDunning(ctx context.Context, invoiceID string) error {
+	config, err := s.getDunningConfig(ctx)
+	if err != nil {
+		return err
+	}
+	if !config.Enabled || len(config.RetryScheduleDays) == 0 {
+		return nil
+	}
+
+	inv, err := s.InvoiceRepo.Get(ctx, invoiceID)
+	if err != nil {
+		return err
+	}
+
+	// an invoice goes through dunning once, failures of the retries themselves land here as well
+	if isInvoiceSettled(inv) || inv.Metadata[types.InvoiceMetadataDunningStatus] != "" {
+		return nil
+	}
+
+	// credit invoices of wallet top-ups credit the wallet only through the top-up payment flow, a
+	// payment recovered by dunning would settle the invoice without crediting the wallet
+	if inv.InvoiceType == types.InvoiceTypeCredit {
+		return nil
+	}
+
+	temporalSvc := temporalservice.GetGlobalTemporalService()
+	if temporalSvc == nil {
+		s.Logger.Warnw("temporal service not available for dunning",
+			"invoice_id", invoiceID)
+		return nil
+	}
+
+	input := models.DunningWorkflowInput{
+		InvoiceID:     invoiceID,
+		TenantID:      types.GetTenantID(ctx),
+		EnvironmentID: types.GetEnvironmentID(ctx),
+		UserID:        types.GetUserID(ctx),
+	}
+	if err := input.Validate(); err != nil {
+		return err
+	}
+
+	// the workflow id is derived from the invoice so a second start while the dunning runs is a no-op
+	workflowRun, err := temporalSvc.StartWorkflow(ctx, models.StartWorkflowOptions{
+		ID:        types.TemporalDunningWorkflow.WorkflowID(invoiceID),
+		TaskQueue: types.TemporalDunningWorkflow.TaskQueueName(),
+	}, types.TemporalDunningWorkflow, input)
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to start the dunning workflow").
+			WithReportableDetails(map[string]interface{}{
+				"invoice_id": invoiceID,
+			}).
+			Mark(ierr.ErrSystem)
+	}
+
+	s.Logger.Infow("dunning workflow started",
+		"invoice_id", invoiceID,
+		"workflow_id", workflowRun.GetID(),
+		"run_id", workflowRun.GetRunID())
+
+	return nil
+}
+
+func (s *dunningService) StopDunning(ctx context.Context, inv *invoice.Invoice) {
+	if inv.Metadata[types.InvoiceMetadataDunningStatus] != string(types.DunningStatusActive) {
+		return
+	}
+
+	temporalSvc := temporalservice.GetGlobalTemporalService()
+	if temporalSvc == nil {
+		return
+	}
+
+	// the workflow also checks the invoice before every retry, a failed signal only delays its end
+	if err := temporalSvc.SignalWorkflow(ctx, types.TemporalDunningWorkflow.WorkflowID(inv.ID), "", models.DunningSignalInvoicePaid, nil); err != nil {
+		s.Logger.Warnw("failed to signal the dunning workflow of the paid invoice",
+			"invoice_id", inv.ID,
+			"error", err)
+	}
+}
+
+func (s *dunningService) BeginDunningCycle(ctx context.Context, invoiceID string) (*models.DunningSchedule, error) {
+	inv, err := s.InvoiceRepo.Get(ctx, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if isInvoiceSettled(inv) {
+		return &models.DunningSchedule{}, nil
+	}
+
+	config, err := s.getDunningConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.updateDunningState(ctx, inv, types.DunningStatusActive, 0); err != nil {
+		return nil, err
+	}
+
+	if inv.SubscriptionID != nil {
+		if err := s.updateSubscriptionStatus(ctx, *inv.SubscriptionID, types.SubscriptionStatusPastDue, types.SubscriptionStatusActive); err != nil {
+			return nil, err
+		}
+	}
+
+	nextRetryAt := time.Now().UTC().AddDate(0, 0, config.RetryScheduleDays[0])
+	s.publishDunningEvent(ctx, types.WebhookEventInvoiceDunningStarted, inv.ID, webhookDto.DunningDetails{
+		Status:      types.DunningStatusActive,
+		MaxAttempts: len(config.RetryScheduleDays),
+		NextRetryAt: &nextRetryAt,
+	})
+
+	s.Logger.Infow("dunning started",
+		"invoice_id", inv.ID,
+		"subscription_id", lo.FromPtr(inv.SubscriptionID),
+		"retry_schedule_days", config.RetryScheduleDays)
+
+	return &models.DunningSchedule{
+		RetryScheduleDays: config.RetryScheduleDays,
+		SendReminders:     config.SendReminders,
+		FinalAction:       config.FinalAction,
+	}, nil
+}
+
+func (s *dunningService) RetryDunningPayment(ctx context.Context, input *models.DunningAttemptInput) (*models.DunningAttemptResult, error) {
+	inv, err := s.InvoiceRepo.Get(ctx, input.InvoiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the invoice may have been paid through another channel since the last attempt
+	if isInvoiceSettled(inv) {
+		return &models.DunningAttemptResult{Paid: true}, nil
+	}
+
+	// the key is derived from the attempt so a retry of the activity does not charge the card twice
+	idempotencyKey := idempotency.NewGenerator().GenerateKey(idempotency.ScopePayment, map[string]interface{}{
+		"invoice_id":      inv.ID,
+		"dunning_attempt": input.Attempt,
+	})
+
+	var paymentErr error
+	existing, err := s.PaymentRepo.GetByIdempotencyKey(ctx, idempotencyKey)
+	if err != nil && !ierr.IsNotFound(err) {
+		return nil, err
+	}
+	if existing != nil {
+		s.Logger.Infow("dunning payment of the attempt already created",
+			"invoice_id", inv.ID,
+			"attempt", input.Attempt,
+			"payment_id", existing.ID,
+			"payment_status", existing.PaymentStatus)
+	} else {
+		paymentService := NewPaymentService(s.ServiceParams)
+		_, paymentErr = paymentService.CreatePayment(ctx, &dto.CreatePaymentRequest{
+			IdempotencyKey:    idempotencyKey,
+			DestinationType:   types.PaymentDestinationTypeInvoice,
+			DestinationID:     inv.ID,
+			PaymentMethodType: types.PaymentMethodTypeCard,
+			Amount:            inv.AmountRemaining,
+			Currency:          inv.Currency,
+			ProcessPayment:    true,
+			Metadata: types.Metadata{
+				"customer_id":     inv.CustomerID,
+				"dunning_attempt": strconv.Itoa(input.Attempt),
+			},
+		})
+	}
+
+	// reload the invoice, the payment post processing updated its amounts
+	inv, err = s.InvoiceRepo.Get(ctx, input.InvoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if isInvoiceSettled(inv) {
+		return &models.DunningAttemptResult{Paid: true}, nil
+	}
+
+	s.Logger.Infow("dunning payment retry failed",
+		"invoice_id", inv.ID,
+		"attempt", input.Attempt,
+		"max_attempts", input.MaxAttempts,
+		"error", paymentErr)
+
+	if err := s.updateDunningState(ctx, inv, types.DunningStatusActive, input.Attempt); err != nil {
+		return nil, err
+	}
+
+	if input.SendReminders {
+		s.publishDunningEvent(ctx, types.WebhookEventInvoiceDunningReminder, inv.ID, webhookDto.DunningDetails{
+			Status:      types.DunningStatusActive,
+			Attempt:     input.Attempt,
+			MaxAttempts: input.MaxAttempts,
+			NextRetryAt: input.NextRetryAt,
+		})
+
+		// reminders also go through the invoice communication path used for the invoice emails
+		if err := NewInvoiceService(s.ServiceParams).TriggerCommunication(ctx, inv.ID); err != nil {
+			s.Logger.Errorw("failed to trigger the dunning reminder communication",
+				"invoice_id", inv.ID,
+				"error", err)
+		}
+	}
+
+	return &models.DunningAttemptResult{Paid: false}, nil
+}
+
+func (s *dunningService) EndDunningCycle(ctx context.Context, input *models.DunningOutcomeInput) error {
+	inv, err := s.InvoiceRepo.Get(ctx, input.InvoiceID)
+	if err != nil {
+		return err
+	}
+
+	details := webhookDto.DunningDetails{
+		Attempt:     input.Attempts,
+		MaxAttempts: input.MaxAttempts,
+	}
+
+	if input.Recovered || isInvoiceSettled(inv) {
+		details.Status = types.DunningStatusRecovered
+		if err := s.updateDunningState(ctx, inv, details.Status, input.Attempts); err != nil {
+			return err
+		}
+		if inv.SubscriptionID != nil {
+			if err := s.updateSubscriptionStatus(ctx, *inv.SubscriptionID, types.SubscriptionStatusActive,
+				types.SubscriptionStatusPastDue, types.SubscriptionStatusUnpaid); err != nil {
+				return err
+			}
+		}
+
+		s.publishDunningEvent(ctx, types.WebhookEventInvoiceDunningRecovered, inv.ID, details)
+		s.Logger.Infow("dunning recovered the invoice payment",
+			"invoice_id", inv.ID,
+			"attempts", input.Attempts)
+		return nil
+	}
+
+	details.Status = types.DunningStatusExhausted
+	if err := s.updateDunningState(ctx, inv, details.Status, input.Attempts); err != nil {
+		return err
+	}
+
+	if inv.SubscriptionID != nil {
+		switch input.FinalAction {
+		case types.DunningFinalActionCancel:
+			// the subscription may have been cancelled while the invoice was in dunning
+			sub, err := s.SubRepo.Get(ctx, *inv.SubscriptionID)
+			if err != nil {
+				return err
+			}
+			if sub.SubscriptionStatus == types.SubscriptionStatusCancelled {
+				break
+			}
+			subscriptionService := NewSubscriptionService(s.ServiceParams)
+			if _, err := subscriptionService.CancelSubscription(ctx, *inv.SubscriptionID, &dto.CancelSubscriptionRequest{
+				CancellationType: types.CancellationTypeImmediate,
+				Reason:           "dunning_exhausted",
+			}); err != nil {
+				return err
+			}
+		default:
+			if err := s.updateSubscriptionStatus(ctx, *inv.SubscriptionID, types.SubscriptionStatusUnpaid,
+				types.SubscriptionStatusActive, types.SubscriptionStatusPastDue); err != nil {
+				return err
+			}
+		}
+	}
+
+	s.publishDunningEvent(ctx, types.WebhookEventInvoiceDunningExhausted, inv.ID, details)
+	s.Logger.Infow("dunning exhausted the payment retries",
+		"invoice_id", inv.ID,
+		"attempts", input.Attempts,
+		"final_action", input.FinalAction)
+
+	return nil
+}
+
+func (s *dunningService) getDunningConfig(ctx context.Context) (*types.DunningConfig, error) {
+	settingsService := NewSettingsService(s.ServiceParams)
+	setting, err := settingsService.GetSettingByKey(ctx, types.SettingKeyDunningConfig.String())
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := dto.ConvertToDunningConfig(setting.Value)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Invalid dunning config").
+			Mark(ierr.ErrValidation)
+	}
+	return config, nil
+}
+
+// updateDunningState records the dunning status and the number of failed retries in the invoice metadata
+func (s *dunningService) updateDunningState(ctx context.Context, inv *invoice.Invoice, status types.DunningStatus, attempts int) error {
+	if inv.Metadata == nil {
+		inv.Metadata = types.Metadata{}
+	}
+	inv.Metadata[types.InvoiceMetadataDunningStatus] = string(status)
+	inv.Metadata[types.InvoiceMetadataDunningAttempts] = strconv.Itoa(attempts)
+	return s.InvoiceRepo.Update(ctx, inv)
+}
+
+// updateSubscriptionStatus moves the subscription to the status when it is in one of the from statuses
+func (s *dunningService) updateSubscriptionStatus(ctx context.Context, subscriptionID string, status types.SubscriptionStatus, from ...types.SubscriptionStatus) error {
+	sub, err := s.SubRepo.Get(ctx, subscriptionID)
+	if err != nil {
+		return err
+	}
+	if !lo.Contains(from, sub.SubscriptionStatus) {
+		return nil
+	}
+
+	previousStatus := sub.SubscriptionStatus
+	sub.SubscriptionStatus = status
+	if err := s.SubRepo.Update(ctx, sub); err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to update subscription status").
+			WithReportableDetails(map[string]interface{}{
+				"subscription_id": subscriptionID,
+				"status":          status,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	s.Logger.Infow("dunning updated the subscription status",
+		"subscription_id", subscriptionID,
+		"previous_status", previousStatus,
+		"new_status", status)
+
+	s.publishWebhookEvent(ctx, types.WebhookEventSubscriptionUpdated, webhookDto.InternalSubscriptionEvent{
+		SubscriptionID: subscriptionID,
+		TenantID:       types.GetTenantID(ctx),
+	})
+	return nil
+}
+
+func (s *dunningService) publishDunningEvent(ctx context.Context, eventName string, invoiceID string, details webhookDto.DunningDetails) {
+	s.publishWebhookEvent(ctx, eventName, webhookDto.InternalDunningEvent{
+		InvoiceID: invoiceID,
+		TenantID:  types.GetTenantID(ctx),
+		Dunning:   details,
+	})
+}
+
+func (s *dunningService) publishWebhookEvent(ctx context.Context, eventName string, payload interface{}) {
+	webhookPayload, err := json.Marshal(payload)
+	if err != nil {
+		s.Logger.Errorw("failed to marshal webhook payload", "error", err)
+		return
+	}
+
+	webhookEvent := &types.WebhookEvent{
+		ID:            types.GenerateUUIDWithPrefix(types.UUID_PREFIX_WEBHOOK_EVENT),
+		EventName:     eventName,
+		TenantID:      types.GetTenantID(ctx),
+		EnvironmentID: types.GetEnvironmentID(ctx),
+		UserID:        types.GetUserID(ctx),
+		Timestamp:     time.Now().UTC(),
+		Payload:       json.RawMessage(webhookPayload),
+	}
+	if err := s.WebhookPublisher.PublishWebhook(ctx, webhookEvent); err != nil {
+		s.Logger.Errorf("failed to publish %s event: %v", webhookEvent.EventName, err)
+	}
+}
+
+// isInvoiceSettled checks if the invoice no longer needs a payment
+func isInvoiceSettled(inv *invoice.Invoice) bool {
+	return inv.InvoiceStatus == types.InvoiceStatusVoided ||
+		inv.PaymentStatus == types.PaymentStatusSucceeded ||
+		inv.PaymentStatus == types.PaymentStatusOverpaid ||
+		!inv.AmountRemaining.GreaterThan(decimal.Zero)
+}
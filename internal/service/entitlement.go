@@ -122,10 +122,10 @@ func (s *entitlementService) CreateEntitlement(ctx context.Context, req dto.Crea
 			return nil, err
 		}
 
-		// Entitlements are restricted for bucketed max meters
-		if meter.IsBucketedMaxMeter() {
+		// Entitlements are restricted for bucketed meters
+		if meter.IsBucketedMeter() {
 			return nil, ierr.NewError("entitlements not supported for bucketed max meters").
-				WithHint("Bucketed max meters process each bucket independently and cannot have entitlements").
+				WithHint("Bucketed meters process each bucket independently and cannot have entitlements").
 				WithReportableDetails(map[string]interface{}{
 					"meter_id":     meter.ID,
 					"bucket_size":  meter.Aggregation.BucketSize,
@@ -243,10 +243,10 @@ func (s *entitlementService) CreateBulkEntitlement(ctx context.Context, req dto.
 					return err
 				}
 
-				// Bucketed max meters cannot have entitlements
-				if meter.IsBucketedMaxMeter() {
+				// Bucketed meters cannot have entitlements
+				if meter.IsBucketedMeter() {
 					return ierr.NewError("entitlements not supported for bucketed max meters").
-						WithHint("Bucketed max meters process each bucket independently and cannot have entitlements").
+						WithHint("Bucketed meters process each bucket independently and cannot have entitlements").
 						WithReportableDetails(map[string]interface{}{
 							"meter_id":     meter.ID,
 							"bucket_size":  meter.Aggregation.BucketSize,
@@ -0,0 +1,286 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/customer"
+	"github.com/flexprice/flexprice/internal/domain/feature"
+	"github.com/flexprice/flexprice/internal/domain/meter"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	webhookDto "github.com/flexprice/flexprice/internal/webhook/dto"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// CheckEntitlement checks if the customer can use the requested quantity of a feature. The usage of metered
+// features is the aggregated feature usage of the current usage reset period plus the in-flight usage reserved
+// by previous checks. An entitlement.limit_reached webhook is published when a hard limit is crossed.
+func (s *billingService) CheckEntitlement(ctx context.Context, req *dto.CheckEntitlementRequest) (*dto.CheckEntitlementResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	quantity := req.Quantity
+	if quantity.IsZero() {
+		quantity = decimal.NewFromInt(1)
+	}
+
+	cust, err := s.getEntitlementCheckCustomer(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := s.getEntitlementCheckFeature(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dto.CheckEntitlementResponse{
+		CustomerID:        cust.ID,
+		FeatureID:         f.ID,
+		RequestedQuantity: quantity,
+		CurrentUsage:      decimal.Zero,
+		InFlightUsage:     decimal.Zero,
+	}
+
+	entitlements, err := s.GetCustomerEntitlements(ctx, cust.ID, &dto.GetCustomerEntitlementsRequest{
+		FeatureIDs: []string{f.ID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	aggregatedFeature, found := lo.Find(entitlements.Features, func(af *dto.AggregatedFeature) bool {
+		return af.Feature != nil && af.Feature.ID == f.ID
+	})
+	if !found || aggregatedFeature.Entitlement == nil || len(aggregatedFeature.Sources) == 0 {
+		resp.Reason = "feature is not included in any active subscription of the customer"
+		return resp, nil
+	}
+
+	entitlement := aggregatedFeature.Entitlement
+	resp.IsEnabled = entitlement.IsEnabled
+	resp.IsSoftLimit = entitlement.IsSoftLimit
+	resp.UsageLimit = entitlement.UsageLimit
+	resp.UsageResetPeriod = entitlement.UsageResetPeriod
+
+	if !entitlement.IsEnabled {
+		resp.Reason = "feature is disabled for the customer"
+		return resp, nil
+	}
+
+	// Boolean and static features are allowed as soon as they are enabled
+	if f.Type != types.FeatureTypeMetered {
+		resp.Allowed = true
+		return resp, nil
+	}
+
+	sub, err := s.SubRepo.Get(ctx, aggregatedFeature.Sources[0].SubscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	resp.PeriodStart, resp.PeriodEnd = getEntitlementUsagePeriod(sub, entitlement.UsageResetPeriod, now)
+
+	// Unlimited usage does not need to be tracked
+	if entitlement.UsageLimit == nil {
+		resp.Allowed = true
+		return resp, nil
+	}
+
+	aggregated, err := s.getAggregatedFeatureUsage(ctx, cust, f, aggregatedFeature.Sources, resp.PeriodStart, now)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := decimal.NewFromInt(*entitlement.UsageLimit)
+
+	// the counter stays locked from the read to the reservation so that concurrent checks of the
+	// customer feature on any instance cannot reserve the same remaining usage
+	var inFlight, projected decimal.Decimal
+	err = s.DB.WithTx(ctx, func(tx context.Context) error {
+		counter, err := s.EntitlementUsageCounterRepo.GetForUpdate(tx, cust.ID, f.ID, resp.PeriodStart)
+		if err != nil {
+			return err
+		}
+
+		inFlight = counter.InFlight(aggregated)
+		projected = aggregated.Add(inFlight).Add(quantity)
+		resp.Allowed = projected.LessThanOrEqual(limit) || entitlement.IsSoftLimit
+		if !resp.Allowed || !req.Reserve {
+			return nil
+		}
+
+		counter.Baseline = aggregated
+		counter.Reserved = inFlight.Add(quantity)
+		return s.EntitlementUsageCounterRepo.Save(tx, counter)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp.CurrentUsage = aggregated
+	resp.InFlightUsage = inFlight
+
+	used := aggregated.Add(inFlight)
+	if resp.Allowed {
+		used = projected
+	}
+	resp.Remaining = lo.ToPtr(decimal.Max(decimal.Zero, limit.Sub(used)))
+
+	if !resp.Allowed {
+		resp.Reason = "usage limit exceeded"
+	}
+
+	// Publish the webhook when the hard limit is crossed, or reached by a reservation
+	if !entitlement.IsSoftLimit && (projected.GreaterThan(limit) || (req.Reserve && projected.Equal(limit))) {
+		s.publishEntitlementLimitReached(ctx, &webhookDto.InternalEntitlementLimitEvent{
+			EntitlementID:     aggregatedFeature.Sources[0].EntitlementID,
+			CustomerID:        cust.ID,
+			FeatureID:         f.ID,
+			TenantID:          types.GetTenantID(ctx),
+			UsageLimit:        *entitlement.UsageLimit,
+			CurrentUsage:      aggregated.Add(inFlight),
+			RequestedQuantity: quantity,
+			PeriodStart:       resp.PeriodStart,
+			PeriodEnd:         resp.PeriodEnd,
+		})
+	}
+
+	return resp, nil
+}
+
+func (s *billingService) getEntitlementCheckCustomer(ctx context.Context, req *dto.CheckEntitlementRequest) (*customer.Customer, error) {
+	if req.CustomerID != "" {
+		return s.CustomerRepo.Get(ctx, req.CustomerID)
+	}
+	return s.CustomerRepo.GetByLookupKey(ctx, req.CustomerLookupKey)
+}
+
+func (s *billingService) getEntitlementCheckFeature(ctx context.Context, req *dto.CheckEntitlementRequest) (*feature.Feature, error) {
+	if req.FeatureID != "" {
+		return s.FeatureRepo.Get(ctx, req.FeatureID)
+	}
+
+	filter := types.NewDefaultFeatureFilter()
+	filter.LookupKeys = []string{req.FeatureLookupKey}
+	features, err := s.FeatureRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(features) == 0 {
+		return nil, ierr.NewError("feature not found").
+			WithHint("No feature found for the given lookup key").
+			WithReportableDetails(map[string]interface{}{
+				"feature_lookup_key": req.FeatureLookupKey,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+
+	return features[0], nil
+}
+
+// getAggregatedFeatureUsage returns the aggregated feature usage of the customer across the subscriptions
+// granting the entitlement for the given period
+func (s *billingService) getAggregatedFeatureUsage(
+	ctx context.Context,
+	cust *customer.Customer,
+	f *feature.Feature,
+	sources []*dto.EntitlementSource,
+	periodStart,
+	periodEnd time.Time,
+) (decimal.Decimal, error) {
+	m, err := s.MeterRepo.GetMeter(ctx, f.MeterID)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	total := decimal.Zero
+	subscriptionIDs := lo.Uniq(lo.Map(sources, func(source *dto.EntitlementSource, _ int) string {
+		return source.SubscriptionID
+	}))
+
+	for _, subscriptionID := range subscriptionIDs {
+		results, err := s.FeatureUsageRepo.GetFeatureUsageBySubscription(ctx, subscriptionID, []string{cust.ExternalID}, periodStart, periodEnd)
+		if err != nil {
+			return decimal.Zero, err
+		}
+
+		if err := setFeatureUsagePercentiles(ctx, s.FeatureUsageRepo, subscriptionID, []string{cust.ExternalID}, results, map[string]meter.Aggregation{m.ID: m.Aggregation}, periodStart, periodEnd); err != nil {
+			return decimal.Zero, err
+		}
+
+		for _, result := range results {
+			if result.FeatureID != f.ID && result.MeterID != f.MeterID {
+				continue
+			}
+			total = total.Add(getFeatureUsageQuantity(m.Aggregation.Type, result))
+		}
+	}
+
+	return total, nil
+}
+
+// getEntitlementUsagePeriod returns the usage reset period of the entitlement containing the given time
+func getEntitlementUsagePeriod(sub *subscription.Subscription, resetPeriod types.EntitlementUsageResetPeriod, now time.Time) (time.Time, time.Time) {
+	switch resetPeriod {
+	case types.ENTITLEMENT_USAGE_RESET_PERIOD_DAILY:
+		local := now.In(sub.CurrentPeriodStart.Location())
+		start := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, local.Location())
+		return start, start.AddDate(0, 0, 1)
+	case types.ENTITLEMENT_USAGE_RESET_PERIOD_MONTHLY:
+		if sub.BillingPeriod == types.BILLING_PERIOD_MONTHLY && sub.BillingPeriodCount <= 1 {
+			return sub.CurrentPeriodStart, sub.CurrentPeriodEnd
+		}
+		start := sub.CurrentPeriodStart
+		for !start.AddDate(0, 1, 0).After(now) {
+			start = start.AddDate(0, 1, 0)
+		}
+		return start, start.AddDate(0, 1, 0)
+	case types.ENTITLEMENT_USAGE_RESET_PERIOD_NEVER:
+		return sub.StartDate, lo.FromPtr(sub.EndDate)
+	default:
+		return sub.CurrentPeriodStart, sub.CurrentPeriodEnd
+	}
+}
+
+// publishEntitlementLimitReached publishes the entitlement.limit_reached webhook once per usage reset period
+func (s *billingService) publishEntitlementLimitReached(ctx context.Context, event *webhookDto.InternalEntitlementLimitEvent) {
+	marked, err := s.EntitlementUsageCounterRepo.MarkLimitReached(ctx, event.CustomerID, event.FeatureID, event.PeriodStart)
+	if err != nil {
+		s.Logger.Errorw("failed to record the entitlement limit reached",
+			"customer_id", event.CustomerID,
+			"feature_id", event.FeatureID,
+			"error", err)
+		return
+	}
+	if !marked {
+		return
+	}
+
+	webhookPayload, err := json.Marshal(event)
+	if err != nil {
+		s.Logger.Errorw("failed to marshal webhook payload", "error", err)
+		return
+	}
+
+	webhookEvent := &types.WebhookEvent{
+		ID:            types.GenerateUUIDWithPrefix(types.UUID_PREFIX_WEBHOOK_EVENT),
+		EventName:     types.WebhookEventEntitlementLimitReached,
+		TenantID:      types.GetTenantID(ctx),
+		EnvironmentID: types.GetEnvironmentID(ctx),
+		UserID:        types.GetUserID(ctx),
+		Timestamp:     time.Now().UTC(),
+		Payload:       json.RawMessage(webhookPayload),
+	}
+	if err := s.WebhookPublisher.PublishWebhook(ctx, webhookEvent); err != nil {
+		s.Logger.Errorf("failed to publish %s event: %v", webhookEvent.EventName, err)
+	}
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/domain/entitlement"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEntitlementUsageCounterInFlight(t *testing.T) {
+	counter := &entitlement.UsageCounter{
+		Baseline: decimal.NewFromInt(100),
+		Reserved: decimal.NewFromInt(10),
+	}
+
+	testCases := []struct {
+		name       string
+		aggregated decimal.Decimal
+		expected   decimal.Decimal
+	}{
+		{name: "nothing aggregated since the reservation", aggregated: decimal.NewFromInt(100), expected: decimal.NewFromInt(10)},
+		{name: "part of the reservation aggregated", aggregated: decimal.NewFromInt(104), expected: decimal.NewFromInt(6)},
+		{name: "whole reservation aggregated", aggregated: decimal.NewFromInt(115), expected: decimal.Zero},
+		{name: "aggregated usage below the baseline", aggregated: decimal.NewFromInt(90), expected: decimal.NewFromInt(10)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.True(t, tc.expected.Equal(counter.InFlight(tc.aggregated)), "got %s", counter.InFlight(tc.aggregated))
+		})
+	}
+}
+
+func TestGetEntitlementUsagePeriod(t *testing.T) {
+	sub := &subscription.Subscription{
+		StartDate:          time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC),
+		CurrentPeriodStart: time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC),
+		CurrentPeriodEnd:   time.Date(2027, 1, 15, 0, 0, 0, 0, time.UTC),
+		BillingPeriod:      types.BILLING_PERIOD_ANNUAL,
+		BillingPeriodCount: 1,
+	}
+	now := time.Date(2026, 3, 20, 10, 30, 0, 0, time.UTC)
+
+	start, end := getEntitlementUsagePeriod(sub, types.ENTITLEMENT_USAGE_RESET_PERIOD_DAILY, now)
+	assert.Equal(t, time.Date(2026, 3, 20, 0, 0, 0, 0, time.UTC), start)
+	assert.Equal(t, time.Date(2026, 3, 21, 0, 0, 0, 0, time.UTC), end)
+
+	start, end = getEntitlementUsagePeriod(sub, types.ENTITLEMENT_USAGE_RESET_PERIOD_MONTHLY, now)
+	assert.Equal(t, time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC), start)
+	assert.Equal(t, time.Date(2026, 4, 15, 0, 0, 0, 0, time.UTC), end)
+
+	start, end = getEntitlementUsagePeriod(sub, types.EntitlementUsageResetPeriod(types.BILLING_PERIOD_ANNUAL), now)
+	assert.Equal(t, sub.CurrentPeriodStart, start)
+	assert.Equal(t, sub.CurrentPeriodEnd, end)
+}
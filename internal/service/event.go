@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"io"
 	"sort"
 	"strconv"
 	"strings"
@@ -12,6 +13,7 @@ import (
 	"github.com/flexprice/flexprice/internal/api/dto"
 	"github.com/flexprice/flexprice/internal/config"
 	"github.com/flexprice/flexprice/internal/domain/events"
+	"github.com/flexprice/flexprice/internal/domain/eventschema"
 	"github.com/flexprice/flexprice/internal/domain/meter"
 	ierr "github.com/flexprice/flexprice/internal/errors"
 	"github.com/flexprice/flexprice/internal/logger"
@@ -24,35 +26,43 @@ import (
 
 type EventService interface {
 	CreateEvent(ctx context.Context, createEventRequest *dto.IngestEventRequest) error
-	BulkCreateEvents(ctx context.Context, createEventRequest *dto.BulkIngestEventRequest) error
+	BulkCreateEvents(ctx context.Context, createEventRequest *dto.BulkIngestEventRequest) (*dto.BulkIngestEventResponse, error)
+	StreamIngestEvents(ctx context.Context, body io.Reader) (*dto.StreamIngestEventResponse, error)
 	GetUsage(ctx context.Context, getUsageRequest *dto.GetUsageRequest) (*events.AggregationResult, error)
 	GetUsageByMeter(ctx context.Context, getUsageByMeterRequest *dto.GetUsageByMeterRequest) (*events.AggregationResult, error)
 	BulkGetUsageByMeter(ctx context.Context, req []*dto.GetUsageByMeterRequest) (map[string]*events.AggregationResult, error)
 	GetUsageByMeterWithFilters(ctx context.Context, req *dto.GetUsageByMeterRequest, filterGroups map[string]map[string][]string) ([]*events.AggregationResult, error)
 	GetEvents(ctx context.Context, req *dto.GetEventsRequest) (*dto.GetEventsResponse, error)
+	GetRejectedEvents(ctx context.Context, req *dto.GetRejectedEventsRequest) (*dto.GetRejectedEventsResponse, error)
 }
 
 type eventService struct {
-	eventRepo events.Repository
-	meterRepo meter.Repository
-	publisher publisher.EventPublisher
-	logger    *logger.Logger
-	config    *config.Configuration
+	eventRepo         events.Repository
+	meterRepo         meter.Repository
+	eventSchemaRepo   eventschema.Repository
+	rejectedEventRepo events.RejectedEventRepository
+	publisher         publisher.EventPublisher
+	logger            *logger.Logger
+	config            *config.Configuration
 }
 
 func NewEventService(
 	eventRepo events.Repository,
 	meterRepo meter.Repository,
+	eventSchemaRepo eventschema.Repository,
+	rejectedEventRepo events.RejectedEventRepository,
 	publisher publisher.EventPublisher,
 	logger *logger.Logger,
 	config *config.Configuration,
 ) EventService {
 	return &eventService{
-		eventRepo: eventRepo,
-		meterRepo: meterRepo,
-		publisher: publisher,
-		logger:    logger,
-		config:    config,
+		eventRepo:         eventRepo,
+		meterRepo:         meterRepo,
+		eventSchemaRepo:   eventSchemaRepo,
+		rejectedEventRepo: rejectedEventRepo,
+		publisher:         publisher,
+		logger:            logger,
+		config:            config,
 	}
 }
 
@@ -62,7 +72,78 @@ func (s *eventService) CreateEvent(ctx context.Context, createEventRequest *dto.
 	}
 
 	event := createEventRequest.ToEvent(ctx)
+	createEventRequest.EventID = event.ID
+
+	rejectedEvent := s.validateEventSchema(ctx, event)
+	if rejectedEvent != nil {
+		s.storeRejectedEvents(ctx, []*events.RejectedEvent{rejectedEvent})
+
+		if rejectedEvent.Action == types.RejectedEventActionRejected {
+			return ierr.NewError("event does not match the schema of its event name").
+				WithHintf("Event %s was rejected because it does not match its event schema", event.EventName).
+				WithReportableDetails(map[string]interface{}{
+					"event_id":   event.ID,
+					"event_name": event.EventName,
+					"violations": rejectedEvent.Violations,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	s.publishEvent(ctx, event)
+	return nil
+}
+
+// CreateBulkEvents creates multiple events in a single operation. Events violating the schema
+// of their event name are reported in the response instead of failing the whole request.
+func (s *eventService) BulkCreateEvents(ctx context.Context, bulkRequest *dto.BulkIngestEventRequest) (*dto.BulkIngestEventResponse, error) {
+	response := &dto.BulkIngestEventResponse{
+		Message:    "Events accepted for processing",
+		Violations: make([]*dto.EventSchemaViolationResult, 0),
+	}
+	if len(bulkRequest.Events) == 0 {
+		return response, nil
+	}
+
+	for _, req := range bulkRequest.Events {
+		if err := req.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	rejectedEvents := make([]*events.RejectedEvent, 0)
+	for i, req := range bulkRequest.Events {
+		event := req.ToEvent(ctx)
+		req.EventID = event.ID
+
+		rejectedEvent := s.validateEventSchema(ctx, event)
+		if rejectedEvent != nil {
+			rejectedEvents = append(rejectedEvents, rejectedEvent)
+			response.Violations = append(response.Violations, &dto.EventSchemaViolationResult{
+				Index:      i,
+				EventID:    event.ID,
+				EventName:  event.EventName,
+				Action:     rejectedEvent.Action,
+				Violations: rejectedEvent.Violations,
+			})
+
+			if rejectedEvent.Action == types.RejectedEventActionRejected {
+				response.Rejected++
+				continue
+			}
+			response.Flagged++
+		}
 
+		// publish events to Kafka for downstream processing
+		s.publishEvent(ctx, event)
+		response.Accepted++
+	}
+
+	s.storeRejectedEvents(ctx, rejectedEvents)
+	return response, nil
+}
+
+func (s *eventService) publishEvent(ctx context.Context, event *events.Event) {
 	if err := s.publisher.Publish(ctx, event); err != nil {
 		// Log the error but don't fail the request
 		s.logger.With(
@@ -70,25 +151,116 @@ func (s *eventService) CreateEvent(ctx context.Context, createEventRequest *dto.
 			"error", err,
 		).Error("failed to publish event")
 	}
+}
 
-	createEventRequest.EventID = event.ID
-	return nil
+// validateEventSchema checks the event against the schema of its event name and returns the
+// rejected event to record when it violates a schema in reject or flag mode
+func (s *eventService) validateEventSchema(ctx context.Context, event *events.Event) *events.RejectedEvent {
+	return validateEventAgainstSchema(event, s.getEventSchema(ctx, event.EventName))
 }
 
-// CreateBulkEvents creates multiple events in a single operation
-func (s *eventService) BulkCreateEvents(ctx context.Context, events *dto.BulkIngestEventRequest) error {
-	if len(events.Events) == 0 {
+// getEventSchema returns the schema of the event name, or nil if there is none or the lookup failed
+func (s *eventService) getEventSchema(ctx context.Context, eventName string) *eventschema.EventSchema {
+	if s.eventSchemaRepo == nil {
 		return nil
 	}
 
-	// publish events to Kafka for downstream processing
-	for _, event := range events.Events {
-		if err := s.CreateEvent(ctx, event); err != nil {
-			return err
-		}
+	schema, err := s.eventSchemaRepo.GetByEventName(ctx, eventName)
+	if err != nil {
+		// Schema lookups must not block ingestion
+		s.logger.Errorw("failed to get event schema, skipping validation",
+			"event_name", eventName,
+			"error", err)
+		return nil
 	}
+	return schema
+}
 
-	return nil
+func validateEventAgainstSchema(event *events.Event, schema *eventschema.EventSchema) *events.RejectedEvent {
+	if schema == nil {
+		return nil
+	}
+
+	violations := schema.ValidateProperties(event.Properties)
+	if len(violations) == 0 {
+		return nil
+	}
+
+	action := types.RejectedEventActionRejected
+	if schema.ValidationMode == types.EventSchemaValidationModeFlag {
+		action = types.RejectedEventActionFlagged
+	}
+
+	return &events.RejectedEvent{
+		Event:      *event,
+		SchemaID:   schema.ID,
+		Action:     action,
+		Violations: violations,
+		RejectedAt: time.Now().UTC(),
+	}
+}
+
+func (s *eventService) storeRejectedEvents(ctx context.Context, rejectedEvents []*events.RejectedEvent) {
+	if len(rejectedEvents) == 0 || s.rejectedEventRepo == nil {
+		return
+	}
+
+	if err := s.rejectedEventRepo.BulkInsertRejectedEvents(ctx, rejectedEvents); err != nil {
+		// Log the error but don't fail the request
+		s.logger.Errorw("failed to store rejected events",
+			"count", len(rejectedEvents),
+			"error", err)
+	}
+}
+
+func (s *eventService) GetRejectedEvents(ctx context.Context, req *dto.GetRejectedEventsRequest) (*dto.GetRejectedEventsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	if req.PageSize <= 0 || req.PageSize > 50 {
+		req.PageSize = 50
+	}
+
+	rejectedEvents, total, err := s.rejectedEventRepo.GetRejectedEvents(ctx, &events.GetRejectedEventsParams{
+		EventName:          req.EventName,
+		ExternalCustomerID: req.ExternalCustomerID,
+		Action:             req.Action,
+		StartTime:          req.StartTime,
+		EndTime:            req.EndTime,
+		PageSize:           req.PageSize,
+		Offset:             req.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.GetRejectedEventsResponse{
+		Events:     make([]*dto.RejectedEvent, 0, len(rejectedEvents)),
+		HasMore:    uint64(req.Offset+len(rejectedEvents)) < total,
+		TotalCount: total,
+		Offset:     req.Offset,
+	}
+	for _, event := range rejectedEvents {
+		response.Events = append(response.Events, &dto.RejectedEvent{
+			Event: dto.Event{
+				ID:                 event.ID,
+				ExternalCustomerID: event.ExternalCustomerID,
+				CustomerID:         event.CustomerID,
+				EventName:          event.EventName,
+				Timestamp:          event.Timestamp,
+				Properties:         event.Properties,
+				Source:             event.Source,
+				EnvironmentID:      event.EnvironmentID,
+			},
+			SchemaID:   event.SchemaID,
+			Action:     event.Action,
+			Violations: event.Violations,
+			RejectedAt: event.RejectedAt,
+		})
+	}
+
+	return response, nil
 }
 
 func (s *eventService) GetUsage(ctx context.Context, getUsageRequest *dto.GetUsageRequest) (*events.AggregationResult, error) {
@@ -123,11 +295,13 @@ func (s *eventService) GetUsageByMeter(ctx context.Context, req *dto.GetUsageByM
 		CustomerID:         req.CustomerID,
 		EventName:          m.EventName,
 		PropertyName:       m.Aggregation.Field,
+		Expression:         m.Aggregation.Expression,
 		AggregationType:    m.Aggregation.Type,
 		StartTime:          req.StartTime,
 		WindowSize:         req.WindowSize,
 		EndTime:            req.EndTime,
 		Filters:            req.Filters,
+		FilterConditions:   req.FilterConditions,
 		PriceID:            req.PriceID,
 		MeterID:            req.MeterID,
 		BillingAnchor:      req.BillingAnchor,
@@ -138,8 +312,13 @@ func (s *eventService) GetUsageByMeter(ctx context.Context, req *dto.GetUsageByM
 		getUsageRequest.Multiplier = m.Aggregation.Multiplier
 	}
 
-	// Pass the bucket_size from meter configuration if it's a MAX aggregation with bucket_size set
-	if m.IsBucketedMaxMeter() {
+	// Pass the percentile from meter configuration if it's a PERCENTILE aggregation
+	if m.Aggregation.Type == types.AggregationPercentile {
+		getUsageRequest.Percentile = m.Aggregation.Percentile
+	}
+
+	// Pass the bucket_size from meter configuration if it's a bucketed aggregation
+	if m.IsBucketedMeter() {
 		getUsageRequest.BucketSize = m.Aggregation.BucketSize
 	}
 
@@ -319,8 +498,13 @@ func (s *eventService) GetUsageByMeterWithFilters(ctx context.Context, req *dto.
 	}
 
 	meterFilters := make(map[string][]string)
+	meterFilterConditions := make([]meter.Filter, 0)
 	for _, filter := range m.Filters {
-		meterFilters[filter.Key] = filter.Values
+		if filter.IsExactMatch() {
+			meterFilters[filter.Key] = filter.Values
+		} else {
+			meterFilterConditions = append(meterFilterConditions, filter)
+		}
 	}
 
 	// Extract and sort priceIDs for stable ordering
@@ -350,6 +534,7 @@ func (s *eventService) GetUsageByMeterWithFilters(ctx context.Context, req *dto.
 			StartTime:          req.StartTime,
 			EndTime:            req.EndTime,
 			Filters:            meterFilters,
+			FilterConditions:   meterFilterConditions,
 		},
 		FilterGroups: prioritizedGroups,
 	}
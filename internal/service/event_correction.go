@@ -0,0 +1,521 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/events"
+	"github.com/flexprice/flexprice/internal/domain/invoice"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// EventCorrectionService amends and retracts ingested events. Corrected events are excluded from usage
+// through their correction record and the invoices billing them are re-rated.
+type EventCorrectionService interface {
+	AmendEvent(ctx context.Context, eventID string, req *dto.AmendEventRequest) (*dto.EventCorrectionResponse, error)
+	RetractEvent(ctx context.Context, eventID string, req *dto.RetractEventRequest) (*dto.EventCorrectionResponse, error)
+}
+
+type eventCorrectionService struct {
+	ServiceParams
+	featureUsageTrackingService FeatureUsageTrackingService
+}
+
+func NewEventCorrectionService(params ServiceParams, featureUsageTrackingService FeatureUsageTrackingService) EventCorrectionService {
+	return &eventCorrectionService{
+		ServiceParams:               params,
+		featureUsageTrackingService: featureUsageTrackingService,
+	}
+}
+
+func (s *eventCorrectionService) AmendEvent(ctx context.Context, eventID string, req *dto.AmendEventRequest) (*dto.EventCorrectionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	event, err := s.getCorrectableEvent(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The replacement keeps the identity and timestamp of the event so it is billed in the same period
+	replacement := events.NewEvent(
+		event.EventName,
+		event.TenantID,
+		event.ExternalCustomerID,
+		req.Properties,
+		event.Timestamp,
+		"", // Generate new ID
+		event.CustomerID,
+		event.Source,
+		event.EnvironmentID,
+	)
+
+	correction := s.newCorrection(ctx, event, types.EventCorrectionActionAmend, req.Reason)
+	correction.ReplacementEventID = replacement.ID
+	if err := s.recordCorrection(ctx, correction); err != nil {
+		return nil, err
+	}
+
+	// The replacement is inserted directly so the re-rating below already sees the amended usage
+	if err := s.EventRepo.InsertEvent(ctx, replacement); err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("The event was retracted but its replacement could not be stored, please ingest the amended event again").
+			WithReportableDetails(map[string]interface{}{
+				"event_id":             event.ID,
+				"replacement_event_id": replacement.ID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	if err := s.featureUsageTrackingService.PublishEvent(ctx, replacement, false); err != nil {
+		// Log the error but don't fail the request, the replacement is picked up by ReprocessEvents
+		s.Logger.Errorw("failed to publish replacement event for feature usage tracking",
+			"event_id", event.ID,
+			"replacement_event_id", replacement.ID,
+			"error", err,
+		)
+	}
+
+	s.Logger.Infow("amended event",
+		"event_id", event.ID,
+		"replacement_event_id", replacement.ID,
+		"correction_id", correction.ID,
+	)
+
+	response := dto.ToEventCorrectionResponse(correction)
+	s.reRateInvoices(ctx, event, correction, response)
+	return response, nil
+}
+
+func (s *eventCorrectionService) RetractEvent(ctx context.Context, eventID string, req *dto.RetractEventRequest) (*dto.EventCorrectionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	event, err := s.getCorrectableEvent(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	correction := s.newCorrection(ctx, event, types.EventCorrectionActionRetract, req.Reason)
+	if err := s.recordCorrection(ctx, correction); err != nil {
+		return nil, err
+	}
+
+	s.Logger.Infow("retracted event",
+		"event_id", event.ID,
+		"correction_id", correction.ID,
+	)
+
+	response := dto.ToEventCorrectionResponse(correction)
+	s.reRateInvoices(ctx, event, correction, response)
+	return response, nil
+}
+
+// getCorrectableEvent returns the event if it exists and has not been amended or retracted yet
+func (s *eventCorrectionService) getCorrectableEvent(ctx context.Context, eventID string) (*events.Event, error) {
+	found, _, err := s.EventRepo.GetEvents(ctx, &events.GetEventsParams{
+		EventID:  eventID,
+		PageSize: 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(found) == 0 {
+		return nil, ierr.NewError("event not found").
+			WithHintf("Event %s was not found", eventID).
+			WithReportableDetails(map[string]interface{}{
+				"event_id": eventID,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+
+	corrections, _, err := s.EventCorrectionRepo.GetEventCorrections(ctx, &events.GetEventCorrectionsParams{
+		EventIDs: []string{eventID},
+		PageSize: 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(corrections) > 0 {
+		return nil, ierr.NewError("event has already been corrected").
+			WithHintf("Event %s was already %sed, correct its replacement event instead", eventID, corrections[0].Action).
+			WithReportableDetails(map[string]interface{}{
+				"event_id":             eventID,
+				"action":               corrections[0].Action,
+				"replacement_event_id": corrections[0].ReplacementEventID,
+			}).
+			Mark(ierr.ErrAlreadyExists)
+	}
+
+	return found[0], nil
+}
+
+// recordCorrection stores the correction once its event is claimed. The claim is unique per event so
+// concurrent corrections of the same event cannot both be recorded.
+func (s *eventCorrectionService) recordCorrection(ctx context.Context, correction *events.EventCorrection) error {
+	if err := s.EventCorrectionClaimRepo.ClaimEvent(ctx, correction); err != nil {
+		return err
+	}
+
+	if err := s.EventCorrectionRepo.InsertEventCorrection(ctx, correction); err != nil {
+		if releaseErr := s.EventCorrectionClaimRepo.ReleaseEvent(ctx, correction); releaseErr != nil {
+			s.Logger.Errorw("failed to release event claimed for correction",
+				"event_id", correction.EventID,
+				"correction_id", correction.ID,
+				"error", releaseErr,
+			)
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *eventCorrectionService) newCorrection(ctx context.Context, event *events.Event, action types.EventCorrectionAction, reason string) *events.EventCorrection {
+	return &events.EventCorrection{
+		ID:                 types.GenerateUUIDWithPrefix(types.UUID_PREFIX_EVENT_CORRECTION),
+		TenantID:           event.TenantID,
+		EnvironmentID:      event.EnvironmentID,
+		EventID:            event.ID,
+		EventName:          event.EventName,
+		ExternalCustomerID: event.ExternalCustomerID,
+		EventTimestamp:     event.Timestamp,
+		Action:             action,
+		Reason:             reason,
+		CreatedAt:          time.Now().UTC(),
+		CreatedBy:          types.GetUserID(ctx),
+	}
+}
+
+// reRateInvoices brings the subscription invoices billing the period of the corrected event in line
+// with the corrected usage. Draft invoices are recalculated, finalized invoices get a draft credit note
+// when they overcharged and a draft adjustment invoice when they undercharged. Failures are logged as
+// the correction itself is already recorded.
+func (s *eventCorrectionService) reRateInvoices(ctx context.Context, event *events.Event, correction *events.EventCorrection, response *dto.EventCorrectionResponse) {
+	customer, err := s.CustomerRepo.GetByLookupKey(ctx, event.ExternalCustomerID)
+	if err != nil {
+		if !ierr.IsNotFound(err) {
+			s.Logger.Errorw("failed to get customer for re-rating corrected event",
+				"event_id", event.ID,
+				"error", err,
+			)
+		}
+		return
+	}
+
+	filter := types.NewNoLimitInvoiceFilter()
+	filter.CustomerID = customer.ID
+	filter.InvoiceType = types.InvoiceTypeSubscription
+	filter.InvoiceStatus = []types.InvoiceStatus{types.InvoiceStatusDraft, types.InvoiceStatusFinalized}
+
+	invoices, err := s.InvoiceRepo.List(ctx, filter)
+	if err != nil {
+		s.Logger.Errorw("failed to list invoices for re-rating corrected event",
+			"event_id", event.ID,
+			"error", err,
+		)
+		return
+	}
+
+	invoiceService := NewInvoiceService(s.ServiceParams)
+	for _, inv := range invoices {
+		if !invoiceBillsEvent(inv, event) {
+			continue
+		}
+
+		switch inv.InvoiceStatus {
+		case types.InvoiceStatusDraft:
+			if _, err := invoiceService.RecalculateInvoice(ctx, inv.ID, false); err != nil {
+				s.Logger.Errorw("failed to recalculate draft invoice for corrected event",
+					"event_id", event.ID,
+					"invoice_id", inv.ID,
+					"error", err,
+				)
+				continue
+			}
+			response.RecalculatedInvoiceIDs = append(response.RecalculatedInvoiceIDs, inv.ID)
+		case types.InvoiceStatusFinalized:
+			if err := s.adjustFinalizedInvoice(ctx, inv.ID, correction, response); err != nil {
+				s.Logger.Errorw("failed to draft adjustment of finalized invoice for corrected event",
+					"event_id", event.ID,
+					"invoice_id", inv.ID,
+					"error", err,
+				)
+			}
+		}
+	}
+}
+
+// invoiceBillsEvent checks if the event falls in the billing period of the subscription invoice
+func invoiceBillsEvent(inv *invoice.Invoice, event *events.Event) bool {
+	if inv.SubscriptionID == nil || inv.PeriodStart == nil || inv.PeriodEnd == nil {
+		return false
+	}
+	return !event.Timestamp.Before(*inv.PeriodStart) && event.Timestamp.Before(*inv.PeriodEnd)
+}
+
+// adjustFinalizedInvoice re-rates the usage line items of a finalized invoice and drafts credit notes
+// for the overcharged amounts and a one-off invoice for the undercharged amounts. The amounts adjusted
+// by earlier corrections of the invoice are taken into account, see getCorrectedLineItems.
+func (s *eventCorrectionService) adjustFinalizedInvoice(ctx context.Context, invoiceID string, correction *events.EventCorrection, response *dto.EventCorrectionResponse) error {
+	inv, err := s.InvoiceRepo.Get(ctx, invoiceID)
+	if err != nil {
+		return err
+	}
+
+	sub, _, err := s.SubRepo.GetWithLineItems(ctx, *inv.SubscriptionID)
+	if err != nil {
+		return err
+	}
+
+	usage, err := NewSubscriptionService(s.ServiceParams).GetUsageBySubscription(ctx, &dto.GetUsageBySubscriptionRequest{
+		SubscriptionID: sub.ID,
+		StartTime:      *inv.PeriodStart,
+		EndTime:        *inv.PeriodEnd,
+	})
+	if err != nil {
+		return err
+	}
+
+	usageCharges, _, err := NewBillingService(s.ServiceParams).CalculateUsageCharges(ctx, sub, usage, *inv.PeriodStart, *inv.PeriodEnd)
+	if err != nil {
+		return err
+	}
+
+	lineItems, credited, err := s.getCorrectedLineItems(ctx, inv, correction)
+	if err != nil {
+		return err
+	}
+
+	creditLineItems, adjustmentLineItems := reRateUsageLineItems(lineItems, credited, usageCharges)
+	metadata := types.Metadata{
+		"event_correction_id": correction.ID,
+		"event_id":            correction.EventID,
+		"invoice_id":          inv.ID,
+	}
+	memo := fmt.Sprintf("Usage correction of event %s", correction.EventID)
+
+	// credits are issued on the invoice of the credited line item, the corrected invoice or an adjustment
+	lineItemInvoiceIDs := make(map[string]string, len(lineItems))
+	for _, item := range lineItems {
+		lineItemInvoiceIDs[item.ID] = item.InvoiceID
+	}
+	creditInvoiceIDs := lo.Uniq(lo.Map(creditLineItems, func(item dto.CreateCreditNoteLineItemRequest, _ int) string {
+		return lineItemInvoiceIDs[item.InvoiceLineItemID]
+	}))
+	for _, invoiceID := range creditInvoiceIDs {
+		creditNote, err := NewCreditNoteService(s.ServiceParams).CreateCreditNote(ctx, &dto.CreateCreditNoteRequest{
+			InvoiceID: invoiceID,
+			Reason:    types.CreditNoteReasonBillingError,
+			Memo:      memo,
+			Metadata:  metadata,
+			LineItems: lo.Filter(creditLineItems, func(item dto.CreateCreditNoteLineItemRequest, _ int) bool {
+				return lineItemInvoiceIDs[item.InvoiceLineItemID] == invoiceID
+			}),
+			ProcessCreditNote: false,
+		})
+		if err != nil {
+			return err
+		}
+		response.CreditNoteIDs = append(response.CreditNoteIDs, creditNote.ID)
+	}
+
+	if len(adjustmentLineItems) > 0 {
+		total := decimal.Zero
+		for _, item := range adjustmentLineItems {
+			total = total.Add(item.Amount)
+		}
+
+		adjustment, err := NewInvoiceService(s.ServiceParams).CreateInvoice(ctx, dto.CreateInvoiceRequest{
+			CustomerID:     inv.CustomerID,
+			SubscriptionID: inv.SubscriptionID,
+			IdempotencyKey: lo.ToPtr(fmt.Sprintf("%s_%s", correction.ID, inv.ID)),
+			InvoiceType:    types.InvoiceTypeOneOff,
+			InvoiceStatus:  lo.ToPtr(types.InvoiceStatusDraft),
+			Currency:       inv.Currency,
+			AmountDue:      total,
+			Total:          total,
+			Subtotal:       total,
+			Description:    memo,
+			PeriodStart:    inv.PeriodStart,
+			PeriodEnd:      inv.PeriodEnd,
+			BillingReason:  types.InvoiceBillingReasonManual,
+			LineItems:      adjustmentLineItems,
+			Metadata:       metadata,
+		})
+		if err != nil {
+			return err
+		}
+		response.AdjustmentInvoiceIDs = append(response.AdjustmentInvoiceIDs, adjustment.ID)
+	}
+
+	return nil
+}
+
+// getCorrectedLineItems returns the line items billing the usage of a finalized invoice, its own and those
+// of the finalized adjustment invoices of earlier corrections, with the amounts credited on each of them by
+// the credit notes of earlier corrections. The draft adjustment invoices of earlier corrections are voided,
+// their amounts are billed again by the new correction.
+func (s *eventCorrectionService) getCorrectedLineItems(ctx context.Context, inv *invoice.Invoice, correction *events.EventCorrection) ([]*invoice.InvoiceLineItem, map[string]decimal.Decimal, error) {
+	lineItems := append([]*invoice.InvoiceLineItem{}, inv.LineItems...)
+	invoiceIDs := []string{inv.ID}
+
+	filter := types.NewNoLimitInvoiceFilter()
+	filter.CustomerID = inv.CustomerID
+	filter.SubscriptionID = lo.FromPtr(inv.SubscriptionID)
+	filter.InvoiceType = types.InvoiceTypeOneOff
+	filter.InvoiceStatus = []types.InvoiceStatus{types.InvoiceStatusDraft, types.InvoiceStatusFinalized}
+
+	adjustments, err := s.InvoiceRepo.List(ctx, filter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	invoiceService := NewInvoiceService(s.ServiceParams)
+	for _, adjustment := range adjustments {
+		if adjustment.Metadata["event_correction_id"] == "" || adjustment.Metadata["invoice_id"] != inv.ID {
+			continue
+		}
+		if adjustment.InvoiceStatus == types.InvoiceStatusDraft {
+			if err := invoiceService.VoidInvoice(ctx, adjustment.ID, dto.InvoiceVoidRequest{
+				Metadata: types.Metadata{"superseded_by_event_correction_id": correction.ID},
+			}); err != nil {
+				return nil, nil, err
+			}
+			continue
+		}
+		lineItems = append(lineItems, adjustment.LineItems...)
+		invoiceIDs = append(invoiceIDs, adjustment.ID)
+	}
+
+	credited := make(map[string]decimal.Decimal)
+	for _, invoiceID := range invoiceIDs {
+		creditNoteFilter := types.NewNoLimitCreditNoteFilter()
+		creditNoteFilter.InvoiceID = invoiceID
+		creditNoteFilter.CreditNoteStatus = []types.CreditNoteStatus{types.CreditNoteStatusDraft, types.CreditNoteStatusFinalized}
+
+		creditNotes, err := s.CreditNoteRepo.List(ctx, creditNoteFilter)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, creditNote := range creditNotes {
+			if creditNote.Metadata["event_correction_id"] == "" {
+				continue
+			}
+			for _, item := range creditNote.LineItems {
+				credited[item.InvoiceLineItemID] = credited[item.InvoiceLineItemID].Add(item.Amount)
+			}
+		}
+	}
+
+	return lineItems, credited, nil
+}
+
+// usageLineItemKey identifies the usage billed by a line item of a price: the child customer the usage
+// belongs to, the cell of a matrix price and whether the usage is billed as overage
+func usageLineItemKey(priceID string, metadata types.Metadata) string {
+	return strings.Join([]string{priceID, metadata["customer_id"], metadata["dimensions"], metadata["is_overage"]}, "|")
+}
+
+// reRateUsageLineItems compares the usage line items of an invoice, net of the amounts credited on them,
+// with the re-rated usage charges of its period and returns the credit note line items for overcharged
+// usage and the invoice line items for undercharged usage. Line items and charges are matched on the
+// usage they bill, see usageLineItemKey.
+func reRateUsageLineItems(lineItems []*invoice.InvoiceLineItem, credited map[string]decimal.Decimal, usageCharges []dto.CreateInvoiceLineItemRequest) ([]dto.CreateCreditNoteLineItemRequest, []dto.CreateInvoiceLineItemRequest) {
+	reRated := make(map[string]*dto.CreateInvoiceLineItemRequest)
+	reRatedKeys := make([]string, 0, len(usageCharges))
+	for _, charge := range usageCharges {
+		if charge.PriceID == nil {
+			continue
+		}
+		key := usageLineItemKey(*charge.PriceID, charge.Metadata)
+		if existing, ok := reRated[key]; ok {
+			existing.Amount = existing.Amount.Add(charge.Amount)
+			existing.Quantity = existing.Quantity.Add(charge.Quantity)
+			continue
+		}
+		charge := charge
+		reRated[key] = &charge
+		reRatedKeys = append(reRatedKeys, key)
+	}
+
+	invoiced := make(map[string][]*invoice.InvoiceLineItem)
+	invoicedKeys := make([]string, 0, len(lineItems))
+	for _, item := range lineItems {
+		if item.PriceID == nil || lo.FromPtr(item.PriceType) != string(types.PRICE_TYPE_USAGE) {
+			continue
+		}
+		key := usageLineItemKey(*item.PriceID, item.Metadata)
+		if _, ok := invoiced[key]; !ok {
+			invoicedKeys = append(invoicedKeys, key)
+		}
+		invoiced[key] = append(invoiced[key], item)
+	}
+
+	creditLineItems := make([]dto.CreateCreditNoteLineItemRequest, 0)
+	adjustmentLineItems := make([]dto.CreateInvoiceLineItemRequest, 0)
+	for _, key := range invoicedKeys {
+		items := invoiced[key]
+		amount, quantity := decimal.Zero, decimal.Zero
+		for _, item := range items {
+			amount = amount.Add(item.Amount).Sub(credited[item.ID])
+			quantity = quantity.Add(item.Quantity)
+		}
+		reRatedAmount, reRatedQuantity := decimal.Zero, decimal.Zero
+		if charge, ok := reRated[key]; ok {
+			reRatedAmount, reRatedQuantity = charge.Amount, charge.Quantity
+		}
+
+		difference := reRatedAmount.Sub(amount)
+		switch {
+		case difference.IsNegative():
+			// The overcharge is credited against the line items, each up to its amount not yet credited
+			remaining := difference.Neg()
+			for _, item := range items {
+				credit := decimal.Min(remaining, item.Amount.Sub(credited[item.ID]))
+				if !credit.IsPositive() {
+					continue
+				}
+				creditLineItems = append(creditLineItems, dto.CreateCreditNoteLineItemRequest{
+					InvoiceLineItemID: item.ID,
+					DisplayName:       lo.FromPtr(item.DisplayName),
+					Amount:            credit,
+				})
+				remaining = remaining.Sub(credit)
+			}
+		case difference.IsPositive():
+			item := items[0]
+			adjustmentLineItems = append(adjustmentLineItems, dto.CreateInvoiceLineItemRequest{
+				EntityID:         item.EntityID,
+				EntityType:       item.EntityType,
+				PriceID:          item.PriceID,
+				PriceType:        item.PriceType,
+				MeterID:          item.MeterID,
+				MeterDisplayName: item.MeterDisplayName,
+				DisplayName:      item.DisplayName,
+				Amount:           difference,
+				Quantity:         reRatedQuantity.Sub(quantity),
+				PeriodStart:      item.PeriodStart,
+				PeriodEnd:        item.PeriodEnd,
+				Metadata:         item.Metadata,
+			})
+		}
+	}
+
+	// Usage that none of the line items of the invoice billed is charged in full
+	for _, key := range reRatedKeys {
+		if _, ok := invoiced[key]; ok || !reRated[key].Amount.IsPositive() {
+			continue
+		}
+		adjustmentLineItems = append(adjustmentLineItems, *reRated[key])
+	}
+
+	return creditLineItems, adjustmentLineItems
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/invoice"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReRateUsageLineItems(t *testing.T) {
+	usage := lo.ToPtr(string(types.PRICE_TYPE_USAGE))
+	lineItems := []*invoice.InvoiceLineItem{
+		{ID: "inv_line_fixed", PriceID: lo.ToPtr("price_fixed"), PriceType: lo.ToPtr(string(types.PRICE_TYPE_FIXED)), Amount: decimal.NewFromInt(50), Quantity: decimal.NewFromInt(1)},
+		{ID: "inv_line_api", PriceID: lo.ToPtr("price_api"), PriceType: usage, Amount: decimal.NewFromInt(100), Quantity: decimal.NewFromInt(1000)},
+		{ID: "inv_line_storage", PriceID: lo.ToPtr("price_storage"), PriceType: usage, Amount: decimal.NewFromInt(20), Quantity: decimal.NewFromInt(20)},
+		{ID: "inv_line_seats", PriceID: lo.ToPtr("price_seats"), PriceType: usage, Amount: decimal.NewFromInt(30), Quantity: decimal.NewFromInt(3)},
+		{ID: "inv_line_tokens", PriceID: lo.ToPtr("price_tokens"), PriceType: usage, Amount: decimal.NewFromInt(5), Quantity: decimal.NewFromInt(500)},
+	}
+	usageCharges := []dto.CreateInvoiceLineItemRequest{
+		{PriceID: lo.ToPtr("price_api"), Amount: decimal.NewFromInt(90), Quantity: decimal.NewFromInt(900)},
+		{PriceID: lo.ToPtr("price_storage"), Amount: decimal.NewFromInt(26), Quantity: decimal.NewFromInt(26)},
+		{PriceID: lo.ToPtr("price_seats"), Amount: decimal.NewFromInt(30), Quantity: decimal.NewFromInt(3)},
+	}
+
+	credits, adjustments := reRateUsageLineItems(lineItems, nil, usageCharges)
+
+	// api calls were overcharged and tokens have no usage left after the correction
+	assert.Len(t, credits, 2)
+	assert.Equal(t, "inv_line_api", credits[0].InvoiceLineItemID)
+	assert.True(t, decimal.NewFromInt(10).Equal(credits[0].Amount), "got %s", credits[0].Amount)
+	assert.Equal(t, "inv_line_tokens", credits[1].InvoiceLineItemID)
+	assert.True(t, decimal.NewFromInt(5).Equal(credits[1].Amount), "got %s", credits[1].Amount)
+
+	// storage was undercharged, fixed and unchanged prices are left alone
+	assert.Len(t, adjustments, 1)
+	assert.Equal(t, "price_storage", lo.FromPtr(adjustments[0].PriceID))
+	assert.True(t, decimal.NewFromInt(6).Equal(adjustments[0].Amount), "got %s", adjustments[0].Amount)
+	assert.True(t, decimal.NewFromInt(6).Equal(adjustments[0].Quantity), "got %s", adjustments[0].Quantity)
+}
+
+func TestReRateUsageLineItemsPerCustomer(t *testing.T) {
+	usage := lo.ToPtr(string(types.PRICE_TYPE_USAGE))
+	child := types.Metadata{"customer_id": "cust_child"}
+	lineItems := []*invoice.InvoiceLineItem{
+		{ID: "inv_line_parent", PriceID: lo.ToPtr("price_api"), PriceType: usage, Amount: decimal.NewFromInt(40), Quantity: decimal.NewFromInt(400)},
+		{ID: "inv_line_child", PriceID: lo.ToPtr("price_api"), PriceType: usage, Amount: decimal.NewFromInt(60), Quantity: decimal.NewFromInt(600), Metadata: child},
+	}
+	usageCharges := []dto.CreateInvoiceLineItemRequest{
+		{PriceID: lo.ToPtr("price_api"), Amount: decimal.NewFromInt(40), Quantity: decimal.NewFromInt(400)},
+		{PriceID: lo.ToPtr("price_api"), Amount: decimal.NewFromInt(50), Quantity: decimal.NewFromInt(500), Metadata: child},
+	}
+
+	credits, adjustments := reRateUsageLineItems(lineItems, nil, usageCharges)
+
+	// only the usage of the child customer changed
+	assert.Len(t, credits, 1)
+	assert.Equal(t, "inv_line_child", credits[0].InvoiceLineItemID)
+	assert.True(t, decimal.NewFromInt(10).Equal(credits[0].Amount), "got %s", credits[0].Amount)
+	assert.Empty(t, adjustments)
+}
+
+func TestReRateUsageLineItemsAfterEarlierCorrection(t *testing.T) {
+	usage := lo.ToPtr(string(types.PRICE_TYPE_USAGE))
+	lineItems := []*invoice.InvoiceLineItem{
+		{ID: "inv_line_api", InvoiceID: "inv_1", PriceID: lo.ToPtr("price_api"), PriceType: usage, Amount: decimal.NewFromInt(100), Quantity: decimal.NewFromInt(1000)},
+		{ID: "inv_line_storage", InvoiceID: "inv_1", PriceID: lo.ToPtr("price_storage"), PriceType: usage, Amount: decimal.NewFromInt(20), Quantity: decimal.NewFromInt(20)},
+	}
+
+	// the first correction overcharges api calls and undercharges storage
+	credits, adjustments := reRateUsageLineItems(lineItems, nil, []dto.CreateInvoiceLineItemRequest{
+		{PriceID: lo.ToPtr("price_api"), Amount: decimal.NewFromInt(90), Quantity: decimal.NewFromInt(900)},
+		{PriceID: lo.ToPtr("price_storage"), Amount: decimal.NewFromInt(26), Quantity: decimal.NewFromInt(26)},
+	})
+	assert.Len(t, credits, 1)
+	assert.Len(t, adjustments, 1)
+
+	// the credit note and the finalized adjustment invoice of the first correction
+	credited := map[string]decimal.Decimal{credits[0].InvoiceLineItemID: credits[0].Amount}
+	lineItems = append(lineItems, &invoice.InvoiceLineItem{
+		ID:        "adj_line_storage",
+		InvoiceID: "inv_adj",
+		PriceID:   adjustments[0].PriceID,
+		PriceType: adjustments[0].PriceType,
+		Amount:    adjustments[0].Amount,
+		Quantity:  adjustments[0].Quantity,
+		Metadata:  adjustments[0].Metadata,
+	})
+
+	// the second correction only bills the change since the first one
+	credits, adjustments = reRateUsageLineItems(lineItems, credited, []dto.CreateInvoiceLineItemRequest{
+		{PriceID: lo.ToPtr("price_api"), Amount: decimal.NewFromInt(80), Quantity: decimal.NewFromInt(800)},
+		{PriceID: lo.ToPtr("price_storage"), Amount: decimal.NewFromInt(28), Quantity: decimal.NewFromInt(28)},
+	})
+	assert.Len(t, credits, 1)
+	assert.Equal(t, "inv_line_api", credits[0].InvoiceLineItemID)
+	assert.True(t, decimal.NewFromInt(10).Equal(credits[0].Amount), "got %s", credits[0].Amount)
+	assert.Len(t, adjustments, 1)
+	assert.Equal(t, "price_storage", lo.FromPtr(adjustments[0].PriceID))
+	assert.True(t, decimal.NewFromInt(2).Equal(adjustments[0].Amount), "got %s", adjustments[0].Amount)
+}
@@ -385,6 +385,7 @@ func (s *eventPostProcessingService) prepareProcessedEvents(ctx context.Context,
 	filter.SubscriptionStatus = []types.SubscriptionStatus{
 		types.SubscriptionStatusActive,
 		types.SubscriptionStatusTrialing,
+		types.SubscriptionStatusPastDue,
 	}
 
 	subscriptionsList, err := subscriptionService.ListSubscriptions(ctx, filter)
@@ -778,26 +779,8 @@ func (s *eventPostProcessingService) findMatchingPricesForEvent(
 
 // Check if an event matches the meter filters
 func (s *eventPostProcessingService) checkMeterFilters(event *events.Event, filters []meter.Filter) bool {
-	if len(filters) == 0 {
-		return true // No filters means everything matches
-	}
-
-	for _, filter := range filters {
-		propertyValue, exists := event.Properties[filter.Key]
-		if !exists {
-			return false
-		}
-
-		// Convert property value to string for comparison
-		propStr := fmt.Sprintf("%v", propertyValue)
-
-		// Check if the value is in the filter values
-		if !lo.Contains(filter.Values, propStr) {
-			return false
-		}
-	}
-
-	return true
+	// No filters means everything matches
+	return meter.MatchesAll(filters, event.Properties)
 }
 
 // Extract quantity from event based on meter aggregation
@@ -981,6 +964,7 @@ func (s *eventPostProcessingService) GetDetailedUsageAnalytics(ctx context.Conte
 	filter.SubscriptionStatus = []types.SubscriptionStatus{
 		types.SubscriptionStatusActive,
 		types.SubscriptionStatusTrialing,
+		types.SubscriptionStatusPastDue,
 	}
 
 	subscriptionsList, err := subscriptionService.ListSubscriptions(ctx, filter)
@@ -0,0 +1,194 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/ThreeDotsLabs/watermill/message/router/middleware"
+	"github.com/flexprice/flexprice/internal/config"
+	"github.com/flexprice/flexprice/internal/domain/events"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/nats"
+	pubsubRouter "github.com/flexprice/flexprice/internal/pubsub/router"
+	"github.com/flexprice/flexprice/internal/sqs"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// EventQueueConsumptionService consumes the events published to NATS or SQS, or forwarded over HTTP by
+// another deployment, and feeds them to the ClickHouse and the feature usage pipelines like the events
+// consumed from Kafka
+type EventQueueConsumptionService interface {
+	// Register the NATS and SQS handlers with the router for the configured event destinations
+	RegisterHandler(router *pubsubRouter.Router, cfg *config.Configuration)
+
+	// Process the events posted by an HTTP forwarder
+	ProcessForwardedEvents(ctx context.Context, events []*events.Event) error
+}
+
+type eventQueueConsumptionService struct {
+	ServiceParams
+	eventConsumptionSvc EventConsumptionService
+	featureUsageSvc     FeatureUsageTrackingService
+}
+
+// NewEventQueueConsumptionService creates a new event queue consumption service
+func NewEventQueueConsumptionService(
+	params ServiceParams,
+	eventConsumptionSvc EventConsumptionService,
+	featureUsageSvc FeatureUsageTrackingService,
+) EventQueueConsumptionService {
+	return &eventQueueConsumptionService{
+		ServiceParams:       params,
+		eventConsumptionSvc: eventConsumptionSvc,
+		featureUsageSvc:     featureUsageSvc,
+	}
+}
+
+// RegisterHandler registers a handler for every queue the events are published to
+func (s *eventQueueConsumptionService) RegisterHandler(
+	router *pubsubRouter.Router,
+	cfg *config.Configuration,
+) {
+	destinations := cfg.Event.GetDestinations()
+
+	if lo.Contains(destinations, types.PublishToNATS) {
+		throttle := middleware.NewThrottle(cfg.EventProcessing.RateLimit, time.Second)
+		subscriber := nats.NewSubscriber(nats.NewClient(cfg, s.Logger), cfg.NATS.Consumer, s.Logger)
+
+		router.AddNoPublishHandler(
+			"event_queue_nats_handler",
+			cfg.NATS.Subject,
+			subscriber,
+			s.processMessage,
+			throttle.Middleware,
+		)
+
+		s.Logger.Infow("registered nats event consumption handler",
+			"subject", cfg.NATS.Subject,
+			"consumer", cfg.NATS.Consumer,
+			"rate_limit", cfg.EventProcessing.RateLimit,
+		)
+	}
+
+	if lo.Contains(destinations, types.PublishToSQS) {
+		client, err := sqs.NewClient(cfg)
+		if err != nil {
+			s.Logger.Fatalw("failed to create sqs client", "error", err)
+			return
+		}
+		throttle := middleware.NewThrottle(cfg.EventProcessing.RateLimit, time.Second)
+
+		router.AddNoPublishHandler(
+			"event_queue_sqs_handler",
+			cfg.SQS.QueueURL,
+			sqs.NewSubscriber(client, s.Logger),
+			s.processMessage,
+			throttle.Middleware,
+		)
+
+		s.Logger.Infow("registered sqs event consumption handler",
+			"queue_url", cfg.SQS.QueueURL,
+			"rate_limit", cfg.EventProcessing.RateLimit,
+		)
+	}
+}
+
+// ProcessForwardedEvents processes the events one by one in the tenant and the environment configured for the
+// http forwarder, the tenant and the environment of the forwarded events are not trusted. The forwarder
+// retries the whole request on error, events which already have feature usage are skipped and the
+// events already stored are replaced by their id.
+func (s *eventQueueConsumptionService) ProcessForwardedEvents(ctx context.Context, forwarded []*events.Event) error {
+	if len(forwarded) == 0 {
+		return nil
+	}
+
+	tenantID := s.Config.HTTPForwarder.TenantID
+	environmentID := s.Config.HTTPForwarder.EnvironmentID
+	ctx = context.WithValue(ctx, types.CtxTenantID, tenantID)
+	ctx = context.WithValue(ctx, types.CtxEnvironmentID, environmentID)
+
+	eventIDs := make([]string, 0, len(forwarded))
+	startTime, endTime := forwarded[0].Timestamp, forwarded[0].Timestamp
+	for _, event := range forwarded {
+		event.TenantID = tenantID
+		event.EnvironmentID = environmentID
+		eventIDs = append(eventIDs, event.ID)
+		startTime = lo.Ternary(event.Timestamp.Before(startTime), event.Timestamp, startTime)
+		endTime = lo.Ternary(event.Timestamp.After(endTime), event.Timestamp, endTime)
+	}
+
+	trackedIDs, err := s.FeatureUsageRepo.GetTrackedEventIDs(ctx, eventIDs, startTime, endTime)
+	if err != nil {
+		return err
+	}
+	tracked := lo.SliceToMap(trackedIDs, func(id string) (string, bool) { return id, true })
+
+	for _, event := range forwarded {
+		if tracked[event.ID] {
+			s.Logger.Debugw("skipping forwarded event with feature usage",
+				"event_id", event.ID)
+			continue
+		}
+
+		payload, err := json.Marshal(event)
+		if err != nil {
+			return ierr.WithError(err).
+				WithHint("Failed to marshal the forwarded event").
+				Mark(ierr.ErrValidation)
+		}
+
+		msg := message.NewMessage(event.ID, payload)
+		msg.Metadata.Set("tenant_id", tenantID)
+		msg.Metadata.Set("environment_id", environmentID)
+		msg.SetContext(ctx)
+
+		if err := s.processMessage(msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// processMessage stores the event in ClickHouse and tracks its feature usage. Errors are returned
+// for retry, both steps are idempotent on the event id.
+func (s *eventQueueConsumptionService) processMessage(msg *message.Message) error {
+	tenantID := msg.Metadata.Get("tenant_id")
+	environmentID := msg.Metadata.Get("environment_id")
+
+	var event events.Event
+	if err := json.Unmarshal(msg.Payload, &event); err != nil {
+		s.Logger.Errorw("failed to unmarshal event from queue",
+			"error", err,
+			"message_uuid", msg.UUID,
+		)
+		return nil // Don't retry on unmarshal errors
+	}
+
+	if event.TenantID == "" || event.TenantID != tenantID {
+		s.Logger.Errorw("invalid tenant id",
+			"expected", tenantID,
+			"actual", event.TenantID,
+			"message_uuid", msg.UUID,
+		)
+		return nil // Don't retry on invalid tenant id
+	}
+
+	ctx := context.WithValue(msg.Context(), types.CtxTenantID, tenantID)
+	if environmentID != "" {
+		ctx = context.WithValue(ctx, types.CtxEnvironmentID, environmentID)
+	}
+
+	if err := s.eventConsumptionSvc.ProcessRawEvent(ctx, msg.Payload); err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to process the event").
+			WithReportableDetails(map[string]interface{}{
+				"event_id": event.ID,
+			}).
+			Mark(ierr.ErrSystem)
+	}
+
+	return s.featureUsageSvc.ProcessMessage(msg)
+}
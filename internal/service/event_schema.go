@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// EventSchemaService manages the schemas events are validated against during ingestion
+type EventSchemaService interface {
+	CreateEventSchema(ctx context.Context, req dto.CreateEventSchemaRequest) (*dto.EventSchemaResponse, error)
+	GetEventSchema(ctx context.Context, id string) (*dto.EventSchemaResponse, error)
+	UpdateEventSchema(ctx context.Context, id string, req dto.UpdateEventSchemaRequest) (*dto.EventSchemaResponse, error)
+	DeleteEventSchema(ctx context.Context, id string) error
+	ListEventSchemas(ctx context.Context, filter *types.EventSchemaFilter) (*dto.ListEventSchemasResponse, error)
+}
+
+type eventSchemaService struct {
+	ServiceParams
+}
+
+func NewEventSchemaService(params ServiceParams) EventSchemaService {
+	return &eventSchemaService{
+		ServiceParams: params,
+	}
+}
+
+// CreateEventSchema registers the schema of an event name, only one schema can exist per event name
+func (s *eventSchemaService) CreateEventSchema(ctx context.Context, req dto.CreateEventSchemaRequest) (*dto.EventSchemaResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.EventSchemaRepo.GetByEventName(ctx, req.EventName)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, ierr.NewError("event schema already exists").
+			WithHint("An event schema already exists for this event name, update it instead").
+			WithReportableDetails(map[string]interface{}{
+				"event_name":      req.EventName,
+				"event_schema_id": existing.ID,
+			}).
+			Mark(ierr.ErrAlreadyExists)
+	}
+
+	schema := req.ToEventSchema(ctx)
+	if err := s.EventSchemaRepo.Create(ctx, schema); err != nil {
+		return nil, err
+	}
+
+	return dto.ToEventSchemaResponse(schema), nil
+}
+
+func (s *eventSchemaService) GetEventSchema(ctx context.Context, id string) (*dto.EventSchemaResponse, error) {
+	if id == "" {
+		return nil, ierr.NewError("event schema ID is required").
+			WithHint("Please provide a valid event schema ID").
+			Mark(ierr.ErrValidation)
+	}
+
+	schema, err := s.EventSchemaRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.ToEventSchemaResponse(schema), nil
+}
+
+func (s *eventSchemaService) UpdateEventSchema(ctx context.Context, id string, req dto.UpdateEventSchemaRequest) (*dto.EventSchemaResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	schema, err := s.EventSchemaRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Description != nil {
+		schema.Description = *req.Description
+	}
+	if req.ValidationMode != nil {
+		schema.ValidationMode = *req.ValidationMode
+	}
+	if req.Properties != nil {
+		schema.Properties = req.Properties
+	}
+
+	if err := s.EventSchemaRepo.Update(ctx, schema); err != nil {
+		return nil, err
+	}
+
+	return dto.ToEventSchemaResponse(schema), nil
+}
+
+func (s *eventSchemaService) DeleteEventSchema(ctx context.Context, id string) error {
+	if _, err := s.EventSchemaRepo.Get(ctx, id); err != nil {
+		return err
+	}
+
+	return s.EventSchemaRepo.Delete(ctx, id)
+}
+
+func (s *eventSchemaService) ListEventSchemas(ctx context.Context, filter *types.EventSchemaFilter) (*dto.ListEventSchemasResponse, error) {
+	if filter == nil {
+		filter = types.NewEventSchemaFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Invalid filter parameters").
+			Mark(ierr.ErrValidation)
+	}
+
+	schemas, err := s.EventSchemaRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := s.EventSchemaRepo.Count(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*dto.EventSchemaResponse, len(schemas))
+	for i, schema := range schemas {
+		items[i] = dto.ToEventSchemaResponse(schema)
+	}
+
+	return &dto.ListEventSchemasResponse{
+		Items:      items,
+		Pagination: types.NewPaginationResponse(total, filter.GetLimit(), filter.GetOffset()),
+	}, nil
+}
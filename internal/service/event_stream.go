@@ -0,0 +1,185 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/events"
+	"github.com/flexprice/flexprice/internal/domain/eventschema"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+const (
+	// streamIngestDefaultBatchSize is used when event.stream_batch_size is not configured
+	streamIngestDefaultBatchSize = 500
+	// streamIngestMaxLineSize bounds the size of a single event line
+	streamIngestMaxLineSize = 1 << 20
+	// streamIngestMaxLineErrors bounds the number of line errors returned in the response
+	streamIngestMaxLineErrors = 1000
+)
+
+// streamIngestion holds the state of a single NDJSON stream ingestion
+type streamIngestion struct {
+	response *dto.StreamIngestEventResponse
+	// schemas caches the schema lookups of the event names seen in the stream, nil values included
+	schemas map[string]*eventschema.EventSchema
+
+	batch          []*events.Event
+	batchLines     []int
+	rejectedEvents []*events.RejectedEvent
+}
+
+func (i *streamIngestion) addLineError(lineError *dto.StreamIngestLineError) {
+	if len(i.response.Errors) >= streamIngestMaxLineErrors {
+		i.response.ErrorsTruncated = true
+		return
+	}
+	i.response.Errors = append(i.response.Errors, lineError)
+}
+
+// StreamIngestEvents ingests an NDJSON stream with one IngestEventRequest per line. Lines are parsed and
+// validated as they are read and the accepted events are published in batches, so that the stream never
+// has to be held in memory. A line failing validation does not fail the stream, it is reported in the
+// response with its line number.
+func (s *eventService) StreamIngestEvents(ctx context.Context, body io.Reader) (*dto.StreamIngestEventResponse, error) {
+	batchSize := s.config.Event.StreamBatchSize
+	if batchSize <= 0 {
+		batchSize = streamIngestDefaultBatchSize
+	}
+
+	ingestion := &streamIngestion{
+		response: &dto.StreamIngestEventResponse{
+			Message: "Events accepted for processing",
+			Errors:  make([]*dto.StreamIngestLineError, 0),
+		},
+		schemas:        make(map[string]*eventschema.EventSchema),
+		batch:          make([]*events.Event, 0, batchSize),
+		batchLines:     make([]int, 0, batchSize),
+		rejectedEvents: make([]*events.RejectedEvent, 0),
+	}
+
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 64*1024), streamIngestMaxLineSize)
+
+	line := 0
+	for scanner.Scan() {
+		line++
+		raw := bytes.TrimSpace(scanner.Bytes())
+		if len(raw) == 0 {
+			continue
+		}
+		ingestion.response.Lines++
+
+		s.ingestStreamLine(ctx, ingestion, line, raw)
+		if len(ingestion.batch) >= batchSize {
+			s.flushStreamBatch(ctx, ingestion)
+		}
+	}
+	s.flushStreamBatch(ctx, ingestion)
+
+	// The events of the lines read so far are published, so a broken stream is reported on the
+	// line it broke at instead of failing the whole request
+	if err := scanner.Err(); err != nil {
+		s.logger.Errorw("failed to read event stream",
+			"line", line+1,
+			"error", err)
+
+		ingestion.response.Message = fmt.Sprintf("Event stream could not be read after line %d, the events of the previous lines were processed", line)
+		ingestion.response.Failed++
+		ingestion.addLineError(&dto.StreamIngestLineError{
+			Line:  line + 1,
+			Error: err.Error(),
+		})
+	}
+
+	return ingestion.response, nil
+}
+
+func (s *eventService) ingestStreamLine(ctx context.Context, ingestion *streamIngestion, line int, raw []byte) {
+	var req dto.IngestEventRequest
+	if err := json.Unmarshal(raw, &req); err != nil {
+		ingestion.response.Failed++
+		ingestion.addLineError(&dto.StreamIngestLineError{
+			Line:  line,
+			Error: fmt.Sprintf("invalid json: %s", err.Error()),
+		})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		ingestion.response.Failed++
+		ingestion.addLineError(&dto.StreamIngestLineError{
+			Line:      line,
+			EventID:   req.EventID,
+			EventName: req.EventName,
+			Error:     err.Error(),
+		})
+		return
+	}
+
+	event := req.ToEvent(ctx)
+
+	schema, ok := ingestion.schemas[event.EventName]
+	if !ok {
+		schema = s.getEventSchema(ctx, event.EventName)
+		ingestion.schemas[event.EventName] = schema
+	}
+
+	if rejectedEvent := validateEventAgainstSchema(event, schema); rejectedEvent != nil {
+		ingestion.rejectedEvents = append(ingestion.rejectedEvents, rejectedEvent)
+		ingestion.addLineError(&dto.StreamIngestLineError{
+			Line:       line,
+			EventID:    event.ID,
+			EventName:  event.EventName,
+			Error:      "event does not match the schema of its event name",
+			Action:     rejectedEvent.Action,
+			Violations: rejectedEvent.Violations,
+		})
+
+		if rejectedEvent.Action == types.RejectedEventActionRejected {
+			ingestion.response.Rejected++
+			return
+		}
+		ingestion.response.Flagged++
+	}
+
+	ingestion.batch = append(ingestion.batch, event)
+	ingestion.batchLines = append(ingestion.batchLines, line)
+}
+
+// flushStreamBatch publishes the pending events and records the schema violations of the batch
+func (s *eventService) flushStreamBatch(ctx context.Context, ingestion *streamIngestion) {
+	s.storeRejectedEvents(ctx, ingestion.rejectedEvents)
+	ingestion.rejectedEvents = ingestion.rejectedEvents[:0]
+
+	if len(ingestion.batch) == 0 {
+		return
+	}
+
+	if err := s.publisher.PublishBatch(ctx, ingestion.batch); err != nil {
+		s.logger.Errorw("failed to publish event batch",
+			"count", len(ingestion.batch),
+			"first_line", ingestion.batchLines[0],
+			"error", err)
+
+		ingestion.response.Failed += len(ingestion.batch)
+		for i, event := range ingestion.batch {
+			ingestion.addLineError(&dto.StreamIngestLineError{
+				Line:      ingestion.batchLines[i],
+				EventID:   event.ID,
+				EventName: event.EventName,
+				Error:     "failed to publish event",
+			})
+		}
+	} else {
+		ingestion.response.Accepted += len(ingestion.batch)
+	}
+
+	ingestion.batch = ingestion.batch[:0]
+	ingestion.batchLines = ingestion.batchLines[:0]
+}
@@ -2,28 +2,33 @@ package service
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/flexprice/flexprice/internal/api/dto"
 	"github.com/flexprice/flexprice/internal/config"
 	"github.com/flexprice/flexprice/internal/domain/events"
+	"github.com/flexprice/flexprice/internal/domain/eventschema"
 	"github.com/flexprice/flexprice/internal/domain/meter"
 	"github.com/flexprice/flexprice/internal/logger"
 	"github.com/flexprice/flexprice/internal/testutil"
 	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/suite"
 )
 
 type EventServiceSuite struct {
 	suite.Suite
-	ctx       context.Context
-	service   EventService
-	eventRepo *testutil.InMemoryEventStore
-	publisher *testutil.InMemoryPublisherService
-	logger    *logger.Logger
-	config    *config.Configuration
+	ctx               context.Context
+	service           EventService
+	eventRepo         *testutil.InMemoryEventStore
+	eventSchemaRepo   *testutil.InMemoryEventSchemaStore
+	rejectedEventRepo *testutil.InMemoryRejectedEventStore
+	publisher         *testutil.InMemoryPublisherService
+	logger            *logger.Logger
+	config            *config.Configuration
 }
 
 func TestEventService(t *testing.T) {
@@ -33,6 +38,8 @@ func TestEventService(t *testing.T) {
 func (s *EventServiceSuite) SetupTest() {
 	s.ctx = testutil.SetupContext()
 	s.eventRepo = testutil.NewInMemoryEventStore()
+	s.eventSchemaRepo = testutil.NewInMemoryEventSchemaStore()
+	s.rejectedEventRepo = testutil.NewInMemoryRejectedEventStore()
 	s.publisher = testutil.NewInMemoryEventPublisher(s.eventRepo).(*testutil.InMemoryPublisherService)
 	s.logger = logger.GetLogger()
 	s.config = config.GetDefaultConfig()
@@ -40,6 +47,8 @@ func (s *EventServiceSuite) SetupTest() {
 	s.service = NewEventService(
 		s.eventRepo,
 		nil, // meter repo not needed for these tests
+		s.eventSchemaRepo,
+		s.rejectedEventRepo,
 		s.publisher,
 		s.logger,
 		s.config,
@@ -48,6 +57,104 @@ func (s *EventServiceSuite) SetupTest() {
 
 func (s *EventServiceSuite) TearDownTest() {
 	s.publisher.Clear()
+	s.eventSchemaRepo.Clear()
+	s.rejectedEventRepo.Clear()
+}
+
+func (s *EventServiceSuite) TestBulkCreateEventsWithEventSchema() {
+	s.NoError(s.eventSchemaRepo.Create(s.ctx, &eventschema.EventSchema{
+		ID:             "evschema-1",
+		EventName:      "api_request",
+		ValidationMode: types.EventSchemaValidationModeReject,
+		Properties: []types.EventPropertySchema{
+			{Name: "duration_ms", Type: types.EventPropertyTypeNumber, Required: true},
+			{Name: "region", Type: types.EventPropertyTypeString, AllowedValues: []string{"us", "eu"}},
+		},
+		BaseModel: types.GetDefaultBaseModel(s.ctx),
+	}))
+
+	resp, err := s.service.BulkCreateEvents(s.ctx, &dto.BulkIngestEventRequest{
+		Events: []*dto.IngestEventRequest{
+			{EventID: "schema-valid", ExternalCustomerID: "customer-1", EventName: "api_request", Timestamp: time.Now(), Properties: map[string]interface{}{"duration_ms": 150, "region": "eu"}},
+			{EventID: "schema-invalid", ExternalCustomerID: "customer-1", EventName: "api_request", Timestamp: time.Now(), Properties: map[string]interface{}{"region": "apac"}},
+			{EventID: "schema-less", ExternalCustomerID: "customer-1", EventName: "storage", Timestamp: time.Now()},
+		},
+	})
+	s.NoError(err)
+	s.Equal(2, resp.Accepted)
+	s.Equal(1, resp.Rejected)
+	s.Equal(0, resp.Flagged)
+	s.Require().Len(resp.Violations, 1)
+	s.Equal(1, resp.Violations[0].Index)
+	s.Equal([]string{"duration_ms", "region"}, lo.Map(resp.Violations[0].Violations, func(v types.EventSchemaViolation, _ int) string {
+		return v.Property
+	}))
+
+	s.True(s.publisher.HasEvent("schema-valid"))
+	s.False(s.publisher.HasEvent("schema-invalid"))
+	s.True(s.publisher.HasEvent("schema-less"))
+
+	rejected, err := s.service.GetRejectedEvents(s.ctx, &dto.GetRejectedEventsRequest{})
+	s.NoError(err)
+	s.Require().Len(rejected.Events, 1)
+	s.Equal("schema-invalid", rejected.Events[0].ID)
+	s.Equal(types.RejectedEventActionRejected, rejected.Events[0].Action)
+
+	// flagged events are ingested and recorded
+	schema, err := s.eventSchemaRepo.Get(s.ctx, "evschema-1")
+	s.NoError(err)
+	schema.ValidationMode = types.EventSchemaValidationModeFlag
+	s.NoError(s.eventSchemaRepo.Update(s.ctx, schema))
+
+	err = s.service.CreateEvent(s.ctx, &dto.IngestEventRequest{
+		EventID: "schema-flagged", ExternalCustomerID: "customer-1", EventName: "api_request", Timestamp: time.Now(),
+	})
+	s.NoError(err)
+	s.True(s.publisher.HasEvent("schema-flagged"))
+
+	rejected, err = s.service.GetRejectedEvents(s.ctx, &dto.GetRejectedEventsRequest{Action: types.RejectedEventActionFlagged})
+	s.NoError(err)
+	s.Require().Len(rejected.Events, 1)
+	s.Equal("schema-flagged", rejected.Events[0].ID)
+}
+
+func (s *EventServiceSuite) TestStreamIngestEvents() {
+	s.NoError(s.eventSchemaRepo.Create(s.ctx, &eventschema.EventSchema{
+		ID:             "evschema-1",
+		EventName:      "api_request",
+		ValidationMode: types.EventSchemaValidationModeReject,
+		Properties: []types.EventPropertySchema{
+			{Name: "duration_ms", Type: types.EventPropertyTypeNumber, Required: true},
+		},
+		BaseModel: types.GetDefaultBaseModel(s.ctx),
+	}))
+	s.config.Event.StreamBatchSize = 2
+
+	body := strings.Join([]string{
+		`{"event_id":"stream-1","event_name":"api_request","external_customer_id":"customer-1","properties":{"duration_ms":10}}`,
+		`{"event_id":"stream-2","event_name":"api_request","external_customer_id":"customer-1","properties":{}}`,
+		``,
+		`{"event_id":"stream-3","event_name":"storage","external_customer_id":"customer-1"}`,
+		`{"event_id":"stream-4","event_name":"storage"}`,
+		`not json`,
+		`{"event_id":"stream-5","event_name":"api_request","external_customer_id":"customer-2","properties":{"duration_ms":20}}`,
+	}, "\n")
+
+	resp, err := s.service.StreamIngestEvents(s.ctx, strings.NewReader(body))
+	s.NoError(err)
+	s.Equal(6, resp.Lines)
+	s.Equal(3, resp.Accepted)
+	s.Equal(1, resp.Rejected)
+	s.Equal(2, resp.Failed)
+	s.Equal([]int{2, 5, 6}, lo.Map(resp.Errors, func(e *dto.StreamIngestLineError, _ int) int {
+		return e.Line
+	}))
+	s.Equal(types.RejectedEventActionRejected, resp.Errors[0].Action)
+
+	for _, id := range []string{"stream-1", "stream-3", "stream-5"} {
+		s.True(s.publisher.HasEvent(id), id)
+	}
+	s.False(s.publisher.HasEvent("stream-2"))
 }
 
 func (s *EventServiceSuite) TestCreateEvent() {
@@ -341,6 +448,8 @@ func (s *EventServiceSuite) TestGetUsageByMeter() {
 	s.service = NewEventService(
 		s.eventRepo,
 		mockedMeterRepo,
+		s.eventSchemaRepo,
+		s.rejectedEventRepo,
 		s.publisher,
 		s.logger,
 		s.config,
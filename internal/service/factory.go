@@ -1,6 +1,7 @@
 package service
 
 import (
+	"github.com/flexprice/flexprice/internal/cache"
 	"github.com/flexprice/flexprice/internal/config"
 	"github.com/flexprice/flexprice/internal/domain/addon"
 	"github.com/flexprice/flexprice/internal/domain/addonassociation"
@@ -19,6 +20,7 @@ import (
 	"github.com/flexprice/flexprice/internal/domain/entityintegrationmapping"
 	"github.com/flexprice/flexprice/internal/domain/environment"
 	"github.com/flexprice/flexprice/internal/domain/events"
+	"github.com/flexprice/flexprice/internal/domain/eventschema"
 	"github.com/flexprice/flexprice/internal/domain/feature"
 	"github.com/flexprice/flexprice/internal/domain/group"
 	"github.com/flexprice/flexprice/internal/domain/invoice"
@@ -27,6 +29,7 @@ import (
 	"github.com/flexprice/flexprice/internal/domain/plan"
 	"github.com/flexprice/flexprice/internal/domain/price"
 	"github.com/flexprice/flexprice/internal/domain/priceunit"
+	"github.com/flexprice/flexprice/internal/domain/promotion_code"
 	"github.com/flexprice/flexprice/internal/domain/proration"
 	"github.com/flexprice/flexprice/internal/domain/scheduledtask"
 	"github.com/flexprice/flexprice/internal/domain/secret"
@@ -57,6 +60,7 @@ type ServiceParams struct {
 	DB           postgres.IClient
 	PDFGenerator pdf.Generator
 	S3           s3.Service
+	Cache        cache.Cache
 
 	// Repositories
 	AuthRepo                     auth.Repository
@@ -77,6 +81,7 @@ type ServiceParams struct {
 	InvoiceRepo                  invoice.Repository
 	FeatureRepo                  feature.Repository
 	EntitlementRepo              entitlement.Repository
+	EntitlementUsageCounterRepo  entitlement.UsageCounterRepository
 	PaymentRepo                  payment.Repository
 	SecretRepo                   secret.Repository
 	EnvironmentRepo              environment.Repository
@@ -92,6 +97,7 @@ type ServiceParams struct {
 	CouponRepo                   coupon.Repository
 	CouponAssociationRepo        coupon_association.Repository
 	CouponApplicationRepo        coupon_application.Repository
+	PromotionCodeRepo            promotion_code.Repository
 	AddonRepo                    addon.Repository
 	AddonAssociationRepo         addonassociation.Repository
 	ConnectionRepo               connection.Repository
@@ -100,6 +106,12 @@ type ServiceParams struct {
 	AlertLogsRepo                alertlogs.Repository
 	GroupRepo                    group.Repository
 	ScheduledTaskRepo            scheduledtask.Repository
+	EventSchemaRepo              eventschema.Repository
+	RejectedEventRepo            events.RejectedEventRepository
+	DeadLetterEventRepo          events.DeadLetterEventRepository
+	EventCorrectionRepo          events.EventCorrectionRepository
+	EventCorrectionClaimRepo     events.EventCorrectionClaimRepository
+	MetricSeriesStateRepo        events.MetricSeriesStateRepository
 
 	// Publishers
 	EventPublisher   publisher.EventPublisher
@@ -140,6 +152,7 @@ func NewServiceParams(
 	featureRepo feature.Repository,
 	creditGrantApplicationRepo creditgrantapplication.Repository,
 	entitlementRepo entitlement.Repository,
+	entitlementUsageCounterRepo entitlement.UsageCounterRepository,
 	paymentRepo payment.Repository,
 	secretRepo secret.Repository,
 	environmentRepo environment.Repository,
@@ -154,6 +167,7 @@ func NewServiceParams(
 	couponRepo coupon.Repository,
 	couponAssociationRepo coupon_association.Repository,
 	couponApplicationRepo coupon_application.Repository,
+	promotionCodeRepo promotion_code.Repository,
 	eventPublisher publisher.EventPublisher,
 	webhookPublisher webhookPublisher.WebhookPublisher,
 	s3Service s3.Service,
@@ -166,14 +180,22 @@ func NewServiceParams(
 	alertLogsRepo alertlogs.Repository,
 	groupRepo group.Repository,
 	scheduledTaskRepo scheduledtask.Repository,
+	eventSchemaRepo eventschema.Repository,
+	rejectedEventRepo events.RejectedEventRepository,
+	deadLetterEventRepo events.DeadLetterEventRepository,
+	eventCorrectionRepo events.EventCorrectionRepository,
+	eventCorrectionClaimRepo events.EventCorrectionClaimRepository,
+	metricSeriesStateRepo events.MetricSeriesStateRepository,
 	prorationCalculator proration.Calculator,
 	integrationFactory *integration.Factory,
+	cache cache.Cache,
 ) ServiceParams {
 	return ServiceParams{
 		Logger:                       logger,
 		Config:                       config,
 		DB:                           db,
 		PDFGenerator:                 pdfGenerator,
+		Cache:                        cache,
 		AuthRepo:                     authRepo,
 		UserRepo:                     userRepo,
 		EventRepo:                    eventRepo,
@@ -192,6 +214,7 @@ func NewServiceParams(
 		InvoiceRepo:                  invoiceRepo,
 		FeatureRepo:                  featureRepo,
 		EntitlementRepo:              entitlementRepo,
+		EntitlementUsageCounterRepo:  entitlementUsageCounterRepo,
 		PaymentRepo:                  paymentRepo,
 		SecretRepo:                   secretRepo,
 		EnvironmentRepo:              environmentRepo,
@@ -211,6 +234,7 @@ func NewServiceParams(
 		CouponRepo:                   couponRepo,
 		CouponAssociationRepo:        couponAssociationRepo,
 		CouponApplicationRepo:        couponApplicationRepo,
+		PromotionCodeRepo:            promotionCodeRepo,
 		AddonRepo:                    addonRepo,
 		AddonAssociationRepo:         addonAssociationRepo,
 		ConnectionRepo:               connectionRepo,
@@ -219,6 +243,12 @@ func NewServiceParams(
 		AlertLogsRepo:                alertLogsRepo,
 		GroupRepo:                    groupRepo,
 		ScheduledTaskRepo:            scheduledTaskRepo,
+		EventSchemaRepo:              eventSchemaRepo,
+		RejectedEventRepo:            rejectedEventRepo,
+		DeadLetterEventRepo:          deadLetterEventRepo,
+		EventCorrectionRepo:          eventCorrectionRepo,
+		EventCorrectionClaimRepo:     eventCorrectionClaimRepo,
+		MetricSeriesStateRepo:        metricSeriesStateRepo,
 		ProrationCalculator:          prorationCalculator,
 		IntegrationFactory:           integrationFactory,
 	}
@@ -12,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/ThreeDotsLabs/watermill"
 	"github.com/ThreeDotsLabs/watermill/message"
 	"github.com/ThreeDotsLabs/watermill/message/router/middleware"
 	"github.com/flexprice/flexprice/internal/api/dto"
@@ -25,9 +26,12 @@ import (
 	"github.com/flexprice/flexprice/internal/domain/price"
 	"github.com/flexprice/flexprice/internal/domain/subscription"
 	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/expression"
 	"github.com/flexprice/flexprice/internal/pubsub"
 	"github.com/flexprice/flexprice/internal/pubsub/kafka"
 	pubsubRouter "github.com/flexprice/flexprice/internal/pubsub/router"
+	"github.com/flexprice/flexprice/internal/temporal/models"
+	temporalservice "github.com/flexprice/flexprice/internal/temporal/service"
 	"github.com/flexprice/flexprice/internal/types"
 	"github.com/samber/lo"
 	"github.com/shopspring/decimal"
@@ -44,6 +48,10 @@ type FeatureUsageTrackingService interface {
 	// Register message handler with the router
 	RegisterHandlerLazy(router *pubsubRouter.Router, cfg *config.Configuration)
 
+	// Process an event message received outside of the kafka handlers, e.g. from NATS, SQS or a
+	// forwarding deployment. The message metadata must carry the tenant and the environment.
+	ProcessMessage(msg *message.Message) error
+
 	// Get detailed usage analytics with filtering, grouping, and time-series data
 	GetDetailedUsageAnalytics(ctx context.Context, req *dto.GetUsageAnalyticsRequest) (*dto.GetUsageAnalyticsResponse, error)
 
@@ -52,6 +60,12 @@ type FeatureUsageTrackingService interface {
 
 	// Reprocess events for a specific customer or with other filters
 	ReprocessEvents(ctx context.Context, params *events.ReprocessEventsParams) error
+
+	// List events that could not be turned into feature usage
+	GetDeadLetterEvents(ctx context.Context, req *dto.GetDeadLetterEventsRequest) (*dto.GetDeadLetterEventsResponse, error)
+
+	// Replay dead-lettered events through feature usage processing, e.g. after fixing the pricing config
+	ReplayDeadLetterEvents(ctx context.Context, req *dto.ReplayDeadLetterEventsRequest) (*dto.ReplayDeadLetterEventsResponse, error)
 }
 
 type featureUsageTrackingService struct {
@@ -61,8 +75,13 @@ type featureUsageTrackingService struct {
 	lazyPubSub       pubsub.PubSub // Dedicated Kafka PubSub for lazy processing
 	eventRepo        events.Repository
 	featureUsageRepo events.FeatureUsageRepository
+	usageUpdates     chan *message.Message // Updates waiting to be published to the live usage streams
 }
 
+// usageUpdateBufferSize is the number of feature usage updates waiting to be published to the live usage
+// streams, updates are dropped while the buffer is full so that streaming never slows the processing down
+const usageUpdateBufferSize = 1024
+
 // NewFeatureUsageTrackingService creates a new feature usage tracking service
 func NewFeatureUsageTrackingService(
 	params ServiceParams,
@@ -110,6 +129,11 @@ func NewFeatureUsageTrackingService(
 	}
 	ev.lazyPubSub = lazyPubSub
 
+	if params.Config.FeatureUsageTracking.TopicUsageUpdates != "" {
+		ev.usageUpdates = make(chan *message.Message, usageUpdateBufferSize)
+		go ev.runUsageUpdatePublisher()
+	}
+
 	return ev
 }
 
@@ -274,15 +298,34 @@ func (s *featureUsageTrackingService) processMessage(msg *message.Message) error
 	}
 
 	// Process the event
-	if err := s.processEvent(ctx, &event); err != nil {
+	failure, err := s.processEvent(ctx, &event)
+	if err != nil {
 		s.Logger.Errorw("failed to process event for feature usage tracking",
 			"error", err,
 			"event_id", event.ID,
 			"event_name", event.EventName,
 		)
+		// The retries of the router process the same message, the event is dead-lettered once on the first
+		// failure and resolved again if a retry succeeds so transient errors do not leave pending rows behind
+		if msg.Metadata.Get(deadLetterFailedAtMetadataKey) == "" {
+			deadLetterEvent := s.recordDeadLetterEvent(ctx, &event, &featureUsageFailure{
+				reason:  types.DeadLetterReasonProcessingError,
+				message: err.Error(),
+			})
+			if deadLetterEvent != nil {
+				msg.Metadata.Set(deadLetterFailedAtMetadataKey, deadLetterEvent.FailedAt.Format(time.RFC3339Nano))
+				msg.Metadata.Set(deadLetterErrorMetadataKey, deadLetterEvent.Error)
+			}
+		}
 		return err // Return error for retry
 	}
 
+	if failure != nil {
+		s.recordDeadLetterEvent(ctx, &event, failure)
+	} else if failedAt := msg.Metadata.Get(deadLetterFailedAtMetadataKey); failedAt != "" {
+		s.resolveDeadLetterEvent(ctx, &event, failedAt, msg.Metadata.Get(deadLetterErrorMetadataKey))
+	}
+
 	s.Logger.Infow("event for feature usage tracking processed successfully",
 		"event_id", event.ID,
 		"event_name", event.EventName,
@@ -291,8 +334,19 @@ func (s *featureUsageTrackingService) processMessage(msg *message.Message) error
 	return nil
 }
 
-// Process a single event for feature usage tracking
-func (s *featureUsageTrackingService) processEvent(ctx context.Context, event *events.Event) error {
+func (s *featureUsageTrackingService) ProcessMessage(msg *message.Message) error {
+	return s.processMessage(msg)
+}
+
+// featureUsageFailure describes why an event, or part of it, could not be turned into feature usage
+type featureUsageFailure struct {
+	reason  types.DeadLetterReason
+	message string
+}
+
+// Process a single event for feature usage tracking. Events which are skipped because of missing
+// customers, subscriptions, prices or unparsable values are reported as a failure without an error.
+func (s *featureUsageTrackingService) processEvent(ctx context.Context, event *events.Event) (*featureUsageFailure, error) {
 	s.Logger.Debugw("processing event",
 		"event_id", event.ID,
 		"event_name", event.EventName,
@@ -300,22 +354,121 @@ func (s *featureUsageTrackingService) processEvent(ctx context.Context, event *e
 		"ingested_at", event.IngestedAt,
 	)
 
-	featureUsage, err := s.prepareProcessedEvents(ctx, event)
+	featureUsage, failure, err := s.prepareProcessedEvents(ctx, event)
 	if err != nil {
 		s.Logger.Errorw("failed to prepare feature usage",
 			"error", err,
 			"event_id", event.ID,
 		)
-		return err
+		return nil, err
 	}
 
 	if len(featureUsage) > 0 {
 		if err := s.featureUsageRepo.BulkInsertProcessedEvents(ctx, featureUsage); err != nil {
-			return err
+			return nil, err
 		}
+		s.publishUsageUpdate(featureUsage)
 	}
 
-	return nil
+	return failure, nil
+}
+
+// publishUsageUpdate queues the notification of the live usage streams for the feature usage written for
+// an event. Streams are best effort, updates are dropped when the queue is full and failures are logged,
+// they never fail or slow down the processing of the event.
+func (s *featureUsageTrackingService) publishUsageUpdate(featureUsage []*events.FeatureUsage) {
+	if s.pubSub == nil || s.usageUpdates == nil {
+		return
+	}
+
+	update := dto.ToFeatureUsageUpdate(featureUsage)
+	payload, err := json.Marshal(update)
+	if err != nil {
+		s.Logger.Errorw("failed to marshal feature usage update",
+			"event_id", update.EventID,
+			"error", err)
+		return
+	}
+
+	msg := message.NewMessage(watermill.NewUUID(), payload)
+	msg.Metadata.Set("tenant_id", update.TenantID)
+	msg.Metadata.Set("environment_id", update.EnvironmentID)
+	msg.Metadata.Set("partition_key", fmt.Sprintf("%s:%s", update.TenantID, update.ExternalCustomerID))
+
+	select {
+	case s.usageUpdates <- msg:
+	default:
+		s.Logger.Warnw("dropping feature usage update, the usage update publisher is not keeping up",
+			"event_id", update.EventID)
+	}
+}
+
+// runUsageUpdatePublisher publishes the queued feature usage updates to the usage updates topic
+func (s *featureUsageTrackingService) runUsageUpdatePublisher() {
+	topic := s.Config.FeatureUsageTracking.TopicUsageUpdates
+	for msg := range s.usageUpdates {
+		if err := s.pubSub.Publish(context.Background(), topic, msg); err != nil {
+			s.Logger.Errorw("failed to publish feature usage update",
+				"message_uuid", msg.UUID,
+				"topic", topic,
+				"error", err)
+		}
+	}
+}
+
+// Message metadata keys used to resolve the dead letter row of an event when a retry of the message succeeds
+const (
+	deadLetterFailedAtMetadataKey = "dead_letter_failed_at"
+	deadLetterErrorMetadataKey    = "dead_letter_error"
+)
+
+// recordDeadLetterEvent stores a failed event so that it can be inspected and replayed,
+// it returns nil if the event could not be stored
+func (s *featureUsageTrackingService) recordDeadLetterEvent(ctx context.Context, event *events.Event, failure *featureUsageFailure) *events.DeadLetterEvent {
+	now := time.Now().UTC()
+	deadLetterEvent := &events.DeadLetterEvent{
+		Event:    *event,
+		Reason:   failure.reason,
+		Error:    failure.message,
+		Status:   types.DeadLetterEventStatusPending,
+		FailedAt: now,
+		Version:  uint64(now.UnixNano()),
+	}
+
+	if err := s.DeadLetterEventRepo.InsertDeadLetterEvents(ctx, []*events.DeadLetterEvent{deadLetterEvent}); err != nil {
+		s.Logger.Errorw("failed to record dead letter event",
+			"event_id", event.ID,
+			"reason", failure.reason,
+			"error", err,
+		)
+		return nil
+	}
+	return deadLetterEvent
+}
+
+// resolveDeadLetterEvent marks the processing error recorded for an event as replayed once a retry of the
+// event succeeded. The new version of the row supersedes the pending one.
+func (s *featureUsageTrackingService) resolveDeadLetterEvent(ctx context.Context, event *events.Event, failedAt, errMessage string) {
+	now := time.Now().UTC()
+	deadLetterEvent := &events.DeadLetterEvent{
+		Event:      *event,
+		Reason:     types.DeadLetterReasonProcessingError,
+		Error:      errMessage,
+		Status:     types.DeadLetterEventStatusReplayed,
+		FailedAt:   now,
+		ReplayedAt: &now,
+		Version:    uint64(now.UnixNano()),
+	}
+	if t, err := time.Parse(time.RFC3339Nano, failedAt); err == nil {
+		deadLetterEvent.FailedAt = t
+	}
+
+	if err := s.DeadLetterEventRepo.InsertDeadLetterEvents(ctx, []*events.DeadLetterEvent{deadLetterEvent}); err != nil {
+		s.Logger.Errorw("failed to resolve dead letter event",
+			"event_id", event.ID,
+			"error", err,
+		)
+	}
 }
 
 // Generate a unique hash for deduplication
@@ -336,7 +489,7 @@ func (s *featureUsageTrackingService) generateUniqueHash(event *events.Event, me
 	return hex.EncodeToString(hash[:])
 }
 
-func (s *featureUsageTrackingService) prepareProcessedEvents(ctx context.Context, event *events.Event) ([]*events.FeatureUsage, error) {
+func (s *featureUsageTrackingService) prepareProcessedEvents(ctx context.Context, event *events.Event) ([]*events.FeatureUsage, *featureUsageFailure, error) {
 	subscriptionService := NewSubscriptionService(s.ServiceParams)
 
 	// Create a base processed event
@@ -345,8 +498,15 @@ func (s *featureUsageTrackingService) prepareProcessedEvents(ctx context.Context
 	// Results slice - will contain either a single skipped event or multiple processed events
 	results := make([]*events.FeatureUsage, 0)
 
-	// CASE 1: Lookup customer
+	// CASE 1: Lookup customer, creating it when the environment auto-creates customers from events
 	customer, err := s.CustomerRepo.GetByLookupKey(ctx, event.ExternalCustomerID)
+	if ierr.IsNotFound(err) {
+		if created, createErr := s.autoCreateCustomer(ctx, event); createErr != nil {
+			return results, nil, createErr
+		} else if created != nil {
+			customer, err = created, nil
+		}
+	}
 	if err != nil {
 		s.Logger.Warnw("customer not found for event, skipping",
 			"event_id", event.ID,
@@ -355,7 +515,13 @@ func (s *featureUsageTrackingService) prepareProcessedEvents(ctx context.Context
 		)
 		// Simply skip the event if customer not found
 		// TODO: add sentry span for customer not found
-		return results, nil
+		if !ierr.IsNotFound(err) {
+			return results, &featureUsageFailure{reason: types.DeadLetterReasonProcessingError, message: err.Error()}, nil
+		}
+		return results, &featureUsageFailure{
+			reason:  types.DeadLetterReasonCustomerNotFound,
+			message: fmt.Sprintf("no customer found with external customer id %s", event.ExternalCustomerID),
+		}, nil
 	}
 
 	// Set the customer ID in the event if it's not already set
@@ -364,14 +530,16 @@ func (s *featureUsageTrackingService) prepareProcessedEvents(ctx context.Context
 		baseProcessedEvent.CustomerID = customer.ID
 	}
 
-	// CASE 2: Get active subscriptions
+	// CASE 2: Get active subscriptions, the usage of a child customer is billed by the
+	// subscriptions of its parent customer
 	filter := types.NewSubscriptionFilter()
-	filter.CustomerID = customer.ID
+	filter.CustomerID = customer.BillingCustomerID()
 	filter.WithLineItems = true
 	filter.Expand = lo.ToPtr(string(types.ExpandPrices) + "," + string(types.ExpandMeters) + "," + string(types.ExpandFeatures))
 	filter.SubscriptionStatus = []types.SubscriptionStatus{
 		types.SubscriptionStatusActive,
 		types.SubscriptionStatusTrialing,
+		types.SubscriptionStatusPastDue,
 	}
 
 	subscriptionsList, err := subscriptionService.ListSubscriptions(ctx, filter)
@@ -382,7 +550,7 @@ func (s *featureUsageTrackingService) prepareProcessedEvents(ctx context.Context
 			"error", err,
 		)
 		// TODO: add sentry span for failed to get subscriptions
-		return results, err
+		return results, nil, err
 	}
 
 	subscriptions := subscriptionsList.Items
@@ -392,7 +560,10 @@ func (s *featureUsageTrackingService) prepareProcessedEvents(ctx context.Context
 			"customer_id", customer.ID,
 		)
 		// TODO: add sentry span for no active subscriptions found
-		return results, nil
+		return results, &featureUsageFailure{
+			reason:  types.DeadLetterReasonSubscriptionNotFound,
+			message: fmt.Sprintf("customer %s has no active subscriptions", customer.ID),
+		}, nil
 	}
 
 	// Filter subscriptions to only include those that are active for the event timestamp
@@ -410,7 +581,10 @@ func (s *featureUsageTrackingService) prepareProcessedEvents(ctx context.Context
 			"customer_id", customer.ID,
 			"event_timestamp", event.Timestamp,
 		)
-		return results, nil
+		return results, &featureUsageFailure{
+			reason:  types.DeadLetterReasonSubscriptionNotFound,
+			message: fmt.Sprintf("customer %s has no subscription active at %s", customer.ID, event.Timestamp.Format(time.RFC3339)),
+		}, nil
 	}
 
 	// Collect all price IDs and meter IDs from subscription line items
@@ -446,7 +620,7 @@ func (s *featureUsageTrackingService) prepareProcessedEvents(ctx context.Context
 			"event_id", event.ID,
 			"price_count", len(priceIDs),
 		)
-		return results, err
+		return results, nil, err
 	}
 
 	// Build price map and collect meter IDs
@@ -475,7 +649,7 @@ func (s *featureUsageTrackingService) prepareProcessedEvents(ctx context.Context
 			"event_id", event.ID,
 			"meter_count", len(meterIDs),
 		)
-		return results, err
+		return results, nil, err
 	}
 
 	// Build meter map
@@ -499,7 +673,7 @@ func (s *featureUsageTrackingService) prepareProcessedEvents(ctx context.Context
 				"meter_count", len(meterMap),
 			)
 			// TODO: add sentry span for failed to get features
-			return results, err
+			return results, nil, err
 		}
 
 		for _, f := range features {
@@ -510,6 +684,8 @@ func (s *featureUsageTrackingService) prepareProcessedEvents(ctx context.Context
 
 	// Process the event against each subscription
 	featureUsagePerSub := make([]*events.FeatureUsage, 0)
+	matchedPrices := false
+	var valueFailure *featureUsageFailure
 
 	for _, sub := range subscriptions {
 		// Calculate the period ID for this subscription (epoch-ms of period start)
@@ -576,6 +752,7 @@ func (s *featureUsageTrackingService) prepareProcessedEvents(ctx context.Context
 			)
 			continue
 		}
+		matchedPrices = true
 
 		for _, match := range matches {
 			// Find the corresponding line item
@@ -617,6 +794,12 @@ func (s *featureUsageTrackingService) prepareProcessedEvents(ctx context.Context
 				continue
 			}
 
+			// Usage is still recorded with a zero quantity, replaying the event once the value is
+			// fixed replaces it since the feature usage table keeps the latest version per event
+			if msg := aggregationValueError(event, match.Meter); msg != "" && valueFailure == nil {
+				valueFailure = &featureUsageFailure{reason: types.DeadLetterReasonInvalidValue, message: msg}
+			}
+
 			// Extract quantity based on meter aggregation
 			quantity, _ := s.extractQuantityFromEvent(event, match.Meter, sub.Subscription, periodID)
 
@@ -643,11 +826,193 @@ func (s *featureUsageTrackingService) prepareProcessedEvents(ctx context.Context
 			"event_id", event.ID,
 			"feature_usage_count", len(featureUsagePerSub),
 		)
-		return featureUsagePerSub, nil
+		return featureUsagePerSub, valueFailure, nil
+	}
+
+	if !matchedPrices {
+		return results, &featureUsageFailure{
+			reason:  types.DeadLetterReasonPriceNotFound,
+			message: fmt.Sprintf("no usage price of the active subscriptions meters event %s", event.EventName),
+		}, nil
 	}
 
 	// If we got here, no events were processed
-	return results, nil
+	return results, valueFailure, nil
+}
+
+// autoCreateCustomer creates the customer of an event with an unknown external customer id when the
+// customer config of the environment enables it, optionally subscribing it to the default plan. The events
+// ingested before the customer existed are reprocessed in the background. Returns nil if disabled.
+func (s *featureUsageTrackingService) autoCreateCustomer(ctx context.Context, event *events.Event) (*customer.Customer, error) {
+	settingsService := NewSettingsService(s.ServiceParams)
+	customerConfigResponse, err := settingsService.GetSettingByKey(ctx, types.SettingKeyCustomerConfig.String())
+	if err != nil {
+		return nil, err
+	}
+
+	customerConfig := dto.ConvertToCustomerConfig(customerConfigResponse.Value)
+	if !customerConfig.AutoCreateOnEvent {
+		return nil, nil
+	}
+
+	customerService := NewCustomerService(s.ServiceParams)
+	resp, err := customerService.CreateCustomer(ctx, dto.CreateCustomerRequest{
+		ExternalID: event.ExternalCustomerID,
+		Name:       event.ExternalCustomerID,
+		Metadata: map[string]string{
+			"created_from_event_id": event.ID,
+		},
+	})
+	if ierr.IsAlreadyExists(err) {
+		// Another event of the same customer created it concurrently, the subscription to the
+		// default plan and the reprocessing of the earlier events are left to that call
+		return s.CustomerRepo.GetByLookupKey(ctx, event.ExternalCustomerID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	created := resp.Customer
+
+	s.Logger.Infow("auto-created customer from event",
+		"event_id", event.ID,
+		"customer_id", created.ID,
+		"external_customer_id", event.ExternalCustomerID,
+	)
+
+	if customerConfig.DefaultPlanID != "" {
+		if err := s.subscribeToDefaultPlan(ctx, created, customerConfig.DefaultPlanID, event.Timestamp); err != nil {
+			// The customer is still usable, the event is dead-lettered as subscription_not_found
+			s.Logger.Errorw("failed to subscribe auto-created customer to default plan",
+				"customer_id", created.ID,
+				"plan_id", customerConfig.DefaultPlanID,
+				"error", err,
+			)
+		}
+	}
+
+	// Reprocess the events ingested for the external customer id before the customer existed
+	if err := s.startReprocessEventsWorkflow(ctx, created); err != nil {
+		s.Logger.Errorw("failed to start reprocessing the events of auto-created customer",
+			"customer_id", created.ID,
+			"external_customer_id", created.ExternalID,
+			"error", err,
+		)
+	}
+
+	return created, nil
+}
+
+// startReprocessEventsWorkflow reprocesses the events of the customer in a workflow
+func (s *featureUsageTrackingService) startReprocessEventsWorkflow(ctx context.Context, cust *customer.Customer) error {
+	temporalSvc := temporalservice.GetGlobalTemporalService()
+	if temporalSvc == nil {
+		s.Logger.Warnw("temporal service not available for reprocessing events",
+			"customer_id", cust.ID)
+		return nil
+	}
+
+	input := models.ReprocessEventsWorkflowInput{
+		ExternalCustomerID: cust.ExternalID,
+		TenantID:           types.GetTenantID(ctx),
+		EnvironmentID:      types.GetEnvironmentID(ctx),
+		UserID:             types.GetUserID(ctx),
+	}
+	if err := input.Validate(); err != nil {
+		return err
+	}
+
+	workflowRun, err := temporalSvc.StartWorkflow(ctx, models.StartWorkflowOptions{
+		ID:        types.TemporalReprocessEventsWorkflow.WorkflowID(cust.ID),
+		TaskQueue: types.TemporalReprocessEventsWorkflow.TaskQueueName(),
+	}, types.TemporalReprocessEventsWorkflow, input)
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to start the reprocess events workflow").
+			WithReportableDetails(map[string]interface{}{
+				"customer_id": cust.ID,
+			}).
+			Mark(ierr.ErrSystem)
+	}
+
+	s.Logger.Infow("reprocess events workflow started",
+		"customer_id", cust.ID,
+		"workflow_id", workflowRun.GetID())
+
+	return nil
+}
+
+// subscribeToDefaultPlan creates a subscription to the plan starting at the given time, using the
+// currency and billing period of the first recurring price of the plan
+func (s *featureUsageTrackingService) subscribeToDefaultPlan(ctx context.Context, cust *customer.Customer, planID string, startDate time.Time) error {
+	prices, err := s.PriceRepo.List(ctx, types.NewNoLimitPriceFilter().
+		WithEntityType(types.PRICE_ENTITY_TYPE_PLAN).
+		WithEntityIDs([]string{planID}).
+		WithStatus(types.StatusPublished))
+	if err != nil {
+		return err
+	}
+
+	planPrice, ok := lo.Find(prices, func(p *price.Price) bool {
+		return p.BillingCadence == types.BILLING_CADENCE_RECURRING
+	})
+	if !ok {
+		return ierr.NewError("default plan has no recurring price").
+			WithHint("The default plan of the customer config must have a recurring price").
+			WithReportableDetails(map[string]interface{}{
+				"plan_id": planID,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if now := time.Now().UTC(); startDate.After(now) {
+		startDate = now
+	}
+
+	subscriptionService := NewSubscriptionService(s.ServiceParams)
+	_, err = subscriptionService.CreateSubscription(ctx, dto.CreateSubscriptionRequest{
+		CustomerID:         cust.ID,
+		PlanID:             planID,
+		Currency:           planPrice.Currency,
+		BillingCadence:     planPrice.BillingCadence,
+		BillingPeriod:      planPrice.BillingPeriod,
+		BillingPeriodCount: planPrice.BillingPeriodCount,
+		StartDate:          lo.ToPtr(startDate.UTC()),
+		BillingCycle:       types.BillingCycleAnniversary,
+	})
+	return err
+}
+
+// aggregationValueError returns why the aggregated property of the event cannot be used by the meter,
+// or an empty string when the value is valid. Expressions treat missing properties as zero.
+func aggregationValueError(event *events.Event, m *meter.Meter) string {
+	switch m.Aggregation.Type {
+	case types.AggregationCount, types.AggregationCountUnique:
+		return ""
+	}
+	if m.HasExpression() || m.Aggregation.Field == "" {
+		return ""
+	}
+
+	val, ok := event.Properties[m.Aggregation.Field]
+	if !ok || val == nil {
+		return fmt.Sprintf("property %s is missing", m.Aggregation.Field)
+	}
+
+	switch v := val.(type) {
+	case float64, float32, int, int64, int32, uint, uint64:
+		return ""
+	case string:
+		if _, err := decimal.NewFromString(v); err != nil {
+			return fmt.Sprintf("property %s value %q is not a number", m.Aggregation.Field, v)
+		}
+	case json.Number:
+		if _, err := decimal.NewFromString(string(v)); err != nil {
+			return fmt.Sprintf("property %s value %q is not a number", m.Aggregation.Field, v)
+		}
+	default:
+		return fmt.Sprintf("property %s has unsupported type %T", m.Aggregation.Field, v)
+	}
+	return ""
 }
 
 // Find matching prices for an event based on meter configuration and filters
@@ -684,6 +1049,13 @@ func (s *featureUsageTrackingService) findMatchingPricesForEvent(
 			continue
 		}
 
+		// Skip matrix prices when the event doesn't fall in any cell of the rate table
+		if price.BillingModel == types.BILLING_MODEL_MATRIX {
+			if _, ok := price.Matrix.FindCell(extractMatrixDimensionValues(event.Properties, price.Matrix)); !ok {
+				continue
+			}
+		}
+
 		// Add to matches
 		matches = append(matches, PriceMatch{
 			Price: price,
@@ -710,26 +1082,23 @@ func (s *featureUsageTrackingService) findMatchingPricesForEvent(
 
 // Check if an event matches the meter filters
 func (s *featureUsageTrackingService) checkMeterFilters(event *events.Event, filters []meter.Filter) bool {
-	if len(filters) == 0 {
-		return true // No filters means everything matches
-	}
-
-	for _, filter := range filters {
-		propertyValue, exists := event.Properties[filter.Key]
-		if !exists {
-			return false
-		}
-
-		// Convert property value to string for comparison
-		propStr := fmt.Sprintf("%v", propertyValue)
+	// No filters means everything matches
+	return meter.MatchesAll(filters, event.Properties)
+}
 
-		// Check if the value is in the filter values
-		if !lo.Contains(filter.Values, propStr) {
-			return false
+// extractMatrixDimensionValues returns the values of the matrix dimensions from the event properties
+// Dimensions missing from the properties are left out so that the event doesn't match any cell
+func extractMatrixDimensionValues(properties map[string]interface{}, matrix *types.PriceMatrix) map[string]string {
+	values := make(map[string]string)
+	if matrix == nil {
+		return values
+	}
+	for _, dimension := range matrix.Dimensions {
+		if value, ok := meter.LookupProperty(properties, dimension); ok && value != nil {
+			values[dimension] = fmt.Sprintf("%v", value)
 		}
 	}
-
-	return true
+	return values
 }
 
 // Extract quantity from event based on meter aggregation
@@ -745,40 +1114,14 @@ func (s *featureUsageTrackingService) extractQuantityFromEvent(
 		// For count, always return 1 and empty string for field value
 		return decimal.NewFromInt(1), ""
 
-	case types.AggregationSum, types.AggregationAvg, types.AggregationLatest, types.AggregationMax:
-		if meter.Aggregation.Field == "" {
-			s.Logger.Warnw("aggregation with empty field name",
-				"event_id", event.ID,
-				"meter_id", meter.ID,
-				"aggregation_type", meter.Aggregation.Type,
-			)
-			return decimal.Zero, ""
-		}
-
-		val, ok := event.Properties[meter.Aggregation.Field]
+	case types.AggregationSum, types.AggregationAvg, types.AggregationLatest, types.AggregationMax, types.AggregationMin, types.AggregationFirst, types.AggregationPercentile:
+		decimalValue, stringValue, ok := s.extractAggregationValue(event, meter)
 		if !ok {
-			s.Logger.Warnw("property not found for aggregation",
-				"event_id", event.ID,
-				"meter_id", meter.ID,
-				"field", meter.Aggregation.Field,
-				"aggregation_type", meter.Aggregation.Type,
-			)
 			return decimal.Zero, ""
 		}
-
-		// Convert value to decimal and string with detailed error handling
-		decimalValue, stringValue := s.convertValueToDecimal(val, event, meter)
 		return decimalValue, stringValue
 
 	case types.AggregationSumWithMultiplier:
-		if meter.Aggregation.Field == "" {
-			s.Logger.Warnw("sum_with_multiplier aggregation with empty field name",
-				"event_id", event.ID,
-				"meter_id", meter.ID,
-			)
-			return decimal.Zero, ""
-		}
-
 		if meter.Aggregation.Multiplier == nil {
 			s.Logger.Warnw("sum_with_multiplier aggregation without multiplier",
 				"event_id", event.ID,
@@ -787,19 +1130,9 @@ func (s *featureUsageTrackingService) extractQuantityFromEvent(
 			return decimal.Zero, ""
 		}
 
-		val, ok := event.Properties[meter.Aggregation.Field]
-		if !ok {
-			s.Logger.Warnw("property not found for sum_with_multiplier aggregation",
-				"event_id", event.ID,
-				"meter_id", meter.ID,
-				"field", meter.Aggregation.Field,
-			)
-			return decimal.Zero, ""
-		}
-
-		// Convert value to decimal and apply multiplier
-		decimalValue, stringValue := s.convertValueToDecimal(val, event, meter)
-		if decimalValue.IsZero() {
+		// Extract the value and apply multiplier
+		decimalValue, stringValue, ok := s.extractAggregationValue(event, meter)
+		if !ok || decimalValue.IsZero() {
 			return decimal.Zero, stringValue
 		}
 
@@ -831,27 +1164,8 @@ func (s *featureUsageTrackingService) extractQuantityFromEvent(
 		stringValue := s.convertValueToString(val)
 		return decimal.NewFromInt(1), stringValue
 	case types.AggregationWeightedSum:
-		if meter.Aggregation.Field == "" {
-			s.Logger.Warnw("weighted_sum aggregation with empty field name",
-				"event_id", event.ID,
-				"meter_id", meter.ID,
-			)
-			return decimal.Zero, ""
-		}
-
-		val, ok := event.Properties[meter.Aggregation.Field]
-		if !ok {
-			s.Logger.Warnw("property not found for weighted_sum aggregation",
-				"event_id", event.ID,
-				"meter_id", meter.ID,
-				"field", meter.Aggregation.Field,
-			)
-			return decimal.Zero, ""
-		}
-
-		// Convert value to decimal and apply multiplier
-		decimalValue, stringValue := s.convertValueToDecimal(val, event, meter)
-		if decimalValue.IsZero() {
+		decimalValue, stringValue, ok := s.extractAggregationValue(event, meter)
+		if !ok || decimalValue.IsZero() {
 			return decimal.Zero, stringValue
 		}
 
@@ -871,6 +1185,51 @@ func (s *featureUsageTrackingService) extractQuantityFromEvent(
 	}
 }
 
+// extractAggregationValue extracts the numeric value to aggregate for the meter, either from the
+// configured field or by evaluating the meter expression over the event properties. The expression
+// evaluation mirrors the ClickHouse aggregators so that previews and invoices match.
+func (s *featureUsageTrackingService) extractAggregationValue(event *events.Event, meter *meter.Meter) (decimal.Decimal, string, bool) {
+	if meter.HasExpression() {
+		expr, err := expression.Parse(meter.Aggregation.Expression)
+		if err != nil {
+			s.Logger.Warnw("invalid expression for aggregation",
+				"event_id", event.ID,
+				"meter_id", meter.ID,
+				"expression", meter.Aggregation.Expression,
+				"error", err,
+			)
+			return decimal.Zero, "", false
+		}
+
+		value := expr.Evaluate(event.Properties)
+		return value, value.String(), true
+	}
+
+	if meter.Aggregation.Field == "" {
+		s.Logger.Warnw("aggregation with empty field name",
+			"event_id", event.ID,
+			"meter_id", meter.ID,
+			"aggregation_type", meter.Aggregation.Type,
+		)
+		return decimal.Zero, "", false
+	}
+
+	val, ok := event.Properties[meter.Aggregation.Field]
+	if !ok {
+		s.Logger.Warnw("property not found for aggregation",
+			"event_id", event.ID,
+			"meter_id", meter.ID,
+			"field", meter.Aggregation.Field,
+			"aggregation_type", meter.Aggregation.Type,
+		)
+		return decimal.Zero, "", false
+	}
+
+	// Convert value to decimal and string with detailed error handling
+	decimalValue, stringValue := s.convertValueToDecimal(val, event, meter)
+	return decimalValue, stringValue, true
+}
+
 // convertValueToDecimal converts a property value to decimal and string representation
 func (s *featureUsageTrackingService) convertValueToDecimal(val interface{}, event *events.Event, meter *meter.Meter) (decimal.Decimal, string) {
 	var decimalValue decimal.Decimal
@@ -1227,6 +1586,7 @@ func (s *featureUsageTrackingService) fetchSubscriptions(ctx context.Context, cu
 	filter.SubscriptionStatus = []types.SubscriptionStatus{
 		types.SubscriptionStatusActive,
 		types.SubscriptionStatusTrialing,
+		types.SubscriptionStatusPastDue,
 		types.SubscriptionStatusPaused,
 		types.SubscriptionStatusCancelled,
 	}
@@ -1333,13 +1693,15 @@ func (s *featureUsageTrackingService) buildMaxBucketFeatures(ctx context.Context
 		// Check features for bucketed max meters
 		for _, f := range features {
 			if meterID := featureToMeterMap[f.ID]; meterID != "" {
-				if m, exists := meterMap[meterID]; exists && m.IsBucketedMaxMeter() {
+				if m, exists := meterMap[meterID]; exists && requiresBucketAnalytics(m) {
 					maxBucketFeatures[f.ID] = &events.MaxBucketFeatureInfo{
-						FeatureID:    f.ID,
-						MeterID:      meterID,
-						BucketSize:   types.WindowSize(m.Aggregation.BucketSize),
-						EventName:    m.EventName,
-						PropertyName: m.Aggregation.Field,
+						FeatureID:       f.ID,
+						MeterID:         meterID,
+						BucketSize:      types.WindowSize(m.Aggregation.BucketSize),
+						EventName:       m.EventName,
+						PropertyName:    m.Aggregation.Field,
+						AggregationType: m.Aggregation.Type,
+						Percentile:      m.Aggregation.Percentile,
 					}
 				}
 			}
@@ -1349,6 +1711,20 @@ func (s *featureUsageTrackingService) buildMaxBucketFeatures(ctx context.Context
 	return maxBucketFeatures, nil
 }
 
+// requiresBucketAnalytics returns true if the meter's usage cannot be derived from the
+// standard analytics aggregates and has to be computed per bucket by the repository
+func requiresBucketAnalytics(m *meter.Meter) bool {
+	if m.IsBucketedMeter() {
+		return true
+	}
+	switch m.Aggregation.Type {
+	case types.AggregationMin, types.AggregationFirst, types.AggregationPercentile:
+		return true
+	default:
+		return false
+	}
+}
+
 // fetchAnalytics fetches analytics data from repository
 func (s *featureUsageTrackingService) fetchAnalytics(ctx context.Context, params *events.UsageAnalyticsParams) ([]*events.DetailedUsageAnalytic, error) {
 	// Build max bucket features map (this will handle fetching features if needed)
@@ -1564,7 +1940,7 @@ func (s *featureUsageTrackingService) calculateCosts(ctx context.Context, data *
 				// that was active when the usage was recorded (important for cancelled/new subscriptions)
 				if price, hasPricing := data.Prices[item.PriceID]; hasPricing {
 					// Calculate cost based on meter type
-					if meter.IsBucketedMaxMeter() {
+					if meter.IsBucketedMeter() {
 						s.calculateBucketedCost(ctx, priceService, item, price)
 					} else {
 						s.calculateRegularCost(ctx, priceService, item, meter, price)
@@ -1577,7 +1953,7 @@ func (s *featureUsageTrackingService) calculateCosts(ctx context.Context, data *
 	return nil
 }
 
-// calculateBucketedCost calculates cost for bucketed max meters
+// calculateBucketedCost calculates cost for bucketed meters (MAX, MIN, FIRST or PERCENTILE with bucket size)
 func (s *featureUsageTrackingService) calculateBucketedCost(ctx context.Context, priceService PriceService, item *events.DetailedUsageAnalytic, price *price.Price) {
 	var cost decimal.Decimal
 
@@ -1611,19 +1987,46 @@ func (s *featureUsageTrackingService) calculateRegularCost(ctx context.Context,
 	// Set correct usage value
 	item.TotalUsage = s.getCorrectUsageValue(item, meter.Aggregation.Type)
 
-	// Calculate total cost
-	cost := priceService.CalculateCost(ctx, price, item.TotalUsage)
+	// Calculate total cost, matrix prices are charged per cell when the usage is grouped by the matrix dimensions
+	dimensionValues, isMatrixCell := matrixCellDimensionValues(price, item.Properties)
+	var cost decimal.Decimal
+	if isMatrixCell {
+		cost = priceService.CalculateMatrixCost(ctx, price, dimensionValues, item.TotalUsage)
+	} else {
+		cost = priceService.CalculateCost(ctx, price, item.TotalUsage)
+	}
 	item.TotalCost = cost
 	item.Currency = price.Currency
 
 	// Calculate cost for each point
 	for i := range item.Points {
 		pointUsage := s.getCorrectUsageValueForPoint(item.Points[i], meter.Aggregation.Type)
+		if isMatrixCell {
+			item.Points[i].Cost = priceService.CalculateMatrixCost(ctx, price, dimensionValues, pointUsage)
+			continue
+		}
 		pointCost := priceService.CalculateCost(ctx, price, pointUsage)
 		item.Points[i].Cost = pointCost
 	}
 }
 
+// matrixCellDimensionValues returns the values of the matrix dimensions of a matrix price from the grouped
+// properties of an analytics item, if the item is grouped by all the dimensions
+func matrixCellDimensionValues(price *price.Price, properties map[string]string) (map[string]string, bool) {
+	if price.BillingModel != types.BILLING_MODEL_MATRIX || price.Matrix == nil {
+		return nil, false
+	}
+	values := make(map[string]string, len(price.Matrix.Dimensions))
+	for _, dimension := range price.Matrix.Dimensions {
+		value, ok := properties[dimension]
+		if !ok {
+			return nil, false
+		}
+		values[dimension] = value
+	}
+	return values, true
+}
+
 // aggregateAnalyticsByGrouping aggregates analytics results by the requested grouping dimensions
 // This ensures that when grouping by source, we return source-level totals rather than source+feature combinations
 func (s *featureUsageTrackingService) aggregateAnalyticsByGrouping(analytics []*events.DetailedUsageAnalytic, groupBy []string) []*events.DetailedUsageAnalytic {
@@ -1804,6 +2207,9 @@ func (s *featureUsageTrackingService) getCorrectUsageValue(item *events.Detailed
 		return item.MaxUsage
 	case types.AggregationLatest:
 		return item.LatestUsage
+	case types.AggregationMin, types.AggregationFirst, types.AggregationPercentile:
+		// These are computed per bucket by the repository and summed into TotalUsage
+		return item.TotalUsage
 	case types.AggregationSum, types.AggregationSumWithMultiplier, types.AggregationAvg, types.AggregationWeightedSum:
 		return item.TotalUsage
 	default:
@@ -1821,6 +2227,8 @@ func (s *featureUsageTrackingService) getCorrectUsageValueForPoint(point events.
 		return point.MaxUsage
 	case types.AggregationLatest:
 		return point.LatestUsage
+	case types.AggregationMin, types.AggregationFirst, types.AggregationPercentile:
+		return point.Usage
 	case types.AggregationSum, types.AggregationSumWithMultiplier, types.AggregationAvg, types.AggregationWeightedSum:
 		return point.Usage
 	default:
@@ -1939,6 +2347,121 @@ func (s *featureUsageTrackingService) ReprocessEvents(ctx context.Context, param
 	return nil
 }
 
+// GetDeadLetterEvents lists the events that could not be turned into feature usage
+func (s *featureUsageTrackingService) GetDeadLetterEvents(ctx context.Context, req *dto.GetDeadLetterEventsRequest) (*dto.GetDeadLetterEventsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	if req.PageSize <= 0 || req.PageSize > 50 {
+		req.PageSize = 50
+	}
+
+	deadLetterEvents, total, err := s.DeadLetterEventRepo.GetDeadLetterEvents(ctx, &events.GetDeadLetterEventsParams{
+		EventIDs:           req.EventIDs,
+		EventName:          req.EventName,
+		ExternalCustomerID: req.ExternalCustomerID,
+		Reason:             req.Reason,
+		Status:             req.Status,
+		StartTime:          req.StartTime,
+		EndTime:            req.EndTime,
+		PageSize:           req.PageSize,
+		Offset:             req.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.GetDeadLetterEventsResponse{
+		Events:     make([]*dto.DeadLetterEvent, 0, len(deadLetterEvents)),
+		HasMore:    uint64(req.Offset+len(deadLetterEvents)) < total,
+		TotalCount: total,
+		Offset:     req.Offset,
+	}
+	for _, event := range deadLetterEvents {
+		response.Events = append(response.Events, dto.ToDeadLetterEventResponse(event))
+	}
+
+	return response, nil
+}
+
+// ReplayDeadLetterEvents processes pending dead-lettered events again. Each attempt is recorded as a new
+// version of the dead letter event, marking it replayed on success or updating the failure reason.
+func (s *featureUsageTrackingService) ReplayDeadLetterEvents(ctx context.Context, req *dto.ReplayDeadLetterEventsRequest) (*dto.ReplayDeadLetterEventsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	eventIDs := lo.Uniq(req.EventIDs)
+	deadLetterEvents, _, err := s.DeadLetterEventRepo.GetDeadLetterEvents(ctx, &events.GetDeadLetterEventsParams{
+		EventIDs: eventIDs,
+		Status:   types.DeadLetterEventStatusPending,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.ReplayDeadLetterEventsResponse{
+		Failed:   make([]*dto.DeadLetterEvent, 0),
+		NotFound: make([]string, 0),
+	}
+
+	found := make(map[string]bool, len(deadLetterEvents))
+	attempts := make([]*events.DeadLetterEvent, 0, len(deadLetterEvents))
+	for _, deadLetterEvent := range deadLetterEvents {
+		found[deadLetterEvent.ID] = true
+
+		event := deadLetterEvent.Event
+		failure, err := s.processEvent(ctx, &event)
+		if err != nil {
+			failure = &featureUsageFailure{reason: types.DeadLetterReasonProcessingError, message: err.Error()}
+		}
+
+		now := time.Now().UTC()
+		attempt := &events.DeadLetterEvent{
+			Event:       event,
+			Reason:      deadLetterEvent.Reason,
+			Error:       deadLetterEvent.Error,
+			ReplayCount: deadLetterEvent.ReplayCount + 1,
+			FailedAt:    deadLetterEvent.FailedAt,
+			Version:     uint64(now.UnixNano()),
+		}
+
+		if failure != nil {
+			attempt.Status = types.DeadLetterEventStatusPending
+			attempt.Reason = failure.reason
+			attempt.Error = failure.message
+			attempt.FailedAt = now
+			response.Failed = append(response.Failed, dto.ToDeadLetterEventResponse(attempt))
+		} else {
+			attempt.Status = types.DeadLetterEventStatusReplayed
+			attempt.ReplayedAt = &now
+			response.Replayed++
+		}
+
+		attempts = append(attempts, attempt)
+	}
+
+	for _, id := range eventIDs {
+		if !found[id] {
+			response.NotFound = append(response.NotFound, id)
+		}
+	}
+
+	if err := s.DeadLetterEventRepo.InsertDeadLetterEvents(ctx, attempts); err != nil {
+		return nil, err
+	}
+
+	s.Logger.Infow("replayed dead letter events",
+		"requested", len(eventIDs),
+		"replayed", response.Replayed,
+		"failed", len(response.Failed),
+		"not_found", len(response.NotFound),
+	)
+
+	return response, nil
+}
+
 // isSubscriptionValidForEvent checks if a subscription is valid for processing the given event
 // It ensures the event timestamp falls within the subscription's active period
 func (s *featureUsageTrackingService) isSubscriptionValidForEvent(
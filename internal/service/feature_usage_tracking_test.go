@@ -0,0 +1,181 @@
+package service
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/domain/customer"
+	"github.com/flexprice/flexprice/internal/domain/events"
+	"github.com/flexprice/flexprice/internal/domain/meter"
+	"github.com/flexprice/flexprice/internal/domain/plan"
+	"github.com/flexprice/flexprice/internal/domain/price"
+	"github.com/flexprice/flexprice/internal/domain/settings"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	"github.com/flexprice/flexprice/internal/testutil"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestAggregationValueError(t *testing.T) {
+	sumMeter := &meter.Meter{Aggregation: meter.Aggregation{Type: types.AggregationSum, Field: "tokens"}}
+	countMeter := &meter.Meter{Aggregation: meter.Aggregation{Type: types.AggregationCount}}
+
+	testCases := []struct {
+		name       string
+		meter      *meter.Meter
+		properties map[string]interface{}
+		valid      bool
+	}{
+		{name: "numeric value", meter: sumMeter, properties: map[string]interface{}{"tokens": float64(12)}, valid: true},
+		{name: "numeric string", meter: sumMeter, properties: map[string]interface{}{"tokens": "12.5"}, valid: true},
+		{name: "json number", meter: sumMeter, properties: map[string]interface{}{"tokens": json.Number("7")}, valid: true},
+		{name: "missing property", meter: sumMeter, properties: map[string]interface{}{}, valid: false},
+		{name: "non numeric string", meter: sumMeter, properties: map[string]interface{}{"tokens": "twelve"}, valid: false},
+		{name: "unsupported type", meter: sumMeter, properties: map[string]interface{}{"tokens": []interface{}{1}}, valid: false},
+		{name: "count ignores properties", meter: countMeter, properties: map[string]interface{}{}, valid: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := aggregationValueError(&events.Event{Properties: tc.properties}, tc.meter)
+			assert.Equal(t, tc.valid, msg == "", msg)
+		})
+	}
+}
+
+type FeatureUsageTrackingServiceSuite struct {
+	testutil.BaseServiceTestSuite
+	service *featureUsageTrackingService
+	plan    *plan.Plan
+}
+
+func TestFeatureUsageTrackingService(t *testing.T) {
+	suite.Run(t, new(FeatureUsageTrackingServiceSuite))
+}
+
+func (s *FeatureUsageTrackingServiceSuite) SetupTest() {
+	s.BaseServiceTestSuite.SetupTest()
+	stores := s.GetStores()
+	s.service = &featureUsageTrackingService{
+		ServiceParams: ServiceParams{
+			Logger:                     s.GetLogger(),
+			Config:                     s.GetConfig(),
+			DB:                         s.GetDB(),
+			TaxAssociationRepo:         stores.TaxAssociationRepo,
+			TaxRateRepo:                stores.TaxRateRepo,
+			SubRepo:                    stores.SubscriptionRepo,
+			PlanRepo:                   stores.PlanRepo,
+			PriceRepo:                  stores.PriceRepo,
+			EventRepo:                  stores.EventRepo,
+			MeterRepo:                  stores.MeterRepo,
+			CustomerRepo:               stores.CustomerRepo,
+			InvoiceRepo:                stores.InvoiceRepo,
+			EntitlementRepo:            stores.EntitlementRepo,
+			EnvironmentRepo:            stores.EnvironmentRepo,
+			FeatureRepo:                stores.FeatureRepo,
+			TenantRepo:                 stores.TenantRepo,
+			UserRepo:                   stores.UserRepo,
+			AuthRepo:                   stores.AuthRepo,
+			WalletRepo:                 stores.WalletRepo,
+			PaymentRepo:                stores.PaymentRepo,
+			CreditGrantRepo:            stores.CreditGrantRepo,
+			CreditGrantApplicationRepo: stores.CreditGrantApplicationRepo,
+			CouponRepo:                 stores.CouponRepo,
+			CouponAssociationRepo:      stores.CouponAssociationRepo,
+			CouponApplicationRepo:      stores.CouponApplicationRepo,
+			SettingsRepo:               stores.SettingsRepo,
+			ConnectionRepo:             stores.ConnectionRepo,
+			IntegrationFactory:         s.GetIntegrationFactory(),
+			EventPublisher:             s.GetPublisher(),
+			WebhookPublisher:           s.GetWebhookPublisher(),
+			ProrationCalculator:        s.GetCalculator(),
+		},
+		eventRepo: stores.EventRepo,
+	}
+
+	ctx := s.GetContext()
+	s.plan = &plan.Plan{
+		ID:        "plan_default",
+		Name:      "Default Plan",
+		BaseModel: types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(stores.PlanRepo.Create(ctx, s.plan))
+	s.NoError(stores.PriceRepo.Create(ctx, &price.Price{
+		ID:                 "price_default",
+		Amount:             decimal.NewFromInt(10),
+		Currency:           "usd",
+		EntityType:         types.PRICE_ENTITY_TYPE_PLAN,
+		EntityID:           s.plan.ID,
+		Type:               types.PRICE_TYPE_FIXED,
+		BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+		BillingPeriodCount: 1,
+		BillingModel:       types.BILLING_MODEL_FLAT_FEE,
+		BillingCadence:     types.BILLING_CADENCE_RECURRING,
+		InvoiceCadence:     types.InvoiceCadenceArrear,
+		BaseModel:          types.GetDefaultBaseModel(ctx),
+	}))
+	s.NoError(stores.SettingsRepo.Create(ctx, &settings.Setting{
+		ID:  "setting_customer_config",
+		Key: types.SettingKeyCustomerConfig.String(),
+		Value: map[string]interface{}{
+			"auto_create_on_event": true,
+			"default_plan_id":      s.plan.ID,
+		},
+		EnvironmentID: types.GetEnvironmentID(ctx),
+		BaseModel:     types.GetDefaultBaseModel(ctx),
+	}))
+}
+
+func (s *FeatureUsageTrackingServiceSuite) listSubscriptions(customerID string) []*subscription.Subscription {
+	filter := types.NewNoLimitSubscriptionFilter()
+	filter.CustomerID = customerID
+	subs, err := s.GetStores().SubscriptionRepo.List(s.GetContext(), filter)
+	s.NoError(err)
+	return subs
+}
+
+func (s *FeatureUsageTrackingServiceSuite) TestAutoCreateCustomer() {
+	event := &events.Event{
+		ID:                 "event_1",
+		ExternalCustomerID: "ext_new_customer",
+		Timestamp:          s.GetNow().Add(-time.Hour),
+	}
+
+	created, err := s.service.autoCreateCustomer(s.GetContext(), event)
+	s.NoError(err)
+	s.Require().NotNil(created)
+	s.Equal(event.ExternalCustomerID, created.ExternalID)
+	s.Equal(event.ID, created.Metadata["created_from_event_id"])
+
+	subs := s.listSubscriptions(created.ID)
+	s.Require().Len(subs, 1)
+	s.Equal(s.plan.ID, subs[0].PlanID)
+}
+
+func (s *FeatureUsageTrackingServiceSuite) TestAutoCreateCustomerAlreadyExists() {
+	// Another event of the customer created it concurrently
+	existing := &customer.Customer{
+		ID:         "cust_existing",
+		ExternalID: "ext_existing",
+		Name:       "ext_existing",
+		BaseModel:  types.GetDefaultBaseModel(s.GetContext()),
+	}
+	s.NoError(s.GetStores().CustomerRepo.Create(s.GetContext(), existing))
+
+	event := &events.Event{
+		ID:                 "event_2",
+		ExternalCustomerID: existing.ExternalID,
+		Timestamp:          s.GetNow().Add(-time.Hour),
+	}
+
+	got, err := s.service.autoCreateCustomer(s.GetContext(), event)
+	s.NoError(err)
+	s.Require().NotNil(got)
+	s.Equal(existing.ID, got.ID)
+
+	// The subscription to the default plan is left to the call that created the customer
+	s.Empty(s.listSubscriptions(existing.ID))
+}
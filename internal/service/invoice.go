@@ -34,6 +34,7 @@ type InvoiceService interface {
 	ProcessDraftInvoice(ctx context.Context, id string, paymentParams *dto.PaymentParameters, sub *subscription.Subscription, flowType types.InvoiceFlowType) error
 	UpdatePaymentStatus(ctx context.Context, id string, status types.PaymentStatus, amount *decimal.Decimal) error
 	CreateSubscriptionInvoice(ctx context.Context, req *dto.CreateSubscriptionInvoiceRequest, paymentParams *dto.PaymentParameters, flowType types.InvoiceFlowType) (*dto.InvoiceResponse, *subscription.Subscription, error)
+	CreateSubscriptionCommitmentInvoice(ctx context.Context, sub *subscription.Subscription, paymentParams *dto.PaymentParameters, flowType types.InvoiceFlowType) (*dto.InvoiceResponse, error)
 	GetPreviewInvoice(ctx context.Context, req dto.GetPreviewInvoiceRequest) (*dto.InvoiceResponse, error)
 	GetCustomerInvoiceSummary(ctx context.Context, customerID string, currency string) (*dto.CustomerInvoiceSummary, error)
 	GetUnpaidInvoicesToBePaid(ctx context.Context, customerID string, currency string) ([]*dto.InvoiceResponse, decimal.Decimal, error)
@@ -1122,6 +1123,57 @@ func (s *invoiceService) CreateSubscriptionInvoice(ctx context.Context, req *dto
 	return inv, subscription, nil
 }
 
+// CreateSubscriptionCommitmentInvoice creates the invoice for the current commitment term of a subscription
+// with a prepaid or postpaid commitment, i.e. the upfront invoice of a prepaid commitment or the true-up
+// invoice of a postpaid commitment. The invoice is created only once per commitment term.
+func (s *invoiceService) CreateSubscriptionCommitmentInvoice(ctx context.Context, sub *subscription.Subscription, paymentParams *dto.PaymentParameters, flowType types.InvoiceFlowType) (*dto.InvoiceResponse, error) {
+	billingService := NewBillingService(s.ServiceParams)
+
+	invoiceReq, err := billingService.PrepareCommitmentInvoiceRequest(ctx, sub)
+	if err != nil {
+		return nil, err
+	}
+
+	if invoiceReq == nil {
+		s.Logger.Debugw("no commitment to invoice for subscription",
+			"subscription_id", sub.ID,
+			"commitment_type", sub.CommitmentType)
+		return nil, nil
+	}
+
+	invoiceReq.IdempotencyKey = lo.ToPtr(s.idempGen.GenerateKey(idempotency.ScopeSubscriptionInvoice, map[string]interface{}{
+		"tenant_id":             types.GetTenantID(ctx),
+		"subscription_id":       sub.ID,
+		"commitment_type":       sub.CommitmentType,
+		"commitment_start_date": sub.CommitmentStartDate,
+		"commitment_end_date":   sub.CommitmentEndDate,
+	}))
+
+	inv, err := s.CreateInvoice(ctx, *invoiceReq)
+	if err != nil {
+		if ierr.IsAlreadyExists(err) {
+			s.Logger.Infow("commitment invoice already exists for the commitment term",
+				"subscription_id", sub.ID,
+				"commitment_start_date", sub.CommitmentStartDate,
+				"commitment_end_date", sub.CommitmentEndDate)
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if err := s.ProcessDraftInvoice(ctx, inv.ID, paymentParams, sub, flowType); err != nil {
+		return nil, err
+	}
+
+	s.Logger.Infow("created commitment invoice for subscription",
+		"subscription_id", sub.ID,
+		"invoice_id", inv.ID,
+		"commitment_type", sub.CommitmentType,
+		"amount", inv.AmountDue)
+
+	return inv, nil
+}
+
 func (s *invoiceService) GetPreviewInvoice(ctx context.Context, req dto.GetPreviewInvoiceRequest) (*dto.InvoiceResponse, error) {
 	billingService := NewBillingService(s.ServiceParams)
 
@@ -1675,6 +1727,14 @@ func (s *invoiceService) getInvoiceDataForPDFGen(
 	// Prepare line items
 	var lineItems []pdf.LineItemData
 
+	// Line items of the child customers of a consolidated invoice, the invoice
+	// customer's own line items always make up the first section
+	sections := []*pdf.InvoiceSectionData{{
+		CustomerID:   customer.ID,
+		CustomerName: data.Recipient.Name,
+	}}
+	sectionsByCustomerID := map[string]*pdf.InvoiceSectionData{customer.ID: sections[0]}
+
 	// Process line items
 	for _, item := range inv.LineItems {
 		planDisplayName := ""
@@ -1732,6 +1792,19 @@ func (s *invoiceService) getInvoiceDataForPDFGen(
 		}
 
 		lineItems = append(lineItems, lineItem)
+
+		sectionCustomerID := lo.CoalesceOrEmpty(item.Metadata["customer_id"], customer.ID)
+		section, ok := sectionsByCustomerID[sectionCustomerID]
+		if !ok {
+			section = &pdf.InvoiceSectionData{
+				CustomerID:   sectionCustomerID,
+				CustomerName: lo.CoalesceOrEmpty(item.Metadata["customer_name"], sectionCustomerID),
+			}
+			sectionsByCustomerID[sectionCustomerID] = section
+			sections = append(sections, section)
+		}
+		section.LineItems = append(section.LineItems, lineItem)
+		section.Subtotal += amount
 	}
 
 	// Line items contain only actual billable items (subscriptions, addons)
@@ -1739,6 +1812,13 @@ func (s *invoiceService) getInvoiceDataForPDFGen(
 
 	data.LineItems = lineItems
 
+	// Only consolidated invoices billing the usage of child customers are split in sections
+	if len(sections) > 1 {
+		for _, section := range sections {
+			data.Sections = append(data.Sections, *section)
+		}
+	}
+
 	// Get applied taxes for detailed breakdown
 	appliedTaxes, err := s.getAppliedTaxesForPDF(ctx, inv.ID)
 	if err != nil {
@@ -149,6 +149,12 @@ func (s *meterService) UpdateMeter(ctx context.Context, id string, filters []met
 			Mark(ierr.ErrValidation)
 	}
 
+	for _, filter := range filters {
+		if err := filter.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
 	// Fetch the existing meter
 	existingMeter, err := s.meterRepo.GetMeter(ctx, id)
 	if err != nil {
@@ -168,34 +174,46 @@ func (s *meterService) UpdateMeter(ctx context.Context, id string, filters []met
 	return existingMeter, nil
 }
 
-// mergeFilters combines existing filters with new filters, ensuring no duplicates
+// mergeFilters combines existing filters with new filters, ensuring no duplicates.
+// Values are merged for filters on the same key and operator, otherwise the new filter
+// replaces the existing one for that key.
 func mergeFilters(existingFilters, newFilters []meter.Filter) []meter.Filter {
-	filterMap := make(map[string][]string)
+	filterMap := make(map[string]meter.Filter)
+	keys := make([]string, 0, len(existingFilters)+len(newFilters))
 
 	// Add existing filters to the map
 	for _, f := range existingFilters {
-		filterMap[f.Key] = f.Values
+		if _, exists := filterMap[f.Key]; !exists {
+			keys = append(keys, f.Key)
+		}
+		filterMap[f.Key] = f
 	}
 
 	// Merge new filters into the map
 	for _, newFilter := range newFilters {
-		if _, exists := filterMap[newFilter.Key]; !exists {
-			filterMap[newFilter.Key] = []string{}
+		existing, exists := filterMap[newFilter.Key]
+		if !exists {
+			keys = append(keys, newFilter.Key)
+		}
+		if !exists || existing.GetOperator() != newFilter.GetOperator() || !newFilter.GetOperator().RequiresValues() {
+			filterMap[newFilter.Key] = newFilter
+			continue
 		}
+
+		values := append([]string{}, existing.Values...)
 		for _, value := range newFilter.Values {
-			if !contains(filterMap[newFilter.Key], value) {
-				filterMap[newFilter.Key] = append(filterMap[newFilter.Key], value)
+			if !contains(values, value) {
+				values = append(values, value)
 			}
 		}
+		existing.Values = values
+		filterMap[newFilter.Key] = existing
 	}
 
 	// Convert the map back to a slice of filters
 	mergedFilters := make([]meter.Filter, 0, len(filterMap))
-	for key, values := range filterMap {
-		mergedFilters = append(mergedFilters, meter.Filter{
-			Key:    key,
-			Values: values,
-		})
+	for _, key := range keys {
+		mergedFilters = append(mergedFilters, filterMap[key])
 	}
 
 	return mergedFilters
@@ -9,6 +9,8 @@ import (
 	"github.com/flexprice/flexprice/internal/domain/meter"
 	"github.com/flexprice/flexprice/internal/testutil"
 	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -64,6 +66,80 @@ func (s *MeterServiceSuite) TestCreateMeter() {
 			},
 			expectedError: false,
 		},
+		{
+			name: "successful_min_meter_with_bucket",
+			input: &dto.CreateMeterRequest{
+				Name:      "Minimum Committed Concurrency",
+				EventName: "concurrency_sample",
+				Aggregation: meter.Aggregation{
+					Type:       types.AggregationMin,
+					Field:      "concurrency",
+					BucketSize: types.WindowSizeDay,
+				},
+				Filters:    []meter.Filter{},
+				ResetUsage: types.ResetUsageBillingPeriod,
+			},
+			expectedError: false,
+		},
+		{
+			name: "successful_first_meter",
+			input: &dto.CreateMeterRequest{
+				Name:      "First Reading",
+				EventName: "meter_reading",
+				Aggregation: meter.Aggregation{
+					Type:  types.AggregationFirst,
+					Field: "reading",
+				},
+				Filters:    []meter.Filter{},
+				ResetUsage: types.ResetUsageBillingPeriod,
+			},
+			expectedError: false,
+		},
+		{
+			name: "successful_percentile_meter_with_bucket",
+			input: &dto.CreateMeterRequest{
+				Name:      "P95 Bandwidth",
+				EventName: "bandwidth_sample",
+				Aggregation: meter.Aggregation{
+					Type:       types.AggregationPercentile,
+					Field:      "mbps",
+					Percentile: lo.ToPtr(decimal.NewFromInt(95)),
+					BucketSize: types.WindowSizeDay,
+				},
+				Filters:    []meter.Filter{},
+				ResetUsage: types.ResetUsageBillingPeriod,
+			},
+			expectedError: false,
+		},
+		{
+			name: "invalid_percentile_meter_missing_percentile",
+			input: &dto.CreateMeterRequest{
+				Name:      "P95 Bandwidth",
+				EventName: "bandwidth_sample",
+				Aggregation: meter.Aggregation{
+					Type:  types.AggregationPercentile,
+					Field: "mbps",
+				},
+				Filters:    []meter.Filter{},
+				ResetUsage: types.ResetUsageBillingPeriod,
+			},
+			expectedError: true,
+		},
+		{
+			name: "invalid_percentile_out_of_range",
+			input: &dto.CreateMeterRequest{
+				Name:      "P95 Bandwidth",
+				EventName: "bandwidth_sample",
+				Aggregation: meter.Aggregation{
+					Type:       types.AggregationPercentile,
+					Field:      "mbps",
+					Percentile: lo.ToPtr(decimal.NewFromInt(150)),
+				},
+				Filters:    []meter.Filter{},
+				ResetUsage: types.ResetUsageBillingPeriod,
+			},
+			expectedError: true,
+		},
 		{
 			name:          "nil_meter",
 			input:         nil,
@@ -318,6 +394,22 @@ func (s *MeterServiceSuite) TestUpdateMeter() {
 				{Key: "region", Values: []string{"us-east-1"}},
 			},
 		},
+		{
+			name:          "replace_filter_with_different_operator",
+			id:            "test-meter-id",
+			filters:       []meter.Filter{{Key: "region", Operator: types.MeterFilterOperatorNotIn, Values: []string{"eu-west-1"}}},
+			expectedError: false,
+			expectedFilters: []meter.Filter{
+				{Key: "status", Values: []string{"active", "inactive"}},
+				{Key: "region", Operator: types.MeterFilterOperatorNotIn, Values: []string{"eu-west-1"}},
+			},
+		},
+		{
+			name:          "invalid_range_filter",
+			id:            "test-meter-id",
+			filters:       []meter.Filter{{Key: "request.latency_ms", Operator: types.MeterFilterOperatorRange}},
+			expectedError: true,
+		},
 		{
 			name:          "empty_id",
 			id:            "",
@@ -0,0 +1,277 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"hash"
+	"sort"
+	"strconv"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/events"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/telemetry"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+const (
+	metricsIngestionSourceOTLP       = "otlp"
+	metricsIngestionSourcePrometheus = "prometheus"
+
+	// metricsIngestionBatchSize matches the maximum number of events of a bulk ingestion request
+	metricsIngestionBatchSize = 1000
+)
+
+// MetricsIngestionService converts the metrics pushed by OpenTelemetry and Prometheus into usage events
+// using the metric mapping rules of the metrics_ingestion_config setting
+type MetricsIngestionService interface {
+	// IngestOTLPMetrics ingests an OTLP/HTTP metrics export request in the JSON encoding
+	IngestOTLPMetrics(ctx context.Context, body []byte) (*dto.IngestMetricsResponse, error)
+	// IngestPrometheusRemoteWrite ingests a snappy compressed Prometheus remote-write request
+	IngestPrometheusRemoteWrite(ctx context.Context, body []byte) (*dto.IngestMetricsResponse, error)
+}
+
+type metricsIngestionService struct {
+	ServiceParams
+}
+
+func NewMetricsIngestionService(params ServiceParams) MetricsIngestionService {
+	return &metricsIngestionService{
+		ServiceParams: params,
+	}
+}
+
+func (s *metricsIngestionService) IngestOTLPMetrics(ctx context.Context, body []byte) (*dto.IngestMetricsResponse, error) {
+	points, err := telemetry.ParseOTLPMetrics(body)
+	if err != nil {
+		return nil, err
+	}
+	return s.ingest(ctx, points, metricsIngestionSourceOTLP)
+}
+
+func (s *metricsIngestionService) IngestPrometheusRemoteWrite(ctx context.Context, body []byte) (*dto.IngestMetricsResponse, error) {
+	points, err := telemetry.ParseRemoteWrite(body)
+	if err != nil {
+		return nil, err
+	}
+	return s.ingest(ctx, points, metricsIngestionSourcePrometheus)
+}
+
+// ingest maps the data points to events and pushes them through the bulk ingestion path, so that the
+// event schemas and the downstream processing apply to them like to any other event
+func (s *metricsIngestionService) ingest(ctx context.Context, points []telemetry.DataPoint, source string) (*dto.IngestMetricsResponse, error) {
+	config, err := s.getMetricsIngestionConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.IngestMetricsResponse{
+		BulkIngestEventResponse: dto.BulkIngestEventResponse{
+			Message:    "Metrics accepted for processing",
+			Violations: make([]*dto.EventSchemaViolationResult, 0),
+		},
+	}
+
+	// cumulative points are converted in the order of their timestamps, so that the points of a series
+	// sent in a single request are subtracted from each other
+	order := lo.Range(len(points))
+	sort.SliceStable(order, func(a, b int) bool {
+		return points[order[a]].Timestamp.Before(points[order[b]].Timestamp)
+	})
+
+	// pointIndexes keeps the position of the data point of every mapped event
+	requests := make([]*dto.IngestEventRequest, 0, len(points))
+	pointIndexes := make([]int, 0, len(points))
+	for _, i := range order {
+		point := points[i]
+		rule := config.RuleFor(point.MetricName)
+		if rule == nil {
+			response.Unmapped++
+			continue
+		}
+
+		if isCumulativePoint(point, rule) {
+			delta, ok, err := s.cumulativeToDelta(ctx, point)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				response.Skipped++
+				continue
+			}
+			point = delta
+		}
+
+		req := metricToEvent(point, rule, source)
+		if req == nil {
+			response.Unmapped++
+			continue
+		}
+		requests = append(requests, req)
+		pointIndexes = append(pointIndexes, i)
+	}
+
+	if response.Unmapped > 0 {
+		s.Logger.Debugw("dropped unmapped metric data points",
+			"source", source,
+			"unmapped", response.Unmapped,
+			"total", len(points))
+	}
+
+	eventService := NewEventService(s.EventRepo, s.MeterRepo, s.EventSchemaRepo, s.RejectedEventRepo, s.EventPublisher, s.Logger, s.Config)
+	for chunkIndex, chunk := range lo.Chunk(requests, metricsIngestionBatchSize) {
+		result, err := eventService.BulkCreateEvents(ctx, &dto.BulkIngestEventRequest{Events: chunk})
+		if err != nil {
+			return nil, err
+		}
+
+		response.Accepted += result.Accepted
+		response.Rejected += result.Rejected
+		response.Flagged += result.Flagged
+		for _, violation := range result.Violations {
+			violation.Index = pointIndexes[chunkIndex*metricsIngestionBatchSize+violation.Index]
+			response.Violations = append(response.Violations, violation)
+		}
+	}
+
+	return response, nil
+}
+
+func (s *metricsIngestionService) getMetricsIngestionConfig(ctx context.Context) (*types.MetricsIngestionConfig, error) {
+	settingsService := NewSettingsService(s.ServiceParams)
+	setting, err := settingsService.GetSettingByKey(ctx, types.SettingKeyMetricsIngestion.String())
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := dto.ConvertToMetricsIngestionConfig(setting.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(config.Rules) == 0 {
+		return nil, ierr.NewError("metrics ingestion is not configured").
+			WithHint("Configure the metric mapping rules of the metrics_ingestion_config setting before sending metrics").
+			Mark(ierr.ErrValidation)
+	}
+	return config, nil
+}
+
+// isCumulativePoint tells whether the value of the point is the running total of its series, the
+// temporality of the payload takes precedence over the one of the rule
+func isCumulativePoint(point telemetry.DataPoint, rule *types.MetricMappingRule) bool {
+	if point.Temporality != telemetry.TemporalityUnspecified {
+		return point.Temporality == telemetry.TemporalityCumulative
+	}
+	return rule.Temporality == types.MetricTemporalityCumulative
+}
+
+// cumulativeToDelta converts a cumulative point to the increase since the previous point of its series.
+// The first point of a series only sets the baseline. A lower value or a new start time means the series
+// was reset, in which case the value of the point is the increase. A retried export of the last point
+// converts to the same increase again, its event has the same id so it is ingested only once even when
+// the first attempt failed to publish it. Returns false when the point must not be ingested: it sets the
+// baseline, it is older than the last point or the series did not increase.
+func (s *metricsIngestionService) cumulativeToDelta(ctx context.Context, point telemetry.DataPoint) (telemetry.DataPoint, bool, error) {
+	seriesHash := sha256.New()
+	writeMetricSeries(seriesHash, point)
+
+	current := &events.MetricSeriesState{
+		TenantID:      types.GetTenantID(ctx),
+		EnvironmentID: types.GetEnvironmentID(ctx),
+		SeriesID:      fmt.Sprintf("%x", seriesHash.Sum(nil)),
+		Value:         point.Value,
+		Count:         lo.FromPtr(point.Count),
+		StartTime:     point.StartTime,
+		ValueAt:       point.Timestamp,
+	}
+
+	previous, advanced, err := s.MetricSeriesStateRepo.Advance(ctx, current)
+	if err != nil {
+		return point, false, err
+	}
+	if !advanced || previous == nil {
+		return point, false, nil
+	}
+
+	delta := point
+	delta.Temporality = telemetry.TemporalityDelta
+	reset := current.Value < previous.Value || current.Count < previous.Count ||
+		(!current.StartTime.IsZero() && !current.StartTime.Equal(previous.StartTime))
+	if !reset {
+		delta.Value = current.Value - previous.Value
+		if point.Count != nil {
+			delta.Count = lo.ToPtr(current.Count - previous.Count)
+		}
+	}
+
+	if delta.Value == 0 && lo.FromPtr(delta.Count) == 0 {
+		return point, false, nil
+	}
+	return delta, true, nil
+}
+
+// metricToEvent builds the event of a data point, returns nil when the point does not carry the
+// customer attribute of the rule
+func metricToEvent(point telemetry.DataPoint, rule *types.MetricMappingRule, source string) *dto.IngestEventRequest {
+	customerID := point.Attributes[rule.CustomerAttribute]
+	if customerID == "" {
+		return nil
+	}
+
+	properties := make(map[string]interface{}, len(point.Attributes)+3)
+	if len(rule.Attributes) > 0 {
+		for _, key := range rule.Attributes {
+			if value, ok := point.Attributes[key]; ok {
+				properties[key] = value
+			}
+		}
+	} else {
+		for key, value := range point.Attributes {
+			properties[key] = value
+		}
+	}
+	delete(properties, rule.CustomerAttribute)
+
+	properties["metric_name"] = point.MetricName
+	properties[rule.GetValueProperty()] = point.Value
+	if point.Count != nil {
+		properties["count"] = *point.Count
+	}
+
+	return &dto.IngestEventRequest{
+		EventID:            metricEventID(point),
+		EventName:          rule.EventName,
+		ExternalCustomerID: customerID,
+		Timestamp:          point.Timestamp,
+		Source:             source,
+		Properties:         properties,
+	}
+}
+
+// metricEventID derives the event id from the series and the timestamp of the data point, so that
+// exporters retrying a request do not create duplicate events
+func metricEventID(point telemetry.DataPoint) string {
+	hash := sha256.New()
+	writeMetricSeries(hash, point)
+	hash.Write([]byte{0})
+	hash.Write([]byte(strconv.FormatInt(point.Timestamp.UnixNano(), 10)))
+
+	return fmt.Sprintf("%s_%x", types.UUID_PREFIX_EVENT, hash.Sum(nil)[:16])
+}
+
+// writeMetricSeries writes the metric name and the sorted attributes identifying the series of the point
+func writeMetricSeries(h hash.Hash, point telemetry.DataPoint) {
+	keys := lo.Keys(point.Attributes)
+	sort.Strings(keys)
+
+	h.Write([]byte(point.MetricName))
+	for _, key := range keys {
+		h.Write([]byte{0})
+		h.Write([]byte(key))
+		h.Write([]byte{0})
+		h.Write([]byte(point.Attributes[key]))
+	}
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/telemetry"
+	"github.com/flexprice/flexprice/internal/testutil"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetricsCumulativeToDelta(t *testing.T) {
+	s := &metricsIngestionService{ServiceParams: ServiceParams{MetricSeriesStateRepo: testutil.NewInMemoryMetricSeriesStateStore()}}
+	ctx := context.WithValue(context.Background(), types.CtxTenantID, "tenant_1")
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	point := func(value float64, offset time.Duration, startTime time.Time) telemetry.DataPoint {
+		return telemetry.DataPoint{
+			MetricName:  "http_requests_total",
+			Attributes:  map[string]string{"tenant": "cust_1"},
+			Value:       value,
+			Timestamp:   start.Add(offset),
+			Temporality: telemetry.TemporalityCumulative,
+			StartTime:   startTime,
+		}
+	}
+
+	// the first point only sets the baseline
+	_, ok, err := s.cumulativeToDelta(ctx, point(100, 0, start))
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	delta, ok, err := s.cumulativeToDelta(ctx, point(130, time.Minute, start))
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, float64(30), delta.Value)
+	assert.Equal(t, telemetry.TemporalityDelta, delta.Temporality)
+
+	// a retried export of the last point converts to the same event, in case the first attempt was not published
+	retried, ok, err := s.cumulativeToDelta(ctx, point(130, time.Minute, start))
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, float64(30), retried.Value)
+	assert.Equal(t, metricEventID(delta), metricEventID(retried))
+
+	// an older point is skipped
+	_, ok, err = s.cumulativeToDelta(ctx, point(120, 30*time.Second, start))
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	// an unchanged series is skipped
+	_, ok, err = s.cumulativeToDelta(ctx, point(130, 2*time.Minute, start))
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	// a lower value means the counter was reset, the value is the increase since the reset
+	delta, ok, err = s.cumulativeToDelta(ctx, point(20, 3*time.Minute, start))
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, float64(20), delta.Value)
+
+	// so does a new start time
+	delta, ok, err = s.cumulativeToDelta(ctx, point(50, 4*time.Minute, start.Add(3*time.Minute)))
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, float64(50), delta.Value)
+}
+
+func TestIsCumulativePoint(t *testing.T) {
+	rule := &types.MetricMappingRule{Temporality: types.MetricTemporalityCumulative}
+
+	// Prometheus samples use the temporality of the rule
+	assert.True(t, isCumulativePoint(telemetry.DataPoint{}, rule))
+	assert.False(t, isCumulativePoint(telemetry.DataPoint{}, &types.MetricMappingRule{}))
+
+	// the temporality of the payload takes precedence
+	assert.False(t, isCumulativePoint(telemetry.DataPoint{Temporality: telemetry.TemporalityDelta}, rule))
+	assert.True(t, isCumulativePoint(telemetry.DataPoint{Temporality: telemetry.TemporalityCumulative}, &types.MetricMappingRule{}))
+}
@@ -164,12 +164,16 @@ func (s *onboardingService) GenerateEvents(ctx context.Context, req *dto.Onboard
 
 // Helper function to create MeterInfo from a Meter
 func createMeterInfoFromMeter(m *dto.MeterResponse) types.MeterInfo {
-	filterInfos := make([]types.FilterInfo, len(m.Filters))
-	for j, f := range m.Filters {
-		filterInfos[j] = types.FilterInfo{
+	// Only exact match filters have values a generated event can take
+	filterInfos := make([]types.FilterInfo, 0, len(m.Filters))
+	for _, f := range m.Filters {
+		if !f.IsExactMatch() {
+			continue
+		}
+		filterInfos = append(filterInfos, types.FilterInfo{
 			Key:    f.Key,
 			Values: f.Values,
-		}
+		})
 	}
 
 	return types.MeterInfo{
@@ -234,7 +238,7 @@ func (s *onboardingService) processMessage(msg *message.Message) error {
 
 // generateEvents generates events at a rate of 1 per second
 func (s *onboardingService) generateEvents(ctx context.Context, eventMsg *types.OnboardingEventsMessage) {
-	eventService := NewEventService(s.EventRepo, s.MeterRepo, s.EventPublisher, s.Logger, s.Config)
+	eventService := NewEventService(s.EventRepo, s.MeterRepo, s.EventSchemaRepo, s.RejectedEventRepo, s.EventPublisher, s.Logger, s.Config)
 
 	// Calculate total events to generate
 	totalEvents := eventMsg.Duration * 5
@@ -7,6 +7,7 @@ import (
 
 	"github.com/flexprice/flexprice/internal/api/dto"
 	"github.com/flexprice/flexprice/internal/domain/invoice"
+	"github.com/flexprice/flexprice/internal/domain/payment"
 	"github.com/flexprice/flexprice/internal/domain/wallet"
 	ierr "github.com/flexprice/flexprice/internal/errors"
 	"github.com/flexprice/flexprice/internal/idempotency"
@@ -14,6 +15,7 @@ import (
 	"github.com/flexprice/flexprice/internal/types"
 	webhookDto "github.com/flexprice/flexprice/internal/webhook/dto"
 	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
 )
 
 // PaymentService defines the interface for payment operations
@@ -254,6 +256,52 @@ func (s *paymentService) UpdatePayment(ctx context.Context, id string, req dto.U
 		return nil, err // Repository already using ierr
 	}
 
+	applyPaymentUpdate(p, req)
+
+	if err := s.PaymentRepo.Update(ctx, p); err != nil {
+		return nil, err // Repository already using ierr
+	}
+
+	s.publishWebhookEvent(ctx, types.WebhookEventPaymentUpdated, p.ID)
+
+	return dto.NewPaymentResponse(p), nil
+}
+
+// UpdatePaymentIfStatus updates a payment while it is in one of the from statuses
+func (s *paymentService) UpdatePaymentIfStatus(ctx context.Context, id string, req dto.UpdatePaymentRequest, from ...types.PaymentStatus) (*dto.PaymentResponse, error) {
+	if id == "" {
+		return nil, ierr.NewError("payment_id is required").
+			WithHint("Payment ID is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	existing, err := s.PaymentRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err // Repository already using ierr
+	}
+	if !lo.Contains(from, existing.PaymentStatus) {
+		return nil, nil
+	}
+
+	// update a copy, the repository may hand out shared instances
+	p := *existing
+	applyPaymentUpdate(&p, req)
+
+	updated, err := s.PaymentRepo.UpdateIfStatus(ctx, &p, from...)
+	if err != nil {
+		return nil, err // Repository already using ierr
+	}
+	if !updated {
+		return nil, nil
+	}
+
+	s.publishWebhookEvent(ctx, types.WebhookEventPaymentUpdated, p.ID)
+
+	return dto.NewPaymentResponse(&p), nil
+}
+
+// applyPaymentUpdate sets the fields of the update request on the payment
+func applyPaymentUpdate(p *payment.Payment, req dto.UpdatePaymentRequest) {
 	if req.PaymentStatus != nil {
 		p.PaymentStatus = types.PaymentStatus(*req.PaymentStatus)
 	}
@@ -272,20 +320,15 @@ func (s *paymentService) UpdatePayment(ctx context.Context, id string, req dto.U
 	if req.FailedAt != nil {
 		p.FailedAt = req.FailedAt
 	}
+	if req.RefundedAt != nil {
+		p.RefundedAt = req.RefundedAt
+	}
 	if req.ErrorMessage != nil {
 		p.ErrorMessage = req.ErrorMessage
 	}
 	if req.Metadata != nil {
 		p.Metadata = *req.Metadata
 	}
-
-	if err := s.PaymentRepo.Update(ctx, p); err != nil {
-		return nil, err // Repository already using ierr
-	}
-
-	s.publishWebhookEvent(ctx, types.WebhookEventPaymentUpdated, p.ID)
-
-	return dto.NewPaymentResponse(p), nil
 }
 
 // ListPayments lists payments based on filter
@@ -364,6 +407,105 @@ func (s *paymentService) DeletePayment(ctx context.Context, id string) error {
 	return s.PaymentRepo.Delete(ctx, id) // Repository already using ierr
 }
 
+// UpdateRefund updates a payment refund with its gateway outcome
+func (s *paymentService) UpdateRefund(ctx context.Context, id string, req dto.UpdatePaymentRefundRequest) error {
+	if id == "" {
+		return ierr.NewError("refund_id is required").
+			WithHint("Refund ID is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	refund, err := s.PaymentRepo.GetRefund(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// gateways deliver refund events more than once
+	if refund.RefundStatus == req.RefundStatus && (req.GatewayRefundID == nil || refund.GatewayRefundID != nil) {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	refund.RefundStatus = req.RefundStatus
+	if req.GatewayRefundID != nil {
+		refund.GatewayRefundID = req.GatewayRefundID
+	}
+	switch req.RefundStatus {
+	case types.PaymentStatusSucceeded:
+		refund.SucceededAt = &now
+	case types.PaymentStatusFailed:
+		refund.FailedAt = &now
+		refund.FailureReason = req.FailureReason
+	}
+
+	// the customer keeps the amount of a failed refund as wallet credits, the credit is part of the
+	// status update so that a redelivered failure event retries it
+	err = s.DB.WithTx(ctx, func(tx context.Context) error {
+		if err := s.PaymentRepo.UpdateRefund(tx, refund); err != nil {
+			return err
+		}
+		if refund.RefundStatus == types.PaymentStatusFailed {
+			return NewCreditNoteService(s.ServiceParams).CreditFailedRefund(tx, refund)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	s.Logger.Infow("updated payment refund",
+		"refund_id", refund.ID,
+		"payment_id", refund.PaymentID,
+		"refund_status", refund.RefundStatus,
+		"gateway_refund_id", lo.FromPtr(refund.GatewayRefundID))
+
+	if err := s.updateRefundedPayment(ctx, refund.PaymentID); err != nil {
+		return err
+	}
+
+	if refund.CreditNoteID != nil {
+		return NewCreditNoteService(s.ServiceParams).SyncRefundStatus(ctx, *refund.CreditNoteID)
+	}
+	return nil
+}
+
+// updateRefundedPayment moves the payment to REFUNDED or PARTIALLY_REFUNDED from the sum of its succeeded refunds
+func (s *paymentService) updateRefundedPayment(ctx context.Context, paymentID string) error {
+	p, err := s.PaymentRepo.Get(ctx, paymentID)
+	if err != nil {
+		return err
+	}
+
+	refunds, err := s.PaymentRepo.ListRefunds(ctx, paymentID)
+	if err != nil {
+		return err
+	}
+
+	refunded := decimal.Zero
+	for _, r := range refunds {
+		if r.RefundStatus == types.PaymentStatusSucceeded {
+			refunded = refunded.Add(r.Amount)
+		}
+	}
+	if refunded.IsZero() {
+		return nil
+	}
+
+	status := types.PaymentStatusPartiallyRefunded
+	if refunded.GreaterThanOrEqual(p.Amount) {
+		status = types.PaymentStatusRefunded
+	}
+	if p.PaymentStatus == status {
+		return nil
+	}
+
+	_, err = s.UpdatePayment(ctx, p.ID, dto.UpdatePaymentRequest{
+		PaymentStatus: lo.ToPtr(string(status)),
+		RefundedAt:    lo.ToPtr(time.Now().UTC()),
+	})
+	return err
+}
+
 func (s *paymentService) publishWebhookEvent(ctx context.Context, eventName string, paymentID string) {
 	webhookPayload, err := json.Marshal(webhookDto.InternalPaymentEvent{
 		PaymentID: paymentID,
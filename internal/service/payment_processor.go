@@ -11,6 +11,7 @@ import (
 	"github.com/flexprice/flexprice/internal/domain/payment"
 	"github.com/flexprice/flexprice/internal/domain/wallet"
 	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/integration"
 	"github.com/flexprice/flexprice/internal/types"
 	webhookDto "github.com/flexprice/flexprice/internal/webhook/dto"
 	"github.com/samber/lo"
@@ -140,8 +141,9 @@ func (p *paymentProcessor) ProcessPayment(ctx context.Context, id string) (*paym
 				attempt.ErrorMessage = lo.ToPtr(processErr.Error())
 			}
 		} else {
-			// For payment links, keep attempt as pending
-			if paymentObj.PaymentMethodType == types.PaymentMethodTypePaymentLink {
+			// For payment links and charges completed asynchronously by the gateway, keep attempt as pending
+			if paymentObj.PaymentMethodType == types.PaymentMethodTypePaymentLink ||
+				paymentObj.PaymentStatus == types.PaymentStatusPending {
 				attempt.PaymentStatus = types.PaymentStatusPending
 			} else {
 				attempt.PaymentStatus = types.PaymentStatusSucceeded
@@ -184,6 +186,9 @@ func (p *paymentProcessor) ProcessPayment(ctx context.Context, id string) (*paym
 			paymentObj.SucceededAt = nil // Keep succeeded_at as nil
 			p.Logger.Infow("keeping payment link as pending", "payment_id", paymentObj.ID, "status", paymentObj.PaymentStatus)
 			p.publishWebhookEvent(ctx, types.WebhookEventPaymentPending, paymentObj.ID)
+		} else if paymentObj.PaymentStatus == types.PaymentStatusPending {
+			// The card charge is completed by the gateway webhook
+			p.Logger.Infow("keeping card payment as pending", "payment_id", paymentObj.ID, "status", paymentObj.PaymentStatus)
 		} else {
 			paymentObj.PaymentStatus = types.PaymentStatusSucceeded
 			succeededAt := time.Now().UTC()
@@ -198,6 +203,16 @@ func (p *paymentProcessor) ProcessPayment(ctx context.Context, id string) (*paym
 		return paymentObj, err
 	}
 
+	// A failed card payment of an invoice starts the dunning of the invoice when it is enabled
+	if paymentObj.PaymentStatus == types.PaymentStatusFailed &&
+		paymentObj.PaymentMethodType == types.PaymentMethodTypeCard &&
+		paymentObj.DestinationType == types.PaymentDestinationTypeInvoice {
+		dunningService := NewDunningService(p.ServiceParams)
+		if err := dunningService.StartDunning(ctx, paymentObj.DestinationID); err != nil {
+			p.Logger.Errorw("failed to start dunning", "error", err, "payment_id", paymentObj.ID, "invoice_id", paymentObj.DestinationID)
+		}
+	}
+
 	// If payment succeeded, handle post-processing
 	if paymentObj.PaymentStatus == types.PaymentStatusSucceeded {
 		if err := p.handlePostProcessing(ctx, paymentObj); err != nil {
@@ -252,8 +267,7 @@ func (p *paymentProcessor) handlePaymentLinkCreation(ctx context.Context, paymen
 	// Add FlexPrice payment ID to metadata for new payment_intent.succeeded webhook
 	linkMetadata["flexprice_payment_id"] = paymentObj.ID
 
-	// Convert to Stripe payment link request
-	paymentLinkReq := &dto.CreateStripePaymentLinkRequest{
+	paymentLinkReq := &dto.CreatePaymentLinkRequest{
 		InvoiceID:  paymentObj.DestinationID,
 		CustomerID: invoice.CustomerID,
 		Amount:     paymentObj.Amount,
@@ -268,36 +282,35 @@ func (p *paymentProcessor) handlePaymentLinkCreation(ctx context.Context, paymen
 			}
 			return false
 		}(),
-		Metadata:      linkMetadata,
-		PaymentID:     paymentObj.ID,
-		EnvironmentID: types.GetEnvironmentID(ctx),
+		Metadata:  linkMetadata,
+		PaymentID: paymentObj.ID,
 	}
 
-	// Get Stripe integration for creating payment link
-	stripeIntegration, err := p.IntegrationFactory.GetStripeIntegration(ctx)
+	// Get the payment gateway for creating payment link
+	gateway, err := p.getPaymentGateway(ctx, paymentObj)
 	if err != nil {
-		return ierr.WithError(err).
-			WithHint("Failed to get Stripe integration").
-			Mark(ierr.ErrSystem)
+		return err
 	}
 
 	customerService := NewCustomerService(p.ServiceParams)
 	invoiceService := NewInvoiceService(p.ServiceParams)
 
-	paymentLinkResp, err := stripeIntegration.PaymentSvc.CreatePaymentLink(ctx, paymentLinkReq, customerService, invoiceService)
+	paymentLinkResp, err := gateway.CreatePaymentLink(ctx, paymentLinkReq, customerService, invoiceService)
 	if err != nil {
-		// If Stripe SDK fails, keep payment status as INITIATED and return error
-		p.Logger.Errorw("failed to create payment link via Stripe SDK",
+		// If the gateway fails, keep payment status as INITIATED and return error
+		p.Logger.Errorw("failed to create payment link via payment gateway",
 			"error", err,
+			"gateway", gateway.GetGatewayType(),
 			"payment_id", paymentObj.ID,
 			"invoice_id", paymentObj.DestinationID)
 		return err
 	}
 
-	// If Stripe SDK succeeds, update payment status to PENDING
+	// If the gateway succeeds, update payment status to PENDING
 	paymentObj.PaymentStatus = types.PaymentStatusPending
 
 	// Update payment with gateway information
+	paymentObj.PaymentGateway = lo.ToPtr(string(gateway.GetGatewayType()))
 	paymentObj.GatewayTrackingID = &paymentLinkResp.ID // Store session_id in gateway_tracking_id
 	paymentObj.GatewayPaymentID = &paymentLinkResp.PaymentIntentID
 	if paymentObj.GatewayMetadata == nil {
@@ -305,7 +318,7 @@ func (p *paymentProcessor) handlePaymentLinkCreation(ctx context.Context, paymen
 	}
 	// Merge with existing gateway metadata (preserving save_card_and_make_default if set)
 	paymentObj.GatewayMetadata["payment_url"] = paymentLinkResp.PaymentURL
-	paymentObj.GatewayMetadata["gateway"] = string(types.PaymentGatewayTypeStripe)
+	paymentObj.GatewayMetadata["gateway"] = string(gateway.GetGatewayType())
 	paymentObj.GatewayMetadata["session_id"] = paymentLinkResp.ID
 
 	// Update the payment record
@@ -477,6 +490,11 @@ func (p *paymentProcessor) handleInvoicePostProcessing(ctx context.Context, paym
 		return err
 	}
 
+	// Stop the payment retries of the invoice once it is paid
+	if invoice.AmountRemaining.IsZero() {
+		NewDunningService(p.ServiceParams).StopDunning(ctx, invoice)
+	}
+
 	// Check if this is the first invoice payment for an incomplete subscription
 	if err := p.handleIncompleteSubscriptionPayment(ctx, invoice); err != nil {
 		p.Logger.Errorw("failed to handle incomplete subscription payment",
@@ -582,19 +600,17 @@ func (p *paymentProcessor) handleCardPayment(ctx context.Context, paymentObj *pa
 		"payment_id", paymentObj.ID,
 	)
 
-	// Get Stripe integration
-	stripeIntegration, err := p.IntegrationFactory.GetStripeIntegration(ctx)
+	// Get the payment gateway to charge the card through
+	gateway, err := p.getPaymentGateway(ctx, paymentObj)
 	if err != nil {
-		return ierr.WithError(err).
-			WithHint("Failed to get Stripe integration").
-			Mark(ierr.ErrSystem)
+		return err
 	}
 
 	// If no specific payment method ID is provided, we need to get one
 	if paymentObj.PaymentMethodID == "" {
 		// Get the default payment method - this is required for card payments
 		customerService := NewCustomerService(p.ServiceParams)
-		defaultPaymentMethod, err := stripeIntegration.CustomerSvc.GetDefaultPaymentMethod(ctx, customerID, customerService)
+		defaultPaymentMethod, err := gateway.GetDefaultPaymentMethod(ctx, customerID, customerService)
 		if err != nil || defaultPaymentMethod == nil {
 			p.Logger.Warnw("customer has no default payment method for card payment",
 				"customer_id", customerID,
@@ -639,7 +655,7 @@ func (p *paymentProcessor) handleCardPayment(ctx context.Context, paymentObj *pa
 	custSvc := NewCustomerService(p.ServiceParams)
 	invSvc := NewInvoiceService(p.ServiceParams)
 
-	paymentIntentResp, err := stripeIntegration.PaymentSvc.ChargeSavedPaymentMethod(ctx, chargeReq, custSvc, invSvc)
+	paymentIntentResp, err := gateway.ChargeSavedPaymentMethod(ctx, chargeReq, custSvc, invSvc)
 	if err != nil {
 		// Update payment status to failed
 		updateReq := &dto.UpdatePaymentRequest{
@@ -659,11 +675,25 @@ func (p *paymentProcessor) handleCardPayment(ctx context.Context, paymentObj *pa
 		return err
 	}
 
-	// Update payment with Stripe details
+	// The gateway completes the charge asynchronously, the payment stays pending until the gateway webhook
+	if paymentIntentResp.PaymentStatus == types.PaymentStatusPending {
+		paymentObj.PaymentStatus = types.PaymentStatusPending
+		paymentObj.GatewayPaymentID = lo.ToPtr(paymentIntentResp.ID)
+		paymentObj.PaymentGateway = lo.ToPtr(string(gateway.GetGatewayType()))
+		p.Logger.Infow("card payment is pending in the payment gateway",
+			"payment_id", paymentObj.ID,
+			"customer_id", customerID,
+			"payment_intent_id", paymentIntentResp.ID,
+			"status", paymentIntentResp.Status,
+		)
+		return nil
+	}
+
+	// Update payment with gateway details
 	updateReq := &dto.UpdatePaymentRequest{
 		PaymentStatus:    lo.ToPtr(string(types.PaymentStatusSucceeded)),
 		GatewayPaymentID: lo.ToPtr(paymentIntentResp.ID),
-		PaymentGateway:   lo.ToPtr(string(types.PaymentGatewayTypeStripe)),
+		PaymentGateway:   lo.ToPtr(string(gateway.GetGatewayType())),
 		PaymentMethodID:  lo.ToPtr(paymentObj.PaymentMethodID),
 		SucceededAt:      lo.ToPtr(time.Now().UTC()),
 	}
@@ -688,6 +718,35 @@ func (p *paymentProcessor) handleCardPayment(ctx context.Context, paymentObj *pa
 	return nil
 }
 
+// getPaymentGateway returns the gateway requested on the payment, or the payment gateway of the environment
+func (p *paymentProcessor) getPaymentGateway(ctx context.Context, paymentObj *payment.Payment) (integration.PaymentGateway, error) {
+	if p.IntegrationFactory == nil {
+		return nil, ierr.NewError("payment gateway integration is not available").
+			WithHint("Payment gateways are not configured").
+			Mark(ierr.ErrSystem)
+	}
+
+	var (
+		gateway integration.PaymentGateway
+		err     error
+	)
+	if paymentObj.PaymentGateway != nil && *paymentObj.PaymentGateway != "" {
+		gateway, err = p.IntegrationFactory.GetPaymentGatewayByType(ctx, types.PaymentGatewayType(*paymentObj.PaymentGateway))
+	} else {
+		gateway, err = p.IntegrationFactory.GetPaymentGateway(ctx)
+	}
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get payment gateway").
+			WithReportableDetails(map[string]interface{}{
+				"payment_id": paymentObj.ID,
+			}).
+			Mark(ierr.ErrSystem)
+	}
+
+	return gateway, nil
+}
+
 // handleIncompleteSubscriptionPayment checks if the paid invoice is the first invoice for a subscription
 // and activates the subscription if it's currently in incomplete status
 func (p *paymentProcessor) handleIncompleteSubscriptionPayment(ctx context.Context, invoice *invoice.Invoice) error {
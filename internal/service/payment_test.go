@@ -6,6 +6,7 @@ import (
 	"github.com/flexprice/flexprice/internal/api/dto"
 	"github.com/flexprice/flexprice/internal/domain/customer"
 	"github.com/flexprice/flexprice/internal/domain/invoice"
+	domainPayment "github.com/flexprice/flexprice/internal/domain/payment"
 	"github.com/flexprice/flexprice/internal/testutil"
 	"github.com/flexprice/flexprice/internal/types"
 	"github.com/samber/lo"
@@ -178,3 +179,60 @@ func (s *PaymentServiceSuite) TestPaymentProcessor_PaymentLinkFlow() {
 	// Verify that the payment is in a state that would be accepted by the processor
 	s.True(payment.PaymentStatus == types.PaymentStatusInitiated || payment.PaymentStatus == types.PaymentStatusPending)
 }
+
+func (s *PaymentServiceSuite) TestUpdateRefund() {
+	ctx := s.GetContext()
+
+	payment, err := s.service.CreatePayment(ctx, &dto.CreatePaymentRequest{
+		DestinationType:   types.PaymentDestinationTypeInvoice,
+		DestinationID:     s.testData.invoice.ID,
+		PaymentMethodType: types.PaymentMethodTypePaymentLink,
+		PaymentGateway:    lo.ToPtr(types.PaymentGatewayTypeStripe),
+		Amount:            decimal.NewFromInt(100),
+		Currency:          "usd",
+	})
+	s.NoError(err)
+
+	refunds := make([]*domainPayment.PaymentRefund, 0, 2)
+	for _, amount := range []int64{40, 60} {
+		refund := &domainPayment.PaymentRefund{
+			ID:           types.GenerateUUIDWithPrefix(types.UUID_PREFIX_PAYMENT_REFUND),
+			PaymentID:    payment.ID,
+			Amount:       decimal.NewFromInt(amount),
+			Currency:     "usd",
+			RefundStatus: types.PaymentStatusPending,
+			BaseModel:    types.GetDefaultBaseModel(ctx),
+		}
+		s.NoError(s.GetStores().PaymentRepo.CreateRefund(ctx, refund))
+		refunds = append(refunds, refund)
+	}
+
+	// a refund still pending at the gateway leaves the payment as it is
+	s.NoError(s.service.UpdateRefund(ctx, refunds[0].ID, dto.UpdatePaymentRefundRequest{
+		RefundStatus:    types.PaymentStatusPending,
+		GatewayRefundID: lo.ToPtr("re_1"),
+	}))
+	updated, err := s.service.GetPayment(ctx, payment.ID)
+	s.NoError(err)
+	s.Equal(payment.PaymentStatus, updated.PaymentStatus)
+
+	s.NoError(s.service.UpdateRefund(ctx, refunds[0].ID, dto.UpdatePaymentRefundRequest{
+		RefundStatus: types.PaymentStatusSucceeded,
+	}))
+	updated, err = s.service.GetPayment(ctx, payment.ID)
+	s.NoError(err)
+	s.Equal(types.PaymentStatusPartiallyRefunded, updated.PaymentStatus)
+	s.NotNil(updated.RefundedAt)
+
+	s.NoError(s.service.UpdateRefund(ctx, refunds[1].ID, dto.UpdatePaymentRefundRequest{
+		RefundStatus: types.PaymentStatusSucceeded,
+	}))
+	updated, err = s.service.GetPayment(ctx, payment.ID)
+	s.NoError(err)
+	s.Equal(types.PaymentStatusRefunded, updated.PaymentStatus)
+
+	refund, err := s.GetStores().PaymentRepo.GetRefund(ctx, refunds[0].ID)
+	s.NoError(err)
+	s.Equal("re_1", lo.FromPtr(refund.GatewayRefundID))
+	s.NotNil(refund.SucceededAt)
+}
@@ -37,6 +37,10 @@ type PriceService interface {
 	// CalculateCostSheetPrice calculates the cost for a given price and quantity
 	// specifically for costsheet calculations
 	CalculateCostSheetPrice(ctx context.Context, price *price.Price, quantity decimal.Decimal) decimal.Decimal
+
+	// CalculateMatrixCost calculates the cost of the usage for a single cell of a MATRIX price
+	// identified by its dimension values
+	CalculateMatrixCost(ctx context.Context, price *price.Price, dimensionValues map[string]string, quantity decimal.Decimal) decimal.Decimal
 }
 
 type priceService struct {
@@ -372,10 +376,40 @@ func (s *priceService) createPriceWithUnitConfig(ctx context.Context, req dto.Cr
 				flatAmount = &convertedFlatAmount
 			}
 
+			var minAmount *decimal.Decimal
+			if tier.MinAmount != nil {
+				// Parse the tier min amount (in price unit currency)
+				parsed, err := decimal.NewFromString(*tier.MinAmount)
+				if err != nil {
+					return nil, ierr.WithError(err).
+						WithHint("Tier min amount must be a valid decimal number").
+						WithReportableDetails(map[string]interface{}{"min_amount": tier.MinAmount}).
+						Mark(ierr.ErrValidation)
+				}
+
+				// Store original price unit min amount
+				priceUnitTiers[i].MinAmount = &parsed
+
+				// Convert tier min amount from price unit to base currency
+				convertedMinAmount, err := s.PriceUnitRepo.ConvertToBaseCurrency(ctx, req.PriceUnitConfig.PriceUnit, tenantID, envID, parsed)
+				if err != nil {
+					return nil, ierr.WithError(err).
+						WithHint("Failed to convert tier min amount to base currency").
+						WithReportableDetails(map[string]interface{}{
+							"tier_index": i,
+							"min_amount": tier.MinAmount,
+							"price_unit": req.PriceUnitConfig.PriceUnit,
+						}).
+						Mark(ierr.ErrInternal)
+				}
+				minAmount = &convertedMinAmount
+			}
+
 			priceTiers[i] = price.PriceTier{
 				UpTo:       tier.UpTo,
 				UnitAmount: convertedUnitAmount, // Store converted amount
 				FlatAmount: flatAmount,          // Store converted flat amount
+				MinAmount:  minAmount,           // Store converted min amount
 			}
 		}
 		tiers = price.JSONBTiers(priceTiers)
@@ -401,10 +435,22 @@ func (s *priceService) createPriceWithUnitConfig(ctx context.Context, req dto.Cr
 				}
 				flatAmount = &parsed
 			}
+			var minAmount *decimal.Decimal
+			if tier.MinAmount != nil {
+				parsed, err := decimal.NewFromString(*tier.MinAmount)
+				if err != nil {
+					return nil, ierr.WithError(err).
+						WithHint("Min amount must be a valid decimal number").
+						WithReportableDetails(map[string]interface{}{"min_amount": tier.MinAmount}).
+						Mark(ierr.ErrValidation)
+				}
+				minAmount = &parsed
+			}
 			priceTiers[i] = price.PriceTier{
 				UpTo:       tier.UpTo,
 				UnitAmount: unitAmount,
 				FlatAmount: flatAmount,
+				MinAmount:  minAmount,
 			}
 		}
 		tiers = price.JSONBTiers(priceTiers)
@@ -432,6 +478,8 @@ func (s *priceService) createPriceWithUnitConfig(ctx context.Context, req dto.Cr
 		Tiers:              tiers,
 		PriceUnitTiers:     priceUnitTiers,
 		TransformQuantity:  transformQuantity,
+		MinCharge:          req.MinCharge,
+		MaxCharge:          req.MaxCharge,
 		ParentPriceID:      req.ParentPriceID,
 		EnvironmentID:      envID,
 		BaseModel:          types.GetDefaultBaseModel(ctx),
@@ -1001,6 +1049,17 @@ func (s *priceService) calculateSingletonCost(ctx context.Context, price *price.
 
 	case types.BILLING_MODEL_TIERED:
 		cost = s.calculateTieredCost(ctx, price, quantity)
+
+	case types.BILLING_MODEL_MATRIX:
+		// Usage broken down per cell is charged with CalculateMatrixCost. Without the dimension values
+		// the usage can only be charged when all cells share the same unit amount.
+		unitAmount, ok := price.Matrix.UniformUnitAmount()
+		if !ok {
+			s.Logger.Warnw("matrix price usage is not broken down per cell, skipping cost",
+				"price_id", price.ID)
+			return decimal.Zero
+		}
+		cost = unitAmount.Mul(quantity).Round(types.GetCurrencyPrecision(price.Currency))
 	}
 
 	return cost
@@ -1110,6 +1169,24 @@ func (s *priceService) calculateTieredCost(ctx context.Context, price *price.Pri
 	return cost
 }
 
+// CalculateMatrixCost calculates the cost for a single cell of a matrix price
+// Usage which doesn't match any cell of the rate table is not charged
+func (s *priceService) CalculateMatrixCost(ctx context.Context, price *price.Price, dimensionValues map[string]string, quantity decimal.Decimal) decimal.Decimal {
+	if price.BillingModel != types.BILLING_MODEL_MATRIX || quantity.IsZero() {
+		return decimal.Zero
+	}
+
+	cell, ok := price.Matrix.FindCell(dimensionValues)
+	if !ok {
+		s.Logger.Debugw("no matrix cell found for dimension values",
+			"price_id", price.ID,
+			"dimension_values", dimensionValues)
+		return decimal.Zero
+	}
+
+	return cell.UnitAmount.Mul(quantity).Round(types.GetCurrencyPrecision(price.Currency))
+}
+
 // CalculateCostWithBreakup calculates the cost with detailed breakdown information
 func (s *priceService) CalculateCostWithBreakup(ctx context.Context, price *price.Price, quantity decimal.Decimal, round bool) dto.CostBreakup {
 	result := dto.CostBreakup{
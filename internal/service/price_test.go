@@ -377,6 +377,39 @@ func (s *PriceServiceSuite) TestCalculateCostWithBreakup_TieredSlab() {
 	s.Equal(2, result.SelectedTierIndex)                               // Index 2 (third tier)
 }
 
+func (s *PriceServiceSuite) TestCalculateCostWithBreakup_TieredSlabWithMinAmount() {
+	upTo10 := uint64(10)
+	price := &price.Price{
+		ID:           "price-tier-min",
+		Amount:       decimal.Zero,
+		Currency:     "usd",
+		BillingModel: types.BILLING_MODEL_TIERED,
+		TierMode:     types.BILLING_TIER_SLAB,
+		Tiers: []price.PriceTier{
+			{
+				UpTo:       &upTo10,
+				UnitAmount: decimal.NewFromInt(1),
+			},
+			{
+				UnitAmount: decimal.NewFromInt(2),
+				MinAmount:  lo.ToPtr(decimal.NewFromInt(25)),
+			},
+		},
+	}
+
+	// First tier only, the minimum of the unused second tier doesn't apply
+	result := s.priceService.CalculateCostWithBreakup(s.ctx, price, decimal.NewFromInt(5), false)
+	s.True(decimal.NewFromInt(5).Equal(result.FinalCost))
+
+	// (10 * 1) + max(2 * 2, 25) = 35
+	result = s.priceService.CalculateCostWithBreakup(s.ctx, price, decimal.NewFromInt(12), false)
+	s.True(decimal.NewFromInt(35).Equal(result.FinalCost))
+
+	// (10 * 1) + max(20 * 2, 25) = 50
+	result = s.priceService.CalculateCostWithBreakup(s.ctx, price, decimal.NewFromInt(30), false)
+	s.True(decimal.NewFromInt(50).Equal(result.FinalCost))
+}
+
 func (s *PriceServiceSuite) TestCalculateCostWithBreakup_ZeroQuantity() {
 	price := &price.Price{
 		ID:           "price-5",
@@ -817,6 +850,90 @@ func (s *PriceServiceSuite) TestCalculateBucketedCost_ComplexScenario() {
 		expected.String(), result.String(), bucketedValues)
 }
 
+func (s *PriceServiceSuite) TestCalculateMatrixCost() {
+	price := &price.Price{
+		ID:           "price-matrix",
+		Currency:     "usd",
+		Type:         types.PRICE_TYPE_USAGE,
+		BillingModel: types.BILLING_MODEL_MATRIX,
+		Matrix: &types.PriceMatrix{
+			Dimensions: []string{"model", "region"},
+			Cells: []types.PriceMatrixCell{
+				{
+					DimensionValues: map[string]string{"model": "gpt-4o", "region": "us"},
+					UnitAmount:      decimal.NewFromFloat(0.005),
+				},
+				{
+					DimensionValues: map[string]string{"model": "o1-mini", "region": "us"},
+					UnitAmount:      decimal.NewFromFloat(0.001),
+				},
+			},
+		},
+	}
+	s.NoError(price.Matrix.Validate())
+
+	tests := []struct {
+		name            string
+		dimensionValues map[string]string
+		quantity        decimal.Decimal
+		expected        decimal.Decimal
+	}{
+		{
+			name:            "gpt-4o in us",
+			dimensionValues: map[string]string{"model": "gpt-4o", "region": "us"},
+			quantity:        decimal.NewFromInt(1000),
+			expected:        decimal.NewFromInt(5),
+		},
+		{
+			name:            "o1-mini in us",
+			dimensionValues: map[string]string{"model": "o1-mini", "region": "us"},
+			quantity:        decimal.NewFromInt(1000),
+			expected:        decimal.NewFromInt(1),
+		},
+		{
+			name:            "no matching cell",
+			dimensionValues: map[string]string{"model": "gpt-4o", "region": "eu"},
+			quantity:        decimal.NewFromInt(1000),
+			expected:        decimal.Zero,
+		},
+		{
+			name:            "partial dimension values",
+			dimensionValues: map[string]string{"model": "gpt-4o"},
+			quantity:        decimal.NewFromInt(1000),
+			expected:        decimal.Zero,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			result := s.priceService.CalculateMatrixCost(s.ctx, price, tt.dimensionValues, tt.quantity)
+			s.True(tt.expected.Equal(result), "Expected cost %s but got %s", tt.expected.String(), result.String())
+		})
+	}
+
+	// The price level cost is zero as there is no single unit amount
+	s.True(s.priceService.CalculateCost(s.ctx, price, decimal.NewFromInt(1000)).IsZero())
+
+	// Cells sharing the same unit amount are charged without the dimension values, including bucketed usage
+	uniform := *price
+	uniform.Matrix = &types.PriceMatrix{
+		Dimensions: price.Matrix.Dimensions,
+		Cells: []types.PriceMatrixCell{
+			{DimensionValues: map[string]string{"model": "gpt-4o", "region": "us"}, UnitAmount: decimal.NewFromFloat(0.002)},
+			{DimensionValues: map[string]string{"model": "gpt-4o", "region": "eu"}, UnitAmount: decimal.NewFromFloat(0.002)},
+		},
+	}
+	s.True(decimal.NewFromInt(2).Equal(s.priceService.CalculateCost(s.ctx, &uniform, decimal.NewFromInt(1000))))
+	s.True(decimal.NewFromInt(3).Equal(s.priceService.CalculateBucketedCost(s.ctx, &uniform, []decimal.Decimal{decimal.NewFromInt(1000), decimal.NewFromInt(500)})))
+
+	// Duplicate cells are rejected
+	price.Matrix.Cells = append(price.Matrix.Cells, types.PriceMatrixCell{
+		DimensionValues: map[string]string{"model": "gpt-4o", "region": "us"},
+		UnitAmount:      decimal.NewFromInt(1),
+	})
+	s.Error(price.Matrix.Validate())
+}
+
 func (s *PriceServiceSuite) TestCalculateCostWithBreakup_TieredSlabCorrected() {
 	// Test the corrected slab tier calculation logic
 	// Pricing: 0-5 = $0/unit, 5-10 = $2/unit, 10+ = $3/unit
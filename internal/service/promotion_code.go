@@ -0,0 +1,348 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/csv"
+	"io"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/promotion_code"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// promotionCodeAlphabet excludes characters that are easily confused when codes are typed, such as 0/O and 1/I
+const promotionCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+// promotionCodeGenerateMaxAttempts bounds the rounds spent replacing generated codes that are already in use
+const promotionCodeGenerateMaxAttempts = 5
+
+// promotionCodeExportBatchSize is the number of promotion codes read per page during a CSV export
+const promotionCodeExportBatchSize = 1000
+
+// PromotionCodeService defines the interface for promotion code operations
+type PromotionCodeService interface {
+	CreatePromotionCode(ctx context.Context, req dto.CreatePromotionCodeRequest) (*dto.PromotionCodeResponse, error)
+	BulkGeneratePromotionCodes(ctx context.Context, req dto.BulkGeneratePromotionCodesRequest) (*dto.BulkGeneratePromotionCodesResponse, error)
+	GetPromotionCode(ctx context.Context, id string) (*dto.PromotionCodeResponse, error)
+	ListPromotionCodes(ctx context.Context, filter *types.PromotionCodeFilter) (*dto.ListPromotionCodesResponse, error)
+	DeletePromotionCode(ctx context.Context, id string) error
+	// ExportPromotionCodesCSV writes all promotion codes matching the filter to w as CSV
+	ExportPromotionCodesCSV(ctx context.Context, filter *types.PromotionCodeFilter, w io.Writer) error
+}
+
+type promotionCodeService struct {
+	ServiceParams
+}
+
+// NewPromotionCodeService creates a new promotion code service
+func NewPromotionCodeService(params ServiceParams) PromotionCodeService {
+	return &promotionCodeService{
+		ServiceParams: params,
+	}
+}
+
+// CreatePromotionCode creates a single promotion code for a coupon
+func (s *promotionCodeService) CreatePromotionCode(ctx context.Context, req dto.CreatePromotionCodeRequest) (*dto.PromotionCodeResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateCoupon(ctx, req.CouponID); err != nil {
+		return nil, err
+	}
+
+	if req.CustomerID != nil {
+		if _, err := s.CustomerRepo.Get(ctx, *req.CustomerID); err != nil {
+			return nil, err
+		}
+	}
+
+	pc := req.ToPromotionCode(ctx)
+	if err := s.PromotionCodeRepo.Create(ctx, pc); err != nil {
+		return nil, err
+	}
+
+	return s.toPromotionCodeResponse(pc), nil
+}
+
+// BulkGeneratePromotionCodes generates random promotion codes for a coupon
+func (s *promotionCodeService) BulkGeneratePromotionCodes(ctx context.Context, req dto.BulkGeneratePromotionCodesRequest) (*dto.BulkGeneratePromotionCodesResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateCoupon(ctx, req.CouponID); err != nil {
+		return nil, err
+	}
+
+	codes, err := s.generateUniqueCodes(ctx, req.Prefix, req.Length, req.Count)
+	if err != nil {
+		return nil, err
+	}
+
+	promotionCodes := make([]*promotion_code.PromotionCode, len(codes))
+	for i, code := range codes {
+		promotionCodes[i] = &promotion_code.PromotionCode{
+			ID:             types.GenerateUUIDWithPrefix(types.UUID_PREFIX_PROMOTION_CODE),
+			CouponID:       req.CouponID,
+			Code:           code,
+			ExpiresAt:      req.ExpiresAt,
+			MaxRedemptions: req.MaxRedemptions,
+			Metadata:       req.Metadata,
+			EnvironmentID:  types.GetEnvironmentID(ctx),
+			BaseModel:      types.GetDefaultBaseModel(ctx),
+		}
+	}
+
+	err = s.DB.WithTx(ctx, func(txCtx context.Context) error {
+		return s.PromotionCodeRepo.CreateBulk(txCtx, promotionCodes)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Infow("generated promotion codes",
+		"coupon_id", req.CouponID,
+		"count", len(promotionCodes))
+
+	return &dto.BulkGeneratePromotionCodesResponse{
+		CouponID: req.CouponID,
+		Count:    len(promotionCodes),
+		Items: lo.Map(promotionCodes, func(pc *promotion_code.PromotionCode, _ int) *dto.PromotionCodeResponse {
+			return s.toPromotionCodeResponse(pc)
+		}),
+	}, nil
+}
+
+// GetPromotionCode retrieves a promotion code by ID
+func (s *promotionCodeService) GetPromotionCode(ctx context.Context, id string) (*dto.PromotionCodeResponse, error) {
+	pc, err := s.PromotionCodeRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toPromotionCodeResponse(pc), nil
+}
+
+// ListPromotionCodes lists promotion codes with filtering
+func (s *promotionCodeService) ListPromotionCodes(ctx context.Context, filter *types.PromotionCodeFilter) (*dto.ListPromotionCodesResponse, error) {
+	if filter == nil {
+		filter = types.NewPromotionCodeFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	promotionCodes, err := s.PromotionCodeRepo.List(ctx, filter)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list promotion codes").
+			Mark(ierr.ErrInternal)
+	}
+
+	total, err := s.PromotionCodeRepo.Count(ctx, filter)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to count promotion codes").
+			Mark(ierr.ErrInternal)
+	}
+
+	responses := make([]*dto.PromotionCodeResponse, len(promotionCodes))
+	for i, pc := range promotionCodes {
+		responses[i] = s.toPromotionCodeResponse(pc)
+	}
+
+	listResponse := types.NewListResponse(responses, total, filter.GetLimit(), filter.GetOffset())
+	return &listResponse, nil
+}
+
+// DeletePromotionCode archives a promotion code, after which it can no longer be redeemed
+func (s *promotionCodeService) DeletePromotionCode(ctx context.Context, id string) error {
+	return s.PromotionCodeRepo.Delete(ctx, id)
+}
+
+// ExportPromotionCodesCSV writes all promotion codes matching the filter to w as CSV.
+// The pagination of the filter is ignored, codes are read in pages until all of them are written.
+func (s *promotionCodeService) ExportPromotionCodesCSV(ctx context.Context, filter *types.PromotionCodeFilter, w io.Writer) error {
+	if filter == nil {
+		filter = types.NewPromotionCodeFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return err
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{
+		"id", "code", "coupon_id", "customer_id", "expires_at", "max_redemptions", "total_redemptions", "status", "created_at",
+	}); err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to write promotion codes export").
+			Mark(ierr.ErrInternal)
+	}
+
+	pageFilter := *filter
+	pageFilter.QueryFilter = types.NewDefaultQueryFilter()
+	if filter.QueryFilter != nil {
+		queryFilter := *filter.QueryFilter
+		pageFilter.QueryFilter = &queryFilter
+	}
+	pageFilter.QueryFilter.Limit = lo.ToPtr(promotionCodeExportBatchSize)
+	pageFilter.QueryFilter.Offset = lo.ToPtr(0)
+
+	for {
+		promotionCodes, err := s.PromotionCodeRepo.List(ctx, &pageFilter)
+		if err != nil {
+			return ierr.WithError(err).
+				WithHint("Failed to list promotion codes").
+				Mark(ierr.ErrInternal)
+		}
+
+		for _, pc := range promotionCodes {
+			if err := writer.Write(promotionCodeCSVRecord(pc)); err != nil {
+				return ierr.WithError(err).
+					WithHint("Failed to write promotion codes export").
+					Mark(ierr.ErrInternal)
+			}
+		}
+
+		if len(promotionCodes) < promotionCodeExportBatchSize {
+			break
+		}
+		*pageFilter.QueryFilter.Offset += promotionCodeExportBatchSize
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to write promotion codes export").
+			Mark(ierr.ErrInternal)
+	}
+
+	return nil
+}
+
+// generateUniqueCodes generates count random codes that are neither repeated nor already in use
+func (s *promotionCodeService) generateUniqueCodes(ctx context.Context, prefix string, length int, count int) ([]string, error) {
+	seen := make(map[string]struct{}, count)
+	codes := make([]string, 0, count)
+
+	for attempt := 0; len(codes) < count; attempt++ {
+		if attempt >= promotionCodeGenerateMaxAttempts {
+			return nil, ierr.NewError("failed to generate unique promotion codes").
+				WithHint("Too many generated codes are already in use, use a longer length or another prefix").
+				WithReportableDetails(map[string]interface{}{
+					"prefix": prefix,
+					"length": length,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+
+		candidates := make([]string, 0, count-len(codes))
+		for len(candidates) < count-len(codes) {
+			code, err := generatePromotionCode(prefix, length)
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := seen[code]; ok {
+				continue
+			}
+			seen[code] = struct{}{}
+			candidates = append(candidates, code)
+		}
+
+		filter := types.NewNoLimitPromotionCodeFilter()
+		filter.Codes = candidates
+		existing, err := s.PromotionCodeRepo.List(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+		taken := lo.SliceToMap(existing, func(pc *promotion_code.PromotionCode) (string, struct{}) {
+			return pc.Code, struct{}{}
+		})
+
+		for _, code := range candidates {
+			if _, ok := taken[code]; !ok {
+				codes = append(codes, code)
+			}
+		}
+	}
+
+	return codes, nil
+}
+
+// validateCoupon checks that promotion codes can be created for the coupon
+func (s *promotionCodeService) validateCoupon(ctx context.Context, couponID string) error {
+	c, err := s.CouponRepo.Get(ctx, couponID)
+	if err != nil {
+		return err
+	}
+
+	if c.Status != types.StatusPublished {
+		return ierr.NewError("coupon is not published").
+			WithHint("Promotion codes can only be created for published coupons").
+			WithReportableDetails(map[string]interface{}{
+				"coupon_id": couponID,
+				"status":    c.Status,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+func (s *promotionCodeService) toPromotionCodeResponse(pc *promotion_code.PromotionCode) *dto.PromotionCodeResponse {
+	return &dto.PromotionCodeResponse{
+		PromotionCode: pc,
+	}
+}
+
+// generatePromotionCode returns the prefix followed by length random characters
+func generatePromotionCode(prefix string, length int) (string, error) {
+	var b strings.Builder
+	b.Grow(len(prefix) + length)
+	b.WriteString(prefix)
+
+	max := big.NewInt(int64(len(promotionCodeAlphabet)))
+	for i := 0; i < length; i++ {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", ierr.WithError(err).
+				WithHint("Failed to generate promotion code").
+				Mark(ierr.ErrInternal)
+		}
+		b.WriteByte(promotionCodeAlphabet[n.Int64()])
+	}
+
+	return b.String(), nil
+}
+
+func promotionCodeCSVRecord(pc *promotion_code.PromotionCode) []string {
+	var expiresAt, maxRedemptions string
+	if pc.ExpiresAt != nil {
+		expiresAt = pc.ExpiresAt.UTC().Format(time.RFC3339)
+	}
+	if pc.MaxRedemptions != nil {
+		maxRedemptions = strconv.Itoa(*pc.MaxRedemptions)
+	}
+
+	return []string{
+		pc.ID,
+		pc.Code,
+		pc.CouponID,
+		lo.FromPtr(pc.CustomerID),
+		expiresAt,
+		maxRedemptions,
+		strconv.Itoa(pc.TotalRedemptions),
+		string(pc.Status),
+		pc.CreatedAt.UTC().Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,193 @@
+package service
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strings"
+	"testing"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/coupon"
+	"github.com/flexprice/flexprice/internal/domain/customer"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/testutil"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type PromotionCodeServiceSuite struct {
+	testutil.BaseServiceTestSuite
+	service            PromotionCodeService
+	associationService CouponAssociationService
+	coupon             *coupon.Coupon
+	customer           *customer.Customer
+	sub                *subscription.Subscription
+}
+
+func TestPromotionCodeService(t *testing.T) {
+	suite.Run(t, new(PromotionCodeServiceSuite))
+}
+
+func (s *PromotionCodeServiceSuite) SetupTest() {
+	s.BaseServiceTestSuite.SetupTest()
+	params := ServiceParams{
+		Logger:                s.GetLogger(),
+		Config:                s.GetConfig(),
+		DB:                    s.GetDB(),
+		CustomerRepo:          s.GetStores().CustomerRepo,
+		SubRepo:               s.GetStores().SubscriptionRepo,
+		InvoiceRepo:           s.GetStores().InvoiceRepo,
+		FeatureRepo:           s.GetStores().FeatureRepo,
+		CouponRepo:            s.GetStores().CouponRepo,
+		CouponAssociationRepo: s.GetStores().CouponAssociationRepo,
+		CouponApplicationRepo: s.GetStores().CouponApplicationRepo,
+		PromotionCodeRepo:     s.GetStores().PromotionCodeRepo,
+	}
+	s.service = NewPromotionCodeService(params)
+	s.associationService = NewCouponAssociationService(params)
+
+	ctx := s.GetContext()
+	s.coupon = &coupon.Coupon{
+		ID:            "coupon_promo",
+		Name:          "Summer",
+		Type:          types.CouponTypePercentage,
+		Cadence:       types.CouponCadenceForever,
+		PercentageOff: lo.ToPtr(decimal.NewFromInt(10)),
+		Currency:      "usd",
+		BaseModel:     types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().CouponRepo.Create(ctx, s.coupon))
+
+	s.customer = &customer.Customer{
+		ID:         "cust_promo",
+		ExternalID: "ext_cust_promo",
+		Name:       "Promo Customer",
+		BaseModel:  types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().CustomerRepo.Create(ctx, s.customer))
+
+	s.sub = &subscription.Subscription{
+		ID:                 "sub_promo",
+		CustomerID:         s.customer.ID,
+		PlanID:             "plan_promo",
+		Currency:           "usd",
+		SubscriptionStatus: types.SubscriptionStatusActive,
+		BaseModel:          types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().SubscriptionRepo.Create(ctx, s.sub))
+}
+
+func (s *PromotionCodeServiceSuite) TestBulkGenerateAndExport() {
+	ctx := s.GetContext()
+
+	resp, err := s.service.BulkGeneratePromotionCodes(ctx, dto.BulkGeneratePromotionCodesRequest{
+		CouponID: s.coupon.ID,
+		Count:    25,
+		Prefix:   "summer-",
+	})
+	s.Require().NoError(err)
+	s.Equal(25, resp.Count)
+
+	codes := make(map[string]struct{})
+	for _, item := range resp.Items {
+		s.True(strings.HasPrefix(item.Code, "SUMMER-"))
+		s.Len(item.Code, len("SUMMER-")+types.PromotionCodeDefaultGeneratedLength)
+		s.Equal(1, lo.FromPtr(item.MaxRedemptions))
+		codes[item.Code] = struct{}{}
+	}
+	s.Len(codes, 25)
+
+	filter := types.NewPromotionCodeFilter()
+	filter.CouponID = s.coupon.ID
+	var buf bytes.Buffer
+	s.Require().NoError(s.service.ExportPromotionCodesCSV(ctx, filter, &buf))
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	s.Require().NoError(err)
+	s.Len(records, 26)
+	s.Equal("code", records[0][1])
+	for _, record := range records[1:] {
+		s.Contains(codes, record[1])
+	}
+}
+
+func (s *PromotionCodeServiceSuite) TestApplyPromotionCodeToSubscription() {
+	ctx := s.GetContext()
+
+	pc, err := s.service.CreatePromotionCode(ctx, dto.CreatePromotionCodeRequest{
+		CouponID:       s.coupon.ID,
+		Code:           "welcome10",
+		MaxRedemptions: lo.ToPtr(1),
+	})
+	s.Require().NoError(err)
+	s.Equal("WELCOME10", pc.Code)
+
+	// Codes are matched case insensitively
+	s.Require().NoError(s.associationService.ApplyCouponToSubscription(ctx, []string{"Welcome10"}, s.sub.ID))
+
+	associations, err := s.GetStores().CouponAssociationRepo.GetBySubscription(ctx, s.sub.ID)
+	s.Require().NoError(err)
+	s.Require().Len(associations, 1)
+	s.Equal(s.coupon.ID, associations[0].CouponID)
+	s.Equal(pc.ID, associations[0].Metadata["promotion_code_id"])
+
+	updated, err := s.GetStores().PromotionCodeRepo.Get(ctx, pc.ID)
+	s.Require().NoError(err)
+	s.Equal(1, updated.TotalRedemptions)
+
+	// The single redemption has been used up
+	err = s.associationService.ApplyCouponToSubscription(ctx, []string{"WELCOME10"}, s.sub.ID)
+	s.Require().Error(err)
+	s.True(ierr.IsValidation(err))
+
+	err = s.associationService.ApplyCouponToSubscription(ctx, []string{"UNKNOWN"}, s.sub.ID)
+	s.Require().Error(err)
+	s.True(ierr.IsNotFound(err))
+}
+
+func (s *PromotionCodeServiceSuite) TestCustomerRestrictedPromotionCode() {
+	ctx := s.GetContext()
+
+	other := &customer.Customer{
+		ID:         "cust_promo_other",
+		ExternalID: "ext_cust_promo_other",
+		Name:       "Other Customer",
+		BaseModel:  types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().CustomerRepo.Create(ctx, other))
+
+	_, err := s.service.CreatePromotionCode(ctx, dto.CreatePromotionCodeRequest{
+		CouponID:   s.coupon.ID,
+		Code:       "VIP",
+		CustomerID: lo.ToPtr(other.ID),
+	})
+	s.Require().NoError(err)
+
+	err = s.associationService.ApplyCouponToSubscription(ctx, []string{"VIP"}, s.sub.ID)
+	s.Require().Error(err)
+	s.True(ierr.IsValidation(err))
+}
+
+func (s *PromotionCodeServiceSuite) TestIncrementRedemptionsLimit() {
+	ctx := s.GetContext()
+
+	pc, err := s.service.CreatePromotionCode(ctx, dto.CreatePromotionCodeRequest{
+		CouponID:       s.coupon.ID,
+		Code:           "ONCE",
+		MaxRedemptions: lo.ToPtr(1),
+	})
+	s.Require().NoError(err)
+
+	// a redemption that passed validation concurrently with another one must not exceed the limit
+	s.Require().NoError(s.GetStores().PromotionCodeRepo.IncrementRedemptions(ctx, pc.ID))
+	err = s.GetStores().PromotionCodeRepo.IncrementRedemptions(ctx, pc.ID)
+	s.Require().Error(err)
+	s.True(ierr.IsValidation(err))
+
+	updated, err := s.GetStores().PromotionCodeRepo.Get(ctx, pc.ID)
+	s.Require().NoError(err)
+	s.Equal(1, updated.TotalRedemptions)
+}
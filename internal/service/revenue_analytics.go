@@ -78,7 +78,7 @@ func (s *revenueAnalyticsService) getCostAnalytics(
 	}
 
 	// 5. Use existing BulkGetUsageByMeter (same as subscription billing)
-	eventService := NewEventService(s.EventRepo, s.MeterRepo, s.EventPublisher, s.Logger, s.Config)
+	eventService := NewEventService(s.EventRepo, s.MeterRepo, s.EventSchemaRepo, s.RejectedEventRepo, s.EventPublisher, s.Logger, s.Config)
 	usageMap, err := eventService.BulkGetUsageByMeter(ctx, meterUsageRequests)
 	if err != nil {
 		return nil, ierr.WithError(err).
@@ -280,7 +280,7 @@ func (s *revenueAnalyticsService) calculateCostsFromUsage(
 			if !exists {
 				continue
 			}
-			if meter.IsBucketedMaxMeter() {
+			if meter.IsBucketedMeter() {
 				// For bucketed max, use array of values
 				bucketedValues := make([]decimal.Decimal, len(usage.Results))
 				for i, result := range usage.Results {
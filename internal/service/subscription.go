@@ -10,7 +10,9 @@ import (
 	"github.com/flexprice/flexprice/internal/api/dto"
 	"github.com/flexprice/flexprice/internal/domain/addonassociation"
 	"github.com/flexprice/flexprice/internal/domain/customer"
+	"github.com/flexprice/flexprice/internal/domain/events"
 	"github.com/flexprice/flexprice/internal/domain/invoice"
+	"github.com/flexprice/flexprice/internal/domain/meter"
 	"github.com/flexprice/flexprice/internal/interfaces"
 
 	"github.com/flexprice/flexprice/internal/domain/price"
@@ -77,6 +79,20 @@ func (s *subscriptionService) CreateSubscription(ctx context.Context, req dto.Cr
 			Mark(ierr.ErrValidation)
 	}
 
+	if customer.IsChild() {
+		return nil, ierr.NewError("customer is billed through a parent customer").
+			WithHint("Usage of child customers is billed through the subscription of the parent customer").
+			WithReportableDetails(map[string]interface{}{
+				"customer_id":        req.CustomerID,
+				"parent_customer_id": customer.ParentCustomerID,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if err := s.validateUsageAlertFeatures(ctx, req.UsageAlerts); err != nil {
+		return nil, err
+	}
+
 	plan, err := s.PlanRepo.Get(ctx, req.PlanID)
 	if err != nil {
 		return nil, err
@@ -150,6 +166,16 @@ func (s *subscriptionService) CreateSubscription(ctx context.Context, req dto.Cr
 	sub.CurrentPeriodStart = sub.StartDate
 	sub.CurrentPeriodEnd = nextBillingDate
 
+	// The first commitment term of prepaid and postpaid commitments starts with the subscription
+	if sub.GetCommitmentType().IsTermCommitment() {
+		commitmentEndDate, err := sub.CalculateCommitmentEndDate(sub.StartDate)
+		if err != nil {
+			return nil, err
+		}
+		sub.CommitmentStartDate = lo.ToPtr(sub.StartDate)
+		sub.CommitmentEndDate = lo.ToPtr(commitmentEndDate)
+	}
+
 	// Convert line items
 	lineItems := make([]*subscription.SubscriptionLineItem, 0, len(validPrices))
 	for _, price := range validPrices {
@@ -336,6 +362,13 @@ func (s *subscriptionService) CreateSubscription(ctx context.Context, req dto.Cr
 			sub = updatedSub
 		}
 
+		// Invoice the prepaid commitment upfront for the first commitment term
+		if sub.HasTermCommitment() && sub.CommitmentType == types.CommitmentTypePrepaid {
+			if _, err := invoiceService.CreateSubscriptionCommitmentInvoice(ctx, sub, paymentParams, types.InvoiceFlowSubscriptionCreation); err != nil {
+				return err
+			}
+		}
+
 		// if the subscription is created with incomplete status, but it doesn't create an invoice, we need to mark it as active
 		// This applies regardless of collection method - if there's no invoice to pay, the subscription should be active
 		if (req.Workflow != nil && *req.Workflow != types.TemporalStripeIntegrationWorkflow) && sub.SubscriptionStatus == types.SubscriptionStatusIncomplete && (invoice == nil || invoice.PaymentStatus == types.PaymentStatusSucceeded) {
@@ -581,6 +614,10 @@ func (s *subscriptionService) ProcessSubscriptionPriceOverrides(
 					flatAmountStr := tier.FlatAmount.String()
 					createPriceReq.Tiers[i].FlatAmount = &flatAmountStr
 				}
+				if tier.MinAmount != nil {
+					minAmountStr := tier.MinAmount.String()
+					createPriceReq.Tiers[i].MinAmount = &minAmountStr
+				}
 			}
 		}
 
@@ -590,6 +627,15 @@ func (s *subscriptionService) ProcessSubscriptionPriceOverrides(
 			createPriceReq.TransformQuantity = &transformQuantity
 		}
 
+		// Carry over the rate table for matrix prices
+		if originalPrice.Matrix != nil {
+			createPriceReq.Matrix = originalPrice.Matrix
+		}
+
+		// Carry over the charge limits
+		createPriceReq.MinCharge = originalPrice.MinCharge
+		createPriceReq.MaxCharge = originalPrice.MaxCharge
+
 		// Amount override
 		if override.Amount != nil {
 			createPriceReq.Amount = override.Amount.String()
@@ -805,6 +851,57 @@ func (s *subscriptionService) UpdateSubscription(ctx context.Context, subscripti
 	return s.GetSubscription(ctx, subscriptionID)
 }
 
+// UpdateSubscriptionUsageAlerts replaces the spend and usage alerts of a subscription
+func (s *subscriptionService) UpdateSubscriptionUsageAlerts(ctx context.Context, subscriptionID string, req *dto.UpdateSubscriptionUsageAlertsRequest) (*dto.SubscriptionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	sub, err := s.SubRepo.Get(ctx, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if sub.SubscriptionStatus == types.SubscriptionStatusCancelled {
+		return nil, ierr.NewError("subscription is cancelled").
+			WithHint("Usage alerts can not be configured for cancelled subscriptions").
+			WithReportableDetails(map[string]interface{}{
+				"subscription_id": subscriptionID,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if err := s.validateUsageAlertFeatures(ctx, req.UsageAlerts); err != nil {
+		return nil, err
+	}
+
+	sub.UsageAlerts = req.UsageAlerts
+	if err := s.SubRepo.Update(ctx, sub); err != nil {
+		return nil, err
+	}
+
+	s.publishInternalWebhookEvent(ctx, types.WebhookEventSubscriptionUpdated, sub.ID)
+
+	return s.GetSubscription(ctx, subscriptionID)
+}
+
+// validateUsageAlertFeatures checks that the features tracked by usage alerts exist
+func (s *subscriptionService) validateUsageAlertFeatures(ctx context.Context, alerts []types.UsageAlert) error {
+	for _, featureID := range lo.Uniq(lo.FilterMap(alerts, func(alert types.UsageAlert, _ int) (string, bool) {
+		return alert.FeatureID, alert.FeatureID != ""
+	})) {
+		if _, err := s.FeatureRepo.Get(ctx, featureID); err != nil {
+			return ierr.WithError(err).
+				WithHint("Usage alert feature not found").
+				WithReportableDetails(map[string]interface{}{
+					"feature_id": featureID,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+	return nil
+}
+
 // CancelSubscription provides enhanced cancellation with proration support
 func (s *subscriptionService) CancelSubscription(
 	ctx context.Context,
@@ -892,6 +989,10 @@ func (s *subscriptionService) CancelSubscription(
 					"invoice_id", inv.ID)
 			}
 
+			// Bill the unused commitment of the term cancelled before it ends
+			if err := s.processCommitmentCancellation(ctx, subscription, paymentParams); err != nil {
+				return err
+			}
 		}
 		// Step 8: Update subscription status
 		err = s.updateSubscriptionForCancellation(ctx, subscription, req.CancellationType, effectiveDate, req.Reason)
@@ -1204,10 +1305,78 @@ func (s *subscriptionService) addSchedulesToSubscriptionResponses(ctx context.Co
 		"schedule_errors", schedulesErrors)
 }
 
+// GetSubscriptionCommitment returns the utilized and remaining commitment of a subscription for the current
+// commitment term, including the usage of the current billing period which is not invoiced yet
+func (s *subscriptionService) GetSubscriptionCommitment(ctx context.Context, subscriptionID string) (*dto.SubscriptionCommitmentResponse, error) {
+	sub, _, err := s.SubRepo.GetWithLineItems(ctx, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	commitmentAmount := lo.FromPtr(sub.CommitmentAmount)
+	if !commitmentAmount.IsPositive() {
+		return nil, ierr.NewError("subscription has no commitment").
+			WithHint("The subscription must have a commitment amount to get its commitment").
+			WithReportableDetails(map[string]interface{}{
+				"subscription_id": subscriptionID,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	response := &dto.SubscriptionCommitmentResponse{
+		SubscriptionID:      sub.ID,
+		CommitmentType:      sub.GetCommitmentType(),
+		CommitmentAmount:    commitmentAmount,
+		OverageFactor:       sub.GetOverageFactor(),
+		Currency:            sub.Currency,
+		CommitmentStartDate: sub.CurrentPeriodStart,
+		CommitmentEndDate:   sub.CurrentPeriodEnd,
+		CommitmentInvoiced:  decimal.Zero,
+	}
+
+	if sub.HasTermCommitment() {
+		billingService := NewBillingService(s.ServiceParams)
+		invoiced, err := billingService.GetCommitmentUtilized(ctx, sub, sub.CurrentPeriodStart)
+		if err != nil {
+			return nil, err
+		}
+
+		response.CommitmentStartDate = *sub.CommitmentStartDate
+		response.CommitmentEndDate = *sub.CommitmentEndDate
+		response.CommitmentInvoiced = invoiced
+	}
+
+	usage, err := s.GetUsageBySubscription(ctx, &dto.GetUsageBySubscriptionRequest{
+		SubscriptionID: sub.ID,
+		StartTime:      sub.CurrentPeriodStart,
+		EndTime:        sub.CurrentPeriodEnd,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Usage charges past a billing period commitment are already marked up by the overage factor
+	currentUsage := decimal.Zero
+	for _, charge := range usage.Charges {
+		if charge.Price == nil || charge.Price.Type != types.PRICE_TYPE_USAGE {
+			continue
+		}
+		amount := decimal.NewFromFloat(charge.Amount)
+		if charge.IsOverage {
+			amount = amount.Div(response.OverageFactor)
+		}
+		currentUsage = currentUsage.Add(amount)
+	}
+
+	response.CommitmentUtilized = decimal.Min(commitmentAmount, response.CommitmentInvoiced.Add(currentUsage))
+	response.CommitmentRemaining = commitmentAmount.Sub(response.CommitmentUtilized)
+	return response, nil
+}
+
 func (s *subscriptionService) GetUsageBySubscription(ctx context.Context, req *dto.GetUsageBySubscriptionRequest) (*dto.GetUsageBySubscriptionResponse, error) {
 	response := &dto.GetUsageBySubscriptionResponse{}
 
-	eventService := NewEventService(s.EventRepo, s.MeterRepo, s.EventPublisher, s.Logger, s.Config)
+	eventService := NewEventService(s.EventRepo, s.MeterRepo, s.EventSchemaRepo, s.RejectedEventRepo, s.EventPublisher, s.Logger, s.Config)
 	priceService := NewPriceService(s.ServiceParams)
 
 	// Get subscription with line items
@@ -1217,7 +1386,7 @@ func (s *subscriptionService) GetUsageBySubscription(ctx context.Context, req *d
 	}
 
 	// Get customer
-	customer, err := s.CustomerRepo.Get(ctx, subscription.CustomerID)
+	subscriptionCustomer, err := s.CustomerRepo.Get(ctx, subscription.CustomerID)
 	if err != nil {
 		return nil, err
 	}
@@ -1282,191 +1451,258 @@ func (s *subscriptionService) GetUsageBySubscription(ctx context.Context, req *d
 		"end_time", usageEndTime,
 		"metered_line_items", len(priceIDs))
 
-	// Performance optimization: Get distinct event names for this customer
-	// to filter out meters that have no events, reducing processing from potentially
-	// 400-500 meters down to only 5-7 that have actual usage
-	distinctEventNames, err := s.EventRepo.GetDistinctEventNames(ctx, customer.ExternalID, usageStartTime, usageEndTime)
+	// Usage of the child customers rolls up into the subscription of the parent customer, the usage
+	// of all the customers is pooled per price before rating so the tiers apply to the pooled usage
+	usageCustomers := []*customer.Customer{subscriptionCustomer}
+	childFilter := types.NewNoLimitCustomerFilter()
+	childFilter.ParentCustomerIDs = []string{subscriptionCustomer.ID}
+	childCustomers, err := s.CustomerRepo.List(ctx, childFilter)
 	if err != nil {
-		s.Logger.Warnw("failed to get distinct event names, proceeding without optimization",
-			"error", err,
-			"external_customer_id", customer.ExternalID)
-		distinctEventNames = nil // Fallback: process all meters if optimization fails
+		return nil, err
 	}
+	usageCustomers = append(usageCustomers, childCustomers...)
 
-	// Create a map for fast event name lookup
-	eventNameExists := make(map[string]bool, len(distinctEventNames))
-	for _, eventName := range distinctEventNames {
-		eventNameExists[eventName] = true
-	}
+	// Store usage charges for later sorting and processing
+	var usageCharges []*dto.SubscriptionUsageByMetersResponse
 
-	s.Logger.Debugw("distinct event names optimization",
-		"external_customer_id", customer.ExternalID,
-		"total_distinct_events", len(distinctEventNames),
-		"total_line_items", len(lineItems),
-		"distinct_event_names", distinctEventNames)
+	// Usage of the prices pooled across the customers, in the order of the line items
+	pooledUsage := make(map[string]*pooledPriceUsage)
+	pooledPriceIDs := make([]string, 0)
 
-	meterUsageRequests := make([]*dto.GetUsageByMeterRequest, 0, len(lineItems))
-	for _, lineItem := range lineItems {
-		if lineItem.PriceType != types.PRICE_TYPE_USAGE {
-			continue
-		}
+	for _, customer := range usageCustomers {
+		customerChargesStart := len(usageCharges)
 
-		if lineItem.MeterID == "" {
-			continue
+		// Performance optimization: Get distinct event names for this customer
+		// to filter out meters that have no events, reducing processing from potentially
+		// 400-500 meters down to only 5-7 that have actual usage
+		distinctEventNames, err := s.EventRepo.GetDistinctEventNames(ctx, customer.ExternalID, usageStartTime, usageEndTime)
+		if err != nil {
+			s.Logger.Warnw("failed to get distinct event names, proceeding without optimization",
+				"error", err,
+				"external_customer_id", customer.ExternalID)
+			distinctEventNames = nil // Fallback: process all meters if optimization fails
 		}
 
-		meter := meterMap[lineItem.MeterID]
-		if meter == nil {
-			continue
+		// Create a map for fast event name lookup
+		eventNameExists := make(map[string]bool, len(distinctEventNames))
+		for _, eventName := range distinctEventNames {
+			eventNameExists[eventName] = true
 		}
 
-		if len(distinctEventNames) == 0 {
-			// skip all usage items if distinct event names is nil
-			// which means there is no event data in the database
-			// this is a fallback to ensure that we don't process all meters
-			// if the event data is not available
+		s.Logger.Debugw("distinct event names optimization",
+			"external_customer_id", customer.ExternalID,
+			"total_distinct_events", len(distinctEventNames),
+			"total_line_items", len(lineItems),
+			"distinct_event_names", distinctEventNames)
 
-			s.Logger.Debugw("skipping meter as there are no events",
-				"meter_id", lineItem.MeterID,
-				"event_name", meter.EventName,
-				"customer_id", customer.ID,
-				"external_customer_id", customer.ExternalID,
-				"subscription_id", req.SubscriptionID)
-			continue
-		}
+		meterUsageRequests := make([]*dto.GetUsageByMeterRequest, 0, len(lineItems))
+		for _, lineItem := range lineItems {
+			if lineItem.PriceType != types.PRICE_TYPE_USAGE {
+				continue
+			}
 
-		// Performance optimization: Skip meters that don't have any events for this customer
-		// Only skip if we successfully got distinct event names (not nil) and the event doesn't exist
-		if distinctEventNames != nil && !eventNameExists[meter.EventName] {
-			s.Logger.Debugw("skipping meter with no events",
-				"meter_id", lineItem.MeterID,
-				"event_name", meter.EventName,
-				"customer_id", customer.ID,
-				"external_customer_id", customer.ExternalID,
-				"subscription_id", req.SubscriptionID)
-			continue
-		}
+			if lineItem.MeterID == "" {
+				continue
+			}
 
-		meterID := lineItem.MeterID
-		usageRequest := &dto.GetUsageByMeterRequest{
-			MeterID:            meterID,
-			PriceID:            lineItem.PriceID,
-			Meter:              meter.ToMeter(),
-			ExternalCustomerID: customer.ExternalID,
-			StartTime:          lineItem.GetPeriodStart(usageStartTime),
-			EndTime:            lineItem.GetPeriodEnd(usageEndTime),
-			Filters:            make(map[string][]string),
+			meter := meterMap[lineItem.MeterID]
+			if meter == nil {
+				continue
+			}
+
+			if len(distinctEventNames) == 0 {
+				// skip all usage items if distinct event names is nil
+				// which means there is no event data in the database
+				// this is a fallback to ensure that we don't process all meters
+				// if the event data is not available
+
+				s.Logger.Debugw("skipping meter as there are no events",
+					"meter_id", lineItem.MeterID,
+					"event_name", meter.EventName,
+					"customer_id", customer.ID,
+					"external_customer_id", customer.ExternalID,
+					"subscription_id", req.SubscriptionID)
+				continue
+			}
+
+			// Performance optimization: Skip meters that don't have any events for this customer
+			// Only skip if we successfully got distinct event names (not nil) and the event doesn't exist
+			if distinctEventNames != nil && !eventNameExists[meter.EventName] {
+				s.Logger.Debugw("skipping meter with no events",
+					"meter_id", lineItem.MeterID,
+					"event_name", meter.EventName,
+					"customer_id", customer.ID,
+					"external_customer_id", customer.ExternalID,
+					"subscription_id", req.SubscriptionID)
+				continue
+			}
+
+			meterID := lineItem.MeterID
+			usageRequest := &dto.GetUsageByMeterRequest{
+				MeterID:            meterID,
+				PriceID:            lineItem.PriceID,
+				Meter:              meter.ToMeter(),
+				ExternalCustomerID: customer.ExternalID,
+				StartTime:          lineItem.GetPeriodStart(usageStartTime),
+				EndTime:            lineItem.GetPeriodEnd(usageEndTime),
+				Filters:            make(map[string][]string),
+			}
+
+			for _, filter := range meter.Filters {
+				if filter.IsExactMatch() {
+					usageRequest.Filters[filter.Key] = filter.Values
+				} else {
+					usageRequest.FilterConditions = append(usageRequest.FilterConditions, filter)
+				}
+			}
+			meterUsageRequests = append(meterUsageRequests, usageRequest)
 		}
 
-		for _, filter := range meter.Filters {
-			usageRequest.Filters[filter.Key] = filter.Values
+		s.Logger.Infow("performance optimization results",
+			"subscription_id", req.SubscriptionID,
+			"external_customer_id", customer.ExternalID,
+			"total_line_items", len(lineItems),
+			"total_usage_line_items", len(priceIDs),
+			"meters_with_events", len(meterUsageRequests),
+			"optimization_enabled", distinctEventNames != nil,
+			"meters_skipped", len(priceIDs)-len(meterUsageRequests))
+
+		usageMap, err := eventService.BulkGetUsageByMeter(ctx, meterUsageRequests)
+		if err != nil {
+			return nil, err
 		}
-		meterUsageRequests = append(meterUsageRequests, usageRequest)
-	}
 
-	s.Logger.Infow("performance optimization results",
-		"subscription_id", req.SubscriptionID,
-		"external_customer_id", customer.ExternalID,
-		"total_line_items", len(lineItems),
-		"total_usage_line_items", len(priceIDs),
-		"meters_with_events", len(meterUsageRequests),
-		"optimization_enabled", distinctEventNames != nil,
-		"meters_skipped", len(priceIDs)-len(meterUsageRequests))
-
-	usageMap, err := eventService.BulkGetUsageByMeter(ctx, meterUsageRequests)
-	if err != nil {
-		return nil, err
-	}
+		s.Logger.Debugw("fetched usage for meters",
+			"meter_ids", lo.Keys(usageMap),
+			"total_usage_count", len(usageMap),
+			"subscription_id", req.SubscriptionID)
 
-	s.Logger.Debugw("fetched usage for meters",
-		"meter_ids", lo.Keys(usageMap),
-		"total_usage_count", len(usageMap),
-		"subscription_id", req.SubscriptionID)
+		// First pass: calculate normal costs and build initial charge objects
+		// Note: we are iterating over the meterUsageRequests and not the usageMap
+		// This is because the usageMap is a map of meterID to usage and we want to iterate over the meterUsageRequests
+		// as there can be multiple requests for the same meterID with different priceIDs
+		// Ideally this will not be the case and we will have a single request per meterID
+		// TODO: should add validation to ensure that same subscription does not have multiple line items with the same meterID
+		for _, request := range meterUsageRequests {
+			meterID := request.MeterID
+			priceID := request.PriceID
+			usage, ok := usageMap[priceID]
 
-	// Store usage charges for later sorting and processing
-	var usageCharges []*dto.SubscriptionUsageByMetersResponse
+			if !ok {
+				continue
+			}
 
-	// First pass: calculate normal costs and build initial charge objects
-	// Note: we are iterating over the meterUsageRequests and not the usageMap
-	// This is because the usageMap is a map of meterID to usage and we want to iterate over the meterUsageRequests
-	// as there can be multiple requests for the same meterID with different priceIDs
-	// Ideally this will not be the case and we will have a single request per meterID
-	// TODO: should add validation to ensure that same subscription does not have multiple line items with the same meterID
-	for _, request := range meterUsageRequests {
-		meterID := request.MeterID
-		priceID := request.PriceID
-		usage, ok := usageMap[priceID]
+			// Get price by price ID and check if it exists
+			priceObj, priceExists := priceMap[usage.PriceID]
+			if !priceExists || priceObj == nil {
+				return nil, ierr.NewError("price not found").
+					WithHint("The price for the meter was not found").
+					WithReportableDetails(map[string]interface{}{
+						"meter_id":        meterID,
+						"price_id":        usage.PriceID,
+						"subscription_id": req.SubscriptionID,
+					}).
+					Mark(ierr.ErrNotFound)
+			}
 
-		if !ok {
-			continue
-		}
+			meterDisplayName := ""
+			if meter, ok := meterDisplayNames[meterID]; ok {
+				meterDisplayName = meter
+			}
 
-		// Get price by price ID and check if it exists
-		priceObj, priceExists := priceMap[usage.PriceID]
-		if !priceExists || priceObj == nil {
-			return nil, ierr.NewError("price not found").
-				WithHint("The price for the meter was not found").
-				WithReportableDetails(map[string]interface{}{
-					"meter_id":        meterID,
-					"price_id":        usage.PriceID,
-					"subscription_id": req.SubscriptionID,
-				}).
-				Mark(ierr.ErrNotFound)
-		}
+			// For matrix prices, the usage is charged per cell of the rate table
+			if priceObj.BillingModel == types.BILLING_MODEL_MATRIX {
+				matrixCharges, matrixCost, err := s.getMatrixUsageCharges(ctx, eventService, priceService, request, priceObj, meterDisplayName)
+				if err != nil {
+					return nil, err
+				}
 
-		meterDisplayName := ""
-		if meter, ok := meterDisplayNames[meterID]; ok {
-			meterDisplayName = meter
-		}
+				usageCharges = append(usageCharges, matrixCharges...)
+				totalCost = totalCost.Add(matrixCost)
+				continue
+			}
 
-		// For bucketed max, we need to handle array of values
-		var cost decimal.Decimal
-		var quantity decimal.Decimal
+			pooled, ok := pooledUsage[priceID]
+			if !ok {
+				pooled = &pooledPriceUsage{
+					price:            priceObj,
+					meterDisplayName: meterDisplayName,
+					bucketedValues:   make(map[time.Time]decimal.Decimal),
+				}
+				pooledUsage[priceID] = pooled
+				pooledPriceIDs = append(pooledPriceIDs, priceID)
+			}
 
-		// Get meter info
-		meterInfo := meterMap[meterID]
-		if priceObj.MeterID != "" && meterInfo != nil && meterInfo.ToMeter().IsBucketedMaxMeter() {
-			// For bucketed max, use the array of values
-			bucketedValues := make([]decimal.Decimal, len(usage.Results))
-			for i, result := range usage.Results {
-				bucketedValues[i] = result.Value
+			// Get meter info
+			meterInfo := meterMap[meterID]
+			if priceObj.MeterID != "" && meterInfo != nil && meterInfo.ToMeter().IsBucketedMeter() {
+				// For bucketed max, the bucket maxes of the customers are pooled per bucket
+				pooled.bucketed = true
+				quantity := decimal.Zero
+				for _, result := range usage.Results {
+					pooled.bucketedValues[result.WindowSize] = pooled.bucketedValues[result.WindowSize].Add(result.Value)
+					quantity = quantity.Add(result.Value)
+				}
+				pooled.add(customer, quantity)
+			} else {
+				// For all other cases, use the single value
+				pooled.add(customer, usage.Value)
 			}
-			cost = priceService.CalculateBucketedCost(ctx, priceObj, bucketedValues)
 
-			// Calculate quantity as sum of all bucket maxes
-			quantity = decimal.Zero
-			for _, bucketValue := range bucketedValues {
-				quantity = quantity.Add(bucketValue)
+			s.Logger.Debugw("fetched usage for meter",
+				"meter_id", meterID,
+				"customer_id", customer.ID,
+				"meter_display_name", meterDisplayName,
+				"subscription_id", req.SubscriptionID,
+				"usage", usage,
+				"price", priceObj,
+			)
+		}
+
+		// Tag the charges of the child customers so they can be invoiced in separate sections
+		if customer.IsChild() {
+			for _, charge := range usageCharges[customerChargesStart:] {
+				charge.CustomerID = customer.ID
+				charge.CustomerName = customer.Name
 			}
-		} else {
-			// For all other cases, use the single value
-			quantity = usage.Value
-			cost = priceService.CalculateCost(ctx, priceObj, quantity)
 		}
+	}
+
+	// Rate the pooled usage of each price and split the charge per customer for display
+	for _, priceID := range pooledPriceIDs {
+		pooled := pooledUsage[priceID]
+		cost := pooled.cost(ctx, priceService)
+		totalCost = totalCost.Add(cost)
 
-		s.Logger.Debugw("calculated usage for meter",
-			"meter_id", meterID,
-			"quantity", quantity,
+		s.Logger.Debugw("calculated usage for price",
+			"price_id", priceID,
+			"quantity", pooled.quantity,
 			"cost", cost,
-			"meter_display_name", meterDisplayName,
+			"customers", len(pooled.customers),
 			"subscription_id", req.SubscriptionID,
-			"usage", usage,
-			"price", priceObj,
 		)
 
-		charge := createChargeResponse(
-			priceObj,
-			quantity,
-			cost,
-			meterDisplayName,
-		)
+		costs := allocateByQuantity(cost, lo.Map(pooled.customers, func(cu customerUsage, _ int) decimal.Decimal {
+			return cu.quantity
+		}))
+		for i, cu := range pooled.customers {
+			charge := createChargeResponse(
+				pooled.price,
+				cu.quantity,
+				costs[i],
+				pooled.meterDisplayName,
+			)
+
+			if charge == nil {
+				continue
+			}
 
-		if charge == nil {
-			continue
+			if cu.customer.IsChild() {
+				charge.CustomerID = cu.customer.ID
+				charge.CustomerName = cu.customer.Name
+			}
+			usageCharges = append(usageCharges, charge)
 		}
-
-		usageCharges = append(usageCharges, charge)
-		totalCost = totalCost.Add(cost)
 	}
 
 	// Apply commitment logic if set on the subscription
@@ -1475,8 +1711,9 @@ func (s *subscriptionService) GetUsageBySubscription(ctx context.Context, req *d
 	commitmentAmount := lo.FromPtr(subscription.CommitmentAmount)
 	overageFactor := lo.FromPtr(subscription.OverageFactor)
 
-	// Check if commitment amount is greater than zero
-	if commitmentAmount.GreaterThan(decimal.Zero) {
+	// Check if commitment amount is greater than zero, prepaid and postpaid commitments
+	// are drawn down across the billing periods of the commitment term while billing
+	if commitmentAmount.GreaterThan(decimal.Zero) && !subscription.HasTermCommitment() {
 		// Check if overage factor is greater than 1.0
 		oneDecimal := decimal.NewFromInt(1)
 		hasCommitment = overageFactor.GreaterThan(oneDecimal)
@@ -1640,7 +1877,10 @@ func (s *subscriptionService) UpdateBillingPeriods(ctx context.Context) (*dto.Su
 				Offset: lo.ToPtr(offset),
 				Status: lo.ToPtr(types.StatusPublished),
 			},
-			SubscriptionStatus: []types.SubscriptionStatus{types.SubscriptionStatusActive},
+			SubscriptionStatus: []types.SubscriptionStatus{
+				types.SubscriptionStatusActive,
+				types.SubscriptionStatusPastDue,
+			},
 			TimeRangeFilter: &types.TimeRangeFilter{
 				EndTime: &now,
 			},
@@ -1698,6 +1938,82 @@ func (s *subscriptionService) UpdateBillingPeriods(ctx context.Context) (*dto.Su
 
 /// Helpers
 
+// processCommitmentTermEnd closes the commitment term of a subscription with a prepaid or postpaid commitment
+// when it ends with the given billing period. The unused commitment of a postpaid commitment is invoiced as a
+// true-up, and unless the subscription ends with the term, the next term is started and invoiced upfront for a
+// prepaid commitment. Returns the subscription as persisted.
+func (s *subscriptionService) processCommitmentTermEnd(ctx context.Context, sub *subscription.Subscription, periodEnd time.Time, paymentParams *dto.PaymentParameters) (*subscription.Subscription, error) {
+	if !sub.HasTermCommitment() || periodEnd.Before(*sub.CommitmentEndDate) {
+		return sub, nil
+	}
+
+	invoiceService := NewInvoiceService(s.ServiceParams)
+
+	// Bill the unused commitment of the term
+	if sub.CommitmentType == types.CommitmentTypePostpaid {
+		if _, err := invoiceService.CreateSubscriptionCommitmentInvoice(ctx, sub, paymentParams, types.InvoiceFlowRenewal); err != nil {
+			return nil, err
+		}
+	}
+
+	// No new term if the subscription ends with the current one
+	termEnd := *sub.CommitmentEndDate
+	if sub.EndDate != nil && !termEnd.Before(*sub.EndDate) {
+		return sub, nil
+	}
+	if sub.CancelAtPeriodEnd && sub.CancelAt != nil && !sub.CancelAt.After(termEnd) {
+		return sub, nil
+	}
+
+	nextTermEnd, err := sub.CalculateCommitmentEndDate(termEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	sub.CommitmentStartDate = lo.ToPtr(termEnd)
+	sub.CommitmentEndDate = lo.ToPtr(nextTermEnd)
+	if err := s.SubRepo.Update(ctx, sub); err != nil {
+		return nil, err
+	}
+
+	// Reload the subscription to pick up the new version for the following updates
+	updatedSub, _, err := s.SubRepo.GetWithLineItems(ctx, sub.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Infow("started new commitment term for subscription",
+		"subscription_id", sub.ID,
+		"commitment_type", sub.CommitmentType,
+		"commitment_start_date", updatedSub.CommitmentStartDate,
+		"commitment_end_date", updatedSub.CommitmentEndDate)
+
+	// Invoice the prepaid commitment upfront for the new term
+	if updatedSub.CommitmentType == types.CommitmentTypePrepaid {
+		if _, err := invoiceService.CreateSubscriptionCommitmentInvoice(ctx, updatedSub, paymentParams, types.InvoiceFlowRenewal); err != nil {
+			return nil, err
+		}
+	}
+
+	return updatedSub, nil
+}
+
+// processCommitmentCancellation closes the commitment term of a subscription cancelled before the term ends.
+// The unused commitment of a postpaid commitment stays due and is invoiced as a true-up, a prepaid commitment
+// has already been invoiced upfront.
+func (s *subscriptionService) processCommitmentCancellation(ctx context.Context, sub *subscription.Subscription, paymentParams *dto.PaymentParameters) error {
+	if !sub.HasTermCommitment() || sub.CommitmentType != types.CommitmentTypePostpaid {
+		return nil
+	}
+
+	// The commitment invoice is idempotent per term, so a term already closed at its end isn't invoiced twice
+	invoiceService := NewInvoiceService(s.ServiceParams)
+	if _, err := invoiceService.CreateSubscriptionCommitmentInvoice(ctx, sub, paymentParams, types.InvoiceFlowCancel); err != nil {
+		return err
+	}
+	return nil
+}
+
 // we get each subscription picked by the cron where the current period end is before now
 // and we process the subscription period to create invoices for the passed period
 // and decide next period start and end or cancel the subscription if it has ended
@@ -1902,6 +2218,12 @@ func (s *subscriptionService) processSubscriptionPeriod(ctx context.Context, sub
 				sub = updatedSub
 			}
 
+			// Close the commitment term if it ends with this period and start the next one
+			sub, err = s.processCommitmentTermEnd(ctx, sub, period.end, paymentParams)
+			if err != nil {
+				return err
+			}
+
 			s.Logger.Infow("created invoice for period",
 				"subscription_id", sub.ID,
 				"period_start", period.start,
@@ -1910,6 +2232,9 @@ func (s *subscriptionService) processSubscriptionPeriod(ctx context.Context, sub
 
 			// Check for cancellation at this period end
 			if sub.CancelAtPeriodEnd && sub.CancelAt != nil && !sub.CancelAt.After(period.end) {
+				if err := s.processCommitmentCancellation(ctx, sub, paymentParams); err != nil {
+					return err
+				}
 				sub.SubscriptionStatus = types.SubscriptionStatusCancelled
 				sub.CancelledAt = sub.CancelAt
 				break
@@ -1917,6 +2242,9 @@ func (s *subscriptionService) processSubscriptionPeriod(ctx context.Context, sub
 
 			// Check if this period end matches the subscription end date
 			if sub.EndDate != nil && period.end.Equal(*sub.EndDate) {
+				if err := s.processCommitmentCancellation(ctx, sub, paymentParams); err != nil {
+					return err
+				}
 				sub.SubscriptionStatus = types.SubscriptionStatusCancelled
 				sub.CancelledAt = sub.EndDate
 				s.Logger.Infow("will cancel subscription at end of this period",
@@ -1992,6 +2320,177 @@ func (s *subscriptionService) processSubscriptionPeriod(ctx context.Context, sub
 	return nil
 }
 
+// getFeatureUsageQuantity returns the quantity of the aggregated feature usage based on the meter aggregation type
+func getFeatureUsageQuantity(aggregationType types.AggregationType, usageResult *events.UsageByFeatureResult) decimal.Decimal {
+	switch aggregationType {
+	case types.AggregationSum, types.AggregationSumWithMultiplier, types.AggregationWeightedSum:
+		return usageResult.SumTotal
+	case types.AggregationMax:
+		return usageResult.MaxTotal
+	case types.AggregationMin:
+		return usageResult.MinTotal
+	case types.AggregationFirst:
+		return usageResult.FirstQty
+	case types.AggregationCount:
+		return decimal.NewFromInt(int64(usageResult.CountDistinctIDs))
+	case types.AggregationCountUnique:
+		return decimal.NewFromInt(int64(usageResult.CountUniqueQty))
+	case types.AggregationLatest:
+		return usageResult.LatestQty
+	case types.AggregationPercentile:
+		return usageResult.PercentileQty
+	default:
+		return usageResult.SumTotal // Default to sum
+	}
+}
+
+// setFeatureUsagePercentiles sets the percentile usage of the results billed by PERCENTILE meters, which
+// cannot be derived from the other aggregates. aggregations maps the meter ID to the meter aggregation.
+func setFeatureUsagePercentiles(
+	ctx context.Context,
+	featureUsageRepo events.FeatureUsageRepository,
+	subscriptionID string,
+	externalCustomerIDs []string,
+	results map[string]*events.UsageByFeatureResult,
+	aggregations map[string]meter.Aggregation,
+	startTime, endTime time.Time,
+) error {
+	percentiles := make(map[string]decimal.Decimal)
+	for _, result := range results {
+		aggregation, ok := aggregations[result.MeterID]
+		if !ok || aggregation.Type != types.AggregationPercentile || aggregation.Percentile == nil {
+			continue
+		}
+		percentiles[result.MeterID] = *aggregation.Percentile
+	}
+	if len(percentiles) == 0 {
+		return nil
+	}
+
+	percentileQtys, err := featureUsageRepo.GetFeatureUsagePercentiles(ctx, subscriptionID, externalCustomerIDs, percentiles, startTime, endTime)
+	if err != nil {
+		return err
+	}
+
+	for subLineItemID, result := range results {
+		if qty, ok := percentileQtys[subLineItemID]; ok {
+			result.PercentileQty = qty
+		}
+	}
+	return nil
+}
+
+// getMatrixUsageCharges breaks down the usage of a matrix price into one charge per cell of its rate table
+// by narrowing down the meter usage request to the dimension values of each cell
+func (s *subscriptionService) getMatrixUsageCharges(
+	ctx context.Context,
+	eventService EventService,
+	priceService PriceService,
+	request *dto.GetUsageByMeterRequest,
+	priceObj *price.Price,
+	meterDisplayName string,
+) ([]*dto.SubscriptionUsageByMetersResponse, decimal.Decimal, error) {
+	charges := make([]*dto.SubscriptionUsageByMetersResponse, 0)
+	totalCost := decimal.Zero
+	if priceObj.Matrix == nil {
+		return charges, totalCost, nil
+	}
+
+	for _, cell := range priceObj.Matrix.Cells {
+		cellRequest := *request
+		cellRequest.Filters = make(map[string][]string, len(request.Filters)+len(cell.DimensionValues))
+		for key, values := range request.Filters {
+			cellRequest.Filters[key] = values
+		}
+
+		// Skip cells excluded by the meter filters on the same property
+		excluded := false
+		for dimension, value := range cell.DimensionValues {
+			if values, ok := request.Filters[dimension]; ok && !lo.Contains(values, value) {
+				excluded = true
+				break
+			}
+			cellRequest.Filters[dimension] = []string{value}
+		}
+		if excluded {
+			continue
+		}
+
+		usage, err := eventService.GetUsageByMeter(ctx, &cellRequest)
+		if err != nil {
+			return nil, decimal.Zero, err
+		}
+
+		// Matrix cells are charged per unit so bucketed values can simply be added up
+		quantity := usage.Value
+		if request.Meter != nil && request.Meter.IsBucketedMeter() {
+			quantity = decimal.Zero
+			for _, result := range usage.Results {
+				quantity = quantity.Add(result.Value)
+			}
+		}
+
+		if quantity.IsZero() {
+			continue
+		}
+
+		cost := priceService.CalculateMatrixCost(ctx, priceObj, cell.DimensionValues, quantity)
+		charge := createChargeResponse(priceObj, quantity, cost, meterDisplayName)
+		if charge == nil {
+			continue
+		}
+
+		charge.DimensionValues = cell.DimensionValues
+		charge.FilterValues = make(price.JSONBFilters, len(cellRequest.Filters))
+		for key, values := range cellRequest.Filters {
+			charge.FilterValues[key] = values
+		}
+
+		charges = append(charges, charge)
+		totalCost = totalCost.Add(cost)
+	}
+
+	return charges, totalCost, nil
+}
+
+// customerUsage is the usage of a price by one of the customers billed through a subscription
+type customerUsage struct {
+	customer *customer.Customer
+	quantity decimal.Decimal
+}
+
+// pooledPriceUsage is the usage of a price pooled across the parent customer of a subscription and
+// its child customers. The price is rated on the pooled usage, so the tiers apply to the usage of all
+// the customers together.
+type pooledPriceUsage struct {
+	price            *price.Price
+	meterDisplayName string
+	quantity         decimal.Decimal
+	bucketed         bool
+	bucketedValues   map[time.Time]decimal.Decimal
+	customers        []customerUsage
+}
+
+func (u *pooledPriceUsage) add(c *customer.Customer, quantity decimal.Decimal) {
+	u.quantity = u.quantity.Add(quantity)
+	u.customers = append(u.customers, customerUsage{customer: c, quantity: quantity})
+}
+
+// cost rates the pooled usage with the price
+func (u *pooledPriceUsage) cost(ctx context.Context, priceService PriceService) decimal.Decimal {
+	if !u.bucketed {
+		return priceService.CalculateCost(ctx, u.price, u.quantity)
+	}
+
+	buckets := lo.Keys(u.bucketedValues)
+	sort.Slice(buckets, func(i, j int) bool { return buckets[i].Before(buckets[j]) })
+	bucketedValues := make([]decimal.Decimal, len(buckets))
+	for i, bucket := range buckets {
+		bucketedValues[i] = u.bucketedValues[bucket]
+	}
+	return priceService.CalculateBucketedCost(ctx, u.price, bucketedValues)
+}
+
 func createChargeResponse(priceObj *price.Price, quantity decimal.Decimal, cost decimal.Decimal, meterDisplayName string) *dto.SubscriptionUsageByMetersResponse {
 	if priceObj == nil {
 		return nil
@@ -3311,6 +3810,31 @@ func (s *subscriptionService) ProcessSubscriptionRenewalDueAlert(ctx context.Con
 	return nil
 }
 
+// ProcessSubscriptionUsageAlerts evaluates the spend and usage alerts of all active subscriptions
+func (s *subscriptionService) ProcessSubscriptionUsageAlerts(ctx context.Context) error {
+	subscriptions, err := s.SubRepo.ListSubscriptionsWithUsageAlerts(ctx)
+	if err != nil {
+		s.Logger.Errorw("failed to list subscriptions with usage alerts", "error", err)
+		return err
+	}
+
+	s.Logger.Infow("found subscriptions with usage alerts", "count", len(subscriptions))
+
+	alertLogsService := NewAlertLogsService(s.ServiceParams)
+	for _, sub := range subscriptions {
+		subCtx := context.WithValue(ctx, types.CtxTenantID, sub.TenantID)
+		subCtx = context.WithValue(subCtx, types.CtxEnvironmentID, sub.EnvironmentID)
+		if err := alertLogsService.CheckSubscriptionUsageAlerts(subCtx, sub); err != nil {
+			s.Logger.Errorw("failed to check subscription usage alerts",
+				"subscription_id", sub.ID,
+				"error", err)
+			continue
+		}
+	}
+
+	return nil
+}
+
 func (s *subscriptionService) handleSubscriptionResume(ctx context.Context, subscriptionID string) error {
 	// Process any missed recurring grants
 	return nil
@@ -4460,12 +4984,34 @@ func (s *subscriptionService) GetFeatureUsageBySubscription(ctx context.Context,
 		"end_time", usageEndTime,
 		"metered_line_items", len(priceIDs))
 
+	// Usage of the child customers is tracked against the subscription of the parent customer
+	externalCustomerIDs := []string{customer.ExternalID}
+	childFilter := types.NewNoLimitCustomerFilter()
+	childFilter.ParentCustomerIDs = []string{customer.ID}
+	childCustomers, err := s.CustomerRepo.List(ctx, childFilter)
+	if err != nil {
+		return nil, err
+	}
+	for _, child := range childCustomers {
+		externalCustomerIDs = append(externalCustomerIDs, child.ExternalID)
+	}
+
 	// Use the optimized single query
-	usageResults, err := s.FeatureUsageRepo.GetFeatureUsageBySubscription(ctx, req.SubscriptionID, customer.ExternalID, usageStartTime, usageEndTime)
+	usageResults, err := s.FeatureUsageRepo.GetFeatureUsageBySubscription(ctx, req.SubscriptionID, externalCustomerIDs, usageStartTime, usageEndTime)
 	if err != nil {
 		return nil, err
 	}
 
+	meterAggregations := make(map[string]meter.Aggregation, len(meterMap))
+	for meterID, m := range meterMap {
+		if m != nil {
+			meterAggregations[meterID] = m.Aggregation
+		}
+	}
+	if err := setFeatureUsagePercentiles(ctx, s.FeatureUsageRepo, req.SubscriptionID, externalCustomerIDs, usageResults, meterAggregations, usageStartTime, usageEndTime); err != nil {
+		return nil, err
+	}
+
 	s.Logger.Debugw("fetched usage for features using V2 query",
 		"feature_ids", lo.Keys(usageResults),
 		"total_usage_count", len(usageResults),
@@ -4513,23 +5059,46 @@ func (s *subscriptionService) GetFeatureUsageBySubscription(ctx context.Context,
 			continue
 		}
 
-		// Calculate quantity based on meter aggregation type
-		var quantity decimal.Decimal
-		switch meter.Aggregation.Type {
-		case types.AggregationSum, types.AggregationSumWithMultiplier, types.AggregationWeightedSum:
-			quantity = usageResult.SumTotal
-		case types.AggregationMax:
-			quantity = usageResult.MaxTotal
-		case types.AggregationCount:
-			quantity = decimal.NewFromInt(int64(usageResult.CountDistinctIDs))
-		case types.AggregationCountUnique:
-			quantity = decimal.NewFromInt(int64(usageResult.CountUniqueQty))
-		case types.AggregationLatest:
-			quantity = usageResult.LatestQty
-		default:
-			quantity = usageResult.SumTotal // Default to sum
+		// Break down the usage of matrix prices per cell of the rate table
+		if priceObj.BillingModel == types.BILLING_MODEL_MATRIX && priceObj.Matrix != nil {
+			dimensionResults, err := s.FeatureUsageRepo.GetFeatureUsageByDimensions(ctx, req.SubscriptionID, subLineItemID, externalCustomerIDs, priceObj.Matrix.Dimensions, meter.Aggregation.Percentile, usageStartTime, usageEndTime)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, dimensionResult := range dimensionResults {
+				quantity := getFeatureUsageQuantity(meter.Aggregation.Type, dimensionResult)
+				cost := priceService.CalculateMatrixCost(ctx, priceObj, dimensionResult.DimensionValues, quantity)
+				totalCost = totalCost.Add(cost)
+
+				charge := &dto.SubscriptionUsageByMetersResponse{
+					Amount:           cost.InexactFloat64(),
+					Currency:         priceObj.Currency,
+					DisplayAmount:    fmt.Sprintf("%.2f %s", cost.InexactFloat64(), priceObj.Currency),
+					Quantity:         quantity.InexactFloat64(),
+					FilterValues:     make(price.JSONBFilters),
+					DimensionValues:  dimensionResult.DimensionValues,
+					MeterID:          meterID,
+					MeterDisplayName: meterDisplayNames[meterID],
+					Price:            priceObj,
+					IsOverage:        false,
+				}
+
+				for _, filter := range meter.Filters {
+					charge.FilterValues[filter.Key] = filter.Values
+				}
+				for dimension, value := range dimensionResult.DimensionValues {
+					charge.FilterValues[dimension] = []string{value}
+				}
+
+				usageCharges = append(usageCharges, charge)
+			}
+			continue
 		}
 
+		// Calculate quantity based on meter aggregation type
+		quantity := getFeatureUsageQuantity(meter.Aggregation.Type, usageResult)
+
 		// Calculate cost using the price service
 		cost := priceService.CalculateCost(ctx, priceObj, quantity)
 		totalCost = totalCost.Add(cost)
@@ -4558,7 +5127,8 @@ func (s *subscriptionService) GetFeatureUsageBySubscription(ctx context.Context,
 	// Apply commitment-based overage logic if configured
 	commitmentAmount := lo.FromPtr(subscription.CommitmentAmount)
 	overageFactor := lo.FromPtr(subscription.OverageFactor)
-	hasCommitment := commitmentAmount.GreaterThan(decimal.Zero) && overageFactor.GreaterThan(decimal.NewFromInt(1))
+	// Prepaid and postpaid commitments are drawn down across the billing periods of the commitment term while billing
+	hasCommitment := commitmentAmount.GreaterThan(decimal.Zero) && overageFactor.GreaterThan(decimal.NewFromInt(1)) && !subscription.HasTermCommitment()
 
 	// Default values assuming no commitment/overage
 	commitmentFloat, _ := commitmentAmount.Float64()
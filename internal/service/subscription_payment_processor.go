@@ -7,6 +7,7 @@ import (
 	"github.com/flexprice/flexprice/internal/domain/subscription"
 	"github.com/flexprice/flexprice/internal/domain/wallet"
 	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/integration"
 	"github.com/flexprice/flexprice/internal/types"
 
 	"github.com/shopspring/decimal"
@@ -708,36 +709,29 @@ func (s *subscriptionPaymentProcessor) processPaymentMethodCharge(
 		"amount", amount,
 	)
 
-	// Check if tenant has Stripe connection
-	if !s.hasStripeConnection(ctx) {
-		s.Logger.Warnw("no Stripe connection available for payment method charge",
-			"subscription_id", sub.ID,
-		)
-		return decimal.Zero
-	}
-
-	// Get Stripe integration
-	stripeIntegration, err := s.IntegrationFactory.GetStripeIntegration(ctx)
+	// Get the payment gateway of the environment
+	gateway, err := s.getPaymentGateway(ctx)
 	if err != nil {
-		s.Logger.Warnw("failed to get Stripe integration",
+		s.Logger.Warnw("no payment gateway available for payment method charge",
 			"subscription_id", sub.ID,
 			"error", err,
 		)
 		return decimal.Zero
 	}
 
-	// Check if customer has Stripe entity mapping
+	// Check if customer is linked to a gateway customer
 	customerService := NewCustomerService(*s.ServiceParams)
-	if !stripeIntegration.CustomerSvc.HasCustomerStripeMapping(ctx, sub.CustomerID, customerService) {
-		s.Logger.Warnw("no Stripe entity mapping found for customer",
+	if !gateway.HasCustomer(ctx, sub.CustomerID, customerService) {
+		s.Logger.Warnw("no payment gateway customer found for customer",
 			"subscription_id", sub.ID,
 			"customer_id", sub.CustomerID,
+			"gateway", gateway.GetGatewayType(),
 		)
 		return decimal.Zero
 	}
 
 	// Get payment method ID
-	paymentMethodID := s.getPaymentMethodID(ctx, sub)
+	paymentMethodID := s.getPaymentMethodID(ctx, sub, gateway)
 	if paymentMethodID == "" {
 		s.Logger.Warnw("no payment method available for automatic charging",
 			"subscription_id", sub.ID,
@@ -799,7 +793,7 @@ func (s *subscriptionPaymentProcessor) processPaymentMethodCharge(
 }
 
 // getPaymentMethodID gets the payment method ID for the subscription
-func (s *subscriptionPaymentProcessor) getPaymentMethodID(ctx context.Context, sub *subscription.Subscription) string {
+func (s *subscriptionPaymentProcessor) getPaymentMethodID(ctx context.Context, sub *subscription.Subscription, gateway integration.PaymentGateway) string {
 	// Use subscription's payment method if set
 	if sub.GatewayPaymentMethodID != nil && *sub.GatewayPaymentMethodID != "" {
 		s.Logger.Infow("using subscription gateway payment method",
@@ -809,18 +803,9 @@ func (s *subscriptionPaymentProcessor) getPaymentMethodID(ctx context.Context, s
 		return *sub.GatewayPaymentMethodID
 	}
 
-	// Get customer's default payment method from Stripe
-	stripeIntegration, err := s.IntegrationFactory.GetStripeIntegration(ctx)
-	if err != nil {
-		s.Logger.Warnw("failed to get Stripe integration",
-			"error", err,
-			"subscription_id", sub.ID,
-		)
-		return ""
-	}
-
+	// Get customer's default payment method from the payment gateway
 	customerService := NewCustomerService(*s.ServiceParams)
-	defaultPaymentMethod, err := stripeIntegration.CustomerSvc.GetDefaultPaymentMethod(ctx, sub.CustomerID, customerService)
+	defaultPaymentMethod, err := gateway.GetDefaultPaymentMethod(ctx, sub.CustomerID, customerService)
 	if err != nil {
 		s.Logger.Warnw("failed to get default payment method",
 			"error", err,
@@ -847,27 +832,15 @@ func (s *subscriptionPaymentProcessor) getPaymentMethodID(ctx context.Context, s
 	return defaultPaymentMethod.ID
 }
 
-// hasStripeConnection checks if the tenant has a Stripe connection available
-func (s *subscriptionPaymentProcessor) hasStripeConnection(ctx context.Context) bool {
-	conn, err := s.ConnectionRepo.GetByProvider(ctx, types.SecretProviderStripe)
-	if err != nil {
-		s.Logger.Debugw("no Stripe connection found",
-			"error", err,
-		)
-		return false
-	}
-
-	if conn == nil {
-		s.Logger.Debugw("Stripe connection is nil")
-		return false
+// getPaymentGateway returns the payment gateway of the environment
+func (s *subscriptionPaymentProcessor) getPaymentGateway(ctx context.Context) (integration.PaymentGateway, error) {
+	if s.IntegrationFactory == nil {
+		return nil, ierr.NewError("payment gateway integration is not available").
+			WithHint("Payment gateways are not configured").
+			Mark(ierr.ErrSystem)
 	}
 
-	s.Logger.Debugw("Stripe connection found",
-		"connection_id", conn.ID,
-		"provider", conn.ProviderType,
-	)
-
-	return true
+	return s.IntegrationFactory.GetPaymentGateway(ctx)
 }
 
 // shouldAllowPartialWalletPayment determines if partial wallet payment should be allowed
@@ -146,6 +146,8 @@ func (s *SubscriptionServiceSuite) setupService() {
 		CouponAssociationRepo:      s.GetStores().CouponAssociationRepo,
 		CouponApplicationRepo:      s.GetStores().CouponApplicationRepo,
 		SettingsRepo:               s.GetStores().SettingsRepo,
+		ConnectionRepo:             s.GetStores().ConnectionRepo,
+		IntegrationFactory:         s.GetIntegrationFactory(),
 		EventPublisher:             s.GetPublisher(),
 		WebhookPublisher:           s.GetWebhookPublisher(),
 		ProrationCalculator:        s.GetCalculator(),
@@ -3076,6 +3078,45 @@ func (s *SubscriptionServiceSuite) TestCancelSubscription() {
 			})
 		}
 	})
+
+	s.Run("TestImmediateCancellationWithPostpaidCommitment", func() {
+		termStart := s.testData.now.Add(-30 * 24 * time.Hour)
+		commitmentSub := &subscription.Subscription{
+			ID:                  "sub_postpaid_commitment_cancel",
+			CustomerID:          s.testData.customer.ID,
+			PlanID:              s.testData.plan.ID,
+			SubscriptionStatus:  types.SubscriptionStatusActive,
+			StartDate:           termStart,
+			CurrentPeriodStart:  s.testData.now.Add(-24 * time.Hour),
+			CurrentPeriodEnd:    s.testData.now.Add(6 * 24 * time.Hour),
+			BillingPeriod:       types.BILLING_PERIOD_MONTHLY,
+			BillingPeriodCount:  1,
+			Currency:            "usd",
+			CommitmentType:      types.CommitmentTypePostpaid,
+			CommitmentAmount:    lo.ToPtr(decimal.NewFromInt(100)),
+			CommitmentStartDate: lo.ToPtr(termStart),
+			CommitmentEndDate:   lo.ToPtr(termStart.AddDate(1, 0, 0)),
+			BaseModel:           types.GetDefaultBaseModel(s.GetContext()),
+		}
+		s.NoError(s.GetStores().SubscriptionRepo.CreateWithLineItems(s.GetContext(), commitmentSub, []*subscription.SubscriptionLineItem{}))
+
+		_, err := s.service.CancelSubscription(s.GetContext(), commitmentSub.ID, &dto.CancelSubscriptionRequest{
+			CancellationType:  types.CancellationTypeImmediate,
+			ProrationBehavior: types.ProrationBehaviorNone,
+			Reason:            "test_cancellation",
+		})
+		s.NoError(err)
+
+		// The unused commitment of the term is billed on a true-up invoice
+		invoiceFilter := types.NewInvoiceFilter()
+		invoiceFilter.SubscriptionID = commitmentSub.ID
+		invoiceFilter.InvoiceType = types.InvoiceTypeOneOff
+
+		invoicesResp, err := s.createInvoiceService().ListInvoices(s.GetContext(), invoiceFilter)
+		s.NoError(err)
+		s.Require().Len(invoicesResp.Items, 1)
+		s.True(decimal.NewFromInt(100).Equal(invoicesResp.Items[0].AmountDue))
+	})
 }
 
 func (s *SubscriptionServiceSuite) TestListSubscriptions() {
@@ -4595,4 +4636,101 @@ func (s *SubscriptionServiceSuite) TestPriceOverrideIntegration() {
 
 		s.T().Logf("✅ Multiple subscriptions test passed: Created %d subscriptions with unique overrides", len(overrideScenarios))
 	})
-}
\ No newline at end of file
+}
+func (s *SubscriptionServiceSuite) TestGetUsageBySubscriptionWithChildCustomers() {
+	parent := &customer.Customer{
+		ID:         "cust_hierarchy_parent",
+		ExternalID: "ext_hierarchy_parent",
+		Name:       "Parent",
+		BaseModel:  types.GetDefaultBaseModel(s.GetContext()),
+	}
+	child := &customer.Customer{
+		ID:               "cust_hierarchy_child",
+		ExternalID:       "ext_hierarchy_child",
+		Name:             "Child",
+		ParentCustomerID: lo.ToPtr(parent.ID),
+		BaseModel:        types.GetDefaultBaseModel(s.GetContext()),
+	}
+	s.NoError(s.GetStores().CustomerRepo.Create(s.GetContext(), parent))
+	s.NoError(s.GetStores().CustomerRepo.Create(s.GetContext(), child))
+
+	sub := &subscription.Subscription{
+		ID:                 "sub_hierarchy",
+		PlanID:             s.testData.plan.ID,
+		CustomerID:         parent.ID,
+		StartDate:          s.testData.now.Add(-30 * 24 * time.Hour),
+		CurrentPeriodStart: s.testData.now.Add(-24 * time.Hour),
+		CurrentPeriodEnd:   s.testData.now.Add(6 * 24 * time.Hour),
+		Currency:           "usd",
+		BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+		BillingPeriodCount: 1,
+		SubscriptionStatus: types.SubscriptionStatusActive,
+		BaseModel:          types.GetDefaultBaseModel(s.GetContext()),
+	}
+	lineItems := []*subscription.SubscriptionLineItem{
+		{
+			ID:               types.GenerateUUIDWithPrefix(types.UUID_PREFIX_SUBSCRIPTION_LINE_ITEM),
+			SubscriptionID:   sub.ID,
+			CustomerID:       sub.CustomerID,
+			EntityID:         s.testData.plan.ID,
+			EntityType:       types.SubscriptionLineItemEntityTypePlan,
+			PlanDisplayName:  s.testData.plan.Name,
+			PriceID:          s.testData.prices.apiCalls.ID,
+			PriceType:        s.testData.prices.apiCalls.Type,
+			MeterID:          s.testData.meters.apiCalls.ID,
+			MeterDisplayName: s.testData.meters.apiCalls.Name,
+			DisplayName:      s.testData.meters.apiCalls.Name,
+			Quantity:         decimal.Zero,
+			Currency:         sub.Currency,
+			BillingPeriod:    sub.BillingPeriod,
+			BaseModel:        types.GetDefaultBaseModel(s.GetContext()),
+		},
+	}
+	s.NoError(s.GetStores().SubscriptionRepo.CreateWithLineItems(s.GetContext(), sub, lineItems))
+
+	eventCounts := map[string]int{parent.ExternalID: 100, child.ExternalID: 200}
+	for externalID, count := range eventCounts {
+		for i := 0; i < count; i++ {
+			s.NoError(s.GetStores().EventRepo.InsertEvent(s.GetContext(), &events.Event{
+				ID:                 s.GetUUID(),
+				TenantID:           sub.TenantID,
+				EventName:          s.testData.meters.apiCalls.EventName,
+				ExternalCustomerID: externalID,
+				Timestamp:          s.testData.now.Add(-1 * time.Hour),
+				Properties:         map[string]interface{}{},
+			}))
+		}
+	}
+
+	s.Run("child_usage_rolls_up_into_parent_subscription", func() {
+		resp, err := s.service.GetUsageBySubscription(s.GetContext(), &dto.GetUsageBySubscriptionRequest{
+			SubscriptionID: sub.ID,
+			StartTime:      s.testData.now.Add(-48 * time.Hour),
+			EndTime:        s.testData.now,
+		})
+		s.NoError(err)
+		s.Len(resp.Charges, 2)
+		s.Equal(6.0, resp.Amount) // 300 * 0.02 within the first tier
+
+		chargesByCustomer := lo.KeyBy(resp.Charges, func(c *dto.SubscriptionUsageByMetersResponse) string {
+			return c.CustomerID
+		})
+		s.Equal(100.0, chargesByCustomer[""].Quantity)
+		s.Equal(200.0, chargesByCustomer[child.ID].Quantity)
+		s.Equal(child.Name, chargesByCustomer[child.ID].CustomerName)
+	})
+
+	s.Run("child_customer_cannot_subscribe", func() {
+		_, err := s.service.CreateSubscription(s.GetContext(), dto.CreateSubscriptionRequest{
+			CustomerID:         child.ID,
+			PlanID:             s.testData.plan.ID,
+			StartDate:          lo.ToPtr(s.testData.now),
+			Currency:           "usd",
+			BillingCadence:     types.BILLING_CADENCE_RECURRING,
+			BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+			BillingPeriodCount: 1,
+		})
+		s.Error(err)
+		s.True(ierr.IsValidation(err))
+	})
+}
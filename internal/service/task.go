@@ -202,6 +202,8 @@ func (s *taskService) ProcessTaskWithStreaming(ctx context.Context, id string) e
 		eventSvc := NewEventService(
 			s.EventRepo,
 			s.MeterRepo,
+			s.EventSchemaRepo,
+			s.RejectedEventRepo,
 			s.EventPublisher,
 			s.Logger,
 			s.Config,
@@ -480,15 +482,16 @@ func (p *EventsChunkProcessor) processBatch(ctx context.Context, batch []*dto.In
 	}
 
 	// Use bulk API for better performance
-	if err := p.eventService.BulkCreateEvents(ctx, bulkRequest); err != nil {
+	resp, err := p.eventService.BulkCreateEvents(ctx, bulkRequest)
+	if err != nil {
 		p.logger.Errorw("bulk event creation failed",
 			"batch_size", len(batch),
 			"error", err)
 		return 0, len(batch) // All events in batch failed
 	}
 
-	// All events in batch were successful
-	return len(batch), 0
+	// Events rejected by their event schema count as failed
+	return resp.Accepted, resp.Rejected
 }
 
 // CustomersChunkProcessor processes chunks of customer data
@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/flexprice/flexprice/internal/api/dto"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/pubsub"
+	"github.com/flexprice/flexprice/internal/pubsub/kafka"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// usageStreamBufferSize is the number of updates buffered per subscriber, updates are dropped for
+// subscribers that do not keep up since streams refresh the usage totals periodically anyway
+const usageStreamBufferSize = 64
+
+// UsageStreamService fans the feature usage updates published by the feature usage tracking out to the
+// live usage streams of this instance
+type UsageStreamService interface {
+	// Subscribe registers a subscriber for the updates of a customer, scoped to the tenant and environment
+	// of the context and optionally to a set of features. The returned function unsubscribes and closes
+	// the channel.
+	Subscribe(ctx context.Context, externalCustomerID string, featureIDs []string) (<-chan *dto.FeatureUsageUpdate, func(), error)
+}
+
+type usageSubscriber struct {
+	tenantID           string
+	environmentID      string
+	externalCustomerID string
+	featureIDs         map[string]struct{}
+	updates            chan *dto.FeatureUsageUpdate
+}
+
+// matches filters the update down to the items of the subscribed features, returns nil when the update
+// is not meant for the subscriber
+func (sub *usageSubscriber) matches(update *dto.FeatureUsageUpdate) *dto.FeatureUsageUpdate {
+	if update.TenantID != sub.tenantID ||
+		update.EnvironmentID != sub.environmentID ||
+		update.ExternalCustomerID != sub.externalCustomerID {
+		return nil
+	}
+	if len(sub.featureIDs) == 0 {
+		return update
+	}
+
+	items := lo.Filter(update.Items, func(item dto.FeatureUsageUpdateItem, _ int) bool {
+		_, ok := sub.featureIDs[item.FeatureID]
+		return ok
+	})
+	if len(items) == 0 {
+		return nil
+	}
+
+	filtered := *update
+	filtered.Items = items
+	return &filtered
+}
+
+type usageStreamService struct {
+	ServiceParams
+	// newPubSub creates the pubsub the updates are consumed from, on the first subscription
+	newPubSub func() (pubsub.PubSub, error)
+
+	startMu sync.Mutex
+	started bool
+
+	mu          sync.RWMutex
+	subscribers map[string]*usageSubscriber
+}
+
+// NewUsageStreamService creates the usage stream service. The consumer of the usage updates topic is
+// only started on the first subscription, in a consumer group of the instance so that every instance serving
+// streams receives all the updates.
+func NewUsageStreamService(params ServiceParams) UsageStreamService {
+	return newUsageStreamService(params, func() (pubsub.PubSub, error) {
+		return kafka.NewBroadcastPubSubFromConfig(
+			params.Config,
+			params.Logger,
+			params.Config.FeatureUsageTracking.ConsumerGroupUsageUpdates,
+		)
+	})
+}
+
+func newUsageStreamService(params ServiceParams, newPubSub func() (pubsub.PubSub, error)) *usageStreamService {
+	return &usageStreamService{
+		ServiceParams: params,
+		newPubSub:     newPubSub,
+		subscribers:   make(map[string]*usageSubscriber),
+	}
+}
+
+func (s *usageStreamService) Subscribe(ctx context.Context, externalCustomerID string, featureIDs []string) (<-chan *dto.FeatureUsageUpdate, func(), error) {
+	if externalCustomerID == "" {
+		return nil, nil, ierr.NewError("external_customer_id is required").
+			WithHint("Please provide the external customer id to stream the usage of").
+			Mark(ierr.ErrValidation)
+	}
+
+	if err := s.ensureStarted(); err != nil {
+		return nil, nil, err
+	}
+
+	sub := &usageSubscriber{
+		tenantID:           types.GetTenantID(ctx),
+		environmentID:      types.GetEnvironmentID(ctx),
+		externalCustomerID: externalCustomerID,
+		featureIDs:         lo.SliceToMap(featureIDs, func(id string) (string, struct{}) { return id, struct{}{} }),
+		updates:            make(chan *dto.FeatureUsageUpdate, usageStreamBufferSize),
+	}
+	id := watermill.NewShortUUID()
+
+	s.mu.Lock()
+	s.subscribers[id] = sub
+	s.mu.Unlock()
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			s.mu.Lock()
+			delete(s.subscribers, id)
+			s.mu.Unlock()
+			close(sub.updates)
+		})
+	}
+
+	return sub.updates, unsubscribe, nil
+}
+
+// ensureStarted starts consuming the usage updates, a failed start is retried on the next subscription
+func (s *usageStreamService) ensureStarted() error {
+	s.startMu.Lock()
+	defer s.startMu.Unlock()
+
+	if s.started {
+		return nil
+	}
+	if err := s.start(); err != nil {
+		return err
+	}
+	s.started = true
+	return nil
+}
+
+func (s *usageStreamService) start() error {
+	pubSub, err := s.newPubSub()
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Live usage streaming is not available").
+			Mark(ierr.ErrSystem)
+	}
+
+	messages, err := pubSub.Subscribe(context.Background(), s.Config.FeatureUsageTracking.TopicUsageUpdates)
+	if err != nil {
+		pubSub.Close()
+		return ierr.WithError(err).
+			WithHint("Live usage streaming is not available").
+			Mark(ierr.ErrSystem)
+	}
+
+	go func() {
+		for msg := range messages {
+			var update dto.FeatureUsageUpdate
+			if err := json.Unmarshal(msg.Payload, &update); err != nil {
+				s.Logger.Errorw("failed to unmarshal feature usage update",
+					"message_uuid", msg.UUID,
+					"error", err)
+				msg.Ack()
+				continue
+			}
+			update.TenantID = msg.Metadata.Get("tenant_id")
+			update.EnvironmentID = msg.Metadata.Get("environment_id")
+
+			s.broadcast(&update)
+			msg.Ack()
+		}
+	}()
+
+	return nil
+}
+
+func (s *usageStreamService) broadcast(update *dto.FeatureUsageUpdate) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, sub := range s.subscribers {
+		filtered := sub.matches(update)
+		if filtered == nil {
+			continue
+		}
+
+		select {
+		case sub.updates <- filtered:
+		default:
+			s.Logger.Debugw("dropping feature usage update for slow usage stream",
+				"event_id", update.EventID,
+				"external_customer_id", update.ExternalCustomerID)
+		}
+	}
+}
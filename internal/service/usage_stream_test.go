@@ -0,0 +1,71 @@
+package service
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/config"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/pubsub"
+	"github.com/flexprice/flexprice/internal/pubsub/memory"
+	"github.com/flexprice/flexprice/internal/testutil"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsageStreamSubscribe(t *testing.T) {
+	ctx := testutil.SetupContext()
+	cfg := config.GetDefaultConfig()
+	cfg.FeatureUsageTracking.TopicUsageUpdates = "feature_usage_updates"
+	log := logger.GetLogger()
+
+	memoryPubSub := memory.NewPubSub(cfg, log)
+	s := newUsageStreamService(ServiceParams{Config: cfg, Logger: log}, func() (pubsub.PubSub, error) {
+		return memoryPubSub, nil
+	})
+
+	updates, unsubscribe, err := s.Subscribe(ctx, "customer-1", []string{"feat_api"})
+	require.NoError(t, err)
+	defer unsubscribe()
+
+	publish := func(tenantID, externalCustomerID string, featureIDs ...string) {
+		update := dto.FeatureUsageUpdate{EventID: watermill.NewShortUUID(), ExternalCustomerID: externalCustomerID}
+		for _, featureID := range featureIDs {
+			update.Items = append(update.Items, dto.FeatureUsageUpdateItem{FeatureID: featureID, Quantity: decimal.NewFromInt(1)})
+		}
+		payload, err := json.Marshal(update)
+		require.NoError(t, err)
+
+		msg := message.NewMessage(watermill.NewUUID(), payload)
+		msg.Metadata.Set("tenant_id", tenantID)
+		msg.Metadata.Set("environment_id", types.GetEnvironmentID(ctx))
+		require.NoError(t, memoryPubSub.Publish(ctx, cfg.FeatureUsageTracking.TopicUsageUpdates, msg))
+	}
+
+	// other tenants, customers and features are filtered out
+	publish("tenant_other", "customer-1", "feat_api")
+	publish(types.GetTenantID(ctx), "customer-2", "feat_api")
+	publish(types.GetTenantID(ctx), "customer-1", "feat_storage")
+	publish(types.GetTenantID(ctx), "customer-1", "feat_storage", "feat_api")
+
+	select {
+	case update := <-updates:
+		assert.Equal(t, "customer-1", update.ExternalCustomerID)
+		require.Len(t, update.Items, 1)
+		assert.Equal(t, "feat_api", update.Items[0].FeatureID)
+	case <-time.After(5 * time.Second):
+		t.Fatal("no usage update received")
+	}
+
+	select {
+	case update := <-updates:
+		t.Fatalf("unexpected usage update %s", update.EventID)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
@@ -5,12 +5,16 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/flexprice/flexprice/internal/api/dto"
 	"github.com/flexprice/flexprice/internal/domain/subscription"
 	"github.com/flexprice/flexprice/internal/domain/wallet"
 	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/idempotency"
+	"github.com/flexprice/flexprice/internal/temporal/models"
+	temporalservice "github.com/flexprice/flexprice/internal/temporal/service"
 	"github.com/flexprice/flexprice/internal/types"
 	webhookDto "github.com/flexprice/flexprice/internal/webhook/dto"
 	"github.com/samber/lo"
@@ -34,6 +38,9 @@ type WalletService interface {
 	// TopUpWallet adds credits to a wallet
 	TopUpWallet(ctx context.Context, walletID string, req *dto.TopUpWalletRequest) (*dto.WalletResponse, error)
 
+	// TransferWallet moves credits from a wallet to another wallet
+	TransferWallet(ctx context.Context, walletID string, req *dto.TransferWalletRequest) (*dto.WalletTransferResponse, error)
+
 	// GetWalletTransactionByID retrieves a transaction by its ID
 	GetWalletTransactionByID(ctx context.Context, transactionID string) (*dto.WalletTransactionResponse, error)
 
@@ -74,11 +81,15 @@ type WalletService interface {
 	// PublishEvent publishes a webhook event for a wallet
 	PublishEvent(ctx context.Context, eventName string, w *wallet.Wallet) error
 
-	// CheckBalanceThresholds checks if wallet balance is below threshold and triggers alerts
+	// CheckBalanceThresholds checks if wallet balance is below threshold and triggers alerts and auto top-ups
 	CheckBalanceThresholds(ctx context.Context, w *wallet.Wallet, balance *dto.WalletBalanceResponse) error
 
 	// TopUpWalletForProratedCharge tops up a wallet for proration credits from subscription changes
 	TopUpWalletForProratedCharge(ctx context.Context, customerID string, amount decimal.Decimal, currency string) error
+
+	// ProcessAutoTopup charges the saved card of the customer and credits the wallet when the credit
+	// balance is below the auto top-up minimum, it is the activity of the wallet auto top-up workflow
+	ProcessAutoTopup(ctx context.Context, walletID string, creditBalance decimal.Decimal) error
 }
 
 type walletService struct {
@@ -347,6 +358,214 @@ func (s *walletService) TopUpWallet(ctx context.Context, walletID string, req *d
 	return s.GetWalletByID(ctx, walletID)
 }
 
+// TransferWallet atomically debits credits from a wallet and credits the same currency amount to another
+// wallet, converted using the conversion rate of each wallet. The credit lots consumed from the source wallet
+// are carried over with their expiry dates, so transferred credits expire as they would have in the source wallet.
+func (s *walletService) TransferWallet(ctx context.Context, walletID string, req *dto.TransferWalletRequest) (*dto.WalletTransferResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	if walletID == req.ToWalletID {
+		return nil, ierr.NewError("cannot transfer credits to the same wallet").
+			WithHint("Source and destination wallets must be different").
+			WithReportableDetails(map[string]interface{}{
+				"wallet_id": walletID,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	from, err := s.WalletRepo.GetWalletByID(ctx, walletID)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := s.WalletRepo.GetWalletByID(ctx, req.ToWalletID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, w := range []*wallet.Wallet{from, to} {
+		if w.WalletStatus != types.WalletStatusActive {
+			return nil, ierr.NewError("wallet is not active").
+				WithHint("Credits can only be transferred between active wallets").
+				WithReportableDetails(map[string]interface{}{
+					"wallet_id":     w.ID,
+					"wallet_status": w.WalletStatus,
+				}).
+				Mark(ierr.ErrInvalidOperation)
+		}
+	}
+
+	if !strings.EqualFold(from.Currency, to.Currency) {
+		return nil, ierr.NewError("wallet currencies do not match").
+			WithHint("Credits can only be transferred between wallets of the same currency").
+			WithReportableDetails(map[string]interface{}{
+				"from_currency": from.Currency,
+				"to_currency":   to.Currency,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	transferID := types.GenerateUUIDWithPrefix(types.UUID_PREFIX_WALLET_TRANSFER)
+	transferMetadata := func(key, value string) types.Metadata {
+		metadata := types.Metadata{}
+		for k, v := range req.Metadata {
+			metadata[k] = v
+		}
+		metadata[key] = value
+		return metadata
+	}
+
+	var debitTx *wallet.Transaction
+	var creditTxs []*wallet.Transaction
+	var fromCreditBalance decimal.Decimal
+
+	err = s.DB.WithTx(ctx, func(ctx context.Context) error {
+		credits, err := s.WalletRepo.FindEligibleCredits(ctx, from.ID, req.CreditsToTransfer, 100)
+		if err != nil {
+			return err
+		}
+
+		// Group the consumed credits by expiry date, following the order in which they are consumed
+		type creditLot struct {
+			expiryDate *time.Time
+			credits    decimal.Decimal
+		}
+		var lots []*creditLot
+		remaining := req.CreditsToTransfer
+		for _, c := range credits {
+			if remaining.IsZero() {
+				break
+			}
+
+			consumed := decimal.Min(remaining, c.CreditsAvailable)
+			remaining = remaining.Sub(consumed)
+
+			lot, found := lo.Find(lots, func(l *creditLot) bool {
+				if l.expiryDate == nil || c.ExpiryDate == nil {
+					return l.expiryDate == c.ExpiryDate
+				}
+				return l.expiryDate.Equal(*c.ExpiryDate)
+			})
+			if found {
+				lot.credits = lot.credits.Add(consumed)
+			} else {
+				lots = append(lots, &creditLot{expiryDate: c.ExpiryDate, credits: consumed})
+			}
+		}
+
+		if remaining.GreaterThan(decimal.Zero) {
+			return ierr.NewError("insufficient balance").
+				WithHint("Insufficient balance to process transfer").
+				WithReportableDetails(map[string]interface{}{
+					"wallet_id": from.ID,
+					"amount":    req.CreditsToTransfer,
+				}).
+				Mark(ierr.ErrInvalidOperation)
+		}
+
+		if err := s.WalletRepo.ConsumeCredits(ctx, credits, req.CreditsToTransfer); err != nil {
+			return err
+		}
+
+		fromCreditBalance = from.CreditBalance.Sub(req.CreditsToTransfer)
+		debitTx = &wallet.Transaction{
+			ID:                  types.GenerateUUIDWithPrefix(types.UUID_PREFIX_WALLET_TRANSACTION),
+			WalletID:            from.ID,
+			Type:                types.TransactionTypeDebit,
+			Amount:              s.GetCurrencyAmountFromCredits(req.CreditsToTransfer, from.ConversionRate),
+			CreditAmount:        req.CreditsToTransfer,
+			ReferenceType:       types.WalletTxReferenceTypeTransfer,
+			ReferenceID:         transferID,
+			Description:         req.Description,
+			Metadata:            transferMetadata("to_wallet_id", to.ID),
+			TxStatus:            types.TransactionStatusCompleted,
+			TransactionReason:   types.TransactionReasonWalletTransfer,
+			CreditBalanceBefore: from.CreditBalance,
+			CreditBalanceAfter:  fromCreditBalance,
+			CreditsAvailable:    decimal.Zero,
+			IdempotencyKey:      lo.FromPtrOr(req.IdempotencyKey, transferID),
+			EnvironmentID:       types.GetEnvironmentID(ctx),
+			BaseModel:           types.GetDefaultBaseModel(ctx),
+		}
+
+		if err := s.WalletRepo.CreateTransaction(ctx, debitTx); err != nil {
+			return err
+		}
+
+		if err := s.WalletRepo.UpdateWalletBalance(ctx, from.ID,
+			s.GetCurrencyAmountFromCredits(fromCreditBalance, from.ConversionRate),
+			fromCreditBalance,
+		); err != nil {
+			return err
+		}
+
+		toCreditBalance := to.CreditBalance
+		for _, lot := range lots {
+			amount := s.GetCurrencyAmountFromCredits(lot.credits, from.ConversionRate)
+			creditAmount := s.GetCreditsFromCurrencyAmount(amount, to.ConversionRate)
+
+			creditTx := &wallet.Transaction{
+				ID:                  types.GenerateUUIDWithPrefix(types.UUID_PREFIX_WALLET_TRANSACTION),
+				WalletID:            to.ID,
+				Type:                types.TransactionTypeCredit,
+				Amount:              amount,
+				CreditAmount:        creditAmount,
+				ReferenceType:       types.WalletTxReferenceTypeTransfer,
+				ReferenceID:         transferID,
+				Description:         req.Description,
+				Metadata:            transferMetadata("from_wallet_id", from.ID),
+				TxStatus:            types.TransactionStatusCompleted,
+				TransactionReason:   types.TransactionReasonWalletTransfer,
+				ExpiryDate:          lot.expiryDate,
+				CreditBalanceBefore: toCreditBalance,
+				CreditBalanceAfter:  toCreditBalance.Add(creditAmount),
+				CreditsAvailable:    creditAmount,
+				EnvironmentID:       types.GetEnvironmentID(ctx),
+				BaseModel:           types.GetDefaultBaseModel(ctx),
+			}
+
+			if err := s.WalletRepo.CreateTransaction(ctx, creditTx); err != nil {
+				return err
+			}
+
+			toCreditBalance = creditTx.CreditBalanceAfter
+			creditTxs = append(creditTxs, creditTx)
+		}
+
+		return s.WalletRepo.UpdateWalletBalance(ctx, to.ID,
+			s.GetCurrencyAmountFromCredits(toCreditBalance, to.ConversionRate),
+			toCreditBalance,
+		)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishInternalTransactionWebhookEvent(ctx, types.WebhookEventWalletTransactionCreated, debitTx.ID)
+	for _, tx := range creditTxs {
+		s.publishInternalTransactionWebhookEvent(ctx, types.WebhookEventWalletTransactionCreated, tx.ID)
+	}
+
+	if err := s.triggerAutoTopup(ctx, from, fromCreditBalance); err != nil {
+		// Log error but don't fail the transfer
+		s.Logger.Errorw("failed to auto top-up wallet",
+			"error", err,
+			"wallet_id", from.ID,
+			"new_credit_balance", fromCreditBalance,
+		)
+	}
+
+	return &dto.WalletTransferResponse{
+		TransferID:       transferID,
+		DebitTransaction: dto.FromWalletTransaction(debitTx),
+		CreditTransactions: lo.Map(creditTxs, func(tx *wallet.Transaction, _ int) *dto.WalletTransactionResponse {
+			return dto.FromWalletTransaction(tx)
+		}),
+	}, nil
+}
+
 func (s *walletService) handlePurchasedCreditInvoicedTransaction(ctx context.Context, walletID string, idempotencyKey *string, req *dto.TopUpWalletRequest) (string, error) {
 	// Initialize required services
 	invoiceService := NewInvoiceService(s.ServiceParams)
@@ -668,9 +887,92 @@ func (s *walletService) DebitWallet(ctx context.Context, req *wallet.WalletOpera
 		req.ReferenceID = types.GenerateUUIDWithPrefix(types.UUID_PREFIX_WALLET_TRANSACTION)
 	}
 
+	w, err := s.WalletRepo.GetWalletByID(ctx, req.WalletID)
+	if err != nil {
+		return err
+	}
+
+	if w.Config.DrawFromParentWallet {
+		return s.debitWalletWithParentWallet(ctx, w, req)
+	}
+
 	return s.processWalletOperation(ctx, req)
 }
 
+// debitWalletWithParentWallet debits the wallet of a child customer and draws the credits the
+// wallet is short of from the active wallet of the parent customer in the same currency
+func (s *walletService) debitWalletWithParentWallet(ctx context.Context, w *wallet.Wallet, req *wallet.WalletOperation) error {
+	if err := s.validateWalletOperation(w, req); err != nil {
+		return err
+	}
+
+	credits, err := s.WalletRepo.FindEligibleCredits(ctx, w.ID, req.CreditAmount, 100)
+	if err != nil {
+		return err
+	}
+
+	available := decimal.Zero
+	for _, c := range credits {
+		available = available.Add(c.CreditsAvailable)
+	}
+
+	if available.GreaterThanOrEqual(req.CreditAmount) {
+		return s.processWalletOperation(ctx, req)
+	}
+
+	cust, err := s.CustomerRepo.Get(ctx, w.CustomerID)
+	if err != nil {
+		return err
+	}
+
+	if !cust.IsChild() {
+		return s.processWalletOperation(ctx, req)
+	}
+
+	parentWallets, err := s.WalletRepo.GetWalletsByCustomerID(ctx, *cust.ParentCustomerID)
+	if err != nil {
+		return err
+	}
+
+	parentWallet, found := lo.Find(parentWallets, func(pw *wallet.Wallet) bool {
+		return pw.WalletStatus == types.WalletStatusActive && types.IsMatchingCurrency(pw.Currency, w.Currency)
+	})
+	if !found {
+		return s.processWalletOperation(ctx, req)
+	}
+
+	shortfall := s.GetCurrencyAmountFromCredits(req.CreditAmount.Sub(available), w.ConversionRate)
+
+	s.Logger.Infow("drawing wallet shortfall from parent wallet",
+		"wallet_id", w.ID,
+		"parent_wallet_id", parentWallet.ID,
+		"shortfall", shortfall)
+
+	return s.DB.WithTx(ctx, func(ctx context.Context) error {
+		if available.IsPositive() {
+			childReq := *req
+			childReq.Amount = decimal.Zero
+			childReq.CreditAmount = available
+			if err := s.processWalletOperation(ctx, &childReq); err != nil {
+				return err
+			}
+		}
+
+		parentReq := *req
+		parentReq.WalletID = parentWallet.ID
+		parentReq.Amount = shortfall
+		parentReq.CreditAmount = decimal.Zero
+		parentReq.Metadata = lo.Assign(req.Metadata, types.Metadata{
+			"child_wallet_id":   w.ID,
+			"child_customer_id": cust.ID,
+		})
+		if req.IdempotencyKey != "" {
+			parentReq.IdempotencyKey = req.IdempotencyKey + "_parent"
+		}
+		return s.processWalletOperation(ctx, &parentReq)
+	})
+}
+
 // CreditWallet processes a credit operation on a wallet
 func (s *walletService) CreditWallet(ctx context.Context, req *wallet.WalletOperation) error {
 	if req.Type != types.TransactionTypeCredit {
@@ -892,6 +1194,18 @@ func (s *walletService) processWalletOperation(ctx context.Context, req *wallet.
 			"alert_status", alertStatus,
 		)
 	}
+
+	// Trigger auto top-up if the debit dropped the credit balance below the configured minimum
+	if req.Type == types.TransactionTypeDebit {
+		if err := s.triggerAutoTopup(ctx, w, newCreditBalance); err != nil {
+			// Log error but don't fail the debit
+			s.Logger.Errorw("failed to auto top-up wallet",
+				"error", err,
+				"wallet_id", w.ID,
+				"new_credit_balance", newCreditBalance,
+			)
+		}
+	}
 	return nil
 }
 
@@ -1224,7 +1538,16 @@ func getAlertType(eventName string) string {
 }
 
 // CheckBalanceThresholds checks if wallet balance is below threshold and triggers alerts
+// and auto top-ups
 func (s *walletService) CheckBalanceThresholds(ctx context.Context, w *wallet.Wallet, balance *dto.WalletBalanceResponse) error {
+	// Auto top-up does not depend on the alert config
+	if err := s.triggerAutoTopup(ctx, w, lo.FromPtrOr(balance.RealTimeCreditBalance, w.CreditBalance)); err != nil {
+		s.Logger.Errorw("failed to auto top-up wallet",
+			"wallet_id", w.ID,
+			"error", err,
+		)
+	}
+
 	// Skip if alerts not enabled or no config
 	if !w.AlertEnabled || w.AlertConfig == nil || w.AlertConfig.Threshold == nil {
 		return nil
@@ -1341,6 +1664,319 @@ func (s *walletService) CheckBalanceThresholds(ctx context.Context, w *wallet.Wa
 	return nil
 }
 
+// autoTopupRetryInterval is the minimum time between two charges of the same auto top-up invoice, so a
+// declined card is not charged again on every debit while the balance stays below the minimum
+const autoTopupRetryInterval = time.Hour
+
+// needsAutoTopup returns true if the wallet has auto top-up enabled and the credit balance is below the minimum
+func needsAutoTopup(w *wallet.Wallet, creditBalance decimal.Decimal) bool {
+	return w.AutoTopupTrigger == types.AutoTopupTriggerBalanceBelowThreshold &&
+		w.WalletStatus == types.WalletStatusActive &&
+		w.AutoTopupAmount.GreaterThan(decimal.Zero) &&
+		creditBalance.LessThan(w.AutoTopupMinBalance)
+}
+
+// triggerAutoTopup starts the auto top-up workflow of the wallet when the credit balance is below the
+// configured minimum. The charge runs in the workflow, outside of the debit path and its transaction.
+func (s *walletService) triggerAutoTopup(ctx context.Context, w *wallet.Wallet, creditBalance decimal.Decimal) error {
+	if !needsAutoTopup(w, creditBalance) {
+		return nil
+	}
+
+	temporalSvc := temporalservice.GetGlobalTemporalService()
+	if temporalSvc == nil {
+		s.Logger.Warnw("temporal service not available for wallet auto top-up",
+			"wallet_id", w.ID)
+		return nil
+	}
+
+	input := models.WalletAutoTopupWorkflowInput{
+		WalletID:      w.ID,
+		CreditBalance: creditBalance,
+		TenantID:      types.GetTenantID(ctx),
+		EnvironmentID: types.GetEnvironmentID(ctx),
+		UserID:        types.GetUserID(ctx),
+	}
+	if err := input.Validate(); err != nil {
+		return err
+	}
+
+	// the workflow id is derived from the wallet so a trigger while a top-up runs is a no-op
+	workflowRun, err := temporalSvc.StartWorkflow(ctx, models.StartWorkflowOptions{
+		ID:        types.TemporalWalletAutoTopupWorkflow.WorkflowID(w.ID),
+		TaskQueue: types.TemporalWalletAutoTopupWorkflow.TaskQueueName(),
+	}, types.TemporalWalletAutoTopupWorkflow, input)
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to start the wallet auto top-up workflow").
+			WithReportableDetails(map[string]interface{}{
+				"wallet_id": w.ID,
+			}).
+			Mark(ierr.ErrSystem)
+	}
+
+	s.Logger.Infow("wallet auto top-up workflow started",
+		"wallet_id", w.ID,
+		"credit_balance", creditBalance,
+		"workflow_id", workflowRun.GetID())
+
+	return nil
+}
+
+// ProcessAutoTopup tops up the wallet with the configured auto top-up amount when the credit balance
+// is below the configured minimum. The wallet is charged by invoicing the credits and paying the invoice
+// with the customer's saved card. One invoice is created per threshold crossing, i.e. until the wallet
+// is credited again, and a declined charge is retried on the same invoice by a later trigger.
+func (s *walletService) ProcessAutoTopup(ctx context.Context, walletID string, creditBalance decimal.Decimal) error {
+	w, err := s.WalletRepo.GetWalletByID(ctx, walletID)
+	if err != nil {
+		return err
+	}
+	if !needsAutoTopup(w, creditBalance) {
+		return nil
+	}
+
+	// The latest credit identifies the threshold crossing as the balance can
+	// only go back above the minimum through a credit
+	filter := types.NewWalletTransactionFilter()
+	filter.WalletID = lo.ToPtr(w.ID)
+	filter.Type = lo.ToPtr(types.TransactionTypeCredit)
+	filter.QueryFilter.Limit = lo.ToPtr(1)
+	credits, err := s.WalletRepo.ListWalletTransactions(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	crossingRef := w.ID
+	if len(credits) > 0 {
+		crossingRef = credits[0].ID
+	}
+
+	idempotencyKey := idempotency.NewGenerator().GenerateKey(idempotency.ScopeWalletAutoTopup, map[string]interface{}{
+		"wallet_id":    w.ID,
+		"crossing_ref": crossingRef,
+	})
+
+	amount := s.GetCurrencyAmountFromCredits(w.AutoTopupAmount, w.ConversionRate)
+	topupInfo := &webhookDto.WalletAutoTopupInfo{
+		MinBalance:    w.AutoTopupMinBalance,
+		CreditBalance: creditBalance,
+		CreditsToAdd:  w.AutoTopupAmount,
+		Amount:        amount,
+		Currency:      w.Currency,
+	}
+
+	invoiceID, err := s.getOrCreateAutoTopupInvoice(ctx, w, amount, idempotencyKey)
+	if err != nil {
+		topupInfo.Error = err.Error()
+		s.publishAutoTopupWebhookEvent(ctx, types.WebhookEventWalletAutoTopupFailed, w, topupInfo)
+		return err
+	}
+	topupInfo.InvoiceID = invoiceID
+
+	payments, err := s.PaymentRepo.List(ctx, &types.PaymentFilter{
+		QueryFilter:     types.NewNoLimitQueryFilter(),
+		DestinationType: lo.ToPtr(string(types.PaymentDestinationTypeInvoice)),
+		DestinationID:   lo.ToPtr(invoiceID),
+	})
+	if err != nil {
+		return err
+	}
+
+	// A succeeded charge whose credit did not go through is only credited
+	var lastFailedAt *time.Time
+	for _, p := range payments {
+		switch p.PaymentStatus {
+		case types.PaymentStatusSucceeded:
+			topupInfo.PaymentID = p.ID
+			return s.creditAutoTopup(ctx, w, invoiceID, p.ID, topupInfo)
+		case types.PaymentStatusFailed:
+			if p.FailedAt != nil && (lastFailedAt == nil || p.FailedAt.After(*lastFailedAt)) {
+				lastFailedAt = p.FailedAt
+			}
+		default:
+			// A charge which is still in progress after autoTopupRetryInterval, e.g. the process crashed
+			// while charging or the gateway webhook never arrived, no longer blocks the top-up
+			if time.Since(p.UpdatedAt) < autoTopupRetryInterval {
+				s.Logger.Debugw("auto top-up charge already in progress",
+					"wallet_id", w.ID,
+					"invoice_id", invoiceID,
+					"payment_id", p.ID)
+				return nil
+			}
+			s.Logger.Warnw("auto top-up charge is stuck in progress, charging again",
+				"wallet_id", w.ID,
+				"invoice_id", invoiceID,
+				"payment_id", p.ID,
+				"payment_status", p.PaymentStatus,
+				"updated_at", p.UpdatedAt)
+		}
+	}
+
+	if lastFailedAt != nil && time.Since(*lastFailedAt) < autoTopupRetryInterval {
+		s.Logger.Debugw("auto top-up charge failed recently, skipping retry",
+			"wallet_id", w.ID,
+			"invoice_id", invoiceID,
+			"failed_at", lastFailedAt)
+		return nil
+	}
+
+	s.Logger.Infow("charging wallet auto top-up",
+		"wallet_id", w.ID,
+		"invoice_id", invoiceID,
+		"credit_balance", creditBalance,
+		"min_balance", w.AutoTopupMinBalance,
+		"credits_to_add", w.AutoTopupAmount,
+		"attempt", len(payments)+1,
+	)
+
+	// Charge the saved payment method of the customer, every attempt on the invoice has its own key
+	paymentService := NewPaymentService(s.ServiceParams)
+	payment, err := paymentService.CreatePayment(ctx, &dto.CreatePaymentRequest{
+		IdempotencyKey: idempotency.NewGenerator().GenerateKey(idempotency.ScopeWalletAutoTopup, map[string]interface{}{
+			"invoice_id": invoiceID,
+			"attempt":    len(payments) + 1,
+		}),
+		DestinationType:   types.PaymentDestinationTypeInvoice,
+		DestinationID:     invoiceID,
+		PaymentMethodType: types.PaymentMethodTypeCard,
+		Amount:            amount,
+		Currency:          w.Currency,
+		ProcessPayment:    true,
+		Metadata: types.Metadata{
+			"customer_id": w.CustomerID,
+			"wallet_id":   w.ID,
+		},
+	})
+	if err != nil {
+		// The charge is retried by a later trigger once autoTopupRetryInterval has passed
+		s.Logger.Warnw("wallet auto top-up charge failed",
+			"wallet_id", w.ID,
+			"invoice_id", invoiceID,
+			"error", err)
+		topupInfo.Error = err.Error()
+		s.publishAutoTopupWebhookEvent(ctx, types.WebhookEventWalletAutoTopupFailed, w, topupInfo)
+		return nil
+	}
+	topupInfo.PaymentID = payment.ID
+
+	// A charge completed asynchronously by the gateway is credited by a later trigger once it succeeded
+	if payment.PaymentStatus != types.PaymentStatusSucceeded {
+		s.Logger.Infow("wallet auto top-up charge is pending",
+			"wallet_id", w.ID,
+			"invoice_id", invoiceID,
+			"payment_id", payment.ID,
+			"payment_status", payment.PaymentStatus)
+		return nil
+	}
+
+	return s.creditAutoTopup(ctx, w, invoiceID, payment.ID, topupInfo)
+}
+
+// getOrCreateAutoTopupInvoice returns the id of the auto top-up invoice of the threshold crossing
+func (s *walletService) getOrCreateAutoTopupInvoice(ctx context.Context, w *wallet.Wallet, amount decimal.Decimal, idempotencyKey string) (string, error) {
+	existing, err := s.InvoiceRepo.GetByIdempotencyKey(ctx, idempotencyKey)
+	if err != nil && !ierr.IsNotFound(err) {
+		return "", err
+	}
+	if existing != nil {
+		return existing.ID, nil
+	}
+
+	invoiceService := NewInvoiceService(s.ServiceParams)
+	inv, err := invoiceService.CreateInvoice(ctx, dto.CreateInvoiceRequest{
+		CustomerID:     w.CustomerID,
+		AmountDue:      amount,
+		Subtotal:       amount,
+		Total:          amount,
+		Currency:       w.Currency,
+		InvoiceType:    types.InvoiceTypeCredit,
+		DueDate:        lo.ToPtr(time.Now().UTC()),
+		IdempotencyKey: lo.ToPtr(idempotencyKey),
+		InvoiceStatus:  lo.ToPtr(types.InvoiceStatusFinalized),
+		LineItems: []dto.CreateInvoiceLineItemRequest{
+			{
+				Amount:      amount,
+				Quantity:    decimal.NewFromInt(1),
+				DisplayName: lo.ToPtr("Purchased Credits (Auto Top-up)"),
+			},
+		},
+		PaymentStatus: lo.ToPtr(types.PaymentStatusPending),
+		Metadata: types.Metadata{
+			"wallet_id":  w.ID,
+			"auto_topup": "true",
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	return inv.ID, nil
+}
+
+// creditAutoTopup credits the wallet for the succeeded auto top-up charge, once per payment
+func (s *walletService) creditAutoTopup(ctx context.Context, w *wallet.Wallet, invoiceID, paymentID string, topupInfo *webhookDto.WalletAutoTopupInfo) error {
+	filter := types.NewWalletTransactionFilter()
+	filter.WalletID = lo.ToPtr(w.ID)
+	filter.ReferenceType = lo.ToPtr(string(types.WalletTxReferenceTypePayment))
+	filter.ReferenceID = lo.ToPtr(paymentID)
+	filter.QueryFilter.Limit = lo.ToPtr(1)
+	credited, err := s.WalletRepo.ListWalletTransactions(ctx, filter)
+	if err != nil {
+		return err
+	}
+	if len(credited) > 0 {
+		return nil
+	}
+
+	if err := s.CreditWallet(ctx, &wallet.WalletOperation{
+		WalletID:          w.ID,
+		Type:              types.TransactionTypeCredit,
+		CreditAmount:      w.AutoTopupAmount,
+		Description:       "Auto top-up",
+		TransactionReason: types.TransactionReasonPurchasedCreditInvoiced,
+		ReferenceType:     types.WalletTxReferenceTypePayment,
+		ReferenceID:       paymentID,
+		IdempotencyKey:    paymentID,
+		Metadata: types.Metadata{
+			"auto_topup": "true",
+			"invoice_id": invoiceID,
+		},
+	}); err != nil {
+		topupInfo.Error = err.Error()
+		s.publishAutoTopupWebhookEvent(ctx, types.WebhookEventWalletAutoTopupFailed, w, topupInfo)
+		return err
+	}
+
+	s.publishAutoTopupWebhookEvent(ctx, types.WebhookEventWalletAutoTopupSucceeded, w, topupInfo)
+	return nil
+}
+
+func (s *walletService) publishAutoTopupWebhookEvent(ctx context.Context, eventName string, w *wallet.Wallet, info *webhookDto.WalletAutoTopupInfo) {
+	webhookPayload, err := json.Marshal(webhookDto.InternalWalletEvent{
+		EventType: eventName,
+		WalletID:  w.ID,
+		TenantID:  types.GetTenantID(ctx),
+		AutoTopup: info,
+	})
+	if err != nil {
+		s.Logger.Errorw("failed to marshal webhook payload", "error", err)
+		return
+	}
+
+	webhookEvent := &types.WebhookEvent{
+		ID:            types.GenerateUUIDWithPrefix(types.UUID_PREFIX_WEBHOOK_EVENT),
+		EventName:     eventName,
+		TenantID:      types.GetTenantID(ctx),
+		EnvironmentID: types.GetEnvironmentID(ctx),
+		UserID:        types.GetUserID(ctx),
+		Timestamp:     time.Now().UTC(),
+		Payload:       json.RawMessage(webhookPayload),
+	}
+	if err := s.WebhookPublisher.PublishWebhook(ctx, webhookEvent); err != nil {
+		s.Logger.Errorf("failed to publish %s event: %v", webhookEvent.EventName, err)
+	}
+}
+
 func (s *walletService) TopUpWalletForProratedCharge(ctx context.Context, customerID string, amount decimal.Decimal, currency string) error {
 	if customerID == "" {
 		return ierr.NewError("customer_id is required").
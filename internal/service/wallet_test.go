@@ -3,6 +3,7 @@ package service
 import (
 	"fmt"
 	"sort"
+	"strconv"
 	"testing"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/flexprice/flexprice/internal/domain/feature"
 	"github.com/flexprice/flexprice/internal/domain/invoice"
 	"github.com/flexprice/flexprice/internal/domain/meter"
+	"github.com/flexprice/flexprice/internal/domain/payment"
 	"github.com/flexprice/flexprice/internal/domain/plan"
 	"github.com/flexprice/flexprice/internal/domain/price"
 	"github.com/flexprice/flexprice/internal/domain/subscription"
@@ -68,23 +70,25 @@ func (s *WalletServiceSuite) TearDownTest() {
 func (s *WalletServiceSuite) setupService() {
 	stores := s.GetStores()
 	s.service = NewWalletService(ServiceParams{
-		Logger:           s.GetLogger(),
-		Config:           s.GetConfig(),
-		DB:               s.GetDB(),
-		WalletRepo:       stores.WalletRepo,
-		SubRepo:          stores.SubscriptionRepo,
-		PlanRepo:         stores.PlanRepo,
-		PriceRepo:        stores.PriceRepo,
-		EventRepo:        stores.EventRepo,
-		MeterRepo:        stores.MeterRepo,
-		CustomerRepo:     stores.CustomerRepo,
-		InvoiceRepo:      stores.InvoiceRepo,
-		EntitlementRepo:  stores.EntitlementRepo,
-		FeatureRepo:      stores.FeatureRepo,
-		SettingsRepo:     stores.SettingsRepo,
-		AlertLogsRepo:    s.GetStores().AlertLogsRepo,
-		EventPublisher:   s.GetPublisher(),
-		WebhookPublisher: s.GetWebhookPublisher(),
+		Logger:             s.GetLogger(),
+		Config:             s.GetConfig(),
+		DB:                 s.GetDB(),
+		WalletRepo:         stores.WalletRepo,
+		SubRepo:            stores.SubscriptionRepo,
+		PlanRepo:           stores.PlanRepo,
+		PriceRepo:          stores.PriceRepo,
+		EventRepo:          stores.EventRepo,
+		MeterRepo:          stores.MeterRepo,
+		CustomerRepo:       stores.CustomerRepo,
+		InvoiceRepo:        stores.InvoiceRepo,
+		EntitlementRepo:    stores.EntitlementRepo,
+		FeatureRepo:        stores.FeatureRepo,
+		SettingsRepo:       stores.SettingsRepo,
+		AlertLogsRepo:      s.GetStores().AlertLogsRepo,
+		PaymentRepo:        stores.PaymentRepo,
+		EventPublisher:     s.GetPublisher(),
+		WebhookPublisher:   s.GetWebhookPublisher(),
+		IntegrationFactory: s.GetIntegrationFactory(),
 	})
 	s.subsService = NewSubscriptionService(ServiceParams{
 		Logger:                s.GetLogger(),
@@ -1183,6 +1187,227 @@ func (s *WalletServiceSuite) TestDebitWithInsufficientBalance() {
 	s.True(decimal.NewFromInt(100).Equal(walletObj.CreditBalance))
 }
 
+func (s *WalletServiceSuite) TestDebitTriggersAutoTopup() {
+	// Reset the wallet's initial state
+	err := s.GetStores().WalletRepo.UpdateWalletBalance(s.GetContext(), s.testData.wallet.ID, decimal.Zero, decimal.Zero)
+	s.NoError(err)
+
+	err = s.service.CreditWallet(s.GetContext(), &wallet.WalletOperation{
+		WalletID:          s.testData.wallet.ID,
+		Type:              types.TransactionTypeCredit,
+		CreditAmount:      decimal.NewFromInt(1000),
+		Description:       "Initial credit",
+		TransactionReason: types.TransactionReasonFreeCredit,
+	})
+	s.NoError(err)
+
+	_, err = s.service.UpdateWallet(s.GetContext(), s.testData.wallet.ID, &dto.UpdateWalletRequest{
+		AutoTopupTrigger:    lo.ToPtr(types.AutoTopupTriggerBalanceBelowThreshold),
+		AutoTopupMinBalance: lo.ToPtr(decimal.NewFromInt(500)),
+		AutoTopupAmount:     lo.ToPtr(decimal.NewFromInt(200)),
+	})
+	s.NoError(err)
+
+	autoTopupInvoices := func() []*invoice.Invoice {
+		invoices, err := s.GetStores().InvoiceRepo.List(s.GetContext(), types.NewNoLimitInvoiceFilter())
+		s.NoError(err)
+		return lo.Filter(invoices, func(inv *invoice.Invoice, _ int) bool {
+			return inv.Metadata["auto_topup"] == "true"
+		})
+	}
+
+	// Debit above the minimum balance does not trigger a top-up
+	err = s.service.DebitWallet(s.GetContext(), &wallet.WalletOperation{
+		WalletID:          s.testData.wallet.ID,
+		Type:              types.TransactionTypeDebit,
+		CreditAmount:      decimal.NewFromInt(400),
+		TransactionReason: types.TransactionReasonInvoicePayment,
+	})
+	s.NoError(err)
+	s.Empty(autoTopupInvoices())
+
+	// Debit crossing the minimum balance triggers a top-up, the charge runs in the auto top-up
+	// workflow so the debit succeeds without invoicing the top-up
+	err = s.service.DebitWallet(s.GetContext(), &wallet.WalletOperation{
+		WalletID:          s.testData.wallet.ID,
+		Type:              types.TransactionTypeDebit,
+		CreditAmount:      decimal.NewFromInt(200),
+		TransactionReason: types.TransactionReasonInvoicePayment,
+	})
+	s.NoError(err)
+	s.Empty(autoTopupInvoices())
+
+	// The workflow activity invoices the top-up and charges the saved card
+	s.NoError(s.service.ProcessAutoTopup(s.GetContext(), s.testData.wallet.ID, decimal.NewFromInt(400)))
+
+	invoices := autoTopupInvoices()
+	s.Require().Len(invoices, 1)
+	s.Equal(types.InvoiceTypeCredit, invoices[0].InvoiceType)
+	s.True(decimal.NewFromInt(200).Equal(invoices[0].AmountDue))
+
+	// No saved payment method in tests, so the charge fails and the wallet is not credited
+	walletObj, err := s.GetStores().WalletRepo.GetWalletByID(s.GetContext(), s.testData.wallet.ID)
+	s.NoError(err)
+	s.True(decimal.NewFromInt(400).Equal(walletObj.CreditBalance))
+
+	payments, err := s.GetStores().PaymentRepo.List(s.GetContext(), &types.PaymentFilter{
+		QueryFilter:   types.NewNoLimitQueryFilter(),
+		DestinationID: lo.ToPtr(invoices[0].ID),
+	})
+	s.NoError(err)
+	s.Require().Len(payments, 1)
+	s.Equal(types.PaymentStatusFailed, payments[0].PaymentStatus)
+
+	// Triggers within the same threshold crossing reuse the invoice and do not charge the
+	// card again right after the failed charge
+	s.NoError(s.service.ProcessAutoTopup(s.GetContext(), s.testData.wallet.ID, decimal.NewFromInt(300)))
+	s.Len(autoTopupInvoices(), 1)
+
+	payments, err = s.GetStores().PaymentRepo.List(s.GetContext(), &types.PaymentFilter{
+		QueryFilter:   types.NewNoLimitQueryFilter(),
+		DestinationID: lo.ToPtr(invoices[0].ID),
+	})
+	s.NoError(err)
+	s.Len(payments, 1)
+
+	// The failed charge is retried on the same invoice once the retry interval has passed
+	payments[0].FailedAt = lo.ToPtr(time.Now().UTC().Add(-2 * autoTopupRetryInterval))
+	s.NoError(s.GetStores().PaymentRepo.Update(s.GetContext(), payments[0]))
+
+	s.NoError(s.service.ProcessAutoTopup(s.GetContext(), s.testData.wallet.ID, decimal.NewFromInt(300)))
+	s.Len(autoTopupInvoices(), 1)
+
+	payments, err = s.GetStores().PaymentRepo.List(s.GetContext(), &types.PaymentFilter{
+		QueryFilter:   types.NewNoLimitQueryFilter(),
+		DestinationID: lo.ToPtr(invoices[0].ID),
+	})
+	s.NoError(err)
+	s.Require().Len(payments, 2)
+
+	// A charge in progress blocks the top-up until the retry interval has passed
+	retried, ok := lo.Find(payments, func(p *payment.Payment) bool {
+		return p.FailedAt.After(time.Now().UTC().Add(-autoTopupRetryInterval))
+	})
+	s.Require().True(ok)
+	retried.PaymentStatus = types.PaymentStatusProcessing
+	s.NoError(s.GetStores().PaymentRepo.Update(s.GetContext(), retried))
+
+	s.NoError(s.service.ProcessAutoTopup(s.GetContext(), s.testData.wallet.ID, decimal.NewFromInt(300)))
+	payments, err = s.GetStores().PaymentRepo.List(s.GetContext(), &types.PaymentFilter{
+		QueryFilter:   types.NewNoLimitQueryFilter(),
+		DestinationID: lo.ToPtr(invoices[0].ID),
+	})
+	s.NoError(err)
+	s.Len(payments, 2)
+
+	// A charge stuck in progress is charged again once the retry interval has passed
+	retried.UpdatedAt = time.Now().UTC().Add(-2 * autoTopupRetryInterval)
+
+	s.NoError(s.service.ProcessAutoTopup(s.GetContext(), s.testData.wallet.ID, decimal.NewFromInt(300)))
+	payments, err = s.GetStores().PaymentRepo.List(s.GetContext(), &types.PaymentFilter{
+		QueryFilter:   types.NewNoLimitQueryFilter(),
+		DestinationID: lo.ToPtr(invoices[0].ID),
+	})
+	s.NoError(err)
+	s.Len(payments, 3)
+}
+
+func (s *WalletServiceSuite) TestTransferWallet() {
+	// Reset the wallet's initial state
+	err := s.GetStores().WalletRepo.UpdateWalletBalance(s.GetContext(), s.testData.wallet.ID, decimal.Zero, decimal.Zero)
+	s.NoError(err)
+
+	expiryDate := time.Now().UTC().AddDate(0, 1, 0)
+	expiryDateInt, err := strconv.Atoi(expiryDate.Format("20060102"))
+	s.NoError(err)
+
+	s.NoError(s.service.CreditWallet(s.GetContext(), &wallet.WalletOperation{
+		WalletID:          s.testData.wallet.ID,
+		Type:              types.TransactionTypeCredit,
+		CreditAmount:      decimal.NewFromInt(100),
+		TransactionReason: types.TransactionReasonFreeCredit,
+		ExpiryDate:        &expiryDateInt,
+	}))
+	s.NoError(s.service.CreditWallet(s.GetContext(), &wallet.WalletOperation{
+		WalletID:          s.testData.wallet.ID,
+		Type:              types.TransactionTypeCredit,
+		CreditAmount:      decimal.NewFromInt(50),
+		TransactionReason: types.TransactionReasonPurchasedCreditDirect,
+	}))
+
+	// Destination wallet of another customer where 1 credit = 2 usd
+	subAccount := &customer.Customer{
+		ID:         "cust_sub_account",
+		ExternalID: "ext_cust_sub_account",
+		Name:       "Sub Account",
+		BaseModel:  types.GetDefaultBaseModel(s.GetContext()),
+	}
+	s.NoError(s.GetStores().CustomerRepo.Create(s.GetContext(), subAccount))
+
+	toWallet := &wallet.Wallet{
+		ID:             "wallet-sub-account",
+		CustomerID:     subAccount.ID,
+		Currency:       "usd",
+		WalletType:     types.WalletTypePrePaid,
+		ConversionRate: decimal.NewFromInt(2),
+		WalletStatus:   types.WalletStatusActive,
+		BaseModel:      types.GetDefaultBaseModel(s.GetContext()),
+	}
+	s.NoError(s.GetStores().WalletRepo.CreateWallet(s.GetContext(), toWallet))
+
+	s.Run("transfer_more_than_balance", func() {
+		_, err := s.service.TransferWallet(s.GetContext(), s.testData.wallet.ID, &dto.TransferWalletRequest{
+			ToWalletID:        toWallet.ID,
+			CreditsToTransfer: decimal.NewFromInt(200),
+		})
+		s.Error(err)
+		s.True(ierr.IsInvalidOperation(err))
+	})
+
+	s.Run("transfer_to_same_wallet", func() {
+		_, err := s.service.TransferWallet(s.GetContext(), s.testData.wallet.ID, &dto.TransferWalletRequest{
+			ToWalletID:        s.testData.wallet.ID,
+			CreditsToTransfer: decimal.NewFromInt(10),
+		})
+		s.Error(err)
+		s.True(ierr.IsValidation(err))
+	})
+
+	s.Run("transfer_carries_over_expiry_dates", func() {
+		resp, err := s.service.TransferWallet(s.GetContext(), s.testData.wallet.ID, &dto.TransferWalletRequest{
+			ToWalletID:        toWallet.ID,
+			CreditsToTransfer: decimal.NewFromInt(120),
+			IdempotencyKey:    lo.ToPtr("transfer_1"),
+		})
+		s.NoError(err)
+
+		s.Equal(types.TransactionReasonWalletTransfer, resp.DebitTransaction.TransactionReason)
+		s.True(decimal.NewFromInt(120).Equal(resp.DebitTransaction.CreditAmount))
+		s.Equal(resp.TransferID, resp.DebitTransaction.ReferenceID)
+
+		// The expiring credits are consumed first and keep their expiry date
+		s.Len(resp.CreditTransactions, 2)
+		s.True(decimal.NewFromInt(50).Equal(resp.CreditTransactions[0].CreditAmount))
+		s.NotNil(resp.CreditTransactions[0].ExpiryDate)
+		s.Equal(expiryDate.Format("20060102"), resp.CreditTransactions[0].ExpiryDate.Format("20060102"))
+		s.True(decimal.NewFromInt(10).Equal(resp.CreditTransactions[1].CreditAmount))
+		s.Nil(resp.CreditTransactions[1].ExpiryDate)
+		for _, tx := range resp.CreditTransactions {
+			s.Equal(resp.TransferID, tx.ReferenceID)
+			s.Equal(s.testData.wallet.ID, tx.Metadata["from_wallet_id"])
+		}
+
+		fromWallet, err := s.GetStores().WalletRepo.GetWalletByID(s.GetContext(), s.testData.wallet.ID)
+		s.NoError(err)
+		s.True(decimal.NewFromInt(30).Equal(fromWallet.CreditBalance))
+
+		updatedToWallet, err := s.GetStores().WalletRepo.GetWalletByID(s.GetContext(), toWallet.ID)
+		s.NoError(err)
+		s.True(decimal.NewFromInt(60).Equal(updatedToWallet.CreditBalance))
+		s.True(decimal.NewFromInt(120).Equal(updatedToWallet.Balance))
+	})
+}
+
 func (s *WalletServiceSuite) TestDebitWithExpiredCredits() {
 	// Create a credit with expiry date in the past
 	pastDate := 20230101 // January 1st, 2023
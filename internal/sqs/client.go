@@ -0,0 +1,148 @@
+package sqs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsConfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	sqstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/aws/smithy-go"
+	"github.com/cenkalti/backoff/v4"
+	"github.com/flexprice/flexprice/internal/config"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+)
+
+const (
+	// MaxBatchEntries is the maximum number of messages of a SendMessageBatch request
+	MaxBatchEntries = 10
+	// MaxBatchBytes is the maximum total size of the messages of a SendMessageBatch request
+	MaxBatchBytes = 256 * 1024
+
+	// receiveWaitSeconds is the long polling wait of ReceiveMessage requests
+	receiveWaitSeconds = 20
+)
+
+// Client sends to and receives from an SQS queue, or an SQS compatible queue (ElasticMQ, LocalStack)
+// when the endpoint is set
+type Client struct {
+	cfg     *config.SQSConfig
+	sqs     *sqs.Client
+	timeout time.Duration
+}
+
+func NewClient(cfg *config.Configuration) (*Client, error) {
+	sqsConfig := &cfg.SQS
+	if sqsConfig.QueueURL == "" {
+		return nil, fmt.Errorf("sqs queue_url is required to publish events to sqs")
+	}
+
+	options := []func(*awsConfig.LoadOptions) error{
+		awsConfig.WithRegion(sqsConfig.Region),
+	}
+	if sqsConfig.AccessKeyID != "" {
+		options = append(options, awsConfig.WithCredentialsProvider(
+			credentials.NewStaticCredentialsProvider(sqsConfig.AccessKeyID, sqsConfig.SecretAccessKey, ""),
+		))
+	}
+
+	awsCfg, err := awsConfig.LoadDefaultConfig(context.Background(), options...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load AWS SDK config: %w", err)
+	}
+
+	timeout := time.Duration(sqsConfig.TimeoutSeconds) * time.Second
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+
+	return &Client{
+		cfg: sqsConfig,
+		sqs: sqs.NewFromConfig(awsCfg, func(o *sqs.Options) {
+			if sqsConfig.Endpoint != "" {
+				o.BaseEndpoint = aws.String(sqsConfig.Endpoint)
+			}
+		}),
+		timeout: timeout,
+	}, nil
+}
+
+// SendMessageBatch sends up to 10 messages to the queue and returns the entries that were not sent.
+// Throttled requests are retried by the SDK, errors caused by the request are wrapped in
+// backoff.Permanent since retrying cannot succeed.
+func (c *Client) SendMessageBatch(ctx context.Context, entries []sqstypes.SendMessageBatchRequestEntry) ([]sqstypes.BatchResultErrorEntry, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	output, err := c.sqs.SendMessageBatch(ctx, &sqs.SendMessageBatchInput{
+		QueueUrl: aws.String(c.cfg.QueueURL),
+		Entries:  entries,
+	})
+	if err != nil {
+		var apiErr smithy.APIError
+		if errors.As(err, &apiErr) && apiErr.ErrorFault() == smithy.FaultClient {
+			return nil, backoff.Permanent(err)
+		}
+		return nil, err
+	}
+	return output.Failed, nil
+}
+
+// ReceiveMessages long polls the queue for up to 10 messages with their attributes
+func (c *Client) ReceiveMessages(ctx context.Context, queueURL string) ([]sqstypes.Message, error) {
+	output, err := c.sqs.ReceiveMessage(ctx, &sqs.ReceiveMessageInput{
+		QueueUrl:              aws.String(queueURL),
+		MaxNumberOfMessages:   MaxBatchEntries,
+		WaitTimeSeconds:       receiveWaitSeconds,
+		MessageAttributeNames: []string{"All"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return output.Messages, nil
+}
+
+// DeleteMessage removes a handled message from the queue
+func (c *Client) DeleteMessage(ctx context.Context, queueURL string, receiptHandle *string) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	_, err := c.sqs.DeleteMessage(ctx, &sqs.DeleteMessageInput{
+		QueueUrl:      aws.String(queueURL),
+		ReceiptHandle: receiptHandle,
+	})
+	return err
+}
+
+// ReleaseMessage makes a message visible again so that it is redelivered right away
+func (c *Client) ReleaseMessage(ctx context.Context, queueURL string, receiptHandle *string) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	_, err := c.sqs.ChangeMessageVisibility(ctx, &sqs.ChangeMessageVisibilityInput{
+		QueueUrl:          aws.String(queueURL),
+		ReceiptHandle:     receiptHandle,
+		VisibilityTimeout: 0,
+	})
+	return err
+}
+
+// QueueURL returns the url of the queue the client sends to
+func (c *Client) QueueURL() string {
+	return c.cfg.QueueURL
+}
+
+// ToBatchError wraps the failed entries of a batch into an error
+func ToBatchError(failed []sqstypes.BatchResultErrorEntry) error {
+	return ierr.NewErrorf("%d messages were not sent to sqs", len(failed)).
+		WithHint("Failed to send messages to SQS").
+		WithReportableDetails(map[string]interface{}{
+			"code":    aws.ToString(failed[0].Code),
+			"message": aws.ToString(failed[0].Message),
+		}).
+		Mark(ierr.ErrSystem)
+}
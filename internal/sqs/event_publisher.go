@@ -0,0 +1,158 @@
+package sqs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	sqstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/cenkalti/backoff/v4"
+	"github.com/flexprice/flexprice/internal/config"
+	"github.com/flexprice/flexprice/internal/domain/events"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"go.uber.org/zap"
+)
+
+type EventPublisher struct {
+	client *Client
+	config *config.SQSConfig
+	logger *logger.Logger
+}
+
+func NewEventPublisher(client *Client, cfg *config.Configuration, logger *logger.Logger) *EventPublisher {
+	return &EventPublisher{
+		client: client,
+		config: &cfg.SQS,
+		logger: logger,
+	}
+}
+
+func (p *EventPublisher) Publish(ctx context.Context, event *events.Event) error {
+	return p.PublishBatch(ctx, []*events.Event{event})
+}
+
+// PublishBatch sends the events with SendMessageBatch requests within the SQS limits, retrying the
+// throttled requests and the entries that failed on the queue side
+func (p *EventPublisher) PublishBatch(ctx context.Context, batch []*events.Event) error {
+	p.logger.With(
+		zap.Int("count", len(batch)),
+		zap.String("queue_url", p.config.QueueURL),
+	).Debug("publishing events to sqs")
+
+	entries := make([]sqstypes.SendMessageBatchRequestEntry, 0, MaxBatchEntries)
+	size := 0
+	for _, event := range batch {
+		entry, err := p.toBatchEntry(event, len(entries))
+		if err != nil {
+			return err
+		}
+
+		entrySize := len(aws.ToString(entry.MessageBody))
+		if len(entries) == MaxBatchEntries || (len(entries) > 0 && size+entrySize > MaxBatchBytes) {
+			if err := p.sendWithRetry(ctx, entries); err != nil {
+				return err
+			}
+			entries = make([]sqstypes.SendMessageBatchRequestEntry, 0, MaxBatchEntries)
+			size = 0
+			entry.Id = aws.String("0")
+		}
+		entries = append(entries, entry)
+		size += entrySize
+	}
+
+	if len(entries) > 0 {
+		return p.sendWithRetry(ctx, entries)
+	}
+	return nil
+}
+
+func (p *EventPublisher) sendWithRetry(ctx context.Context, entries []sqstypes.SendMessageBatchRequestEntry) error {
+	pending := entries
+	operation := func() error {
+		failed, err := p.client.SendMessageBatch(ctx, pending)
+		if err != nil {
+			p.logger.Warnw("failed to send events to sqs",
+				"count", len(pending),
+				"error", err)
+			return err
+		}
+		if len(failed) == 0 {
+			return nil
+		}
+
+		byID := make(map[string]sqstypes.SendMessageBatchRequestEntry, len(pending))
+		for _, entry := range pending {
+			byID[aws.ToString(entry.Id)] = entry
+		}
+		retry := make([]sqstypes.SendMessageBatchRequestEntry, 0, len(failed))
+		for _, failure := range failed {
+			// sender faults, e.g. invalid attributes, fail again on retry
+			if failure.SenderFault {
+				return backoff.Permanent(ToBatchError(failed))
+			}
+			if entry, ok := byID[aws.ToString(failure.Id)]; ok {
+				retry = append(retry, entry)
+			}
+		}
+		pending = retry
+		return ToBatchError(failed)
+	}
+
+	policy := backoff.WithContext(backoff.WithMaxRetries(backoff.NewExponentialBackOff(), uint64(max(p.config.MaxRetries, 0))), ctx)
+	if err := backoff.Retry(operation, policy); err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to publish events to SQS").
+			WithReportableDetails(map[string]interface{}{
+				"queue_url": p.config.QueueURL,
+				"pending":   len(pending),
+			}).
+			Mark(ierr.ErrSystem)
+	}
+	return nil
+}
+
+// toBatchEntry builds the message of the event, the entry id is its position in the request
+func (p *EventPublisher) toBatchEntry(event *events.Event, index int) (sqstypes.SendMessageBatchRequestEntry, error) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return sqstypes.SendMessageBatchRequestEntry{}, ierr.WithError(err).
+			WithHint("Failed to marshal event").
+			Mark(ierr.ErrValidation)
+	}
+
+	partitionKey := event.TenantID
+	if event.ExternalCustomerID != "" {
+		partitionKey = fmt.Sprintf("%s:%s", event.TenantID, event.ExternalCustomerID)
+	}
+
+	entry := sqstypes.SendMessageBatchRequestEntry{
+		Id:          aws.String(strconv.Itoa(index)),
+		MessageBody: aws.String(string(payload)),
+		MessageAttributes: map[string]sqstypes.MessageAttributeValue{
+			"tenant_id":      stringAttribute(event.TenantID),
+			"environment_id": stringAttribute(event.EnvironmentID),
+			"event_name":     stringAttribute(event.EventName),
+			"partition_key":  stringAttribute(partitionKey),
+		},
+	}
+	if event.EnvironmentID == "" {
+		delete(entry.MessageAttributes, "environment_id")
+	}
+
+	// FIFO queues keep the events of a customer in order and drop the duplicates of an event id
+	if p.config.FIFO {
+		entry.MessageGroupId = aws.String(partitionKey)
+		entry.MessageDeduplicationId = aws.String(event.ID)
+	}
+	return entry, nil
+}
+
+func stringAttribute(value string) sqstypes.MessageAttributeValue {
+	return sqstypes.MessageAttributeValue{
+		DataType:    aws.String("String"),
+		StringValue: aws.String(value),
+	}
+}
@@ -0,0 +1,77 @@
+package sqs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/flexprice/flexprice/internal/config"
+	"github.com/flexprice/flexprice/internal/domain/events"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sendMessageBatchRequest is the AWS JSON 1.0 body of the SendMessageBatch requests sent by the SDK
+type sendMessageBatchRequest struct {
+	QueueURL string `json:"QueueUrl"`
+	Entries  []struct {
+		ID                     string `json:"Id"`
+		MessageGroupID         string `json:"MessageGroupId"`
+		MessageDeduplicationID string `json:"MessageDeduplicationId"`
+	} `json:"Entries"`
+}
+
+func TestEventPublisherPublishBatch(t *testing.T) {
+	requests := make([]sendMessageBatchRequest, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "AmazonSQS.SendMessageBatch", r.Header.Get("X-Amz-Target"))
+		assert.True(t, strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256"))
+
+		var req sendMessageBatchRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		requests = append(requests, req)
+
+		w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+		// the first entry of the first request fails on the queue side and is retried alone
+		if len(requests) == 1 {
+			fmt.Fprintf(w, `{"Successful":[],"Failed":[{"Id":"%s","SenderFault":false,"Code":"InternalError"}]}`, req.Entries[0].ID)
+			return
+		}
+		fmt.Fprint(w, `{"Successful":[],"Failed":[]}`)
+	}))
+	defer server.Close()
+
+	cfg := config.GetDefaultConfig()
+	cfg.SQS = config.SQSConfig{
+		QueueURL:        server.URL + "/000000000000/events.fifo",
+		Region:          "us-east-1",
+		Endpoint:        server.URL,
+		AccessKeyID:     "test",
+		SecretAccessKey: "test",
+		FIFO:            true,
+		MaxRetries:      2,
+	}
+	client, err := NewClient(cfg)
+	require.NoError(t, err)
+	publisher := NewEventPublisher(client, cfg, logger.GetLogger())
+
+	batch := make([]*events.Event, 0, 12)
+	for i := 0; i < 12; i++ {
+		batch = append(batch, &events.Event{ID: fmt.Sprintf("event-%d", i), TenantID: "tenant_1", EventName: "api_request", ExternalCustomerID: "customer-1"})
+	}
+	require.NoError(t, publisher.PublishBatch(context.Background(), batch))
+
+	// 12 events are sent in batches of 10 and 2, with the failed entry of the first batch retried in between
+	require.Len(t, requests, 3)
+	assert.Len(t, requests[0].Entries, MaxBatchEntries)
+	require.Len(t, requests[1].Entries, 1)
+	assert.Equal(t, "event-0", requests[1].Entries[0].MessageDeduplicationID)
+	assert.Equal(t, "tenant_1:customer-1", requests[1].Entries[0].MessageGroupID)
+	assert.Len(t, requests[2].Entries, 2)
+	assert.Equal(t, cfg.SQS.QueueURL, requests[2].QueueURL)
+}
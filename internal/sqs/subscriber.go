@@ -0,0 +1,127 @@
+package sqs
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	sqstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/flexprice/flexprice/internal/logger"
+)
+
+// receiveErrorBackoff is the wait before polling again after a failed ReceiveMessage request
+const receiveErrorBackoff = 5 * time.Second
+
+// Subscriber long polls a queue, it implements the watermill subscriber so that the messages go
+// through the handlers of the pubsub router. Messages are deleted once the handler acked them and
+// made visible again when it nacked them. Every message of a queue is received by a single
+// subscriber, the topic is the queue url.
+type Subscriber struct {
+	client *Client
+	logger *logger.Logger
+
+	closing   chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+func NewSubscriber(client *Client, logger *logger.Logger) *Subscriber {
+	return &Subscriber{
+		client:  client,
+		logger:  logger,
+		closing: make(chan struct{}),
+	}
+}
+
+// Subscribe polls the queue until the context is cancelled or the subscriber is closed
+func (s *Subscriber) Subscribe(ctx context.Context, queueURL string) (<-chan *message.Message, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	output := make(chan *message.Message)
+
+	s.wg.Add(2)
+	go func() {
+		defer s.wg.Done()
+		select {
+		case <-ctx.Done():
+		case <-s.closing:
+			cancel()
+		}
+	}()
+	go func() {
+		defer s.wg.Done()
+		defer close(output)
+
+		for ctx.Err() == nil {
+			received, err := s.client.ReceiveMessages(ctx, queueURL)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				s.logger.Warnw("failed to receive messages from sqs",
+					"queue_url", queueURL,
+					"error", err)
+				select {
+				case <-time.After(receiveErrorBackoff):
+				case <-ctx.Done():
+				}
+				continue
+			}
+
+			for _, sqsMsg := range received {
+				s.handle(ctx, queueURL, sqsMsg, output)
+			}
+		}
+	}()
+
+	s.logger.Infow("subscribed to sqs", "queue_url", queueURL)
+	return output, nil
+}
+
+// handle hands the message to the router and waits for the handler. Messages left unhandled on close
+// become visible again after the visibility timeout of the queue.
+func (s *Subscriber) handle(ctx context.Context, queueURL string, sqsMsg sqstypes.Message, output chan<- *message.Message) {
+	msg := message.NewMessage(aws.ToString(sqsMsg.MessageId), []byte(aws.ToString(sqsMsg.Body)))
+	for key, attribute := range sqsMsg.MessageAttributes {
+		if attribute.StringValue != nil {
+			msg.Metadata.Set(key, *attribute.StringValue)
+		}
+	}
+
+	msgCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	msg.SetContext(msgCtx)
+
+	select {
+	case output <- msg:
+	case <-ctx.Done():
+		return
+	}
+
+	select {
+	case <-msg.Acked():
+		// the handling context may be cancelled by a close, the delete must still go through
+		if err := s.client.DeleteMessage(context.Background(), queueURL, sqsMsg.ReceiptHandle); err != nil {
+			s.logger.Warnw("failed to delete sqs message",
+				"message_id", msg.UUID,
+				"error", err)
+		}
+	case <-msg.Nacked():
+		if err := s.client.ReleaseMessage(context.Background(), queueURL, sqsMsg.ReceiptHandle); err != nil {
+			s.logger.Warnw("failed to release sqs message",
+				"message_id", msg.UUID,
+				"error", err)
+		}
+	case <-ctx.Done():
+	}
+}
+
+// Close stops polling the queues
+func (s *Subscriber) Close() error {
+	s.closeOnce.Do(func() {
+		close(s.closing)
+	})
+	s.wg.Wait()
+	return nil
+}
@@ -0,0 +1,242 @@
+package telemetry
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+)
+
+// The OTLP JSON encoding follows the protobuf JSON mapping, 64 bit integers are encoded as strings.
+// Only the fields used to build data points are decoded.
+type otlpMetricsData struct {
+	ResourceMetrics []struct {
+		Resource struct {
+			Attributes []otlpKeyValue `json:"attributes"`
+		} `json:"resource"`
+		ScopeMetrics []struct {
+			Metrics []otlpMetric `json:"metrics"`
+		} `json:"scopeMetrics"`
+	} `json:"resourceMetrics"`
+}
+
+type otlpMetric struct {
+	Name  string `json:"name"`
+	Gauge *struct {
+		DataPoints []otlpNumberDataPoint `json:"dataPoints"`
+	} `json:"gauge"`
+	Sum *struct {
+		DataPoints             []otlpNumberDataPoint `json:"dataPoints"`
+		AggregationTemporality otlpTemporality       `json:"aggregationTemporality"`
+		IsMonotonic            bool                  `json:"isMonotonic"`
+	} `json:"sum"`
+	Histogram *struct {
+		DataPoints             []otlpHistogramDataPoint `json:"dataPoints"`
+		AggregationTemporality otlpTemporality          `json:"aggregationTemporality"`
+	} `json:"histogram"`
+}
+
+type otlpNumberDataPoint struct {
+	Attributes        []otlpKeyValue `json:"attributes"`
+	StartTimeUnixNano otlpInt64      `json:"startTimeUnixNano"`
+	TimeUnixNano      otlpInt64      `json:"timeUnixNano"`
+	AsDouble          *float64       `json:"asDouble"`
+	AsInt             *otlpInt64     `json:"asInt"`
+}
+
+type otlpHistogramDataPoint struct {
+	Attributes        []otlpKeyValue `json:"attributes"`
+	StartTimeUnixNano otlpInt64      `json:"startTimeUnixNano"`
+	TimeUnixNano      otlpInt64      `json:"timeUnixNano"`
+	Count             otlpInt64      `json:"count"`
+	Sum               *float64       `json:"sum"`
+}
+
+type otlpKeyValue struct {
+	Key   string `json:"key"`
+	Value struct {
+		StringValue *string    `json:"stringValue"`
+		BoolValue   *bool      `json:"boolValue"`
+		IntValue    *otlpInt64 `json:"intValue"`
+		DoubleValue *float64   `json:"doubleValue"`
+	} `json:"value"`
+}
+
+// otlpInt64 accepts 64 bit integers encoded as JSON strings or numbers
+type otlpInt64 int64
+
+func (i *otlpInt64) UnmarshalJSON(data []byte) error {
+	value, err := strconv.ParseInt(string(bytes.Trim(data, `"`)), 10, 64)
+	if err != nil {
+		return err
+	}
+	*i = otlpInt64(value)
+	return nil
+}
+
+// otlpTemporality is the AggregationTemporality enum, encoded as its number or as its name
+type otlpTemporality int
+
+const (
+	otlpTemporalityDelta      otlpTemporality = 1
+	otlpTemporalityCumulative otlpTemporality = 2
+)
+
+func (t *otlpTemporality) UnmarshalJSON(data []byte) error {
+	switch string(bytes.Trim(data, `"`)) {
+	case "AGGREGATION_TEMPORALITY_DELTA":
+		*t = otlpTemporalityDelta
+		return nil
+	case "AGGREGATION_TEMPORALITY_CUMULATIVE":
+		*t = otlpTemporalityCumulative
+		return nil
+	case "AGGREGATION_TEMPORALITY_UNSPECIFIED":
+		*t = 0
+		return nil
+	}
+
+	value, err := strconv.Atoi(string(bytes.Trim(data, `"`)))
+	if err != nil {
+		return err
+	}
+	*t = otlpTemporality(value)
+	return nil
+}
+
+// temporality maps the aggregation temporality, non-monotonic sums go up and down like gauges so
+// their points are not converted
+func (t otlpTemporality) temporality(monotonic bool) Temporality {
+	if !monotonic {
+		return TemporalityUnspecified
+	}
+	switch t {
+	case otlpTemporalityDelta:
+		return TemporalityDelta
+	case otlpTemporalityCumulative:
+		return TemporalityCumulative
+	default:
+		return TemporalityUnspecified
+	}
+}
+
+// ParseOTLPMetrics decodes an OTLP/HTTP ExportMetricsServiceRequest in the JSON encoding. Data points of
+// gauges and sums use their value, histograms use the sum of their observations. Other metric types
+// (exponential histograms, summaries) are skipped. Points of monotonic sums and histograms carry their
+// aggregation temporality, cumulative points are the running total of their series and are converted
+// to deltas by the caller.
+func ParseOTLPMetrics(body []byte) ([]DataPoint, error) {
+	var request otlpMetricsData
+	if err := json.Unmarshal(body, &request); err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Request body must be an OTLP metrics export request in the JSON encoding").
+			Mark(ierr.ErrValidation)
+	}
+
+	points := make([]DataPoint, 0)
+	for _, resourceMetrics := range request.ResourceMetrics {
+		resourceAttributes := otlpAttributes(nil, resourceMetrics.Resource.Attributes)
+
+		for _, scopeMetrics := range resourceMetrics.ScopeMetrics {
+			for _, metric := range scopeMetrics.Metrics {
+				var numberPoints []otlpNumberDataPoint
+				temporality := TemporalityUnspecified
+				switch {
+				case metric.Gauge != nil:
+					numberPoints = metric.Gauge.DataPoints
+				case metric.Sum != nil:
+					numberPoints = metric.Sum.DataPoints
+					temporality = metric.Sum.AggregationTemporality.temporality(metric.Sum.IsMonotonic)
+				}
+
+				for _, point := range numberPoints {
+					var value float64
+					switch {
+					case point.AsDouble != nil:
+						value = *point.AsDouble
+					case point.AsInt != nil:
+						value = float64(*point.AsInt)
+					default:
+						continue
+					}
+					if !isValidValue(value) {
+						continue
+					}
+
+					points = append(points, DataPoint{
+						MetricName:  metric.Name,
+						Attributes:  otlpAttributes(resourceAttributes, point.Attributes),
+						Value:       value,
+						Timestamp:   otlpTimestamp(point.TimeUnixNano),
+						Temporality: temporality,
+						StartTime:   otlpStartTime(point.StartTimeUnixNano),
+					})
+				}
+
+				if metric.Histogram == nil {
+					continue
+				}
+				// the sum and the count of histograms only grow for non-negative observations, which is
+				// the case of the durations and sizes billed from histograms
+				histogramTemporality := metric.Histogram.AggregationTemporality.temporality(true)
+				for _, point := range metric.Histogram.DataPoints {
+					if point.Sum == nil || !isValidValue(*point.Sum) {
+						continue
+					}
+
+					count := uint64(point.Count)
+					points = append(points, DataPoint{
+						MetricName:  metric.Name,
+						Attributes:  otlpAttributes(resourceAttributes, point.Attributes),
+						Value:       *point.Sum,
+						Timestamp:   otlpTimestamp(point.TimeUnixNano),
+						Count:       &count,
+						Temporality: histogramTemporality,
+						StartTime:   otlpStartTime(point.StartTimeUnixNano),
+					})
+				}
+			}
+		}
+	}
+
+	return points, nil
+}
+
+// otlpAttributes merges the key values over a copy of the base attributes
+func otlpAttributes(base map[string]string, keyValues []otlpKeyValue) map[string]string {
+	attributes := make(map[string]string, len(base)+len(keyValues))
+	for key, value := range base {
+		attributes[key] = value
+	}
+
+	for _, kv := range keyValues {
+		switch {
+		case kv.Value.StringValue != nil:
+			attributes[kv.Key] = *kv.Value.StringValue
+		case kv.Value.BoolValue != nil:
+			attributes[kv.Key] = strconv.FormatBool(*kv.Value.BoolValue)
+		case kv.Value.IntValue != nil:
+			attributes[kv.Key] = strconv.FormatInt(int64(*kv.Value.IntValue), 10)
+		case kv.Value.DoubleValue != nil:
+			attributes[kv.Key] = strconv.FormatFloat(*kv.Value.DoubleValue, 'f', -1, 64)
+		}
+	}
+	return attributes
+}
+
+// otlpTimestamp converts the point time, falling back to now when the exporter did not set it
+func otlpTimestamp(unixNano otlpInt64) time.Time {
+	if unixNano <= 0 {
+		return time.Now().UTC()
+	}
+	return time.Unix(0, int64(unixNano)).UTC()
+}
+
+// otlpStartTime converts the start time of the series, zero when the exporter did not set it
+func otlpStartTime(unixNano otlpInt64) time.Time {
+	if unixNano <= 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, int64(unixNano)).UTC()
+}
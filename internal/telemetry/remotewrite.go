@@ -0,0 +1,180 @@
+package telemetry
+
+import (
+	"math"
+	"time"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/golang/snappy"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// Field numbers of the Prometheus remote-write protobuf messages (prompb), only the fields used to
+// build data points are decoded
+const (
+	writeRequestTimeseriesField = 1
+	timeSeriesLabelsField       = 1
+	timeSeriesSamplesField      = 2
+	labelNameField              = 1
+	labelValueField             = 2
+	sampleValueField            = 1
+	sampleTimestampField        = 2
+
+	metricNameLabel = "__name__"
+)
+
+// ParseRemoteWrite decodes a snappy compressed Prometheus remote-write WriteRequest. Every sample of a
+// series becomes a data point, native histograms and exemplars are skipped. Samples do not carry the
+// metric type, the temporality of the points is left unspecified and counters are marked as cumulative
+// by the metric mapping rule.
+func ParseRemoteWrite(body []byte) ([]DataPoint, error) {
+	raw, err := snappy.Decode(nil, body)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Request body must be a snappy compressed remote-write request").
+			Mark(ierr.ErrValidation)
+	}
+
+	points := make([]DataPoint, 0)
+	err = consumeMessage(raw, func(field protowire.Number, value []byte) error {
+		if field != writeRequestTimeseriesField {
+			return nil
+		}
+
+		series, err := parseTimeSeries(value)
+		if err != nil {
+			return err
+		}
+		points = append(points, series...)
+		return nil
+	})
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Request body must be a valid remote-write request").
+			Mark(ierr.ErrValidation)
+	}
+
+	return points, nil
+}
+
+type remoteWriteSample struct {
+	value     float64
+	timestamp int64
+}
+
+func parseTimeSeries(data []byte) ([]DataPoint, error) {
+	labels := make(map[string]string)
+	samples := make([]remoteWriteSample, 0, 1)
+
+	err := consumeMessage(data, func(field protowire.Number, value []byte) error {
+		switch field {
+		case timeSeriesLabelsField:
+			var name, labelValue string
+			if err := consumeMessage(value, func(field protowire.Number, value []byte) error {
+				switch field {
+				case labelNameField:
+					name = string(value)
+				case labelValueField:
+					labelValue = string(value)
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+			labels[name] = labelValue
+		case timeSeriesSamplesField:
+			s, err := parseSample(value)
+			if err != nil {
+				return err
+			}
+			samples = append(samples, s)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	metricName := labels[metricNameLabel]
+	delete(labels, metricNameLabel)
+
+	points := make([]DataPoint, 0, len(samples))
+	for _, s := range samples {
+		if !isValidValue(s.value) {
+			continue
+		}
+		points = append(points, DataPoint{
+			MetricName: metricName,
+			Attributes: labels,
+			Value:      s.value,
+			Timestamp:  time.UnixMilli(s.timestamp).UTC(),
+		})
+	}
+	return points, nil
+}
+
+func parseSample(data []byte) (remoteWriteSample, error) {
+	var s remoteWriteSample
+	for len(data) > 0 {
+		field, wireType, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return s, protowire.ParseError(n)
+		}
+		data = data[n:]
+
+		switch {
+		case field == sampleValueField && wireType == protowire.Fixed64Type:
+			bits, n := protowire.ConsumeFixed64(data)
+			if n < 0 {
+				return s, protowire.ParseError(n)
+			}
+			s.value = math.Float64frombits(bits)
+			data = data[n:]
+		case field == sampleTimestampField && wireType == protowire.VarintType:
+			timestamp, n := protowire.ConsumeVarint(data)
+			if n < 0 {
+				return s, protowire.ParseError(n)
+			}
+			s.timestamp = int64(timestamp)
+			data = data[n:]
+		default:
+			n := protowire.ConsumeFieldValue(field, wireType, data)
+			if n < 0 {
+				return s, protowire.ParseError(n)
+			}
+			data = data[n:]
+		}
+	}
+	return s, nil
+}
+
+// consumeMessage calls fn with the length delimited fields of a protobuf message and skips the others
+func consumeMessage(data []byte, fn func(field protowire.Number, value []byte) error) error {
+	for len(data) > 0 {
+		field, wireType, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		data = data[n:]
+
+		if wireType != protowire.BytesType {
+			n = protowire.ConsumeFieldValue(field, wireType, data)
+			if n < 0 {
+				return protowire.ParseError(n)
+			}
+			data = data[n:]
+			continue
+		}
+
+		value, n := protowire.ConsumeBytes(data)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		data = data[n:]
+
+		if err := fn(field, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+// Package telemetry decodes the metrics pushed by OpenTelemetry exporters (OTLP/HTTP with the JSON
+// encoding) and Prometheus remote-write into flat data points that can be mapped to usage events.
+//
+// Every data point carries the metric name, its value, its timestamp and the merged attributes of the
+// point. For OTLP the resource attributes are merged below the data point attributes, for Prometheus the
+// labels of the series are used, without the reserved __name__ label.
+package telemetry
+
+import (
+	"math"
+	"time"
+)
+
+// Temporality tells whether the value of a data point is the increase since the previous point of its
+// series or the running total since the start time of the series
+type Temporality string
+
+const (
+	// TemporalityUnspecified is used for gauges, non-monotonic sums and Prometheus samples, whose
+	// temporality is not carried by the payload
+	TemporalityUnspecified Temporality = ""
+	TemporalityDelta       Temporality = "delta"
+	TemporalityCumulative  Temporality = "cumulative"
+)
+
+// DataPoint is a single sample of a metric
+type DataPoint struct {
+	MetricName string
+	Attributes map[string]string
+	Value      float64
+	Timestamp  time.Time
+	// Count is the number of observations of histogram data points, nil for gauges and counters
+	Count *uint64
+	// Temporality is the aggregation temporality of monotonic sums and histograms
+	Temporality Temporality
+	// StartTime is the start of the series of cumulative points, a new start time means the series was reset
+	StartTime time.Time
+}
+
+// isValidValue filters the NaN stale markers and infinite values that cannot be billed
+func isValidValue(value float64) bool {
+	return !math.IsNaN(value) && !math.IsInf(value, 0)
+}
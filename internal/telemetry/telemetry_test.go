@@ -0,0 +1,120 @@
+package telemetry
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/golang/snappy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+func TestParseOTLPMetrics(t *testing.T) {
+	body := []byte(`{
+		"resourceMetrics": [{
+			"resource": {"attributes": [
+				{"key": "service.name", "value": {"stringValue": "api"}},
+				{"key": "customer.id", "value": {"stringValue": "cust_resource"}}
+			]},
+			"scopeMetrics": [{"metrics": [
+				{"name": "llm.tokens", "sum": {"dataPoints": [
+					{"attributes": [{"key": "customer.id", "value": {"stringValue": "cust_1"}}], "timeUnixNano": "1700000000000000000", "asInt": "42"},
+					{"timeUnixNano": "1700000000000000000", "asDouble": 1.5}
+				]}},
+				{"name": "request.duration", "histogram": {"dataPoints": [
+					{"timeUnixNano": "1700000000000000000", "count": "3", "sum": 0.9}
+				]}}
+			]}]
+		}]
+	}`)
+
+	points, err := ParseOTLPMetrics(body)
+	require.NoError(t, err)
+	require.Len(t, points, 3)
+
+	// data point attributes take precedence over the resource attributes
+	assert.Equal(t, "llm.tokens", points[0].MetricName)
+	assert.Equal(t, "cust_1", points[0].Attributes["customer.id"])
+	assert.Equal(t, "api", points[0].Attributes["service.name"])
+	assert.Equal(t, float64(42), points[0].Value)
+	assert.Equal(t, time.Unix(1700000000, 0).UTC(), points[0].Timestamp)
+	assert.Equal(t, "cust_resource", points[1].Attributes["customer.id"])
+
+	assert.Equal(t, 0.9, points[2].Value)
+	require.NotNil(t, points[2].Count)
+	assert.Equal(t, uint64(3), *points[2].Count)
+}
+
+func TestParseOTLPMetricsTemporality(t *testing.T) {
+	body := []byte(`{
+		"resourceMetrics": [{
+			"scopeMetrics": [{"metrics": [
+				{"name": "llm.tokens", "sum": {"aggregationTemporality": 2, "isMonotonic": true, "dataPoints": [
+					{"startTimeUnixNano": "1699999990000000000", "timeUnixNano": "1700000000000000000", "asInt": "42"}
+				]}},
+				{"name": "llm.requests", "sum": {"aggregationTemporality": "AGGREGATION_TEMPORALITY_DELTA", "isMonotonic": true, "dataPoints": [
+					{"timeUnixNano": "1700000000000000000", "asInt": "3"}
+				]}},
+				{"name": "queue.depth", "sum": {"aggregationTemporality": 2, "dataPoints": [
+					{"timeUnixNano": "1700000000000000000", "asInt": "7"}
+				]}},
+				{"name": "request.duration", "histogram": {"aggregationTemporality": 2, "dataPoints": [
+					{"timeUnixNano": "1700000000000000000", "count": "3", "sum": 0.9}
+				]}}
+			]}]
+		}]
+	}`)
+
+	points, err := ParseOTLPMetrics(body)
+	require.NoError(t, err)
+	require.Len(t, points, 4)
+
+	assert.Equal(t, TemporalityCumulative, points[0].Temporality)
+	assert.Equal(t, time.Unix(1699999990, 0).UTC(), points[0].StartTime)
+	assert.Equal(t, TemporalityDelta, points[1].Temporality)
+	// non-monotonic sums are not converted
+	assert.Equal(t, TemporalityUnspecified, points[2].Temporality)
+	assert.Equal(t, TemporalityCumulative, points[3].Temporality)
+}
+
+func TestParseRemoteWrite(t *testing.T) {
+	label := func(name, value string) []byte {
+		b := protowire.AppendTag(nil, labelNameField, protowire.BytesType)
+		b = protowire.AppendString(b, name)
+		b = protowire.AppendTag(b, labelValueField, protowire.BytesType)
+		return protowire.AppendString(b, value)
+	}
+	sample := func(value float64, timestamp int64) []byte {
+		b := protowire.AppendTag(nil, sampleValueField, protowire.Fixed64Type)
+		b = protowire.AppendFixed64(b, math.Float64bits(value))
+		b = protowire.AppendTag(b, sampleTimestampField, protowire.VarintType)
+		return protowire.AppendVarint(b, uint64(timestamp))
+	}
+
+	var series []byte
+	for _, l := range [][]byte{label(metricNameLabel, "http_requests_total"), label("tenant", "cust_1")} {
+		series = protowire.AppendTag(series, timeSeriesLabelsField, protowire.BytesType)
+		series = protowire.AppendBytes(series, l)
+	}
+	// stale markers are dropped
+	for _, s := range [][]byte{sample(10, 1700000000000), sample(math.NaN(), 1700000015000)} {
+		series = protowire.AppendTag(series, timeSeriesSamplesField, protowire.BytesType)
+		series = protowire.AppendBytes(series, s)
+	}
+	request := protowire.AppendTag(nil, writeRequestTimeseriesField, protowire.BytesType)
+	request = protowire.AppendBytes(request, series)
+
+	points, err := ParseRemoteWrite(snappy.Encode(nil, request))
+	require.NoError(t, err)
+	require.Len(t, points, 1)
+	assert.Equal(t, "http_requests_total", points[0].MetricName)
+	assert.Equal(t, map[string]string{"tenant": "cust_1"}, points[0].Attributes)
+	assert.Equal(t, float64(10), points[0].Value)
+	assert.Equal(t, time.UnixMilli(1700000000000).UTC(), points[0].Timestamp)
+	assert.Equal(t, TemporalityUnspecified, points[0].Temporality)
+
+	_, err = ParseRemoteWrite([]byte("not snappy"))
+	assert.Error(t, err)
+}
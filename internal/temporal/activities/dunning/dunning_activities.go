@@ -0,0 +1,46 @@
+package dunning
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/service"
+	"github.com/flexprice/flexprice/internal/temporal/models"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// DunningActivities contains the steps of the dunning workflow, they delegate to the DunningService
+type DunningActivities struct {
+	dunningService service.DunningService
+}
+
+// NewDunningActivities creates a new instance of DunningActivities
+func NewDunningActivities(dunningService service.DunningService) *DunningActivities {
+	return &DunningActivities{
+		dunningService: dunningService,
+	}
+}
+
+// BeginDunningCycle moves the subscription to past_due and returns the retry schedule of the invoice
+func (a *DunningActivities) BeginDunningCycle(ctx context.Context, input models.DunningWorkflowInput) (*models.DunningSchedule, error) {
+	ctx = withDunningContext(ctx, input)
+	return a.dunningService.BeginDunningCycle(ctx, input.InvoiceID)
+}
+
+// RetryDunningPayment retries the payment of the invoice and sends the reminder when it fails
+func (a *DunningActivities) RetryDunningPayment(ctx context.Context, input models.DunningAttemptInput) (*models.DunningAttemptResult, error) {
+	ctx = withDunningContext(ctx, input.DunningWorkflowInput)
+	return a.dunningService.RetryDunningPayment(ctx, &input)
+}
+
+// EndDunningCycle closes the dunning of the invoice
+func (a *DunningActivities) EndDunningCycle(ctx context.Context, input models.DunningOutcomeInput) error {
+	ctx = withDunningContext(ctx, input.DunningWorkflowInput)
+	return a.dunningService.EndDunningCycle(ctx, &input)
+}
+
+func withDunningContext(ctx context.Context, input models.DunningWorkflowInput) context.Context {
+	ctx = types.SetTenantID(ctx, input.TenantID)
+	ctx = types.SetEnvironmentID(ctx, input.EnvironmentID)
+	ctx = types.SetUserID(ctx, input.UserID)
+	return ctx
+}
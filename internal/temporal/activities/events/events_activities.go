@@ -0,0 +1,32 @@
+package events
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/domain/events"
+	"github.com/flexprice/flexprice/internal/service"
+	"github.com/flexprice/flexprice/internal/temporal/models"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// EventActivities contains the event activities, they delegate to the FeatureUsageTrackingService
+type EventActivities struct {
+	featureUsageTrackingService service.FeatureUsageTrackingService
+}
+
+// NewEventActivities creates a new instance of EventActivities
+func NewEventActivities(featureUsageTrackingService service.FeatureUsageTrackingService) *EventActivities {
+	return &EventActivities{
+		featureUsageTrackingService: featureUsageTrackingService,
+	}
+}
+
+// ReprocessEvents publishes the unprocessed events of the external customer id for feature usage tracking
+func (a *EventActivities) ReprocessEvents(ctx context.Context, input models.ReprocessEventsWorkflowInput) error {
+	ctx = types.SetTenantID(ctx, input.TenantID)
+	ctx = types.SetEnvironmentID(ctx, input.EnvironmentID)
+	ctx = types.SetUserID(ctx, input.UserID)
+	return a.featureUsageTrackingService.ReprocessEvents(ctx, &events.ReprocessEventsParams{
+		ExternalCustomerID: input.ExternalCustomerID,
+	})
+}
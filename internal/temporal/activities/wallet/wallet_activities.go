@@ -0,0 +1,29 @@
+package wallet
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/service"
+	"github.com/flexprice/flexprice/internal/temporal/models"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// WalletActivities contains the wallet activities, they delegate to the WalletService
+type WalletActivities struct {
+	walletService service.WalletService
+}
+
+// NewWalletActivities creates a new instance of WalletActivities
+func NewWalletActivities(walletService service.WalletService) *WalletActivities {
+	return &WalletActivities{
+		walletService: walletService,
+	}
+}
+
+// ProcessWalletAutoTopup charges the saved card of the customer and credits the wallet
+func (a *WalletActivities) ProcessWalletAutoTopup(ctx context.Context, input models.WalletAutoTopupWorkflowInput) error {
+	ctx = types.SetTenantID(ctx, input.TenantID)
+	ctx = types.SetEnvironmentID(ctx, input.EnvironmentID)
+	ctx = types.SetUserID(ctx, input.UserID)
+	return a.walletService.ProcessAutoTopup(ctx, input.WalletID, input.CreditBalance)
+}
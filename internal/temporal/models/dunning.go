@@ -0,0 +1,66 @@
+package models
+
+import (
+	"time"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// DunningSignalInvoicePaid is signalled to the dunning workflow of an invoice once the invoice is paid
+const DunningSignalInvoicePaid = "invoice_paid"
+
+// DunningWorkflowInput contains the input for the dunning workflow of an invoice
+type DunningWorkflowInput struct {
+	InvoiceID     string `json:"invoice_id"`
+	TenantID      string `json:"tenant_id"`
+	EnvironmentID string `json:"environment_id"`
+	UserID        string `json:"user_id"`
+}
+
+// Validate validates the workflow input
+func (input *DunningWorkflowInput) Validate() error {
+	if input.InvoiceID == "" {
+		return ierr.NewError("invoice_id is required").
+			WithHint("InvoiceID must not be empty").
+			Mark(ierr.ErrValidation)
+	}
+	if input.TenantID == "" || input.EnvironmentID == "" {
+		return ierr.NewError("tenant_id and environment_id are required").
+			WithHint("TenantID and EnvironmentID must not be empty").
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// DunningSchedule is the retry schedule of the invoice, read from the dunning config when the dunning starts.
+// An empty schedule means there is nothing to retry, e.g. the invoice was paid in the meantime.
+type DunningSchedule struct {
+	RetryScheduleDays []int                    `json:"retry_schedule_days"`
+	SendReminders     bool                     `json:"send_reminders"`
+	FinalAction       types.DunningFinalAction `json:"final_action"`
+}
+
+// DunningAttemptInput contains the input of a payment retry
+type DunningAttemptInput struct {
+	DunningWorkflowInput
+	Attempt       int  `json:"attempt"`
+	MaxAttempts   int  `json:"max_attempts"`
+	SendReminders bool `json:"send_reminders"`
+	// NextRetryAt is the time of the next retry, nil for the last attempt
+	NextRetryAt *time.Time `json:"next_retry_at,omitempty"`
+}
+
+// DunningAttemptResult is the result of a payment retry
+type DunningAttemptResult struct {
+	Paid bool `json:"paid"`
+}
+
+// DunningOutcomeInput contains the input for closing the dunning of the invoice
+type DunningOutcomeInput struct {
+	DunningWorkflowInput
+	Recovered   bool                     `json:"recovered"`
+	Attempts    int                      `json:"attempts"`
+	MaxAttempts int                      `json:"max_attempts"`
+	FinalAction types.DunningFinalAction `json:"final_action"`
+}
@@ -0,0 +1,28 @@
+package models
+
+import (
+	ierr "github.com/flexprice/flexprice/internal/errors"
+)
+
+// ReprocessEventsWorkflowInput contains the input for reprocessing the events of an external customer id
+type ReprocessEventsWorkflowInput struct {
+	ExternalCustomerID string `json:"external_customer_id"`
+	TenantID           string `json:"tenant_id"`
+	EnvironmentID      string `json:"environment_id"`
+	UserID             string `json:"user_id"`
+}
+
+// Validate validates the workflow input
+func (input *ReprocessEventsWorkflowInput) Validate() error {
+	if input.ExternalCustomerID == "" {
+		return ierr.NewError("external_customer_id is required").
+			WithHint("ExternalCustomerID must not be empty").
+			Mark(ierr.ErrValidation)
+	}
+	if input.TenantID == "" || input.EnvironmentID == "" {
+		return ierr.NewError("tenant_id and environment_id are required").
+			WithHint("TenantID and EnvironmentID must not be empty").
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package models
+
+import (
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/shopspring/decimal"
+)
+
+// WalletAutoTopupWorkflowInput contains the input for the auto top-up workflow of a wallet
+type WalletAutoTopupWorkflowInput struct {
+	WalletID string `json:"wallet_id"`
+	// CreditBalance is the credit balance that triggered the top-up
+	CreditBalance decimal.Decimal `json:"credit_balance"`
+	TenantID      string          `json:"tenant_id"`
+	EnvironmentID string          `json:"environment_id"`
+	UserID        string          `json:"user_id"`
+}
+
+// Validate validates the workflow input
+func (input *WalletAutoTopupWorkflowInput) Validate() error {
+	if input.WalletID == "" {
+		return ierr.NewError("wallet_id is required").
+			WithHint("WalletID must not be empty").
+			Mark(ierr.ErrValidation)
+	}
+	if input.TenantID == "" || input.EnvironmentID == "" {
+		return ierr.NewError("tenant_id and environment_id are required").
+			WithHint("TenantID and EnvironmentID must not be empty").
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
@@ -4,10 +4,13 @@ import (
 	"fmt"
 
 	"github.com/flexprice/flexprice/internal/service"
+	dunningActivities "github.com/flexprice/flexprice/internal/temporal/activities/dunning"
+	eventActivities "github.com/flexprice/flexprice/internal/temporal/activities/events"
 	exportActivities "github.com/flexprice/flexprice/internal/temporal/activities/export"
 	hubspotActivities "github.com/flexprice/flexprice/internal/temporal/activities/hubspot"
 	planActivities "github.com/flexprice/flexprice/internal/temporal/activities/plan"
 	taskActivities "github.com/flexprice/flexprice/internal/temporal/activities/task"
+	walletActivities "github.com/flexprice/flexprice/internal/temporal/activities/wallet"
 	temporalService "github.com/flexprice/flexprice/internal/temporal/service"
 	"github.com/flexprice/flexprice/internal/temporal/workflows"
 	exportWorkflows "github.com/flexprice/flexprice/internal/temporal/workflows/export"
@@ -55,9 +58,18 @@ func RegisterWorkflowsAndActivities(temporalService temporalService.TemporalServ
 		params.Logger,
 	)
 
+	// Dunning activities - the steps of the invoice payment retries
+	dunningActivities := dunningActivities.NewDunningActivities(service.NewDunningService(params))
+
+	// Wallet activities - the auto top-up charge of wallets
+	walletActivities := walletActivities.NewWalletActivities(service.NewWalletService(params))
+
+	// Event activities - the reprocessing of events for feature usage tracking
+	eventActivities := eventActivities.NewEventActivities(service.NewFeatureUsageTrackingService(params, params.EventRepo, params.FeatureUsageRepo))
+
 	// Get all task queues and register workflows/activities for each
 	for _, taskQueue := range types.GetAllTaskQueues() {
-		config := buildWorkerConfig(taskQueue, planActivities, taskActivities, taskActivity, scheduledTaskActivity, exportActivity, hubspotDealSyncActivities)
+		config := buildWorkerConfig(taskQueue, planActivities, taskActivities, taskActivity, scheduledTaskActivity, exportActivity, hubspotDealSyncActivities, dunningActivities, walletActivities, eventActivities)
 		if err := registerWorker(temporalService, config); err != nil {
 			return fmt.Errorf("failed to register worker for task queue %s: %w", taskQueue, err)
 		}
@@ -75,6 +87,9 @@ func buildWorkerConfig(
 	scheduledTaskActivity *exportActivities.ScheduledTaskActivity,
 	exportActivity *exportActivities.ExportActivity,
 	hubspotDealSyncActivities *hubspotActivities.DealSyncActivities,
+	dunningActivities *dunningActivities.DunningActivities,
+	walletActivities *walletActivities.WalletActivities,
+	eventActivities *eventActivities.EventActivities,
 ) WorkerConfig {
 	workflowsList := []interface{}{}
 	activitiesList := []interface{}{}
@@ -84,11 +99,19 @@ func buildWorkerConfig(
 		workflowsList = append(workflowsList,
 			workflows.TaskProcessingWorkflow,
 			workflows.HubSpotDealSyncWorkflow,
+			workflows.DunningWorkflow,
+			workflows.WalletAutoTopupWorkflow,
+			workflows.ReprocessEventsWorkflow,
 		)
 		activitiesList = append(activitiesList,
 			taskActivities.ProcessTask,
 			hubspotDealSyncActivities.CreateLineItems,
 			hubspotDealSyncActivities.UpdateDealAmount,
+			dunningActivities.BeginDunningCycle,
+			dunningActivities.RetryDunningPayment,
+			dunningActivities.EndDunningCycle,
+			walletActivities.ProcessWalletAutoTopup,
+			eventActivities.ReprocessEvents,
 		)
 
 	case types.TemporalTaskQueuePrice:
@@ -0,0 +1,115 @@
+package workflows
+
+import (
+	"time"
+
+	"github.com/flexprice/flexprice/internal/temporal/models"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+const (
+	// Workflow name - must match the function name
+	WorkflowDunning = "DunningWorkflow"
+	// Activity names - must match the registered method names
+	ActivityBeginDunningCycle   = "BeginDunningCycle"
+	ActivityRetryDunningPayment = "RetryDunningPayment"
+	ActivityEndDunningCycle     = "EndDunningCycle"
+)
+
+// DunningWorkflow retries the payment of an invoice whose card payment failed
+// Steps:
+// 1. Move the subscription to past_due and read the retry schedule, e.g. day 1, 3 and 7
+// 2. Wait for each retry day and retry the payment, sending a reminder when it fails
+// 3. Reactivate the subscription once the invoice is paid, or apply the final action (unpaid or cancel)
+// The workflow stops early when the invoice_paid signal is received.
+func DunningWorkflow(ctx workflow.Context, input models.DunningWorkflowInput) error {
+	logger := workflow.GetLogger(ctx)
+
+	if err := input.Validate(); err != nil {
+		logger.Error("Invalid workflow input", "error", err)
+		return err
+	}
+
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 5 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    5,
+		},
+	})
+
+	var schedule models.DunningSchedule
+	if err := workflow.ExecuteActivity(ctx, ActivityBeginDunningCycle, input).Get(ctx, &schedule); err != nil {
+		logger.Error("Failed to begin dunning", "error", err, "invoice_id", input.InvoiceID)
+		return err
+	}
+	if len(schedule.RetryScheduleDays) == 0 {
+		logger.Info("Invoice does not need dunning", "invoice_id", input.InvoiceID)
+		return nil
+	}
+
+	paidSignal := workflow.GetSignalChannel(ctx, models.DunningSignalInvoicePaid)
+	startedAt := workflow.Now(ctx)
+	maxAttempts := len(schedule.RetryScheduleDays)
+	paid := false
+	attempts := 0
+
+	for i, day := range schedule.RetryScheduleDays {
+		retryAt := startedAt.Add(time.Duration(day) * 24 * time.Hour)
+
+		// wait for the retry day unless the invoice gets paid in the meantime
+		timerCtx, cancelTimer := workflow.WithCancel(ctx)
+		selector := workflow.NewSelector(ctx)
+		selector.AddFuture(workflow.NewTimer(timerCtx, retryAt.Sub(workflow.Now(ctx))), func(workflow.Future) {})
+		selector.AddReceive(paidSignal, func(c workflow.ReceiveChannel, _ bool) {
+			c.Receive(ctx, nil)
+			paid = true
+		})
+		selector.Select(ctx)
+		cancelTimer()
+		if paid {
+			logger.Info("Invoice paid before the retry", "invoice_id", input.InvoiceID, "attempt", i+1)
+			break
+		}
+
+		attemptInput := models.DunningAttemptInput{
+			DunningWorkflowInput: input,
+			Attempt:              i + 1,
+			MaxAttempts:          maxAttempts,
+			SendReminders:        schedule.SendReminders,
+		}
+		if i+1 < maxAttempts {
+			nextRetryAt := startedAt.Add(time.Duration(schedule.RetryScheduleDays[i+1]) * 24 * time.Hour)
+			attemptInput.NextRetryAt = &nextRetryAt
+		}
+
+		var result models.DunningAttemptResult
+		if err := workflow.ExecuteActivity(ctx, ActivityRetryDunningPayment, attemptInput).Get(ctx, &result); err != nil {
+			// an attempt that could not run counts as a failed attempt
+			logger.Error("Failed to retry the payment", "error", err, "invoice_id", input.InvoiceID, "attempt", i+1)
+		}
+		attempts = i + 1
+		if result.Paid {
+			paid = true
+			break
+		}
+	}
+
+	outcome := models.DunningOutcomeInput{
+		DunningWorkflowInput: input,
+		Recovered:            paid,
+		Attempts:             attempts,
+		MaxAttempts:          maxAttempts,
+		FinalAction:          schedule.FinalAction,
+	}
+	if err := workflow.ExecuteActivity(ctx, ActivityEndDunningCycle, outcome).Get(ctx, nil); err != nil {
+		logger.Error("Failed to end dunning", "error", err, "invoice_id", input.InvoiceID)
+		return err
+	}
+
+	logger.Info("Successfully completed dunning workflow", "invoice_id", input.InvoiceID, "recovered", paid, "attempts", attempts)
+	return nil
+}
@@ -0,0 +1,97 @@
+package workflows
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	dunningActivities "github.com/flexprice/flexprice/internal/temporal/activities/dunning"
+	"github.com/flexprice/flexprice/internal/temporal/models"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+)
+
+func newDunningTestEnv() *testsuite.TestWorkflowEnvironment {
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	env.RegisterWorkflow(DunningWorkflow)
+	env.RegisterActivity(dunningActivities.NewDunningActivities(nil))
+
+	env.OnActivity(ActivityBeginDunningCycle, mock.Anything, mock.Anything).Return(&models.DunningSchedule{
+		RetryScheduleDays: []int{1, 3, 7},
+		SendReminders:     true,
+		FinalAction:       types.DunningFinalActionCancel,
+	}, nil)
+	return env
+}
+
+func TestDunningWorkflow(t *testing.T) {
+	input := models.DunningWorkflowInput{InvoiceID: "inv_1", TenantID: "tenant_1", EnvironmentID: "env_1"}
+
+	t.Run("exhausted retries apply the final action", func(t *testing.T) {
+		env := newDunningTestEnv()
+
+		var attempts []models.DunningAttemptInput
+		env.OnActivity(ActivityRetryDunningPayment, mock.Anything, mock.Anything).Return(
+			func(_ context.Context, attempt models.DunningAttemptInput) (*models.DunningAttemptResult, error) {
+				attempts = append(attempts, attempt)
+				return &models.DunningAttemptResult{Paid: false}, nil
+			})
+
+		var outcome models.DunningOutcomeInput
+		env.OnActivity(ActivityEndDunningCycle, mock.Anything, mock.Anything).Return(
+			func(_ context.Context, in models.DunningOutcomeInput) error {
+				outcome = in
+				return nil
+			})
+
+		start := env.Now()
+		env.ExecuteWorkflow(DunningWorkflow, input)
+		require.True(t, env.IsWorkflowCompleted())
+		require.NoError(t, env.GetWorkflowError())
+
+		require.Len(t, attempts, 3)
+		assert.Equal(t, 1, attempts[0].Attempt)
+		require.NotNil(t, attempts[0].NextRetryAt)
+		assert.True(t, start.Add(3*24*time.Hour).Equal(*attempts[0].NextRetryAt))
+		assert.Nil(t, attempts[2].NextRetryAt)
+
+		assert.False(t, outcome.Recovered)
+		assert.Equal(t, 3, outcome.Attempts)
+		assert.Equal(t, types.DunningFinalActionCancel, outcome.FinalAction)
+	})
+
+	t.Run("paid signal stops the retries", func(t *testing.T) {
+		env := newDunningTestEnv()
+
+		retries := 0
+		env.OnActivity(ActivityRetryDunningPayment, mock.Anything, mock.Anything).Return(
+			func(_ context.Context, _ models.DunningAttemptInput) (*models.DunningAttemptResult, error) {
+				retries++
+				return &models.DunningAttemptResult{Paid: false}, nil
+			})
+
+		var outcome models.DunningOutcomeInput
+		env.OnActivity(ActivityEndDunningCycle, mock.Anything, mock.Anything).Return(
+			func(_ context.Context, in models.DunningOutcomeInput) error {
+				outcome = in
+				return nil
+			})
+
+		// the invoice is paid between the first and the second retry
+		env.RegisterDelayedCallback(func() {
+			env.SignalWorkflow(models.DunningSignalInvoicePaid, nil)
+		}, 2*24*time.Hour)
+
+		env.ExecuteWorkflow(DunningWorkflow, input)
+		require.True(t, env.IsWorkflowCompleted())
+		require.NoError(t, env.GetWorkflowError())
+
+		assert.Equal(t, 1, retries)
+		assert.True(t, outcome.Recovered)
+		assert.Equal(t, 1, outcome.Attempts)
+	})
+}
@@ -0,0 +1,46 @@
+package workflows
+
+import (
+	"time"
+
+	"github.com/flexprice/flexprice/internal/temporal/models"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+const (
+	// Workflow name - must match the function name
+	WorkflowReprocessEvents = "ReprocessEventsWorkflow"
+	// Activity names - must match the registered method names
+	ActivityReprocessEvents = "ReprocessEvents"
+)
+
+// ReprocessEventsWorkflow publishes the events of an external customer id that have no feature usage yet,
+// e.g. the events ingested before the customer was auto-created. Reprocessing is idempotent as the
+// events that were processed in the meantime are skipped.
+func ReprocessEventsWorkflow(ctx workflow.Context, input models.ReprocessEventsWorkflowInput) error {
+	logger := workflow.GetLogger(ctx)
+
+	if err := input.Validate(); err != nil {
+		logger.Error("Invalid workflow input", "error", err)
+		return err
+	}
+
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 30 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    5,
+		},
+	})
+
+	if err := workflow.ExecuteActivity(ctx, ActivityReprocessEvents, input).Get(ctx, nil); err != nil {
+		logger.Error("Failed to reprocess events", "error", err, "external_customer_id", input.ExternalCustomerID)
+		return err
+	}
+
+	logger.Info("Successfully completed reprocess events workflow", "external_customer_id", input.ExternalCustomerID)
+	return nil
+}
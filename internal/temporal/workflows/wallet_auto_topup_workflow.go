@@ -0,0 +1,47 @@
+package workflows
+
+import (
+	"time"
+
+	"github.com/flexprice/flexprice/internal/temporal/models"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+const (
+	// Workflow name - must match the function name
+	WorkflowWalletAutoTopup = "WalletAutoTopupWorkflow"
+	// Activity names - must match the registered method names
+	ActivityProcessWalletAutoTopup = "ProcessWalletAutoTopup"
+)
+
+// WalletAutoTopupWorkflow tops up a wallet whose credit balance dropped below the auto top-up minimum.
+// It runs outside of the wallet debit that triggered it, so a slow or failing charge never holds up
+// the debit or its transaction. A declined card is not retried by the workflow, the next trigger of
+// the wallet retries the charge on the same invoice.
+func WalletAutoTopupWorkflow(ctx workflow.Context, input models.WalletAutoTopupWorkflowInput) error {
+	logger := workflow.GetLogger(ctx)
+
+	if err := input.Validate(); err != nil {
+		logger.Error("Invalid workflow input", "error", err)
+		return err
+	}
+
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 5 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    5,
+		},
+	})
+
+	if err := workflow.ExecuteActivity(ctx, ActivityProcessWalletAutoTopup, input).Get(ctx, nil); err != nil {
+		logger.Error("Failed to top up wallet", "error", err, "wallet_id", input.WalletID)
+		return err
+	}
+
+	logger.Info("Successfully completed wallet auto top-up workflow", "wallet_id", input.WalletID)
+	return nil
+}
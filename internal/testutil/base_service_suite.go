@@ -21,12 +21,14 @@ import (
 	"github.com/flexprice/flexprice/internal/domain/entityintegrationmapping"
 	"github.com/flexprice/flexprice/internal/domain/environment"
 	"github.com/flexprice/flexprice/internal/domain/events"
+	"github.com/flexprice/flexprice/internal/domain/eventschema"
 	"github.com/flexprice/flexprice/internal/domain/feature"
 	"github.com/flexprice/flexprice/internal/domain/invoice"
 	"github.com/flexprice/flexprice/internal/domain/meter"
 	"github.com/flexprice/flexprice/internal/domain/payment"
 	"github.com/flexprice/flexprice/internal/domain/plan"
 	"github.com/flexprice/flexprice/internal/domain/price"
+	"github.com/flexprice/flexprice/internal/domain/promotion_code"
 	"github.com/flexprice/flexprice/internal/domain/proration"
 	"github.com/flexprice/flexprice/internal/domain/secret"
 	"github.com/flexprice/flexprice/internal/domain/settings"
@@ -80,11 +82,14 @@ type Stores struct {
 	CouponRepo                   coupon.Repository
 	CouponAssociationRepo        coupon_association.Repository
 	CouponApplicationRepo        coupon_application.Repository
+	PromotionCodeRepo            promotion_code.Repository
 	AddonAssociationRepo         addonassociation.Repository
 	ConnectionRepo               connection.Repository
 	EntityIntegrationMappingRepo entityintegrationmapping.Repository
 	SettingsRepo                 settings.Repository
 	AlertLogsRepo                alertlogs.Repository
+	EventSchemaRepo              eventschema.Repository
+	RejectedEventRepo            events.RejectedEventRepository
 }
 
 // BaseServiceTestSuite provides common functionality for all service test suites
@@ -211,11 +216,14 @@ func (s *BaseServiceTestSuite) setupStores() {
 		CouponRepo:                   NewInMemoryCouponStore(),
 		CouponAssociationRepo:        NewInMemoryCouponAssociationStore(),
 		CouponApplicationRepo:        NewInMemoryCouponApplicationStore(),
+		PromotionCodeRepo:            NewInMemoryPromotionCodeStore(),
 		AddonAssociationRepo:         NewInMemoryAddonAssociationStore(),
 		ConnectionRepo:               NewInMemoryConnectionStore(),
 		EntityIntegrationMappingRepo: NewInMemoryEntityIntegrationMappingStore(),
 		SettingsRepo:                 NewInMemorySettingsStore(),
 		AlertLogsRepo:                NewInMemoryAlertLogsStore(),
+		EventSchemaRepo:              NewInMemoryEventSchemaStore(),
+		RejectedEventRepo:            NewInMemoryRejectedEventStore(),
 	}
 
 	s.db = NewMockPostgresClient(s.logger)
@@ -260,10 +268,13 @@ func (s *BaseServiceTestSuite) clearStores() {
 	s.stores.CouponRepo.(*InMemoryCouponStore).Clear()
 	s.stores.CouponAssociationRepo.(*InMemoryCouponAssociationStore).Clear()
 	s.stores.CouponApplicationRepo.(*InMemoryCouponApplicationStore).Clear()
+	s.stores.PromotionCodeRepo.(*InMemoryPromotionCodeStore).Clear()
 	s.stores.AddonAssociationRepo.(*InMemoryAddonAssociationStore).Clear()
 	s.stores.SettingsRepo.(*InMemorySettingsStore).Clear()
 	s.stores.SubscriptionLineItemRepo.(*InMemorySubscriptionLineItemStore).Clear()
 	s.stores.AlertLogsRepo.(*InMemoryAlertLogsStore).Clear()
+	s.stores.EventSchemaRepo.(*InMemoryEventSchemaStore).Clear()
+	s.stores.RejectedEventRepo.(*InMemoryRejectedEventStore).Clear()
 }
 
 func (s *BaseServiceTestSuite) ClearStores() {
@@ -177,6 +177,7 @@ func copyConnection(c *connection.Connection) *connection.Connection {
 		Name:                c.Name,
 		ProviderType:        c.ProviderType,
 		EncryptedSecretData: c.EncryptedSecretData,
+		Metadata:            c.Metadata,
 		EnvironmentID:       c.EnvironmentID,
 		BaseModel: types.BaseModel{
 			TenantID:  c.TenantID,
@@ -112,6 +112,27 @@ func (s *InMemoryCouponAssociationStore) GetBySubscription(ctx context.Context,
 	}), nil
 }
 
+// GetBySubscriptionsAndCoupon retrieves the associations of a coupon with any of the given subscriptions
+func (s *InMemoryCouponAssociationStore) GetBySubscriptionsAndCoupon(ctx context.Context, subscriptionIDs []string, couponID string) ([]*coupon_association.CouponAssociation, error) {
+	filterFn := func(ctx context.Context, ca *coupon_association.CouponAssociation, _ interface{}) bool {
+		return lo.Contains(subscriptionIDs, ca.SubscriptionID) &&
+			ca.CouponID == couponID &&
+			ca.TenantID == types.GetTenantID(ctx) &&
+			CheckEnvironmentFilter(ctx, ca.EnvironmentID)
+	}
+
+	associations, err := s.InMemoryStore.List(ctx, nil, filterFn, nil)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list coupon associations").
+			Mark(ierr.ErrDatabase)
+	}
+
+	return lo.Map(associations, func(ca *coupon_association.CouponAssociation, _ int) *coupon_association.CouponAssociation {
+		return copyCouponAssociation(ca)
+	}), nil
+}
+
 // GetBySubscriptionForLineItems retrieves coupon associations that target specific subscription line items
 // for a given subscription. It excludes invoice-level associations (those without SubscriptionLineItemID).
 func (s *InMemoryCouponAssociationStore) GetBySubscriptionForLineItems(ctx context.Context, subscriptionID string) ([]*coupon_association.CouponAssociation, error) {
@@ -44,6 +44,7 @@ func copyCreditNote(cn *creditnote.CreditNote) *creditnote.CreditNote {
 		CreditNoteStatus: cn.CreditNoteStatus,
 		CreditNoteType:   cn.CreditNoteType,
 		RefundStatus:     cn.RefundStatus,
+		RefundMethod:     cn.RefundMethod,
 		Reason:           cn.Reason,
 		Memo:             cn.Memo,
 		Currency:         cn.Currency,
@@ -41,6 +41,7 @@ func copyCustomer(c *customer.Customer) *customer.Customer {
 		AddressPostalCode: c.AddressPostalCode,
 		AddressCountry:    c.AddressCountry,
 		Metadata:          lo.Assign(map[string]string{}, c.Metadata),
+		ParentCustomerID:  lo.EmptyableToPtr(lo.FromPtr(c.ParentCustomerID)),
 		EnvironmentID:     c.EnvironmentID,
 		BaseModel: types.BaseModel{
 			TenantID:  c.TenantID,
@@ -60,6 +61,18 @@ func (s *InMemoryCustomerStore) Create(ctx context.Context, c *customer.Customer
 	if c.EnvironmentID == "" {
 		c.EnvironmentID = types.GetEnvironmentID(ctx)
 	}
+
+	// Mirror the unique index on the external id of the published customers of an environment
+	if c.ExternalID != "" && c.Status == types.StatusPublished {
+		if existing, err := s.GetByLookupKey(ctx, c.ExternalID); err == nil && existing.Status == types.StatusPublished {
+			return ierr.NewError("customer already exists").
+				WithHint("A customer with this identifier already exists").
+				WithReportableDetails(map[string]any{
+					"external_id": c.ExternalID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+	}
 	return s.InMemoryStore.Create(ctx, c.ID, copyCustomer(c))
 }
 
@@ -161,6 +174,11 @@ func customerFilterFn(ctx context.Context, c *customer.Customer, filter interfac
 		return false
 	}
 
+	// Apply parent customer ID filter
+	if len(f.ParentCustomerIDs) > 0 && !lo.Contains(f.ParentCustomerIDs, lo.FromPtr(c.ParentCustomerID)) {
+		return false
+	}
+
 	// Apply time range filter if present
 	if f.TimeRangeFilter != nil {
 		if f.StartTime != nil && c.CreatedAt.Before(*f.StartTime) {
@@ -0,0 +1,130 @@
+package testutil
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/domain/eventschema"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// InMemoryEventSchemaStore implements eventschema.Repository
+type InMemoryEventSchemaStore struct {
+	*InMemoryStore[*eventschema.EventSchema]
+}
+
+func NewInMemoryEventSchemaStore() *InMemoryEventSchemaStore {
+	return &InMemoryEventSchemaStore{
+		InMemoryStore: NewInMemoryStore[*eventschema.EventSchema](),
+	}
+}
+
+func (s *InMemoryEventSchemaStore) Create(ctx context.Context, schema *eventschema.EventSchema) error {
+	if schema == nil {
+		return ierr.NewError("event schema cannot be nil").
+			WithHint("Event schema data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	if schema.EnvironmentID == "" {
+		schema.EnvironmentID = types.GetEnvironmentID(ctx)
+	}
+
+	existing, err := s.GetByEventName(ctx, schema.EventName)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return ierr.NewError("event schema already exists").
+			WithHint("An event schema for this event name already exists").
+			WithReportableDetails(map[string]any{
+				"event_name": schema.EventName,
+			}).
+			Mark(ierr.ErrAlreadyExists)
+	}
+
+	return s.InMemoryStore.Create(ctx, schema.ID, schema)
+}
+
+func (s *InMemoryEventSchemaStore) Get(ctx context.Context, id string) (*eventschema.EventSchema, error) {
+	schema, err := s.InMemoryStore.Get(ctx, id)
+	if err != nil || schema.Status != types.StatusPublished {
+		return nil, ierr.NewError("event schema not found").
+			WithHintf("Event schema with ID %s was not found", id).
+			WithReportableDetails(map[string]any{
+				"id": id,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+	return schema, nil
+}
+
+func (s *InMemoryEventSchemaStore) GetByEventName(ctx context.Context, eventName string) (*eventschema.EventSchema, error) {
+	schemas, err := s.InMemoryStore.List(ctx, &types.EventSchemaFilter{
+		QueryFilter: types.NewNoLimitQueryFilter(),
+		EventNames:  []string{eventName},
+	}, eventSchemaFilterFn, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(schemas) == 0 {
+		return nil, nil
+	}
+	return schemas[0], nil
+}
+
+func (s *InMemoryEventSchemaStore) Update(ctx context.Context, schema *eventschema.EventSchema) error {
+	return s.InMemoryStore.Update(ctx, schema.ID, schema)
+}
+
+func (s *InMemoryEventSchemaStore) Delete(ctx context.Context, id string) error {
+	schema, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	schema.Status = types.StatusArchived
+	return s.InMemoryStore.Update(ctx, id, schema)
+}
+
+func (s *InMemoryEventSchemaStore) List(ctx context.Context, filter *types.EventSchemaFilter) ([]*eventschema.EventSchema, error) {
+	return s.InMemoryStore.List(ctx, filter, eventSchemaFilterFn, eventSchemaSortFn)
+}
+
+func (s *InMemoryEventSchemaStore) Count(ctx context.Context, filter *types.EventSchemaFilter) (int, error) {
+	return s.InMemoryStore.Count(ctx, filter, eventSchemaFilterFn)
+}
+
+func eventSchemaFilterFn(ctx context.Context, schema *eventschema.EventSchema, filter interface{}) bool {
+	if schema == nil {
+		return false
+	}
+
+	if !CheckTenantFilter(ctx, schema.TenantID) || !CheckEnvironmentFilter(ctx, schema.EnvironmentID) {
+		return false
+	}
+
+	// archived schemas are deleted
+	if schema.Status != types.StatusPublished {
+		return false
+	}
+
+	f, ok := filter.(*types.EventSchemaFilter)
+	if !ok {
+		return true
+	}
+
+	if len(f.EventSchemaIDs) > 0 && !lo.Contains(f.EventSchemaIDs, schema.ID) {
+		return false
+	}
+
+	if len(f.EventNames) > 0 && !lo.Contains(f.EventNames, schema.EventName) {
+		return false
+	}
+
+	return true
+}
+
+func eventSchemaSortFn(i, j *eventschema.EventSchema) bool {
+	return i.CreatedAt.After(j.CreatedAt)
+}
@@ -341,13 +341,14 @@ func invoiceFilterFn(ctx context.Context, inv *invoice.Invoice, filter interface
 
 	// Filter by time range
 	if f.TimeRangeFilter != nil && (f.TimeRangeFilter.StartTime != nil || f.TimeRangeFilter.EndTime != nil) {
+		// Same as the ent repository, the invoice period has to be within the time range
 		if f.TimeRangeFilter.StartTime != nil {
-			if inv.PeriodStart == nil || inv.PeriodStart.After(*f.TimeRangeFilter.StartTime) {
+			if inv.PeriodStart == nil || inv.PeriodStart.Before(*f.TimeRangeFilter.StartTime) {
 				return false
 			}
 		}
 		if f.TimeRangeFilter.EndTime != nil {
-			if inv.PeriodEnd == nil || inv.PeriodEnd.Before(*f.TimeRangeFilter.EndTime) {
+			if inv.PeriodEnd == nil || inv.PeriodEnd.After(*f.TimeRangeFilter.EndTime) {
 				return false
 			}
 		}
@@ -52,6 +52,15 @@ func (b *InMemoryKafka) Publish(ctx context.Context, event *events.Event) error
 	return nil
 }
 
+func (b *InMemoryKafka) PublishBatch(ctx context.Context, batch []*events.Event) error {
+	for _, event := range batch {
+		if err := b.Publish(ctx, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (b *InMemoryKafka) Subscribe() chan *message.Message {
 	b.mu.Lock()
 	defer b.mu.Unlock()
@@ -151,40 +151,8 @@ func (s *InMemoryMeterStore) UpdateMeter(ctx context.Context, id string, filters
 		return err
 	}
 
-	if m.Filters == nil {
-		m.Filters = []meter.Filter{}
-	}
-
-	// Merge new filters into the existing filters
-	existingFilters := map[string][]string{}
-	for _, f := range m.Filters {
-		existingFilters[f.Key] = f.Values
-	}
-
-	for _, newFilter := range filters {
-		if _, exists := existingFilters[newFilter.Key]; !exists {
-			// If the key doesn't exist, add the entire filter
-			existingFilters[newFilter.Key] = newFilter.Values
-		} else {
-			// Append new values for an existing key, avoiding duplicates
-			for _, newValue := range newFilter.Values {
-				if !lo.Contains(existingFilters[newFilter.Key], newValue) {
-					existingFilters[newFilter.Key] = append(existingFilters[newFilter.Key], newValue)
-				}
-			}
-		}
-	}
-
-	// Update the meter's filters
-	updatedFilters := []meter.Filter{}
-	for key, values := range existingFilters {
-		updatedFilters = append(updatedFilters, meter.Filter{
-			Key:    key,
-			Values: values,
-		})
-	}
-
-	m.Filters = updatedFilters
+	// Filters are merged by the service, the repository replaces them as-is
+	m.Filters = filters
 	err = s.InMemoryStore.Update(ctx, m.ID, m)
 	if err != nil {
 		return ierr.WithError(err).
@@ -0,0 +1,41 @@
+package testutil
+
+import (
+	"context"
+	"sync"
+
+	"github.com/flexprice/flexprice/internal/domain/events"
+)
+
+// InMemoryMetricSeriesStateStore implements events.MetricSeriesStateRepository
+type InMemoryMetricSeriesStateStore struct {
+	mu       sync.Mutex
+	states   map[string]*events.MetricSeriesState
+	previous map[string]*events.MetricSeriesState
+}
+
+func NewInMemoryMetricSeriesStateStore() *InMemoryMetricSeriesStateStore {
+	return &InMemoryMetricSeriesStateStore{
+		states:   make(map[string]*events.MetricSeriesState),
+		previous: make(map[string]*events.MetricSeriesState),
+	}
+}
+
+func (s *InMemoryMetricSeriesStateStore) Advance(ctx context.Context, state *events.MetricSeriesState) (*events.MetricSeriesState, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := state.TenantID + ":" + state.EnvironmentID + ":" + state.SeriesID
+	last, ok := s.states[key]
+	if ok && last.ValueAt.Equal(state.ValueAt) && last.Value == state.Value && last.Count == state.Count {
+		return s.previous[key], true, nil
+	}
+	if ok && !last.ValueAt.Before(state.ValueAt) {
+		return nil, false, nil
+	}
+
+	stored := *state
+	s.states[key] = &stored
+	s.previous[key] = last
+	return last, true, nil
+}
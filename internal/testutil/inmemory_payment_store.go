@@ -18,6 +18,7 @@ type InMemoryPaymentStore struct {
 	*InMemoryStore[*payment.Payment]
 	mu             sync.RWMutex
 	attempts       map[string]*payment.PaymentAttempt
+	refunds        map[string]*payment.PaymentRefund
 	createdInOrder []*payment.Payment
 }
 
@@ -26,6 +27,7 @@ func NewInMemoryPaymentStore() *InMemoryPaymentStore {
 	return &InMemoryPaymentStore{
 		InMemoryStore:  NewInMemoryStore[*payment.Payment](),
 		attempts:       make(map[string]*payment.PaymentAttempt),
+		refunds:        make(map[string]*payment.PaymentRefund),
 		createdInOrder: make([]*payment.Payment, 0),
 	}
 }
@@ -36,6 +38,7 @@ func (m *InMemoryPaymentStore) Clear() {
 	defer m.mu.Unlock()
 	m.InMemoryStore.Clear()
 	m.attempts = make(map[string]*payment.PaymentAttempt)
+	m.refunds = make(map[string]*payment.PaymentRefund)
 	m.createdInOrder = make([]*payment.Payment, 0)
 }
 
@@ -89,6 +92,31 @@ func (m *InMemoryPaymentStore) Update(ctx context.Context, p *payment.Payment) e
 	return m.InMemoryStore.Update(ctx, p.ID, p)
 }
 
+// UpdateIfStatus updates a payment while it is in one of the from statuses
+func (m *InMemoryPaymentStore) UpdateIfStatus(ctx context.Context, p *payment.Payment, from ...types.PaymentStatus) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	existing, err := m.InMemoryStore.Get(ctx, p.ID)
+	if err != nil {
+		return false, err
+	}
+	if !lo.Contains(from, existing.PaymentStatus) {
+		return false, nil
+	}
+
+	p.UpdatedAt = time.Now().UTC()
+	if err := m.InMemoryStore.Update(ctx, p.ID, p); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// LockByDestination is a no-op, the in-memory store has no transactions
+func (m *InMemoryPaymentStore) LockByDestination(ctx context.Context, destinationType types.PaymentDestinationType, destinationID string) error {
+	return nil
+}
+
 // Delete removes a payment
 func (m *InMemoryPaymentStore) Delete(ctx context.Context, id string) error {
 	m.mu.Lock()
@@ -290,6 +318,103 @@ func (m *InMemoryPaymentStore) GetLatestAttempt(ctx context.Context, paymentID s
 	return latest, nil
 }
 
+// CreateRefund creates a new payment refund
+func (m *InMemoryPaymentStore) CreateRefund(ctx context.Context, refund *payment.PaymentRefund) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if refund.ID == "" {
+		return ierr.NewError("refund ID cannot be empty").
+			WithHint("Refund ID cannot be empty").
+			Mark(ierr.ErrValidation)
+	}
+
+	// Check if payment exists
+	_, err := m.InMemoryStore.Get(ctx, refund.PaymentID)
+	if err != nil {
+		return err
+	}
+
+	// Set environment ID from context if not already set
+	if refund.EnvironmentID == "" {
+		refund.EnvironmentID = types.GetEnvironmentID(ctx)
+	}
+
+	m.refunds[refund.ID] = refund
+	return nil
+}
+
+// GetRefund retrieves a payment refund by ID
+func (m *InMemoryPaymentStore) GetRefund(ctx context.Context, id string) (*payment.PaymentRefund, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	refund, exists := m.refunds[id]
+	if !exists || !m.refundVisible(ctx, refund) {
+		return nil, ierr.NewError("refund not found").
+			WithHint(fmt.Sprintf("Refund not found: %s", id)).
+			Mark(ierr.ErrNotFound)
+	}
+
+	return refund, nil
+}
+
+// UpdateRefund updates an existing payment refund
+func (m *InMemoryPaymentStore) UpdateRefund(ctx context.Context, refund *payment.PaymentRefund) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	existing, exists := m.refunds[refund.ID]
+	if !exists || !m.refundVisible(ctx, existing) {
+		return ierr.NewError("refund not found").
+			WithHint(fmt.Sprintf("Refund not found: %s", refund.ID)).
+			Mark(ierr.ErrNotFound)
+	}
+
+	refund.UpdatedAt = time.Now().UTC()
+	m.refunds[refund.ID] = refund
+	return nil
+}
+
+// ListRefunds returns the refunds of a payment
+func (m *InMemoryPaymentStore) ListRefunds(ctx context.Context, paymentID string) ([]*payment.PaymentRefund, error) {
+	return m.listRefunds(ctx, func(r *payment.PaymentRefund) bool {
+		return r.PaymentID == paymentID
+	}), nil
+}
+
+// ListRefundsByCreditNote returns the refunds issued by a credit note
+func (m *InMemoryPaymentStore) ListRefundsByCreditNote(ctx context.Context, creditNoteID string) ([]*payment.PaymentRefund, error) {
+	return m.listRefunds(ctx, func(r *payment.PaymentRefund) bool {
+		return lo.FromPtr(r.CreditNoteID) == creditNoteID
+	}), nil
+}
+
+func (m *InMemoryPaymentStore) listRefunds(ctx context.Context, match func(*payment.PaymentRefund) bool) []*payment.PaymentRefund {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	result := make([]*payment.PaymentRefund, 0)
+	for _, r := range m.refunds {
+		if match(r) && m.refundVisible(ctx, r) {
+			result = append(result, r)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].CreatedAt.Before(result[j].CreatedAt)
+	})
+	return result
+}
+
+// refundVisible checks the tenant and the environment of the refund against the context
+func (m *InMemoryPaymentStore) refundVisible(ctx context.Context, r *payment.PaymentRefund) bool {
+	if tenantID, ok := ctx.Value(types.CtxTenantID).(string); ok && r.TenantID != tenantID {
+		return false
+	}
+	return CheckEnvironmentFilter(ctx, r.EnvironmentID)
+}
+
 // paymentFilterFn implements filtering logic for payments
 func paymentFilterFn(ctx context.Context, p *payment.Payment, filter interface{}) bool {
 	if p == nil {
@@ -0,0 +1,180 @@
+package testutil
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/domain/promotion_code"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// InMemoryPromotionCodeStore implements promotion_code.Repository
+type InMemoryPromotionCodeStore struct {
+	*InMemoryStore[*promotion_code.PromotionCode]
+}
+
+// NewInMemoryPromotionCodeStore creates a new in-memory promotion code store
+func NewInMemoryPromotionCodeStore() *InMemoryPromotionCodeStore {
+	return &InMemoryPromotionCodeStore{
+		InMemoryStore: NewInMemoryStore[*promotion_code.PromotionCode](),
+	}
+}
+
+// Helper to copy promotion code
+func copyPromotionCode(p *promotion_code.PromotionCode) *promotion_code.PromotionCode {
+	if p == nil {
+		return nil
+	}
+
+	copied := *p
+	copied.Metadata = lo.Assign(map[string]string{}, p.Metadata)
+	return &copied
+}
+
+func (s *InMemoryPromotionCodeStore) Create(ctx context.Context, p *promotion_code.PromotionCode) error {
+	if p == nil {
+		return ierr.NewError("promotion code cannot be nil").
+			WithHint("Promotion code cannot be nil").
+			Mark(ierr.ErrValidation)
+	}
+
+	// Set environment ID from context if not already set
+	if p.EnvironmentID == "" {
+		p.EnvironmentID = types.GetEnvironmentID(ctx)
+	}
+
+	if existing, err := s.GetByCode(ctx, p.Code); err == nil && existing != nil {
+		return ierr.NewError("promotion code already exists").
+			WithHint("A promotion code with this code already exists").
+			Mark(ierr.ErrAlreadyExists)
+	}
+
+	return s.InMemoryStore.Create(ctx, p.ID, copyPromotionCode(p))
+}
+
+func (s *InMemoryPromotionCodeStore) CreateBulk(ctx context.Context, promotionCodes []*promotion_code.PromotionCode) error {
+	for _, p := range promotionCodes {
+		if err := s.Create(ctx, p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *InMemoryPromotionCodeStore) Get(ctx context.Context, id string) (*promotion_code.PromotionCode, error) {
+	p, err := s.InMemoryStore.Get(ctx, id)
+	if err != nil {
+		return nil, ierr.NewError("promotion code not found").
+			WithHint("Promotion code not found").
+			WithReportableDetails(map[string]interface{}{
+				"id": id,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+	return copyPromotionCode(p), nil
+}
+
+func (s *InMemoryPromotionCodeStore) GetByCode(ctx context.Context, code string) (*promotion_code.PromotionCode, error) {
+	filterFn := func(ctx context.Context, p *promotion_code.PromotionCode, _ interface{}) bool {
+		return p.Code == code &&
+			p.Status == types.StatusPublished &&
+			p.TenantID == types.GetTenantID(ctx) &&
+			CheckEnvironmentFilter(ctx, p.EnvironmentID)
+	}
+
+	items, err := s.InMemoryStore.List(ctx, nil, filterFn, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, ierr.NewError("promotion code not found").
+			WithHintf("Promotion code %s was not found", code).
+			Mark(ierr.ErrNotFound)
+	}
+	return copyPromotionCode(items[0]), nil
+}
+
+func (s *InMemoryPromotionCodeStore) Delete(ctx context.Context, id string) error {
+	p, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	p.Status = types.StatusArchived
+	return s.InMemoryStore.Update(ctx, p.ID, p)
+}
+
+func (s *InMemoryPromotionCodeStore) List(ctx context.Context, filter *types.PromotionCodeFilter) ([]*promotion_code.PromotionCode, error) {
+	items, err := s.InMemoryStore.List(ctx, filter, promotionCodeFilterFn, promotionCodeSortFn)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(items, func(p *promotion_code.PromotionCode, _ int) *promotion_code.PromotionCode {
+		return copyPromotionCode(p)
+	}), nil
+}
+
+func (s *InMemoryPromotionCodeStore) Count(ctx context.Context, filter *types.PromotionCodeFilter) (int, error) {
+	return s.InMemoryStore.Count(ctx, filter, promotionCodeFilterFn)
+}
+
+func (s *InMemoryPromotionCodeStore) IncrementRedemptions(ctx context.Context, id string) error {
+	p, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if !p.HasRedemptionsLeft() {
+		return ierr.NewError("promotion code redemption limit reached").
+			WithHint("The promotion code has reached its maximum number of redemptions").
+			Mark(ierr.ErrValidation)
+	}
+
+	p.TotalRedemptions++
+	return s.InMemoryStore.Update(ctx, p.ID, p)
+}
+
+// promotionCodeFilterFn implements filtering logic for promotion codes
+func promotionCodeFilterFn(ctx context.Context, p *promotion_code.PromotionCode, filter interface{}) bool {
+	f, ok := filter.(*types.PromotionCodeFilter)
+	if !ok {
+		return false
+	}
+
+	if tenantID := types.GetTenantID(ctx); tenantID != "" && p.TenantID != tenantID {
+		return false
+	}
+
+	if !CheckEnvironmentFilter(ctx, p.EnvironmentID) {
+		return false
+	}
+
+	if f.GetStatus() != "" && string(p.Status) != f.GetStatus() {
+		return false
+	}
+
+	if len(f.PromotionCodeIDs) > 0 && !lo.Contains(f.PromotionCodeIDs, p.ID) {
+		return false
+	}
+
+	if f.CouponID != "" && p.CouponID != f.CouponID {
+		return false
+	}
+
+	if f.CustomerID != "" && lo.FromPtr(p.CustomerID) != f.CustomerID {
+		return false
+	}
+
+	if len(f.Codes) > 0 && !lo.Contains(f.Codes, p.Code) {
+		return false
+	}
+
+	return true
+}
+
+// promotionCodeSortFn implements sorting logic for promotion codes
+func promotionCodeSortFn(i, j *promotion_code.PromotionCode) bool {
+	return i.CreatedAt.After(j.CreatedAt)
+}
@@ -87,6 +87,16 @@ func (p *InMemoryPublisherService) Publish(ctx context.Context, event *events.Ev
 	return nil
 }
 
+// PublishBatch implements publisher.Service interface
+func (p *InMemoryPublisherService) PublishBatch(ctx context.Context, batch []*events.Event) error {
+	for _, event := range batch {
+		if err := p.Publish(ctx, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Subscribe returns a channel for receiving messages
 func (p *InMemoryPublisherService) Subscribe() chan *message.Message {
 	p.mu.Lock()
@@ -0,0 +1,77 @@
+package testutil
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/flexprice/flexprice/internal/domain/events"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// InMemoryRejectedEventStore implements events.RejectedEventRepository
+type InMemoryRejectedEventStore struct {
+	mu     sync.RWMutex
+	events []*events.RejectedEvent
+}
+
+func NewInMemoryRejectedEventStore() *InMemoryRejectedEventStore {
+	return &InMemoryRejectedEventStore{}
+}
+
+func (s *InMemoryRejectedEventStore) BulkInsertRejectedEvents(ctx context.Context, rejectedEvents []*events.RejectedEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.events = append(s.events, rejectedEvents...)
+	return nil
+}
+
+func (s *InMemoryRejectedEventStore) GetRejectedEvents(ctx context.Context, params *events.GetRejectedEventsParams) ([]*events.RejectedEvent, uint64, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var matched []*events.RejectedEvent
+	for _, event := range s.events {
+		if event.TenantID != types.GetTenantID(ctx) || !CheckEnvironmentFilter(ctx, event.EnvironmentID) {
+			continue
+		}
+		if params.EventName != "" && event.EventName != params.EventName {
+			continue
+		}
+		if params.ExternalCustomerID != "" && event.ExternalCustomerID != params.ExternalCustomerID {
+			continue
+		}
+		if params.Action != "" && event.Action != params.Action {
+			continue
+		}
+		if !params.StartTime.IsZero() && event.RejectedAt.Before(params.StartTime) {
+			continue
+		}
+		if !params.EndTime.IsZero() && event.RejectedAt.After(params.EndTime) {
+			continue
+		}
+		matched = append(matched, event)
+	}
+
+	sort.SliceStable(matched, func(i, j int) bool {
+		return matched[i].RejectedAt.After(matched[j].RejectedAt)
+	})
+
+	total := uint64(len(matched))
+	if params.Offset >= len(matched) {
+		return []*events.RejectedEvent{}, total, nil
+	}
+	matched = matched[params.Offset:]
+	if params.PageSize > 0 && params.PageSize < len(matched) {
+		matched = matched[:params.PageSize]
+	}
+	return matched, total, nil
+}
+
+func (s *InMemoryRejectedEventStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.events = nil
+}
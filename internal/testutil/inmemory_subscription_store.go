@@ -418,6 +418,26 @@ func (s *InMemorySubscriptionStore) ListSubscriptionsDueForRenewal(ctx context.C
 	return s.ListAll(ctx, filter)
 }
 
+// ListSubscriptionsWithUsageAlerts retrieves all active subscriptions that have usage alerts configured
+func (s *InMemorySubscriptionStore) ListSubscriptionsWithUsageAlerts(ctx context.Context) ([]*subscription.Subscription, error) {
+	filter := &types.SubscriptionFilter{
+		QueryFilter: types.NewNoLimitQueryFilter(),
+		SubscriptionStatus: []types.SubscriptionStatus{
+			types.SubscriptionStatusActive,
+			types.SubscriptionStatusTrialing,
+		},
+	}
+
+	subs, err := s.ListAll(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Filter(subs, func(sub *subscription.Subscription, _ int) bool {
+		return len(sub.UsageAlerts) > 0
+	}), nil
+}
+
 // Clear removes all data from the store
 func (s *InMemorySubscriptionStore) Clear() {
 	// Clear the base subscription store
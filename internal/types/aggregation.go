@@ -12,7 +12,10 @@ const (
 	AggregationLatest            AggregationType = "LATEST"
 	AggregationSumWithMultiplier AggregationType = "SUM_WITH_MULTIPLIER" // Sum with a multiplier - [sum(value) * multiplier]
 	AggregationMax               AggregationType = "MAX"
+	AggregationMin               AggregationType = "MIN"
+	AggregationFirst             AggregationType = "FIRST" // Earliest value in the period, the counterpart of LATEST
 	AggregationWeightedSum       AggregationType = "WEIGHTED_SUM"
+	AggregationPercentile        AggregationType = "PERCENTILE" // Nth percentile of the values - e.g. P95 bandwidth
 )
 
 func (t AggregationType) Validate() bool {
@@ -24,7 +27,10 @@ func (t AggregationType) Validate() bool {
 		AggregationLatest,
 		AggregationSumWithMultiplier,
 		AggregationMax,
-		AggregationWeightedSum:
+		AggregationMin,
+		AggregationFirst,
+		AggregationWeightedSum,
+		AggregationPercentile:
 		return true
 	default:
 		return false
@@ -40,3 +46,25 @@ func (t AggregationType) RequiresField() bool {
 		return true
 	}
 }
+
+// SupportsBucketSize returns true if the aggregation type can be computed over
+// fixed time buckets, where each bucket is aggregated and billed independently
+func (t AggregationType) SupportsBucketSize() bool {
+	switch t {
+	case AggregationMax, AggregationMin, AggregationFirst, AggregationPercentile:
+		return true
+	default:
+		return false
+	}
+}
+
+// SupportsExpression returns true if the aggregation type operates on a numeric value
+// that can be computed from an expression over multiple event properties
+func (t AggregationType) SupportsExpression() bool {
+	switch t {
+	case AggregationCount, AggregationCountUnique:
+		return false
+	default:
+		return t.Validate()
+	}
+}
@@ -24,20 +24,25 @@ const (
 	AlertTypeLowOngoingBalance    AlertType = "low_ongoing_balance"
 	AlertTypeLowCreditBalance     AlertType = "low_credit_balance"
 	AlertTypeFeatureWalletBalance AlertType = "feature_wallet_balance"
+	AlertTypeSubscriptionSpend    AlertType = "subscription_spend"
+	AlertTypeFeatureSpend         AlertType = "feature_spend"
+	AlertTypeFeatureUsage         AlertType = "feature_usage"
 )
 
 // AlertEntityType represents the type of entity for alerts
 type AlertEntityType string
 
 const (
-	AlertEntityTypeWallet  AlertEntityType = "wallet"
-	AlertEntityTypeFeature AlertEntityType = "feature"
+	AlertEntityTypeWallet       AlertEntityType = "wallet"
+	AlertEntityTypeFeature      AlertEntityType = "feature"
+	AlertEntityTypeSubscription AlertEntityType = "subscription"
 )
 
 func (aet AlertEntityType) Validate() error {
 	allowedTypes := []AlertEntityType{
 		AlertEntityTypeWallet,
 		AlertEntityTypeFeature,
+		AlertEntityTypeSubscription,
 	}
 	if !lo.Contains(allowedTypes, aet) {
 		return ierr.NewError("invalid alert entity type").
@@ -51,12 +56,14 @@ func (aet AlertEntityType) Validate() error {
 type AlertThresholdType string
 
 const (
-	AlertThresholdTypeAmount AlertThresholdType = "amount"
+	AlertThresholdTypeAmount     AlertThresholdType = "amount"
+	AlertThresholdTypePercentage AlertThresholdType = "percentage"
 )
 
 func (att AlertThresholdType) Validate() error {
 	allowedTypes := []AlertThresholdType{
 		AlertThresholdTypeAmount,
+		AlertThresholdTypePercentage,
 	}
 	if !lo.Contains(allowedTypes, att) {
 		return ierr.NewError("invalid alert threshold type").
@@ -71,6 +78,9 @@ func (at AlertType) Validate() error {
 		AlertTypeLowOngoingBalance,
 		AlertTypeLowCreditBalance,
 		AlertTypeFeatureWalletBalance,
+		AlertTypeSubscriptionSpend,
+		AlertTypeFeatureSpend,
+		AlertTypeFeatureUsage,
 	}
 	if !lo.Contains(allowedTypes, at) {
 		return ierr.NewError("invalid alert type").
@@ -81,9 +91,10 @@ func (at AlertType) Validate() error {
 }
 
 type AlertInfo struct {
-	AlertSettings *AlertSettings  `json:"alert_settings,omitempty"`
-	ValueAtTime   decimal.Decimal `json:"value_at_time"`
-	Timestamp     time.Time       `json:"timestamp"`
+	AlertSettings *AlertSettings     `json:"alert_settings,omitempty"`
+	ThresholdType AlertThresholdType `json:"threshold_type,omitempty"`
+	ValueAtTime   decimal.Decimal    `json:"value_at_time"`
+	Timestamp     time.Time          `json:"timestamp"`
 }
 
 // AlertConfig represents the configuration for wallet alerts
@@ -97,6 +108,90 @@ type WalletAlertThreshold struct {
 	Value decimal.Decimal    `json:"value"`
 }
 
+// UsageAlert defines a spend or usage threshold evaluated against a subscription's current billing period.
+// Subscription spend alerts compare the total period cost, feature spend alerts the cost of a single feature and
+// feature usage alerts either the usage quantity (amount) or the share of the entitlement usage limit (percentage).
+type UsageAlert struct {
+	AlertType     AlertType          `json:"alert_type"`
+	FeatureID     string             `json:"feature_id,omitempty"`
+	ThresholdType AlertThresholdType `json:"threshold_type,omitempty"`
+	AlertSettings *AlertSettings     `json:"alert_settings"`
+}
+
+// GetThresholdType returns the threshold type, defaulting to an amount threshold
+func (ua *UsageAlert) GetThresholdType() AlertThresholdType {
+	if ua.ThresholdType == "" {
+		return AlertThresholdTypeAmount
+	}
+	return ua.ThresholdType
+}
+
+func (ua *UsageAlert) Validate() error {
+	switch ua.AlertType {
+	case AlertTypeSubscriptionSpend:
+		if ua.FeatureID != "" {
+			return ierr.NewError("feature_id is not allowed for subscription spend alerts").
+				WithHint("Use a feature spend alert to track the spend of a single feature").
+				Mark(ierr.ErrValidation)
+		}
+	case AlertTypeFeatureSpend, AlertTypeFeatureUsage:
+		if ua.FeatureID == "" {
+			return ierr.NewError("feature_id is required for feature alerts").
+				WithHint("Please provide the feature to track").
+				WithReportableDetails(map[string]interface{}{
+					"alert_type": ua.AlertType,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	default:
+		return ierr.NewError("invalid usage alert type").
+			WithHint("Usage alert type must be one of subscription_spend, feature_spend or feature_usage").
+			WithReportableDetails(map[string]interface{}{
+				"alert_type": ua.AlertType,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if err := ua.GetThresholdType().Validate(); err != nil {
+		return err
+	}
+	if ua.GetThresholdType() == AlertThresholdTypePercentage && ua.AlertType != AlertTypeFeatureUsage {
+		return ierr.NewError("percentage thresholds are only supported for feature usage alerts").
+			WithHint("Spend alerts only support amount thresholds").
+			Mark(ierr.ErrValidation)
+	}
+
+	if ua.AlertSettings == nil {
+		return ierr.NewError("alert_settings is required").
+			WithHint("Please provide at least one threshold").
+			Mark(ierr.ErrValidation)
+	}
+	return ua.AlertSettings.Validate()
+}
+
+// ValidateUsageAlerts validates the usage alerts of a subscription. Alert states are tracked per alert type
+// and feature, so each combination can only be configured once.
+func ValidateUsageAlerts(alerts []UsageAlert) error {
+	seen := make(map[string]bool, len(alerts))
+	for _, alert := range alerts {
+		if err := alert.Validate(); err != nil {
+			return err
+		}
+		key := string(alert.AlertType) + ":" + alert.FeatureID
+		if seen[key] {
+			return ierr.NewError("duplicate usage alert").
+				WithHint("Each alert type can only be configured once per feature").
+				WithReportableDetails(map[string]interface{}{
+					"alert_type": alert.AlertType,
+					"feature_id": alert.FeatureID,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
 // AlertLogFilter represents filters for alert log queries
 type AlertLogFilter struct {
 	*QueryFilter
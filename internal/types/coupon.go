@@ -1,10 +1,12 @@
 package types
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
 	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/shopspring/decimal"
 )
 
 // CouponType represents the type of coupon discount (fixed or percentage)
@@ -29,6 +31,71 @@ const (
 	CouponCadenceForever CouponCadence = "forever"
 )
 
+// CouponRules restricts which customers, plans, prices and invoices a coupon can be redeemed on.
+// Rules are stored on the coupon as a JSON object; an empty rule places no restriction.
+type CouponRules struct {
+	// PlanIDs restricts the coupon to subscriptions and invoice line items of these plans
+	PlanIDs []string `json:"plan_ids,omitempty"`
+	// PriceIDs restricts the coupon to subscriptions and invoice line items with these prices
+	PriceIDs []string `json:"price_ids,omitempty"`
+	// FeatureIDs restricts the coupon to subscriptions and invoice line items charging these features
+	FeatureIDs []string `json:"feature_ids,omitempty"`
+	// CustomerMetadata requires the customer metadata to contain all of these key value pairs
+	CustomerMetadata map[string]string `json:"customer_metadata,omitempty"`
+	// FirstTimeCustomerOnly restricts the coupon to customers without finalized invoices
+	// outside of the subscription the coupon is redeemed on
+	FirstTimeCustomerOnly bool `json:"first_time_customer_only,omitempty"`
+	// MinInvoiceAmount is the minimum invoice subtotal the coupon can be applied on
+	MinInvoiceAmount *decimal.Decimal `json:"min_invoice_amount,omitempty"`
+	// MaxRedemptionsPerCustomer limits how many times a customer can redeem the coupon
+	MaxRedemptionsPerCustomer *int `json:"max_redemptions_per_customer,omitempty"`
+}
+
+// ParseCouponRules converts the rules stored on a coupon into CouponRules
+func ParseCouponRules(rules map[string]interface{}) (*CouponRules, error) {
+	parsed := &CouponRules{}
+	if len(rules) == 0 {
+		return parsed, nil
+	}
+
+	data, err := json.Marshal(rules)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Invalid coupon rules").
+			Mark(ierr.ErrValidation)
+	}
+
+	// unknown keys are ignored, coupons may hold rules of other integrations or of a newer version
+	if err := json.Unmarshal(data, parsed); err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Coupon rules support plan_ids, price_ids, feature_ids, customer_metadata, first_time_customer_only, min_invoice_amount and max_redemptions_per_customer").
+			Mark(ierr.ErrValidation)
+	}
+
+	if err := parsed.Validate(); err != nil {
+		return nil, err
+	}
+
+	return parsed, nil
+}
+
+// Validate validates the coupon rules
+func (r *CouponRules) Validate() error {
+	if r.MinInvoiceAmount != nil && r.MinInvoiceAmount.IsNegative() {
+		return ierr.NewError("min_invoice_amount must not be negative").
+			WithHint("Please provide a valid minimum invoice amount").
+			Mark(ierr.ErrValidation)
+	}
+
+	if r.MaxRedemptionsPerCustomer != nil && *r.MaxRedemptionsPerCustomer <= 0 {
+		return ierr.NewError("max_redemptions_per_customer must be greater than zero").
+			WithHint("Please provide a valid maximum redemption count per customer").
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
 type CouponFilter struct {
 	*QueryFilter
 
@@ -164,3 +231,7 @@ func ValidateCouponID(id string) error {
 
 	return validateCouponID(id, "coupon id")
 }
+
+// InvoiceMetadataSkippedCoupons is the invoice metadata key listing the coupons that were not applied
+// because the invoice did not satisfy their eligibility rules
+const InvoiceMetadataSkippedCoupons = "skipped_coupons"
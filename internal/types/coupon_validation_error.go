@@ -33,6 +33,22 @@ const (
 	CouponValidationErrorCodeRepeatedCadenceLimitReached CouponValidationErrorCode = "REPEATED_CADENCE_LIMIT_REACHED"
 	CouponValidationErrorCodeInvalidRepeatedCadence      CouponValidationErrorCode = "INVALID_REPEATED_CADENCE"
 
+	// Rule validation errors
+	CouponValidationErrorCodeInvalidRules                   CouponValidationErrorCode = "INVALID_RULES"
+	CouponValidationErrorCodePlanNotEligible                CouponValidationErrorCode = "PLAN_NOT_ELIGIBLE"
+	CouponValidationErrorCodePriceNotEligible               CouponValidationErrorCode = "PRICE_NOT_ELIGIBLE"
+	CouponValidationErrorCodeFeatureNotEligible             CouponValidationErrorCode = "FEATURE_NOT_ELIGIBLE"
+	CouponValidationErrorCodeCustomerNotEligible            CouponValidationErrorCode = "CUSTOMER_NOT_ELIGIBLE"
+	CouponValidationErrorCodeFirstTimeCustomerOnly          CouponValidationErrorCode = "FIRST_TIME_CUSTOMER_ONLY"
+	CouponValidationErrorCodeMinInvoiceAmountNotMet         CouponValidationErrorCode = "MIN_INVOICE_AMOUNT_NOT_MET"
+	CouponValidationErrorCodeCustomerRedemptionLimitReached CouponValidationErrorCode = "CUSTOMER_REDEMPTION_LIMIT_REACHED"
+
+	// Promotion code validation errors
+	CouponValidationErrorCodePromotionCodeInactive               CouponValidationErrorCode = "PROMOTION_CODE_INACTIVE"
+	CouponValidationErrorCodePromotionCodeExpired                CouponValidationErrorCode = "PROMOTION_CODE_EXPIRED"
+	CouponValidationErrorCodePromotionCodeRedemptionLimitReached CouponValidationErrorCode = "PROMOTION_CODE_REDEMPTION_LIMIT_REACHED"
+	CouponValidationErrorCodePromotionCodeCustomerMismatch       CouponValidationErrorCode = "PROMOTION_CODE_CUSTOMER_MISMATCH"
+
 	// Database and system errors
 	CouponValidationErrorCodeDatabaseError CouponValidationErrorCode = "DATABASE_ERROR"
 )
@@ -55,6 +71,18 @@ func (c CouponValidationErrorCode) Validate() error {
 		CouponValidationErrorCodeOnceCadenceViolation,
 		CouponValidationErrorCodeRepeatedCadenceLimitReached,
 		CouponValidationErrorCodeInvalidRepeatedCadence,
+		CouponValidationErrorCodeInvalidRules,
+		CouponValidationErrorCodePlanNotEligible,
+		CouponValidationErrorCodePriceNotEligible,
+		CouponValidationErrorCodeFeatureNotEligible,
+		CouponValidationErrorCodeCustomerNotEligible,
+		CouponValidationErrorCodeFirstTimeCustomerOnly,
+		CouponValidationErrorCodeMinInvoiceAmountNotMet,
+		CouponValidationErrorCodeCustomerRedemptionLimitReached,
+		CouponValidationErrorCodePromotionCodeInactive,
+		CouponValidationErrorCodePromotionCodeExpired,
+		CouponValidationErrorCodePromotionCodeRedemptionLimitReached,
+		CouponValidationErrorCodePromotionCodeCustomerMismatch,
 		CouponValidationErrorCodeDatabaseError,
 	}
 
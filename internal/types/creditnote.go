@@ -110,6 +110,39 @@ func (c CreditNoteType) Validate() error {
 	return nil
 }
 
+// CreditNoteRefundMethod is where the amount of a refund credit note goes when it is finalized
+type CreditNoteRefundMethod string
+
+const (
+	// CreditNoteRefundMethodWallet credits the amount to the prepaid wallet of the customer
+	CreditNoteRefundMethodWallet CreditNoteRefundMethod = "WALLET"
+	// CreditNoteRefundMethodOriginalPaymentMethod refunds the gateway payments that settled the invoice
+	CreditNoteRefundMethodOriginalPaymentMethod CreditNoteRefundMethod = "ORIGINAL_PAYMENT_METHOD"
+)
+
+func (c CreditNoteRefundMethod) String() string {
+	return string(c)
+}
+
+func (c CreditNoteRefundMethod) Validate() error {
+
+	allowed := []CreditNoteRefundMethod{
+		CreditNoteRefundMethodWallet,
+		CreditNoteRefundMethodOriginalPaymentMethod,
+	}
+
+	if !lo.Contains(allowed, c) {
+		return ierr.NewError("invalid credit note refund method").
+			WithHint("Please provide a valid credit note refund method").
+			WithReportableDetails(map[string]any{
+				"allowed": allowed,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
 // CreditNoteFilter represents the filter options for listing credit notes
 type CreditNoteFilter struct {
 	*QueryFilter
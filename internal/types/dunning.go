@@ -0,0 +1,57 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/samber/lo"
+)
+
+// DunningFinalAction is applied to the subscription of an invoice once every payment retry failed
+type DunningFinalAction string
+
+const (
+	// DunningFinalActionMarkUnpaid moves the subscription to unpaid, it can be reactivated once the invoice is paid
+	DunningFinalActionMarkUnpaid DunningFinalAction = "mark_unpaid"
+	// DunningFinalActionCancel cancels the subscription immediately
+	DunningFinalActionCancel DunningFinalAction = "cancel"
+)
+
+func (a DunningFinalAction) String() string {
+	return string(a)
+}
+
+func (a DunningFinalAction) Validate() error {
+	allowed := []DunningFinalAction{
+		DunningFinalActionMarkUnpaid,
+		DunningFinalActionCancel,
+	}
+	if a == "" || lo.Contains(allowed, a) {
+		return nil
+	}
+	return ierr.NewError("invalid dunning final action").
+		WithHint(fmt.Sprintf("Dunning final action must be one of: %s", strings.Join(lo.Map(allowed, func(a DunningFinalAction, _ int) string { return string(a) }), ", "))).
+		WithReportableDetails(map[string]interface{}{
+			"final_action": a,
+		}).
+		Mark(ierr.ErrValidation)
+}
+
+// DunningStatus is the state of the dunning of an invoice, kept in the invoice metadata
+type DunningStatus string
+
+const (
+	// DunningStatusActive means the payment of the invoice is being retried
+	DunningStatusActive DunningStatus = "active"
+	// DunningStatusRecovered means the invoice was paid during the dunning
+	DunningStatusRecovered DunningStatus = "recovered"
+	// DunningStatusExhausted means every retry failed and the final action was applied
+	DunningStatusExhausted DunningStatus = "exhausted"
+)
+
+// Invoice metadata keys holding the dunning state
+const (
+	InvoiceMetadataDunningStatus   = "dunning_status"
+	InvoiceMetadataDunningAttempts = "dunning_attempts"
+)
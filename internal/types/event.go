@@ -1 +1,91 @@
 package types
+
+import (
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/samber/lo"
+)
+
+// DeadLetterReason is the reason an event could not be turned into feature usage
+type DeadLetterReason string
+
+const (
+	// DeadLetterReasonCustomerNotFound is used when no customer matches the external customer id of the event
+	DeadLetterReasonCustomerNotFound DeadLetterReason = "customer_not_found"
+	// DeadLetterReasonSubscriptionNotFound is used when the customer has no subscription active at the event timestamp
+	DeadLetterReasonSubscriptionNotFound DeadLetterReason = "subscription_not_found"
+	// DeadLetterReasonPriceNotFound is used when no usage price of the subscriptions meters the event
+	DeadLetterReasonPriceNotFound DeadLetterReason = "price_not_found"
+	// DeadLetterReasonInvalidValue is used when the aggregated property is missing or cannot be parsed as a number
+	DeadLetterReasonInvalidValue DeadLetterReason = "invalid_value"
+	// DeadLetterReasonProcessingError is used for unexpected errors, e.g. database failures
+	DeadLetterReasonProcessingError DeadLetterReason = "processing_error"
+)
+
+func (r DeadLetterReason) Validate() error {
+	allowed := []DeadLetterReason{
+		DeadLetterReasonCustomerNotFound,
+		DeadLetterReasonSubscriptionNotFound,
+		DeadLetterReasonPriceNotFound,
+		DeadLetterReasonInvalidValue,
+		DeadLetterReasonProcessingError,
+	}
+	if !lo.Contains(allowed, r) {
+		return ierr.NewError("invalid dead letter reason").
+			WithHint("Reason must be one of customer_not_found, subscription_not_found, price_not_found, invalid_value or processing_error").
+			WithReportableDetails(map[string]interface{}{
+				"reason": r,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// DeadLetterEventStatus tracks whether a dead-lettered event has been replayed successfully
+type DeadLetterEventStatus string
+
+const (
+	DeadLetterEventStatusPending  DeadLetterEventStatus = "pending"
+	DeadLetterEventStatusReplayed DeadLetterEventStatus = "replayed"
+)
+
+func (s DeadLetterEventStatus) Validate() error {
+	allowed := []DeadLetterEventStatus{
+		DeadLetterEventStatusPending,
+		DeadLetterEventStatusReplayed,
+	}
+	if !lo.Contains(allowed, s) {
+		return ierr.NewError("invalid dead letter event status").
+			WithHint("Status must be one of pending or replayed").
+			WithReportableDetails(map[string]interface{}{
+				"status": s,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// EventCorrectionAction is the kind of correction applied to an ingested event
+type EventCorrectionAction string
+
+const (
+	// EventCorrectionActionAmend replaces the event with a new event carrying the corrected values
+	EventCorrectionActionAmend EventCorrectionAction = "amend"
+	// EventCorrectionActionRetract removes the event from usage without a replacement
+	EventCorrectionActionRetract EventCorrectionAction = "retract"
+)
+
+func (a EventCorrectionAction) Validate() error {
+	allowed := []EventCorrectionAction{
+		EventCorrectionActionAmend,
+		EventCorrectionActionRetract,
+	}
+	if !lo.Contains(allowed, a) {
+		return ierr.NewError("invalid event correction action").
+			WithHint("Action must be one of amend or retract").
+			WithReportableDetails(map[string]interface{}{
+				"action": a,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
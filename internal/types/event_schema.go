@@ -0,0 +1,213 @@
+package types
+
+import (
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/samber/lo"
+)
+
+// EventSchemaValidationMode determines what happens to events that violate their schema
+type EventSchemaValidationMode string
+
+const (
+	// EventSchemaValidationModeReject drops violating events and records them as rejected
+	EventSchemaValidationModeReject EventSchemaValidationMode = "reject"
+	// EventSchemaValidationModeFlag ingests violating events and records them as flagged
+	EventSchemaValidationModeFlag EventSchemaValidationMode = "flag"
+	// EventSchemaValidationModeAccept ingests all events without validation
+	EventSchemaValidationModeAccept EventSchemaValidationMode = "accept"
+)
+
+func (m EventSchemaValidationMode) Validate() error {
+	allowed := []EventSchemaValidationMode{
+		EventSchemaValidationModeReject,
+		EventSchemaValidationModeFlag,
+		EventSchemaValidationModeAccept,
+	}
+	if !lo.Contains(allowed, m) {
+		return ierr.NewError("invalid validation mode").
+			WithHint("Validation mode must be one of reject, flag or accept").
+			WithReportableDetails(map[string]interface{}{
+				"validation_mode": m,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// EventPropertyType is the expected JSON type of an event property
+type EventPropertyType string
+
+const (
+	EventPropertyTypeString  EventPropertyType = "string"
+	EventPropertyTypeNumber  EventPropertyType = "number"
+	EventPropertyTypeBoolean EventPropertyType = "boolean"
+	EventPropertyTypeObject  EventPropertyType = "object"
+	EventPropertyTypeArray   EventPropertyType = "array"
+)
+
+func (t EventPropertyType) Validate() error {
+	allowed := []EventPropertyType{
+		EventPropertyTypeString,
+		EventPropertyTypeNumber,
+		EventPropertyTypeBoolean,
+		EventPropertyTypeObject,
+		EventPropertyTypeArray,
+	}
+	if !lo.Contains(allowed, t) {
+		return ierr.NewError("invalid property type").
+			WithHint("Property type must be one of string, number, boolean, object or array").
+			WithReportableDetails(map[string]interface{}{
+				"type": t,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// EventPropertySchema describes a single property of an event
+type EventPropertySchema struct {
+	// Name is the key of the property in the event properties
+	Name string `json:"name"`
+	// Type is the expected type of the property, any type is allowed when empty
+	Type EventPropertyType `json:"type,omitempty"`
+	// Required marks the property as mandatory
+	Required bool `json:"required,omitempty"`
+	// AllowedValues restricts the property to a set of values, compared by their string representation
+	AllowedValues []string `json:"allowed_values,omitempty"`
+}
+
+func (p *EventPropertySchema) Validate() error {
+	if p.Name == "" {
+		return ierr.NewError("property name is required").
+			WithHint("Please provide a name for every property").
+			Mark(ierr.ErrValidation)
+	}
+	if p.Type != "" {
+		if err := p.Type.Validate(); err != nil {
+			return err
+		}
+	}
+	if len(p.AllowedValues) > 0 && (p.Type == EventPropertyTypeObject || p.Type == EventPropertyTypeArray) {
+		return ierr.NewError("allowed values are not supported for object and array properties").
+			WithHint("Remove the allowed values or change the property type").
+			WithReportableDetails(map[string]interface{}{
+				"property": p.Name,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// EventSchemaViolation describes why a property of an event does not match its schema
+type EventSchemaViolation struct {
+	Property string `json:"property"`
+	Message  string `json:"message"`
+}
+
+// RejectedEventAction is the action taken for an event that violated its schema
+type RejectedEventAction string
+
+const (
+	RejectedEventActionRejected RejectedEventAction = "rejected"
+	RejectedEventActionFlagged  RejectedEventAction = "flagged"
+)
+
+// EventSchemaFilter represents the filter options for event schemas
+type EventSchemaFilter struct {
+	*QueryFilter
+	*TimeRangeFilter
+
+	// filters allows complex filtering based on multiple fields
+	Filters []*FilterCondition `json:"filters,omitempty" form:"filters" validate:"omitempty"`
+	Sort    []*SortCondition   `json:"sort,omitempty" form:"sort" validate:"omitempty"`
+
+	EventSchemaIDs []string `json:"event_schema_ids,omitempty" form:"event_schema_ids"`
+	EventNames     []string `json:"event_names,omitempty" form:"event_names"`
+}
+
+// NewEventSchemaFilter creates a new event schema filter with default options
+func NewEventSchemaFilter() *EventSchemaFilter {
+	return &EventSchemaFilter{
+		QueryFilter: NewDefaultQueryFilter(),
+	}
+}
+
+// NewNoLimitEventSchemaFilter creates a new event schema filter without pagination
+func NewNoLimitEventSchemaFilter() *EventSchemaFilter {
+	return &EventSchemaFilter{
+		QueryFilter: NewNoLimitQueryFilter(),
+	}
+}
+
+// GetLimit returns the limit value, handling nil QueryFilter
+func (f *EventSchemaFilter) GetLimit() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetLimit()
+	}
+	return f.QueryFilter.GetLimit()
+}
+
+// GetOffset returns the offset value, handling nil QueryFilter
+func (f *EventSchemaFilter) GetOffset() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOffset()
+	}
+	return f.QueryFilter.GetOffset()
+}
+
+// GetStatus returns the status value, handling nil QueryFilter
+func (f *EventSchemaFilter) GetStatus() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetStatus()
+	}
+	return f.QueryFilter.GetStatus()
+}
+
+// GetSort returns the sort field, handling nil QueryFilter
+func (f *EventSchemaFilter) GetSort() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetSort()
+	}
+	return f.QueryFilter.GetSort()
+}
+
+// GetOrder returns the sort order, handling nil QueryFilter
+func (f *EventSchemaFilter) GetOrder() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOrder()
+	}
+	return f.QueryFilter.GetOrder()
+}
+
+// GetExpand returns the expand value, handling nil QueryFilter
+func (f *EventSchemaFilter) GetExpand() Expand {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetExpand()
+	}
+	return f.QueryFilter.GetExpand()
+}
+
+// IsUnlimited returns true if the filter has no pagination limits
+func (f *EventSchemaFilter) IsUnlimited() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsUnlimited()
+	}
+	return f.QueryFilter.IsUnlimited()
+}
+
+// Validate validates the event schema filter
+func (f *EventSchemaFilter) Validate() error {
+	if f.QueryFilter != nil {
+		if err := f.QueryFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if f.TimeRangeFilter != nil {
+		if err := f.TimeRangeFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -106,3 +106,42 @@ func (f *MeterFilter) IsUnlimited() bool {
 	}
 	return f.QueryFilter.IsUnlimited()
 }
+
+// MeterFilterOperator is the operator used by a meter filter to match $event.properties
+type MeterFilterOperator string
+
+const (
+	// MeterFilterOperatorIn matches when the property value is one of the filter values (default)
+	MeterFilterOperatorIn MeterFilterOperator = "in"
+	// MeterFilterOperatorNotIn matches when the property is present and its value is none of the filter values
+	MeterFilterOperatorNotIn MeterFilterOperator = "not_in"
+	// MeterFilterOperatorRange matches when the numeric property value is within [min, max)
+	MeterFilterOperatorRange MeterFilterOperator = "range"
+	// MeterFilterOperatorPrefix matches when the property value starts with one of the filter values
+	MeterFilterOperatorPrefix MeterFilterOperator = "prefix"
+	// MeterFilterOperatorExists matches when the property is present, regardless of its value
+	MeterFilterOperatorExists MeterFilterOperator = "exists"
+)
+
+func (o MeterFilterOperator) Validate() bool {
+	switch o {
+	case MeterFilterOperatorIn,
+		MeterFilterOperatorNotIn,
+		MeterFilterOperatorRange,
+		MeterFilterOperatorPrefix,
+		MeterFilterOperatorExists:
+		return true
+	default:
+		return false
+	}
+}
+
+// RequiresValues returns true if the operator matches against the filter values
+func (o MeterFilterOperator) RequiresValues() bool {
+	switch o {
+	case MeterFilterOperatorIn, MeterFilterOperatorNotIn, MeterFilterOperatorPrefix:
+		return true
+	default:
+		return false
+	}
+}
@@ -200,5 +200,6 @@ func (f *PaymentFilter) IsUnlimited() bool {
 type PaymentMethodProvider string
 
 const (
-	PaymentMethodProviderStripe PaymentMethodProvider = "stripe"
+	PaymentMethodProviderStripe   PaymentMethodProvider = "stripe"
+	PaymentMethodProviderRazorpay PaymentMethodProvider = "razorpay"
 )
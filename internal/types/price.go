@@ -1,12 +1,15 @@
 package types
 
 import (
+	"fmt"
+	"strings"
+
 	ierr "github.com/flexprice/flexprice/internal/errors"
 	"github.com/samber/lo"
 	"github.com/shopspring/decimal"
 )
 
-// BillingModel is the billing model for the price ex FLAT_FEE, PACKAGE, TIERED
+// BillingModel is the billing model for the price ex FLAT_FEE, PACKAGE, TIERED, MATRIX
 type BillingModel string
 
 // BillingPeriod is the billing period for the price ex MONTHLY, ANNUAL, WEEKLY, DAILY
@@ -78,6 +81,9 @@ type PriceTier struct {
 	// flat_amount is the flat amount for the given tier (optional)
 	// Applied on top of unit_amount*quantity. Useful for cases like "2.7$ + 5c"
 	FlatAmount *decimal.Decimal `json:"flat_amount,omitempty"`
+
+	// min_amount is the minimum amount charged for the tier once any usage falls in it (optional)
+	MinAmount *decimal.Decimal `json:"min_amount,omitempty"`
 }
 
 type TransformQuantity struct {
@@ -85,6 +91,155 @@ type TransformQuantity struct {
 	Round    string `json:"round,omitempty"`
 }
 
+// PriceMatrix is the rate table for the MATRIX billing model. Usage is broken down by the
+// values of the dimension properties and each combination is charged at its own unit amount.
+type PriceMatrix struct {
+	// Dimensions are the keys from $event.properties the rate table is keyed on, ex ["model", "region"]
+	// Nested keys are supported using a dot separated path like meter filters, ex "request.region"
+	Dimensions []string `json:"dimensions"`
+
+	// Cells are the unit amounts for each combination of dimension values.
+	// Events which don't match any cell are not charged by the price.
+	Cells []PriceMatrixCell `json:"cells"`
+}
+
+// PriceMatrixCell is a single entry of the price matrix
+type PriceMatrixCell struct {
+	// DimensionValues holds the value of every dimension for this cell ex {"model": "gpt-4o", "region": "us"}
+	DimensionValues map[string]string `json:"dimension_values"`
+
+	// UnitAmount is the amount per unit of usage for this cell
+	UnitAmount decimal.Decimal `json:"unit_amount"`
+}
+
+// FindCell returns the cell matching the given dimension values, if any
+func (m *PriceMatrix) FindCell(dimensionValues map[string]string) (*PriceMatrixCell, bool) {
+	if m == nil {
+		return nil, false
+	}
+	for i, cell := range m.Cells {
+		if cell.Matches(dimensionValues) {
+			return &m.Cells[i], true
+		}
+	}
+	return nil, false
+}
+
+// UniformUnitAmount returns the unit amount shared by every cell of the matrix, if the cells don't
+// differ in price. Usage which isn't broken down per cell can only be charged in this case.
+func (m *PriceMatrix) UniformUnitAmount() (decimal.Decimal, bool) {
+	if m == nil || len(m.Cells) == 0 {
+		return decimal.Zero, false
+	}
+	amount := m.Cells[0].UnitAmount
+	for _, cell := range m.Cells[1:] {
+		if !cell.UnitAmount.Equal(amount) {
+			return decimal.Zero, false
+		}
+	}
+	return amount, true
+}
+
+// Matches returns true if the cell has the same value for every dimension
+func (c PriceMatrixCell) Matches(dimensionValues map[string]string) bool {
+	if len(c.DimensionValues) != len(dimensionValues) {
+		return false
+	}
+	for dimension, value := range c.DimensionValues {
+		if v, ok := dimensionValues[dimension]; !ok || v != value {
+			return false
+		}
+	}
+	return true
+}
+
+// FormatDimensionValues returns a readable label for the dimension values in the order of the
+// matrix dimensions, ex "model: gpt-4o, region: us"
+func (m *PriceMatrix) FormatDimensionValues(dimensionValues map[string]string) string {
+	if m == nil {
+		return ""
+	}
+	parts := make([]string, 0, len(m.Dimensions))
+	for _, dimension := range m.Dimensions {
+		if value, ok := dimensionValues[dimension]; ok {
+			parts = append(parts, fmt.Sprintf("%s: %s", dimension, value))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Validate validates the matrix dimensions and cells
+func (m *PriceMatrix) Validate() error {
+	if m == nil || len(m.Dimensions) == 0 {
+		return ierr.NewError("matrix dimensions are required when billing model is MATRIX").
+			WithHint("Please provide at least one dimension for matrix pricing, ex model or region").
+			Mark(ierr.ErrValidation)
+	}
+
+	if len(lo.Uniq(m.Dimensions)) != len(m.Dimensions) || lo.Contains(m.Dimensions, "") {
+		return ierr.NewError("matrix dimensions must be unique and non empty").
+			WithHint("Please provide each dimension key only once").
+			WithReportableDetails(map[string]interface{}{
+				"dimensions": m.Dimensions,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if len(m.Cells) == 0 {
+		return ierr.NewError("matrix cells are required when billing model is MATRIX").
+			WithHint("Please provide the unit amount for at least one combination of dimension values").
+			Mark(ierr.ErrValidation)
+	}
+
+	for i, cell := range m.Cells {
+		if len(cell.DimensionValues) != len(m.Dimensions) {
+			return ierr.NewError("matrix cell must have a value for every dimension").
+				WithHint("Please provide a value for each dimension in every cell").
+				WithReportableDetails(map[string]interface{}{
+					"cell_index": i,
+					"dimensions": m.Dimensions,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+
+		for _, dimension := range m.Dimensions {
+			if _, ok := cell.DimensionValues[dimension]; !ok {
+				return ierr.NewError("matrix cell is missing a dimension value").
+					WithHint("Please provide a value for each dimension in every cell").
+					WithReportableDetails(map[string]interface{}{
+						"cell_index": i,
+						"dimension":  dimension,
+					}).
+					Mark(ierr.ErrValidation)
+			}
+		}
+
+		if cell.UnitAmount.LessThan(decimal.Zero) {
+			return ierr.NewError("matrix cell unit amount cannot be negative").
+				WithHint("Matrix cell unit amount cannot be negative").
+				WithReportableDetails(map[string]interface{}{
+					"cell_index":  i,
+					"unit_amount": cell.UnitAmount,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+
+		for j := 0; j < i; j++ {
+			if m.Cells[j].Matches(cell.DimensionValues) {
+				return ierr.NewError("duplicate matrix cell").
+					WithHint("Each combination of dimension values can only be priced once").
+					WithReportableDetails(map[string]interface{}{
+						"cell_index":       i,
+						"dimension_values": cell.DimensionValues,
+					}).
+					Mark(ierr.ErrValidation)
+			}
+		}
+	}
+
+	return nil
+}
+
 const (
 	PRICE_UNIT_TYPE_FIAT   PriceUnitType = "FIAT"
 	PRICE_UNIT_TYPE_CUSTOM PriceUnitType = "CUSTOM"
@@ -108,6 +263,10 @@ const (
 	// ex 1-100 emails for $100, 101-1000 emails for $90
 	BILLING_MODEL_TIERED BillingModel = "TIERED"
 
+	// Billing model for a rate table keyed on event property dimensions
+	// ex gpt-4o tokens in us for $5 per unit, o1-mini tokens in eu for $1 per unit
+	BILLING_MODEL_MATRIX BillingModel = "MATRIX"
+
 	// For BILLING_CADENCE_RECURRING
 	BILLING_PERIOD_MONTHLY   BillingPeriod = "MONTHLY"
 	BILLING_PERIOD_ANNUAL    BillingPeriod = "ANNUAL"
@@ -189,6 +348,7 @@ func (b BillingModel) Validate() error {
 		BILLING_MODEL_FLAT_FEE,
 		BILLING_MODEL_PACKAGE,
 		BILLING_MODEL_TIERED,
+		BILLING_MODEL_MATRIX,
 	}
 	if !lo.Contains(allowed, b) {
 		return ierr.NewError("invalid billing model").
@@ -0,0 +1,139 @@
+package types
+
+import (
+	"regexp"
+	"strings"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+)
+
+const (
+	// PromotionCodeMaxBulkGenerateCount is the maximum number of promotion codes generated in one request
+	PromotionCodeMaxBulkGenerateCount = 10000
+	// PromotionCodeDefaultGeneratedLength is the length of the random part of generated promotion codes
+	PromotionCodeDefaultGeneratedLength = 8
+)
+
+var promotionCodePattern = regexp.MustCompile(`^[A-Z0-9_-]{3,100}$`)
+
+// NormalizePromotionCode returns the canonical form of a promotion code.
+// Codes are matched case insensitively, so they are stored and looked up in upper case.
+func NormalizePromotionCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// ValidatePromotionCode validates a normalized promotion code
+func ValidatePromotionCode(code string) error {
+	if !promotionCodePattern.MatchString(code) {
+		return ierr.NewError("invalid promotion code").
+			WithHint("Promotion codes must be 3 to 100 characters long and contain only letters, digits, '-' and '_'").
+			WithReportableDetails(map[string]interface{}{
+				"code": code,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+type PromotionCodeFilter struct {
+	*QueryFilter
+
+	Filters          []*FilterCondition `json:"filters,omitempty" form:"filters" validate:"omitempty"`
+	Sort             []*SortCondition   `json:"sort,omitempty" form:"sort" validate:"omitempty"`
+	PromotionCodeIDs []string           `json:"promotion_code_ids,omitempty" form:"promotion_code_ids" validate:"omitempty"`
+	CouponID         string             `json:"coupon_id,omitempty" form:"coupon_id" validate:"omitempty"`
+	CustomerID       string             `json:"customer_id,omitempty" form:"customer_id" validate:"omitempty"`
+	Codes            []string           `json:"codes,omitempty" form:"codes" validate:"omitempty"`
+}
+
+// NewPromotionCodeFilter creates a new PromotionCodeFilter with default values
+func NewPromotionCodeFilter() *PromotionCodeFilter {
+	return &PromotionCodeFilter{
+		QueryFilter: NewDefaultQueryFilter(),
+	}
+}
+
+// NewNoLimitPromotionCodeFilter creates a new PromotionCodeFilter with no pagination limits
+func NewNoLimitPromotionCodeFilter() *PromotionCodeFilter {
+	return &PromotionCodeFilter{
+		QueryFilter: NewNoLimitQueryFilter(),
+	}
+}
+
+// Validate validates the promotion code filter
+func (f PromotionCodeFilter) Validate() error {
+	if f.QueryFilter != nil {
+		if err := f.QueryFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, filter := range f.Filters {
+		if err := filter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, sort := range f.Sort {
+		if err := sort.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetLimit implements BaseFilter interface
+func (f *PromotionCodeFilter) GetLimit() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetLimit()
+	}
+	return f.QueryFilter.GetLimit()
+}
+
+// GetOffset implements BaseFilter interface
+func (f *PromotionCodeFilter) GetOffset() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOffset()
+	}
+	return f.QueryFilter.GetOffset()
+}
+
+// GetSort implements BaseFilter interface
+func (f *PromotionCodeFilter) GetSort() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetSort()
+	}
+	return f.QueryFilter.GetSort()
+}
+
+// GetOrder implements BaseFilter interface
+func (f *PromotionCodeFilter) GetOrder() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOrder()
+	}
+	return f.QueryFilter.GetOrder()
+}
+
+// GetStatus implements BaseFilter interface
+func (f *PromotionCodeFilter) GetStatus() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetStatus()
+	}
+	return f.QueryFilter.GetStatus()
+}
+
+// GetExpand implements BaseFilter interface
+func (f *PromotionCodeFilter) GetExpand() Expand {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetExpand()
+	}
+	return f.QueryFilter.GetExpand()
+}
+
+func (f *PromotionCodeFilter) IsUnlimited() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsUnlimited()
+	}
+	return f.QueryFilter.IsUnlimited()
+}
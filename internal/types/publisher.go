@@ -1,10 +1,36 @@
 package types
 
+import (
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/samber/lo"
+)
+
 // PublishDestination determines where to publish events
 type PublishDestination string
 
 const (
 	PublishToKafka    PublishDestination = "kafka"
 	PublishToDynamoDB PublishDestination = "dynamodb"
-	PublishToAll      PublishDestination = "all"
+	PublishToNATS     PublishDestination = "nats"
+	PublishToSQS      PublishDestination = "sqs"
+	PublishToHTTP     PublishDestination = "http"
+	// PublishToAll publishes to kafka and dynamodb
+	PublishToAll PublishDestination = "all"
 )
+
+func (d PublishDestination) Validate() error {
+	allowed := []PublishDestination{
+		PublishToKafka,
+		PublishToDynamoDB,
+		PublishToNATS,
+		PublishToSQS,
+		PublishToHTTP,
+		PublishToAll,
+	}
+	if !lo.Contains(allowed, d) {
+		return ierr.NewErrorf("invalid publish destination: %s", d).
+			WithHintf("Publish destination must be one of %v", allowed).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
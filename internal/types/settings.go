@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/json"
 	"errors"
 	"strings"
 	"time"
@@ -13,6 +14,9 @@ type SettingKey string
 const (
 	SettingKeyInvoiceConfig      SettingKey = "invoice_config"
 	SettingKeySubscriptionConfig SettingKey = "subscription_config"
+	SettingKeyCustomerConfig     SettingKey = "customer_config"
+	SettingKeyMetricsIngestion   SettingKey = "metrics_ingestion_config"
+	SettingKeyDunningConfig      SettingKey = "dunning_config"
 )
 
 func (s SettingKey) String() string {
@@ -33,6 +37,125 @@ type SubscriptionConfig struct {
 	AutoCancellationEnabled bool `json:"auto_cancellation_enabled"`
 }
 
+// CustomerConfig represents the configuration for customers created from ingested events
+type CustomerConfig struct {
+	// AutoCreateOnEvent creates a customer for events referencing an unknown external customer id
+	AutoCreateOnEvent bool `json:"auto_create_on_event"`
+	// DefaultPlanID subscribes auto-created customers to this plan when set
+	DefaultPlanID string `json:"default_plan_id"`
+}
+
+// MetricsIngestionConfig maps the metrics received on the OTLP and Prometheus remote-write endpoints to events
+type MetricsIngestionConfig struct {
+	Rules []MetricMappingRule `json:"rules"`
+}
+
+// RuleFor returns the first rule matching the metric name, or nil if the metric is not mapped
+func (c *MetricsIngestionConfig) RuleFor(metricName string) *MetricMappingRule {
+	for i := range c.Rules {
+		if c.Rules[i].Matches(metricName) {
+			return &c.Rules[i]
+		}
+	}
+	return nil
+}
+
+// MetricMappingRule maps the data points of the matching metrics to events
+type MetricMappingRule struct {
+	// MetricName matches the metric name exactly, or by prefix when it ends with "*"
+	MetricName string `json:"metric_name"`
+	// EventName is the name of the events created from the data points
+	EventName string `json:"event_name"`
+	// CustomerAttribute is the label or attribute holding the external customer id
+	CustomerAttribute string `json:"customer_attribute"`
+	// ValueProperty is the event property receiving the data point value, "value" by default
+	ValueProperty string `json:"value_property,omitempty"`
+	// Attributes restricts the labels and attributes copied to the event properties, all are copied when empty
+	Attributes []string `json:"attributes,omitempty"`
+	// Temporality is the temporality of the points whose payload does not carry it, set to "cumulative"
+	// for Prometheus counters so that the increase between two samples is billed instead of the total
+	Temporality MetricTemporality `json:"temporality,omitempty"`
+}
+
+// MetricTemporality is the temporality of the data points of a metric mapping rule
+type MetricTemporality string
+
+const (
+	MetricTemporalityDelta      MetricTemporality = "delta"
+	MetricTemporalityCumulative MetricTemporality = "cumulative"
+)
+
+// Matches checks if the rule applies to the metric name
+func (r MetricMappingRule) Matches(metricName string) bool {
+	if prefix, ok := strings.CutSuffix(r.MetricName, "*"); ok {
+		return strings.HasPrefix(metricName, prefix)
+	}
+	return r.MetricName == metricName
+}
+
+// GetValueProperty returns the event property receiving the data point value
+func (r MetricMappingRule) GetValueProperty() string {
+	if r.ValueProperty == "" {
+		return "value"
+	}
+	return r.ValueProperty
+}
+
+func (r MetricMappingRule) Validate() error {
+	if r.MetricName == "" || r.EventName == "" || r.CustomerAttribute == "" {
+		return ierr.NewError("metric mapping rule is incomplete").
+			WithHint("Metric mapping rules require a metric_name, an event_name and a customer_attribute").
+			WithReportableDetails(map[string]interface{}{
+				"metric_name": r.MetricName,
+				"event_name":  r.EventName,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if r.Temporality != "" && r.Temporality != MetricTemporalityDelta && r.Temporality != MetricTemporalityCumulative {
+		return ierr.NewError("invalid metric mapping rule temporality").
+			WithHint("Metric mapping rule 'temporality' must be 'delta' or 'cumulative'").
+			WithReportableDetails(map[string]interface{}{
+				"metric_name": r.MetricName,
+				"temporality": r.Temporality,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// DunningConfig represents the retry schedule of invoices whose card payment failed
+type DunningConfig struct {
+	Enabled bool `json:"enabled"`
+	// RetryScheduleDays are the days after the failed payment on which the payment is retried, e.g. [1, 3, 7]
+	RetryScheduleDays []int `json:"retry_schedule_days"`
+	// SendReminders sends a reminder to the customer after every failed retry
+	SendReminders bool `json:"send_reminders"`
+	// FinalAction is applied to the subscription of the invoice once every retry failed
+	FinalAction DunningFinalAction `json:"final_action"`
+}
+
+func (c *DunningConfig) Validate() error {
+	for i, day := range c.RetryScheduleDays {
+		if day <= 0 || (i > 0 && day <= c.RetryScheduleDays[i-1]) {
+			return ierr.NewError("invalid dunning retry schedule").
+				WithHint("Dunning config 'retry_schedule_days' must be increasing days greater than 0, e.g. [1, 3, 7]").
+				WithReportableDetails(map[string]interface{}{
+					"retry_schedule_days": c.RetryScheduleDays,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	if c.Enabled && len(c.RetryScheduleDays) == 0 {
+		return ierr.NewError("dunning retry schedule is empty").
+			WithHint("Dunning config 'retry_schedule_days' requires at least one day when dunning is enabled").
+			Mark(ierr.ErrValidation)
+	}
+
+	return c.FinalAction.Validate()
+}
+
 // TenantEnvConfig represents a generic configuration for a specific tenant and environment
 type TenantEnvConfig struct {
 	TenantID      string                 `json:"tenant_id"`
@@ -125,6 +248,34 @@ func GetDefaultSettings() map[SettingKey]DefaultSettingValue {
 			Description: "Default configuration for subscription auto-cancellation (grace period and enabled flag)",
 			Required:    true,
 		},
+		SettingKeyCustomerConfig: {
+			Key: SettingKeyCustomerConfig,
+			DefaultValue: map[string]interface{}{
+				"auto_create_on_event": false,
+				"default_plan_id":      "",
+			},
+			Description: "Default configuration for creating customers from events with an unknown external customer id",
+			Required:    false,
+		},
+		SettingKeyMetricsIngestion: {
+			Key: SettingKeyMetricsIngestion,
+			DefaultValue: map[string]interface{}{
+				"rules": []interface{}{},
+			},
+			Description: "Rules mapping OTLP and Prometheus remote-write metrics to events",
+			Required:    false,
+		},
+		SettingKeyDunningConfig: {
+			Key: SettingKeyDunningConfig,
+			DefaultValue: map[string]interface{}{
+				"enabled":             false,
+				"retry_schedule_days": []interface{}{1, 3, 7},
+				"send_reminders":      true,
+				"final_action":        string(DunningFinalActionMarkUnpaid),
+			},
+			Description: "Retry schedule, reminders and final subscription action for invoices whose card payment failed",
+			Required:    false,
+		},
 	}
 }
 
@@ -145,6 +296,12 @@ func ValidateSettingValue(key string, value map[string]interface{}) error {
 		return ValidateInvoiceConfig(value)
 	case SettingKeySubscriptionConfig:
 		return ValidateSubscriptionConfig(value)
+	case SettingKeyCustomerConfig:
+		return ValidateCustomerConfig(value)
+	case SettingKeyMetricsIngestion:
+		return ValidateMetricsIngestionConfig(value)
+	case SettingKeyDunningConfig:
+		return ValidateDunningConfig(value)
 	default:
 		return ierr.NewErrorf("unknown setting key: %s", key).
 			WithHintf("Unknown setting key: %s", key).
@@ -458,3 +615,79 @@ func validateTimezone(timezone string) error {
 	_, err := time.LoadLocation(resolvedTimezone)
 	return err
 }
+
+func ValidateCustomerConfig(value map[string]interface{}) error {
+	if value == nil {
+		return errors.New("customer_config value cannot be nil")
+	}
+
+	if autoCreateRaw, exists := value["auto_create_on_event"]; exists {
+		if _, ok := autoCreateRaw.(bool); !ok {
+			return ierr.NewErrorf("customer_config: 'auto_create_on_event' must be a boolean, got %T", autoCreateRaw).
+				WithHintf("Customer config auto create on event must be a boolean, got %T", autoCreateRaw).
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	if defaultPlanIDRaw, exists := value["default_plan_id"]; exists {
+		if _, ok := defaultPlanIDRaw.(string); !ok {
+			return ierr.NewErrorf("customer_config: 'default_plan_id' must be a string, got %T", defaultPlanIDRaw).
+				WithHintf("Customer config default plan id must be a string, got %T", defaultPlanIDRaw).
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	return nil
+}
+
+// ValidateMetricsIngestionConfig validates the metric mapping rules
+func ValidateMetricsIngestionConfig(value map[string]interface{}) error {
+	if value == nil {
+		return errors.New("metrics_ingestion_config value cannot be nil")
+	}
+
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Metrics ingestion config must be a valid JSON object").
+			Mark(ierr.ErrValidation)
+	}
+
+	var config MetricsIngestionConfig
+	if err := json.Unmarshal(raw, &config); err != nil {
+		return ierr.WithError(err).
+			WithHint("Metrics ingestion config 'rules' must be a list of metric mapping rules").
+			Mark(ierr.ErrValidation)
+	}
+
+	for _, rule := range config.Rules {
+		if err := rule.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateDunningConfig validates the dunning retry schedule and final action
+func ValidateDunningConfig(value map[string]interface{}) error {
+	if value == nil {
+		return errors.New("dunning_config value cannot be nil")
+	}
+
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Dunning config must be a valid JSON object").
+			Mark(ierr.ErrValidation)
+	}
+
+	var config DunningConfig
+	if err := json.Unmarshal(raw, &config); err != nil {
+		return ierr.WithError(err).
+			WithHint("Dunning config requires a boolean 'enabled', a list of days 'retry_schedule_days', a boolean 'send_reminders' and a string 'final_action'").
+			Mark(ierr.ErrValidation)
+	}
+
+	return config.Validate()
+}
@@ -132,6 +132,50 @@ func (c CollectionMethod) Validate() error {
 	return nil
 }
 
+// CommitmentType determines how the commitment amount of a subscription is applied
+type CommitmentType string
+
+const (
+	// CommitmentTypeBillingPeriod - The commitment applies to each billing period on its own
+	// and usage beyond it is billed at the overage factor
+	CommitmentTypeBillingPeriod CommitmentType = "billing_period"
+
+	// CommitmentTypePrepaid - The commitment is invoiced upfront for the commitment term and
+	// drawn down by usage charges across billing periods, usage past it is billed at the overage factor
+	CommitmentTypePrepaid CommitmentType = "prepaid"
+
+	// CommitmentTypePostpaid - Usage charges are billed as they occur and drawn down from the
+	// commitment for the term, the unused commitment is billed as a true-up at the end of the term
+	CommitmentTypePostpaid CommitmentType = "postpaid"
+)
+
+func (c CommitmentType) String() string {
+	return string(c)
+}
+
+func (c CommitmentType) Validate() error {
+	allowed := []CommitmentType{
+		CommitmentTypeBillingPeriod,
+		CommitmentTypePrepaid,
+		CommitmentTypePostpaid,
+	}
+	if !lo.Contains(allowed, c) {
+		return ierr.NewError("invalid commitment type").
+			WithHint("Commitment type must be one of billing_period, prepaid or postpaid").
+			WithReportableDetails(map[string]any{
+				"commitment_type": c,
+				"allowed_values":  allowed,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// IsTermCommitment returns true if the commitment spans a commitment term of multiple billing periods
+func (c CommitmentType) IsTermCommitment() bool {
+	return c == CommitmentTypePrepaid || c == CommitmentTypePostpaid
+}
+
 // PauseStatus represents the pause state of a subscription
 type PauseStatus string
 
@@ -50,6 +50,9 @@ const (
 	TemporalStripeIntegrationWorkflow    TemporalWorkflowType = "StripeIntegrationWorkflow"
 	TemporalExecuteExportWorkflow        TemporalWorkflowType = "ExecuteExportWorkflow"
 	TemporalHubSpotDealSyncWorkflow      TemporalWorkflowType = "HubSpotDealSyncWorkflow"
+	TemporalDunningWorkflow              TemporalWorkflowType = "DunningWorkflow"
+	TemporalWalletAutoTopupWorkflow      TemporalWorkflowType = "WalletAutoTopupWorkflow"
+	TemporalReprocessEventsWorkflow      TemporalWorkflowType = "ReprocessEventsWorkflow"
 )
 
 // String returns the string representation of the workflow type
@@ -66,6 +69,9 @@ func (w TemporalWorkflowType) Validate() error {
 		TemporalSubscriptionCreationWorkflow, // "SubscriptionCreationWorkflow"
 		TemporalExecuteExportWorkflow,        // "ExecuteExportWorkflow"
 		TemporalHubSpotDealSyncWorkflow,      // "HubSpotDealSyncWorkflow"
+		TemporalDunningWorkflow,              // "DunningWorkflow"
+		TemporalWalletAutoTopupWorkflow,      // "WalletAutoTopupWorkflow"
+		TemporalReprocessEventsWorkflow,      // "ReprocessEventsWorkflow"
 	}
 	if lo.Contains(allowedWorkflows, w) {
 		return nil
@@ -79,7 +85,7 @@ func (w TemporalWorkflowType) Validate() error {
 // TaskQueue returns the logical task queue for the workflow
 func (w TemporalWorkflowType) TaskQueue() TemporalTaskQueue {
 	switch w {
-	case TemporalTaskProcessingWorkflow, TemporalSubscriptionChangeWorkflow, TemporalSubscriptionCreationWorkflow, TemporalHubSpotDealSyncWorkflow:
+	case TemporalTaskProcessingWorkflow, TemporalSubscriptionChangeWorkflow, TemporalSubscriptionCreationWorkflow, TemporalHubSpotDealSyncWorkflow, TemporalDunningWorkflow, TemporalWalletAutoTopupWorkflow, TemporalReprocessEventsWorkflow:
 		return TemporalTaskQueueTask
 	case TemporalPriceSyncWorkflow:
 		return TemporalTaskQueuePrice
@@ -107,6 +113,9 @@ func GetWorkflowsForTaskQueue(taskQueue TemporalTaskQueue) []TemporalWorkflowTyp
 		return []TemporalWorkflowType{
 			TemporalTaskProcessingWorkflow,
 			TemporalHubSpotDealSyncWorkflow,
+			TemporalDunningWorkflow,
+			TemporalWalletAutoTopupWorkflow,
+			TemporalReprocessEventsWorkflow,
 		}
 	case TemporalTaskQueuePrice:
 		return []TemporalWorkflowType{
@@ -83,12 +83,14 @@ const (
 	UUID_PREFIX_CONNECTION                  = "conn"
 	UUID_PREFIX_WALLET                      = "wallet"
 	UUID_PREFIX_WALLET_TRANSACTION          = "wtxn"
+	UUID_PREFIX_WALLET_TRANSFER             = "wtrf"
 	UUID_PREFIX_ENVIRONMENT                 = "env"
 	UUID_PREFIX_USER                        = "user"
 	UUID_PREFIX_TENANT                      = "tenant"
 	UUID_PREFIX_ENTITLEMENT                 = "ent"
 	UUID_PREFIX_PAYMENT                     = "pay"
 	UUID_PREFIX_PAYMENT_ATTEMPT             = "attempt"
+	UUID_PREFIX_PAYMENT_REFUND              = "refund"
 	UUID_PREFIX_TASK                        = "task"
 	UUID_PREFIX_SECRET                      = "secret"
 	UUID_PREFIX_TAX_RATE                    = "taxrate"
@@ -101,6 +103,7 @@ const (
 	UUID_PREFIX_COUPON                      = "coupon"
 	UUID_PREFIX_COUPON_ASSOCIATION          = "coupon_assoc"
 	UUID_PREFIX_COUPON_APPLICATION          = "coupon_app"
+	UUID_PREFIX_PROMOTION_CODE              = "promo"
 	UUID_PREFIX_PRICE_UNIT                  = "price_unit"
 	UUID_PREFIX_ADDON                       = "addon"
 	UUID_PREFIX_ADDON_ASSOCIATION           = "addon_assoc"
@@ -108,6 +111,8 @@ const (
 	UUID_PREFIX_SETTING                     = "setting"
 	UUID_PREFIX_ALERT_LOG                   = "alert"
 	UUID_PREFIX_GROUP                       = "group"
+	UUID_PREFIX_EVENT_SCHEMA                = "evschema"
+	UUID_PREFIX_EVENT_CORRECTION            = "evcorr"
 
 	// Temporal workflow prefixes
 	UUID_PREFIX_WORKFLOW = "wf"
@@ -58,6 +58,7 @@ const (
 	TransactionReasonCreditNote              TransactionReason = "CREDIT_NOTE"
 	TransactionReasonCreditExpired           TransactionReason = "CREDIT_EXPIRED"
 	TransactionReasonWalletTermination       TransactionReason = "WALLET_TERMINATION"
+	TransactionReasonWalletTransfer          TransactionReason = "WALLET_TRANSFER"
 )
 
 func (t TransactionReason) Validate() error {
@@ -74,6 +75,7 @@ func (t TransactionReason) Validate() error {
 		string(TransactionReasonCreditNote),
 		string(TransactionReasonCreditExpired),
 		string(TransactionReasonWalletTermination),
+		string(TransactionReasonWalletTransfer),
 	}
 	if !lo.Contains(allowedValues, string(t)) {
 		return ierr.NewError("invalid transaction reason").
@@ -97,6 +99,9 @@ const (
 	WalletTxReferenceTypeExternal WalletTxReferenceType = "EXTERNAL"
 	// WalletTxReferenceTypeRequest is used for auto generated reference IDs
 	WalletTxReferenceTypeRequest WalletTxReferenceType = "REQUEST"
+	// WalletTxReferenceTypeTransfer is used for wallet to wallet transfers, the reference ID
+	// is the transfer ID shared by the debit and credit transactions of the transfer
+	WalletTxReferenceTypeTransfer WalletTxReferenceType = "TRANSFER"
 )
 
 func (t WalletTxReferenceType) Validate() error {
@@ -104,6 +109,7 @@ func (t WalletTxReferenceType) Validate() error {
 		string(WalletTxReferenceTypePayment),
 		string(WalletTxReferenceTypeExternal),
 		string(WalletTxReferenceTypeRequest),
+		string(WalletTxReferenceTypeTransfer),
 	}
 	if !lo.Contains(allowedValues, string(t)) {
 		return ierr.NewError("invalid wallet transaction reference type").
@@ -293,6 +299,10 @@ type WalletConfig struct {
 	// AllowedPriceTypes is a list of price types that are allowed for the wallet
 	// nil means all price types are allowed
 	AllowedPriceTypes []WalletConfigPriceType `json:"allowed_price_types,omitempty"`
+
+	// DrawFromParentWallet allows the wallet of a child customer to draw the credits it is
+	// short of from the active wallet of the parent customer in the same currency
+	DrawFromParentWallet bool `json:"draw_from_parent_wallet,omitempty"`
 }
 
 func GetDefaultWalletConfig() *WalletConfig {
@@ -29,6 +29,9 @@ const (
 	WebhookEventSubscriptionPaused    = "subscription.paused"
 	WebhookEventSubscriptionCancelled = "subscription.cancelled"
 	WebhookEventSubscriptionResumed   = "subscription.resumed"
+
+	// WebhookEventSubscriptionSpendAlert is published when the spend of a subscription in the current period changes alert state
+	WebhookEventSubscriptionSpendAlert = "subscription.spend.alert"
 )
 
 // feature event names
@@ -37,6 +40,8 @@ const (
 	WebhookEventFeatureUpdated            = "feature.updated"
 	WebhookEventFeatureDeleted            = "feature.deleted"
 	WebhookEventFeatureWalletBalanceAlert = "feature.wallet_balance.alert"
+	WebhookEventFeatureSpendAlert         = "feature.spend.alert"
+	WebhookEventFeatureUsageAlert         = "feature.usage.alert"
 )
 
 // entitlement event names
@@ -44,6 +49,8 @@ const (
 	WebhookEventEntitlementCreated = "entitlement.created"
 	WebhookEventEntitlementUpdated = "entitlement.updated"
 	WebhookEventEntitlementDeleted = "entitlement.deleted"
+	// WebhookEventEntitlementLimitReached is published when the usage of a feature crosses the hard limit of the entitlement
+	WebhookEventEntitlementLimitReached = "entitlement.limit_reached"
 )
 
 // wallet event names
@@ -52,6 +59,8 @@ const (
 	WebhookEventWalletUpdated            = "wallet.updated"
 	WebhookEventWalletTerminated         = "wallet.terminated"
 	WebhookEventWalletTransactionCreated = "wallet.transaction.created"
+	WebhookEventWalletAutoTopupSucceeded = "wallet.auto_topup.succeeded"
+	WebhookEventWalletAutoTopupFailed    = "wallet.auto_topup.failed"
 )
 
 // payment event names
@@ -79,6 +88,14 @@ const (
 	WebhookEventInvoicePaymentOverdue  = "invoice.payment.overdue"
 )
 
+// dunning event names
+const (
+	WebhookEventInvoiceDunningStarted   = "invoice.dunning.started"
+	WebhookEventInvoiceDunningReminder  = "invoice.dunning.reminder"
+	WebhookEventInvoiceDunningRecovered = "invoice.dunning.recovered"
+	WebhookEventInvoiceDunningExhausted = "invoice.dunning.exhausted"
+)
+
 // alert event names
 const (
 	WebhookEventWalletCreditBalanceDropped   = "wallet.credit_balance.dropped"
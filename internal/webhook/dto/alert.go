@@ -1,23 +1,43 @@
 package webhookDto
 
-import "github.com/flexprice/flexprice/internal/api/dto"
+import (
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/types"
+)
 
 type InternalAlertEvent struct {
-	FeatureID   string `json:"feature_id,omitempty"`
-	WalletID    string `json:"wallet_id,omitempty"`
-	AlertType   string `json:"alert_type"`
-	AlertStatus string `json:"alert_status"`
+	FeatureID      string           `json:"feature_id,omitempty"`
+	WalletID       string           `json:"wallet_id,omitempty"`
+	SubscriptionID string           `json:"subscription_id,omitempty"`
+	AlertType      string           `json:"alert_type"`
+	AlertStatus    string           `json:"alert_status"`
+	AlertInfo      *types.AlertInfo `json:"alert_info,omitempty"`
 }
 
 type AlertWebhookPayload struct {
-	EventType   string                `json:"event_type"`
-	AlertType   string                `json:"alert_type"`
-	AlertStatus string                `json:"alert_status"`
-	Feature     *dto.FeatureResponse  `json:"feature,omitempty"`
-	Wallet      *dto.WalletResponse   `json:"wallet,omitempty"`
-	Customer    *dto.CustomerResponse `json:"customer,omitempty"`
+	EventType    string                    `json:"event_type"`
+	AlertType    string                    `json:"alert_type"`
+	AlertStatus  string                    `json:"alert_status"`
+	Feature      *dto.FeatureResponse      `json:"feature,omitempty"`
+	Wallet       *dto.WalletResponse       `json:"wallet,omitempty"`
+	Subscription *dto.SubscriptionResponse `json:"subscription,omitempty"`
+	Customer     *dto.CustomerResponse     `json:"customer,omitempty"`
+	AlertInfo    *types.AlertInfo          `json:"alert_info,omitempty"`
 }
 
 func NewAlertWebhookPayload(feature *dto.FeatureResponse, wallet *dto.WalletResponse, customer *dto.CustomerResponse, alertType string, alertStatus string, eventType string) *AlertWebhookPayload {
 	return &AlertWebhookPayload{EventType: eventType, AlertType: alertType, AlertStatus: alertStatus, Feature: feature, Wallet: wallet, Customer: customer}
 }
+
+// NewUsageAlertWebhookPayload builds the payload of subscription and feature spend and usage alerts
+func NewUsageAlertWebhookPayload(subscription *dto.SubscriptionResponse, feature *dto.FeatureResponse, customer *dto.CustomerResponse, event *InternalAlertEvent, eventType string) *AlertWebhookPayload {
+	return &AlertWebhookPayload{
+		EventType:    eventType,
+		AlertType:    event.AlertType,
+		AlertStatus:  event.AlertStatus,
+		Feature:      feature,
+		Subscription: subscription,
+		Customer:     customer,
+		AlertInfo:    event.AlertInfo,
+	}
+}
@@ -0,0 +1,33 @@
+package webhookDto
+
+import (
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+type InternalDunningEvent struct {
+	InvoiceID string         `json:"invoice_id"`
+	TenantID  string         `json:"tenant_id"`
+	Dunning   DunningDetails `json:"dunning"`
+}
+
+// DunningDetails describes the state of the payment retries of the invoice
+type DunningDetails struct {
+	Status      types.DunningStatus `json:"status"`
+	Attempt     int                 `json:"attempt"`
+	MaxAttempts int                 `json:"max_attempts"`
+	// NextRetryAt is the time of the next payment retry, nil after the last one
+	NextRetryAt *time.Time `json:"next_retry_at,omitempty"`
+}
+
+type DunningWebhookPayload struct {
+	EventType string               `json:"event_type"`
+	Invoice   *dto.InvoiceResponse `json:"invoice"`
+	Dunning   DunningDetails       `json:"dunning"`
+}
+
+func NewDunningWebhookPayload(invoice *dto.InvoiceResponse, dunning DunningDetails, eventType string) *DunningWebhookPayload {
+	return &DunningWebhookPayload{EventType: eventType, Invoice: invoice, Dunning: dunning}
+}
@@ -1,6 +1,11 @@
 package webhookDto
 
-import "github.com/flexprice/flexprice/internal/api/dto"
+import (
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/shopspring/decimal"
+)
 
 type InternalEntitlementEvent struct {
 	EntitlementID string `json:"entitlement_id"`
@@ -15,3 +20,40 @@ type EntitlementWebhookPayload struct {
 func NewEntitlementWebhookPayload(entitlement *dto.EntitlementResponse, eventType string) *EntitlementWebhookPayload {
 	return &EntitlementWebhookPayload{EventType: eventType, Entitlement: entitlement}
 }
+
+// InternalEntitlementLimitEvent is published when the usage of a customer crosses the hard limit of an entitlement
+type InternalEntitlementLimitEvent struct {
+	EntitlementID     string          `json:"entitlement_id"`
+	CustomerID        string          `json:"customer_id"`
+	FeatureID         string          `json:"feature_id"`
+	TenantID          string          `json:"tenant_id"`
+	UsageLimit        int64           `json:"usage_limit"`
+	CurrentUsage      decimal.Decimal `json:"current_usage"`
+	RequestedQuantity decimal.Decimal `json:"requested_quantity"`
+	PeriodStart       time.Time       `json:"period_start"`
+	PeriodEnd         time.Time       `json:"period_end"`
+}
+
+type EntitlementLimitWebhookPayload struct {
+	EventType         string                   `json:"event_type"`
+	Entitlement       *dto.EntitlementResponse `json:"entitlement"`
+	Customer          *dto.CustomerResponse    `json:"customer"`
+	UsageLimit        int64                    `json:"usage_limit"`
+	CurrentUsage      decimal.Decimal          `json:"current_usage"`
+	RequestedQuantity decimal.Decimal          `json:"requested_quantity"`
+	PeriodStart       time.Time                `json:"period_start"`
+	PeriodEnd         time.Time                `json:"period_end"`
+}
+
+func NewEntitlementLimitWebhookPayload(entitlement *dto.EntitlementResponse, customer *dto.CustomerResponse, event *InternalEntitlementLimitEvent, eventType string) *EntitlementLimitWebhookPayload {
+	return &EntitlementLimitWebhookPayload{
+		EventType:         eventType,
+		Entitlement:       entitlement,
+		Customer:          customer,
+		UsageLimit:        event.UsageLimit,
+		CurrentUsage:      event.CurrentUsage,
+		RequestedQuantity: event.RequestedQuantity,
+		PeriodStart:       event.PeriodStart,
+		PeriodEnd:         event.PeriodEnd,
+	}
+}
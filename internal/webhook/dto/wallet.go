@@ -11,6 +11,7 @@ type InternalWalletEvent struct {
 	WalletID  string                     `json:"wallet_id"`
 	TenantID  string                     `json:"tenant_id"`
 	Alert     *WalletAlertInfo           `json:"alert,omitempty"`
+	AutoTopup *WalletAutoTopupInfo       `json:"auto_topup,omitempty"`
 	Balance   *dto.WalletBalanceResponse `json:"balance,omitempty"`
 }
 
@@ -26,6 +27,7 @@ type WalletWebhookPayload struct {
 	Wallet    *dto.WalletResponse   `json:"wallet"`
 	Customer  *dto.CustomerResponse `json:"customer,omitempty"`
 	Alert     *WalletAlertInfo      `json:"alert,omitempty"`
+	AutoTopup *WalletAutoTopupInfo  `json:"auto_topup,omitempty"`
 }
 
 // WalletAlertInfo contains details about the wallet alert
@@ -38,6 +40,18 @@ type WalletAlertInfo struct {
 	AlertConfig    *types.AlertConfig `json:"alert_config,omitempty"`
 }
 
+// WalletAutoTopupInfo contains details about an auto top-up attempt
+type WalletAutoTopupInfo struct {
+	MinBalance    decimal.Decimal `json:"min_balance"`
+	CreditBalance decimal.Decimal `json:"credit_balance"`
+	CreditsToAdd  decimal.Decimal `json:"credits_to_add"`
+	Amount        decimal.Decimal `json:"amount"`
+	Currency      string          `json:"currency"`
+	InvoiceID     string          `json:"invoice_id,omitempty"`
+	PaymentID     string          `json:"payment_id,omitempty"`
+	Error         string          `json:"error,omitempty"`
+}
+
 type TransactionWebhookPayload struct {
 	EventType   string                         `json:"event_type"`
 	Transaction *dto.WalletTransactionResponse `json:"transaction"`
@@ -65,6 +65,36 @@ func (b *AlertPayloadBuilder) BuildPayload(ctx context.Context, eventType string
 		return json.Marshal(payload)
 	}
 
+	// Usage alert: needs the subscription and, for feature alerts, the feature
+	if internalEvent.SubscriptionID != "" {
+		subscription, err := b.services.SubscriptionService.GetSubscription(ctx, internalEvent.SubscriptionID)
+		if err != nil {
+			return nil, err
+		}
+
+		var feature *dto.FeatureResponse
+		if internalEvent.FeatureID != "" {
+			feature, err = b.services.FeatureService.GetFeature(ctx, internalEvent.FeatureID)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		// Customer is optional in the payload
+		var customer *dto.CustomerResponse
+		if subscription.CustomerID != "" {
+			customerData, err := b.services.CustomerService.GetCustomer(ctx, subscription.CustomerID)
+			if err != nil {
+				b.services.Sentry.CaptureException(err)
+			} else {
+				customer = customerData
+			}
+		}
+
+		payload := webhookDto.NewUsageAlertWebhookPayload(subscription, feature, customer, &internalEvent, eventType)
+		return json.Marshal(payload)
+	}
+
 	// If we get here, no valid combination found - return nil
 	return nil, nil
 }
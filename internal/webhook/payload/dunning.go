@@ -0,0 +1,59 @@
+package payload
+
+import (
+	"context"
+	"encoding/json"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	webhookDto "github.com/flexprice/flexprice/internal/webhook/dto"
+	"github.com/samber/lo"
+)
+
+type DunningPayloadBuilder struct {
+	services *Services
+}
+
+func NewDunningPayloadBuilder(services *Services) PayloadBuilder {
+	return &DunningPayloadBuilder{
+		services: services,
+	}
+}
+
+// BuildPayload builds the webhook payload for dunning events
+func (b *DunningPayloadBuilder) BuildPayload(ctx context.Context, eventType string, data json.RawMessage) (json.RawMessage, error) {
+	var parsedPayload webhookDto.InternalDunningEvent
+
+	err := json.Unmarshal(data, &parsedPayload)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Unable to unmarshal dunning event payload").
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	invoiceID, tenantID := parsedPayload.InvoiceID, parsedPayload.TenantID
+	if invoiceID == "" || tenantID == "" {
+		return nil, ierr.NewError("missing required field(s) for dunning event").
+			WithHint("Please provide a valid invoice ID and tenant ID").
+			WithReportableDetails(map[string]any{
+				"invoice_id": invoiceID,
+				"tenant_id":  tenantID,
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	invoice, err := b.services.InvoiceService.GetInvoice(ctx, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	// inject the invoice pdf url so reminders can link the invoice
+	pdfUrl, err := b.services.InvoiceService.GetInvoicePDFUrl(ctx, invoiceID)
+	if err != nil {
+		b.services.Sentry.CaptureException(err)
+	}
+	invoice.InvoicePDFURL = lo.ToPtr(pdfUrl)
+
+	payload := webhookDto.NewDunningWebhookPayload(invoice, parsedPayload.Dunning, eventType)
+
+	return json.Marshal(payload)
+}
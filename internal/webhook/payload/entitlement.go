@@ -46,3 +46,47 @@ func (b *EntitlementPayloadBuilder) BuildPayload(ctx context.Context, eventType
 
 	return json.Marshal(payload)
 }
+
+type EntitlementLimitPayloadBuilder struct {
+	services *Services
+}
+
+func NewEntitlementLimitPayloadBuilder(services *Services) PayloadBuilder {
+	return &EntitlementLimitPayloadBuilder{services: services}
+}
+
+func (b *EntitlementLimitPayloadBuilder) BuildPayload(ctx context.Context, eventType string, data json.RawMessage) (json.RawMessage, error) {
+	var parsedPayload webhookDto.InternalEntitlementLimitEvent
+
+	err := json.Unmarshal(data, &parsedPayload)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Unable to unmarshal entitlement limit event payload").
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	if parsedPayload.EntitlementID == "" || parsedPayload.CustomerID == "" || parsedPayload.TenantID == "" {
+		return nil, ierr.NewError("invalid data type for entitlement limit event").
+			WithHint("Please provide a valid entitlement ID, customer ID and tenant ID").
+			WithReportableDetails(map[string]any{
+				"entitlement_id": parsedPayload.EntitlementID,
+				"customer_id":    parsedPayload.CustomerID,
+				"tenant_id":      parsedPayload.TenantID,
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	entitlement, err := b.services.EntitlementService.GetEntitlement(ctx, parsedPayload.EntitlementID)
+	if err != nil {
+		return nil, err
+	}
+
+	customer, err := b.services.CustomerService.GetCustomer(ctx, parsedPayload.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := webhookDto.NewEntitlementLimitWebhookPayload(entitlement, customer, &parsedPayload, eventType)
+
+	return json.Marshal(payload)
+}
@@ -45,6 +45,20 @@ func NewPayloadBuilderFactory(services *Services) PayloadBuilderFactory {
 		return NewCommunicationPayloadBuilder(f.services)
 	}
 
+	// Register dunning builders
+	f.builders[types.WebhookEventInvoiceDunningStarted] = func() PayloadBuilder {
+		return NewDunningPayloadBuilder(f.services)
+	}
+	f.builders[types.WebhookEventInvoiceDunningReminder] = func() PayloadBuilder {
+		return NewDunningPayloadBuilder(f.services)
+	}
+	f.builders[types.WebhookEventInvoiceDunningRecovered] = func() PayloadBuilder {
+		return NewDunningPayloadBuilder(f.services)
+	}
+	f.builders[types.WebhookEventInvoiceDunningExhausted] = func() PayloadBuilder {
+		return NewDunningPayloadBuilder(f.services)
+	}
+
 	// Register subscription builders
 	f.builders[types.WebhookEventSubscriptionCreated] = func() PayloadBuilder {
 		return NewSubscriptionPayloadBuilder(f.services)
@@ -89,6 +103,9 @@ func NewPayloadBuilderFactory(services *Services) PayloadBuilderFactory {
 	f.builders[types.WebhookEventEntitlementDeleted] = func() PayloadBuilder {
 		return NewEntitlementPayloadBuilder(f.services)
 	}
+	f.builders[types.WebhookEventEntitlementLimitReached] = func() PayloadBuilder {
+		return NewEntitlementLimitPayloadBuilder(f.services)
+	}
 
 	// wallet builders
 	f.builders[types.WebhookEventWalletCreated] = func() PayloadBuilder {
@@ -103,6 +120,12 @@ func NewPayloadBuilderFactory(services *Services) PayloadBuilderFactory {
 	f.builders[types.WebhookEventWalletTransactionCreated] = func() PayloadBuilder {
 		return NewTransactionPayloadBuilder(f.services)
 	}
+	f.builders[types.WebhookEventWalletAutoTopupSucceeded] = func() PayloadBuilder {
+		return NewWalletPayloadBuilder(f.services)
+	}
+	f.builders[types.WebhookEventWalletAutoTopupFailed] = func() PayloadBuilder {
+		return NewWalletPayloadBuilder(f.services)
+	}
 	f.builders[types.WebhookEventWalletCreditBalanceDropped] = func() PayloadBuilder {
 		return NewWalletPayloadBuilder(f.services)
 	}
@@ -155,6 +178,15 @@ func NewPayloadBuilderFactory(services *Services) PayloadBuilderFactory {
 	f.builders[types.WebhookEventFeatureWalletBalanceAlert] = func() PayloadBuilder {
 		return NewAlertPayloadBuilder(f.services)
 	}
+	f.builders[types.WebhookEventFeatureSpendAlert] = func() PayloadBuilder {
+		return NewAlertPayloadBuilder(f.services)
+	}
+	f.builders[types.WebhookEventFeatureUsageAlert] = func() PayloadBuilder {
+		return NewAlertPayloadBuilder(f.services)
+	}
+	f.builders[types.WebhookEventSubscriptionSpendAlert] = func() PayloadBuilder {
+		return NewAlertPayloadBuilder(f.services)
+	}
 
 	return f
 }
@@ -60,8 +60,9 @@ func (b WalletPayloadBuilder) BuildPayload(ctx context.Context, eventType string
 		}
 	}
 
-	// Create webhook payload with alert info and customer if present
+	// Create webhook payload with alert, auto top-up info and customer if present
 	payload := webhookDto.NewWalletWebhookPayload(walletData, customerData, parsedPayload.Alert, eventType)
+	payload.AutoTopup = parsedPayload.AutoTopup
 
 	// Marshal payload
 	return json.Marshal(payload)
@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS flexprice.rejected_events (
+    id String NOT NULL,
+    tenant_id String NOT NULL,
+    environment_id String NOT NULL,
+    external_customer_id String NOT NULL,
+    customer_id String NOT NULL DEFAULT '',
+    event_name String NOT NULL,
+    source String NOT NULL DEFAULT '',
+    timestamp DateTime64(3) NOT NULL,
+    properties String,
+    schema_id String NOT NULL,
+    action LowCardinality(String) NOT NULL,
+    violations String,
+    rejected_at DateTime64(3) NOT NULL DEFAULT now(),
+    CONSTRAINT check_tenant_id CHECK tenant_id != '',
+    CONSTRAINT check_environment_id CHECK environment_id != ''
+)
+ENGINE = MergeTree
+PARTITION BY toYYYYMM(rejected_at)
+ORDER BY (tenant_id, environment_id, rejected_at, id)
+TTL toDateTime(rejected_at) + INTERVAL 90 DAY
+SETTINGS index_granularity = 8192;
+
+-- Set Index for event_name
+ALTER TABLE flexprice.rejected_events
+ADD INDEX IF NOT EXISTS event_name_idx event_name TYPE set(0) GRANULARITY 8192;
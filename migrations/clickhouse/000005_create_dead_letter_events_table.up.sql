@@ -0,0 +1,31 @@
+CREATE TABLE IF NOT EXISTS flexprice.dead_letter_events (
+    id String NOT NULL,
+    tenant_id String NOT NULL,
+    environment_id String NOT NULL,
+    external_customer_id String NOT NULL,
+    customer_id String NOT NULL DEFAULT '',
+    event_name String NOT NULL,
+    source String NOT NULL DEFAULT '',
+    timestamp DateTime64(3) NOT NULL,
+    ingested_at DateTime64(3) NOT NULL,
+    properties String CODEC(ZSTD),
+    reason LowCardinality(String) NOT NULL,
+    error String NOT NULL DEFAULT '',
+    status LowCardinality(String) NOT NULL,
+    replay_count UInt32 NOT NULL DEFAULT 0,
+    failed_at DateTime64(3) NOT NULL,
+    replayed_at Nullable(DateTime64(3)),
+    version UInt64 NOT NULL,
+    CONSTRAINT check_tenant_id CHECK tenant_id != '',
+    CONSTRAINT check_environment_id CHECK environment_id != ''
+)
+ENGINE = ReplacingMergeTree(version)
+PARTITION BY toYYYYMM(timestamp)
+ORDER BY (tenant_id, environment_id, id)
+TTL toDateTime(failed_at) + INTERVAL 90 DAY
+SETTINGS index_granularity = 8192;
+
+ALTER TABLE flexprice.dead_letter_events
+ADD INDEX IF NOT EXISTS set_reason reason TYPE set(0) GRANULARITY 128,
+ADD INDEX IF NOT EXISTS set_status status TYPE set(0) GRANULARITY 128,
+ADD INDEX IF NOT EXISTS set_event_name event_name TYPE set(0) GRANULARITY 128;
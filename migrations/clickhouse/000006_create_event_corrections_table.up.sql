@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS flexprice.event_corrections (
+    id String NOT NULL,
+    tenant_id String NOT NULL,
+    environment_id String NOT NULL,
+    event_id String NOT NULL,
+    event_name String NOT NULL,
+    external_customer_id String NOT NULL,
+    event_timestamp DateTime64(3) NOT NULL,
+    action LowCardinality(String) NOT NULL,
+    replacement_event_id String NOT NULL DEFAULT '',
+    reason String NOT NULL DEFAULT '',
+    created_at DateTime64(3) NOT NULL DEFAULT now(),
+    created_by String NOT NULL DEFAULT '',
+    CONSTRAINT check_tenant_id CHECK tenant_id != '',
+    CONSTRAINT check_environment_id CHECK environment_id != '',
+    CONSTRAINT check_event_id CHECK event_id != ''
+)
+ENGINE = ReplacingMergeTree(created_at)
+PRIMARY KEY (tenant_id, environment_id)
+ORDER BY (tenant_id, environment_id, event_id)
+SETTINGS index_granularity = 8192;